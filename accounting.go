@@ -0,0 +1,420 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// accountingPaymentsPage is the number of payments that we query from lnd at
+// a time when we reconcile our swap costs.
+const accountingPaymentsPage = 1000
+
+var (
+	// ErrNoAccountingReport is returned when an accounting report is
+	// requested before our first accounting check has completed.
+	ErrNoAccountingReport = errors.New("accounting check has not " +
+		"completed yet")
+)
+
+// AccountingCheck identifies the swap cost that an accounting discrepancy was
+// found in.
+type AccountingCheck uint8
+
+const (
+	// AccountingCheckServerCost compares the server cost that we recorded
+	// for a loop out to the value of its payments in lnd, less the amount
+	// of its htlc if it succeeded.
+	AccountingCheckServerCost AccountingCheck = iota
+
+	// AccountingCheckOffchainFees compares the off-chain fees that we
+	// recorded for a loop out to the routing fees of its payments in lnd.
+	AccountingCheckOffchainFees
+
+	// AccountingCheckOnchainFees compares the on-chain fees that we
+	// recorded for a swap to the fees of its transactions in lnd's wallet.
+	AccountingCheckOnchainFees
+)
+
+// String returns a string representation of an accounting check.
+func (a AccountingCheck) String() string {
+	switch a {
+	case AccountingCheckServerCost:
+		return "server cost"
+
+	case AccountingCheckOffchainFees:
+		return "off-chain fees"
+
+	case AccountingCheckOnchainFees:
+		return "on-chain fees"
+
+	default:
+		return "unknown"
+	}
+}
+
+// AccountingDiscrepancy describes a swap cost that we recorded which does not
+// match lnd's payment or transaction records.
+type AccountingDiscrepancy struct {
+	// SwapHash is the hash of the swap.
+	SwapHash lntypes.Hash
+
+	// SwapType is the type of the swap.
+	SwapType swap.Type
+
+	// Check is the cost that the discrepancy was found in.
+	Check AccountingCheck
+
+	// Recorded is the cost that we recorded for the swap.
+	Recorded btcutil.Amount
+
+	// Actual is the cost that lnd's records imply.
+	Actual btcutil.Amount
+}
+
+// AccountingReport contains the outcome of reconciling the costs of our
+// completed swaps against lnd's payment and transaction records.
+type AccountingReport struct {
+	// CheckedAt is the time at which the check was performed.
+	CheckedAt time.Time
+
+	// SwapsChecked is the number of completed swaps that had at least one
+	// of their costs reconciled against lnd's records.
+	SwapsChecked int
+
+	// Skipped is the number of completed swaps that could not be checked
+	// because lnd has no records for them. This is expected for swaps that
+	// predate full swap hash labels, loop outs that were swept to an
+	// external address and swaps whose payments were deleted from lnd.
+	Skipped int
+
+	// Discrepancies holds each of the costs that did not match lnd's
+	// records.
+	Discrepancies []*AccountingDiscrepancy
+}
+
+// CheckAccounting reconciles the costs that we recorded for our completed
+// swaps against lnd's payment and transaction records.
+func (s *Client) CheckAccounting(ctx context.Context) (*AccountingReport,
+	error) {
+
+	loopOutSwaps, err := s.Store.FetchLoopOutSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	loopInSwaps, err := s.Store.FetchLoopInSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := s.listPayments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.lndServices.Client.ListTransactions(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAccountingReport(
+		loopOutSwaps, loopInSwaps, payments, txs, time.Now(),
+	), nil
+}
+
+// listPayments pages through all of the payments that lnd has made.
+func (s *Client) listPayments(ctx context.Context) ([]lndclient.Payment,
+	error) {
+
+	var (
+		payments []lndclient.Payment
+		offset   uint64
+	)
+
+	for {
+		resp, err := s.lndServices.Client.ListPayments(
+			ctx, lndclient.ListPaymentsRequest{
+				MaxPayments: accountingPaymentsPage,
+				Offset:      offset,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		payments = append(payments, resp.Payments...)
+
+		// We are done once lnd returns no more payments, or our offset
+		// does not advance.
+		if len(resp.Payments) == 0 || resp.LastIndexOffset <= offset {
+			return payments, nil
+		}
+
+		offset = resp.LastIndexOffset
+	}
+}
+
+// paymentTotals is the total value and routing fees of the successful
+// payments of an invoice.
+type paymentTotals struct {
+	value btcutil.Amount
+	fees  btcutil.Amount
+}
+
+// newAccountingReport reconciles the costs of the completed swaps provided
+// against the set of lnd payments and transactions provided.
+func newAccountingReport(loopOutSwaps []*loopdb.LoopOut,
+	loopInSwaps []*loopdb.LoopIn, payments []lndclient.Payment,
+	txs []lndclient.Transaction, now time.Time) *AccountingReport {
+
+	report := &AccountingReport{
+		CheckedAt: now,
+	}
+
+	// We index successful payments by invoice. We convert each payment's
+	// amounts to satoshis individually, because that is how we record our
+	// swap costs.
+	paid := make(map[string]*paymentTotals)
+	for _, payment := range payments {
+		if payment.Status == nil ||
+			payment.Status.State != lnrpc.Payment_SUCCEEDED {
+
+			continue
+		}
+
+		totals, ok := paid[payment.PaymentRequest]
+		if !ok {
+			totals = &paymentTotals{}
+			paid[payment.PaymentRequest] = totals
+		}
+
+		totals.value += payment.Amount.ToSatoshis()
+		totals.fees += payment.Fee.ToSatoshis()
+	}
+
+	// We index confirmed transactions by label, so that we ignore sweeps
+	// that were replaced by a sweep with a higher fee.
+	labelled := make(map[string]lndclient.Transaction)
+	for _, tx := range txs {
+		if tx.Label == "" || tx.Confirmations == 0 {
+			continue
+		}
+
+		labelled[tx.Label] = tx
+	}
+
+	// addDiscrepancy is a helper that adds a discrepancy to our report if
+	// our recorded cost does not match the actual cost.
+	addDiscrepancy := func(hash lntypes.Hash, swapType swap.Type,
+		check AccountingCheck, recorded, actual btcutil.Amount) {
+
+		if recorded == actual {
+			return
+		}
+
+		report.Discrepancies = append(
+			report.Discrepancies, &AccountingDiscrepancy{
+				SwapHash: hash,
+				SwapType: swapType,
+				Check:    check,
+				Recorded: recorded,
+				Actual:   actual,
+			},
+		)
+	}
+
+	for _, out := range loopOutSwaps {
+		state := out.State()
+		if state.State.Type() == loopdb.StateTypePending {
+			continue
+		}
+
+		swapPaid, swapOk := paid[out.Contract.SwapInvoice]
+		prepayPaid, prepayOk := paid[out.Contract.PrepayInvoice]
+		sweep, sweepOk := labelled[labels.LoopOutSweepSuccess(out.Hash)]
+
+		if !swapOk && !prepayOk && !sweepOk {
+			report.Skipped++
+			continue
+		}
+		report.SwapsChecked++
+
+		if swapOk || prepayOk {
+			var value, fees btcutil.Amount
+			for _, totals := range []*paymentTotals{
+				swapPaid, prepayPaid,
+			} {
+				if totals != nil {
+					value += totals.value
+					fees += totals.fees
+				}
+			}
+
+			// If our swap succeeded, the server paid us the value
+			// of its htlc on chain.
+			if state.State == loopdb.StateSuccess {
+				value -= out.Contract.AmountRequested
+			}
+
+			addDiscrepancy(
+				out.Hash, swap.TypeOut,
+				AccountingCheckServerCost, state.Cost.Server,
+				value,
+			)
+
+			addDiscrepancy(
+				out.Hash, swap.TypeOut,
+				AccountingCheckOffchainFees,
+				state.Cost.Offchain, fees,
+			)
+		}
+
+		// Our sweep only pays to our wallet if it succeeded, so we
+		// only check its fee for successful swaps.
+		if sweepOk && state.State == loopdb.StateSuccess &&
+			len(sweep.Tx.TxOut) > 0 {
+
+			fee := out.Contract.AmountRequested -
+				btcutil.Amount(sweep.Tx.TxOut[0].Value)
+
+			addDiscrepancy(
+				out.Hash, swap.TypeOut,
+				AccountingCheckOnchainFees, state.Cost.Onchain,
+				fee,
+			)
+		}
+	}
+
+	for _, in := range loopInSwaps {
+		state := in.State()
+		if state.State.Type() == loopdb.StateTypePending {
+			continue
+		}
+
+		// We can only reconcile htlcs that our own wallet published.
+		htlc, ok := labelled[labels.LoopInHtlcLabel(in.Hash)]
+		if in.Contract.ExternalHtlc || !ok {
+			report.Skipped++
+			continue
+		}
+		report.SwapsChecked++
+
+		fee := htlc.Fee
+
+		// If we swept our htlc with the timeout path, the fee of our
+		// timeout sweep is also included in our on-chain costs.
+		if state.State == loopdb.StateFailTimeout {
+			label := labels.LoopInSweepTimeout(in.Hash)
+			sweep, ok := labelled[label]
+			if !ok || len(sweep.Tx.TxOut) == 0 {
+				continue
+			}
+
+			fee += in.Contract.AmountRequested -
+				btcutil.Amount(sweep.Tx.TxOut[0].Value)
+		}
+
+		addDiscrepancy(
+			in.Hash, swap.TypeIn, AccountingCheckOnchainFees,
+			state.Cost.Onchain, fee,
+		)
+	}
+
+	return report
+}
+
+// AccountingChecker periodically reconciles the costs that we record for our
+// swaps against lnd's payment and transaction records, and logs any
+// discrepancies that it finds.
+type AccountingChecker struct {
+	// check performs a single accounting check.
+	check func(context.Context) (*AccountingReport, error)
+
+	// interval is the period between our accounting checks.
+	interval time.Duration
+
+	// report is the outcome of our last successful check.
+	report *AccountingReport
+
+	mu sync.Mutex
+}
+
+// NewAccountingChecker returns an accounting checker that performs the check
+// provided on the interval provided.
+func NewAccountingChecker(check func(context.Context) (*AccountingReport,
+	error), interval time.Duration) *AccountingChecker {
+
+	return &AccountingChecker{
+		check:    check,
+		interval: interval,
+	}
+}
+
+// Run performs an accounting check immediately, and then on every interval
+// until the context provided is canceled. Failed checks are logged and
+// retried on the next interval.
+func (a *AccountingChecker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := a.Refresh(ctx); err != nil &&
+			ctx.Err() == nil {
+
+			log.Errorf("Accounting check failed: %v", err)
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Refresh performs an accounting check, stores it as our latest report and
+// returns it.
+func (a *AccountingChecker) Refresh(ctx context.Context) (*AccountingReport,
+	error) {
+
+	report, err := a.check(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, discrepancy := range report.Discrepancies {
+		log.Warnf("Accounting discrepancy in %v %v: %v recorded as "+
+			"%v, lnd records imply %v", discrepancy.SwapType,
+			discrepancy.SwapHash, discrepancy.Check,
+			discrepancy.Recorded, discrepancy.Actual)
+	}
+
+	a.mu.Lock()
+	a.report = report
+	a.mu.Unlock()
+
+	return report, nil
+}
+
+// Report returns the outcome of our last accounting check.
+func (a *AccountingChecker) Report() (*AccountingReport, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.report == nil {
+		return nil, ErrNoAccountingReport
+	}
+
+	return a.report, nil
+}
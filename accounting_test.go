@@ -0,0 +1,261 @@
+package loop
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAccountingReport tests reconciliation of our recorded swap costs against
+// lnd's payment and transaction records.
+func TestAccountingReport(t *testing.T) {
+	const amount = btcutil.Amount(100_000)
+
+	newEvent := func(state loopdb.SwapState,
+		cost loopdb.SwapCost) *loopdb.LoopEvent {
+
+		return &loopdb.LoopEvent{
+			SwapStateData: loopdb.SwapStateData{
+				State: state,
+				Cost:  cost,
+			},
+		}
+	}
+
+	newLoopOut := func(hash lntypes.Hash, state loopdb.SwapState,
+		cost loopdb.SwapCost) *loopdb.LoopOut {
+
+		return &loopdb.LoopOut{
+			Loop: loopdb.Loop{
+				Hash: hash,
+				Events: []*loopdb.LoopEvent{
+					newEvent(state, cost),
+				},
+			},
+			Contract: &loopdb.LoopOutContract{
+				SwapContract: loopdb.SwapContract{
+					AmountRequested: amount,
+				},
+				SwapInvoice:   "swap" + hash.String(),
+				PrepayInvoice: "prepay" + hash.String(),
+			},
+		}
+	}
+
+	newLoopIn := func(hash lntypes.Hash, state loopdb.SwapState,
+		cost loopdb.SwapCost, external bool) *loopdb.LoopIn {
+
+		return &loopdb.LoopIn{
+			Loop: loopdb.Loop{
+				Hash: hash,
+				Events: []*loopdb.LoopEvent{
+					newEvent(state, cost),
+				},
+			},
+			Contract: &loopdb.LoopInContract{
+				SwapContract: loopdb.SwapContract{
+					AmountRequested: amount,
+				},
+				ExternalHtlc: external,
+			},
+		}
+	}
+
+	newPayment := func(invoice string, state lnrpc.Payment_PaymentStatus,
+		value, fee btcutil.Amount) lndclient.Payment {
+
+		return lndclient.Payment{
+			PaymentRequest: invoice,
+			Amount:         lnwire.NewMSatFromSatoshis(value),
+			Fee:            lnwire.NewMSatFromSatoshis(fee),
+			Status: &lndclient.PaymentStatus{
+				State: state,
+			},
+		}
+	}
+
+	newTx := func(label string, output, fee btcutil.Amount,
+		confs int32) lndclient.Transaction {
+
+		return lndclient.Transaction{
+			Tx: &wire.MsgTx{
+				TxOut: []*wire.TxOut{{
+					Value: int64(output),
+				}},
+			},
+			Fee:           fee,
+			Confirmations: confs,
+			Label:         label,
+		}
+	}
+
+	var (
+		// outOk is a successful loop out whose costs match lnd's
+		// records. Its sweep was replaced, and its unconfirmed
+		// replacement should be ignored.
+		outOk = newLoopOut(
+			lntypes.Hash{1}, loopdb.StateSuccess, loopdb.SwapCost{
+				Server:   1050,
+				Onchain:  300,
+				Offchain: 6,
+			},
+		)
+
+		// outMissedFee is a successful loop out that did not record
+		// the routing fee of its prepay.
+		outMissedFee = newLoopOut(
+			lntypes.Hash{2}, loopdb.StateSuccess, loopdb.SwapCost{
+				Server:   1050,
+				Offchain: 5,
+			},
+		)
+
+		// outPending is still in flight, so it is not checked.
+		outPending = newLoopOut(
+			lntypes.Hash{3}, loopdb.StateHtlcPublished,
+			loopdb.SwapCost{},
+		)
+
+		// outUnknown has no records in lnd, so it is skipped.
+		outUnknown = newLoopOut(
+			lntypes.Hash{4}, loopdb.StateFailOffchainPayments,
+			loopdb.SwapCost{},
+		)
+
+		// inWrongFee is a successful loop in that recorded an
+		// estimate of its htlc fee.
+		inWrongFee = newLoopIn(
+			lntypes.Hash{5}, loopdb.StateSuccess, loopdb.SwapCost{
+				Onchain: 240,
+			}, false,
+		)
+
+		// inTimeout is a loop in that swept its htlc with the timeout
+		// path and recorded both of its on-chain fees.
+		inTimeout = newLoopIn(
+			lntypes.Hash{6}, loopdb.StateFailTimeout,
+			loopdb.SwapCost{
+				Onchain: 400,
+			}, false,
+		)
+
+		// inExternal had its htlc published by an external wallet, so
+		// it is skipped.
+		inExternal = newLoopIn(
+			lntypes.Hash{7}, loopdb.StateSuccess, loopdb.SwapCost{},
+			true,
+		)
+	)
+
+	payments := []lndclient.Payment{
+		newPayment(
+			outOk.Contract.SwapInvoice, lnrpc.Payment_FAILED,
+			amount+50, 0,
+		),
+		newPayment(
+			outOk.Contract.SwapInvoice, lnrpc.Payment_SUCCEEDED,
+			amount+50, 5,
+		),
+		newPayment(
+			outOk.Contract.PrepayInvoice, lnrpc.Payment_SUCCEEDED,
+			1000, 1,
+		),
+		newPayment(
+			outMissedFee.Contract.SwapInvoice,
+			lnrpc.Payment_SUCCEEDED, amount+50, 5,
+		),
+		newPayment(
+			outMissedFee.Contract.PrepayInvoice,
+			lnrpc.Payment_SUCCEEDED, 1000, 2,
+		),
+	}
+
+	txs := []lndclient.Transaction{
+		newTx(
+			labels.LoopOutSweepSuccess(outOk.Hash), amount-300, 0,
+			3,
+		),
+		newTx(
+			labels.LoopOutSweepSuccess(outOk.Hash), amount-500, 0,
+			0,
+		),
+		newTx(labels.LoopInHtlcLabel(inWrongFee.Hash), amount, 250, 1),
+		newTx(labels.LoopInHtlcLabel(inTimeout.Hash), amount, 200, 1),
+		newTx(
+			labels.LoopInSweepTimeout(inTimeout.Hash), amount-200,
+			0, 1,
+		),
+	}
+
+	now := time.Unix(1000, 0)
+
+	report := newAccountingReport(
+		[]*loopdb.LoopOut{
+			outOk, outMissedFee, outPending, outUnknown,
+		},
+		[]*loopdb.LoopIn{
+			inWrongFee, inTimeout, inExternal,
+		},
+		payments, txs, now,
+	)
+
+	expected := &AccountingReport{
+		CheckedAt:    now,
+		SwapsChecked: 4,
+		Skipped:      2,
+		Discrepancies: []*AccountingDiscrepancy{
+			{
+				SwapHash: outMissedFee.Hash,
+				SwapType: swap.TypeOut,
+				Check:    AccountingCheckOffchainFees,
+				Recorded: 5,
+				Actual:   7,
+			},
+			{
+				SwapHash: inWrongFee.Hash,
+				SwapType: swap.TypeIn,
+				Check:    AccountingCheckOnchainFees,
+				Recorded: 240,
+				Actual:   250,
+			},
+		},
+	}
+	require.Equal(t, expected, report)
+}
+
+// TestAccountingChecker tests storing of the outcome of our accounting
+// checks.
+func TestAccountingChecker(t *testing.T) {
+	report := &AccountingReport{
+		SwapsChecked: 1,
+	}
+
+	checker := NewAccountingChecker(
+		func(context.Context) (*AccountingReport, error) {
+			return report, nil
+		}, time.Hour,
+	)
+
+	// Before we have performed a check, we do not have a report.
+	_, err := checker.Report()
+	require.ErrorIs(t, err, ErrNoAccountingReport)
+
+	refreshed, err := checker.Refresh(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, report, refreshed)
+
+	stored, err := checker.Report()
+	require.NoError(t, err)
+	require.Equal(t, report, stored)
+}
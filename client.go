@@ -9,12 +9,14 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/aperture/lsat"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopdb"
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/sweep"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"google.golang.org/grpc/status"
 )
@@ -28,6 +30,10 @@ var (
 	// too high.
 	ErrPrepayAmountTooHigh = errors.New("prepay amount too high")
 
+	// ErrTotalCostTooHigh is returned when the costs of a swap would
+	// exceed its total cost ceiling.
+	ErrTotalCostTooHigh = errors.New("swap total cost too high")
+
 	// ErrSwapAmountTooLow is returned when the requested swap amount is
 	// less than the server minimum.
 	ErrSwapAmountTooLow = errors.New("swap amount too low")
@@ -67,8 +73,10 @@ type Client struct {
 	errChan chan error
 
 	lndServices *lndclient.LndServices
+	lndFeatures *LndFeatures
 	sweeper     *sweep.Sweeper
 	executor    *executor
+	syncMonitor *lndSyncMonitor
 
 	resumeReady chan struct{}
 	wg          sync.WaitGroup
@@ -116,14 +124,43 @@ type ClientConfig struct {
 	// MaxPaymentRetries is the maximum times we retry an off-chain payment
 	// (used in loop out).
 	MaxPaymentRetries int
+
+	// LoopInAlarmDelta is the number of blocks before a loop in htlc's
+	// expiry at which we raise an alarm if the server has not yet paid
+	// our swap invoice. A zero value disables the alarm.
+	LoopInAlarmDelta int32
+
+	// LoopInCancelDelta is the number of blocks before a loop in htlc's
+	// expiry at which we cancel our swap invoice if the server has not
+	// yet paid it. A zero value disables cancellation.
+	LoopInCancelDelta int32
+
+	// SecretStore is an optional store that the preimages of new swaps
+	// are held in, rather than our database.
+	SecretStore loopdb.SecretStore
 }
 
 // NewClient returns a new instance to initiate swaps with.
 func NewClient(dbDir string, cfg *ClientConfig) (*Client, func(), error) {
-	store, err := loopdb.NewBoltSwapStore(dbDir, cfg.Lnd.ChainParams)
+	boltStore, err := loopdb.NewBoltSwapStore(dbDir, cfg.Lnd.ChainParams)
 	if err != nil {
 		return nil, nil, err
 	}
+
+	var store loopdb.SwapStore = boltStore
+	if cfg.SecretStore != nil {
+		store = loopdb.NewSecretSwapStore(boltStore, cfg.SecretStore)
+	}
+
+	// If we have no secret store, we can't resume pending swaps whose
+	// preimages are held in one, so we refuse to start rather than
+	// resuming them without their preimages.
+	if cfg.SecretStore == nil {
+		if err := loopdb.CheckSecretPreimages(store); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	lsatStore, err := lsat.NewFileStore(dbDir)
 	if err != nil {
 		return nil, nil, err
@@ -158,14 +195,18 @@ func NewClient(dbDir string, cfg *ClientConfig) (*Client, func(), error) {
 		totalPaymentTimeout: cfg.TotalPaymentTimeout,
 		maxPaymentRetries:   cfg.MaxPaymentRetries,
 		cancelSwap:          swapServerClient.CancelLoopOutSwap,
+		loopInAlarmDelta:    cfg.LoopInAlarmDelta,
+		loopInCancelDelta:   cfg.LoopInCancelDelta,
 	})
 
 	client := &Client{
 		errChan:      make(chan error),
 		clientConfig: *config,
 		lndServices:  cfg.Lnd,
+		lndFeatures:  NewLndFeatures(cfg.Lnd.Version),
 		sweeper:      sweeper,
 		executor:     executor,
+		syncMonitor:  newLndSyncMonitor(cfg.Lnd.Client),
 		resumeReady:  make(chan struct{}),
 	}
 
@@ -192,59 +233,191 @@ func (s *Client) FetchSwaps() ([]*SwapInfo, error) {
 	swaps := make([]*SwapInfo, 0, len(loopInSwaps)+len(loopOutSwaps))
 
 	for _, swp := range loopOutSwaps {
-		htlc, err := swap.NewHtlc(
-			GetHtlcScriptVersion(swp.Contract.ProtocolVersion),
-			swp.Contract.CltvExpiry, swp.Contract.SenderKey,
-			swp.Contract.ReceiverKey, swp.Hash, swap.HtlcP2WSH,
-			s.lndServices.ChainParams,
-		)
+		info, err := loopOutSwapInfo(swp, s.lndServices.ChainParams)
 		if err != nil {
 			return nil, err
 		}
 
-		swaps = append(swaps, &SwapInfo{
-			SwapType:         swap.TypeOut,
-			SwapContract:     swp.Contract.SwapContract,
-			SwapStateData:    swp.State(),
-			SwapHash:         swp.Hash,
-			LastUpdate:       swp.LastUpdateTime(),
-			HtlcAddressP2WSH: htlc.Address,
-		})
+		swaps = append(swaps, info)
 	}
 
 	for _, swp := range loopInSwaps {
-		htlcNP2WSH, err := swap.NewHtlc(
-			GetHtlcScriptVersion(swp.Contract.ProtocolVersion),
-			swp.Contract.CltvExpiry, swp.Contract.SenderKey,
-			swp.Contract.ReceiverKey, swp.Hash, swap.HtlcNP2WSH,
-			s.lndServices.ChainParams,
+		info, err := loopInSwapInfo(swp, s.lndServices.ChainParams)
+		if err != nil {
+			return nil, err
+		}
+
+		swaps = append(swaps, info)
+	}
+
+	return swaps, nil
+}
+
+// loopOutSwapInfo returns the swap info for a loop out in our store.
+func loopOutSwapInfo(swp *loopdb.LoopOut,
+	chainParams *chaincfg.Params) (*SwapInfo, error) {
+
+	htlc, err := swap.NewHtlc(
+		GetHtlcScriptVersion(swp.Contract.ProtocolVersion),
+		swp.Contract.CltvExpiry, swp.Contract.SenderKey,
+		swp.Contract.ReceiverKey, swp.Hash, swap.HtlcP2WSH,
+		chainParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SwapInfo{
+		SwapType:         swap.TypeOut,
+		SwapContract:     swp.Contract.SwapContract,
+		SwapStateData:    swp.State(),
+		SwapHash:         swp.Hash,
+		LastUpdate:       swp.LastUpdateTime(),
+		HtlcAddressP2WSH: htlc.Address,
+		DestVerification: swp.Contract.DestVerification,
+	}, nil
+}
+
+// loopInSwapInfo returns the swap info for a loop in in our store.
+func loopInSwapInfo(swp *loopdb.LoopIn,
+	chainParams *chaincfg.Params) (*SwapInfo, error) {
+
+	htlcNP2WSH, err := swap.NewHtlc(
+		GetHtlcScriptVersion(swp.Contract.ProtocolVersion),
+		swp.Contract.CltvExpiry, swp.Contract.SenderKey,
+		swp.Contract.ReceiverKey, swp.Hash, swap.HtlcNP2WSH,
+		chainParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	htlcP2WSH, err := swap.NewHtlc(
+		GetHtlcScriptVersion(swp.Contract.ProtocolVersion),
+		swp.Contract.CltvExpiry, swp.Contract.SenderKey,
+		swp.Contract.ReceiverKey, swp.Hash, swap.HtlcP2WSH,
+		chainParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SwapInfo{
+		SwapType:          swap.TypeIn,
+		SwapContract:      swp.Contract.SwapContract,
+		SwapStateData:     swp.State(),
+		SwapHash:          swp.Hash,
+		LastUpdate:        swp.LastUpdateTime(),
+		HtlcAddressP2WSH:  htlcP2WSH.Address,
+		HtlcAddressNP2WSH: htlcNP2WSH.Address,
+	}, nil
+}
+
+// LndFeatures returns the version dependent features that our lnd node
+// supports.
+func (s *Client) LndFeatures() *LndFeatures {
+	return s.lndFeatures
+}
+
+// SetSwapNotes replaces the free-text notes attached to the swap with the hash
+// and type provided. An empty string clears the swap's notes.
+func (s *Client) SetSwapNotes(swapType swap.Type, hash lntypes.Hash,
+	notes string) error {
+
+	if swapType == swap.TypeOut {
+		return s.Store.SetLoopOutNotes(hash, notes)
+	}
+
+	return s.Store.SetLoopInNotes(hash, notes)
+}
+
+// SwapConflict describes a swap hash that is used by both a loop out and a
+// loop in swap in our store.
+type SwapConflict struct {
+	// Hash is the swap hash shared by both swaps.
+	Hash lntypes.Hash
+
+	// LoopOut is the loop out swap that uses the hash.
+	LoopOut *SwapInfo
+
+	// LoopIn is the loop in swap that uses the hash.
+	LoopIn *SwapInfo
+}
+
+// SwapConflicts returns the swap hashes that are used by both a loop out and
+// a loop in swap in our store.
+func (s *Client) SwapConflicts() ([]*SwapConflict, error) {
+	loopOutSwaps, err := s.Store.FetchLoopOutSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	loopInSwaps, err := s.Store.FetchLoopInSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := loopdb.FindSwapConflicts(loopOutSwaps, loopInSwaps)
+	result := make([]*SwapConflict, 0, len(conflicts))
+
+	for _, conflict := range conflicts {
+		loopOut, err := loopOutSwapInfo(
+			conflict.LoopOut, s.lndServices.ChainParams,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		htlcP2WSH, err := swap.NewHtlc(
-			GetHtlcScriptVersion(swp.Contract.ProtocolVersion),
-			swp.Contract.CltvExpiry, swp.Contract.SenderKey,
-			swp.Contract.ReceiverKey, swp.Hash, swap.HtlcP2WSH,
-			s.lndServices.ChainParams,
+		loopIn, err := loopInSwapInfo(
+			conflict.LoopIn, s.lndServices.ChainParams,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		swaps = append(swaps, &SwapInfo{
-			SwapType:          swap.TypeIn,
-			SwapContract:      swp.Contract.SwapContract,
-			SwapStateData:     swp.State(),
-			SwapHash:          swp.Hash,
-			LastUpdate:        swp.LastUpdateTime(),
-			HtlcAddressP2WSH:  htlcP2WSH.Address,
-			HtlcAddressNP2WSH: htlcNP2WSH.Address,
+		result = append(result, &SwapConflict{
+			Hash:    conflict.Hash,
+			LoopOut: loopOut,
+			LoopIn:  loopIn,
 		})
 	}
 
-	return swaps, nil
+	return result, nil
+}
+
+// ResolveSwapConflict resolves a conflict between a loop out and a loop in
+// swap that share the hash provided by keeping the swap of the type provided.
+// The swap that was kept is returned. If it is pending, it is resumed on our
+// next restart.
+func (s *Client) ResolveSwapConflict(hash lntypes.Hash,
+	keep swap.Type) (*SwapInfo, error) {
+
+	conflicts, err := s.SwapConflicts()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, conflict := range conflicts {
+		if conflict.Hash != hash {
+			continue
+		}
+
+		err := s.Store.ResolveSwapConflict(hash, keep)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Resolved swap conflict for %v, kept %v swap", hash,
+			keep)
+
+		if keep == swap.TypeOut {
+			return conflict.LoopOut, nil
+		}
+
+		return conflict.LoopIn, nil
+	}
+
+	return nil, fmt.Errorf("%w: %v", loopdb.ErrNoSwapConflict, hash)
 }
 
 // Run is a blocking call that executes all swaps. Any pending swaps are
@@ -262,6 +435,7 @@ func (s *Client) Run(ctx context.Context,
 	log.Infof("Connected to lnd node '%v' with pubkey %s (version %s)",
 		s.lndServices.NodeAlias, s.lndServices.NodePubkey,
 		lndclient.VersionString(s.lndServices.Version))
+	s.lndFeatures.logStatus()
 
 	// Setup main context used for cancelation.
 	mainCtx, mainCancel := context.WithCancel(ctx)
@@ -279,12 +453,18 @@ func (s *Client) Run(ctx context.Context,
 		return err
 	}
 
+	rebalances, err := s.Store.FetchRebalances()
+	if err != nil {
+		return err
+	}
+
 	// Start goroutine to deliver all pending swaps to the main loop.
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
 
 		s.resumeSwaps(mainCtx, pendingLoopOutSwaps, pendingLoopInSwaps)
+		s.resumeRebalances(mainCtx, rebalances)
 
 		// Signal that new requests can be accepted. Otherwise the new
 		// swap could already have been added to the store and read in
@@ -293,6 +473,15 @@ func (s *Client) Run(ctx context.Context,
 		close(s.resumeReady)
 	}()
 
+	// Monitor lnd's sync state, so that we suspend dispatch of new swaps
+	// while lnd is catching up with the chain.
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		s.syncMonitor.run(mainCtx, DefaultSyncCheckInterval)
+	}()
+
 	// Main event loop.
 	err = s.executor.run(mainCtx, statusChan)
 
@@ -328,10 +517,27 @@ func (s *Client) resumeSwaps(ctx context.Context,
 
 	swapCfg := newSwapConfig(s.lndServices, s.Store, s.Server)
 
+	// We do not know which of two swaps that share a hash is the swap
+	// that the server knows about, so we do not resume either of them
+	// until the conflict has been resolved.
+	conflicted := make(map[lntypes.Hash]bool)
+	for _, conflict := range loopdb.FindSwapConflicts(
+		loopOutSwaps, loopInSwaps,
+	) {
+		log.Errorf("Swap %v is used by both a loop out and a loop in "+
+			"swap, not resuming until resolved with `loop "+
+			"resolveconflict`", conflict.Hash)
+
+		conflicted[conflict.Hash] = true
+	}
+
 	for _, pend := range loopOutSwaps {
 		if pend.State().State.Type() != loopdb.StateTypePending {
 			continue
 		}
+		if conflicted[pend.Hash] {
+			continue
+		}
 		swap, err := resumeLoopOutSwap(ctx, swapCfg, pend)
 		if err != nil {
 			log.Errorf("resuming loop out swap: %v", err)
@@ -345,6 +551,9 @@ func (s *Client) resumeSwaps(ctx context.Context,
 		if pend.State().State.Type() != loopdb.StateTypePending {
 			continue
 		}
+		if conflicted[pend.Hash] {
+			continue
+		}
 		swap, err := resumeLoopInSwap(ctx, swapCfg, pend)
 		if err != nil {
 			log.Errorf("resuming loop in swap: %v", err)
@@ -375,6 +584,14 @@ func (s *Client) LoopOut(globalCtx context.Context,
 		return nil, err
 	}
 
+	if !s.LndSynced() {
+		return nil, ErrLndNotSynced
+	}
+
+	if err := ValidateCustomRecords(request.CustomRecords); err != nil {
+		return nil, err
+	}
+
 	// Calculate htlc expiry height.
 	terms, err := s.Server.GetLoopOutTerms(globalCtx)
 	if err != nil {
@@ -399,18 +616,109 @@ func (s *Client) LoopOut(globalCtx context.Context,
 	}
 	swap := initResult.swap
 
+	// Estimate the fee that we will pay to sweep the htlc, so that we can
+	// provide the value we expect to sweep. We do this before we start
+	// the swap, because the executor owns the swap once it is posted.
+	sweepFee := s.estimateLoopOutSweepFee(globalCtx, swap)
+
 	// Post swap to the main loop.
 	s.executor.initiateSwap(globalCtx, swap)
 
 	// Return hash so that the caller can identify this swap in the updates
 	// stream.
 	return &LoopOutSwapInfo{
-		SwapHash:         swap.hash,
-		HtlcAddressP2WSH: swap.htlc.Address,
-		ServerMessage:    initResult.serverMessage,
+		SwapHash:           swap.hash,
+		HtlcAddressP2WSH:   swap.htlc.Address,
+		ServerMessage:      initResult.serverMessage,
+		HtlcValue:          swap.AmountRequested,
+		SwapInvoiceAmount:  initResult.swapInvoiceAmt,
+		PrepayAmount:       initResult.prepayInvoiceAmt,
+		ExpectedSweepValue: swap.AmountRequested - sweepFee,
 	}, nil
 }
 
+// ValidateLoopOut runs the checks that LoopOut performs before a swap is
+// created, without creating the swap. It gets a quote for the request and
+// fails if the quoted fees exceed the request's fee limits, returning the
+// quote otherwise.
+func (s *Client) ValidateLoopOut(ctx context.Context,
+	request *OutRequest) (*LoopOutQuote, error) {
+
+	log.Infof("Validate loop out %v to %v (channels: %v)",
+		request.Amount, request.DestAddr, request.OutgoingChanSet,
+	)
+
+	if err := s.waitForInitialized(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateCustomRecords(request.CustomRecords); err != nil {
+		return nil, err
+	}
+
+	quote, err := s.LoopOutQuote(ctx, &LoopOutQuoteRequest{
+		Amount:                  request.Amount,
+		SweepConfTarget:         request.SweepConfTarget,
+		SwapPublicationDeadline: request.SwapPublicationDeadline,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if quote.SwapFee > request.MaxSwapFee {
+		log.Warnf("Swap fee %v exceeding maximum of %v",
+			quote.SwapFee, request.MaxSwapFee)
+
+		return nil, ErrSwapFeeTooHigh
+	}
+
+	if quote.PrepayAmount > request.MaxPrepayAmount {
+		log.Warnf("Prepay amount %v exceeding maximum of %v",
+			quote.PrepayAmount, request.MaxPrepayAmount)
+
+		return nil, ErrPrepayAmountTooHigh
+	}
+
+	err = checkTotalCost(request.MaxTotalCost, quote.SwapFee+quote.MinerFee)
+	if err != nil {
+		return nil, err
+	}
+
+	// The prepay is forfeited if the swap fails, so it has to fit within
+	// our total cost ceiling by itself as well.
+	err = checkTotalCost(request.MaxTotalCost, quote.PrepayAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
+
+// estimateLoopOutSweepFee returns the fee we expect to pay to sweep a loop
+// out's htlc at current fee rates, capped at the swap's maximum miner fee. If
+// we cannot get a fee estimate, we fall back to the maximum miner fee because
+// this is only an estimate and should not fail the swap.
+func (s *Client) estimateLoopOutSweepFee(ctx context.Context,
+	swp *loopOutSwap) btcutil.Amount {
+
+	fee, err := s.sweeper.GetSweepFee(
+		ctx, swp.htlc.AddSuccessToEstimator, swp.DestAddr,
+		swp.SweepConfTarget,
+	)
+	if err != nil {
+		log.Warnf("Could not estimate sweep fee for %v: %v", swp.hash,
+			err)
+
+		return swp.MaxMinerFee
+	}
+
+	if fee > swp.MaxMinerFee {
+		return swp.MaxMinerFee
+	}
+
+	return fee
+}
+
 // getExpiry returns an absolute expiry height based on the sweep confirmation
 // target, constrained by the server terms.
 func (s *Client) getExpiry(height int32, terms *LoopOutTerms,
@@ -529,6 +837,10 @@ func (s *Client) LoopIn(globalCtx context.Context,
 		return nil, err
 	}
 
+	if !s.LndSynced() {
+		return nil, ErrLndNotSynced
+	}
+
 	// Create a new swap object for this swap.
 	initiationHeight := s.executor.height()
 	swapCfg := newSwapConfig(s.lndServices, s.Store, s.Server)
@@ -554,6 +866,57 @@ func (s *Client) LoopIn(globalCtx context.Context,
 	return swapInfo, nil
 }
 
+// ValidateLoopIn runs the checks that LoopIn performs before a swap is
+// created, without creating the swap. It gets a quote for the request and
+// fails if the quoted swap fee exceeds the request's fee limit, returning the
+// quote otherwise. If the request has a change tolerance, its amount is
+// adjusted to the amount that the swap would be initiated with.
+func (s *Client) ValidateLoopIn(ctx context.Context,
+	request *LoopInRequest) (*LoopInQuote, error) {
+
+	log.Infof("Validate loop in %v (last hop: %v)", request.Amount,
+		request.LastHop)
+
+	if err := s.waitForInitialized(ctx); err != nil {
+		return nil, err
+	}
+
+	// If the swap should be funded without change, we quote for the
+	// adjusted amount that it would be initiated with.
+	if request.ChangeTolerance != 0 {
+		_, err := applyChangeTolerance(ctx, s.lndServices, request)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	quote, err := s.LoopInQuote(ctx, &LoopInQuoteRequest{
+		Amount:         request.Amount,
+		HtlcConfTarget: request.HtlcConfTarget,
+		ExternalHtlc:   request.ExternalHtlc,
+		LastHop:        request.LastHop,
+		RouteHints:     request.RouteHints,
+		Private:        request.Private,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if quote.SwapFee > request.MaxSwapFee {
+		log.Warnf("Swap fee %v exceeding maximum of %v",
+			quote.SwapFee, request.MaxSwapFee)
+
+		return nil, ErrSwapFeeTooHigh
+	}
+
+	err = checkTotalCost(request.MaxTotalCost, quote.SwapFee+quote.MinerFee)
+	if err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
+
 // LoopInQuote takes an amount and returns a break down of estimated
 // costs for the client. Both the swap server and the on-chain fee estimator are
 // queried to get to build the quote response.
@@ -61,6 +61,18 @@ func TestSuccess(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// Assert that we report each of the swap's payment legs. Our sweep
+	// value depends on the mock fee estimate, so we just check that it is
+	// within the bounds set by our maximum miner fee.
+	require.Equal(t, req.Amount, info.HtlcValue)
+	require.Equal(t, ctx.serverMock.swapInvoiceAmt, info.SwapInvoiceAmount)
+	require.Equal(t, ctx.serverMock.prepayInvoiceAmt, info.PrepayAmount)
+	require.Less(t, int64(info.ExpectedSweepValue), int64(req.Amount))
+	require.GreaterOrEqual(
+		t, int64(info.ExpectedSweepValue),
+		int64(req.Amount-req.MaxMinerFee),
+	)
+
 	ctx.assertStored()
 	ctx.assertStatus(loopdb.StateInitiated)
 
@@ -148,6 +160,36 @@ func TestFailWrongAmount(t *testing.T) {
 
 }
 
+// TestValidateLoopOut tests validating a loop out request against its fee
+// limits without creating a swap.
+func TestValidateLoopOut(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := createClientTestContext(t, nil)
+
+	quote, err := ctx.swapClient.ValidateLoopOut(
+		context.Background(), testRequest,
+	)
+	require.NoError(t, err)
+	require.Equal(t, testSwapFee, quote.SwapFee)
+	require.Equal(t, testFixedPrepayAmount, quote.PrepayAmount)
+
+	req := *testRequest
+	req.MaxSwapFee = testSwapFee - 1
+	_, err = ctx.swapClient.ValidateLoopOut(context.Background(), &req)
+	require.Equal(t, ErrSwapFeeTooHigh, err)
+
+	req = *testRequest
+	req.MaxPrepayAmount = testFixedPrepayAmount - 1
+	_, err = ctx.swapClient.ValidateLoopOut(context.Background(), &req)
+	require.Equal(t, ErrPrepayAmountTooHigh, err)
+
+	// Validating our requests should not have created any swaps.
+	require.Empty(t, ctx.store.loopOutSwaps)
+
+	ctx.finish()
+}
+
 // TestResume tests that swaps in various states are properly resumed after a
 // restart.
 func TestResume(t *testing.T) {
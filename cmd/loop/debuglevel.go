@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var debugLevelCommand = cli.Command{
+	Name:  "debuglevel",
+	Usage: "set the log levels of loopd's subsystems",
+	Description: "Sets the log level of all subsystems, or of individual " +
+		"subsystems, while loopd is running. Use --show to list the " +
+		"subsystems that log levels can be set for.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "show",
+			Usage: "list the subsystems that log levels can be set for",
+		},
+		cli.StringFlag{
+			Name: "level",
+			Usage: "the log level for all subsystems, or " +
+				"<subsystem>=<level> pairs separated by " +
+				"commas, for example info,LOOP=debug",
+		},
+	},
+	Action: debugLevel,
+}
+
+func debugLevel(ctx *cli.Context) error {
+	// Show command help if no flags were provided.
+	if !ctx.IsSet("show") && !ctx.IsSet("level") {
+		return cli.ShowCommandHelp(ctx, "debuglevel")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.DebugLevel(
+		context.Background(), &looprpc.DebugLevelRequest{
+			Show:      ctx.Bool("show"),
+			LevelSpec: ctx.String("level"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var getInfoCommand = cli.Command{
+	Name:  "getinfo",
+	Usage: "show basic information about the daemon",
+	Description: "Shows the version and network of loopd, and whether " +
+		"the connected lnd node is synced to the chain. While lnd " +
+		"is syncing, new swaps are refused and autoloop ticks are " +
+		"skipped until it has caught up.",
+	Action: getInfo,
+}
+
+func getInfo(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetInfo(
+		context.Background(), &looprpc.GetInfoRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/lightninglabs/loop/liquidity"
 	"github.com/lightninglabs/loop/looprpc"
@@ -20,6 +22,16 @@ var getLiquidityParamsCommand = cli.Command{
 	Usage: "show liquidity manager parameters",
 	Description: "Displays the current set of parameters that are set " +
 		"for the liquidity manager.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "effective",
+			Usage: "show the fully resolved settings of loopd " +
+				"and the liquidity manager, annotated with " +
+				"whether each value is a default or was set " +
+				"in the config file, on the command line or " +
+				"over rpc.",
+		},
+	},
 	Action: getParams,
 }
 
@@ -30,6 +42,20 @@ func getParams(ctx *cli.Context) error {
 	}
 	defer cleanup()
 
+	if ctx.Bool("effective") {
+		resp, err := client.GetEffectiveConfig(
+			context.Background(),
+			&looprpc.GetEffectiveConfigRequest{},
+		)
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+
+		return nil
+	}
+
 	cfg, err := client.GetLiquidityParams(
 		context.Background(), &looprpc.GetLiquidityParamsRequest{},
 	)
@@ -43,58 +69,242 @@ func getParams(ctx *cli.Context) error {
 }
 
 var setLiquidityRuleCommand = cli.Command{
-	Name:        "setrule",
-	Usage:       "set liquidity manager rule for a channel/peer",
-	Description: "Update or remove the liquidity rule for a channel/peer.",
-	ArgsUsage:   "{shortchanid |  peerpubkey}",
-	Flags: []cli.Flag{
+	Name:  "setrule",
+	Usage: "set liquidity manager rule for a channel/peer/group",
+	Description: "Update or remove the liquidity rule for a channel/peer, " +
+		"or for a group of channels set with the --group flag.",
+	ArgsUsage: "{shortchanid |  peerpubkey}",
+	Flags: append([]cli.Flag{
 		cli.StringFlag{
-			Name: "type",
-			Usage: "the type of swap to perform, set to 'out' " +
-				"for acquiring inbound liquidity or 'in' for " +
-				"acquiring outbound liquidity.",
-			Value: "out",
+			Name: "group",
+			Usage: "the name of a channel group to set the rule " +
+				"for instead of a channel or peer. The rule " +
+				"applies to the total balance of the group's " +
+				"channels.",
 		},
-
-		cli.IntFlag{
-			Name: "incoming_threshold",
-			Usage: "the minimum percentage of incoming liquidity " +
-				"to total capacity beneath which to " +
-				"recommend loop out to acquire incoming.",
-		},
-		cli.IntFlag{
-			Name: "outgoing_threshold",
-			Usage: "the minimum percentage of outbound liquidity " +
-				"that we do not want to drop below.",
+		cli.StringFlag{
+			Name: "channels",
+			Usage: "a comma separated list of the short channel " +
+				"IDs in the channel group, required when " +
+				"setting a group rule.",
 		},
 		cli.BoolFlag{
 			Name: "clear",
 			Usage: "remove the rule currently set for the " +
 				"channel/peer.",
 		},
-	},
+	}, ruleTemplateFlags...),
 	Action: setRule,
 }
 
-func setRule(ctx *cli.Context) error {
-	// We require that a channel ID is set for this rule update.
-	if ctx.NArg() != 1 {
-		return fmt.Errorf("please set a channel id or peer pubkey " +
-			"for the rule update")
-	}
+// ruleTemplateFlags is the set of flags that set the values of a liquidity
+// rule, shared by the commands that set rules.
+var ruleTemplateFlags = []cli.Flag{
+	cli.StringFlag{
+		Name: "type",
+		Usage: "the type of swap to perform, set to 'out' " +
+			"for acquiring inbound liquidity or 'in' for " +
+			"acquiring outbound liquidity.",
+		Value: "out",
+	},
+	cli.IntFlag{
+		Name: "incoming_threshold",
+		Usage: "the minimum percentage of incoming liquidity " +
+			"to total capacity beneath which to " +
+			"recommend loop out to acquire incoming.",
+	},
+	cli.IntFlag{
+		Name: "outgoing_threshold",
+		Usage: "the minimum percentage of outbound liquidity " +
+			"that we do not want to drop below.",
+	},
+	cli.Uint64Flag{
+		Name: "incoming_amount",
+		Usage: "the minimum amount of incoming liquidity in " +
+			"satoshis beneath which to recommend loop " +
+			"out to acquire incoming, which may be set " +
+			"instead of incoming_threshold.",
+	},
+	cli.Uint64Flag{
+		Name: "outgoing_amount",
+		Usage: "the minimum amount of outbound liquidity in " +
+			"satoshis that we do not want to drop below, " +
+			"which may be set instead of " +
+			"outgoing_threshold.",
+	},
+	cli.StringFlag{
+		Name: "shrink_policy",
+		Usage: "the action to take when the server lowers " +
+			"its maximum swap amount below the amount " +
+			"of a swap suggested for this rule before it " +
+			"is dispatched, set to 'skip' to skip the " +
+			"swap, 'resize' to lower its amount to the " +
+			"new maximum or 'split' to split it into " +
+			"multiple swaps.",
+		Value: "skip",
+	},
+	cli.Uint64Flag{
+		Name: "fee_budget",
+		Usage: "the total amount of fees in satoshis that " +
+			"autoloop may spend on swaps for this " +
+			"channel/peer since the budget start date, " +
+			"in addition to the global budget. Set to 0 " +
+			"to only apply the global budget.",
+	},
+	cli.DurationFlag{
+		Name: "expires_in",
+		Usage: "the duration after which the rule expires, " +
+			"for example 48h, after which autoloop no " +
+			"longer suggests swaps for it. If not set, " +
+			"the rule does not expire.",
+	},
+	cli.DurationFlag{
+		Name: "cooldown",
+		Usage: "the duration, for example 12h, to wait after " +
+			"a swap in the opposite direction completes " +
+			"for this channel/peer before suggesting " +
+			"swaps for this rule. Set to 0 to disable " +
+			"the cooldown.",
+	},
+	cli.Uint64Flag{
+		Name: "max_swap_amount",
+		Usage: "the maximum amount in satoshis of swaps for " +
+			"this channel/peer, even if the rule's " +
+			"thresholds require a larger swap. Set to 0 " +
+			"to only apply the global swap size limits.",
+	},
+	cli.Uint64Flag{
+		Name: "min_revenue_percent",
+		Usage: "the percentage of the fees of a loop out for " +
+			"this channel/peer that it must have earned in " +
+			"routing fees over the revenue window for the " +
+			"swap to be suggested. Set to 0 to not require " +
+			"any revenue. Only valid for loop out rules.",
+	},
+	cli.DurationFlag{
+		Name: "revenue_window",
+		Usage: "the period of forwarding history, for example " +
+			"168h, that min_revenue_percent is applied to. " +
+			"If not set, 30 days is used.",
+	},
+	cli.StringFlag{
+		Name: "sweep_address",
+		Usage: "the address that autoloop sweeps loop outs " +
+			"for this rule to, rather than the lnd " +
+			"wallet. Only valid for loop out rules.",
+	},
+	cli.StringFlag{
+		Name: "sweep_xpub",
+		Usage: "an extended public key that autoloop derives " +
+			"a fresh sweep address from for each loop " +
+			"out for this rule. Only valid for loop out " +
+			"rules, and may not be set with sweep_address.",
+	},
+	cli.StringFlag{
+		Name: "sweep_address_signature",
+		Usage: "an optional base64 signature of sweep_address, " +
+			"signed by the address's key with a wallet's " +
+			"sign message feature, which verifies that the " +
+			"address is owned by you.",
+	},
+	cli.DurationFlag{
+		Name: "publication_deadline",
+		Usage: "the amount of time, for example 30m, that the " +
+			"server may wait before publishing the htlc " +
+			"of a loop out for this rule, which lowers " +
+			"the swap fee, overriding the global " +
+			"publication deadline. Only valid for loop " +
+			"out rules.",
+	},
+	cli.Float64Flag{
+		Name: "feepercent",
+		Usage: "the maximum percentage of swap amount to be " +
+			"used across all fee categories for swaps " +
+			"for this rule, overriding the global fee " +
+			"limit.",
+	},
+	cli.IntFlag{
+		Name: "sweeplimit",
+		Usage: "the limit placed on our estimated sweep fee " +
+			"in sat/vByte for swaps for this rule.",
+	},
+	cli.Float64Flag{
+		Name: "maxswapfee",
+		Usage: "the maximum percentage of swap volume we are " +
+			"willing to pay in server fees for swaps for " +
+			"this rule.",
+	},
+	cli.Float64Flag{
+		Name: "maxroutingfee",
+		Usage: "the maximum percentage of off-chain payment " +
+			"volume that we are willing to pay in " +
+			"routing fees for swaps for this rule.",
+	},
+	cli.Float64Flag{
+		Name: "maxprepayfee",
+		Usage: "the maximum percentage of off-chain prepay " +
+			"volume that we are willing to pay in " +
+			"routing fees for swaps for this rule.",
+	},
+	cli.Uint64Flag{
+		Name: "maxprepay",
+		Usage: "the maximum no-show (prepay) in satoshis for " +
+			"swaps for this rule.",
+	},
+	cli.Uint64Flag{
+		Name: "maxminer",
+		Usage: "the maximum miner fee in satoshis for swaps " +
+			"for this rule.",
+	},
+	cli.StringSliceFlag{
+		Name: "custom_record",
+		Usage: "a custom tlv record to attach to the " +
+			"payments of loop outs for this rule, in " +
+			"the format type=hexvalue, where type is " +
+			">= 65536. The flag can be repeated to " +
+			"attach multiple records.",
+	},
+}
 
+func setRule(ctx *cli.Context) error {
 	var (
 		pubkey     route.Vertex
 		pubkeyRule bool
+		chanID     uint64
+		groupName  = ctx.String("group")
+		target     = groupName
 	)
-	chanID, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
-	if err != nil {
-		pubkey, err = route.NewVertexFromStr(ctx.Args().First())
+
+	// We require that a channel ID, pubkey or group is set for this rule
+	// update. Group rules are identified by their name, so they do not
+	// take a channel or peer argument.
+	switch {
+	case groupName != "":
+		if ctx.NArg() != 0 {
+			return errors.New("do not set a channel id or peer " +
+				"pubkey for a group rule")
+		}
+
+	case ctx.NArg() != 1:
+		return fmt.Errorf("please set a channel id, peer pubkey or " +
+			"group for the rule update")
+
+	case ctx.IsSet("channels"):
+		return errors.New("channels may only be set for a group rule")
+
+	default:
+		target = ctx.Args().First()
+
+		var err error
+		chanID, err = strconv.ParseUint(target, 10, 64)
 		if err != nil {
-			return fmt.Errorf("please provide a valid pubkey: "+
-				"%v, or short channel ID", err)
+			pubkey, err = route.NewVertexFromStr(target)
+			if err != nil {
+				return fmt.Errorf("please provide a valid "+
+					"pubkey: %v, or short channel ID", err)
+			}
+			pubkeyRule = true
 		}
-		pubkeyRule = true
 	}
 
 	client, cleanup, err := getClient(ctx)
@@ -114,10 +324,12 @@ func setRule(ctx *cli.Context) error {
 	}
 
 	var (
-		inboundSet  = ctx.IsSet("incoming_threshold")
-		outboundSet = ctx.IsSet("outgoing_threshold")
-		ruleSet     bool
-		otherRules  []*looprpc.LiquidityRule
+		inboundSet = ctx.IsSet("incoming_threshold") ||
+			ctx.IsSet("incoming_amount")
+		outboundSet = ctx.IsSet("outgoing_threshold") ||
+			ctx.IsSet("outgoing_amount")
+		ruleSet    bool
+		otherRules []*looprpc.LiquidityRule
 	)
 
 	// Run through our current set of rules and check whether we have a rule
@@ -132,9 +344,12 @@ func setRule(ctx *cli.Context) error {
 			peerRuleSet = rule.Pubkey != nil && bytes.Equal(
 				rule.Pubkey, pubkey[:],
 			)
+
+			groupRuleSet = groupName != "" &&
+				rule.GroupName == groupName
 		)
 
-		if channelRuleSet || peerRuleSet {
+		if channelRuleSet || peerRuleSet || groupRuleSet {
 			ruleSet = true
 		} else {
 			otherRules = append(otherRules, rule)
@@ -146,11 +361,21 @@ func setRule(ctx *cli.Context) error {
 	// set excluding the channel specified.
 	if ctx.IsSet("clear") {
 		if !ruleSet {
-			return fmt.Errorf("cannot clear channel: %v, no rule "+
-				"set at present", chanID)
+			return fmt.Errorf("cannot clear rule for: %v, no rule "+
+				"set at present", target)
 		}
 
-		if inboundSet || outboundSet {
+		if inboundSet || outboundSet || ctx.IsSet("shrink_policy") ||
+			ctx.IsSet("fee_budget") || ctx.IsSet("expires_in") ||
+			ctx.IsSet("channels") || ctx.IsSet("cooldown") ||
+			ctx.IsSet("max_swap_amount") ||
+			ctx.IsSet("min_revenue_percent") ||
+			ctx.IsSet("revenue_window") ||
+			ctx.IsSet("sweep_address") || ctx.IsSet("sweep_xpub") ||
+			ctx.IsSet("sweep_address_signature") ||
+			ctx.IsSet("publication_deadline") ||
+			ruleFeesSet(ctx) {
+
 			return fmt.Errorf("do not set other flags with clear " +
 				"flag")
 		}
@@ -173,37 +398,38 @@ func setRule(ctx *cli.Context) error {
 	}
 
 	// Create a new rule which will be used to overwrite our current rule.
-	newRule := &looprpc.LiquidityRule{
-		ChannelId: chanID,
-		Type:      looprpc.LiquidityRuleType_THRESHOLD,
-	}
-	if ctx.IsSet("type") {
-		switch ctx.String("type") {
-		case "in":
-			newRule.SwapType = looprpc.SwapType_LOOP_IN
-
-		case "out":
-			newRule.SwapType = looprpc.SwapType_LOOP_OUT
-
-		default:
-			return errors.New("please set type to in or out")
-		}
+	newRule, err := newRuleTemplate(ctx)
+	if err != nil {
+		return err
 	}
+	newRule.ChannelId = chanID
 
 	if pubkeyRule {
 		newRule.Pubkey = pubkey[:]
 	}
 
-	if inboundSet {
-		newRule.IncomingThreshold = uint32(
-			ctx.Int("incoming_threshold"),
-		)
-	}
+	if groupName != "" {
+		if !ctx.IsSet("channels") {
+			return errors.New("please set the channels in the " +
+				"group")
+		}
 
-	if outboundSet {
-		newRule.OutgoingThreshold = uint32(
-			ctx.Int("outgoing_threshold"),
-		)
+		newRule.GroupName = groupName
+
+		channels := strings.Split(ctx.String("channels"), ",")
+		for _, channel := range channels {
+			id, err := strconv.ParseUint(
+				strings.TrimSpace(channel), 10, 64,
+			)
+			if err != nil {
+				return fmt.Errorf("invalid short channel ID: "+
+					"%v", channel)
+			}
+
+			newRule.GroupChannels = append(
+				newRule.GroupChannels, id,
+			)
+		}
 	}
 
 	// Just set the rules on our current set of parameters and leave the
@@ -222,6 +448,314 @@ func setRule(ctx *cli.Context) error {
 	return err
 }
 
+var applyRulesCommand = cli.Command{
+	Name:  "applyrules",
+	Usage: "set or remove the rules of many channels/peers at once",
+	Description: "Set the rule of a selection of channels or peers from " +
+		"a single rule template, or remove their rules with the " +
+		"--remove flag. The channels and peers that would gain, " +
+		"change or lose a rule are shown along with the swaps that " +
+		"would be suggested before and after the change, and the " +
+		"change is only applied once it is confirmed. The change is " +
+		"rejected if our rules or channels change before it is " +
+		"confirmed.",
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name: "channels",
+			Usage: "a comma separated list of the short channel " +
+				"IDs of the channels to select.",
+		},
+		cli.StringFlag{
+			Name: "peers",
+			Usage: "a comma separated list of the pubkeys of the " +
+				"peers to select.",
+		},
+		cli.BoolFlag{
+			Name:  "all_channels",
+			Usage: "select all of our open channels.",
+		},
+		cli.BoolFlag{
+			Name: "all_peers",
+			Usage: "select all of the peers that we have open " +
+				"channels with.",
+		},
+		cli.BoolFlag{
+			Name: "remove",
+			Usage: "remove the rules of the selected " +
+				"channels/peers.",
+		},
+		cli.BoolFlag{
+			Name:  "force, f",
+			Usage: "apply the change without confirmation.",
+		},
+	}, ruleTemplateFlags...),
+	Action: applyRules,
+}
+
+func applyRules(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return cli.ShowCommandHelp(ctx, "applyrules")
+	}
+
+	req := &looprpc.ApplyRulesRequest{
+		Remove:      ctx.Bool("remove"),
+		AllChannels: ctx.Bool("all_channels"),
+		AllPeers:    ctx.Bool("all_peers"),
+	}
+
+	if ctx.IsSet("channels") {
+		channels := strings.Split(ctx.String("channels"), ",")
+		for _, channel := range channels {
+			id, err := strconv.ParseUint(
+				strings.TrimSpace(channel), 10, 64,
+			)
+			if err != nil {
+				return fmt.Errorf("invalid short channel ID: "+
+					"%v", channel)
+			}
+
+			req.Channels = append(req.Channels, id)
+		}
+	}
+
+	if ctx.IsSet("peers") {
+		peers := strings.Split(ctx.String("peers"), ",")
+		for _, peer := range peers {
+			pubkey, err := route.NewVertexFromStr(
+				strings.TrimSpace(peer),
+			)
+			if err != nil {
+				return fmt.Errorf("invalid peer pubkey: %v",
+					peer)
+			}
+
+			req.Peers = append(req.Peers, pubkey[:])
+		}
+	}
+
+	inboundSet := ctx.IsSet("incoming_threshold") ||
+		ctx.IsSet("incoming_amount")
+	outboundSet := ctx.IsSet("outgoing_threshold") ||
+		ctx.IsSet("outgoing_amount")
+
+	if req.Remove {
+		for _, flag := range ruleTemplateFlags {
+			if ctx.IsSet(flag.GetName()) {
+				return errors.New("do not set rule flags " +
+					"with remove flag")
+			}
+		}
+	} else {
+		if !inboundSet && !outboundSet {
+			return errors.New("provide at least one threshold " +
+				"for the rule, or use the --remove flag to " +
+				"remove rules")
+		}
+
+		var err error
+		req.Rule, err = newRuleTemplate(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	// First preview the change so that it can be confirmed.
+	preview, err := client.ApplyRules(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(preview)
+
+	if len(preview.Changes) == 0 {
+		fmt.Println("No rules would change.")
+		return nil
+	}
+
+	if !ctx.Bool("force") {
+		fmt.Printf("APPLY CHANGE? (y/n): ")
+
+		var answer string
+		fmt.Scanln(&answer)
+		if answer != "y" {
+			return errors.New("change canceled")
+		}
+	}
+
+	// Apply the change, confirming the preview that we displayed.
+	req.PreviewId = preview.PreviewId
+	resp, err := client.ApplyRules(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// newRuleTemplate creates a threshold rule from our rule template flags,
+// without setting the channel, peer or group that it applies to.
+func newRuleTemplate(ctx *cli.Context) (*looprpc.LiquidityRule, error) {
+	rule := &looprpc.LiquidityRule{
+		Type: looprpc.LiquidityRuleType_THRESHOLD,
+	}
+	if ctx.IsSet("type") {
+		switch ctx.String("type") {
+		case "in":
+			rule.SwapType = looprpc.SwapType_LOOP_IN
+
+		case "out":
+			rule.SwapType = looprpc.SwapType_LOOP_OUT
+
+		default:
+			return nil, errors.New("please set type to in or out")
+		}
+	}
+
+	switch ctx.String("shrink_policy") {
+	case "skip":
+		rule.ShrinkPolicy = looprpc.ShrinkPolicy_SHRINK_POLICY_SKIP
+
+	case "resize":
+		rule.ShrinkPolicy = looprpc.ShrinkPolicy_SHRINK_POLICY_RESIZE
+
+	case "split":
+		rule.ShrinkPolicy = looprpc.ShrinkPolicy_SHRINK_POLICY_SPLIT
+
+	default:
+		return nil, errors.New("please set shrink policy to skip, " +
+			"resize or split")
+	}
+
+	rule.IncomingThreshold = uint32(ctx.Int("incoming_threshold"))
+	rule.OutgoingThreshold = uint32(ctx.Int("outgoing_threshold"))
+	rule.IncomingThresholdSat = ctx.Uint64("incoming_amount")
+	rule.OutgoingThresholdSat = ctx.Uint64("outgoing_amount")
+
+	rule.FeeBudgetSat = ctx.Uint64("fee_budget")
+
+	if ctx.IsSet("expires_in") {
+		expiresIn := ctx.Duration("expires_in")
+		if expiresIn <= 0 {
+			return nil, errors.New("rule expiry must be in the " +
+				"future")
+		}
+
+		rule.ExpirySec = uint64(time.Now().Add(expiresIn).Unix())
+	}
+
+	cooldown := ctx.Duration("cooldown")
+	if cooldown < 0 {
+		return nil, errors.New("rule cooldown must be >= 0")
+	}
+	rule.CooldownSec = uint64(cooldown.Seconds())
+
+	rule.MaxSwapAmountSat = ctx.Uint64("max_swap_amount")
+
+	revenueWindow := ctx.Duration("revenue_window")
+	if revenueWindow < 0 {
+		return nil, errors.New("rule revenue window must be >= 0")
+	}
+	rule.MinRevenuePercent = ctx.Uint64("min_revenue_percent")
+	rule.RevenueWindowSec = uint64(revenueWindow.Seconds())
+
+	rule.SweepAddress = ctx.String("sweep_address")
+	rule.SweepXpub = ctx.String("sweep_xpub")
+	rule.SweepAddressSignature = ctx.String("sweep_address_signature")
+
+	publicationDeadline := ctx.Duration("publication_deadline")
+	if publicationDeadline < 0 {
+		return nil, errors.New("rule publication deadline must be >= 0")
+	}
+	rule.PublicationDeadlineSec = uint64(publicationDeadline.Seconds())
+
+	var err error
+	rule.CustomRecords, err = parseCustomRecords(
+		ctx.StringSlice("custom_record"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setRuleFees(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// ruleFeeFlags is the set of setrule flags that set a fee limit for the rule.
+var ruleFeeFlags = []string{
+	"feepercent", "sweeplimit", "maxswapfee", "maxroutingfee",
+	"maxprepayfee", "maxprepay", "maxminer",
+}
+
+// ruleFeesSet returns true if any of the flags that set a rule's fee limit
+// are set.
+func ruleFeesSet(ctx *cli.Context) bool {
+	for _, flag := range ruleFeeFlags {
+		if ctx.IsSet(flag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setRuleFees sets the fee limit for a rule from our cli flags. The fee
+// percentage and fee categories are exclusive, which is checked by the
+// server.
+func setRuleFees(ctx *cli.Context, rule *looprpc.LiquidityRule) error {
+	var err error
+
+	if ctx.IsSet("feepercent") {
+		rule.FeePpm, err = ppmFromPercentage(ctx.Float64("feepercent"))
+		if err != nil {
+			return err
+		}
+	}
+
+	if ctx.IsSet("maxswapfee") {
+		rule.MaxSwapFeePpm, err = ppmFromPercentage(
+			ctx.Float64("maxswapfee"),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ctx.IsSet("maxroutingfee") {
+		rule.MaxRoutingFeePpm, err = ppmFromPercentage(
+			ctx.Float64("maxroutingfee"),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ctx.IsSet("maxprepayfee") {
+		rule.MaxPrepayRoutingFeePpm, err = ppmFromPercentage(
+			ctx.Float64("maxprepayfee"),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	rule.SweepFeeRateSatPerVbyte = uint64(ctx.Int("sweeplimit"))
+	rule.MaxPrepaySat = ctx.Uint64("maxprepay")
+	rule.MaxMinerFeeSat = ctx.Uint64("maxminer")
+
+	return nil
+}
+
 var setParamsCommand = cli.Command{
 	Name:        "setparams",
 	Usage:       "update the parameters set for the liquidity manager",
@@ -264,6 +798,20 @@ var setParamsCommand = cli.Command{
 			Usage: "the maximum miner fee in satoshis that swap " +
 				"suggestions should be limited to.",
 		},
+		cli.Float64Flag{
+			Name: "minerfeepercent",
+			Usage: "the maximum percentage of swap amount that " +
+				"the current on-chain fee estimate may " +
+				"consume, set with minermultiplier to limit " +
+				"on-chain fees relative to current fee " +
+				"estimates rather than to feepercent",
+		},
+		cli.Uint64Flag{
+			Name: "minermultiplier",
+			Usage: "the multiple of a swap's current on-chain " +
+				"fee estimate that may be paid if fees " +
+				"spike, set with minerfeepercent",
+		},
 		cli.IntFlag{
 			Name: "sweepconf",
 			Usage: "the number of blocks from htlc height that " +
@@ -277,6 +825,37 @@ var setParamsCommand = cli.Command{
 				"previously had a failed swap will be " +
 				"included in suggestions.",
 		},
+		cli.BoolFlag{
+			Name: "expbackoff",
+			Usage: "set to true to grow the failure backoff of " +
+				"a channel or peer with its number of " +
+				"consecutive failures, starting at " +
+				"failurebackoff",
+		},
+		cli.Float64Flag{
+			Name: "backoffmultiplier",
+			Usage: "the factor that the failure backoff grows " +
+				"by for each consecutive failure when " +
+				"expbackoff is set",
+		},
+		cli.DurationFlag{
+			Name: "backoffcap",
+			Usage: "the maximum failure backoff when " +
+				"expbackoff is set, before jitter is added",
+		},
+		cli.Uint64Flag{
+			Name: "backoffjitter",
+			Usage: "the largest percentage of the failure " +
+				"backoff, in [0, 100], that is added to it " +
+				"as jitter when expbackoff is set",
+		},
+		cli.DurationFlag{
+			Name: "successcooldown",
+			Usage: "the amount of time that should pass after a " +
+				"swap completes for a channel or peer " +
+				"before autoloop suggests another swap for " +
+				"it, set to 0 to disable the cooldown",
+		},
 		cli.BoolFlag{
 			Name: "autoloop",
 			Usage: "set to true to enable automated dispatch " +
@@ -295,6 +874,56 @@ var setParamsCommand = cli.Command{
 				"out budget, expressed as a unix timestamp " +
 				"in seconds",
 		},
+		cli.StringFlag{
+			Name: "budgetrefresh",
+			Usage: "the period after which the automated loop " +
+				"budget resets, either daily, weekly, " +
+				"monthly (30 days), a duration such as " +
+				"72h or none to disable budget refreshes",
+		},
+		cli.BoolFlag{
+			Name: "budgetrollover",
+			Usage: "set to true to carry unused budget over " +
+				"to the next budget period when the budget " +
+				"refreshes",
+		},
+		cli.Uint64Flag{
+			Name: "autobudgetout",
+			Usage: "the maximum amount of fees in satoshis that " +
+				"automatically dispatched loop out swaps " +
+				"may spend, in addition to autobudget, set " +
+				"to 0 to disable",
+		},
+		cli.Uint64Flag{
+			Name: "autobudgetin",
+			Usage: "the maximum amount of fees in satoshis that " +
+				"automatically dispatched loop in swaps may " +
+				"spend, in addition to autobudget, set to 0 " +
+				"to disable",
+		},
+		cli.Uint64Flag{
+			Name: "budgetppm",
+			Usage: "the autoloop fee budget expressed as parts " +
+				"per million of the amount swapped by " +
+				"automated swaps in the budget period, the " +
+				"larger of this budget and autobudget " +
+				"applies, set to 0 to disable",
+		},
+		cli.StringSliceFlag{
+			Name: "schedule",
+			Usage: "a window in which automated swaps may be " +
+				"dispatched, in the format " +
+				"[days@]HH:MM-HH:MM using local time, for " +
+				"example mon-fri@22:00-06:00, may be " +
+				"repeated to set multiple windows, set to " +
+				"none to allow dispatch at any time",
+		},
+		cli.Uint64Flag{
+			Name: "maxchainfee",
+			Usage: "the maximum on-chain fee rate in sat/vbyte " +
+				"at which automated swaps are dispatched, " +
+				"set to 0 to disable the ceiling",
+		},
 		cli.Uint64Flag{
 			Name: "autoinflight",
 			Usage: "the maximum number of automatically " +
@@ -302,20 +931,203 @@ var setParamsCommand = cli.Command{
 				"flight",
 		},
 		cli.Uint64Flag{
-			Name: "minamt",
-			Usage: "the minimum amount in satoshis that the " +
-				"autoloop client will dispatch per-swap",
+			Name: "autoinflightout",
+			Usage: "the maximum number of automatically " +
+				"dispatched loop out swaps that we allow " +
+				"to be in flight, set to 0 to only apply " +
+				"autoinflight",
 		},
 		cli.Uint64Flag{
-			Name: "maxamt",
-			Usage: "the maximum amount in satoshis that the " +
-				"autoloop client will dispatch per-swap",
+			Name: "autoinflightin",
+			Usage: "the maximum number of automatically " +
+				"dispatched loop in swaps that we allow to " +
+				"be in flight, set to 0 to only apply " +
+				"autoinflight",
+		},
+		cli.DurationFlag{
+			Name: "dispatchspacing",
+			Usage: "the amount of time that autoloop waits " +
+				"between dispatching the swaps that it " +
+				"decides on at the same time, set to 0 to " +
+				"dispatch them all at once",
+		},
+		cli.DurationFlag{
+			Name: "publicationdeadline",
+			Usage: "the amount of time that the server may " +
+				"wait before publishing the htlc of a loop " +
+				"out dispatched by autoloop, which lowers " +
+				"the swap fee, set to 0 to publish " +
+				"immediately",
+		},
+		cli.DurationFlag{
+			Name: "flowwindow",
+			Usage: "the period of forwarding history that " +
+				"autoloop uses to forecast channel flow, " +
+				"lowering swaps for channels that refill " +
+				"themselves, set to 0 to ignore forwarding " +
+				"flow",
+		},
+		cli.Uint64Flag{
+			Name: "minchanage",
+			Usage: "the number of blocks that must have been " +
+				"mined since a channel confirmed before " +
+				"autoloop suggests swaps for it, roughly " +
+				"144 per day, set to 0 to manage channels " +
+				"of any age",
+		},
+		cli.Uint64Flag{
+			Name: "minamt",
+			Usage: "the minimum amount in satoshis that the " +
+				"autoloop client will dispatch per-swap",
+		},
+		cli.Uint64Flag{
+			Name: "maxamt",
+			Usage: "the maximum amount in satoshis that the " +
+				"autoloop client will dispatch per-swap",
 		},
 		cli.IntFlag{
 			Name: "htlc_conf",
 			Usage: "the confirmation target for loop in on-chain " +
 				"htlcs",
 		},
+		cli.Uint64Flag{
+			Name: "vbytebudget",
+			Usage: "the maximum number of vbytes that loop " +
+				"transactions may occupy on chain per " +
+				"budget period, set to 0 to disable",
+		},
+		cli.Uint64Flag{
+			Name: "vbyteperiod",
+			Usage: "the period, in seconds, that the vbyte " +
+				"budget applies to",
+		},
+		cli.Uint64Flag{
+			Name: "rebalanceppm",
+			Usage: "the maximum routing fee for circular " +
+				"rebalances, expressed in parts per million " +
+				"of the rebalance amount, set to 0 to " +
+				"disable rebalances",
+		},
+		cli.Uint64Flag{
+			Name: "rebalancebudget",
+			Usage: "the maximum amount of routing fees in " +
+				"satoshis that may be spent on circular " +
+				"rebalances since the budget start date",
+		},
+		cli.BoolFlag{
+			Name: "approval_mode",
+			Usage: "set to true to queue the swaps that " +
+				"autoloop would dispatch for manual " +
+				"approval instead of dispatching them",
+		},
+		cli.Uint64Flag{
+			Name: "approval_ttl",
+			Usage: "the amount of time, in seconds, that a " +
+				"swap queued for approval may be approved " +
+				"for, set to 0 for approvals that do not " +
+				"expire",
+		},
+		cli.BoolFlag{
+			Name: "simulation_mode",
+			Usage: "set to true to record the swaps that " +
+				"autoloop would dispatch and their " +
+				"projected costs instead of dispatching " +
+				"them, whether or not autoloop is enabled",
+		},
+		cli.BoolFlag{
+			Name: "loopinprivate",
+			Usage: "set to true to allow autoloop to loop in to " +
+				"peers that the node only has private " +
+				"channels with, including route hints for " +
+				"their channels in the swap invoice",
+		},
+		cli.BoolFlag{
+			Name: "batchloopouts",
+			Usage: "set to true to combine the loop outs that " +
+				"autoloop suggests for different channels " +
+				"and peers into as few swaps as possible",
+		},
+		cli.StringFlag{
+			Name: "ruleconflict",
+			Usage: "the way that autoloop resolves the rules of " +
+				"a channel that has a rule of its own and " +
+				"whose peer also has a rule, set to 'reject' " +
+				"to not allow both rules, 'channel' to apply " +
+				"the channel's rule to the channel or " +
+				"'strictest' to apply the stricter rule",
+		},
+		cli.StringFlag{
+			Name: "loopinspread",
+			Usage: "the way that autoloop spreads loop ins " +
+				"across last hops, set to 'none' to swap " +
+				"the peers that need the most inbound " +
+				"liquidity first, 'roundrobin' to cycle " +
+				"through peers or 'capacity' to spread " +
+				"loop ins in proportion to peer capacity",
+		},
+		cli.BoolFlag{
+			Name: "easyautoloop",
+			Usage: "set to true to manage the node's liquidity " +
+				"with a single outbound target instead of " +
+				"channel, peer or group rules",
+		},
+		cli.Uint64Flag{
+			Name: "easytarget",
+			Usage: "the percentage of the node's total channel " +
+				"capacity that easy autoloop aims to hold " +
+				"as local balance",
+		},
+		cli.Uint64Flag{
+			Name: "amountbucket",
+			Usage: "the multiple, in satoshis, that suggested " +
+				"swap amounts are rounded down to so that " +
+				"swaps do not reveal exact channel balances, " +
+				"set to 0 to disable rounding",
+		},
+		cli.Uint64Flag{
+			Name: "amountjitter",
+			Usage: "the largest percentage of a suggested swap " +
+				"amount, in [0, 50], that is randomly " +
+				"removed from it before it is rounded, set " +
+				"to 0 to disable randomization",
+		},
+		cli.BoolFlag{
+			Name: "adaptiveinflight",
+			Usage: "set to true to scale the number of " +
+				"autoloop swaps that may be in flight with " +
+				"the number of channels that autoloop " +
+				"manages, up to autoinflight",
+		},
+		cli.Uint64Flag{
+			Name: "channelsperinflight",
+			Usage: "the number of managed channels that one " +
+				"in flight swap is allowed for when " +
+				"adaptiveinflight is set",
+		},
+		cli.BoolFlag{
+			Name: "retryfailures",
+			Usage: "set to true to dispatch the next-best swap " +
+				"in the same tick when a swap that autoloop " +
+				"dispatches fails at initiation",
+		},
+		cli.Uint64Flag{
+			Name: "maxswaps",
+			Usage: "the maximum number of autoloop swaps that " +
+				"may be initiated within the swap period, " +
+				"set to 0 to not limit the number of swaps",
+		},
+		cli.Uint64Flag{
+			Name: "maxchannelswaps",
+			Usage: "the maximum number of autoloop swaps that " +
+				"may be initiated for a single channel or " +
+				"peer within the swap period, set to 0 to " +
+				"not limit the number of swaps",
+		},
+		cli.DurationFlag{
+			Name: "swapperiod",
+			Usage: "the rolling window that the number of " +
+				"autoloop swaps is limited in",
+		},
 	},
 	Action: setParams,
 }
@@ -337,7 +1149,7 @@ func setParams(ctx *cli.Context) error {
 		return err
 	}
 
-	var flagSet, categoriesSet, feePercentSet bool
+	var flagSet, categoriesSet, feePercentSet, dynamicSet bool
 
 	// Update our existing parameters with the values provided by cli flags.
 	// Our fee categories and fee percentage are exclusive, so track which
@@ -406,6 +1218,23 @@ func setParams(ctx *cli.Context) error {
 		categoriesSet = true
 	}
 
+	if ctx.IsSet("minerfeepercent") {
+		feeRate := ctx.Float64("minerfeepercent")
+		params.MinerFeePpm, err = ppmFromPercentage(feeRate)
+		if err != nil {
+			return err
+		}
+
+		flagSet = true
+		dynamicSet = true
+	}
+
+	if ctx.IsSet("minermultiplier") {
+		params.MinerFeeMultiplier = ctx.Uint64("minermultiplier")
+		flagSet = true
+		dynamicSet = true
+	}
+
 	if ctx.IsSet("sweepconf") {
 		params.SweepConfTarget = int32(ctx.Int("sweepconf"))
 		flagSet = true
@@ -416,6 +1245,37 @@ func setParams(ctx *cli.Context) error {
 		flagSet = true
 	}
 
+	if ctx.IsSet("expbackoff") {
+		params.ExponentialBackoff = ctx.Bool("expbackoff")
+		flagSet = true
+	}
+
+	if ctx.IsSet("backoffmultiplier") {
+		params.BackoffMultiplier = ctx.Float64("backoffmultiplier")
+		flagSet = true
+	}
+
+	if ctx.IsSet("backoffcap") {
+		params.BackoffCapSec = uint64(
+			ctx.Duration("backoffcap").Seconds(),
+		)
+		flagSet = true
+	}
+
+	if ctx.IsSet("backoffjitter") {
+		params.BackoffJitterPercent = uint32(
+			ctx.Uint64("backoffjitter"),
+		)
+		flagSet = true
+	}
+
+	if ctx.IsSet("successcooldown") {
+		params.SuccessCooldownSec = uint64(
+			ctx.Duration("successcooldown").Seconds(),
+		)
+		flagSet = true
+	}
+
 	if ctx.IsSet("autoloop") {
 		params.Autoloop = ctx.Bool("autoloop")
 		flagSet = true
@@ -431,11 +1291,93 @@ func setParams(ctx *cli.Context) error {
 		flagSet = true
 	}
 
+	if ctx.IsSet("budgetrefresh") {
+		period, err := parseBudgetRefresh(ctx.String("budgetrefresh"))
+		if err != nil {
+			return err
+		}
+
+		params.AutoloopBudgetRefreshPeriodSec = uint64(period.Seconds())
+		flagSet = true
+	}
+
+	if ctx.IsSet("budgetrollover") {
+		params.AutoloopBudgetRollover = ctx.Bool("budgetrollover")
+		flagSet = true
+	}
+
+	if ctx.IsSet("autobudgetout") {
+		params.AutoloopBudgetOutSat = ctx.Uint64("autobudgetout")
+		flagSet = true
+	}
+
+	if ctx.IsSet("autobudgetin") {
+		params.AutoloopBudgetInSat = ctx.Uint64("autobudgetin")
+		flagSet = true
+	}
+
+	if ctx.IsSet("budgetppm") {
+		params.AutoloopBudgetPpm = ctx.Uint64("budgetppm")
+		flagSet = true
+	}
+
+	if ctx.IsSet("schedule") {
+		params.AutoloopSchedule, err = parseSchedule(
+			ctx.StringSlice("schedule"),
+		)
+		if err != nil {
+			return err
+		}
+
+		flagSet = true
+	}
+
+	if ctx.IsSet("maxchainfee") {
+		params.ChainFeeCeilingSatPerVbyte = ctx.Uint64("maxchainfee")
+		flagSet = true
+	}
+
 	if ctx.IsSet("autoinflight") {
 		params.AutoMaxInFlight = ctx.Uint64("autoinflight")
 		flagSet = true
 	}
 
+	if ctx.IsSet("autoinflightout") {
+		params.AutoMaxInFlightOut = ctx.Uint64("autoinflightout")
+		flagSet = true
+	}
+
+	if ctx.IsSet("autoinflightin") {
+		params.AutoMaxInFlightIn = ctx.Uint64("autoinflightin")
+		flagSet = true
+	}
+
+	if ctx.IsSet("dispatchspacing") {
+		params.DispatchSpacingSec = uint64(
+			ctx.Duration("dispatchspacing").Seconds(),
+		)
+		flagSet = true
+	}
+
+	if ctx.IsSet("publicationdeadline") {
+		params.PublicationDeadlineSec = uint64(
+			ctx.Duration("publicationdeadline").Seconds(),
+		)
+		flagSet = true
+	}
+
+	if ctx.IsSet("flowwindow") {
+		params.FlowWindowSec = uint64(
+			ctx.Duration("flowwindow").Seconds(),
+		)
+		flagSet = true
+	}
+
+	if ctx.IsSet("minchanage") {
+		params.MinChannelAgeBlocks = uint32(ctx.Uint64("minchanage"))
+		flagSet = true
+	}
+
 	if ctx.IsSet("minamt") {
 		params.MinSwapAmount = ctx.Uint64("minamt")
 		flagSet = true
@@ -451,6 +1393,149 @@ func setParams(ctx *cli.Context) error {
 		flagSet = true
 	}
 
+	if ctx.IsSet("vbytebudget") {
+		params.VbyteBudget = ctx.Uint64("vbytebudget")
+		flagSet = true
+	}
+
+	if ctx.IsSet("vbyteperiod") {
+		params.VbyteBudgetPeriodSec = ctx.Uint64("vbyteperiod")
+		flagSet = true
+	}
+
+	if ctx.IsSet("rebalanceppm") {
+		params.RebalanceFeePpm = ctx.Uint64("rebalanceppm")
+		flagSet = true
+	}
+
+	if ctx.IsSet("rebalancebudget") {
+		params.RebalanceBudgetSat = ctx.Uint64("rebalancebudget")
+		flagSet = true
+	}
+
+	if ctx.IsSet("approval_mode") {
+		params.ApprovalMode = ctx.Bool("approval_mode")
+		flagSet = true
+	}
+
+	if ctx.IsSet("approval_ttl") {
+		params.ApprovalTtlSec = ctx.Uint64("approval_ttl")
+		flagSet = true
+	}
+
+	if ctx.IsSet("simulation_mode") {
+		params.SimulationMode = ctx.Bool("simulation_mode")
+		flagSet = true
+	}
+
+	if ctx.IsSet("loopinprivate") {
+		params.LoopInPrivate = ctx.Bool("loopinprivate")
+		flagSet = true
+	}
+
+	if ctx.IsSet("batchloopouts") {
+		params.BatchLoopOuts = ctx.Bool("batchloopouts")
+		flagSet = true
+	}
+
+	if ctx.IsSet("ruleconflict") {
+		switch ctx.String("ruleconflict") {
+		case "reject":
+			params.RuleConflict =
+				looprpc.RuleConflict_RULE_CONFLICT_REJECT
+
+		case "channel":
+			params.RuleConflict =
+				looprpc.RuleConflict_RULE_CONFLICT_CHANNEL
+
+		case "strictest":
+			params.RuleConflict =
+				looprpc.RuleConflict_RULE_CONFLICT_STRICTEST
+
+		default:
+			return errors.New("please set rule conflict to " +
+				"reject, channel or strictest")
+		}
+		flagSet = true
+	}
+
+	if ctx.IsSet("loopinspread") {
+		switch ctx.String("loopinspread") {
+		case "none":
+			params.LoopInSpread =
+				looprpc.LoopInSpread_LOOP_IN_SPREAD_NONE
+
+		case "roundrobin":
+			params.LoopInSpread =
+				looprpc.LoopInSpread_LOOP_IN_SPREAD_ROUND_ROBIN
+
+		case "capacity":
+			params.LoopInSpread =
+				looprpc.LoopInSpread_LOOP_IN_SPREAD_CAPACITY
+
+		default:
+			return errors.New("please set loop in spread to " +
+				"none, roundrobin or capacity")
+		}
+		flagSet = true
+	}
+
+	if ctx.IsSet("easyautoloop") {
+		params.EasyAutoloop = ctx.Bool("easyautoloop")
+		flagSet = true
+	}
+
+	if ctx.IsSet("easytarget") {
+		params.EasyOutboundTargetPercent = ctx.Uint64("easytarget")
+		flagSet = true
+	}
+
+	if ctx.IsSet("amountbucket") {
+		params.AmountBucketSat = ctx.Uint64("amountbucket")
+		flagSet = true
+	}
+
+	if ctx.IsSet("amountjitter") {
+		params.AmountJitterPercent = ctx.Uint64("amountjitter")
+		flagSet = true
+	}
+
+	if ctx.IsSet("adaptiveinflight") {
+		params.AdaptiveInFlight = ctx.Bool("adaptiveinflight")
+		flagSet = true
+	}
+
+	if ctx.IsSet("channelsperinflight") {
+		params.ChannelsPerInFlight = uint32(
+			ctx.Uint64("channelsperinflight"),
+		)
+		flagSet = true
+	}
+
+	if ctx.IsSet("retryfailures") {
+		params.RetryDispatchFailures = ctx.Bool("retryfailures")
+		flagSet = true
+	}
+
+	if ctx.IsSet("maxswaps") {
+		params.MaxSwapsPerPeriod = uint32(ctx.Uint64("maxswaps"))
+		flagSet = true
+	}
+
+	if ctx.IsSet("maxchannelswaps") {
+		params.MaxChannelSwapsPerPeriod = uint32(
+			ctx.Uint64("maxchannelswaps"),
+		)
+		flagSet = true
+	}
+
+	if ctx.IsSet("swapperiod") {
+		params.SwapRatePeriodSec = uint64(
+			ctx.Duration("swapperiod").Seconds(),
+		)
+		flagSet = true
+	}
+
 	if !flagSet {
 		return fmt.Errorf("at least one flag required to set params")
 	}
@@ -462,9 +1547,14 @@ func setParams(ctx *cli.Context) error {
 		return fmt.Errorf("feepercent cannot be set with specific " +
 			"fee category flags")
 
+	case dynamicSet && categoriesSet:
+		return fmt.Errorf("minerfeepercent and minermultiplier " +
+			"cannot be set with specific fee category flags")
+
 	// If we are updating to fee percentage, we unset all other fee related
-	// params so that users do not need to manually unset them.
-	case feePercentSet:
+	// params so that users do not need to manually unset them. Our miner
+	// fee limits are only kept if they are set in the same update.
+	case feePercentSet || dynamicSet:
 		params.SweepFeeRateSatPerVbyte = 0
 		params.MaxMinerFeeSat = 0
 		params.MaxPrepayRoutingFeePpm = 0
@@ -472,20 +1562,37 @@ func setParams(ctx *cli.Context) error {
 		params.MaxRoutingFeePpm = 0
 		params.MaxSwapFeePpm = 0
 
+		if !dynamicSet {
+			params.MinerFeePpm = 0
+			params.MinerFeeMultiplier = 0
+		}
+
 	// If we are setting any of our fee categories, unset fee percentage
 	// so that it does not need to be manually updated.
 	case categoriesSet:
 		params.FeePpm = 0
+		params.MinerFeePpm = 0
+		params.MinerFeeMultiplier = 0
 
 	}
 	// Update our parameters to our mutated values.
-	_, err = client.SetLiquidityParams(
+	resp, err := client.SetLiquidityParams(
 		context.Background(), &looprpc.SetLiquidityParamsRequest{
 			Parameters: params,
 		},
 	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	// Let the user know if part of their update was delayed.
+	if resp.PendingIncrease != nil {
+		fmt.Printf("Budget or fee limit increase delayed until %v, "+
+			"cancel it with `loop cancelincrease`\n",
+			time.Unix(resp.PendingIncrease.EffectiveTime, 0))
+	}
+
+	return nil
 }
 
 // ppmFromPercentage converts a percentage, expressed as a float, to parts
@@ -498,12 +1605,86 @@ func ppmFromPercentage(percentage float64) (uint64, error) {
 	return uint64(percentage / 100 * liquidity.FeeBase), nil
 }
 
+// parseBudgetRefresh parses a budget refresh period, which is either one of
+// our named periods or a duration.
+func parseBudgetRefresh(period string) (time.Duration, error) {
+	switch period {
+	case "none":
+		return 0, nil
+
+	case "daily":
+		return time.Hour * 24, nil
+
+	case "weekly":
+		return time.Hour * 24 * 7, nil
+
+	case "monthly":
+		return time.Hour * 24 * 30, nil
+	}
+
+	duration, err := time.ParseDuration(period)
+	if err != nil {
+		return 0, fmt.Errorf("budget refresh must be none, daily, "+
+			"weekly, monthly or a duration: %v", err)
+	}
+
+	if duration < 0 {
+		return 0, errors.New("budget refresh must be >= 0")
+	}
+
+	return duration, nil
+}
+
+// parseSchedule parses a set of autoloop schedule windows. A single window of
+// none clears our schedule.
+func parseSchedule(windows []string) ([]*looprpc.AutoloopWindow, error) {
+	if len(windows) == 1 && windows[0] == "none" {
+		return nil, nil
+	}
+
+	schedule := make([]*looprpc.AutoloopWindow, len(windows))
+	for i, window := range windows {
+		parsed, err := liquidity.ParseScheduleWindow(window)
+		if err != nil {
+			return nil, fmt.Errorf("schedule window %v: %v", window,
+				err)
+		}
+
+		rpcWindow := &looprpc.AutoloopWindow{
+			StartMinute: uint32(parsed.Start / time.Minute),
+			EndMinute:   uint32(parsed.End / time.Minute),
+		}
+
+		for _, day := range parsed.Days {
+			rpcWindow.Days = append(rpcWindow.Days, uint32(day))
+		}
+
+		schedule[i] = rpcWindow
+	}
+
+	return schedule, nil
+}
+
 var suggestSwapCommand = cli.Command{
 	Name:  "suggestswaps",
 	Usage: "show a list of suggested swaps",
 	Description: "Displays a list of suggested swaps that aim to obtain " +
 		"the liquidity balance as specified by the rules set in " +
 		"the liquidity manager.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "subscribe",
+			Usage: "keep displaying suggestions each time the " +
+				"inputs to them change materially.",
+		},
+		cli.Uint64Flag{
+			Name: "balance_delta",
+			Usage: "the amount in satoshis that a channel's " +
+				"balance must shift by for new suggestions " +
+				"to be displayed, used with --subscribe. If " +
+				"not set, a default of 10000 is used.",
+		},
+	},
 	Action: suggestSwap,
 }
 
@@ -514,6 +1695,10 @@ func suggestSwap(ctx *cli.Context) error {
 	}
 	defer cleanup()
 
+	if ctx.Bool("subscribe") {
+		return subscribeSuggestions(ctx, client)
+	}
+
 	resp, err := client.SuggestSwaps(
 		context.Background(), &looprpc.SuggestSwapsRequest{},
 	)
@@ -536,3 +1721,816 @@ func suggestSwap(ctx *cli.Context) error {
 	return errors.New("no rules set for autolooper, please set rules " +
 		"using the setrule command")
 }
+
+// subscribeSuggestions displays each set of suggestions that loopd sends until
+// the stream is closed.
+func subscribeSuggestions(ctx *cli.Context,
+	client looprpc.SwapClientClient) error {
+
+	stream, err := client.SubscribeSuggestions(
+		context.Background(), &looprpc.SubscribeSuggestionsRequest{
+			BalanceDeltaSat: ctx.Uint64("balance_delta"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("recv: %v", err)
+		}
+
+		printRespJSON(resp)
+	}
+}
+
+var previewFeesCommand = cli.Command{
+	Name:      "previewfees",
+	Usage:     "show the fees autoloop would set for a loop out swap",
+	ArgsUsage: "amt",
+	Description: "Gets a loop out quote for the amount provided and " +
+		"displays the breakdown of fees that the liquidity " +
+		"manager's current fee limits would set for the swap.",
+	Action: previewFees,
+}
+
+func previewFees(ctx *cli.Context) error {
+	// Show command help if the incorrect number arguments was provided.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "previewfees")
+	}
+
+	amt, err := parseAmt(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.PreviewFees(
+		context.Background(), &looprpc.PreviewFeesRequest{
+			Amt: uint64(amt),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var compareRebalanceCommand = cli.Command{
+	Name:      "comparerebalance",
+	Usage:     "compare the cost of a circular rebalance to a loop out",
+	ArgsUsage: "amt",
+	Description: "Estimates the cost of shifting local balance out of " +
+		"the outgoing channel with an off-chain circular rebalance " +
+		"into the incoming channel, and the cost of doing so with a " +
+		"loop out swap, and displays which of the two is cheaper.",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "outgoing_chan",
+			Usage: "the short channel ID of the channel that has " +
+				"excess local balance.",
+		},
+		cli.Uint64Flag{
+			Name: "incoming_chan",
+			Usage: "the short channel ID of the channel that a " +
+				"circular rebalance should shift local " +
+				"balance into.",
+		},
+	},
+	Action: compareRebalance,
+}
+
+func compareRebalance(ctx *cli.Context) error {
+	// Show command help if the incorrect number arguments was provided.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "comparerebalance")
+	}
+
+	if !ctx.IsSet("outgoing_chan") || !ctx.IsSet("incoming_chan") {
+		return errors.New("outgoing_chan and incoming_chan must be set")
+	}
+
+	amt, err := parseAmt(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.CompareRebalance(
+		context.Background(), &looprpc.CompareRebalanceRequest{
+			Amt:            uint64(amt),
+			OutgoingChanId: ctx.Uint64("outgoing_chan"),
+			IncomingChanId: ctx.Uint64("incoming_chan"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var closeAdviceCommand = cli.Command{
+	Name:      "closeadvice",
+	Usage:     "check whether a channel can be safely closed",
+	ArgsUsage: "shortchanid",
+	Description: "Displays the swaps in flight and the swaps suggested " +
+		"by the liquidity manager that involve a channel, along with " +
+		"the fees already committed to the swaps in flight, and " +
+		"recommends whether to wait for them to complete before " +
+		"closing the channel.",
+	Action: closeAdvice,
+}
+
+func closeAdvice(ctx *cli.Context) error {
+	// Show command help if the incorrect number arguments was provided.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "closeadvice")
+	}
+
+	chanID, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("please provide a valid short channel ID: "+
+			"%v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.CloseAdvice(
+		context.Background(), &looprpc.CloseAdviceRequest{
+			ChannelId: chanID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var excludeChannelCommand = cli.Command{
+	Name:      "excludechannel",
+	Usage:     "exclude a channel from the liquidity manager",
+	ArgsUsage: "shortchanid",
+	Description: "Marks a channel as managed externally, for example by " +
+		"another rebalancing tool, so that the liquidity manager " +
+		"never suggests swaps or rebalances that use it, even if " +
+		"its peer has a rule set.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "clear",
+			Usage: "remove the channel from the set of excluded " +
+				"channels.",
+		},
+	},
+	Action: excludeChannel,
+}
+
+func excludeChannel(ctx *cli.Context) error {
+	// Show command help if the incorrect number arguments was provided.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "excludechannel")
+	}
+
+	chanID, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("please provide a valid short channel ID: "+
+			"%v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	// We need to set the full set of current parameters every time we
+	// call SetParameters. To allow users to set only individual fields on
+	// the cli, we lookup our current params, then update the set of
+	// excluded channels.
+	params, err := client.GetLiquidityParams(
+		context.Background(), &looprpc.GetLiquidityParamsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	var (
+		excluded bool
+		others   []uint64
+	)
+	for _, channel := range params.ExcludedChannels {
+		if channel == chanID {
+			excluded = true
+			continue
+		}
+
+		others = append(others, channel)
+	}
+
+	switch {
+	case ctx.Bool("clear") && !excluded:
+		return fmt.Errorf("channel: %v is not excluded", chanID)
+
+	case ctx.Bool("clear"):
+		params.ExcludedChannels = others
+
+	case excluded:
+		return fmt.Errorf("channel: %v is already excluded", chanID)
+
+	default:
+		params.ExcludedChannels = append(others, chanID)
+	}
+
+	_, err = client.SetLiquidityParams(
+		context.Background(),
+		&looprpc.SetLiquidityParamsRequest{
+			Parameters: params,
+		},
+	)
+
+	return err
+}
+
+var autoloopStatsCommand = cli.Command{
+	Name:  "autoloopstats",
+	Usage: "show metrics for recent autoloop ticks",
+	Description: "Displays the duration of recent autoloop ticks, along " +
+		"with the number of channels they evaluated, quotes they " +
+		"fetched and swaps they dispatched.",
+	Flags: []cli.Flag{
+		cli.UintFlag{
+			Name: "max_ticks",
+			Usage: "the maximum number of ticks to display, " +
+				"starting with the most recent, zero displays " +
+				"all stored ticks.",
+			Value: 20,
+		},
+	},
+	Action: autoloopStats,
+}
+
+func autoloopStats(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetAutoloopStats(
+		context.Background(), &looprpc.AutoloopStatsRequest{
+			MaxTicks: uint32(ctx.Uint("max_ticks")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var suggestionHistoryCommand = cli.Command{
+	Name:  "suggestionhistory",
+	Usage: "show the swaps suggested by recent autoloop ticks",
+	Description: "Displays the swaps that recent autoloop ticks " +
+		"suggested, whether they were dispatched, and the reasons " +
+		"that swaps were not suggested for the rest of the " +
+		"liquidity rules.",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start",
+			Usage: "the unix timestamp from which to display " +
+				"suggestions, inclusive.",
+		},
+		cli.Int64Flag{
+			Name: "end",
+			Usage: "the unix timestamp until which to display " +
+				"suggestions, exclusive.",
+		},
+		cli.UintFlag{
+			Name: "max_rounds",
+			Usage: "the maximum number of rounds to display, " +
+				"starting with the most recent, zero displays " +
+				"all rounds in the time range.",
+			Value: 20,
+		},
+	},
+	Action: suggestionHistory,
+}
+
+func suggestionHistory(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetSuggestionHistory(
+		context.Background(), &looprpc.SuggestionHistoryRequest{
+			StartTime: ctx.Int64("start"),
+			EndTime:   ctx.Int64("end"),
+			MaxRounds: uint32(ctx.Uint("max_rounds")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var simulationCommand = cli.Command{
+	Name:  "simulation",
+	Usage: "show the swaps recorded in simulation mode",
+	Description: "Displays the swaps that autoloop recorded instead of " +
+		"dispatching while it was in simulation mode, along with " +
+		"their projected costs and totals for each swap type.",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "start",
+			Usage: "the unix timestamp from which to display " +
+				"simulated swaps, inclusive.",
+		},
+		cli.Int64Flag{
+			Name: "end",
+			Usage: "the unix timestamp until which to display " +
+				"simulated swaps, exclusive.",
+		},
+		cli.BoolFlag{
+			Name: "clear",
+			Usage: "remove all simulated swaps to start a new " +
+				"simulation.",
+		},
+	},
+	Action: simulation,
+}
+
+func simulation(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if ctx.Bool("clear") {
+		_, err := client.ClearSimulatedSwaps(
+			context.Background(),
+			&looprpc.ClearSimulatedSwapsRequest{},
+		)
+
+		return err
+	}
+
+	resp, err := client.GetSimulatedSwaps(
+		context.Background(), &looprpc.SimulatedSwapsRequest{
+			StartTime: ctx.Int64("start"),
+			EndTime:   ctx.Int64("end"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var calendarCommand = cli.Command{
+	Name:  "calendar",
+	Usage: "show the actions that autoloop plans to take",
+	Description: "Displays the actions that autoloop plans to take over " +
+		"the coming days, including swaps that were deferred by the " +
+		"autoloop schedule or chain fee ceiling, the expiry of " +
+		"swaps waiting for approval and temporary rules, and the " +
+		"next budget refresh.",
+	Flags: []cli.Flag{
+		cli.DurationFlag{
+			Name: "horizon",
+			Usage: "the period from now that the calendar " +
+				"covers, for example 72h, at most 744h.",
+			Value: liquidity.DefaultCalendarHorizon,
+		},
+		cli.BoolFlag{
+			Name: "ical",
+			Usage: "print the calendar in iCalendar format, so " +
+				"that it can be imported into a calendar " +
+				"application.",
+		},
+	},
+	Action: calendar,
+}
+
+func calendar(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	horizon := ctx.Duration("horizon")
+	if horizon <= 0 {
+		return errors.New("calendar horizon must be positive")
+	}
+
+	resp, err := client.GetAutoloopCalendar(
+		context.Background(), &looprpc.AutoloopCalendarRequest{
+			HorizonSec: uint64(horizon.Seconds()),
+			Ical:       ctx.Bool("ical"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if ctx.Bool("ical") {
+		fmt.Print(resp.Ical)
+		return nil
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var listApprovalsCommand = cli.Command{
+	Name:  "listapprovals",
+	Usage: "show the swaps that are waiting for approval",
+	Description: "Displays the swaps that autoloop has queued for " +
+		"approval while it is in approval mode. Swaps can be " +
+		"dispatched with approveswap or dropped with rejectswap.",
+	Action: listApprovals,
+}
+
+func listApprovals(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListApprovals(
+		context.Background(), &looprpc.ListApprovalsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var approveSwapCommand = cli.Command{
+	Name:      "approveswap",
+	Usage:     "dispatch a swap that is waiting for approval",
+	ArgsUsage: "id",
+	Description: "Dispatches a swap that autoloop has queued for " +
+		"approval, using the id displayed by listapprovals.",
+	Action: approveSwap,
+}
+
+func approveSwap(ctx *cli.Context) error {
+	// Show command help if the incorrect number arguments was provided.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "approveswap")
+	}
+
+	id, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("please provide a valid approval id: %v",
+			err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ApproveSwap(
+		context.Background(), &looprpc.ApproveSwapRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var rejectSwapCommand = cli.Command{
+	Name:      "rejectswap",
+	Usage:     "drop a swap that is waiting for approval",
+	ArgsUsage: "id",
+	Description: "Removes a swap that autoloop has queued for approval " +
+		"without dispatching it, using the id displayed by " +
+		"listapprovals. Autoloop does not queue another swap for " +
+		"the same channels or peer until the failure backoff " +
+		"period has passed.",
+	Action: rejectSwap,
+}
+
+func rejectSwap(ctx *cli.Context) error {
+	// Show command help if the incorrect number arguments was provided.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "rejectswap")
+	}
+
+	id, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("please provide a valid approval id: %v",
+			err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	_, err = client.RejectSwap(
+		context.Background(), &looprpc.RejectSwapRequest{
+			Id: id,
+		},
+	)
+
+	return err
+}
+
+var profileCommand = cli.Command{
+	Name:  "profile",
+	Usage: "manage saved liquidity parameter profiles",
+	Description: "Saves liquidity parameters as named profiles, and " +
+		"schedules automatic switches between them.",
+	Subcommands: []cli.Command{
+		saveProfileCommand, removeProfileCommand, listProfilesCommand,
+		profileScheduleCommand,
+	},
+}
+
+var saveProfileCommand = cli.Command{
+	Name:      "save",
+	Usage:     "save the current liquidity parameters as a profile",
+	ArgsUsage: "name",
+	Description: "Saves the current liquidity parameters as a profile " +
+		"with the name provided, replacing any profile that is " +
+		"already saved with that name.",
+	Action: saveProfile,
+}
+
+func saveProfile(ctx *cli.Context) error {
+	// Show command help if the incorrect number arguments was provided.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "save")
+	}
+
+	return updateProfile(ctx, ctx.Args().First(), false)
+}
+
+var removeProfileCommand = cli.Command{
+	Name:      "remove",
+	Usage:     "remove a saved profile",
+	ArgsUsage: "name",
+	Description: "Removes a saved profile. Profiles that are used by " +
+		"the profile schedule cannot be removed.",
+	Action: removeProfile,
+}
+
+func removeProfile(ctx *cli.Context) error {
+	// Show command help if the incorrect number arguments was provided.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "remove")
+	}
+
+	return updateProfile(ctx, ctx.Args().First(), true)
+}
+
+// updateProfile saves or removes the profile with the name provided.
+func updateProfile(ctx *cli.Context, name string, remove bool) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	_, err = client.SaveProfile(
+		context.Background(), &looprpc.SaveProfileRequest{
+			Name:   name,
+			Remove: remove,
+		},
+	)
+
+	return err
+}
+
+var listProfilesCommand = cli.Command{
+	Name:   "list",
+	Usage:  "show saved profiles and the profile schedule",
+	Action: listProfiles,
+}
+
+func listProfiles(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListProfiles(
+		context.Background(), &looprpc.ListProfilesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var profileScheduleCommand = cli.Command{
+	Name:  "schedule",
+	Usage: "set the schedule that profiles are switched on",
+	Description: "Replaces the profile schedule. Each switch activates " +
+		"a saved profile when its window starts, and is checked " +
+		"on every autoloop tick. If windows overlap, the switch " +
+		"that is listed first takes precedence. Outside of all " +
+		"windows, the current parameters are kept.",
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: "switch",
+			Usage: "a profile switch in the format " +
+				"name=[days@]HH:MM-HH:MM using local time, " +
+				"for example aggressive=mon-fri@00:00-23:59, " +
+				"may be repeated to set multiple switches, " +
+				"set to none to clear the schedule",
+		},
+	},
+	Action: setProfileSchedule,
+}
+
+func setProfileSchedule(ctx *cli.Context) error {
+	if !ctx.IsSet("switch") {
+		return cli.ShowCommandHelp(ctx, "schedule")
+	}
+
+	switches := ctx.StringSlice("switch")
+
+	var schedule []*looprpc.ProfileSwitch
+	if len(switches) != 1 || switches[0] != "none" {
+		for _, profileSwitch := range switches {
+			parts := strings.SplitN(profileSwitch, "=", 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("profile switch %v: expected "+
+					"name=window", profileSwitch)
+			}
+
+			window, err := parseSchedule(parts[1:])
+			if err != nil {
+				return err
+			}
+
+			if len(window) == 0 {
+				return fmt.Errorf("profile switch %v: window "+
+					"required", profileSwitch)
+			}
+
+			schedule = append(schedule, &looprpc.ProfileSwitch{
+				Profile: parts[0],
+				Window:  window[0],
+			})
+		}
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	_, err = client.SetProfileSchedule(
+		context.Background(), &looprpc.SetProfileScheduleRequest{
+			Schedule: schedule,
+		},
+	)
+
+	return err
+}
+
+var paramsHistoryCommand = cli.Command{
+	Name:  "paramshistory",
+	Usage: "show the history of changes to the liquidity parameters",
+	Description: "Displays recent changes to the liquidity parameters, " +
+		"including whether they were made over rpc or by a " +
+		"scheduled profile switch, and the profiles that were " +
+		"active before and after each change.",
+	Action: paramsHistory,
+}
+
+func paramsHistory(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetParamsHistory(
+		context.Background(), &looprpc.GetParamsHistoryRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var pendingIncreaseCommand = cli.Command{
+	Name:  "pendingincrease",
+	Usage: "show the delayed increase to the autoloop budget or fee limit",
+	Description: "Displays the increase to the autoloop fee budget or " +
+		"fee limit that is waiting for the budget increase delay " +
+		"configured in loopd to pass, and the parameters that will " +
+		"be in effect once it is applied.",
+	Action: pendingIncrease,
+}
+
+func pendingIncrease(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetPendingIncrease(
+		context.Background(), &looprpc.GetPendingIncreaseRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var cancelIncreaseCommand = cli.Command{
+	Name:  "cancelincrease",
+	Usage: "cancel the delayed increase to the autoloop budget or fee limit",
+	Description: "Cancels the increase to the autoloop fee budget or fee " +
+		"limit that is waiting for its delay to pass, so that it " +
+		"never takes effect.",
+	Action: cancelIncrease,
+}
+
+func cancelIncrease(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.CancelPendingIncrease(
+		context.Background(), &looprpc.CancelPendingIncreaseRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var lndFeaturesCommand = cli.Command{
+	Name:  "lndfeatures",
+	Usage: "show the lnd features that loopd detected",
+	Description: "Shows the version dependent lnd features that loopd " +
+		"detected on startup, and whether they are enabled for the " +
+		"connected lnd node.",
+	Action: lndFeatures,
+}
+
+func lndFeatures(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetLndFeatures(
+		context.Background(), &looprpc.LndFeaturesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
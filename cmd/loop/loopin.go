@@ -48,6 +48,42 @@ var (
 		Usage: "Assumes yes during confirmation. Using this option will result in an immediate swap",
 	}
 
+	validateOnlyFlag = cli.BoolFlag{
+		Name: "validate_only",
+		Usage: "validate and quote the swap and check its fee " +
+			"limits, without creating the swap",
+	}
+
+	maxTotalCostFlag = cli.Uint64Flag{
+		Name: "max_total_cost",
+		Usage: "an optional ceiling in satoshis on the total cost " +
+			"of the swap, including swap, routing and miner " +
+			"fees. The swap is aborted before committing funds " +
+			"if the ceiling cannot be met",
+	}
+
+	maxTotalCostPPMFlag = cli.Uint64Flag{
+		Name: "max_total_cost_ppm",
+		Usage: "an optional ceiling on the total cost of the swap, " +
+			"expressed in parts per million of the swap amount",
+	}
+
+	customRecordFlag = cli.StringSliceFlag{
+		Name: "custom_record",
+		Usage: "a custom tlv record to attach to the swap and " +
+			"prepay payments, in the format type=hexvalue, " +
+			"where type is >= 65536. The flag can be repeated " +
+			"to attach multiple records",
+	}
+
+	changeToleranceFlag = cli.Uint64Flag{
+		Name: "change_tolerance",
+		Usage: "an optional amount in satoshis by which the swap " +
+			"amount may be adjusted so that the htlc is funded " +
+			"without a change output, the swap fails if no " +
+			"amount within the tolerance avoids change",
+	}
+
 	loopInCommand = cli.Command{
 		Name:      "in",
 		Usage:     "perform an on-chain to off-chain swap (loop in)",
@@ -80,6 +116,10 @@ var (
 			verboseFlag,
 			routeHintsFlag,
 			privateFlag,
+			validateOnlyFlag,
+			maxTotalCostFlag,
+			maxTotalCostPPMFlag,
+			changeToleranceFlag,
 		},
 		Action: loopIn,
 	}
@@ -147,8 +187,18 @@ func loopIn(ctx *cli.Context) error {
 		return err
 	}
 
+	// Externally published htlcs are not funded by our wallet, so we
+	// cannot adjust their amount to avoid change.
+	changeTolerance := ctx.Uint64(changeToleranceFlag.Name)
+	if external && changeTolerance != 0 {
+		return fmt.Errorf("external and change_tolerance both set")
+	}
+
+	// If the swap amount may be adjusted to avoid change, we quote for the
+	// largest amount that it may be adjusted to, so that our fee limits
+	// cover the swap whichever amount it is initiated with.
 	quoteReq := &looprpc.QuoteRequest{
-		Amt:              int64(amt),
+		Amt:              int64(amt) + int64(changeTolerance),
 		ConfTarget:       htlcConfTarget,
 		ExternalHtlc:     external,
 		LoopInLastHop:    lastHop,
@@ -176,9 +226,11 @@ func loopIn(ctx *cli.Context) error {
 	}
 
 	limits := getInLimits(quote)
+	validateOnly := ctx.Bool(validateOnlyFlag.Name)
 
-	// Skip showing details if configured
-	if !(ctx.Bool("force") || ctx.Bool("f")) {
+	// Skip showing details if configured, or if we are only validating
+	// the swap.
+	if !(ctx.Bool("force") || ctx.Bool("f") || validateOnly) {
 		err = displayInDetails(quoteReq, quote, ctx.Bool("verbose"))
 		if err != nil {
 			return err
@@ -186,16 +238,20 @@ func loopIn(ctx *cli.Context) error {
 	}
 
 	req := &looprpc.LoopInRequest{
-		Amt:            int64(amt),
-		MaxMinerFee:    int64(limits.maxMinerFee),
-		MaxSwapFee:     int64(limits.maxSwapFee),
-		ExternalHtlc:   external,
-		HtlcConfTarget: htlcConfTarget,
-		Label:          label,
-		Initiator:      defaultInitiator,
-		LastHop:        lastHop,
-		RouteHints:     hints,
-		Private:        ctx.Bool(privateFlag.Name),
+		Amt:                int64(amt),
+		MaxMinerFee:        int64(limits.maxMinerFee),
+		MaxSwapFee:         int64(limits.maxSwapFee),
+		ExternalHtlc:       external,
+		HtlcConfTarget:     htlcConfTarget,
+		Label:              label,
+		Initiator:          defaultInitiator,
+		LastHop:            lastHop,
+		RouteHints:         hints,
+		Private:            ctx.Bool(privateFlag.Name),
+		ValidateOnly:       validateOnly,
+		MaxTotalCostSat:    int64(ctx.Uint64(maxTotalCostFlag.Name)),
+		MaxTotalCostPpm:    ctx.Uint64(maxTotalCostPPMFlag.Name),
+		ChangeToleranceSat: changeTolerance,
 	}
 
 	resp, err := client.LoopIn(context.Background(), req)
@@ -203,6 +259,11 @@ func loopIn(ctx *cli.Context) error {
 		return err
 	}
 
+	if validateOnly {
+		printRespJSON(resp.Validation)
+		return nil
+	}
+
 	fmt.Printf("Swap initiated\n")
 	fmt.Printf("ID:           %v\n", resp.Id)
 	if external {
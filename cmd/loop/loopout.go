@@ -39,6 +39,13 @@ var loopOutCommand = cli.Command{
 				"should be sent to, if let blank the funds " +
 				"will go to lnd's wallet",
 		},
+		cli.StringFlag{
+			Name: "addr_signature",
+			Usage: "an optional base64 signature of the " +
+				"address, signed by the address's key with " +
+				"a wallet's sign message feature, which " +
+				"verifies that the address is owned by you",
+		},
 		cli.Uint64Flag{
 			Name:  "amt",
 			Usage: "the amount in satoshis to loop out",
@@ -76,6 +83,10 @@ var loopOutCommand = cli.Command{
 		forceFlag,
 		labelFlag,
 		verboseFlag,
+		validateOnlyFlag,
+		maxTotalCostFlag,
+		maxTotalCostPPMFlag,
+		customRecordFlag,
 	},
 	Action: loopOut,
 }
@@ -122,6 +133,13 @@ func loopOut(ctx *cli.Context) error {
 		return err
 	}
 
+	customRecords, err := parseCustomRecords(
+		ctx.StringSlice(customRecordFlag.Name),
+	)
+	if err != nil {
+		return err
+	}
+
 	var destAddr string
 	switch {
 	case ctx.IsSet("addr"):
@@ -178,8 +196,10 @@ func loopOut(ctx *cli.Context) error {
 		)
 	}
 
-	// Skip showing details if configured
-	if !(ctx.Bool("force") || ctx.Bool("f")) {
+	// Skip showing details if configured, or if we are only validating
+	// the swap.
+	validateOnly := ctx.Bool(validateOnlyFlag.Name)
+	if !(ctx.Bool("force") || ctx.Bool("f") || validateOnly) {
 		err = displayOutDetails(
 			limits, warning, quoteReq, quote, ctx.Bool("verbose"),
 		)
@@ -202,14 +222,29 @@ func loopOut(ctx *cli.Context) error {
 		SwapPublicationDeadline: uint64(swapDeadline.Unix()),
 		Label:                   label,
 		Initiator:               defaultInitiator,
+		ValidateOnly:            validateOnly,
+		MaxTotalCostSat:         int64(ctx.Uint64(maxTotalCostFlag.Name)),
+		MaxTotalCostPpm:         ctx.Uint64(maxTotalCostPPMFlag.Name),
+		CustomRecords:           customRecords,
+		DestSignature:           ctx.String("addr_signature"),
 	})
 	if err != nil {
 		return err
 	}
 
+	if validateOnly {
+		printRespJSON(resp.Validation)
+		return nil
+	}
+
 	fmt.Printf("Swap initiated\n")
 	fmt.Printf("ID:             %x\n", resp.IdBytes)
 	fmt.Printf("HTLC address:   %v\n", resp.HtlcAddress) // nolint:staticcheck
+	fmt.Printf("HTLC value:     %v\n", formatAmount(resp.HtlcValueSat))
+	fmt.Printf("Swap invoice:   %v\n", formatAmount(resp.SwapInvoiceAmtSat))
+	fmt.Printf("Prepay invoice: %v\n", formatAmount(resp.PrepayAmtSat))
+	fmt.Printf("Expected sweep: %v\n",
+		formatAmount(resp.ExpectedSweepValueSat))
 	if resp.ServerMessage != "" {
 		fmt.Printf("Server message: %v\n", resp.ServerMessage)
 	}
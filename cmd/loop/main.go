@@ -78,16 +78,29 @@ var (
 		Name:  "verbose, v",
 		Usage: "show expanded details",
 	}
+	unitFlag = cli.StringFlag{
+		Name: "unit",
+		Usage: "the unit to display amounts in, one of sat, btc or " +
+			"msat. JSON output keeps its exact integer amount " +
+			"fields, and also includes display_amounts in this " +
+			"unit where supported if the flag is set.",
+		Value: "sat",
+	}
+
+	// displayUnit is the unit that amounts are displayed in, which is set
+	// by our global unit flag.
+	displayUnit = loop.DisplayUnitSat
 )
 
 const (
 
-	// satAmtFmt formats a satoshi value into a one line string, intended to
-	// prettify the terminal output. For Instance,
-	// 	fmt.Printf(f, "Estimated on-chain fee:", fee)
+	// amtFmt formats an amount that has been formatted in our display unit
+	// into a one line string, intended to prettify the terminal output.
+	// For Instance,
+	// 	fmt.Printf(f, "Estimated on-chain fee:", "7262 sat")
 	// prints out as,
 	//      Estimated on-chain fee:                      7262 sat
-	satAmtFmt = "%-36s %12d sat\n"
+	amtFmt = "%-36s %16s\n"
 
 	// blkFmt formats the number of blocks into a one line string, intended
 	// to prettify the terminal output. For Instance,
@@ -112,6 +125,38 @@ func printJSON(resp interface{}) {
 	_, _ = out.WriteTo(os.Stdout)
 }
 
+// formatAmount formats a satoshi amount from an rpc response in our display
+// unit.
+func formatAmount(amt int64) string {
+	return displayUnit.FormatAmount(btcutil.Amount(amt))
+}
+
+// printAmount prints a labeled satoshi amount from an rpc response in our
+// display unit.
+func printAmount(label string, amt int64) {
+	fmt.Printf(amtFmt, label, formatAmount(amt))
+}
+
+// rpcDisplayUnit returns the display unit that we request display amounts in
+// for JSON output. Display amounts are only requested if the unit flag is set,
+// so that our default output is unchanged.
+func rpcDisplayUnit(ctx *cli.Context) looprpc.DisplayUnit {
+	if !ctx.GlobalIsSet(unitFlag.Name) {
+		return looprpc.DisplayUnit_DISPLAY_UNIT_NONE
+	}
+
+	switch displayUnit {
+	case loop.DisplayUnitBTC:
+		return looprpc.DisplayUnit_DISPLAY_UNIT_BTC
+
+	case loop.DisplayUnitMSat:
+		return looprpc.DisplayUnit_DISPLAY_UNIT_MSAT
+
+	default:
+		return looprpc.DisplayUnit_DISPLAY_UNIT_SAT
+	}
+}
+
 func printRespJSON(resp proto.Message) {
 	jsonMarshaler := &jsonpb.Marshaler{
 		OrigName:     true,
@@ -149,12 +194,37 @@ func main() {
 		loopDirFlag,
 		tlsCertFlag,
 		macaroonPathFlag,
+		unitFlag,
+	}
+	app.Before = func(ctx *cli.Context) error {
+		unit, err := loop.ParseDisplayUnit(
+			ctx.GlobalString(unitFlag.Name),
+		)
+		if err != nil {
+			return err
+		}
+
+		displayUnit = unit
+
+		return nil
 	}
 	app.Commands = []cli.Command{
 		loopOutCommand, loopInCommand, termsCommand,
 		monitorCommand, quoteCommand, listAuthCommand,
 		listSwapsCommand, swapInfoCommand, getLiquidityParamsCommand,
 		setLiquidityRuleCommand, suggestSwapCommand, setParamsCommand,
+		setSwapNotesCommand, searchSwapsCommand, previewFeesCommand,
+		compareRebalanceCommand, debugLevelCommand, lndFeaturesCommand,
+		autoloopStatsCommand, recoveryTestCommand, closeAdviceCommand,
+		excludeChannelCommand, suggestionHistoryCommand,
+		listApprovalsCommand, approveSwapCommand, rejectSwapCommand,
+		feeReportCommand, calendarCommand, noticesCommand,
+		accountingCommand, watchSwapCommand, watchedSwapsCommand,
+		applyRulesCommand, profileCommand, paramsHistoryCommand,
+		simulationCommand, getInfoCommand, replaySwapCommand,
+		pendingIncreaseCommand, cancelIncreaseCommand,
+		postMortemsCommand, swapConflictsCommand,
+		resolveConflictCommand, stateGraphCommand,
 	}
 
 	err := app.Run(os.Args)
@@ -298,12 +368,11 @@ func displayOutDetails(l *outLimits, warning string, req *looprpc.QuoteRequest,
 	// Display fee limits.
 	if verbose {
 		fmt.Println()
-		fmt.Printf(satAmtFmt, "Max on-chain fee:", l.maxMinerFee)
-		fmt.Printf(satAmtFmt,
-			"Max off-chain swap routing fee:", l.maxSwapRoutingFee,
-		)
-		fmt.Printf(satAmtFmt, "Max off-chain prepay routing fee:",
-			l.maxPrepayRoutingFee)
+		printAmount("Max on-chain fee:", int64(l.maxMinerFee))
+		printAmount("Max off-chain swap routing fee:",
+			int64(l.maxSwapRoutingFee))
+		printAmount("Max off-chain prepay routing fee:",
+			int64(l.maxPrepayRoutingFee))
 	}
 
 	// show warning
@@ -340,13 +409,13 @@ func logSwap(swap *looprpc.SwapStatus) {
 	if swap.Type == looprpc.SwapType_LOOP_OUT {
 		fmt.Printf("%v %v %v %v - %v",
 			time.Unix(0, swap.LastUpdateTime).Format(time.RFC3339),
-			swap.Type, swapState, btcutil.Amount(swap.Amt),
+			swap.Type, swapState, formatAmount(swap.Amt),
 			swap.HtlcAddressP2Wsh,
 		)
 	} else {
 		fmt.Printf("%v %v %v %v -",
 			time.Unix(0, swap.LastUpdateTime).Format(time.RFC3339),
-			swap.Type, swapState, btcutil.Amount(swap.Amt))
+			swap.Type, swapState, formatAmount(swap.Amt))
 		if swap.HtlcAddressP2Wsh != "" {
 			fmt.Printf(" P2WSH: %v", swap.HtlcAddressP2Wsh)
 		}
@@ -361,7 +430,9 @@ func logSwap(swap *looprpc.SwapStatus) {
 		swap.State != looprpc.SwapState_PREIMAGE_REVEALED {
 
 		fmt.Printf(" (cost: server %v, onchain %v, offchain %v)",
-			swap.CostServer, swap.CostOnchain, swap.CostOffchain,
+			formatAmount(swap.CostServer),
+			formatAmount(swap.CostOnchain),
+			formatAmount(swap.CostOffchain),
 		)
 	}
 
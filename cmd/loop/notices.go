@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var noticesCommand = cli.Command{
+	Name:  "notices",
+	Usage: "show operational notices published by the server",
+	Description: "Displays the notices, such as maintenance windows and " +
+		"fee changes, that the swap server has published. By default " +
+		"only notices that are currently in effect are shown.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "all",
+			Usage: "include notices that are scheduled for the " +
+				"future or that have already ended",
+		},
+	},
+	Action: notices,
+}
+
+func notices(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetServerNotices(
+		context.Background(), &looprpc.ServerNoticesRequest{
+			IncludeInactive: ctx.Bool("all"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -174,33 +174,30 @@ func printQuoteInResp(req *looprpc.QuoteRequest,
 
 	totalFee := resp.HtlcPublishFeeSat + resp.SwapFeeSat
 
-	fmt.Printf(satAmtFmt, "Send on-chain:", req.Amt)
-	fmt.Printf(satAmtFmt, "Receive off-chain:", req.Amt-totalFee)
+	printAmount("Send on-chain:", req.Amt)
+	printAmount("Receive off-chain:", req.Amt-totalFee)
 
 	switch {
 	case req.ExternalHtlc && !verbose:
 		// If it's external then we don't know the miner fee hence the
 		// total cost.
-		fmt.Printf(satAmtFmt, "Loop service fee:", resp.SwapFeeSat)
+		printAmount("Loop service fee:", resp.SwapFeeSat)
 
 	case req.ExternalHtlc && verbose:
-		fmt.Printf(satAmtFmt, "Loop service fee:", resp.SwapFeeSat)
+		printAmount("Loop service fee:", resp.SwapFeeSat)
 		fmt.Println()
 		fmt.Printf(blkFmt, "CLTV expiry delta:", resp.CltvDelta)
 
 	case verbose:
 		fmt.Println()
-		fmt.Printf(
-			satAmtFmt, "Estimated on-chain fee:",
-			resp.HtlcPublishFeeSat,
-		)
-		fmt.Printf(satAmtFmt, "Loop service fee:", resp.SwapFeeSat)
-		fmt.Printf(satAmtFmt, "Estimated total fee:", totalFee)
+		printAmount("Estimated on-chain fee:", resp.HtlcPublishFeeSat)
+		printAmount("Loop service fee:", resp.SwapFeeSat)
+		printAmount("Estimated total fee:", totalFee)
 		fmt.Println()
 		fmt.Printf(blkFmt, "Conf target:", resp.ConfTarget)
 		fmt.Printf(blkFmt, "CLTV expiry delta:", resp.CltvDelta)
 	default:
-		fmt.Printf(satAmtFmt, "Estimated total fee:", totalFee)
+		printAmount("Estimated total fee:", totalFee)
 	}
 }
 
@@ -209,20 +206,20 @@ func printQuoteOutResp(req *looprpc.QuoteRequest,
 
 	totalFee := resp.HtlcSweepFeeSat + resp.SwapFeeSat
 
-	fmt.Printf(satAmtFmt, "Send off-chain:", req.Amt)
-	fmt.Printf(satAmtFmt, "Receive on-chain:", req.Amt-totalFee)
+	printAmount("Send off-chain:", req.Amt)
+	printAmount("Receive on-chain:", req.Amt-totalFee)
 
 	if !verbose {
-		fmt.Printf(satAmtFmt, "Estimated total fee:", totalFee)
+		printAmount("Estimated total fee:", totalFee)
 		return
 	}
 
 	fmt.Println()
-	fmt.Printf(satAmtFmt, "Estimated on-chain fee:", resp.HtlcSweepFeeSat)
-	fmt.Printf(satAmtFmt, "Loop service fee:", resp.SwapFeeSat)
-	fmt.Printf(satAmtFmt, "Estimated total fee:", totalFee)
+	printAmount("Estimated on-chain fee:", resp.HtlcSweepFeeSat)
+	printAmount("Loop service fee:", resp.SwapFeeSat)
+	printAmount("Estimated total fee:", totalFee)
 	fmt.Println()
-	fmt.Printf(satAmtFmt, "No show penalty (prepay):", resp.PrepayAmtSat)
+	printAmount("No show penalty (prepay):", resp.PrepayAmtSat)
 	fmt.Printf(blkFmt, "Conf target:", resp.ConfTarget)
 	fmt.Printf(blkFmt, "CLTV expiry delta:", resp.CltvDelta)
 	fmt.Printf("%-38s %s\n",
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/urfave/cli"
+)
+
+var recoveryTestCommand = cli.Command{
+	Name:  "recoverytest",
+	Usage: "run a drill that verifies the swap recovery paths",
+	Description: "Runs a drill on regtest or signet that exercises the " +
+		"timeout and uncooperative sweep paths of a synthetic swap, " +
+		"and reports whether the keys, scripts and wallet that loopd " +
+		"relies on to recover funds work on this node. By default " +
+		"the sweeps are only signed and verified. With --publish, " +
+		"the drill's htlcs are funded from the wallet and the sweeps " +
+		"are broadcast once the htlcs confirm.",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "amt",
+			Usage: "the amount in satoshis to lock in each of " +
+				"the drill's htlcs, a default of 20000 " +
+				"satoshis is used if not set.",
+		},
+		cli.BoolFlag{
+			Name: "publish",
+			Usage: "fund the drill's htlcs on chain and " +
+				"broadcast their sweeps.",
+		},
+	},
+	Action: recoveryTest,
+}
+
+func recoveryTest(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.RecoveryTest(
+		context.Background(), &looprpc.RecoveryTestRequest{
+			Amt:     ctx.Uint64("amt"),
+			Publish: ctx.Bool("publish"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 
 	"github.com/lightninglabs/loop/looprpc"
@@ -26,7 +27,43 @@ func listSwaps(ctx *cli.Context) error {
 	defer cleanup()
 
 	resp, err := client.ListSwaps(
-		context.Background(), &looprpc.ListSwapsRequest{},
+		context.Background(), &looprpc.ListSwapsRequest{
+			DisplayUnit: rpcDisplayUnit(ctx),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var searchSwapsCommand = cli.Command{
+	Name:      "searchswaps",
+	Usage:     "search for swaps in the local database",
+	ArgsUsage: "query",
+	Description: "Returns all swaps with a hash, label, notes, htlc " +
+		"txid, invoice, destination address or peer pubkey that " +
+		"contains the query provided. Matching is case-insensitive.",
+	Action: searchSwaps,
+}
+
+func searchSwaps(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "searchswaps")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.SearchSwaps(
+		context.Background(), &looprpc.SearchSwapsRequest{
+			Query: ctx.Args().First(),
+		},
 	)
 	if err != nil {
 		return err
@@ -81,7 +118,556 @@ func swapInfo(ctx *cli.Context) error {
 	defer cleanup()
 
 	resp, err := client.SwapInfo(
-		context.Background(), &looprpc.SwapInfoRequest{Id: idBytes},
+		context.Background(), &looprpc.SwapInfoRequest{
+			Id:          idBytes,
+			DisplayUnit: rpcDisplayUnit(ctx),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var setSwapNotesCommand = cli.Command{
+	Name:      "setnotes",
+	Usage:     "attach notes to a swap",
+	ArgsUsage: "id notes",
+	Description: "Attaches free-text notes to an existing swap, replacing " +
+		"any notes that were previously set. Pass an empty string " +
+		"to clear the notes of a swap.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the ID of the swap",
+		},
+		cli.StringFlag{
+			Name:  "notes",
+			Usage: "the notes to attach to the swap",
+		},
+	},
+	Action: setSwapNotes,
+}
+
+func setSwapNotes(ctx *cli.Context) error {
+	args := ctx.Args()
+
+	var id string
+	switch {
+	case ctx.IsSet("id"):
+		id = ctx.String("id")
+	case ctx.NArg() > 0:
+		id = args[0]
+		args = args.Tail()
+	default:
+		// Show command help if no arguments and flags were provided.
+		return cli.ShowCommandHelp(ctx, "setnotes")
+	}
+
+	var notes string
+	switch {
+	case ctx.IsSet("notes"):
+		notes = ctx.String("notes")
+	case args.Present():
+		notes = args.First()
+	default:
+		return fmt.Errorf("notes required")
+	}
+
+	if len(id) != hex.EncodedLen(lntypes.HashSize) {
+		return fmt.Errorf("invalid swap ID")
+	}
+	idBytes, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode id: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.SetSwapNotes(
+		context.Background(), &looprpc.SetSwapNotesRequest{
+			Id:    idBytes,
+			Notes: notes,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var swapConflictsCommand = cli.Command{
+	Name:  "swapconflicts",
+	Usage: "list swaps that share a hash",
+	Description: "Lists the swap hashes that are used by both a loop " +
+		"out and a loop in swap in the daemon's swap store. Pending " +
+		"swaps with a conflicting hash are not resumed until the " +
+		"conflict is resolved with the resolveconflict command.",
+	Action: swapConflicts,
+}
+
+func swapConflicts(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListSwapConflicts(
+		context.Background(), &looprpc.ListSwapConflictsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var resolveConflictCommand = cli.Command{
+	Name:      "resolveconflict",
+	Usage:     "resolve a conflict between swaps that share a hash",
+	ArgsUsage: "id",
+	Description: "Resolves a conflict between a loop out and a loop in " +
+		"swap that share a hash by keeping the swap of the type " +
+		"provided. The record of the other swap is set aside in the " +
+		"swap store rather than being deleted. If the swap that is " +
+		"kept is pending, it is resumed when loopd is next started.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the ID of the conflicting swaps",
+		},
+		cli.StringFlag{
+			Name: "keep",
+			Usage: "the type of the swap to keep, set to 'out' to " +
+				"keep the loop out or 'in' to keep the loop in",
+		},
+	},
+	Action: resolveConflict,
+}
+
+func resolveConflict(ctx *cli.Context) error {
+	var id string
+	switch {
+	case ctx.IsSet("id"):
+		id = ctx.String("id")
+	case ctx.NArg() > 0:
+		id = ctx.Args().First()
+	default:
+		// Show command help if no arguments and flags were provided.
+		return cli.ShowCommandHelp(ctx, "resolveconflict")
+	}
+
+	if len(id) != hex.EncodedLen(lntypes.HashSize) {
+		return fmt.Errorf("invalid swap ID")
+	}
+	idBytes, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode id: %v", err)
+	}
+
+	// We require the type to keep to be set explicitly, because we do not
+	// want to set aside a swap's record by default.
+	var keep looprpc.SwapType
+	switch ctx.String("keep") {
+	case "in":
+		keep = looprpc.SwapType_LOOP_IN
+
+	case "out":
+		keep = looprpc.SwapType_LOOP_OUT
+
+	default:
+		return errors.New("please set keep to in or out")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ResolveSwapConflict(
+		context.Background(), &looprpc.ResolveSwapConflictRequest{
+			Id:   idBytes,
+			Keep: keep,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var feeReportCommand = cli.Command{
+	Name:  "feereport",
+	Usage: "compare loop out costs to on-chain alternatives",
+	Description: "Compares the cost of each successful loop out to the " +
+		"estimated cost of a plain on-chain send of the same " +
+		"amount, and of closing and reopening a channel instead, " +
+		"at the fee rate that the swap's sweep paid.",
+	Action: feeReport,
+}
+
+func feeReport(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetFeeReport(
+		context.Background(), &looprpc.FeeReportRequest{
+			DisplayUnit: rpcDisplayUnit(ctx),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var accountingCommand = cli.Command{
+	Name:  "accounting",
+	Usage: "show discrepancies between swap costs and lnd's records",
+	Description: "Shows the outcome of the daemon's last accounting " +
+		"check, which reconciles the costs recorded for completed " +
+		"swaps against lnd's payment and transaction records. " +
+		"Accounting checks must be enabled with the " +
+		"accountingcheckinterval option.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "refresh",
+			Usage: "perform a new accounting check rather than " +
+				"showing the last periodic check",
+		},
+	},
+	Action: accountingReport,
+}
+
+func accountingReport(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetAccountingReport(
+		context.Background(), &looprpc.AccountingReportRequest{
+			Refresh: ctx.Bool("refresh"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var watchSwapCommand = cli.Command{
+	Name:      "watchswap",
+	Usage:     "monitor a swap initiated by another loop client",
+	ArgsUsage: "id",
+	Description: "Adds a swap that was initiated by another loop client " +
+		"using the same lnd node to the daemon's watch list. Watched " +
+		"swaps are only monitored through lnd's records for " +
+		"accounting purposes.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the ID of the swap",
+		},
+		cli.StringFlag{
+			Name: "type",
+			Usage: "the type of the swap, set to 'out' for a " +
+				"loop out or 'in' for a loop in",
+			Value: "out",
+		},
+		cli.StringFlag{
+			Name:  "label",
+			Usage: "an optional label for the swap",
+		},
+	},
+	Action: watchSwap,
+}
+
+func watchSwap(ctx *cli.Context) error {
+	var id string
+	switch {
+	case ctx.IsSet("id"):
+		id = ctx.String("id")
+	case ctx.NArg() > 0:
+		id = ctx.Args().First()
+	default:
+		// Show command help if no arguments and flags were provided.
+		return cli.ShowCommandHelp(ctx, "watchswap")
+	}
+
+	if len(id) != hex.EncodedLen(lntypes.HashSize) {
+		return fmt.Errorf("invalid swap ID")
+	}
+	idBytes, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode id: %v", err)
+	}
+
+	var swapType looprpc.SwapType
+	switch ctx.String("type") {
+	case "in":
+		swapType = looprpc.SwapType_LOOP_IN
+
+	case "out":
+		swapType = looprpc.SwapType_LOOP_OUT
+
+	default:
+		return errors.New("please set type to in or out")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.WatchSwap(
+		context.Background(), &looprpc.WatchSwapRequest{
+			Id:    idBytes,
+			Type:  swapType,
+			Label: ctx.String("label"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var watchedSwapsCommand = cli.Command{
+	Name:  "watchedswaps",
+	Usage: "list watched swaps initiated by other loop clients",
+	Description: "Lists the swaps in the daemon's watch list, along with " +
+		"the state and costs that lnd's records imply for them.",
+	Action: watchedSwaps,
+}
+
+func watchedSwaps(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListWatchedSwaps(
+		context.Background(), &looprpc.ListWatchedSwapsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var replaySwapCommand = cli.Command{
+	Name:      "replayswap",
+	Usage:     "reconstruct the timeline of a swap",
+	ArgsUsage: "id",
+	Description: "Reconstructs the full timeline of a swap from loopd's " +
+		"swap store, lnd's payments, invoices and wallet " +
+		"transactions, and the chain. This is intended for " +
+		"debugging swaps that did not behave as expected.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the ID of the swap",
+		},
+	},
+	Action: replaySwap,
+}
+
+func replaySwap(ctx *cli.Context) error {
+	var id string
+	switch {
+	case ctx.IsSet("id"):
+		id = ctx.String("id")
+	case ctx.NArg() > 0:
+		id = ctx.Args().First()
+	default:
+		// Show command help if no arguments and flags were provided.
+		return cli.ShowCommandHelp(ctx, "replayswap")
+	}
+
+	if len(id) != hex.EncodedLen(lntypes.HashSize) {
+		return fmt.Errorf("invalid swap ID")
+	}
+	idBytes, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("cannot hex decode id: %v", err)
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ReplaySwap(
+		context.Background(), &looprpc.ReplaySwapRequest{
+			Id: idBytes,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var stateGraphCommand = cli.Command{
+	Name:      "stategraph",
+	Usage:     "export the state machine of a swap",
+	ArgsUsage: "[id]",
+	Description: "Exports the state machine of a swap type as a DOT or " +
+		"mermaid graph. If a swap ID is provided, the graph is for " +
+		"the swap's type, and highlights the states that the swap " +
+		"went through and its current state. The graph is printed " +
+		"on its own so that it can be piped to a renderer, for " +
+		"example `loop stategraph | dot -Tsvg > swap.svg`.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the ID of the swap",
+		},
+		cli.StringFlag{
+			Name: "type",
+			Usage: "the type of swap to export the state machine " +
+				"of if no swap is provided, set to 'out' " +
+				"for loop out or 'in' for loop in",
+			Value: "out",
+		},
+		cli.StringFlag{
+			Name: "format",
+			Usage: "the format to export the graph in, either " +
+				"'dot' or 'mermaid'",
+			Value: "dot",
+		},
+		cli.BoolFlag{
+			Name: "json",
+			Usage: "print the full response, including the " +
+				"swap's path and the states that it can " +
+				"still reach, rather than just the graph",
+		},
+	},
+	Action: stateGraph,
+}
+
+func stateGraph(ctx *cli.Context) error {
+	var id string
+	switch {
+	case ctx.IsSet("id"):
+		id = ctx.String("id")
+	case ctx.NArg() > 0:
+		id = ctx.Args().First()
+	}
+
+	var idBytes []byte
+	if id != "" {
+		if len(id) != hex.EncodedLen(lntypes.HashSize) {
+			return fmt.Errorf("invalid swap ID")
+		}
+
+		var err error
+		idBytes, err = hex.DecodeString(id)
+		if err != nil {
+			return fmt.Errorf("cannot hex decode id: %v", err)
+		}
+	}
+
+	var swapType looprpc.SwapType
+	switch ctx.String("type") {
+	case "in":
+		swapType = looprpc.SwapType_LOOP_IN
+
+	case "out":
+		swapType = looprpc.SwapType_LOOP_OUT
+
+	default:
+		return errors.New("please set type to in or out")
+	}
+
+	var format looprpc.StateGraphFormat
+	switch ctx.String("format") {
+	case "dot":
+		format = looprpc.StateGraphFormat_STATE_GRAPH_FORMAT_DOT
+
+	case "mermaid":
+		format = looprpc.StateGraphFormat_STATE_GRAPH_FORMAT_MERMAID
+
+	default:
+		return errors.New("please set format to dot or mermaid")
+	}
+
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetSwapStateGraph(
+		context.Background(), &looprpc.SwapStateGraphRequest{
+			Id:       idBytes,
+			SwapType: swapType,
+			Format:   format,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if ctx.Bool("json") {
+		printRespJSON(resp)
+		return nil
+	}
+
+	fmt.Print(resp.Graph)
+	return nil
+}
+
+var postMortemsCommand = cli.Command{
+	Name:  "postmortems",
+	Usage: "show reports for swaps that exceeded their maximum lifetime",
+	Description: "Shows the post-mortem reports of swaps that have been " +
+		"pending for longer than the maximum lifetime for their " +
+		"type, including their state timeline, last errors and " +
+		"the status of their htlc on chain.",
+	Action: postMortems,
+}
+
+func postMortems(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetPostMortems(
+		context.Background(), &looprpc.PostMortemsRequest{},
 	)
 	if err != nil {
 		return err
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/loop/looprpc"
 	"github.com/urfave/cli"
 )
@@ -23,8 +22,8 @@ func terms(ctx *cli.Context) error {
 	defer cleanup()
 
 	printAmountRange := func(min, max int64) {
-		fmt.Printf("Amount: %d - %d\n",
-			btcutil.Amount(min), btcutil.Amount(max),
+		fmt.Printf("Amount: %v - %v\n", formatAmount(min),
+			formatAmount(max),
 		)
 	}
 
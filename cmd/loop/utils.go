@@ -1,8 +1,11 @@
 package main
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/lightninglabs/loop/swapserverrpc"
 	"github.com/urfave/cli"
@@ -31,3 +34,41 @@ func validateRouteHints(ctx *cli.Context) ([]*swapserverrpc.RouteHint, error) {
 
 	return hints, nil
 }
+
+// parseCustomRecords parses a set of custom records in the format
+// type=hexvalue. It returns nil if no records are provided.
+func parseCustomRecords(values []string) (map[uint64][]byte, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	records := make(map[uint64][]byte, len(values))
+	for _, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("custom record %v must be in "+
+				"the format type=hexvalue", value)
+		}
+
+		recordType, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid custom record type "+
+				"%v: %v", parts[0], err)
+		}
+
+		if _, ok := records[recordType]; ok {
+			return nil, fmt.Errorf("duplicate custom record type "+
+				"%v", recordType)
+		}
+
+		recordValue, err := hex.DecodeString(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid custom record value "+
+				"%v: %v", parts[1], err)
+		}
+
+		records[recordType] = recordValue
+	}
+
+	return records, nil
+}
@@ -0,0 +1,58 @@
+package loop
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/record"
+)
+
+// MaxCustomRecordsSize is the maximum total size, in bytes, of the values of
+// the custom records that may be attached to a swap's off-chain payments. The
+// records are carried in the onion payload of the final hop, so we leave
+// plenty of room for the rest of the payload.
+const MaxCustomRecordsSize = 512
+
+// ErrCustomRecordsTooLarge is returned when the custom records attached to a
+// swap exceed our size limit.
+var ErrCustomRecordsTooLarge = fmt.Errorf("custom records may not exceed "+
+	"%v bytes", MaxCustomRecordsSize)
+
+// ErrCustomRecordType is returned when a custom record's type is outside of
+// the custom record range.
+var ErrCustomRecordType = errors.New("custom record types must be >= 65536")
+
+// ValidateCustomRecords checks that a set of custom records may be attached to
+// a swap's off-chain payments.
+func ValidateCustomRecords(records map[uint64][]byte) error {
+	var size int
+	for key, value := range records {
+		if key < record.CustomTypeStart {
+			return ErrCustomRecordType
+		}
+
+		size += len(value)
+	}
+
+	if size > MaxCustomRecordsSize {
+		return ErrCustomRecordsTooLarge
+	}
+
+	return nil
+}
+
+// CopyCustomRecords returns a deep copy of a set of custom records, so that
+// the copy may be modified without affecting the original. It returns nil if
+// the records provided are nil.
+func CopyCustomRecords(records map[uint64][]byte) map[uint64][]byte {
+	if records == nil {
+		return nil
+	}
+
+	recordsCopy := make(map[uint64][]byte, len(records))
+	for key, value := range records {
+		recordsCopy[key] = append([]byte(nil), value...)
+	}
+
+	return recordsCopy
+}
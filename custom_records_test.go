@@ -0,0 +1,72 @@
+package loop
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidateCustomRecords tests validation of the custom records that are
+// attached to a swap's off-chain payments.
+func TestValidateCustomRecords(t *testing.T) {
+	tests := []struct {
+		name    string
+		records map[uint64][]byte
+		err     error
+	}{
+		{
+			name: "no records",
+		},
+		{
+			name: "valid records",
+			records: map[uint64][]byte{
+				65536: {1, 2, 3},
+				65537: bytes.Repeat([]byte{1}, 100),
+			},
+		},
+		{
+			name: "type below custom range",
+			records: map[uint64][]byte{
+				65535: {1},
+			},
+			err: ErrCustomRecordType,
+		},
+		{
+			name: "records too large",
+			records: map[uint64][]byte{
+				65536: bytes.Repeat([]byte{1}, 500),
+				65537: bytes.Repeat([]byte{1}, 13),
+			},
+			err: ErrCustomRecordsTooLarge,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateCustomRecords(testCase.records)
+			require.Equal(t, testCase.err, err)
+		})
+	}
+}
+
+// TestCopyCustomRecords tests that copies of custom records do not share
+// their values with the original records.
+func TestCopyCustomRecords(t *testing.T) {
+	require.Nil(t, CopyCustomRecords(nil))
+
+	records := map[uint64][]byte{
+		65536: {1, 2, 3},
+	}
+
+	recordsCopy := CopyCustomRecords(records)
+	require.Equal(t, records, recordsCopy)
+
+	recordsCopy[65536][0] = 4
+	recordsCopy[65537] = []byte{5}
+	require.Equal(t, map[uint64][]byte{
+		65536: {1, 2, 3},
+	}, records)
+}
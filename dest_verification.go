@@ -0,0 +1,257 @@
+package loop
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// signedMessagePrefix is the prefix that is added to messages before they
+// are signed, as defined by the bitcoin signmessage format.
+const signedMessagePrefix = "Bitcoin Signed Message:\n"
+
+var (
+	// ErrInvalidDestSignature is returned when the signature provided for
+	// a loop out destination was not made by the destination's key.
+	ErrInvalidDestSignature = errors.New("destination signature does not " +
+		"match destination address")
+
+	// ErrUnverifiedDest is returned when we require loop out destinations
+	// to be verified, and a destination's ownership could not be proven.
+	ErrUnverifiedDest = errors.New("destination ownership could not be " +
+		"verified, provide a signature from the destination address " +
+		"or use an address derived from a configured xpub")
+
+	// ErrUnverifiedXpub is returned when we require loop out destinations
+	// to be verified, and an xpub that destinations are derived from is
+	// not one of our configured xpubs.
+	ErrUnverifiedXpub = errors.New("xpub is not a configured destination " +
+		"xpub, add it with the destxpub option")
+)
+
+// XpubAddress derives the native segwit address at the branch and index
+// provided from an extended public key (m/branch/index).
+func XpubAddress(xpub *hdkeychain.ExtendedKey, branch, index uint32,
+	chainParams *chaincfg.Params) (btcutil.Address, error) {
+
+	branchKey, err := xpub.Derive(branch)
+	if err != nil {
+		return nil, err
+	}
+
+	child, err := branchKey.Derive(index)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := child.ECPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(pubKey.SerializeCompressed()), chainParams,
+	)
+}
+
+// XpubVerification returns the verification for an address that was derived
+// from an extended public key at the branch and index provided.
+func XpubVerification(xpub *hdkeychain.ExtendedKey,
+	branch, index uint32) *loopdb.DestVerification {
+
+	return &loopdb.DestVerification{
+		Method: loopdb.DestVerificationXpub,
+		Proof:  fmt.Sprintf("%v/%v/%v", xpub, branch, index),
+	}
+}
+
+// DestVerifier verifies that the custom destination addresses of loop outs
+// are owned by the user, so that a mistyped address does not lose an entire
+// swap.
+type DestVerifier struct {
+	// xpubs is the set of extended public keys that addresses may be
+	// derived from to be verified.
+	xpubs []*hdkeychain.ExtendedKey
+
+	// lookahead is the number of addresses on each branch of our xpubs
+	// that we check for a destination.
+	lookahead uint32
+
+	// required indicates whether destinations that cannot be verified are
+	// rejected.
+	required bool
+
+	// chainParams is the network that destinations must be on.
+	chainParams *chaincfg.Params
+}
+
+// NewDestVerifier creates a destination verifier that accepts addresses that
+// are derived from the xpubs provided, or signed for.
+func NewDestVerifier(xpubs []*hdkeychain.ExtendedKey, lookahead uint32,
+	required bool, chainParams *chaincfg.Params) *DestVerifier {
+
+	return &DestVerifier{
+		xpubs:       xpubs,
+		lookahead:   lookahead,
+		required:    required,
+		chainParams: chainParams,
+	}
+}
+
+// Verify verifies that the destination address provided is owned by the
+// user. If a signature is provided, it must be a base64 encoded signature of
+// the address itself in the bitcoin signmessage format, made by the
+// address's key. Otherwise, we check whether the address was derived from one
+// of our xpubs. If the address could not be verified, nil is returned unless
+// verification is required.
+func (v *DestVerifier) Verify(addr btcutil.Address,
+	signature string) (*loopdb.DestVerification, error) {
+
+	if signature != "" {
+		if err := verifyDestSignature(addr, signature); err != nil {
+			return nil, err
+		}
+
+		return &loopdb.DestVerification{
+			Method: loopdb.DestVerificationSignature,
+			Proof:  signature,
+		}, nil
+	}
+
+	verification, err := v.xpubVerification(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if verification == nil && v.required {
+		return nil, ErrUnverifiedDest
+	}
+
+	return verification, nil
+}
+
+// VerifyXpub returns a boolean indicating whether an extended public key that
+// destinations are derived from is one of our configured xpubs, in which case
+// the addresses derived from it are verified by their derivation. If it is
+// not, and verification is required, ErrUnverifiedXpub is returned.
+func (v *DestVerifier) VerifyXpub(xpub *hdkeychain.ExtendedKey) (bool, error) {
+	target := xpub.String()
+	for _, configured := range v.xpubs {
+		if configured.String() == target {
+			return true, nil
+		}
+	}
+
+	if v.required {
+		return false, ErrUnverifiedXpub
+	}
+
+	return false, nil
+}
+
+// xpubVerification returns the verification of an address that was derived
+// from one of our xpubs, searching their external and change branches up to
+// our lookahead. If the address was not derived from our xpubs, nil is
+// returned.
+func (v *DestVerifier) xpubVerification(
+	addr btcutil.Address) (*loopdb.DestVerification, error) {
+
+	target := addr.String()
+
+	for _, xpub := range v.xpubs {
+		for _, branch := range []uint32{0, 1} {
+			for i := uint32(0); i < v.lookahead; i++ {
+				derived, err := XpubAddress(
+					xpub, branch, i, v.chainParams,
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				if derived.String() == target {
+					return XpubVerification(
+						xpub, branch, i,
+					), nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// verifyDestSignature verifies that a base64 encoded signmessage signature of
+// an address was made by the key that the address pays to. Pay to pubkey
+// hash, native segwit and nested segwit addresses are supported.
+func verifyDestSignature(addr btcutil.Address, signature string) error {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("decode destination signature: %v", err)
+	}
+
+	var msg bytes.Buffer
+	err = wire.WriteVarString(&msg, 0, signedMessagePrefix)
+	if err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarString(&msg, 0, addr.String()); err != nil {
+		return err
+	}
+
+	pubKey, compressed, err := btcec.RecoverCompact(
+		btcec.S256(), sig, chainhash.DoubleHashB(msg.Bytes()),
+	)
+	if err != nil {
+		return ErrInvalidDestSignature
+	}
+
+	var serialized []byte
+	if compressed {
+		serialized = pubKey.SerializeCompressed()
+	} else {
+		serialized = pubKey.SerializeUncompressed()
+	}
+	keyHash := btcutil.Hash160(serialized)
+
+	var expected []byte
+	switch a := addr.(type) {
+	case *btcutil.AddressPubKeyHash:
+		expected = a.Hash160()[:]
+
+	case *btcutil.AddressWitnessPubKeyHash:
+		expected = a.Hash160()[:]
+
+	case *btcutil.AddressScriptHash:
+		// A nested segwit address pays to a script hash of the witness
+		// program of the key.
+		script, err := txscript.NewScriptBuilder().
+			AddOp(txscript.OP_0).AddData(keyHash).Script()
+		if err != nil {
+			return err
+		}
+
+		keyHash = btcutil.Hash160(script)
+		expected = a.Hash160()[:]
+
+	default:
+		return fmt.Errorf("signature verification not supported for "+
+			"address type: %T", addr)
+	}
+
+	if !bytes.Equal(keyHash, expected) {
+		return ErrInvalidDestSignature
+	}
+
+	return nil
+}
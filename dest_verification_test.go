@@ -0,0 +1,164 @@
+package loop
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/stretchr/testify/require"
+)
+
+// signAddress returns a base64 encoded signmessage signature of an address
+// by the key provided.
+func signAddress(t *testing.T, key *btcec.PrivateKey,
+	addr btcutil.Address) string {
+
+	var msg bytes.Buffer
+	require.NoError(t, wire.WriteVarString(&msg, 0, signedMessagePrefix))
+	require.NoError(t, wire.WriteVarString(&msg, 0, addr.String()))
+
+	sig, err := btcec.SignCompact(
+		btcec.S256(), key, chainhash.DoubleHashB(msg.Bytes()), true,
+	)
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+// TestVerifyDestSignature tests verification of signatures of each of our
+// supported address types.
+func TestVerifyDestSignature(t *testing.T) {
+	params := &chaincfg.RegressionNetParams
+
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	keyHash := btcutil.Hash160(key.PubKey().SerializeCompressed())
+
+	p2pkh, err := btcutil.NewAddressPubKeyHash(keyHash, params)
+	require.NoError(t, err)
+
+	p2wkh, err := btcutil.NewAddressWitnessPubKeyHash(keyHash, params)
+	require.NoError(t, err)
+
+	witnessProgram, err := txscript.PayToAddrScript(p2wkh)
+	require.NoError(t, err)
+
+	np2wkh, err := btcutil.NewAddressScriptHash(witnessProgram, params)
+	require.NoError(t, err)
+
+	for _, addr := range []btcutil.Address{p2pkh, p2wkh, np2wkh} {
+		err := verifyDestSignature(addr, signAddress(t, key, addr))
+		require.NoError(t, err, addr.String())
+	}
+
+	// A signature of the wrong address, or by another key, fails.
+	err = verifyDestSignature(p2wkh, signAddress(t, key, p2pkh))
+	require.Equal(t, ErrInvalidDestSignature, err)
+
+	otherKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	err = verifyDestSignature(p2wkh, signAddress(t, otherKey, p2wkh))
+	require.Equal(t, ErrInvalidDestSignature, err)
+}
+
+// TestDestVerifier tests verification of destinations with signatures and
+// configured xpubs, and rejection of unverified destinations when we require
+// verification.
+func TestDestVerifier(t *testing.T) {
+	params := &chaincfg.RegressionNetParams
+
+	seed := bytes.Repeat([]byte{2}, hdkeychain.RecommendedSeedLen)
+	master, err := hdkeychain.NewMaster(seed, params)
+	require.NoError(t, err)
+
+	xpub, err := master.Neuter()
+	require.NoError(t, err)
+
+	// Our change address is within our lookahead, but our external
+	// address beyond it is not.
+	change, err := XpubAddress(xpub, 1, 4, params)
+	require.NoError(t, err)
+
+	beyond, err := XpubAddress(xpub, 0, 5, params)
+	require.NoError(t, err)
+
+	verifier := NewDestVerifier(
+		[]*hdkeychain.ExtendedKey{xpub}, 5, false, params,
+	)
+
+	verification, err := verifier.Verify(change, "")
+	require.NoError(t, err)
+	require.Equal(t, XpubVerification(xpub, 1, 4), verification)
+
+	verification, err = verifier.Verify(beyond, "")
+	require.NoError(t, err)
+	require.Nil(t, verification)
+
+	// Signatures verify destinations that are not derived from our
+	// xpubs.
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	signed, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(key.PubKey().SerializeCompressed()), params,
+	)
+	require.NoError(t, err)
+
+	signature := signAddress(t, key, signed)
+	verification, err = verifier.Verify(signed, signature)
+	require.NoError(t, err)
+	require.Equal(t, &loopdb.DestVerification{
+		Method: loopdb.DestVerificationSignature,
+		Proof:  signature,
+	}, verification)
+
+	// When verification is required, unverified destinations are
+	// rejected.
+	verifier.required = true
+	_, err = verifier.Verify(beyond, "")
+	require.Equal(t, ErrUnverifiedDest, err)
+}
+
+// TestVerifyXpub tests verification of the xpubs that destinations are
+// derived from against our configured xpubs.
+func TestVerifyXpub(t *testing.T) {
+	params := &chaincfg.RegressionNetParams
+
+	seed := bytes.Repeat([]byte{2}, hdkeychain.RecommendedSeedLen)
+	master, err := hdkeychain.NewMaster(seed, params)
+	require.NoError(t, err)
+
+	xpub, err := master.Neuter()
+	require.NoError(t, err)
+
+	other, err := xpub.Derive(1)
+	require.NoError(t, err)
+
+	verifier := NewDestVerifier(
+		[]*hdkeychain.ExtendedKey{xpub}, 5, false, params,
+	)
+
+	verified, err := verifier.VerifyXpub(xpub)
+	require.NoError(t, err)
+	require.True(t, verified)
+
+	// Xpubs that we are not configured with are accepted unverified,
+	// unless we require verification.
+	verified, err = verifier.VerifyXpub(other)
+	require.NoError(t, err)
+	require.False(t, verified)
+
+	verifier.required = true
+	_, err = verifier.VerifyXpub(other)
+	require.Equal(t, ErrUnverifiedXpub, err)
+}
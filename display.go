@@ -0,0 +1,84 @@
+package loop
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// DisplayUnit is the unit that amounts are formatted in for display. Amounts
+// are always formatted from their integer satoshi value, so that no precision
+// is lost to floating point conversion.
+type DisplayUnit uint8
+
+const (
+	// DisplayUnitSat displays amounts in satoshis.
+	DisplayUnitSat DisplayUnit = iota
+
+	// DisplayUnitBTC displays amounts in bitcoin, with all eight decimal
+	// places.
+	DisplayUnitBTC
+
+	// DisplayUnitMSat displays amounts in millisatoshis.
+	DisplayUnitMSat
+)
+
+// String returns the string representation of a display unit.
+func (u DisplayUnit) String() string {
+	switch u {
+	case DisplayUnitSat:
+		return "sat"
+
+	case DisplayUnitBTC:
+		return "BTC"
+
+	case DisplayUnitMSat:
+		return "msat"
+
+	default:
+		return "unknown"
+	}
+}
+
+// FormatAmount formats an amount in the display unit, followed by the unit's
+// name.
+func (u DisplayUnit) FormatAmount(amt btcutil.Amount) string {
+	switch u {
+	case DisplayUnitBTC:
+		sign := ""
+		if amt < 0 {
+			sign = "-"
+			amt = -amt
+		}
+
+		return fmt.Sprintf("%v%d.%08d %v", sign,
+			amt/btcutil.SatoshiPerBitcoin,
+			amt%btcutil.SatoshiPerBitcoin, u)
+
+	case DisplayUnitMSat:
+		return fmt.Sprintf("%d %v", int64(amt)*1000, u)
+
+	default:
+		return fmt.Sprintf("%d %v", int64(amt), DisplayUnitSat)
+	}
+}
+
+// ParseDisplayUnit parses the name of a display unit, which is not case
+// sensitive.
+func ParseDisplayUnit(unit string) (DisplayUnit, error) {
+	switch strings.ToLower(unit) {
+	case "sat":
+		return DisplayUnitSat, nil
+
+	case "btc":
+		return DisplayUnitBTC, nil
+
+	case "msat":
+		return DisplayUnitMSat, nil
+
+	default:
+		return 0, fmt.Errorf("unknown display unit: %v, expected "+
+			"sat, btc or msat", unit)
+	}
+}
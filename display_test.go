@@ -0,0 +1,74 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFormatAmount tests formatting of amounts in each of our display units.
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		unit     DisplayUnit
+		amt      btcutil.Amount
+		expected string
+	}{
+		{
+			name:     "sat",
+			unit:     DisplayUnitSat,
+			amt:      123456,
+			expected: "123456 sat",
+		},
+		{
+			name:     "msat",
+			unit:     DisplayUnitMSat,
+			amt:      123456,
+			expected: "123456000 msat",
+		},
+		{
+			name:     "btc below one",
+			unit:     DisplayUnitBTC,
+			amt:      123456,
+			expected: "0.00123456 BTC",
+		},
+		{
+			name:     "btc above one",
+			unit:     DisplayUnitBTC,
+			amt:      2_100_000_000_000_001,
+			expected: "21000000.00000001 BTC",
+		},
+		{
+			name:     "btc negative",
+			unit:     DisplayUnitBTC,
+			amt:      -150_000_000,
+			expected: "-1.50000000 BTC",
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t, testCase.expected,
+				testCase.unit.FormatAmount(testCase.amt),
+			)
+		})
+	}
+}
+
+// TestParseDisplayUnit tests parsing of display unit names.
+func TestParseDisplayUnit(t *testing.T) {
+	unit, err := ParseDisplayUnit("BTC")
+	require.NoError(t, err)
+	require.Equal(t, DisplayUnitBTC, unit)
+
+	unit, err = ParseDisplayUnit("msat")
+	require.NoError(t, err)
+	require.Equal(t, DisplayUnitMSat, unit)
+
+	_, err = ParseDisplayUnit("bits")
+	require.Error(t, err)
+}
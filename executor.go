@@ -31,6 +31,10 @@ type executorConfig struct {
 	maxPaymentRetries int
 
 	cancelSwap func(ctx context.Context, details *outCancelDetails) error
+
+	loopInAlarmDelta int32
+
+	loopInCancelDelta int32
 }
 
 // executor is responsible for executing swaps.
@@ -153,6 +157,8 @@ func (s *executor) run(mainCtx context.Context,
 					totalPaymentTimout: s.executorConfig.totalPaymentTimeout,
 					maxPaymentRetries:  s.executorConfig.maxPaymentRetries,
 					cancelSwap:         s.executorConfig.cancelSwap,
+					loopInAlarmDelta:   s.executorConfig.loopInAlarmDelta,
+					loopInCancelDelta:  s.executorConfig.loopInCancelDelta,
 				}, height)
 				if err != nil && err != context.Canceled {
 					log.Errorf("Execute error: %v", err)
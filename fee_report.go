@@ -0,0 +1,222 @@
+package loop
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// onChainSendWeight is the weight of a plain on-chain send of a loop
+	// out's amount, which we assume spends a single p2wkh output to a
+	// p2wkh destination and returns change to a p2wkh output.
+	onChainSendWeight = int64(
+		(&input.TxWeightEstimator{}).
+			AddP2WKHInput().
+			AddP2WKHOutput().
+			AddP2WKHOutput().
+			Weight(),
+	)
+
+	// coopCloseWeight is the weight of cooperatively closing a channel,
+	// which we assume pays out to a p2wkh output on each side.
+	coopCloseWeight = int64(
+		(&input.TxWeightEstimator{}).
+			AddWitnessInput(input.MultiSigWitnessSize).
+			AddP2WKHOutput().
+			AddP2WKHOutput().
+			Weight(),
+	)
+
+	// channelOpenWeight is the weight of funding a channel, which we
+	// assume spends a single p2wkh output and returns change.
+	channelOpenWeight = int64(
+		(&input.TxWeightEstimator{}).
+			AddP2WKHInput().
+			AddP2WSHOutput().
+			AddP2WKHOutput().
+			Weight(),
+	)
+
+	// closeReopenWeight is the total weight of closing a channel and
+	// opening a new one, which is the on-chain alternative to shifting
+	// balance out of a channel with a loop out.
+	closeReopenWeight = coopCloseWeight + channelOpenWeight
+)
+
+// LoopOutFeeComparison compares the cost of a completed loop out to the cost
+// of on-chain alternatives. The cost of the alternatives is estimated with the
+// fee rate that the swap's sweep paid, which reflects on-chain fees at the
+// time of the swap.
+type LoopOutFeeComparison struct {
+	// SwapHash is the hash of the loop out.
+	SwapHash lntypes.Hash
+
+	// Amount is the amount of the loop out.
+	Amount btcutil.Amount
+
+	// CompletedAt is the time at which the loop out completed.
+	CompletedAt time.Time
+
+	// FeeRate is the fee rate that the loop out's sweep paid.
+	FeeRate chainfee.SatPerKWeight
+
+	// LoopCost is the total cost of the loop out, including its server,
+	// on-chain and off-chain fees.
+	LoopCost btcutil.Amount
+
+	// OnChainCost is the estimated fee for a plain on-chain send of the
+	// loop out's amount.
+	OnChainCost btcutil.Amount
+
+	// CloseReopenCost is the estimated fee for closing a channel and
+	// opening a new one instead of looping out.
+	CloseReopenCost btcutil.Amount
+}
+
+// FeeReport compares the costs of our completed loop outs to the costs of
+// on-chain alternatives.
+type FeeReport struct {
+	// Swaps holds a comparison for each completed loop out, ordered by
+	// completion time.
+	Swaps []*LoopOutFeeComparison
+
+	// TotalLoopCost is the total cost of all of the loop outs in the
+	// report.
+	TotalLoopCost btcutil.Amount
+
+	// TotalOnChainCost is the total estimated cost of plain on-chain sends
+	// for all of the loop outs in the report.
+	TotalOnChainCost btcutil.Amount
+
+	// TotalCloseReopenCost is the total estimated cost of closing and
+	// reopening a channel for all of the loop outs in the report.
+	TotalCloseReopenCost btcutil.Amount
+
+	// Skipped is the number of completed loop outs that are not included
+	// in the report because their on-chain cost was not recorded, so we
+	// do not know the fee rate at the time of the swap.
+	Skipped int
+}
+
+// FeeReport returns a report that compares the cost of each of our
+// successful loop outs to what a plain on-chain send, and closing and
+// reopening a channel, would have cost at the time of the swap.
+func (s *Client) FeeReport(ctx context.Context) (*FeeReport, error) {
+	loopOutSwaps, err := s.Store.FetchLoopOutSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return newFeeReport(loopOutSwaps, s.lndServices.ChainParams)
+}
+
+// newFeeReport creates a fee report for the successful loop outs in the set
+// of swaps provided.
+func newFeeReport(loopOutSwaps []*loopdb.LoopOut,
+	chainParams *chaincfg.Params) (*FeeReport, error) {
+
+	report := &FeeReport{}
+
+	for _, out := range loopOutSwaps {
+		state := out.State()
+		if state.State != loopdb.StateSuccess {
+			continue
+		}
+
+		if state.Cost.Onchain == 0 {
+			report.Skipped++
+			continue
+		}
+
+		weight, err := sweepWeight(out, chainParams)
+		if err != nil {
+			return nil, fmt.Errorf("swap %v: %v", out.Hash, err)
+		}
+
+		// We calculate the fee rate that our sweep paid in sat/kw,
+		// which is the same unit that lnd's fee estimates use.
+		feeRate := chainfee.SatPerKWeight(
+			state.Cost.Onchain * 1000 / btcutil.Amount(weight),
+		)
+
+		var (
+			onChain     = feeRate.FeeForWeight(onChainSendWeight)
+			closeReopen = feeRate.FeeForWeight(closeReopenWeight)
+		)
+
+		comparison := &LoopOutFeeComparison{
+			SwapHash:        out.Hash,
+			Amount:          out.Contract.AmountRequested,
+			CompletedAt:     out.LastUpdateTime(),
+			FeeRate:         feeRate,
+			LoopCost:        state.Cost.Total(),
+			OnChainCost:     onChain,
+			CloseReopenCost: closeReopen,
+		}
+
+		report.Swaps = append(report.Swaps, comparison)
+		report.TotalLoopCost += comparison.LoopCost
+		report.TotalOnChainCost += comparison.OnChainCost
+		report.TotalCloseReopenCost += comparison.CloseReopenCost
+	}
+
+	sort.SliceStable(report.Swaps, func(i, j int) bool {
+		return report.Swaps[i].CompletedAt.Before(
+			report.Swaps[j].CompletedAt,
+		)
+	})
+
+	return report, nil
+}
+
+// sweepWeight returns the estimated weight of the transaction that swept a
+// loop out's htlc to its destination address.
+func sweepWeight(out *loopdb.LoopOut, chainParams *chaincfg.Params) (int64,
+	error) {
+
+	htlc, err := swap.NewHtlc(
+		GetHtlcScriptVersion(out.Contract.ProtocolVersion),
+		out.Contract.CltvExpiry, out.Contract.SenderKey,
+		out.Contract.ReceiverKey, out.Hash, swap.HtlcP2WSH, chainParams,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var weightEstimate input.TxWeightEstimator
+	switch out.Contract.DestAddr.(type) {
+	case *btcutil.AddressWitnessScriptHash:
+		weightEstimate.AddP2WSHOutput()
+
+	case *btcutil.AddressWitnessPubKeyHash:
+		weightEstimate.AddP2WKHOutput()
+
+	case *btcutil.AddressScriptHash:
+		weightEstimate.AddP2SHOutput()
+
+	case *btcutil.AddressPubKeyHash:
+		weightEstimate.AddP2PKHOutput()
+
+	default:
+		return 0, fmt.Errorf("unknown address type %T",
+			out.Contract.DestAddr)
+	}
+
+	htlc.AddSuccessToEstimator(&weightEstimate)
+
+	return int64(weightEstimate.Weight()), nil
+}
@@ -0,0 +1,129 @@
+package loop
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFeeReport tests comparison of the costs of completed loop outs to the
+// costs of on-chain alternatives.
+func TestFeeReport(t *testing.T) {
+	chainParams := &chaincfg.TestNet3Params
+
+	destAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+		make([]byte, 20), chainParams,
+	)
+	require.NoError(t, err)
+
+	newLoopOut := func(hash lntypes.Hash, completed time.Time,
+		state loopdb.SwapState, cost loopdb.SwapCost) *loopdb.LoopOut {
+
+		event := &loopdb.LoopEvent{
+			SwapStateData: loopdb.SwapStateData{
+				State: state,
+				Cost:  cost,
+			},
+			Time: completed,
+		}
+
+		contract := loopdb.SwapContract{
+			AmountRequested: 100_000,
+			ProtocolVersion: loopdb.ProtocolVersionHtlcV2,
+		}
+
+		return &loopdb.LoopOut{
+			Loop: loopdb.Loop{
+				Hash:   hash,
+				Events: []*loopdb.LoopEvent{event},
+			},
+			Contract: &loopdb.LoopOutContract{
+				SwapContract: contract,
+				DestAddr:     destAddr,
+			},
+		}
+	}
+
+	var (
+		hash1 = lntypes.Hash{1}
+		hash2 = lntypes.Hash{2}
+
+		time1 = time.Unix(1000, 0)
+		time2 = time.Unix(2000, 0)
+	)
+
+	// We set our first swap's on-chain cost to the weight of its sweep,
+	// so that it paid 1000 sat/kw, and our second swap's cost to double
+	// that.
+	weight, err := sweepWeight(
+		newLoopOut(
+			hash1, time1, loopdb.StateSuccess, loopdb.SwapCost{},
+		), chainParams,
+	)
+	require.NoError(t, err)
+
+	cost1 := loopdb.SwapCost{
+		Server:   100,
+		Onchain:  btcutil.Amount(weight),
+		Offchain: 10,
+	}
+	cost2 := loopdb.SwapCost{
+		Server:  100,
+		Onchain: btcutil.Amount(weight * 2),
+	}
+
+	swaps := []*loopdb.LoopOut{
+		newLoopOut(hash2, time2, loopdb.StateSuccess, cost2),
+		newLoopOut(hash1, time1, loopdb.StateSuccess, cost1),
+
+		// Failed swaps are not included in our report, and successful
+		// swaps without an on-chain cost are skipped.
+		newLoopOut(
+			lntypes.Hash{3}, time1, loopdb.StateFailTimeout, cost1,
+		),
+		newLoopOut(
+			lntypes.Hash{4}, time1, loopdb.StateSuccess,
+			loopdb.SwapCost{},
+		),
+	}
+
+	report, err := newFeeReport(swaps, chainParams)
+	require.NoError(t, err)
+
+	var (
+		onChain     = btcutil.Amount(onChainSendWeight)
+		closeReopen = btcutil.Amount(closeReopenWeight)
+	)
+
+	require.Equal(t, &FeeReport{
+		Swaps: []*LoopOutFeeComparison{
+			{
+				SwapHash:        hash1,
+				Amount:          100_000,
+				CompletedAt:     time1,
+				FeeRate:         1000,
+				LoopCost:        cost1.Total(),
+				OnChainCost:     onChain,
+				CloseReopenCost: closeReopen,
+			},
+			{
+				SwapHash:        hash2,
+				Amount:          100_000,
+				CompletedAt:     time2,
+				FeeRate:         2000,
+				LoopCost:        cost2.Total(),
+				OnChainCost:     onChain * 2,
+				CloseReopenCost: closeReopen * 2,
+			},
+		},
+		TotalLoopCost:        cost1.Total() + cost2.Total(),
+		TotalOnChainCost:     onChain * 3,
+		TotalCloseReopenCost: closeReopen * 3,
+		Skipped:              1,
+	}, report)
+}
@@ -61,6 +61,14 @@ type OutRequest struct {
 	// LoopOutQuote call.
 	MaxMinerFee btcutil.Amount
 
+	// MaxTotalCost is an optional ceiling on the total cost of the swap,
+	// which covers the swap fee (including the prepay), the routing fees
+	// of our off-chain payments and the sweep fee. The routing and sweep
+	// fee limits are lowered if required to stay within the ceiling, and
+	// the swap is aborted before paying the server if it cannot be met.
+	// A zero value sets no ceiling.
+	MaxTotalCost btcutil.Amount
+
 	// SweepConfTarget specifies the targeted confirmation target for the
 	// client sweep tx.
 	SweepConfTarget int32
@@ -87,6 +95,23 @@ type OutRequest struct {
 	// initiated the swap (loop CLI, autolooper, LiT UI and so on) and is
 	// appended to the user agent string.
 	Initiator string
+
+	// SwapPaymentDest is an optional node pubkey that the server's swap
+	// and prepay invoices must pay to, typically taken from the response
+	// of the LoopOutQuote call. If it is not set, the invoices are only
+	// required to pay to the same node.
+	SwapPaymentDest route.Vertex
+
+	// CustomRecords is an optional set of custom tlv records, keyed by
+	// record type, that are attached to the swap and prepay payments so
+	// that they can be correlated with the swap downstream. Types must be
+	// in the custom record range, and the total size of the values may
+	// not exceed MaxCustomRecordsSize.
+	CustomRecords map[uint64][]byte
+
+	// DestVerification optionally records how DestAddr was verified to be
+	// owned by the user. It is stored with the swap.
+	DestVerification *loopdb.DestVerification
 }
 
 // Out contains the full details of a loop out request. This includes things
@@ -188,6 +213,12 @@ type LoopInRequest struct {
 	// call.
 	MaxMinerFee btcutil.Amount
 
+	// MaxTotalCost is an optional ceiling on the total cost of the swap,
+	// which covers the swap fee and the fee for publishing the htlc. The
+	// swap is aborted before the htlc is published if the ceiling cannot
+	// be met. A zero value sets no ceiling.
+	MaxTotalCost btcutil.Amount
+
 	// HtlcConfTarget specifies the targeted confirmation target for the
 	// client htlc tx.
 	HtlcConfTarget int32
@@ -200,6 +231,12 @@ type LoopInRequest struct {
 	// source.
 	ExternalHtlc bool
 
+	// ChangeTolerance optionally allows the swap amount to be adjusted by
+	// up to this amount so that the transaction that funds the htlc does
+	// not have a change output. If no amount within the tolerance avoids
+	// change, the swap fails. It may not be set for external htlcs.
+	ChangeTolerance btcutil.Amount
+
 	// Label contains an optional label for the swap.
 	Label string
 
@@ -316,6 +353,24 @@ type LoopOutSwapInfo struct { // nolint:golint
 	// ServerMessages is the human-readable message received from the loop
 	// server.
 	ServerMessage string
+
+	// HtlcValue is the value of the on-chain htlc output that the server
+	// will publish.
+	HtlcValue btcutil.Amount
+
+	// SwapInvoiceAmount is the amount of the swap invoice that we pay to
+	// the server.
+	SwapInvoiceAmount btcutil.Amount
+
+	// PrepayAmount is the amount of the prepay invoice that we pay to the
+	// server.
+	PrepayAmount btcutil.Amount
+
+	// ExpectedSweepValue is the value we expect to receive in our sweep
+	// of the htlc, based on the fee estimate at initiation time. The
+	// actual value depends on fee conditions when the htlc is swept, but
+	// will not be less than the htlc value minus our maximum miner fee.
+	ExpectedSweepValue btcutil.Amount
 }
 
 // SwapInfoKit contains common swap info fields.
@@ -353,6 +408,14 @@ type SwapInfo struct {
 
 	// ExternalHtlc is set to true for external loop-in swaps.
 	ExternalHtlc bool
+
+	// InvoiceHoldAlarm is set for loop in swaps when the server has not
+	// paid our swap invoice by the time our htlc approaches expiry.
+	InvoiceHoldAlarm bool
+
+	// DestVerification records how the custom destination of a loop out
+	// was verified to be owned by the user, if it was.
+	DestVerification *loopdb.DestVerification
 }
 
 // LastUpdate returns the last update time of the swap
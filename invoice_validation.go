@@ -0,0 +1,130 @@
+package loop
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const (
+	// minInvoiceExpiry is the minimum amount of time that we require a
+	// server invoice to remain valid for when we receive it, so that we
+	// have time to pay it.
+	minInvoiceExpiry = time.Minute
+)
+
+// The invoices that the server provides for a loop out.
+const (
+	invoiceSwap   = "swap"
+	invoicePrepay = "prepay"
+)
+
+var (
+	// ErrInvoiceMismatch is returned when an invoice provided by the server
+	// does not match the swap that we negotiated. All InvoiceMismatchErrors
+	// match this error with errors.Is.
+	ErrInvoiceMismatch = errors.New("server invoice does not match swap")
+)
+
+// InvoiceMismatchError describes a field of an invoice provided by the server
+// that does not match the swap that we negotiated. We refuse to pay invoices
+// that diverge from our swap, so that a buggy or malicious server cannot get
+// us to pay more than we agreed to.
+type InvoiceMismatchError struct {
+	// Invoice is the invoice that failed validation, either the swap or
+	// the prepay invoice.
+	Invoice string
+
+	// Field is the field of the invoice that failed validation.
+	Field string
+
+	// Expected describes the value that we expected.
+	Expected string
+
+	// Actual is the value that the server provided.
+	Actual string
+}
+
+// Error returns a string describing the mismatch.
+func (e *InvoiceMismatchError) Error() string {
+	return fmt.Sprintf("%v invoice %v: expected %v, got %v", e.Invoice,
+		e.Field, e.Expected, e.Actual)
+}
+
+// Is returns true if the target is ErrInvoiceMismatch, so that all mismatch
+// errors can be matched with errors.Is.
+func (e *InvoiceMismatchError) Is(target error) bool {
+	return target == ErrInvoiceMismatch
+}
+
+// serverInvoice contains the fields of an invoice provided by the server that
+// we validate.
+type serverInvoice struct {
+	destination route.Vertex
+	hash        lntypes.Hash
+	amount      btcutil.Amount
+	expiry      time.Time
+	cltvDelta   uint64
+}
+
+// decodeServerInvoice decodes an invoice provided by the server.
+func decodeServerInvoice(params *chaincfg.Params,
+	payReq string) (*serverInvoice, error) {
+
+	invoice, err := zpay32.Decode(payReq, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice.MilliSat == nil {
+		return nil, errors.New("no amount in invoice")
+	}
+
+	decoded := &serverInvoice{
+		amount:    invoice.MilliSat.ToSatoshis(),
+		expiry:    invoice.Timestamp.Add(invoice.Expiry()),
+		cltvDelta: invoice.MinFinalCLTVExpiry(),
+	}
+	copy(decoded.hash[:], invoice.PaymentHash[:])
+	copy(
+		decoded.destination[:],
+		invoice.Destination.SerializeCompressed(),
+	)
+
+	return decoded, nil
+}
+
+// validateServerInvoice checks that an invoice provided by the server can
+// still be paid at the time provided, and does not lock our payment for longer
+// than our on-chain htlc's expiry delta. A zero or negative delta skips the
+// latter check.
+func validateServerInvoice(name string, invoice *serverInvoice,
+	now time.Time, expiryDelta int32) error {
+
+	if invoice.expiry.Before(now.Add(minInvoiceExpiry)) {
+		return &InvoiceMismatchError{
+			Invoice: name,
+			Field:   "expiry",
+			Expected: fmt.Sprintf("after %v",
+				now.Add(minInvoiceExpiry)),
+			Actual: invoice.expiry.String(),
+		}
+	}
+
+	if expiryDelta > 0 && invoice.cltvDelta > uint64(expiryDelta) {
+		return &InvoiceMismatchError{
+			Invoice:  name,
+			Field:    "final cltv delta",
+			Expected: fmt.Sprintf("at most %v", expiryDelta),
+			Actual:   fmt.Sprintf("%v", invoice.cltvDelta),
+		}
+	}
+
+	return nil
+}
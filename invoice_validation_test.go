@@ -0,0 +1,220 @@
+package loop
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidateLoopOutContract tests validation of the invoices that the server
+// provides for a loop out against our request.
+func TestValidateLoopOutContract(t *testing.T) {
+	const (
+		height      = int32(600)
+		swapAmt     = btcutil.Amount(50000)
+		prepayAmt   = btcutil.Amount(100)
+		swapFee     = btcutil.Amount(1000)
+		defaultCLTV = 40
+	)
+
+	var (
+		now       = time.Now()
+		swapHash  = lntypes.Hash{1, 2, 3}
+		otherHash = lntypes.Hash{3, 2, 1}
+	)
+
+	// The test invoices are signed with a fixed key, which is the
+	// destination that they decode with.
+	_, serverKey := test.CreateKey(5)
+	var serverDest route.Vertex
+	copy(serverDest[:], serverKey.SerializeCompressed())
+
+	_, otherKey := test.CreateKey(6)
+	var otherDest route.Vertex
+	copy(otherDest[:], otherKey.SerializeCompressed())
+
+	invoice := func(t *testing.T, hash lntypes.Hash, amt btcutil.Amount,
+		timestamp time.Time, cltvDelta uint64) string {
+
+		req, err := zpay32.NewInvoice(
+			&chaincfg.TestNet3Params, hash, timestamp,
+			zpay32.Description("invoice"),
+			zpay32.Amount(lnwire.NewMSatFromSatoshis(amt)),
+			zpay32.CLTVExpiry(cltvDelta),
+		)
+		require.NoError(t, err)
+
+		payReq, err := test.EncodePayReq(req)
+		require.NoError(t, err)
+
+		return payReq
+	}
+
+	request := func() *OutRequest {
+		return &OutRequest{
+			Amount:          swapAmt,
+			MaxSwapFee:      swapFee + prepayAmt,
+			MaxPrepayAmount: prepayAmt,
+			Expiry:          height + defaultCLTV,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		request       *OutRequest
+		swapInvoice   string
+		prepayInvoice string
+		err           error
+	}{
+		{
+			name:    "valid invoices",
+			request: request(),
+			swapInvoice: invoice(
+				t, swapHash, swapAmt+swapFee, now, 18,
+			),
+			prepayInvoice: invoice(
+				t, otherHash, prepayAmt, now, 18,
+			),
+		},
+		{
+			name: "valid quoted destination",
+			request: func() *OutRequest {
+				req := request()
+				req.SwapPaymentDest = serverDest
+				return req
+			}(),
+			swapInvoice: invoice(
+				t, swapHash, swapAmt+swapFee, now, 18,
+			),
+			prepayInvoice: invoice(
+				t, otherHash, prepayAmt, now, 18,
+			),
+		},
+		{
+			name:    "wrong payment hash",
+			request: request(),
+			swapInvoice: invoice(
+				t, otherHash, swapAmt+swapFee, now, 18,
+			),
+			prepayInvoice: invoice(
+				t, otherHash, prepayAmt, now, 18,
+			),
+			err: &InvoiceMismatchError{
+				Invoice:  invoiceSwap,
+				Field:    "payment hash",
+				Expected: swapHash.String(),
+				Actual:   otherHash.String(),
+			},
+		},
+		{
+			name: "destination differs from quote",
+			request: func() *OutRequest {
+				req := request()
+				req.SwapPaymentDest = otherDest
+				return req
+			}(),
+			swapInvoice: invoice(
+				t, swapHash, swapAmt+swapFee, now, 18,
+			),
+			prepayInvoice: invoice(
+				t, otherHash, prepayAmt, now, 18,
+			),
+			err: &InvoiceMismatchError{
+				Invoice:  invoiceSwap,
+				Field:    "destination",
+				Expected: otherDest.String(),
+				Actual:   serverDest.String(),
+			},
+		},
+		{
+			name:    "swap fee too high",
+			request: request(),
+			swapInvoice: invoice(
+				t, swapHash, swapAmt+swapFee+1, now, 18,
+			),
+			prepayInvoice: invoice(
+				t, otherHash, prepayAmt, now, 18,
+			),
+			err: ErrSwapFeeTooHigh,
+		},
+		{
+			name:    "expired prepay invoice",
+			request: request(),
+			swapInvoice: invoice(
+				t, swapHash, swapAmt+swapFee, now, 18,
+			),
+			prepayInvoice: invoice(
+				t, otherHash, prepayAmt,
+				now.Add(-time.Hour), 18,
+			),
+			err: &InvoiceMismatchError{
+				Invoice: invoicePrepay,
+				Field:   "expiry",
+			},
+		},
+		{
+			name:    "cltv delta exceeds htlc expiry",
+			request: request(),
+			swapInvoice: invoice(
+				t, swapHash, swapAmt+swapFee, now,
+				defaultCLTV+1,
+			),
+			prepayInvoice: invoice(
+				t, otherHash, prepayAmt, now, 18,
+			),
+			err: &InvoiceMismatchError{
+				Invoice:  invoiceSwap,
+				Field:    "final cltv delta",
+				Expected: "at most 40",
+				Actual:   "41",
+			},
+		},
+	}
+
+	lnd := test.NewMockLnd()
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			response := &newLoopOutResponse{
+				swapInvoice:   testCase.swapInvoice,
+				prepayInvoice: testCase.prepayInvoice,
+			}
+
+			swapAmount, prepayAmount, err := validateLoopOutContract(
+				&lnd.LndServices, height, now, testCase.request,
+				swapHash, response,
+			)
+
+			mismatch, ok := testCase.err.(*InvoiceMismatchError)
+			switch {
+			case testCase.err == nil:
+				require.NoError(t, err)
+				require.Equal(t, swapAmt+swapFee, swapAmount)
+				require.Equal(t, prepayAmt, prepayAmount)
+
+			// We don't know the exact expiry that our invoices
+			// decode with, so we only check the invoice and field
+			// of expiry mismatches.
+			case ok && mismatch.Field == "expiry":
+				require.ErrorIs(t, err, ErrInvoiceMismatch)
+
+				actual := err.(*InvoiceMismatchError)
+				require.Equal(t, mismatch.Invoice, actual.Invoice)
+				require.Equal(t, mismatch.Field, actual.Field)
+
+			default:
+				require.Equal(t, testCase.err, err)
+			}
+		})
+	}
+}
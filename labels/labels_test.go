@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/stretchr/testify/require"
 )
 
@@ -51,3 +52,44 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+// TestLndLabels tests that the labels we set on transactions in lnd contain
+// the full swap hash and fit within lnd's label limit.
+func TestLndLabels(t *testing.T) {
+	hash := lntypes.Hash{1, 2, 3}
+
+	tests := []struct {
+		label    string
+		expected string
+	}{
+		{
+			label:    LoopOutSweepSuccess(hash),
+			expected: "loopd -- OutSweepSuccess(swap=%v)",
+		},
+		{
+			label:    LoopInHtlcLabel(hash),
+			expected: "loopd -- InHtlc(swap=%v)",
+		},
+		{
+			label:    LoopInSweepTimeout(hash),
+			expected: "loopd -- InSweepTimeout(swap=%v)",
+		},
+		{
+			label:    RecoveryDrillHtlc(hash),
+			expected: "loopd -- DrillHtlc(swap=%v)",
+		},
+		{
+			label:    RecoveryDrillSweepTimeout(hash),
+			expected: "loopd -- DrillSweepTimeout(swap=%v)",
+		},
+		{
+			label:    RecoveryDrillSweepSuccess(hash),
+			expected: "loopd -- DrillSweepSuccess(swap=%v)",
+		},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, fmt.Sprintf(test.expected, hash), test.label)
+		require.LessOrEqual(t, len(test.label), MaxLength)
+	}
+}
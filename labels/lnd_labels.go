@@ -1,10 +1,16 @@
 package labels
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+)
 
 const (
 	// loopdLabelPattern is the pattern that loop uses to label on-chain
-	// transactions in the lnd backend.
+	// transactions in the lnd backend. The label contains the type of the
+	// transaction and the full hash of the swap that it belongs to, so that
+	// transactions can be matched to swaps without consulting loopd.
 	loopdLabelPattern = "loopd -- %s(swap=%s)"
 
 	// loopOutSweepSuccess is the label used for loop out swaps to sweep
@@ -17,21 +23,51 @@ const (
 	// loopInTimeout is the label used for loop in swaps to sweep an HTLC
 	// that has timed out.
 	loopInSweepTimeout = "InSweepTimeout"
+
+	// recoveryDrillHtlc is the label used to fund the htlcs of a
+	// recovery drill.
+	recoveryDrillHtlc = "DrillHtlc"
+
+	// recoveryDrillTimeout is the label used to sweep the timeout htlc of
+	// a recovery drill.
+	recoveryDrillTimeout = "DrillSweepTimeout"
+
+	// recoveryDrillSuccess is the label used to sweep the success htlc of
+	// a recovery drill.
+	recoveryDrillSuccess = "DrillSweepSuccess"
 )
 
 // LoopOutSweepSuccess returns the label used for loop out swaps to sweep the
 // HTLC in the success case.
-func LoopOutSweepSuccess(swapHash string) string {
+func LoopOutSweepSuccess(swapHash lntypes.Hash) string {
 	return fmt.Sprintf(loopdLabelPattern, loopOutSweepSuccess, swapHash)
 }
 
 // LoopInHtlcLabel returns the label used for loop in swaps to publish an HTLC.
-func LoopInHtlcLabel(swapHash string) string {
+func LoopInHtlcLabel(swapHash lntypes.Hash) string {
 	return fmt.Sprintf(loopdLabelPattern, loopInHtlc, swapHash)
 }
 
 // LoopInSweepTimeout returns the label used for loop in swaps to sweep an HTLC
 // that has timed out.
-func LoopInSweepTimeout(swapHash string) string {
+func LoopInSweepTimeout(swapHash lntypes.Hash) string {
 	return fmt.Sprintf(loopdLabelPattern, loopInSweepTimeout, swapHash)
 }
+
+// RecoveryDrillHtlc returns the label used for the transaction that funds the
+// htlcs of a recovery drill.
+func RecoveryDrillHtlc(drillHash lntypes.Hash) string {
+	return fmt.Sprintf(loopdLabelPattern, recoveryDrillHtlc, drillHash)
+}
+
+// RecoveryDrillSweepTimeout returns the label used to sweep the timeout htlc
+// of a recovery drill.
+func RecoveryDrillSweepTimeout(drillHash lntypes.Hash) string {
+	return fmt.Sprintf(loopdLabelPattern, recoveryDrillTimeout, drillHash)
+}
+
+// RecoveryDrillSweepSuccess returns the label used to sweep the success htlc
+// of a recovery drill.
+func RecoveryDrillSweepSuccess(drillHash lntypes.Hash) string {
+	return fmt.Sprintf(loopdLabelPattern, recoveryDrillSuccess, drillHash)
+}
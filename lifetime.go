@@ -0,0 +1,258 @@
+package loop
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// lifetimeCheckInterval is the interval at which we check whether any
+	// of our pending swaps have exceeded their maximum lifetime.
+	lifetimeCheckInterval = time.Minute * 10
+
+	// postMortemErrors is the number of the most recent failures in a
+	// swap's timeline that we include in its post-mortem.
+	postMortemErrors = 3
+)
+
+// PostMortem is a report on a swap that is still pending after its maximum
+// lifetime has passed.
+type PostMortem struct {
+	// Swap is the swap's contract and state at the time it was flagged.
+	Swap *SwapInfo
+
+	// CreatedAt is the time at which the swap was flagged.
+	CreatedAt time.Time
+
+	// Age is the amount of time that the swap had been pending for when
+	// it was flagged.
+	Age time.Duration
+
+	// MaxLifetime is the maximum lifetime for the swap's type that it
+	// exceeded.
+	MaxLifetime time.Duration
+
+	// Replay is the swap's timeline, which includes its state updates,
+	// its payment attempts and the status of its htlc on chain. It is nil
+	// if the swap could not be replayed.
+	Replay *SwapReplay
+
+	// ReplayError is the error that we failed to replay the swap with, if
+	// any.
+	ReplayError string
+
+	// LastErrors holds the most recent failures in the swap's timeline,
+	// ordered by the time at which they occurred.
+	LastErrors []*ReplayEvent
+}
+
+// BlocksUntilExpiry returns the number of blocks until the swap's htlc
+// expires, which is negative if it has already expired. It is zero if the
+// swap could not be replayed.
+func (p *PostMortem) BlocksUntilExpiry() int32 {
+	if p.Replay == nil {
+		return 0
+	}
+
+	return p.Swap.CltvExpiry - p.Replay.CurrentHeight
+}
+
+// String returns a summary of a post-mortem.
+func (p *PostMortem) String() string {
+	str := fmt.Sprintf("%v swap %v of %v pending for %v in state %v, "+
+		"exceeding its maximum lifetime of %v", p.Swap.SwapType,
+		p.Swap.SwapHash, p.Swap.AmountRequested,
+		p.Age.Round(time.Minute), p.Swap.State, p.MaxLifetime)
+
+	if p.Replay == nil {
+		return str + fmt.Sprintf(", replay failed: %v", p.ReplayError)
+	}
+
+	str += fmt.Sprintf(", htlc expires in %v blocks",
+		p.BlocksUntilExpiry())
+
+	if len(p.LastErrors) > 0 {
+		str += fmt.Sprintf(", last error: %v",
+			p.LastErrors[len(p.LastErrors)-1].Description)
+	}
+
+	return str
+}
+
+// lastErrors returns the most recent failures in a swap's timeline.
+func lastErrors(replay *SwapReplay) []*ReplayEvent {
+	var failures []*ReplayEvent
+	for _, event := range replay.Events {
+		if event.Failure {
+			failures = append(failures, event)
+		}
+	}
+
+	if len(failures) > postMortemErrors {
+		failures = failures[len(failures)-postMortemErrors:]
+	}
+
+	return failures
+}
+
+// LifetimeConfig provides the functionality that a lifetime checker requires.
+type LifetimeConfig struct {
+	// MaxLifetime is the maximum amount of time that swaps of each type
+	// may be pending for before they are flagged. Swap types that are not
+	// present are never flagged.
+	MaxLifetime map[swap.Type]time.Duration
+
+	// FetchSwaps returns all of our swaps.
+	FetchSwaps func() ([]*SwapInfo, error)
+
+	// ReplaySwap reconstructs the timeline of a swap.
+	ReplaySwap func(ctx context.Context, hash lntypes.Hash) (*SwapReplay,
+		error)
+
+	// Notify is called with the post-mortem of each swap that we flag.
+	Notify func(ctx context.Context, postMortem *PostMortem)
+
+	// Clock allows easy mocking of time in unit tests.
+	Clock clock.Clock
+}
+
+// LifetimeChecker periodically flags swaps that are still pending after the
+// maximum lifetime for their type, so that swaps do not silently linger. Each
+// flagged swap gets a post-mortem report and a notification.
+type LifetimeChecker struct {
+	cfg *LifetimeConfig
+
+	// postMortems holds the post-mortems of the swaps that we have
+	// flagged since we started, keyed by swap hash.
+	postMortems map[lntypes.Hash]*PostMortem
+
+	mu sync.Mutex
+}
+
+// NewLifetimeChecker returns a lifetime checker with the config provided.
+func NewLifetimeChecker(cfg *LifetimeConfig) *LifetimeChecker {
+	return &LifetimeChecker{
+		cfg:         cfg,
+		postMortems: make(map[lntypes.Hash]*PostMortem),
+	}
+}
+
+// Run checks our swaps' lifetimes immediately, and then on every interval
+// until the context provided is canceled. Failed checks are logged and
+// retried on the next interval.
+func (l *LifetimeChecker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(lifetimeCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := l.check(ctx); err != nil && ctx.Err() == nil {
+			log.Errorf("Swap lifetime check failed: %v", err)
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// check flags each pending swap that has exceeded the maximum lifetime for
+// its type and has not been flagged yet.
+func (l *LifetimeChecker) check(ctx context.Context) error {
+	swaps, err := l.cfg.FetchSwaps()
+	if err != nil {
+		return err
+	}
+
+	now := l.cfg.Clock.Now()
+
+	for _, swp := range swaps {
+		if swp.State.Type() != loopdb.StateTypePending {
+			continue
+		}
+
+		maxLifetime, ok := l.cfg.MaxLifetime[swp.SwapType]
+		if !ok || maxLifetime == 0 {
+			continue
+		}
+
+		age := now.Sub(swp.InitiationTime)
+		if age <= maxLifetime || l.Flagged(swp.SwapHash) {
+			continue
+		}
+
+		postMortem := &PostMortem{
+			Swap:        swp,
+			CreatedAt:   now,
+			Age:         age,
+			MaxLifetime: maxLifetime,
+		}
+
+		replay, err := l.cfg.ReplaySwap(ctx, swp.SwapHash)
+		if err != nil {
+			postMortem.ReplayError = err.Error()
+		} else {
+			postMortem.Replay = replay
+			postMortem.LastErrors = lastErrors(replay)
+		}
+
+		log.Warnf("Swap lifetime exceeded: %v", postMortem)
+
+		l.mu.Lock()
+		l.postMortems[swp.SwapHash] = postMortem
+		l.mu.Unlock()
+
+		if l.cfg.Notify != nil {
+			l.cfg.Notify(ctx, postMortem)
+		}
+	}
+
+	return nil
+}
+
+// Flagged returns a boolean indicating whether the swap with the hash
+// provided has exceeded its maximum lifetime.
+func (l *LifetimeChecker) Flagged(hash lntypes.Hash) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, ok := l.postMortems[hash]
+	return ok
+}
+
+// PostMortems returns the post-mortems of the swaps that we have flagged,
+// ordered by the time at which they were flagged. Swaps that were flagged at
+// the same time are ordered by hash.
+func (l *LifetimeChecker) PostMortems() []*PostMortem {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	postMortems := make([]*PostMortem, 0, len(l.postMortems))
+	for _, postMortem := range l.postMortems {
+		postMortems = append(postMortems, postMortem)
+	}
+
+	sort.Slice(postMortems, func(i, j int) bool {
+		a, b := postMortems[i], postMortems[j]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+
+		return bytes.Compare(
+			a.Swap.SwapHash[:], b.Swap.SwapHash[:],
+		) < 0
+	})
+
+	return postMortems
+}
@@ -0,0 +1,130 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLifetimeChecker tests that we flag pending swaps that exceed the
+// maximum lifetime for their type once, and generate a post-mortem for them.
+func TestLifetimeChecker(t *testing.T) {
+	var (
+		now        = time.Unix(1_000_000, 0)
+		testClock  = clock.NewTestClock(now)
+		maxOut     = time.Hour * 24
+		errReplay  = errors.New("replay failed")
+		overdueOut = lntypes.Hash{1}
+		overdueIn  = lntypes.Hash{2}
+	)
+
+	newSwap := func(hash lntypes.Hash, swapType swap.Type,
+		state loopdb.SwapState, age time.Duration) *SwapInfo {
+
+		return &SwapInfo{
+			SwapStateData: loopdb.SwapStateData{
+				State: state,
+			},
+			SwapContract: loopdb.SwapContract{
+				InitiationTime: now.Add(-age),
+				CltvExpiry:     150,
+			},
+			SwapHash: hash,
+			SwapType: swapType,
+		}
+	}
+
+	swaps := []*SwapInfo{
+		// A loop out that has exceeded its lifetime.
+		newSwap(
+			overdueOut, swap.TypeOut, loopdb.StatePreimageRevealed,
+			maxOut+time.Minute,
+		),
+		// A loop out that is still within its lifetime.
+		newSwap(
+			lntypes.Hash{3}, swap.TypeOut, loopdb.StateInitiated,
+			maxOut-time.Minute,
+		),
+		// A completed loop out is never flagged.
+		newSwap(
+			lntypes.Hash{4}, swap.TypeOut, loopdb.StateSuccess,
+			maxOut*2,
+		),
+		// A loop in that has exceeded its lifetime, and fails to
+		// replay.
+		newSwap(
+			overdueIn, swap.TypeIn, loopdb.StateHtlcPublished,
+			time.Hour*3,
+		),
+	}
+
+	failure := &ReplayEvent{
+		Description: "payment failed",
+		Failure:     true,
+	}
+
+	var notified []*PostMortem
+	checker := NewLifetimeChecker(&LifetimeConfig{
+		MaxLifetime: map[swap.Type]time.Duration{
+			swap.TypeOut: maxOut,
+			swap.TypeIn:  time.Hour * 2,
+		},
+		FetchSwaps: func() ([]*SwapInfo, error) {
+			return swaps, nil
+		},
+		ReplaySwap: func(_ context.Context,
+			hash lntypes.Hash) (*SwapReplay, error) {
+
+			if hash == overdueIn {
+				return nil, errReplay
+			}
+
+			return &SwapReplay{
+				CurrentHeight: 100,
+				Events: []*ReplayEvent{
+					{Description: "initiated"},
+					failure,
+				},
+			}, nil
+		},
+		Notify: func(_ context.Context, postMortem *PostMortem) {
+			notified = append(notified, postMortem)
+		},
+		Clock: testClock,
+	})
+
+	ctx := context.Background()
+	require.NoError(t, checker.check(ctx))
+
+	require.Len(t, notified, 2)
+	require.Equal(t, notified, checker.PostMortems())
+
+	out := notified[0]
+	require.Equal(t, overdueOut, out.Swap.SwapHash)
+	require.Equal(t, maxOut+time.Minute, out.Age)
+	require.Equal(t, maxOut, out.MaxLifetime)
+	require.Equal(t, []*ReplayEvent{failure}, out.LastErrors)
+	require.Equal(t, int32(50), out.BlocksUntilExpiry())
+
+	in := notified[1]
+	require.Equal(t, overdueIn, in.Swap.SwapHash)
+	require.Nil(t, in.Replay)
+	require.Equal(t, errReplay.Error(), in.ReplayError)
+
+	require.True(t, checker.Flagged(overdueOut))
+	require.False(t, checker.Flagged(lntypes.Hash{3}))
+
+	// Once our second loop out exceeds its lifetime it is flagged, but
+	// swaps that have already been flagged are not flagged again.
+	testClock.SetTime(now.Add(time.Hour))
+	require.NoError(t, checker.check(ctx))
+	require.Len(t, notified, 3)
+	require.Equal(t, lntypes.Hash{3}, notified[2].Swap.SwapHash)
+}
@@ -0,0 +1,313 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// ErrApprovalNotFound is returned when a pending approval that does not
+// exist, or has expired, is approved or rejected.
+var ErrApprovalNotFound = errors.New("pending approval not found")
+
+// PendingApproval is a swap that autoloop suggested while it was in approval
+// mode, which waits to be approved or rejected before it is dispatched. Only
+// one of LoopOut, LoopIn or Rebalance is set.
+type PendingApproval struct {
+	// ID is the unique identifier of the approval.
+	ID uint64
+
+	// Created is the time at which the swap was queued.
+	Created time.Time
+
+	// Expiry is the time after which the swap may no longer be approved.
+	// It is zero if the swap does not expire.
+	Expiry time.Time
+
+	// LoopOut is the loop out that is waiting for approval.
+	LoopOut *loop.OutRequest
+
+	// LoopIn is the loop in that is waiting for approval.
+	LoopIn *loop.LoopInRequest
+
+	// Rebalance is the circular rebalance that is waiting for approval.
+	Rebalance *loop.RebalanceRequest
+
+	// note is the shrink policy note that is recorded for the swap once it
+	// is dispatched, if any.
+	note string
+
+	// dest is the sweep destination that a loop out's sweep address is
+	// derived from once it is approved, if any.
+	dest *SweepDestination
+}
+
+// target returns a key that identifies the channels or peer that an approval
+// is for, so that we do not queue more than one swap for the same target.
+func (p *PendingApproval) target() string {
+	switch {
+	case p.LoopOut != nil:
+		return fmt.Sprintf("out: %v", p.LoopOut.OutgoingChanSet)
+
+	case p.LoopIn != nil:
+		return fmt.Sprintf("in: %v", p.LoopIn.LastHop)
+
+	default:
+		return fmt.Sprintf("rebalance: %v -> %v",
+			p.Rebalance.OutgoingChannel,
+			p.Rebalance.IncomingChannel)
+	}
+}
+
+// expired returns true if the approval has expired at the time provided.
+func (p *PendingApproval) expired(now time.Time) bool {
+	return !p.Expiry.IsZero() && !now.Before(p.Expiry)
+}
+
+// approvalQueue holds the swaps that are waiting for approval, along with the
+// targets of swaps that have recently been rejected.
+type approvalQueue struct {
+	// nextID is the identifier that we assign to our next approval.
+	nextID uint64
+
+	// pending maps approval IDs to the swaps that are waiting for
+	// approval.
+	pending map[uint64]*PendingApproval
+
+	// rejected maps the targets of rejected swaps to the time until which
+	// we do not queue new swaps for them.
+	rejected map[string]time.Time
+
+	sync.Mutex
+}
+
+// newApprovalQueue creates an empty approval queue.
+func newApprovalQueue() *approvalQueue {
+	return &approvalQueue{
+		nextID:   1,
+		pending:  make(map[uint64]*PendingApproval),
+		rejected: make(map[string]time.Time),
+	}
+}
+
+// prune removes expired approvals and rejections from the queue. It must be
+// called with the queue's lock held.
+func (q *approvalQueue) prune(now time.Time) {
+	for id, approval := range q.pending {
+		if approval.expired(now) {
+			log.Infof("pending approval: %v for %v expired", id,
+				approval.target())
+
+			delete(q.pending, id)
+		}
+	}
+
+	for target, until := range q.rejected {
+		if !now.Before(until) {
+			delete(q.rejected, target)
+		}
+	}
+}
+
+// add queues a swap for approval, unless a swap is already queued for its
+// target or its target was recently rejected. It returns true if the swap was
+// queued.
+func (q *approvalQueue) add(approval *PendingApproval, now time.Time) bool {
+	q.Lock()
+	defer q.Unlock()
+
+	q.prune(now)
+
+	target := approval.target()
+	if _, ok := q.rejected[target]; ok {
+		return false
+	}
+
+	for _, pending := range q.pending {
+		if pending.target() == target {
+			return false
+		}
+	}
+
+	approval.ID = q.nextID
+	q.nextID++
+	q.pending[approval.ID] = approval
+
+	return true
+}
+
+// queueApprovals queues the swaps provided for approval rather than
+// dispatching them, returning the number of swaps that were queued.
+func (m *Manager) queueApprovals(set *dispatchSet,
+	rebalances []loop.RebalanceRequest) int {
+
+	var (
+		now    = m.cfg.Clock.Now()
+		expiry time.Time
+		queued int
+	)
+
+	if m.params.ApprovalTTL != 0 {
+		expiry = now.Add(m.params.ApprovalTTL)
+	}
+
+	queue := func(approval *PendingApproval) {
+		approval.Created = now
+		approval.Expiry = expiry
+
+		if !m.approvals.add(approval, now) {
+			return
+		}
+
+		log.Infof("queued %v for approval: %v", approval.target(),
+			approval.ID)
+
+		queued++
+	}
+
+	for i, out := range set.outSwaps {
+		out := out
+		queue(&PendingApproval{
+			LoopOut: &out,
+			note:    set.outNotes[i],
+			dest:    set.outDests[i],
+		})
+	}
+
+	for i, in := range set.inSwaps {
+		in := in
+		queue(&PendingApproval{LoopIn: &in, note: set.inNotes[i]})
+	}
+
+	for _, rebalance := range rebalances {
+		rebalance := rebalance
+		queue(&PendingApproval{Rebalance: &rebalance})
+	}
+
+	return queued
+}
+
+// ListApprovals returns the swaps that are waiting for approval, ordered by
+// the time that they were queued.
+func (m *Manager) ListApprovals() []*PendingApproval {
+	m.approvals.Lock()
+	defer m.approvals.Unlock()
+
+	m.approvals.prune(m.cfg.Clock.Now())
+
+	approvals := make([]*PendingApproval, 0, len(m.approvals.pending))
+	for _, approval := range m.approvals.pending {
+		approvals = append(approvals, approval)
+	}
+
+	sort.Slice(approvals, func(i, j int) bool {
+		return approvals[i].ID < approvals[j].ID
+	})
+
+	return approvals
+}
+
+// takeApproval removes a pending approval from our queue and returns it.
+func (m *Manager) takeApproval(id uint64) (*PendingApproval, error) {
+	m.approvals.Lock()
+	defer m.approvals.Unlock()
+
+	m.approvals.prune(m.cfg.Clock.Now())
+
+	approval, ok := m.approvals.pending[id]
+	if !ok {
+		return nil, ErrApprovalNotFound
+	}
+	delete(m.approvals.pending, id)
+
+	return approval, nil
+}
+
+// ApproveSwap dispatches the swap that is waiting for approval with the id
+// provided, returning its hash. The swap is removed from our queue even if it
+// fails to dispatch, because it will be suggested again on our next autoloop
+// tick if it is still required.
+func (m *Manager) ApproveSwap(ctx context.Context, id uint64) (lntypes.Hash,
+	error) {
+
+	approval, err := m.takeApproval(id)
+	if err != nil {
+		return lntypes.Hash{}, err
+	}
+
+	switch {
+	case approval.LoopOut != nil:
+		loopOut, err := m.dispatchLoopOut(
+			ctx, approval.LoopOut, approval.dest,
+		)
+		if err != nil {
+			return lntypes.Hash{}, err
+		}
+
+		log.Infof("approved loop out dispatched: hash: %v, "+
+			"address: %v", loopOut.SwapHash,
+			loopOut.HtlcAddressP2WSH)
+
+		m.recordShrink(swap.TypeOut, loopOut.SwapHash, approval.note)
+
+		return loopOut.SwapHash, nil
+
+	case approval.LoopIn != nil:
+		loopIn, err := m.cfg.Dispatcher.LoopIn(ctx, approval.LoopIn)
+		if err != nil {
+			return lntypes.Hash{}, err
+		}
+
+		log.Infof("approved loop in dispatched: hash: %v, "+
+			"address: %v", loopIn.SwapHash,
+			loopIn.HtlcAddressNP2WSH)
+
+		m.recordShrink(swap.TypeIn, loopIn.SwapHash, approval.note)
+
+		return loopIn.SwapHash, nil
+
+	default:
+		info, err := m.cfg.Rebalancer.Rebalance(ctx, approval.Rebalance)
+		if err != nil {
+			return lntypes.Hash{}, err
+		}
+
+		log.Infof("approved rebalance dispatched: hash: %v, "+
+			"state: %v, fee: %v", info.Hash, info.State, info.Fee)
+
+		return info.Hash, nil
+	}
+}
+
+// RejectSwap removes the swap that is waiting for approval with the id
+// provided from our queue. We do not queue swaps for the same target again
+// until our failure backoff period has passed, so that the swap is not
+// immediately suggested again.
+func (m *Manager) RejectSwap(id uint64) error {
+	approval, err := m.takeApproval(id)
+	if err != nil {
+		return err
+	}
+
+	m.paramsLock.Lock()
+	backoff := m.params.FailureBackOff
+	m.paramsLock.Unlock()
+
+	m.approvals.Lock()
+	defer m.approvals.Unlock()
+
+	until := m.cfg.Clock.Now().Add(backoff)
+	m.approvals.rejected[approval.target()] = until
+
+	log.Infof("pending approval: %v for %v rejected, not queueing swaps "+
+		"for target until %v", id, approval.target(), until)
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestApprovalQueue tests queueing of swaps for approval, and approval,
+// rejection and expiry of the swaps that are queued.
+func TestApprovalQueue(t *testing.T) {
+	cfg, _ := newTestConfig()
+
+	testClock := clock.NewTestClock(testTime)
+	cfg.Clock = testClock
+
+	var (
+		ctx        = context.Background()
+		swapHash   = lntypes.Hash{1, 2, 3}
+		dispatched []*loop.OutRequest
+	)
+
+	cfg.Dispatcher = &testDispatcher{
+		loopOut: func(_ context.Context,
+			request *loop.OutRequest) (
+			*loop.LoopOutSwapInfo, error) {
+
+			dispatched = append(dispatched, request)
+
+			return &loop.LoopOutSwapInfo{
+				SwapHash: swapHash,
+			}, nil
+		},
+	}
+
+	manager := NewManager(cfg)
+	manager.params.FailureBackOff = time.Hour
+	manager.params.ApprovalTTL = time.Hour * 2
+
+	rebalance := loop.RebalanceRequest{
+		Amount:          1000,
+		MaxFee:          10,
+		OutgoingChannel: chanID1.ToUint64(),
+		IncomingChannel: chanID2.ToUint64(),
+		LastHop:         peer2,
+	}
+
+	suggestion := &Suggestions{
+		OutSwaps: []loop.OutRequest{chan1Rec},
+	}
+	rebalances := []loop.RebalanceRequest{rebalance}
+
+	queue := func() int {
+		return manager.queueApprovals(
+			newDispatchSet(suggestion), rebalances,
+		)
+	}
+
+	// Both of our swaps should be queued, and queueing them again should
+	// not duplicate them.
+	require.Equal(t, 2, queue())
+	require.Equal(t, 0, queue())
+
+	expiry := testTime.Add(time.Hour * 2)
+	require.Equal(t, []*PendingApproval{
+		{
+			ID:      1,
+			Created: testTime,
+			Expiry:  expiry,
+			LoopOut: &chan1Rec,
+		},
+		{
+			ID:        2,
+			Created:   testTime,
+			Expiry:    expiry,
+			Rebalance: &rebalance,
+		},
+	}, manager.ListApprovals())
+
+	// Approving our loop out should dispatch it and remove it from the
+	// queue, so that it cannot be approved twice.
+	hash, err := manager.ApproveSwap(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, swapHash, hash)
+	require.Equal(t, []*loop.OutRequest{&chan1Rec}, dispatched)
+
+	_, err = manager.ApproveSwap(ctx, 1)
+	require.ErrorIs(t, err, ErrApprovalNotFound)
+
+	// Once we reject our rebalance, it should not be queued again until
+	// our failure backoff has passed. Our loop out is not pending, so it
+	// can be queued again.
+	require.NoError(t, manager.RejectSwap(2))
+	require.ErrorIs(t, manager.RejectSwap(2), ErrApprovalNotFound)
+	require.Equal(t, 1, queue())
+
+	testClock.SetTime(testTime.Add(time.Hour))
+	require.Equal(t, 1, queue())
+
+	approvals := manager.ListApprovals()
+	require.Len(t, approvals, 2)
+	require.Equal(t, uint64(3), approvals[0].ID)
+	require.Equal(t, uint64(4), approvals[1].ID)
+
+	// Once our first approval has expired, it should no longer be listed
+	// or approvable.
+	testClock.SetTime(expiry)
+	approvals = manager.ListApprovals()
+	require.Len(t, approvals, 1)
+	require.Equal(t, uint64(4), approvals[0].ID)
+
+	_, err = manager.ApproveSwap(ctx, 3)
+	require.ErrorIs(t, err, ErrApprovalNotFound)
+}
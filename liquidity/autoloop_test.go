@@ -12,8 +12,10 @@ import (
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/test"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
 )
 
 // TestAutoLoopDisabled tests the case where we need to perform a swap, but
@@ -70,6 +72,53 @@ func TestAutoLoopDisabled(t *testing.T) {
 	c.stop()
 }
 
+// TestAutoLoopOutsideSchedule tests that we still get swap suggestions, but do
+// not dispatch them, when autoloop is enabled outside of our schedule windows.
+func TestAutoLoopOutsideSchedule(t *testing.T) {
+	defer test.Guard(t)()
+
+	channels := []lndclient.ChannelInfo{
+		channel1,
+	}
+
+	// Our test time falls on a Thursday, so we only allow dispatch on
+	// weekends.
+	params := defaultParameters
+	params.Autoloop = true
+	params.AutoloopSchedule = []ScheduleWindow{
+		{
+			Days:  []time.Weekday{time.Saturday, time.Sunday},
+			Start: 0,
+			End:   time.Hour * 24,
+		},
+	}
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+	}
+
+	c := newAutoloopTestCtx(t, params, channels, testRestrictions)
+	c.start()
+
+	// We expect a quote for our suggested swap, but do not expect it to
+	// be dispatched.
+	step := &autoloopStep{
+		minAmt: 1,
+		maxAmt: chan1Rec.Amount + 1,
+		quotesOut: []quoteRequestResp{
+			{
+				request: &loop.LoopOutQuoteRequest{
+					Amount:          chan1Rec.Amount,
+					SweepConfTarget: chan1Rec.SweepConfTarget,
+				},
+				quote: testQuote,
+			},
+		},
+	}
+	c.autoloop(step)
+
+	c.stop()
+}
+
 // TestAutoLoopEnabled tests enabling the liquidity manger's autolooper. To keep
 // the test simple, we do not update actual lnd channel balances, but rather
 // run our mock with two channels that will always require a loop out according
@@ -307,6 +356,151 @@ func TestAutoLoopEnabled(t *testing.T) {
 	c.stop()
 }
 
+// TestAutoLoopShrinkPolicy tests the policies that we apply to swaps when the
+// server lowers its maximum swap amount between suggestion and dispatch.
+func TestAutoLoopShrinkPolicy(t *testing.T) {
+	defer test.Guard(t)()
+
+	var (
+		amt         = chan1Rec.Amount
+		dispatchMax = btcutil.Amount(4000)
+
+		quote = &loop.LoopOutQuote{
+			SwapFee:      5,
+			PrepayAmount: 100,
+			MinerFee:     10,
+		}
+
+		quotes = []quoteRequestResp{
+			{
+				request: &loop.LoopOutQuoteRequest{
+					Amount:          amt,
+					SweepConfTarget: 10,
+				},
+				quote: quote,
+			},
+		}
+
+		suggested = loop.OutRequest{
+			Amount:              amt,
+			MaxSwapRoutingFee:   ppmToSat(amt, 1000),
+			MaxPrepayRoutingFee: ppmToSat(quote.PrepayAmount, 1000),
+			MaxSwapFee:          quote.SwapFee,
+			MaxPrepayAmount:     quote.PrepayAmount,
+			MaxMinerFee:         20000,
+			SweepConfTarget:     10,
+			OutgoingChanSet:     loopdb.ChannelSet{chanID1.ToUint64()},
+			Label:               labels.AutoloopLabel(swap.TypeOut),
+			Initiator:           autoloopSwapInitiator,
+		}
+
+		resized = shrinkOutRequest(suggested, dispatchMax, false)
+		part    = shrinkOutRequest(suggested, amt/2, true)
+	)
+
+	tests := []struct {
+		name     string
+		policy   ShrinkPolicy
+		expected []*loop.OutRequest
+		notes    []string
+	}{
+		{
+			name:   "skip",
+			policy: ShrinkPolicySkip,
+		},
+		{
+			name:     "resize",
+			policy:   ShrinkPolicyResize,
+			expected: []*loop.OutRequest{&resized},
+			notes: []string{
+				shrinkNote(
+					ShrinkPolicyResize, amt, dispatchMax,
+					1, 1,
+				),
+			},
+		},
+		{
+			name:     "split",
+			policy:   ShrinkPolicySplit,
+			expected: []*loop.OutRequest{&part, &part},
+			notes: []string{
+				shrinkNote(
+					ShrinkPolicySplit, amt, dispatchMax,
+					1, 2,
+				),
+				shrinkNote(
+					ShrinkPolicySplit, amt, dispatchMax,
+					2, 2,
+				),
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			rule := &SwapRule{
+				ThresholdRule: chanRule.ThresholdRule,
+				Type:          swap.TypeOut,
+				ShrinkPolicy:  testCase.policy,
+			}
+
+			params := Parameters{
+				Autoloop:         true,
+				AutoFeeBudget:    100000,
+				AutoFeeStartDate: testTime,
+				MaxAutoInFlight:  2,
+				FailureBackOff:   time.Hour,
+				SweepConfTarget:  10,
+				FeeLimit: NewFeeCategoryLimit(
+					1000, 1000, 1000, 20000, 1000, 20000,
+				),
+				ChannelRules: map[lnwire.ShortChannelID]*SwapRule{
+					chanID1: rule,
+				},
+				HtlcConfTarget: defaultHtlcConfTarget,
+			}
+
+			c := newAutoloopTestCtx(
+				t, params, []lndclient.ChannelInfo{channel1},
+				testRestrictions,
+			)
+
+			notes := &testNotes{}
+			c.manager.cfg.Notes = notes
+
+			c.start()
+
+			var expected []loopOutRequestResp
+			for i, request := range testCase.expected {
+				expected = append(expected, loopOutRequestResp{
+					request: request,
+					response: &loop.LoopOutSwapInfo{
+						SwapHash: lntypes.Hash{
+							byte(i),
+						},
+					},
+				})
+			}
+
+			c.autoloop(&autoloopStep{
+				minAmt:         1,
+				maxAmt:         amt + 1,
+				dispatchMaxAmt: dispatchMax,
+				quotesOut:      quotes,
+				expectedOut:    expected,
+				shrunk:         true,
+				shrunkOut:      true,
+			})
+
+			c.stop()
+
+			require.Equal(t, testCase.notes, notes.notes)
+		})
+	}
+}
+
 // TestCompositeRules tests the case where we have rules set on a per peer
 // and per channel basis, and perform swaps for both targets.
 func TestCompositeRules(t *testing.T) {
@@ -816,6 +1010,119 @@ func TestAutoloopBothTypes(t *testing.T) {
 
 // existingSwapFromRequest is a helper function which returns the db
 // representation of a loop out request with the event set provided.
+// TestAutoLoopFeeCeiling tests that we defer dispatch of swaps while the
+// on-chain fee estimate exceeds our chain fee ceiling, and resume dispatch once
+// it drops.
+func TestAutoLoopFeeCeiling(t *testing.T) {
+	defer test.Guard(t)()
+
+	var (
+		channel = lndclient.ChannelInfo{
+			ChannelID:    chanID1.ToUint64(),
+			PubKeyBytes:  peer1,
+			Capacity:     1000000,
+			LocalBalance: 1000000,
+		}
+
+		loopOutAmt btcutil.Amount = 700000
+		swapFeePPM uint64         = 50000
+
+		htlcConfTarget  int32 = 10
+		sweepConfTarget       = loop.DefaultSweepConfTarget
+		ceiling               = chainfee.SatPerKWeight(1000)
+
+		params = Parameters{
+			Autoloop:         true,
+			AutoFeeBudget:    ppmToSat(loopOutAmt, swapFeePPM),
+			AutoFeeStartDate: testTime,
+			MaxAutoInFlight:  1,
+			FailureBackOff:   time.Hour,
+			FeeLimit:         NewFeePortion(swapFeePPM),
+			ChannelRules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: {
+					ThresholdRule: NewThresholdRule(40, 0),
+					Type:          swap.TypeOut,
+				},
+			},
+			HtlcConfTarget:  htlcConfTarget,
+			SweepConfTarget: sweepConfTarget,
+			ChainFeeCeiling: ceiling,
+		}
+	)
+
+	c := newAutoloopTestCtx(
+		t, params, []lndclient.ChannelInfo{channel}, testRestrictions,
+	)
+	c.start()
+
+	var (
+		quote = &loop.LoopOutQuote{
+			SwapFee:      ppmToSat(loopOutAmt, swapFeePPM) / 4,
+			PrepayAmount: ppmToSat(loopOutAmt, swapFeePPM) / 4,
+		}
+
+		quoteRequest = &loop.LoopOutQuoteRequest{
+			Amount:                  loopOutAmt,
+			SweepConfTarget:         sweepConfTarget,
+			SwapPublicationDeadline: testTime,
+		}
+
+		quotes = []quoteRequestResp{
+			{
+				request: quoteRequest,
+				quote:   quote,
+			},
+		}
+
+		prepayMaxFee, routeMaxFee,
+		minerFee = params.FeeLimit.loopOutFees(loopOutAmt, quote)
+	)
+
+	// While our fee estimate is above our ceiling, we expect our swap to
+	// be suggested but not dispatched.
+	c.lnd.SetFeeEstimate(htlcConfTarget, ceiling+1)
+
+	c.autoloop(&autoloopStep{
+		minAmt:    1,
+		maxAmt:    loopOutAmt + 1,
+		quotesOut: quotes,
+	})
+
+	// Once our fee estimate drops to our ceiling, we dispatch the swap.
+	c.lnd.SetFeeEstimate(htlcConfTarget, ceiling)
+
+	c.autoloop(&autoloopStep{
+		minAmt:    1,
+		maxAmt:    loopOutAmt + 1,
+		quotesOut: quotes,
+		expectedOut: []loopOutRequestResp{
+			{
+				request: &loop.OutRequest{
+					Amount:              loopOutAmt,
+					MaxSwapRoutingFee:   routeMaxFee,
+					MaxPrepayRoutingFee: prepayMaxFee,
+					MaxSwapFee:          quote.SwapFee,
+					MaxPrepayAmount:     quote.PrepayAmount,
+					MaxMinerFee:         minerFee,
+					SweepConfTarget:     sweepConfTarget,
+					OutgoingChanSet: loopdb.ChannelSet{
+						chanID1.ToUint64(),
+					},
+					Label: labels.AutoloopLabel(
+						swap.TypeOut,
+					),
+					Initiator: autoloopSwapInitiator,
+				},
+				response: &loop.LoopOutSwapInfo{
+					SwapHash: lntypes.Hash{1},
+				},
+			},
+		},
+	})
+
+	c.stop()
+}
+
 func existingSwapFromRequest(request *loop.OutRequest, initTime time.Time,
 	events []*loopdb.LoopEvent) *loopdb.LoopOut {
 
@@ -117,55 +117,22 @@ func newAutoloopTestCtx(t *testing.T, parameters Parameters,
 	testCtx.lnd.Channels = channels
 
 	cfg := &Config{
-		AutoloopTicker: ticker.NewForce(DefaultAutoloopTicker),
-		Restrictions: func(_ context.Context, swapType swap.Type) (*Restrictions,
-			error) {
-
-			if swapType == swap.TypeOut {
-				return <-testCtx.loopOutRestrictions, nil
-			}
-
-			return <-testCtx.loopInRestrictions, nil
-		},
-		ListLoopOut: func() ([]*loopdb.LoopOut, error) {
-			return <-testCtx.loopOuts, nil
-		},
-		ListLoopIn: func() ([]*loopdb.LoopIn, error) {
-			return <-testCtx.loopIns, nil
-		},
-		LoopOutQuote: func(_ context.Context,
-			req *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote,
-			error) {
-
-			testCtx.quoteRequest <- req
-
-			return <-testCtx.quotes, nil
-		},
-		LoopOut: func(_ context.Context,
-			req *loop.OutRequest) (*loop.LoopOutSwapInfo,
-			error) {
-
-			testCtx.outRequest <- req
-
-			return <-testCtx.loopOut, nil
-		},
-		LoopInQuote: func(_ context.Context,
-			req *loop.LoopInQuoteRequest) (*loop.LoopInQuote, error) {
-
-			testCtx.quoteRequestIn <- req
-
-			return <-testCtx.quotesIn, nil
-		},
-		LoopIn: func(_ context.Context,
-			req *loop.LoopInRequest) (*loop.LoopInSwapInfo, error) {
-
-			testCtx.inRequest <- req
-
-			return <-testCtx.loopIn, nil
-		},
+		AutoloopTicker:       ticker.NewForce(DefaultAutoloopTicker),
+		Channels:             NewLndChannelSource(testCtx.lnd.Client),
+		Quotes:               testCtx,
+		Dispatcher:           testCtx,
+		Swaps:                testCtx,
 		MinimumConfirmations: loop.DefaultSweepConfTarget,
-		Lnd:                  &testCtx.lnd.LndServices,
+		Wallet:               testCtx.lnd.WalletKit,
+		Graph:                testCtx.lnd.Client,
+		Node:                 testCtx.lnd.Client,
+		ChainParams:          testCtx.lnd.ChainParams,
+		NodePubkey:           testCtx.lnd.LndServices.NodePubkey,
 		Clock:                testCtx.testClock,
+
+		// We get our suggestions serially so that our quote
+		// requests arrive in a predictable order.
+		QuoteWorkers: 1,
 	}
 
 	// SetParameters needs to make a call to our mocked restrictions call,
@@ -185,6 +152,68 @@ func newAutoloopTestCtx(t *testing.T, parameters Parameters,
 	return testCtx
 }
 
+// Restrictions returns the restrictions that our test pushes for the swap
+// type provided.
+func (c *autoloopTestCtx) Restrictions(_ context.Context,
+	swapType swap.Type) (*Restrictions, error) {
+
+	if swapType == swap.TypeOut {
+		return <-c.loopOutRestrictions, nil
+	}
+
+	return <-c.loopInRestrictions, nil
+}
+
+// ListLoopOut returns the loop outs that our test pushes.
+func (c *autoloopTestCtx) ListLoopOut() ([]*loopdb.LoopOut, error) {
+	return <-c.loopOuts, nil
+}
+
+// ListLoopIn returns the loop ins that our test pushes.
+func (c *autoloopTestCtx) ListLoopIn() ([]*loopdb.LoopIn, error) {
+	return <-c.loopIns, nil
+}
+
+// LoopOutQuote sends the quote request to our test and returns the quote that
+// it pushes.
+func (c *autoloopTestCtx) LoopOutQuote(_ context.Context,
+	req *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote, error) {
+
+	c.quoteRequest <- req
+
+	return <-c.quotes, nil
+}
+
+// LoopInQuote sends the quote request to our test and returns the quote that
+// it pushes.
+func (c *autoloopTestCtx) LoopInQuote(_ context.Context,
+	req *loop.LoopInQuoteRequest) (*loop.LoopInQuote, error) {
+
+	c.quoteRequestIn <- req
+
+	return <-c.quotesIn, nil
+}
+
+// LoopOut sends the loop out request to our test and returns the swap info
+// that it pushes.
+func (c *autoloopTestCtx) LoopOut(_ context.Context,
+	req *loop.OutRequest) (*loop.LoopOutSwapInfo, error) {
+
+	c.outRequest <- req
+
+	return <-c.loopOut, nil
+}
+
+// LoopIn sends the loop in request to our test and returns the swap info that
+// it pushes.
+func (c *autoloopTestCtx) LoopIn(_ context.Context,
+	req *loop.LoopInRequest) (*loop.LoopInSwapInfo, error) {
+
+	c.inRequest <- req
+
+	return <-c.loopIn, nil
+}
+
 // start starts our liquidity manager's run loop in a goroutine. Tests should
 // be run with test.Guard() to ensure that this does not leak.
 func (c *autoloopTestCtx) start() {
@@ -242,6 +271,20 @@ type autoloopStep struct {
 	quotesIn    []quoteInRequestResp
 	expectedOut []loopOutRequestResp
 	expectedIn  []loopInRequestResp
+
+	// dispatchMaxAmt is the server's maximum swap amount when swaps are
+	// dispatched. If it is zero, the maximum is unchanged from maxAmt.
+	dispatchMaxAmt btcutil.Amount
+
+	// shrunk indicates that the dispatch maximum is below the amount of
+	// our suggested swaps, so we expect our shrink policies to be applied.
+	shrunk bool
+
+	// shrunkOut and shrunkIn indicate that we expect the server's
+	// restrictions to be checked before dispatch even though no swaps of
+	// that type are dispatched, because they were skipped.
+	shrunkOut bool
+	shrunkIn  bool
 }
 
 // autoloop walks our test context through the process of triggering our
@@ -292,6 +335,32 @@ func (c *autoloopTestCtx) autoloop(step *autoloopStep) {
 		c.quotes <- expected.quote
 	}
 
+	// Before dispatching swaps, we expect the server's restrictions to be
+	// checked again, since they may have changed.
+	dispatchMax := step.maxAmt
+	if step.dispatchMaxAmt != 0 {
+		dispatchMax = step.dispatchMaxAmt
+	}
+
+	if len(step.expectedOut) > 0 || step.shrunkOut {
+		c.loopOutRestrictions <- NewRestrictions(
+			step.minAmt, dispatchMax,
+		)
+	}
+
+	if len(step.expectedIn) > 0 || step.shrunkIn {
+		c.loopInRestrictions <- NewRestrictions(
+			step.minAmt, dispatchMax,
+		)
+	}
+
+	// If our swaps no longer fit in the server's maximum, we lookup our
+	// existing swaps to check our in flight limit.
+	if step.shrunk {
+		c.loopOuts <- step.existingOut
+		c.loopIns <- step.existingIn
+	}
+
 	// Assert that we dispatch the expected set of swaps.
 	for _, expected := range step.expectedOut {
 		actual := <-c.outRequest
@@ -0,0 +1,273 @@
+package liquidity
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// replaceWithRebalances replaces the loop out suggestions that drain a single
+// channel with circular rebalances, if a rebalance is cheaper than the swap
+// and fits within our rebalance fee limit and budget. It returns the remaining
+// swap suggestions and the rebalances that replaced the others. This function
+// expects our params lock to be held.
+func (m *Manager) replaceWithRebalances(ctx context.Context,
+	channels []lndclient.ChannelInfo, suggestions []swapSuggestion) (
+	[]swapSuggestion, []loop.RebalanceRequest, error) {
+
+	if m.cfg.Rebalancer == nil || m.params.RebalanceFeePPM == 0 {
+		return suggestions, nil, nil
+	}
+
+	existing, err := m.checkExistingRebalances()
+	if err != nil {
+		return nil, nil, err
+	}
+	available := existing.available
+
+	// Track the remote balance that is left in each of our channels, so
+	// that we do not suggest rebalancing more into a channel than it can
+	// receive.
+	remote := make(map[uint64]btcutil.Amount, len(channels))
+	for _, channel := range channels {
+		remote[channel.ChannelID] = channel.RemoteBalance
+	}
+
+	var (
+		remaining  []swapSuggestion
+		rebalances []loop.RebalanceRequest
+	)
+
+	for _, suggestion := range suggestions {
+		out, ok := suggestion.(*loopOutSwapSuggestion)
+		if !ok || len(out.OutgoingChanSet) != 1 {
+			remaining = append(remaining, suggestion)
+			continue
+		}
+
+		// If a rebalance out of this channel is still in flight, we
+		// do not suggest anything for the channel until it completes,
+		// because its balances do not reflect the rebalance yet.
+		if existing.pending[out.OutgoingChanSet[0]] {
+			log.Debugf("rebalance out of %v pending, not "+
+				"suggesting swap", out.OutgoingChanSet[0])
+
+			continue
+		}
+
+		// If a rebalance out of this channel recently failed, we fall
+		// back to the swap.
+		if existing.failed[out.OutgoingChanSet[0]] {
+			remaining = append(remaining, suggestion)
+			continue
+		}
+
+		rebalance, err := m.rebalanceForSwap(
+			ctx, channels, remote, &out.OutRequest, available,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if rebalance == nil {
+			remaining = append(remaining, suggestion)
+			continue
+		}
+
+		available -= rebalance.MaxFee
+		remote[rebalance.IncomingChannel] -= rebalance.Amount
+		rebalances = append(rebalances, *rebalance)
+	}
+
+	return remaining, rebalances, nil
+}
+
+// rebalanceForSwap returns a circular rebalance that shifts the amount of a
+// loop out swap out of its channel, if a rebalance is cheaper than the swap
+// and its maximum fee is within our limit and available budget. If no
+// rebalance should replace the swap, nil is returned.
+func (m *Manager) rebalanceForSwap(ctx context.Context,
+	channels []lndclient.ChannelInfo, remote map[uint64]btcutil.Amount,
+	swap *loop.OutRequest, available btcutil.Amount) (
+	*loop.RebalanceRequest, error) {
+
+	maxFee := ppmToSat(swap.Amount, m.params.RebalanceFeePPM)
+	if maxFee > available {
+		log.Debugf("rebalance fee limit %v for %v exceeds available "+
+			"rebalance budget %v", maxFee, swap.Amount, available)
+
+		return nil, nil
+	}
+
+	var outChannel, inChannel *lndclient.ChannelInfo
+	for i, channel := range channels {
+		if channel.ChannelID == swap.OutgoingChanSet[0] {
+			outChannel = &channels[i]
+		}
+	}
+
+	if outChannel == nil {
+		return nil, nil
+	}
+
+	// We rebalance into the active channel with another peer that has
+	// the most remote balance left.
+	for i, channel := range channels {
+		if !channel.Active ||
+			channel.PubKeyBytes == outChannel.PubKeyBytes ||
+			remote[channel.ChannelID] < swap.Amount {
+
+			continue
+		}
+
+		if inChannel == nil ||
+			remote[channel.ChannelID] > remote[inChannel.ChannelID] {
+
+			inChannel = &channels[i]
+		}
+	}
+
+	if inChannel == nil {
+		log.Debugf("no channel can receive a rebalance of %v from %v",
+			swap.Amount, outChannel.ChannelID)
+
+		return nil, nil
+	}
+
+	comparison, err := m.compareRebalance(
+		ctx, swap.Amount, outChannel, inChannel,
+		m.params.SweepConfTarget,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if comparison.Preferred != RebalanceMethodCircular {
+		return nil, nil
+	}
+
+	if comparison.RebalanceFee > maxFee {
+		log.Debugf("rebalance fee %v for %v from %v exceeds limit %v",
+			comparison.RebalanceFee, swap.Amount,
+			outChannel.ChannelID, maxFee)
+
+		return nil, nil
+	}
+
+	log.Debugf("rebalance from %v to %v for %v is cheaper than swap: "+
+		"fee %v, swap cost %v",
+		lnwire.NewShortChanIDFromInt(outChannel.ChannelID),
+		lnwire.NewShortChanIDFromInt(inChannel.ChannelID), swap.Amount,
+		comparison.RebalanceFee, comparison.SwapCost())
+
+	return &loop.RebalanceRequest{
+		Amount:          swap.Amount,
+		MaxFee:          maxFee,
+		OutgoingChannel: outChannel.ChannelID,
+		IncomingChannel: inChannel.ChannelID,
+		LastHop:         inChannel.PubKeyBytes,
+		Autoloop:        true,
+	}, nil
+}
+
+// existingRebalances summarizes the rebalances that we have already
+// dispatched.
+type existingRebalances struct {
+	// available is the part of our rebalance budget that is left.
+	available btcutil.Amount
+
+	// pending is the set of outgoing channels that have a rebalance in
+	// flight.
+	pending map[uint64]bool
+
+	// failed is the set of outgoing channels that had a rebalance fail
+	// within our failure backoff period.
+	failed map[uint64]bool
+}
+
+// checkExistingRebalances returns the part of our rebalance budget that has
+// not been used by the rebalances that autoloop dispatched since our budget
+// start date, where pending rebalances reserve their maximum fee. Manual
+// rebalances do not count towards our budget. It also returns the outgoing
+// channels that have a rebalance in flight, and those that had a rebalance
+// fail within our failure backoff period. This function expects our params
+// lock to be held.
+func (m *Manager) checkExistingRebalances() (*existingRebalances, error) {
+	rebalances, err := m.cfg.Rebalancer.ListRebalances()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		used     btcutil.Amount
+		existing = &existingRebalances{
+			pending: make(map[uint64]bool),
+			failed:  make(map[uint64]bool),
+		}
+		failedCutoff = m.cfg.Clock.Now().Add(m.params.FailureBackOff * -1)
+	)
+
+	for _, rebalance := range rebalances {
+		if rebalance.State.IsPending() {
+			existing.pending[rebalance.OutgoingChannel] = true
+		}
+
+		if rebalance.State == loopdb.RebalanceStateFailed &&
+			rebalance.LastUpdate.After(failedCutoff) {
+
+			existing.failed[rebalance.OutgoingChannel] = true
+		}
+
+		// Only the rebalances that autoloop dispatched count towards
+		// our budget.
+		if !rebalance.Autoloop {
+			continue
+		}
+
+		if rebalance.InitiationTime.Before(m.params.AutoFeeStartDate) {
+			continue
+		}
+
+		if rebalance.State.IsPending() {
+			used += rebalance.MaxFee
+			continue
+		}
+
+		used += rebalance.Fee
+	}
+
+	if used < m.params.RebalanceBudget {
+		existing.available = m.params.RebalanceBudget - used
+	}
+
+	return existing, nil
+}
+
+// dispatchRebalance dispatches a rebalance in the background, so that our
+// autoloop tick does not wait for its payment to complete. The rebalance is
+// stored before its payment is sent, so it reserves its maximum fee from our
+// budget on our next tick while it is still in flight.
+func (m *Manager) dispatchRebalance(ctx context.Context,
+	rebalance *loop.RebalanceRequest) {
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+
+		info, err := m.cfg.Rebalancer.Rebalance(ctx, rebalance)
+		if err != nil {
+			log.Errorf("rebalance from %v to %v failed: %v",
+				rebalance.OutgoingChannel,
+				rebalance.IncomingChannel, err)
+
+			return
+		}
+
+		log.Infof("automatic rebalance completed: hash: %v, "+
+			"state: %v, fee: %v", info.Hash, info.State, info.Fee)
+	}()
+}
@@ -0,0 +1,302 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRebalanceSuggestions tests replacement of loop out suggestions with
+// circular rebalances.
+func TestRebalanceSuggestions(t *testing.T) {
+	var (
+		peer3 = route.Vertex{3}
+
+		// channel3 is an active channel that has enough inbound
+		// liquidity to receive a rebalance out of channel 1.
+		channel3 = lndclient.ChannelInfo{
+			Active:        true,
+			ChannelID:     chanID3.ToUint64(),
+			PubKeyBytes:   peer3,
+			RemoteBalance: 10000,
+			Capacity:      10000,
+		}
+
+		// peerEdge is the channel that peer 1 forwards our payments
+		// over, for which it charges a 1 sat base fee.
+		peerEdge = &lndclient.ChannelEdge{
+			ChannelID: 4,
+			Node1:     peer1,
+			Node1Policy: &lndclient.RoutingPolicy{
+				FeeBaseMsat: 1000,
+			},
+		}
+
+		// serverRoute is a route to the server that costs 1 sat in
+		// fees, including our peer's fee, so that the swap costs 7
+		// sat in total.
+		serverRoute = &lndclient.QueryRoutesResponse{
+			Hops: []*lndclient.Hop{{ChannelID: 4}},
+		}
+
+		// rebalanceFeePPM is the rebalance fee limit that we use,
+		// which allows a fee of 7 sat for our swap amount.
+		rebalanceFeePPM uint64 = 1000
+		maxFee                 = ppmToSat(chan1Rec.Amount, rebalanceFeePPM)
+
+		rebalance = loop.RebalanceRequest{
+			Amount:          chan1Rec.Amount,
+			MaxFee:          maxFee,
+			OutgoingChannel: chanID1.ToUint64(),
+			IncomingChannel: chanID3.ToUint64(),
+			LastHop:         peer3,
+			Autoloop:        true,
+		}
+	)
+
+	// rebalanceRoute returns a route back to ourselves that costs the
+	// routing fee provided on top of our peer's fee.
+	rebalanceRoute := func(fee lnwire.MilliSatoshi) *lndclient.
+		QueryRoutesResponse {
+
+		return &lndclient.QueryRoutesResponse{
+			Hops: []*lndclient.Hop{
+				{ChannelID: 4},
+				{ChannelID: chanID3.ToUint64()},
+			},
+			TotalFeesMsat: fee,
+		}
+	}
+
+	swapSuggestion := &Suggestions{
+		OutSwaps:          []loop.OutRequest{chan1Rec},
+		DisqualifiedChans: make(map[lnwire.ShortChannelID]Reason),
+		DisqualifiedPeers: make(map[route.Vertex]Reason),
+	}
+
+	tests := []struct {
+		name            string
+		rebalanceFee    lnwire.MilliSatoshi
+		rebalanceFeePPM uint64
+		budget          btcutil.Amount
+		existing        []*loopdb.Rebalance
+		expected        *Suggestions
+	}{
+		{
+			name:            "rebalance cheaper",
+			rebalanceFeePPM: rebalanceFeePPM,
+			budget:          100,
+			expected: &Suggestions{
+				Rebalances: []loop.RebalanceRequest{
+					rebalance,
+				},
+				DisqualifiedChans: make(
+					map[lnwire.ShortChannelID]Reason,
+				),
+				DisqualifiedPeers: make(
+					map[route.Vertex]Reason,
+				),
+			},
+		},
+		{
+			name:            "swap cheaper",
+			rebalanceFee:    10000,
+			rebalanceFeePPM: rebalanceFeePPM,
+			budget:          100,
+			expected:        swapSuggestion,
+		},
+		{
+			name:            "rebalances disabled",
+			rebalanceFeePPM: 0,
+			budget:          100,
+			expected:        swapSuggestion,
+		},
+		{
+			name:            "fee limit exceeded",
+			rebalanceFeePPM: 100,
+			budget:          100,
+			expected:        swapSuggestion,
+		},
+		{
+			name:            "budget used",
+			rebalanceFeePPM: rebalanceFeePPM,
+			budget:          100,
+			existing: []*loopdb.Rebalance{
+				{
+					RebalanceContract: loopdb.RebalanceContract{
+						InitiationTime:  testTime,
+						OutgoingChannel: chanID2.ToUint64(),
+						Autoloop:        true,
+					},
+					State: loopdb.RebalanceStateSuccess,
+					Fee:   95,
+				},
+			},
+			expected: swapSuggestion,
+		},
+		{
+			name:            "manual rebalance does not use budget",
+			rebalanceFeePPM: rebalanceFeePPM,
+			budget:          100,
+			existing: []*loopdb.Rebalance{
+				{
+					RebalanceContract: loopdb.RebalanceContract{
+						InitiationTime:  testTime,
+						OutgoingChannel: chanID2.ToUint64(),
+					},
+					State: loopdb.RebalanceStateSuccess,
+					Fee:   95,
+				},
+			},
+			expected: &Suggestions{
+				Rebalances: []loop.RebalanceRequest{
+					rebalance,
+				},
+				DisqualifiedChans: make(
+					map[lnwire.ShortChannelID]Reason,
+				),
+				DisqualifiedPeers: make(
+					map[route.Vertex]Reason,
+				),
+			},
+		},
+		{
+			name:            "budget reserved by pending rebalance",
+			rebalanceFeePPM: rebalanceFeePPM,
+			budget:          100,
+			existing: []*loopdb.Rebalance{
+				{
+					RebalanceContract: loopdb.RebalanceContract{
+						InitiationTime:  testTime,
+						OutgoingChannel: chanID2.ToUint64(),
+						MaxFee:          95,
+						Autoloop:        true,
+					},
+					State: loopdb.RebalanceStateInitiated,
+				},
+			},
+			expected: swapSuggestion,
+		},
+		{
+			name:            "rebalance in flight",
+			rebalanceFeePPM: rebalanceFeePPM,
+			budget:          100,
+			existing: []*loopdb.Rebalance{
+				{
+					RebalanceContract: loopdb.RebalanceContract{
+						InitiationTime:  testTime,
+						OutgoingChannel: chanID1.ToUint64(),
+					},
+					State: loopdb.RebalanceStateInitiated,
+				},
+			},
+			expected: &Suggestions{
+				DisqualifiedChans: make(
+					map[lnwire.ShortChannelID]Reason,
+				),
+				DisqualifiedPeers: make(
+					map[route.Vertex]Reason,
+				),
+			},
+		},
+		{
+			name:            "recent failure",
+			rebalanceFeePPM: rebalanceFeePPM,
+			budget:          100,
+			existing: []*loopdb.Rebalance{
+				{
+					RebalanceContract: loopdb.RebalanceContract{
+						InitiationTime:  testTime,
+						OutgoingChannel: chanID1.ToUint64(),
+					},
+					State:      loopdb.RebalanceStateFailed,
+					LastUpdate: testTime,
+				},
+			},
+			expected: swapSuggestion,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel3,
+			}
+			lnd.ChannelEdges = map[uint64]*lndclient.ChannelEdge{
+				peerEdge.ChannelID: peerEdge,
+			}
+			lnd.Routes = map[route.Vertex]*lndclient.QueryRoutesResponse{
+				cfg.NodePubkey: rebalanceRoute(
+					testCase.rebalanceFee,
+				),
+				{}: serverRoute,
+			}
+
+			cfg.Rebalancer = &testRebalancer{
+				rebalance: func(context.Context,
+					*loop.RebalanceRequest) (
+					*loop.RebalanceInfo, error) {
+
+					return &loop.RebalanceInfo{}, nil
+				},
+				existing: testCase.existing,
+			}
+
+			params := defaultParameters
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			}
+			params.RebalanceFeePPM = testCase.rebalanceFeePPM
+			params.RebalanceBudget = testCase.budget
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.expected, nil,
+			)
+		})
+	}
+}
+
+// TestDispatchRebalance tests that autoloop dispatches rebalances without
+// waiting for their payments to complete.
+func TestDispatchRebalance(t *testing.T) {
+	cfg, _ := newTestConfig()
+
+	var (
+		dispatched = make(chan *loop.RebalanceRequest, 1)
+		release    = make(chan struct{})
+	)
+	cfg.Rebalancer = &testRebalancer{
+		rebalance: func(_ context.Context,
+			req *loop.RebalanceRequest) (*loop.RebalanceInfo,
+			error) {
+
+			dispatched <- req
+			<-release
+
+			return &loop.RebalanceInfo{}, nil
+		},
+	}
+
+	manager := NewManager(cfg)
+	rebalance := &loop.RebalanceRequest{Amount: 1000}
+
+	// Our dispatch returns while the rebalance's payment is still in
+	// flight, and we wait for the payment when we shut down.
+	manager.dispatchRebalance(context.Background(), rebalance)
+	require.Equal(t, rebalance, <-dispatched)
+
+	close(release)
+	manager.wg.Wait()
+}
@@ -0,0 +1,132 @@
+package liquidity
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// DefaultBackoffMultiplier is the default factor that our failure
+	// backoff grows by for each consecutive failure when we back off
+	// exponentially.
+	DefaultBackoffMultiplier = 2
+
+	// DefaultBackoffCap is the default maximum failure backoff when we
+	// back off exponentially.
+	DefaultBackoffCap = time.Hour * 24 * 7
+
+	// maxBackoffJitter is the largest percentage of jitter that we allow
+	// to be added to our failure backoff.
+	maxBackoffJitter = 100
+)
+
+var (
+	// ErrInvalidBackoffMultiplier is returned if exponential backoff is
+	// enabled with a multiplier that is less than one.
+	ErrInvalidBackoffMultiplier = errors.New("backoff multiplier must " +
+		"be >= 1")
+
+	// ErrBackoffCapTooLow is returned if exponential backoff is enabled
+	// with a cap that is less than our failure backoff.
+	ErrBackoffCapTooLow = errors.New("backoff cap must be >= failure " +
+		"backoff")
+
+	// ErrInvalidBackoffJitter is returned if the backoff jitter is not a
+	// percentage in [0, 100].
+	ErrInvalidBackoffJitter = errors.New("backoff jitter must be a " +
+		"percentage in [0, 100]")
+)
+
+// failedSwap is a swap that failed for a channel or peer.
+type failedSwap struct {
+	// hash is the hash of the failed swap.
+	hash lntypes.Hash
+
+	// failedAt is the time that the swap failed.
+	failedAt time.Time
+}
+
+// validateBackoff checks that our exponential backoff parameters are valid,
+// if we back off exponentially.
+func (p Parameters) validateBackoff() error {
+	if !p.ExponentialBackoff {
+		return nil
+	}
+
+	if p.BackoffMultiplier < 1 {
+		return ErrInvalidBackoffMultiplier
+	}
+
+	if p.BackoffCap < p.FailureBackOff {
+		return ErrBackoffCapTooLow
+	}
+
+	if p.BackoffJitter > maxBackoffJitter {
+		return ErrInvalidBackoffJitter
+	}
+
+	return nil
+}
+
+// failureBackoff returns the amount of time that we back off for after the
+// number of consecutive failures provided. Our backoff starts at
+// FailureBackOff and grows by BackoffMultiplier for each further failure, up
+// to BackoffCap. Jitter is added on top of our capped backoff so that targets
+// that have reached the cap do not all become eligible at the same time. We
+// derive our jitter from the hash of the most recent failed swap, so that it
+// does not change between ticks.
+func (p Parameters) failureBackoff(failures int,
+	hash lntypes.Hash) time.Duration {
+
+	backoff := float64(p.FailureBackOff) * math.Pow(
+		p.BackoffMultiplier, float64(failures-1),
+	)
+	if backoff > float64(p.BackoffCap) {
+		backoff = float64(p.BackoffCap)
+	}
+
+	if p.BackoffJitter > 0 {
+		random := float64(binary.BigEndian.Uint32(hash[:4])) /
+			math.MaxUint32
+
+		backoff += backoff * float64(p.BackoffJitter) / 100 * random
+	}
+
+	return time.Duration(backoff)
+}
+
+// backingOff returns the time of the most recent failure in the set of
+// failures provided, and a boolean indicating whether we are still backing
+// off from it. Only failures after the last successful swap for the channel
+// or peer count towards our backoff.
+func (p Parameters) backingOff(now, lastSuccess time.Time,
+	failures []failedSwap) (time.Time, bool) {
+
+	var (
+		count int
+		last  failedSwap
+	)
+
+	for _, failure := range failures {
+		if !failure.failedAt.After(lastSuccess) {
+			continue
+		}
+
+		count++
+		if failure.failedAt.After(last.failedAt) {
+			last = failure
+		}
+	}
+
+	if count == 0 {
+		return time.Time{}, false
+	}
+
+	backoff := p.failureBackoff(count, last.hash)
+
+	return last.failedAt, now.Before(last.failedAt.Add(backoff))
+}
@@ -0,0 +1,119 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFailureBackoff tests calculation of our exponential failure backoff.
+func TestFailureBackoff(t *testing.T) {
+	params := Parameters{
+		FailureBackOff:    time.Hour,
+		BackoffMultiplier: 2,
+		BackoffCap:        time.Hour * 6,
+	}
+
+	var hash lntypes.Hash
+
+	require.Equal(t, time.Hour, params.failureBackoff(1, hash))
+	require.Equal(t, time.Hour*2, params.failureBackoff(2, hash))
+	require.Equal(t, time.Hour*4, params.failureBackoff(3, hash))
+	require.Equal(t, time.Hour*6, params.failureBackoff(4, hash))
+
+	// A zero hash adds no jitter, and a maximal hash adds all of it.
+	params.BackoffJitter = 50
+	require.Equal(t, time.Hour, params.failureBackoff(1, hash))
+
+	hash = lntypes.Hash{0xff, 0xff, 0xff, 0xff}
+	require.Equal(t, time.Minute*90, params.failureBackoff(1, hash))
+
+	// Jitter is added on top of our capped backoff.
+	require.Equal(t, time.Hour*9, params.failureBackoff(10, hash))
+}
+
+// TestBackingOff tests that we count consecutive failures since a target's
+// last success when we decide whether to back off from it.
+func TestBackingOff(t *testing.T) {
+	params := Parameters{
+		FailureBackOff:    time.Hour,
+		BackoffMultiplier: 2,
+		BackoffCap:        time.Hour * 24,
+	}
+
+	failures := []failedSwap{
+		{failedAt: testTime.Add(time.Hour * -5)},
+		{failedAt: testTime.Add(time.Hour * -4)},
+		{failedAt: testTime.Add(time.Hour * -3)},
+	}
+
+	// With three consecutive failures, we back off for four hours from
+	// our most recent failure.
+	lastFail, backingOff := params.backingOff(
+		testTime, time.Time{}, failures,
+	)
+	require.True(t, backingOff)
+	require.Equal(t, testTime.Add(time.Hour*-3), lastFail)
+
+	// If we had a success after our first two failures, we only count our
+	// last failure, and its backoff has passed.
+	success := testTime.Add(time.Hour * -3).Add(-time.Minute)
+	_, backingOff = params.backingOff(testTime, success, failures)
+	require.False(t, backingOff)
+
+	// If we have no failures since our last success, we do not back off.
+	_, backingOff = params.backingOff(testTime, testTime, failures)
+	require.False(t, backingOff)
+}
+
+// TestExponentialBackoffTraffic tests that our current swap traffic uses our
+// exponential backoff when it is enabled.
+func TestExponentialBackoffTraffic(t *testing.T) {
+	failedOut := func(failedAt time.Time) *loopdb.LoopOut {
+		event := &loopdb.LoopEvent{
+			SwapStateData: loopdb.SwapStateData{
+				State: loopdb.StateFailOffchainPayments,
+			},
+			Time: failedAt,
+		}
+
+		return &loopdb.LoopOut{
+			Loop: loopdb.Loop{
+				Events: []*loopdb.LoopEvent{
+					event,
+				},
+			},
+			Contract: chan1Out,
+		}
+	}
+
+	loopOuts := []*loopdb.LoopOut{
+		failedOut(testTime.Add(time.Hour * -3)),
+		failedOut(testTime.Add(time.Minute * -90)),
+	}
+
+	params := defaultParameters
+	params.FailureBackOff = time.Hour
+
+	m := NewManager(&Config{
+		Clock: clock.NewTestClock(testTime),
+	})
+	m.params = params
+
+	// Our fixed backoff of one hour has passed since our last failure.
+	traffic := m.currentSwapTraffic(loopOuts, nil)
+	require.Empty(t, traffic.failedLoopOut)
+
+	// With exponential backoff, our second consecutive failure backs off
+	// for two hours.
+	m.params.ExponentialBackoff = true
+	traffic = m.currentSwapTraffic(loopOuts, nil)
+	require.Equal(t, map[lnwire.ShortChannelID]time.Time{
+		chanID1: testTime.Add(time.Minute * -90),
+	}, traffic.failedLoopOut)
+}
@@ -7,8 +7,9 @@ import (
 	"github.com/lightningnetwork/lnd/routing/route"
 )
 
-// balances summarizes the state of the balances of a channel. Channel reserve,
-// fees and pending htlc balances are not included in these balances.
+// balances summarizes the state of the balances of a channel. Fees and
+// pending htlc balances are not included in these balances, but channel
+// reserves are, so we track them separately.
 type balances struct {
 	// capacity is the total capacity of the channel.
 	capacity btcutil.Amount
@@ -26,10 +27,71 @@ type balances struct {
 
 	// pubkey is the public key of the peer we have this balances set with.
 	pubkey route.Vertex
+
+	// outgoingReserve is the portion of our local balance that cannot be
+	// sent because of channel reserves and commitment fees.
+	outgoingReserve btcutil.Amount
+
+	// incomingReserve is the portion of the remote balance that our peer
+	// cannot send because of channel reserves and commitment fees.
+	incomingReserve btcutil.Amount
+
+	// htlcs describes the htlc slots and in-flight amounts that each of
+	// the channels has available.
+	htlcs []*channelHtlcs
+
+	// flow is the net amount that was forwarded out over the channels in
+	// our flow window. It is only set if flow forecasting is enabled.
+	flow btcutil.Amount
+
+	// revenue is the amount of routing fees that the channels earned in
+	// their rule's revenue window. It is only set if the rule has a
+	// minimum revenue.
+	revenue btcutil.Amount
+
+	// private indicates that all of the channels that these balances
+	// represent are private, so they can only be reached by loop in
+	// payments that include route hints for them.
+	private bool
+}
+
+// peerBalances returns the total balances of the channels provided for each
+// of their peers.
+func peerBalances(
+	channels []lndclient.ChannelInfo) map[route.Vertex]*balances {
+
+	peers := make(map[route.Vertex]*balances)
+	for _, channel := range channels {
+		bal, ok := peers[channel.PubKeyBytes]
+		if !ok {
+			bal = &balances{
+				private: true,
+			}
+		}
+
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		bal.channels = append(bal.channels, chanID)
+		bal.capacity += channel.Capacity
+		bal.incoming += channel.RemoteBalance
+		bal.outgoing += channel.LocalBalance
+		bal.pubkey = channel.PubKeyBytes
+		bal.htlcs = append(bal.htlcs, newChannelHtlcs(channel))
+		bal.private = bal.private && channel.Private
+
+		outgoingReserve, incomingReserve := channelReserves(channel)
+		bal.outgoingReserve += outgoingReserve
+		bal.incomingReserve += incomingReserve
+
+		peers[channel.PubKeyBytes] = bal
+	}
+
+	return peers
 }
 
 // newBalances creates a balances struct from lndclient channel information.
 func newBalances(info lndclient.ChannelInfo) *balances {
+	outgoingReserve, incomingReserve := channelReserves(info)
+
 	return &balances{
 		capacity: info.Capacity,
 		incoming: info.RemoteBalance,
@@ -37,6 +99,10 @@ func newBalances(info lndclient.ChannelInfo) *balances {
 		channels: []lnwire.ShortChannelID{
 			lnwire.NewShortChanIDFromInt(info.ChannelID),
 		},
-		pubkey: info.PubKeyBytes,
+		pubkey:          info.PubKeyBytes,
+		outgoingReserve: outgoingReserve,
+		incomingReserve: incomingReserve,
+		htlcs:           []*channelHtlcs{newChannelHtlcs(info)},
+		private:         info.Private,
 	}
 }
@@ -0,0 +1,164 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// batchable returns a boolean indicating whether the loop outs that we
+// suggest for a rule may be combined with loop outs for other rules. This
+// requires the rule to use our global swap settings, because a combined swap
+// can only have one set of fee limits, publication deadline, custom records
+// and sweep address.
+func (r *SwapRule) batchable() bool {
+	return r.Type == swap.TypeOut && r.FeeLimit == nil &&
+		r.PublicationDeadline == 0 && len(r.CustomRecords) == 0 &&
+		r.SweepDestination == nil
+}
+
+// loopOutBatch is a set of loop out suggestions that we combine into a single
+// swap.
+type loopOutBatch struct {
+	swaps  []*loopOutSwapSuggestion
+	amount btcutil.Amount
+}
+
+// packLoopOuts groups the loop outs provided into batches whose total amount
+// does not exceed the maximum provided. Swaps are placed in the first batch
+// that has room for them, largest first, so that we create as few batches as
+// possible.
+func packLoopOuts(swaps []*loopOutSwapSuggestion,
+	maximum btcutil.Amount) []*loopOutBatch {
+
+	sort.SliceStable(swaps, func(i, j int) bool {
+		return swaps[i].Amount > swaps[j].Amount
+	})
+
+	var batches []*loopOutBatch
+	for _, swap := range swaps {
+		var batch *loopOutBatch
+		for _, candidate := range batches {
+			if candidate.amount+swap.Amount <= maximum {
+				batch = candidate
+				break
+			}
+		}
+
+		if batch == nil {
+			batch = &loopOutBatch{}
+			batches = append(batches, batch)
+		}
+
+		batch.swaps = append(batch.swaps, swap)
+		batch.amount += swap.Amount
+	}
+
+	return batches
+}
+
+// batchLoopOuts combines the batchable loop outs in the suggestions provided
+// into swaps that span the channels of each of the loop outs that they
+// replace, up to the maximum swap amount that the server allows. Combined
+// swaps are quoted for their total amount, so they pay a single set of fixed
+// swap costs and share a single on-chain sweep. If a combined swap cannot be
+// quoted within our fee limits, we keep the loop outs that it would replace.
+func (m *Manager) batchLoopOuts(ctx context.Context,
+	suggestions []swapSuggestion, batchable map[swapSuggestion]bool,
+	restrictions *Restrictions, stats *tickStats) ([]swapSuggestion,
+	error) {
+
+	var (
+		combined []swapSuggestion
+		loopOuts []*loopOutSwapSuggestion
+
+		// position tracks the index of each suggestion, so that we can
+		// return our suggestions in the order they were provided.
+		position = make(map[swapSuggestion]int)
+	)
+
+	for i, suggestion := range suggestions {
+		position[suggestion] = i
+
+		out, ok := suggestion.(*loopOutSwapSuggestion)
+		if !ok || !batchable[suggestion] {
+			combined = append(combined, suggestion)
+			continue
+		}
+
+		loopOuts = append(loopOuts, out)
+	}
+
+	builder := newLoopOutBuilder(m.cfg)
+	for _, batch := range packLoopOuts(loopOuts, restrictions.Maximum) {
+		if len(batch.swaps) == 1 {
+			combined = append(combined, batch.swaps[0])
+			continue
+		}
+
+		var channels []lnwire.ShortChannelID
+		for _, out := range batch.swaps {
+			channels = append(channels, out.channels()...)
+		}
+
+		sort.Slice(channels, func(i, j int) bool {
+			return channels[i].ToUint64() < channels[j].ToUint64()
+		})
+
+		// We build our combined swap as a dry run, and take its label
+		// and sweep address from the first of the swaps it replaces so
+		// that we do not need a new address for it.
+		stats.addQuote()
+		suggestion, err := builder.buildSwap(
+			ctx, route.Vertex{}, channels, batch.amount, false,
+			m.params,
+		)
+
+		var reasonErr *reasonError
+		switch {
+		case errors.As(err, &reasonErr):
+			log.Debugf("Could not combine %v loop outs of %v: %v",
+				len(batch.swaps), batch.amount, reasonErr)
+
+			for _, out := range batch.swaps {
+				combined = append(combined, out)
+			}
+
+			continue
+
+		case err != nil:
+			return nil, err
+		}
+
+		out := suggestion.(*loopOutSwapSuggestion)
+		out.Label = batch.swaps[0].Label
+		out.DestAddr = batch.swaps[0].DestAddr
+		out.sweepDest = batch.swaps[0].sweepDest
+
+		// Our combined swap takes the place of the earliest of the
+		// swaps that it replaces.
+		position[out] = len(suggestions)
+		for _, replaced := range batch.swaps {
+			if position[replaced] < position[out] {
+				position[out] = position[replaced]
+			}
+		}
+
+		log.Infof("Combined %v loop outs into a single swap of %v "+
+			"over channels: %v", len(batch.swaps), batch.amount,
+			channels)
+
+		combined = append(combined, out)
+	}
+
+	sort.SliceStable(combined, func(i, j int) bool {
+		return position[combined[i]] < position[combined[j]]
+	})
+
+	return combined, nil
+}
@@ -0,0 +1,158 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPackLoopOuts tests grouping of loop outs into batches that fit within
+// the server's maximum swap amount.
+func TestPackLoopOuts(t *testing.T) {
+	newSwap := func(amount btcutil.Amount) *loopOutSwapSuggestion {
+		return &loopOutSwapSuggestion{
+			OutRequest: loop.OutRequest{
+				Amount: amount,
+			},
+		}
+	}
+
+	var (
+		swap6 = newSwap(6000)
+		swap5 = newSwap(5000)
+		swap4 = newSwap(4000)
+		swap3 = newSwap(3000)
+	)
+
+	batches := packLoopOuts(
+		[]*loopOutSwapSuggestion{swap3, swap5, swap4, swap6}, 10000,
+	)
+
+	require.Equal(t, []*loopOutBatch{
+		{
+			swaps:  []*loopOutSwapSuggestion{swap6, swap4},
+			amount: 10000,
+		},
+		{
+			swaps:  []*loopOutSwapSuggestion{swap5, swap3},
+			amount: 8000,
+		},
+	}, batches)
+}
+
+// TestBatchLoopOuts tests combining the loop outs that we suggest for
+// several channels into a single swap.
+func TestBatchLoopOuts(t *testing.T) {
+	const amount btcutil.Amount = 15000
+
+	prepay, routing := testPPMFees(defaultFeePPM, testQuote, amount)
+
+	// batched is the swap that combines chan1Rec and chan2Rec.
+	batched := loop.OutRequest{
+		Amount: amount,
+		OutgoingChanSet: loopdb.ChannelSet{
+			chanID1.ToUint64(), chanID2.ToUint64(),
+		},
+		MaxPrepayRoutingFee: prepay,
+		MaxSwapRoutingFee:   routing,
+		MaxMinerFee:         scaleMinerFee(testQuote.MinerFee),
+		MaxSwapFee:          testQuote.SwapFee,
+		MaxPrepayAmount:     testQuote.PrepayAmount,
+		SweepConfTarget:     defaultConfTarget,
+		Initiator:           autoloopSwapInitiator,
+	}
+
+	// ownFeeRule is a rule with its own fee limit, so its swaps may not be
+	// combined with others.
+	ownFeeRule := &SwapRule{
+		ThresholdRule: NewThresholdRule(50, 0),
+		Type:          swap.TypeOut,
+		FeeLimit:      NewFeePortion(defaultFeePPM),
+	}
+
+	tests := []struct {
+		name     string
+		batch    bool
+		maximum  btcutil.Amount
+		rule2    *SwapRule
+		expected []loop.OutRequest
+	}{
+		{
+			name:     "batching disabled",
+			maximum:  20000,
+			rule2:    chanRule,
+			expected: []loop.OutRequest{chan1Rec, chan2Rec},
+		},
+		{
+			name:     "batched",
+			batch:    true,
+			maximum:  20000,
+			rule2:    chanRule,
+			expected: []loop.OutRequest{batched},
+		},
+		{
+			// The server does not allow swaps that are large
+			// enough to combine both of our swaps.
+			name:     "exceeds maximum",
+			batch:    true,
+			maximum:  10000,
+			rule2:    chanRule,
+			expected: []loop.OutRequest{chan1Rec, chan2Rec},
+		},
+		{
+			name:     "rule not batchable",
+			batch:    true,
+			maximum:  20000,
+			rule2:    ownFeeRule,
+			expected: []loop.OutRequest{chan1Rec, chan2Rec},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			restrictions := NewRestrictions(1, testCase.maximum)
+			cfg.Quotes = &testQuotes{
+				restrictions: func(context.Context,
+					swap.Type) (*Restrictions, error) {
+
+					return restrictions, nil
+				},
+			}
+
+			rules := map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+				chanID2: testCase.rule2,
+			}
+
+			// We allow two swaps in flight so that we can compare
+			// our combined swap to the swaps that it replaces.
+			params := defaultParameters
+			params.BatchLoopOuts = testCase.batch
+			params.MaxAutoInFlight = 2
+			params.ChannelRules = rules
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				&Suggestions{
+					OutSwaps:          testCase.expected,
+					DisqualifiedChans: noneDisqualified,
+					DisqualifiedPeers: noPeersDisqualified,
+				}, nil,
+			)
+		})
+	}
+}
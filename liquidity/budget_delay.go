@@ -0,0 +1,281 @@
+package liquidity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// ErrNoPendingIncrease is returned when we are asked to cancel a delayed
+// increase, but none is pending.
+var ErrNoPendingIncrease = errors.New("no budget or fee limit increase " +
+	"pending")
+
+// PendingIncrease is an increase to our fee budget or fee limit that exceeds
+// our configured threshold, and only takes effect once our increase delay has
+// passed.
+type PendingIncrease struct {
+	// AutoFeeBudget is the fee budget that will take effect, or nil if our
+	// budget is not being increased.
+	AutoFeeBudget *btcutil.Amount
+
+	// FeeLimit is the fee limit that will take effect, or nil if our fee
+	// limit is not being increased.
+	FeeLimit FeeLimit
+
+	// Requested is the time that the increase was requested.
+	Requested time.Time
+
+	// Effective is the time from which the increase takes effect. It is
+	// applied on the first autoloop tick at or after this time.
+	Effective time.Time
+}
+
+// String returns the string representation of a pending increase.
+func (p *PendingIncrease) String() string {
+	str := fmt.Sprintf("effective: %v", p.Effective)
+
+	if p.AutoFeeBudget != nil {
+		str += fmt.Sprintf(", budget: %v", *p.AutoFeeBudget)
+	}
+
+	if p.FeeLimit != nil {
+		str += fmt.Sprintf(", fee limit: %v", p.FeeLimit)
+	}
+
+	return str
+}
+
+// Apply returns a copy of the parameters provided with the increase applied.
+func (p *PendingIncrease) Apply(params Parameters) Parameters {
+	params = cloneParameters(params)
+
+	if p.AutoFeeBudget != nil {
+		params.AutoFeeBudget = *p.AutoFeeBudget
+	}
+
+	if p.FeeLimit != nil {
+		params.FeeLimit = p.FeeLimit
+	}
+
+	return params
+}
+
+// exceedsIncrease returns a boolean indicating whether a value increased by
+// more than the percentage provided. Any increase from zero exceeds it.
+func exceedsIncrease(old, new, percent uint64) bool {
+	if new <= old {
+		return false
+	}
+
+	return new-old > old*percent/100
+}
+
+// feeLimitIncreased returns a boolean indicating whether any of the limits of
+// a new fee limit increased by more than the percentage provided. Fee limits
+// of a different type cannot be compared, so a change of type is always
+// treated as an increase.
+func feeLimitIncreased(old, new FeeLimit, percent uint64) bool {
+	switch oldLimit := old.(type) {
+	case *FeePortion:
+		newLimit, ok := new.(*FeePortion)
+		if !ok {
+			return true
+		}
+
+		return exceedsIncrease(
+			oldLimit.PartsPerMillion, newLimit.PartsPerMillion,
+			percent,
+		)
+
+	case *FeeCategoryLimit:
+		newLimit, ok := new.(*FeeCategoryLimit)
+		if !ok {
+			return true
+		}
+
+		increased := func(old, new uint64) bool {
+			return exceedsIncrease(old, new, percent)
+		}
+
+		return increased(
+			uint64(oldLimit.MaximumPrepay),
+			uint64(newLimit.MaximumPrepay),
+		) || increased(
+			oldLimit.MaximumSwapFeePPM, newLimit.MaximumSwapFeePPM,
+		) || increased(
+			oldLimit.MaximumRoutingFeePPM,
+			newLimit.MaximumRoutingFeePPM,
+		) || increased(
+			oldLimit.MaximumPrepayRoutingFeePPM,
+			newLimit.MaximumPrepayRoutingFeePPM,
+		) || increased(
+			uint64(oldLimit.MaximumMinerFee),
+			uint64(newLimit.MaximumMinerFee),
+		) || increased(
+			uint64(oldLimit.SweepFeeRateLimit),
+			uint64(newLimit.SweepFeeRateLimit),
+		)
+
+	case *DynamicFeeLimit:
+		newLimit, ok := new.(*DynamicFeeLimit)
+		if !ok {
+			return true
+		}
+
+		increased := func(old, new uint64) bool {
+			return exceedsIncrease(old, new, percent)
+		}
+
+		return increased(
+			oldLimit.PartsPerMillion, newLimit.PartsPerMillion,
+		) || increased(
+			oldLimit.MinerFeePPM, newLimit.MinerFeePPM,
+		) || increased(
+			oldLimit.MinerFeeMultiplier,
+			newLimit.MinerFeeMultiplier,
+		)
+
+	default:
+		return true
+	}
+}
+
+// sameFeeLimit returns a boolean indicating whether two fee limits are equal.
+func sameFeeLimit(a, b FeeLimit) bool {
+	switch aLimit := a.(type) {
+	case *FeePortion:
+		bLimit, ok := b.(*FeePortion)
+		return ok && *aLimit == *bLimit
+
+	case *FeeCategoryLimit:
+		bLimit, ok := b.(*FeeCategoryLimit)
+		return ok && *aLimit == *bLimit
+
+	case *DynamicFeeLimit:
+		bLimit, ok := b.(*DynamicFeeLimit)
+		return ok && *aLimit == *bLimit
+
+	default:
+		return a == b
+	}
+}
+
+// delayIncreases holds back the increases to our fee budget and fee limit in
+// the parameters provided that exceed our increase threshold, returning the
+// parameters that take effect now. Any value that a new update changes
+// replaces the pending increase of that value. This function must be called
+// with our params lock held.
+func (m *Manager) delayIncreases(params Parameters) Parameters {
+	if m.cfg.BudgetIncreaseDelay == 0 {
+		return params
+	}
+
+	var (
+		now       = m.cfg.Clock.Now()
+		threshold = m.cfg.BudgetIncreaseThreshold
+		pending   = m.pendingIncrease
+		delayed   bool
+	)
+
+	if pending == nil {
+		pending = &PendingIncrease{}
+	}
+
+	if params.AutoFeeBudget != m.params.AutoFeeBudget {
+		pending.AutoFeeBudget = nil
+
+		increased := exceedsIncrease(
+			uint64(m.params.AutoFeeBudget),
+			uint64(params.AutoFeeBudget), threshold,
+		)
+		if increased {
+			budget := params.AutoFeeBudget
+			pending.AutoFeeBudget = &budget
+			params.AutoFeeBudget = m.params.AutoFeeBudget
+			delayed = true
+		}
+	}
+
+	if !sameFeeLimit(params.FeeLimit, m.params.FeeLimit) {
+		pending.FeeLimit = nil
+
+		increased := feeLimitIncreased(
+			m.params.FeeLimit, params.FeeLimit, threshold,
+		)
+		if increased {
+			pending.FeeLimit = params.FeeLimit
+			params.FeeLimit = m.params.FeeLimit
+			delayed = true
+		}
+	}
+
+	// Each new increase restarts our delay, so that increases cannot be
+	// slipped into an update that is about to take effect.
+	if delayed {
+		pending.Requested = now
+		pending.Effective = now.Add(m.cfg.BudgetIncreaseDelay)
+
+		log.Infof("Delaying liquidity parameter increase: %v", pending)
+	}
+
+	m.pendingIncrease = pending
+	if pending.AutoFeeBudget == nil && pending.FeeLimit == nil {
+		m.pendingIncrease = nil
+	}
+
+	return params
+}
+
+// applyPendingIncrease applies our pending increase if its delay has passed.
+func (m *Manager) applyPendingIncrease() {
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	pending := m.pendingIncrease
+	if pending == nil || m.cfg.Clock.Now().Before(pending.Effective) {
+		return
+	}
+
+	m.params = pending.Apply(m.params)
+
+	log.Infof("Applying delayed liquidity parameter increase: %v", pending)
+
+	m.pendingIncrease = nil
+	m.paramsVersion++
+	m.recordParamsChange(ParamsChangeDelayedIncrease, m.profiles.active)
+}
+
+// PendingIncrease returns the increase to our fee budget or fee limit that is
+// waiting for its delay to pass, or nil if there is none.
+func (m *Manager) PendingIncrease() *PendingIncrease {
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	if m.pendingIncrease == nil {
+		return nil
+	}
+
+	pending := *m.pendingIncrease
+	return &pending
+}
+
+// CancelPendingIncrease cancels the increase to our fee budget or fee limit
+// that is waiting for its delay to pass, and returns it.
+func (m *Manager) CancelPendingIncrease() (*PendingIncrease, error) {
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	if m.pendingIncrease == nil {
+		return nil, ErrNoPendingIncrease
+	}
+
+	pending := m.pendingIncrease
+	m.pendingIncrease = nil
+
+	log.Infof("Cancelled liquidity parameter increase: %v", pending)
+
+	return pending, nil
+}
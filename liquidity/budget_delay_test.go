@@ -0,0 +1,180 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFeeLimitIncreased tests detection of fee limit increases that exceed
+// our threshold.
+func TestFeeLimitIncreased(t *testing.T) {
+	category := func(swapFeePPM uint64,
+		minerFee btcutil.Amount) *FeeCategoryLimit {
+
+		return NewFeeCategoryLimit(
+			swapFeePPM, 100, 100, minerFee, 1000, 250,
+		)
+	}
+
+	tests := []struct {
+		name     string
+		old      FeeLimit
+		new      FeeLimit
+		increase bool
+	}{
+		{
+			name: "portion within threshold",
+			old:  NewFeePortion(10000),
+			new:  NewFeePortion(12000),
+		},
+		{
+			name:     "portion above threshold",
+			old:      NewFeePortion(10000),
+			new:      NewFeePortion(12001),
+			increase: true,
+		},
+		{
+			name: "portion decreased",
+			old:  NewFeePortion(10000),
+			new:  NewFeePortion(100),
+		},
+		{
+			name: "category within threshold",
+			old:  category(1000, 10000),
+			new:  category(1100, 12000),
+		},
+		{
+			name:     "one category above threshold",
+			old:      category(1000, 10000),
+			new:      category(1000, 20000),
+			increase: true,
+		},
+		{
+			name: "dynamic within threshold",
+			old:  NewDynamicFeeLimit(10000, 20000, 3),
+			new:  NewDynamicFeeLimit(12000, 20000, 3),
+		},
+		{
+			name:     "dynamic multiplier above threshold",
+			old:      NewDynamicFeeLimit(10000, 20000, 3),
+			new:      NewDynamicFeeLimit(10000, 20000, 4),
+			increase: true,
+		},
+		{
+			name:     "type changed",
+			old:      NewFeePortion(10000),
+			new:      category(1000, 10000),
+			increase: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.increase, feeLimitIncreased(
+				testCase.old, testCase.new, 20,
+			))
+		})
+	}
+}
+
+// TestDelayedIncrease tests that increases to our budget and fee limit that
+// exceed our threshold only take effect once our delay has passed, and that
+// they can be cancelled until then.
+func TestDelayedIncrease(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, _ := newTestConfig()
+	cfg.BudgetIncreaseDelay = time.Hour
+	cfg.BudgetIncreaseThreshold = 20
+
+	testClock := clock.NewTestClock(testTime)
+	cfg.Clock = testClock
+
+	manager := NewManager(cfg)
+
+	params := manager.GetParameters()
+	params.AutoFeeBudget = 10000
+	params.FeeLimit = NewFeePortion(10000)
+	manager.params = params
+
+	// Increases within our threshold take effect immediately.
+	params.AutoFeeBudget = 12000
+	require.NoError(t, manager.SetParameters(ctx, params))
+	require.EqualValues(t, 12000, manager.GetParameters().AutoFeeBudget)
+	require.Nil(t, manager.PendingIncrease())
+
+	// A larger increase to our budget is delayed, while the other changes
+	// in the same update take effect.
+	params.AutoFeeBudget = 100000
+	params.MaxAutoInFlight = 5
+	require.NoError(t, manager.SetParameters(ctx, params))
+
+	current := manager.GetParameters()
+	require.EqualValues(t, 12000, current.AutoFeeBudget)
+	require.Equal(t, 5, current.MaxAutoInFlight)
+
+	budget := btcutil.Amount(100000)
+	require.Equal(t, &PendingIncrease{
+		AutoFeeBudget: &budget,
+		Requested:     testTime,
+		Effective:     testTime.Add(time.Hour),
+	}, manager.PendingIncrease())
+
+	// Setting our current budget again, as clients do when they update
+	// other parameters, does not affect the pending increase. A large fee
+	// limit increase is added to it, and restarts our delay.
+	testClock.SetTime(testTime.Add(time.Minute * 30))
+	params = manager.GetParameters()
+	params.FeeLimit = NewFeePortion(50000)
+	require.NoError(t, manager.SetParameters(ctx, params))
+
+	pending := manager.PendingIncrease()
+	require.Equal(t, &budget, pending.AutoFeeBudget)
+	require.Equal(t, NewFeePortion(50000), pending.FeeLimit)
+	require.Equal(t, testTime.Add(time.Minute*90), pending.Effective)
+
+	// Before our delay has passed, nothing is applied.
+	testClock.SetTime(testTime.Add(time.Hour))
+	manager.applyPendingIncrease()
+	require.EqualValues(t, 12000, manager.GetParameters().AutoFeeBudget)
+
+	// Once it has passed, both increases take effect.
+	testClock.SetTime(testTime.Add(time.Minute * 90))
+	manager.applyPendingIncrease()
+
+	current = manager.GetParameters()
+	require.EqualValues(t, 100000, current.AutoFeeBudget)
+	require.Equal(t, NewFeePortion(50000), current.FeeLimit)
+	require.Nil(t, manager.PendingIncrease())
+
+	history := manager.ParamsHistory()
+	require.Equal(
+		t, ParamsChangeDelayedIncrease,
+		history[len(history)-1].Source,
+	)
+
+	// Cancelled increases never take effect.
+	params = manager.GetParameters()
+	params.AutoFeeBudget = 1000000
+	require.NoError(t, manager.SetParameters(ctx, params))
+
+	cancelled, err := manager.CancelPendingIncrease()
+	require.NoError(t, err)
+
+	budget = 1000000
+	require.Equal(t, &budget, cancelled.AutoFeeBudget)
+
+	_, err = manager.CancelPendingIncrease()
+	require.Equal(t, ErrNoPendingIncrease, err)
+
+	testClock.SetTime(testTime.Add(time.Hour * 24))
+	manager.applyPendingIncrease()
+	require.EqualValues(t, 100000, manager.GetParameters().AutoFeeBudget)
+}
@@ -0,0 +1,117 @@
+package liquidity
+
+import (
+	"context"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// feeBudget returns our fee budget for a budget period in which our
+// automatically dispatched swaps have swapped the volume provided. If a ppm
+// budget is set, our budget is the larger of our flat budget and the ppm of
+// our volume, so that our flat budget acts as a floor that allows us to
+// dispatch swaps before any volume has been swapped in the period.
+func (p Parameters) feeBudget(volume btcutil.Amount) btcutil.Amount {
+	if p.AutoFeeBudgetPPM == 0 {
+		return p.AutoFeeBudget
+	}
+
+	ppmBudget := btcutil.Amount(
+		uint64(volume) * p.AutoFeeBudgetPPM / FeeBase,
+	)
+	if ppmBudget > p.AutoFeeBudget {
+		return ppmBudget
+	}
+
+	return p.AutoFeeBudget
+}
+
+// totalBudget returns the total amount that automatically dispatched swaps
+// may spend in our current budget period, given the volume that they have
+// swapped in it, which is our fee budget plus any budget that was rolled
+// over from our previous period.
+func (p Parameters) totalBudget(volume btcutil.Amount) btcutil.Amount {
+	return p.feeBudget(volume) + p.AutoFeeRolledOver
+}
+
+// nextBudgetPeriod returns the start date of the budget period that the time
+// provided falls in and the budget that is rolled over into it, given the fees
+// that were spent on and the volume swapped by automated swaps in our current
+// period. If no refresh period is set, or our current period has not yet
+// elapsed, our current start date and rolled over budget are returned
+// unchanged.
+//
+// If several periods have elapsed, we skip ahead to the latest one. Unused
+// budget is only rolled over from our current period, and the amount rolled
+// over is capped at our fee budget for the period, so that periods in which we
+// did not dispatch any swaps do not accumulate an unbounded budget.
+func nextBudgetPeriod(params Parameters, spent, volume btcutil.Amount,
+	now time.Time) (time.Time, btcutil.Amount) {
+
+	start := params.AutoFeeStartDate
+	period := params.AutoFeeRefreshPeriod
+
+	if period <= 0 || now.Before(start.Add(period)) {
+		return start, params.AutoFeeRolledOver
+	}
+
+	elapsed := now.Sub(start) / period
+	start = start.Add(elapsed * period)
+
+	if !params.AutoFeeRollover {
+		return start, 0
+	}
+
+	budget := params.feeBudget(volume)
+
+	unused := params.totalBudget(volume) - spent
+	switch {
+	case unused < 0:
+		unused = 0
+
+	case unused > budget:
+		unused = budget
+	}
+
+	return start, unused
+}
+
+// refreshBudget moves our budget start date forward if our budget refresh
+// period has elapsed, rolling over unused budget if required. This function
+// must be called with the params lock held.
+func (m *Manager) refreshBudget(ctx context.Context,
+	loopOut []*loopdb.LoopOut, loopIn []*loopdb.LoopIn) error {
+
+	now := m.cfg.Clock.Now()
+	end := m.params.AutoFeeStartDate.Add(m.params.AutoFeeRefreshPeriod)
+
+	if m.params.AutoFeeRefreshPeriod <= 0 || now.Before(end) {
+		return nil
+	}
+
+	// We only need to look up the fees spent in our current period if we
+	// roll over unused budget.
+	var spent, volume btcutil.Amount
+	if m.params.AutoFeeRollover {
+		summary, err := m.checkExistingAutoLoops(ctx, loopOut, loopIn)
+		if err != nil {
+			return err
+		}
+
+		spent = summary.spentFees
+		volume = summary.swappedVolume
+	}
+
+	start, rolledOver := nextBudgetPeriod(m.params, spent, volume, now)
+
+	log.Infof("Autoloop budget period started at: %v elapsed, new "+
+		"period starts at: %v with %v rolled over",
+		m.params.AutoFeeStartDate, start, rolledOver)
+
+	m.params.AutoFeeStartDate = start
+	m.params.AutoFeeRolledOver = rolledOver
+
+	return nil
+}
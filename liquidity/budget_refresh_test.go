@@ -0,0 +1,147 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNextBudgetPeriod tests moving our budget start date forward and rolling
+// over unused budget when our budget refresh period elapses.
+func TestNextBudgetPeriod(t *testing.T) {
+	const (
+		budget = btcutil.Amount(1000)
+		period = time.Hour * 24
+	)
+
+	start := testTime
+
+	tests := []struct {
+		name       string
+		period     time.Duration
+		rollover   bool
+		rolledOver btcutil.Amount
+		spent      btcutil.Amount
+		budgetPPM  uint64
+		volume     btcutil.Amount
+		now        time.Time
+		start      time.Time
+		carried    btcutil.Amount
+	}{
+		{
+			name:       "no refresh period",
+			rolledOver: 100,
+			now:        start.Add(period * 10),
+			start:      start,
+			carried:    100,
+		},
+		{
+			name:       "period not elapsed",
+			period:     period,
+			rollover:   true,
+			rolledOver: 100,
+			now:        start.Add(period - 1),
+			start:      start,
+			carried:    100,
+		},
+		{
+			name:       "period elapsed, no rollover",
+			period:     period,
+			rolledOver: 100,
+			now:        start.Add(period),
+			start:      start.Add(period),
+		},
+		{
+			name:     "multiple periods elapsed",
+			period:   period,
+			spent:    400,
+			now:      start.Add(period*3 + time.Hour),
+			start:    start.Add(period * 3),
+			rollover: true,
+			carried:  600,
+		},
+		{
+			name:       "rollover includes previous rollover",
+			period:     period,
+			rollover:   true,
+			rolledOver: 500,
+			spent:      1000,
+			now:        start.Add(period),
+			start:      start.Add(period),
+			carried:    500,
+		},
+		{
+			name:       "rollover capped at budget",
+			period:     period,
+			rollover:   true,
+			rolledOver: 800,
+			spent:      100,
+			now:        start.Add(period),
+			start:      start.Add(period),
+			carried:    budget,
+		},
+		{
+			name:       "rollover capped at ppm budget",
+			period:     period,
+			rollover:   true,
+			rolledOver: 800,
+			spent:      100,
+			budgetPPM:  10000,
+			volume:     200000,
+			now:        start.Add(period),
+			start:      start.Add(period),
+			carried:    2000,
+		},
+		{
+			name:     "budget overspent",
+			period:   period,
+			rollover: true,
+			spent:    1500,
+			now:      start.Add(period),
+			start:    start.Add(period),
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			params := defaultParameters
+			params.AutoFeeBudget = budget
+			params.AutoFeeStartDate = start
+			params.AutoFeeRefreshPeriod = testCase.period
+			params.AutoFeeRollover = testCase.rollover
+			params.AutoFeeRolledOver = testCase.rolledOver
+			params.AutoFeeBudgetPPM = testCase.budgetPPM
+
+			newStart, carried := nextBudgetPeriod(
+				params, testCase.spent, testCase.volume,
+				testCase.now,
+			)
+			require.Equal(t, testCase.start, newStart)
+			require.Equal(t, testCase.carried, carried)
+		})
+	}
+}
+
+// TestFeeBudgetPPM tests calculation of our fee budget when it is expressed as
+// parts per million of our swapped volume.
+func TestFeeBudgetPPM(t *testing.T) {
+	params := Parameters{
+		AutoFeeBudget:     1000,
+		AutoFeeRolledOver: 100,
+	}
+
+	// Without a ppm budget, we just use our flat budget.
+	require.Equal(t, btcutil.Amount(1000), params.feeBudget(500000))
+	require.Equal(t, btcutil.Amount(1100), params.totalBudget(500000))
+
+	// With a ppm budget, our flat budget is a floor.
+	params.AutoFeeBudgetPPM = 5000
+	require.Equal(t, btcutil.Amount(1000), params.feeBudget(0))
+	require.Equal(t, btcutil.Amount(1000), params.feeBudget(100000))
+	require.Equal(t, btcutil.Amount(2500), params.feeBudget(500000))
+	require.Equal(t, btcutil.Amount(2600), params.totalBudget(500000))
+}
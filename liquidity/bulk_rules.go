@@ -0,0 +1,452 @@
+package liquidity
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// ErrEmptyRuleSelector is returned when a bulk rule change does not
+	// select any channels or peers.
+	ErrEmptyRuleSelector = errors.New("rule change must select at least " +
+		"one channel or peer")
+
+	// ErrRulePreviewStale is returned when we are asked to apply a bulk
+	// rule change that no longer matches the preview that it was
+	// confirmed for, because our parameters or channels have changed
+	// since.
+	ErrRulePreviewStale = errors.New("rule change no longer matches its " +
+		"preview, please preview the change again")
+)
+
+// RuleSelector selects the channels and peers that a bulk rule change applies
+// to. Channels are given channel rules, and peers are given peer rules.
+type RuleSelector struct {
+	// Channels is a set of channels to select.
+	Channels []lnwire.ShortChannelID
+
+	// Peers is a set of peers to select.
+	Peers []route.Vertex
+
+	// AllChannels selects all of our open channels.
+	AllChannels bool
+
+	// AllPeers selects all of the peers that we have open channels with.
+	AllPeers bool
+}
+
+// RuleChange is a change to the rules of a set of channels and peers.
+type RuleChange struct {
+	// Selector selects the channels and peers that the change applies
+	// to.
+	Selector RuleSelector
+
+	// Rule is the template rule that the selected channels and peers are
+	// given. If it is nil, their rules are removed instead.
+	Rule *SwapRule
+}
+
+// RuleDiffType describes how a bulk rule change alters the rule of a channel
+// or peer.
+type RuleDiffType uint8
+
+const (
+	// RuleAdded indicates that the channel or peer gains a rule.
+	RuleAdded RuleDiffType = iota
+
+	// RuleChanged indicates that the channel or peer's rule is replaced.
+	RuleChanged
+
+	// RuleRemoved indicates that the channel or peer loses its rule.
+	RuleRemoved
+)
+
+// String returns a string representation of a rule diff type.
+func (r RuleDiffType) String() string {
+	switch r {
+	case RuleAdded:
+		return "added"
+
+	case RuleChanged:
+		return "changed"
+
+	case RuleRemoved:
+		return "removed"
+
+	default:
+		return "unknown"
+	}
+}
+
+// RuleDiff describes the change that a bulk rule change makes to the rule of
+// a single channel or peer. Exactly one of channel and peer is set.
+type RuleDiff struct {
+	// Channel is the channel whose rule changes.
+	Channel lnwire.ShortChannelID
+
+	// Peer is the peer whose rule changes.
+	Peer route.Vertex
+
+	// Type is the type of change.
+	Type RuleDiffType
+
+	// Old is the rule that is currently set, or nil if there is none.
+	Old *SwapRule
+
+	// New is the rule that will be set, or nil if the rule is removed.
+	New *SwapRule
+}
+
+// ProjectedSwaps summarizes the swaps that we would suggest for a set of
+// rules.
+type ProjectedSwaps struct {
+	// LoopOutCount is the number of loop outs.
+	LoopOutCount int
+
+	// LoopOutAmount is the total amount of our loop outs.
+	LoopOutAmount btcutil.Amount
+
+	// LoopInCount is the number of loop ins.
+	LoopInCount int
+
+	// LoopInAmount is the total amount of our loop ins.
+	LoopInAmount btcutil.Amount
+}
+
+// RulePreview describes the changes that a bulk rule change would make.
+type RulePreview struct {
+	// ID identifies the preview. It must be provided to apply the change,
+	// which fails if the change no longer matches the preview.
+	ID [sha256.Size]byte
+
+	// Diffs is the set of channels and peers whose rules change, ordered
+	// by channel and then by peer. Channels and peers whose rules would
+	// not change are not included.
+	Diffs []*RuleDiff
+
+	// Current summarizes the swaps that we suggest for our current rules.
+	Current ProjectedSwaps
+
+	// Projected summarizes the swaps that we would suggest once the change
+	// has been applied.
+	Projected ProjectedSwaps
+}
+
+// PreviewRules returns the changes that a bulk rule change would make to our
+// rules, and the swaps that we would suggest before and after the change.
+func (m *Manager) PreviewRules(ctx context.Context, change *RuleChange) (
+	*RulePreview, error) {
+
+	channels, restrictions, err := m.ruleChangeInputs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	params, preview, err := m.applyRuleChange(
+		change, channels, restrictions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	preview.Current, err = m.projectSwaps(ctx, m.params)
+	if err != nil {
+		return nil, err
+	}
+
+	preview.Projected, err = m.projectSwaps(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+// ApplyRules applies a bulk rule change if it still matches the preview with
+// the ID provided. Our parameters are updated in a single step, so no other
+// parameter change can be interleaved with the change.
+func (m *Manager) ApplyRules(ctx context.Context, change *RuleChange,
+	previewID [sha256.Size]byte) (*RulePreview, error) {
+
+	channels, restrictions, err := m.ruleChangeInputs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	params, preview, err := m.applyRuleChange(
+		change, channels, restrictions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if preview.ID != previewID {
+		return nil, ErrRulePreviewStale
+	}
+
+	m.params = params
+	m.paramsVersion++
+	m.recordParamsChange(ParamsChangeRPC, "")
+
+	return preview, nil
+}
+
+// ruleChangeInputs fetches the channels and server restrictions that we
+// validate rule changes against. These calls are made before we acquire our
+// params lock, as they are for SetParameters.
+func (m *Manager) ruleChangeInputs(ctx context.Context) (
+	[]lndclient.ChannelInfo, *Restrictions, error) {
+
+	restrictions, err := m.cfg.Quotes.Restrictions(ctx, swap.TypeOut)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	channels, err := m.cfg.Channels.ListChannels(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return channels, restrictions, nil
+}
+
+// applyRuleChange returns a copy of our current parameters with a bulk rule
+// change applied, along with a preview of the change without its projected
+// swaps. The new parameters are validated. It must be called with our params
+// lock held.
+func (m *Manager) applyRuleChange(change *RuleChange,
+	channels []lndclient.ChannelInfo, restrictions *Restrictions) (
+	Parameters, *RulePreview, error) {
+
+	var (
+		selector = change.Selector
+		params   = cloneParameters(m.params)
+		preview  = &RulePreview{}
+	)
+
+	selectedChans := selector.Channels
+	selectedPeers := selector.Peers
+
+	for _, channel := range channels {
+		if selector.AllChannels {
+			selectedChans = append(
+				selectedChans,
+				lnwire.NewShortChanIDFromInt(channel.ChannelID),
+			)
+		}
+
+		if selector.AllPeers {
+			selectedPeers = append(
+				selectedPeers, channel.PubKeyBytes,
+			)
+		}
+	}
+
+	if len(selectedChans) == 0 && len(selectedPeers) == 0 {
+		return Parameters{}, nil, ErrEmptyRuleSelector
+	}
+
+	// newRule returns a copy of our template rule for each channel or
+	// peer, so that they can be edited individually later on.
+	newRule := func() *SwapRule {
+		if change.Rule == nil {
+			return nil
+		}
+
+		rule := *change.Rule
+		if rule.ThresholdRule != nil {
+			threshold := *rule.ThresholdRule
+			rule.ThresholdRule = &threshold
+		}
+
+		return &rule
+	}
+
+	seenChans := make(map[lnwire.ShortChannelID]bool)
+	for _, channel := range selectedChans {
+		if seenChans[channel] {
+			continue
+		}
+		seenChans[channel] = true
+
+		diff := newRuleDiff(params.ChannelRules[channel], newRule())
+		if diff == nil {
+			continue
+		}
+
+		diff.Channel = channel
+		preview.Diffs = append(preview.Diffs, diff)
+
+		if diff.New == nil {
+			delete(params.ChannelRules, channel)
+		} else {
+			params.ChannelRules[channel] = diff.New
+		}
+	}
+
+	seenPeers := make(map[route.Vertex]bool)
+	for _, peer := range selectedPeers {
+		if seenPeers[peer] {
+			continue
+		}
+		seenPeers[peer] = true
+
+		diff := newRuleDiff(params.PeerRules[peer], newRule())
+		if diff == nil {
+			continue
+		}
+
+		diff.Peer = peer
+		preview.Diffs = append(preview.Diffs, diff)
+
+		if diff.New == nil {
+			delete(params.PeerRules, peer)
+		} else {
+			params.PeerRules[peer] = diff.New
+		}
+	}
+
+	err := params.validate(
+		m.cfg.MinimumConfirmations, channels, restrictions,
+	)
+	if err != nil {
+		return Parameters{}, nil, err
+	}
+
+	sortRuleDiffs(preview.Diffs)
+	preview.ID = rulePreviewID(m.paramsVersion, preview.Diffs)
+
+	return params, preview, nil
+}
+
+// newRuleDiff returns the change from an old rule to a new rule, or nil if
+// the rule does not change.
+func newRuleDiff(old, new *SwapRule) *RuleDiff {
+	switch {
+	case old == nil && new == nil:
+		return nil
+
+	case old == nil:
+		return &RuleDiff{Type: RuleAdded, New: new}
+
+	case new == nil:
+		return &RuleDiff{Type: RuleRemoved, Old: old}
+
+	case reflect.DeepEqual(old, new):
+		return nil
+
+	default:
+		return &RuleDiff{Type: RuleChanged, Old: old, New: new}
+	}
+}
+
+// sortRuleDiffs sorts a set of rule diffs so that channels come before peers,
+// and channels and peers are each ordered by their identifiers.
+func sortRuleDiffs(diffs []*RuleDiff) {
+	sort.Slice(diffs, func(i, j int) bool {
+		chanI, chanJ := diffs[i].Channel.ToUint64(),
+			diffs[j].Channel.ToUint64()
+
+		if chanI != chanJ {
+			// Peer diffs have a zero channel, so we sort them
+			// after our channels.
+			if chanI == 0 || chanJ == 0 {
+				return chanJ == 0
+			}
+
+			return chanI < chanJ
+		}
+
+		return diffs[i].Peer.String() < diffs[j].Peer.String()
+	})
+}
+
+// rulePreviewID derives the ID of a rule preview from the version of the
+// parameters that it was made against and its diffs, so that a change can
+// only be applied if it makes exactly the changes that were previewed.
+func rulePreviewID(version uint64, diffs []*RuleDiff) [sha256.Size]byte {
+	h := sha256.New()
+
+	fmt.Fprintf(h, "%v", version)
+	for _, diff := range diffs {
+		fmt.Fprintf(h, "|%v|%v|%v|%v|%v", diff.Channel, diff.Peer,
+			diff.Type, ruleString(diff.Old), ruleString(diff.New))
+	}
+
+	var id [sha256.Size]byte
+	copy(id[:], h.Sum(nil))
+
+	return id
+}
+
+// ruleString returns a string representation of all of the fields of a rule
+// that may be nil.
+func ruleString(rule *SwapRule) string {
+	if rule == nil {
+		return "none"
+	}
+
+	var threshold ThresholdRule
+	if rule.ThresholdRule != nil {
+		threshold = *rule.ThresholdRule
+	}
+
+	return fmt.Sprintf("%+v|%v|%v|%v|%v|%v|%v|%v|%v|%v|%v|%v|%v",
+		threshold,
+		rule.Type, rule.ShrinkPolicy, rule.FeeBudget,
+		rule.Expiry.UnixNano(), rule.Cooldown, rule.FeeLimit,
+		rule.MaxSwapAmount, rule.CustomRecords,
+		rule.MinRevenuePercent, rule.RevenueWindow,
+		rule.SweepDestination, rule.PublicationDeadline)
+}
+
+// projectSwaps summarizes the swaps that we would suggest for the parameters
+// provided. Our parameters are temporarily replaced while we get our
+// suggestions, so it must be called with our params lock held.
+func (m *Manager) projectSwaps(ctx context.Context, params Parameters) (
+	ProjectedSwaps, error) {
+
+	var projected ProjectedSwaps
+	if !params.haveRules() {
+		return projected, nil
+	}
+
+	current := m.params
+	m.params = params
+	defer func() {
+		m.params = current
+	}()
+
+	suggestions, err := m.suggestEligibleSwaps(ctx, false, &tickStats{})
+	if err != nil {
+		return projected, err
+	}
+
+	for _, out := range suggestions.OutSwaps {
+		projected.LoopOutCount++
+		projected.LoopOutAmount += out.Amount
+	}
+
+	for _, in := range suggestions.InSwaps {
+		projected.LoopInCount++
+		projected.LoopInAmount += in.Amount
+	}
+
+	return projected, nil
+}
@@ -0,0 +1,150 @@
+package liquidity
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBulkRules tests previewing and applying bulk rule changes.
+func TestBulkRules(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, lnd := newTestConfig()
+	lnd.Channels = []lndclient.ChannelInfo{
+		channel1, channel2,
+	}
+
+	manager := NewManager(cfg)
+
+	// Allow two swaps in flight so that we suggest swaps for both of our
+	// channels.
+	params := manager.GetParameters()
+	params.MaxAutoInFlight = 2
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+	}
+	require.NoError(t, manager.SetParameters(ctx, params))
+
+	// A change that does not select anything should fail.
+	_, err := manager.PreviewRules(ctx, &RuleChange{Rule: chanRule})
+	require.Equal(t, ErrEmptyRuleSelector, err)
+
+	// Preview adding our rule to all of our channels. Channel 1 already
+	// has the rule, so only channel 2 should change.
+	change := &RuleChange{
+		Selector: RuleSelector{
+			AllChannels: true,
+		},
+		Rule: chanRule,
+	}
+
+	preview, err := manager.PreviewRules(ctx, change)
+	require.NoError(t, err)
+
+	require.Len(t, preview.Diffs, 1)
+	require.Equal(t, chanID2, preview.Diffs[0].Channel)
+	require.Equal(t, RuleAdded, preview.Diffs[0].Type)
+	require.Nil(t, preview.Diffs[0].Old)
+	require.Equal(t, chanRule, preview.Diffs[0].New)
+
+	require.Equal(t, ProjectedSwaps{
+		LoopOutCount:  1,
+		LoopOutAmount: chan1Rec.Amount,
+	}, preview.Current)
+
+	require.Equal(t, ProjectedSwaps{
+		LoopOutCount:  2,
+		LoopOutAmount: chan1Rec.Amount + chan2Rec.Amount,
+	}, preview.Projected)
+
+	// Previewing should not change our parameters.
+	require.Len(t, manager.GetParameters().ChannelRules, 1)
+
+	// Applying the change with an ID that does not match the change
+	// should fail.
+	_, err = manager.ApplyRules(ctx, change, [sha256.Size]byte{1})
+	require.Equal(t, ErrRulePreviewStale, err)
+
+	// Apply the change with our preview's ID, and assert that both of
+	// our channels now have the rule.
+	applied, err := manager.ApplyRules(ctx, change, preview.ID)
+	require.NoError(t, err)
+	require.Equal(t, preview.Diffs, applied.Diffs)
+
+	rules := manager.GetParameters().ChannelRules
+	require.Len(t, rules, 2)
+	require.Equal(t, chanRule, rules[chanID2])
+
+	// Our parameters have changed since we previewed, so applying the
+	// preview again should fail.
+	_, err = manager.ApplyRules(ctx, change, preview.ID)
+	require.Equal(t, ErrRulePreviewStale, err)
+
+	// Preview removing the rules of channel 1 and channel 3, which does
+	// not have a rule, and of a peer that does not have a rule. Only
+	// channel 1 should change.
+	remove := &RuleChange{
+		Selector: RuleSelector{
+			Channels: []lnwire.ShortChannelID{
+				chanID1, chanID3, chanID1,
+			},
+			Peers: []route.Vertex{peer1},
+		},
+	}
+
+	preview, err = manager.PreviewRules(ctx, remove)
+	require.NoError(t, err)
+
+	require.Len(t, preview.Diffs, 1)
+	require.Equal(t, chanID1, preview.Diffs[0].Channel)
+	require.Equal(t, RuleRemoved, preview.Diffs[0].Type)
+	require.Equal(t, chanRule, preview.Diffs[0].Old)
+	require.Nil(t, preview.Diffs[0].New)
+
+	require.Equal(t, ProjectedSwaps{
+		LoopOutCount:  1,
+		LoopOutAmount: chan2Rec.Amount,
+	}, preview.Projected)
+
+	_, err = manager.ApplyRules(ctx, remove, preview.ID)
+	require.NoError(t, err)
+
+	rules = manager.GetParameters().ChannelRules
+	require.Len(t, rules, 1)
+	require.Contains(t, rules, chanID2)
+
+	// Rules are validated before they are previewed, so we cannot add a
+	// peer rule for a peer whose channel already has a rule.
+	_, err = manager.PreviewRules(ctx, &RuleChange{
+		Selector: RuleSelector{
+			AllPeers: true,
+		},
+		Rule: chanRule,
+	})
+	require.Error(t, err)
+}
+
+// TestSortRuleDiffs tests ordering of rule diffs.
+func TestSortRuleDiffs(t *testing.T) {
+	diffs := []*RuleDiff{
+		{Peer: peer2},
+		{Channel: chanID2},
+		{Peer: peer1},
+		{Channel: chanID1},
+	}
+
+	sortRuleDiffs(diffs)
+
+	require.Equal(t, []*RuleDiff{
+		{Channel: chanID1},
+		{Channel: chanID2},
+		{Peer: peer1},
+		{Peer: peer2},
+	}, diffs)
+}
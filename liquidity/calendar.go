@@ -0,0 +1,416 @@
+package liquidity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+const (
+	// DefaultCalendarHorizon is the period that our calendar covers if no
+	// horizon is provided.
+	DefaultCalendarHorizon = time.Hour * 24 * 7
+
+	// maxCalendarHorizon is the longest period that our calendar may
+	// cover.
+	maxCalendarHorizon = time.Hour * 24 * 31
+
+	// icalTimeFormat is the format that times are expressed in within our
+	// iCalendar export, which is always in UTC.
+	icalTimeFormat = "20060102T150405Z"
+)
+
+// ErrInvalidCalendarHorizon is returned when a calendar is requested for a
+// horizon that is negative or exceeds our maximum.
+var ErrInvalidCalendarHorizon = fmt.Errorf("calendar horizon must be in "+
+	"(0, %v]", maxCalendarHorizon)
+
+// CalendarEventType describes the kind of action that a calendar event
+// represents.
+type CalendarEventType uint8
+
+const (
+	// CalendarDispatchWindow is a period in which our autoloop schedule
+	// allows swaps to be dispatched.
+	CalendarDispatchWindow CalendarEventType = iota
+
+	// CalendarDeferredSwap is a swap that autoloop suggested but deferred,
+	// because it was outside of our schedule or the chain fee estimate was
+	// above our ceiling. It is placed at the time that we next expect to
+	// be able to dispatch it.
+	CalendarDeferredSwap
+
+	// CalendarApprovalExpiry is the time at which a swap that is waiting
+	// for approval expires.
+	CalendarApprovalExpiry
+
+	// CalendarBudgetRefresh is the time at which our autoloop budget
+	// refreshes.
+	CalendarBudgetRefresh
+
+	// CalendarRuleExpiry is the time at which a temporary rule expires.
+	CalendarRuleExpiry
+)
+
+// String returns the string representation of a calendar event type.
+func (c CalendarEventType) String() string {
+	switch c {
+	case CalendarDispatchWindow:
+		return "dispatch window"
+
+	case CalendarDeferredSwap:
+		return "deferred swap"
+
+	case CalendarApprovalExpiry:
+		return "approval expiry"
+
+	case CalendarBudgetRefresh:
+		return "budget refresh"
+
+	case CalendarRuleExpiry:
+		return "rule expiry"
+
+	default:
+		return "unknown"
+	}
+}
+
+// CalendarEvent is an action that autoloop plans to take, or a change in the
+// conditions that it operates under, at a time in the future.
+type CalendarEvent struct {
+	// Type is the kind of event.
+	Type CalendarEventType
+
+	// Start is the time at which the event starts.
+	Start time.Time
+
+	// End is the time at which the event ends. It is equal to Start for
+	// events that happen at a single point in time.
+	End time.Time
+
+	// Summary is a human readable description of the event.
+	Summary string
+
+	// Amount is the amount of the swap that the event is for, if any.
+	Amount btcutil.Amount
+}
+
+// setDeferred records the round provided as deferred if autoloop is enabled
+// but was not allowed to dispatch its swaps, so that they can be included in
+// our calendar. Rounds that were dispatched clear our deferred swaps.
+func (m *Manager) setDeferred(dispatch bool, round *loopdb.SuggestionRound) {
+	m.deferredLock.Lock()
+	defer m.deferredLock.Unlock()
+
+	if dispatch || !m.params.Autoloop {
+		m.deferred = nil
+		return
+	}
+
+	m.deferred = round
+}
+
+// Calendar returns the events that we expect to happen within the horizon
+// provided, ordered by start time. The calendar includes our autoloop dispatch
+// windows, the swaps that our last autoloop tick deferred, the expiry of
+// swaps that are waiting for approval and temporary rules, and our next
+// budget refresh.
+func (m *Manager) Calendar(horizon time.Duration) ([]*CalendarEvent,
+	error) {
+
+	if horizon <= 0 || horizon > maxCalendarHorizon {
+		return nil, ErrInvalidCalendarHorizon
+	}
+
+	var (
+		params = m.GetParameters()
+		now    = m.cfg.Clock.Now()
+		end    = now.Add(horizon)
+		events []*CalendarEvent
+	)
+
+	// Our dispatch windows and deferred swaps are only relevant if
+	// autoloop is enabled.
+	if params.Autoloop {
+		for _, window := range params.AutoloopSchedule {
+			occurrences := window.occurrences(now, end)
+			for _, occurrence := range occurrences {
+				events = append(events, &CalendarEvent{
+					Type:    CalendarDispatchWindow,
+					Start:   occurrence[0],
+					End:     occurrence[1],
+					Summary: "autoloop dispatch window",
+				})
+			}
+		}
+
+		m.deferredLock.Lock()
+		deferred := m.deferred
+		m.deferredLock.Unlock()
+
+		at := nextDispatch(params.AutoloopSchedule, now, end)
+		if deferred != nil && !at.IsZero() {
+			events = append(events, deferredEvents(deferred, at)...)
+		}
+	}
+
+	for _, approval := range m.ListApprovals() {
+		if approval.Expiry.IsZero() || !approval.Expiry.Before(end) {
+			continue
+		}
+
+		summary, amount := approval.describe()
+		events = append(events, &CalendarEvent{
+			Type:    CalendarApprovalExpiry,
+			Start:   approval.Expiry,
+			End:     approval.Expiry,
+			Summary: "approval expires: " + summary,
+			Amount:  amount,
+		})
+	}
+
+	if refresh := nextBudgetRefresh(params, now); !refresh.IsZero() &&
+		refresh.Before(end) {
+
+		events = append(events, &CalendarEvent{
+			Type:    CalendarBudgetRefresh,
+			Start:   refresh,
+			End:     refresh,
+			Summary: "autoloop budget refresh",
+		})
+	}
+
+	addRuleExpiry := func(rule *SwapRule, target string) {
+		if rule.Expiry.IsZero() || rule.expired(now) ||
+			!rule.Expiry.Before(end) {
+
+			return
+		}
+
+		events = append(events, &CalendarEvent{
+			Type:    CalendarRuleExpiry,
+			Start:   rule.Expiry,
+			End:     rule.Expiry,
+			Summary: fmt.Sprintf("rule expires: %v", target),
+		})
+	}
+
+	for channel, rule := range params.ChannelRules {
+		addRuleExpiry(rule, fmt.Sprintf("channel %v", channel))
+	}
+
+	for peer, rule := range params.PeerRules {
+		addRuleExpiry(rule, fmt.Sprintf("peer %v", peer))
+	}
+
+	for name, group := range params.ChannelGroups {
+		addRuleExpiry(group.Rule, fmt.Sprintf("group %v", name))
+	}
+
+	// Our rules are held in maps, so we sort by summary as well as start
+	// time so that our events are returned in a stable order.
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].Start.Equal(events[j].Start) {
+			return events[i].Start.Before(events[j].Start)
+		}
+
+		return events[i].Summary < events[j].Summary
+	})
+
+	return events, nil
+}
+
+// deferredEvents returns a calendar event at the time provided for each of the
+// swaps in a deferred suggestion round.
+func deferredEvents(round *loopdb.SuggestionRound,
+	at time.Time) []*CalendarEvent {
+
+	var events []*CalendarEvent
+
+	add := func(summary string, amount btcutil.Amount) {
+		events = append(events, &CalendarEvent{
+			Type:    CalendarDeferredSwap,
+			Start:   at,
+			End:     at,
+			Summary: "deferred " + summary,
+			Amount:  amount,
+		})
+	}
+
+	for _, out := range round.LoopOut {
+		add(fmt.Sprintf("loop out of %v over channels %v", out.Amount,
+			loopdb.ChannelSet(out.Channels)), out.Amount)
+	}
+
+	for _, in := range round.LoopIn {
+		summary := fmt.Sprintf("loop in of %v", in.Amount)
+		if in.LastHop != nil {
+			summary += fmt.Sprintf(" via peer %v", *in.LastHop)
+		}
+
+		add(summary, in.Amount)
+	}
+
+	// Our suggested rebalances hold their outgoing channel followed by
+	// their incoming channel.
+	for _, rebalance := range round.Rebalances {
+		add(fmt.Sprintf("rebalance of %v from channel %v to %v",
+			rebalance.Amount, rebalance.Channels[0],
+			rebalance.Channels[1]), rebalance.Amount)
+	}
+
+	return events
+}
+
+// describe returns a summary of the swap that an approval is for, along with
+// its amount.
+func (p *PendingApproval) describe() (string, btcutil.Amount) {
+	switch {
+	case p.LoopOut != nil:
+		summary := fmt.Sprintf("loop out of %v over channels %v",
+			p.LoopOut.Amount, p.LoopOut.OutgoingChanSet)
+
+		return summary, p.LoopOut.Amount
+
+	case p.LoopIn != nil:
+		summary := fmt.Sprintf("loop in of %v", p.LoopIn.Amount)
+		if p.LoopIn.LastHop != nil {
+			summary += fmt.Sprintf(" via peer %v",
+				*p.LoopIn.LastHop)
+		}
+
+		return summary, p.LoopIn.Amount
+
+	default:
+		summary := fmt.Sprintf("rebalance of %v from channel %v to %v",
+			p.Rebalance.Amount, p.Rebalance.OutgoingChannel,
+			p.Rebalance.IncomingChannel)
+
+		return summary, p.Rebalance.Amount
+	}
+}
+
+// occurrences returns the start and end times of each occurrence of the
+// window that overlaps with the period provided. Occurrences are not clipped
+// to the period, so the first may have started before it.
+func (w ScheduleWindow) occurrences(start, end time.Time) [][2]time.Time {
+
+	var (
+		result          [][2]time.Time
+		year, month, dd = start.Date()
+	)
+
+	// We start from the day before our period, because a window that
+	// wraps past midnight may still be open at its start.
+	for i := -1; ; i++ {
+		midnight := time.Date(
+			year, month, dd+i, 0, 0, 0, 0, start.Location(),
+		)
+		if !midnight.Before(end) {
+			break
+		}
+
+		if !w.appliesTo(midnight.Weekday()) {
+			continue
+		}
+
+		windowEnd := w.End
+		if w.End < w.Start {
+			windowEnd += fullDay
+		}
+
+		occurrence := [2]time.Time{
+			midnight.Add(w.Start), midnight.Add(windowEnd),
+		}
+		if occurrence[1].After(start) && occurrence[0].Before(end) {
+			result = append(result, occurrence)
+		}
+	}
+
+	return result
+}
+
+// nextDispatch returns the earliest time before the end time provided at which
+// our schedule allows swaps to be dispatched, or a zero time if it does not
+// allow dispatch in that period.
+func nextDispatch(windows []ScheduleWindow, now, end time.Time) time.Time {
+	if inSchedule(windows, now) {
+		return now
+	}
+
+	var next time.Time
+	for _, window := range windows {
+		for _, occurrence := range window.occurrences(now, end) {
+			if next.IsZero() || occurrence[0].Before(next) {
+				next = occurrence[0]
+			}
+		}
+	}
+
+	return next
+}
+
+// nextBudgetRefresh returns the next time after the time provided at which
+// our autoloop budget refreshes, or a zero time if no refresh period is set.
+func nextBudgetRefresh(params Parameters, now time.Time) time.Time {
+	period := params.AutoFeeRefreshPeriod
+	if period <= 0 {
+		return time.Time{}
+	}
+
+	next := params.AutoFeeStartDate.Add(period)
+	if now.Before(next) {
+		return next
+	}
+
+	elapsed := now.Sub(params.AutoFeeStartDate)/period + 1
+
+	return params.AutoFeeStartDate.Add(elapsed * period)
+}
+
+// ICalendar formats a set of calendar events as an iCalendar feed, so that
+// they can be imported into calendar applications. The time provided is used
+// as the time stamp of each event.
+func ICalendar(events []*CalendarEvent, stamp time.Time) string {
+	var b strings.Builder
+
+	writeLine := func(format string, args ...interface{}) {
+		fmt.Fprintf(&b, format+"\r\n", args...)
+	}
+
+	writeLine("BEGIN:VCALENDAR")
+	writeLine("VERSION:2.0")
+	writeLine("PRODID:-//Lightning Labs//Loop//EN")
+
+	for i, event := range events {
+		writeLine("BEGIN:VEVENT")
+		writeLine("UID:%v-%v@loop", event.Start.Unix(), i)
+		writeLine("DTSTAMP:%v", formatICalTime(stamp))
+		writeLine("DTSTART:%v", formatICalTime(event.Start))
+		writeLine("DTEND:%v", formatICalTime(event.End))
+		writeLine("SUMMARY:%v", escapeICalText(event.Summary))
+		writeLine("CATEGORIES:%v", escapeICalText(event.Type.String()))
+		writeLine("END:VEVENT")
+	}
+
+	writeLine("END:VCALENDAR")
+
+	return b.String()
+}
+
+// formatICalTime formats a time in UTC, as required by our iCalendar export.
+func formatICalTime(t time.Time) string {
+	return t.UTC().Format(icalTimeFormat)
+}
+
+// escapeICalText escapes the characters that have special meaning in
+// iCalendar text values.
+func escapeICalText(text string) string {
+	return strings.NewReplacer(
+		`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`,
+	).Replace(text)
+}
@@ -0,0 +1,160 @@
+package liquidity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCalendar tests the events that our calendar reports for the actions
+// that autoloop plans to take.
+func TestCalendar(t *testing.T) {
+	cfg, _ := newTestConfig()
+	cfg.Clock = clock.NewTestClock(testTime)
+
+	manager := NewManager(cfg)
+
+	_, err := manager.Calendar(0)
+	require.ErrorIs(t, err, ErrInvalidCalendarHorizon)
+
+	_, err = manager.Calendar(maxCalendarHorizon + 1)
+	require.ErrorIs(t, err, ErrInvalidCalendarHorizon)
+
+	// Our test time is at midnight, so we are outside of our daily
+	// dispatch window and deferred swaps are expected at its next start.
+	expiredRule := *chanRule
+	expiredRule.Expiry = testTime.Add(time.Hour * -1)
+
+	expiringRule := *chanRule
+	expiringRule.Expiry = testTime.Add(time.Hour * 6)
+
+	manager.params.Autoloop = true
+	manager.params.AutoloopSchedule = []ScheduleWindow{
+		{
+			Start: time.Hour * 12,
+			End:   time.Hour * 13,
+		},
+	}
+	manager.params.AutoFeeStartDate = testTime.Add(time.Hour * -20)
+	manager.params.AutoFeeRefreshPeriod = time.Hour * 24
+	manager.params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: &expiringRule,
+	}
+	manager.params.PeerRules = map[route.Vertex]*SwapRule{
+		peer1: &expiredRule,
+	}
+
+	manager.setDeferred(false, &loopdb.SuggestionRound{
+		LoopOut: []*loopdb.SuggestedSwap{
+			{
+				Channels: []uint64{chanID2.ToUint64()},
+				Amount:   5000,
+			},
+		},
+	})
+
+	approval := &PendingApproval{
+		LoopOut: &chan1Rec,
+		Expiry:  testTime.Add(time.Hour),
+	}
+	require.True(t, manager.approvals.add(approval, testTime))
+
+	events, err := manager.Calendar(time.Hour * 37)
+	require.NoError(t, err)
+
+	var (
+		approvalExpiry = testTime.Add(time.Hour)
+		refresh        = testTime.Add(time.Hour * 4)
+		ruleExpiry     = testTime.Add(time.Hour * 6)
+		window1        = testTime.Add(time.Hour * 12)
+		window2        = testTime.Add(time.Hour * 36)
+	)
+
+	require.Equal(t, []*CalendarEvent{
+		{
+			Type:  CalendarApprovalExpiry,
+			Start: approvalExpiry,
+			End:   approvalExpiry,
+			Summary: "approval expires: loop out of 0.000075 BTC " +
+				"over channels 1",
+			Amount: chan1Rec.Amount,
+		},
+		{
+			Type:    CalendarBudgetRefresh,
+			Start:   refresh,
+			End:     refresh,
+			Summary: "autoloop budget refresh",
+		},
+		{
+			Type:    CalendarRuleExpiry,
+			Start:   ruleExpiry,
+			End:     ruleExpiry,
+			Summary: "rule expires: channel 0:0:1",
+		},
+		{
+			Type:    CalendarDispatchWindow,
+			Start:   window1,
+			End:     window1.Add(time.Hour),
+			Summary: "autoloop dispatch window",
+		},
+		{
+			Type:  CalendarDeferredSwap,
+			Start: window1,
+			End:   window1,
+			Summary: "deferred loop out of 0.00005 BTC over " +
+				"channels 2",
+			Amount: 5000,
+		},
+		{
+			Type:    CalendarDispatchWindow,
+			Start:   window2,
+			End:     window2.Add(time.Hour),
+			Summary: "autoloop dispatch window",
+		},
+	}, events)
+
+	// Once a tick dispatches its swaps, we no longer report deferred
+	// swaps.
+	manager.setDeferred(true, &loopdb.SuggestionRound{})
+	events, err = manager.Calendar(time.Hour * 37)
+	require.NoError(t, err)
+	for _, event := range events {
+		require.NotEqual(t, CalendarDeferredSwap, event.Type)
+	}
+
+	ical := ICalendar(events, testTime)
+	require.True(t, strings.HasPrefix(ical, "BEGIN:VCALENDAR\r\n"))
+	require.Contains(t, ical, "DTSTART:20200213T120000Z\r\n")
+	require.Contains(t, ical, "SUMMARY:rule expires: channel 0:0:1\r\n")
+}
+
+// TestWindowOccurrences tests finding the occurrences of a schedule window
+// within a period, including windows that wrap past midnight.
+func TestWindowOccurrences(t *testing.T) {
+	// Our test time is a Thursday at midnight.
+	window := ScheduleWindow{
+		Days:  []time.Weekday{time.Wednesday, time.Thursday},
+		Start: time.Hour * 22,
+		End:   time.Hour * 2,
+	}
+
+	occurrences := window.occurrences(
+		testTime, testTime.Add(time.Hour*48),
+	)
+	require.Equal(t, [][2]time.Time{
+		{
+			testTime.Add(time.Hour * -2),
+			testTime.Add(time.Hour * 2),
+		},
+		{
+			testTime.Add(time.Hour * 22),
+			testTime.Add(time.Hour * 26),
+		},
+	}, occurrences)
+}
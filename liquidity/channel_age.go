@@ -0,0 +1,85 @@
+package liquidity
+
+import (
+	"context"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// channelAge returns the number of blocks that have been mined since the
+// channel provided was confirmed. Channels that are confirmed above the
+// current height, for example because they are identified by an alias while
+// unconfirmed, have an age of zero.
+func channelAge(channel lnwire.ShortChannelID, height uint32) uint32 {
+	if channel.BlockHeight > height {
+		return 0
+	}
+
+	return height - channel.BlockHeight
+}
+
+// filterYoung removes the channels that are younger than our minimum channel
+// age at the height provided from the set of channels provided, returning
+// the channels that we may manage and the young channels that we do not touch
+// until their natural flow is known.
+func (p Parameters) filterYoung(channels []lndclient.ChannelInfo,
+	height uint32) ([]lndclient.ChannelInfo, []lndclient.ChannelInfo) {
+
+	if p.MinChannelAge == 0 {
+		return channels, nil
+	}
+
+	var managed, young []lndclient.ChannelInfo
+	for _, channel := range channels {
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		if channelAge(chanID, height) < p.MinChannelAge {
+			young = append(young, channel)
+			continue
+		}
+
+		managed = append(managed, channel)
+	}
+
+	return managed, young
+}
+
+// currentHeight returns our current block height if we have a minimum
+// channel age set, so that we only query lnd when we need to.
+func (m *Manager) currentHeight(ctx context.Context) (uint32, error) {
+	if m.params.MinChannelAge == 0 {
+		return 0, nil
+	}
+
+	info, err := m.cfg.Node.GetInfo(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return info.BlockHeight, nil
+}
+
+// disqualifyYoung records the channels that are younger than our minimum
+// channel age, and the peer rules that we cannot suggest swaps for because all
+// of the peer's channels are young. Peers that have other channels keep their
+// rule, which only considers the balances of their older channels.
+func (p Parameters) disqualifyYoung(resp *Suggestions,
+	young []lndclient.ChannelInfo,
+	peerChannels map[route.Vertex]*balances) {
+
+	for _, channel := range young {
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		resp.DisqualifiedChans[chanID] = ReasonChannelAge
+
+		if _, ok := p.PeerRules[channel.PubKeyBytes]; !ok {
+			continue
+		}
+
+		if _, ok := peerChannels[channel.PubKeyBytes]; ok {
+			continue
+		}
+
+		resp.DisqualifiedPeers[channel.PubKeyBytes] = ReasonChannelAge
+	}
+}
@@ -0,0 +1,164 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChannelAge tests calculation of the age of a channel.
+func TestChannelAge(t *testing.T) {
+	channel := lnwire.ShortChannelID{BlockHeight: 100}
+	require.EqualValues(t, 50, channelAge(channel, 150))
+
+	// Channels that are identified by an alias have a block height far
+	// above our current height, so they have no age.
+	alias := lnwire.ShortChannelID{BlockHeight: 16_000_000}
+	require.EqualValues(t, 0, channelAge(alias, 150))
+}
+
+// TestMinChannelAge tests that we do not suggest swaps for channels that are
+// younger than our minimum channel age. Our mock lnd reports a height of 600.
+func TestMinChannelAge(t *testing.T) {
+	// Create a channel with our second peer that confirmed 10 blocks
+	// ago.
+	youngID := lnwire.ShortChannelID{BlockHeight: 590}
+	youngChannel := channel2
+	youngChannel.ChannelID = youngID.ToUint64()
+
+	youngRec := chan2Rec
+	youngRec.OutgoingChanSet = loopdb.ChannelSet{youngID.ToUint64()}
+
+	tests := []struct {
+		name         string
+		minAge       uint32
+		channelRules map[lnwire.ShortChannelID]*SwapRule
+		peerRules    map[route.Vertex]*SwapRule
+		groups       map[string]*ChannelGroup
+		suggestions  *Suggestions
+	}{
+		{
+			name:   "no minimum age",
+			minAge: 0,
+			channelRules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+				youngID: chanRule,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, youngRec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:   "channel old enough",
+			minAge: 10,
+			channelRules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+				youngID: chanRule,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, youngRec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:   "channel too young",
+			minAge: 11,
+			channelRules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+				youngID: chanRule,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					youngID: ReasonChannelAge,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:   "peer with young channels",
+			minAge: 11,
+			peerRules: map[route.Vertex]*SwapRule{
+				peer2: chanRule,
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					youngID: ReasonChannelAge,
+				},
+				DisqualifiedPeers: map[route.Vertex]Reason{
+					peer2: ReasonChannelAge,
+				},
+			},
+		},
+		{
+			name:   "group with young channels",
+			minAge: 11,
+			groups: map[string]*ChannelGroup{
+				"young": {
+					Channels: []lnwire.ShortChannelID{
+						youngID,
+					},
+					Rule: chanRule,
+				},
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					youngID: ReasonChannelAge,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+				DisqualifiedGroups: map[string]Reason{
+					"young": ReasonChannelAge,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, youngChannel,
+			}
+
+			params := defaultParameters
+			params.MaxAutoInFlight = 2
+			params.MinChannelAge = testCase.minAge
+			params.ChannelGroups = testCase.groups
+
+			params.ChannelRules = testCase.channelRules
+			if params.ChannelRules == nil {
+				params.ChannelRules = make(
+					map[lnwire.ShortChannelID]*SwapRule,
+				)
+			}
+
+			params.PeerRules = testCase.peerRules
+			if params.PeerRules == nil {
+				params.PeerRules = make(
+					map[route.Vertex]*SwapRule,
+				)
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
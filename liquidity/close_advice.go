@@ -0,0 +1,193 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// ErrUnknownChannel is returned when close advice is requested for a
+	// channel that is not one of our open channels.
+	ErrUnknownChannel = errors.New("channel not found in open channels")
+)
+
+// CloseAdvice describes the swaps that involve a channel that we are
+// considering closing, so that we do not close it while a swap depends on it.
+type CloseAdvice struct {
+	// Channel is the channel that the advice is for.
+	Channel lnwire.ShortChannelID
+
+	// Peer is the peer that the channel is with.
+	Peer route.Vertex
+
+	// InFlight is the set of swap hashes of pending swaps that involve the
+	// channel. Loop outs are included if they may use the channel, and
+	// loop ins if their last hop is the channel's peer.
+	InFlight []lntypes.Hash
+
+	// SunkCost is our estimate of the fees that we have already committed
+	// to the swaps in flight, which we risk losing if the channel is
+	// closed before they complete.
+	SunkCost btcutil.Amount
+
+	// SuggestedOut is the set of loop outs that our current rules suggest
+	// for the channel.
+	SuggestedOut []loop.OutRequest
+
+	// SuggestedIn is the set of loop ins that our current rules suggest
+	// for the channel's peer.
+	SuggestedIn []loop.LoopInRequest
+
+	// Wait is true if we recommend waiting for the swaps in flight to
+	// complete before closing the channel.
+	Wait bool
+}
+
+// sunkLoopOutCost estimates the fees that we have committed to a pending loop
+// out. The prepay is paid to the server when the swap starts, but it is only
+// recorded in the swap's cost once the payment has settled, so if no server
+// cost has been recorded yet we assume that the prepay invoice has been paid.
+func sunkLoopOutCost(params *chaincfg.Params, out *loopdb.LoopOut) (
+	btcutil.Amount, error) {
+
+	cost := out.State().Cost
+	if cost.Server == 0 {
+		_, _, _, prepay, err := swap.DecodeInvoice(
+			params, out.Contract.PrepayInvoice,
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		cost.Server = prepay
+	}
+
+	return cost.Total(), nil
+}
+
+// CloseAdvice reports the swaps in flight and the swaps suggested by our
+// current rules that involve the channel provided, along with the fees that we
+// have already committed to the swaps in flight. We recommend waiting before
+// closing the channel if any swaps that involve it are still in flight,
+// because closing it may cause them to fail after we have paid for them.
+func (m *Manager) CloseAdvice(ctx context.Context,
+	channel lnwire.ShortChannelID) (*CloseAdvice, error) {
+
+	channels, err := m.cfg.Channels.ListChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	advice := &CloseAdvice{
+		Channel: channel,
+	}
+
+	var found bool
+	for _, info := range channels {
+		if info.ChannelID == channel.ToUint64() {
+			advice.Peer = info.PubKeyBytes
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return nil, ErrUnknownChannel
+	}
+
+	loopOut, err := m.cfg.Swaps.ListLoopOut()
+	if err != nil {
+		return nil, err
+	}
+
+	loopIn, err := m.cfg.Swaps.ListLoopIn()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, out := range loopOut {
+		if out.State().State.Type() != loopdb.StateTypePending {
+			continue
+		}
+
+		// Loop outs that are not restricted to a set of channels may
+		// use any of our channels.
+		chanSet := out.Contract.OutgoingChanSet
+		if len(chanSet) != 0 && !chanSetContains(chanSet, channel) {
+			continue
+		}
+
+		sunk, err := sunkLoopOutCost(m.cfg.ChainParams, out)
+		if err != nil {
+			return nil, err
+		}
+
+		advice.InFlight = append(advice.InFlight, out.Hash)
+		advice.SunkCost += sunk
+	}
+
+	for _, in := range loopIn {
+		if in.State().State.Type() != loopdb.StateTypePending {
+			continue
+		}
+
+		lastHop := in.Contract.LastHop
+		if lastHop == nil || *lastHop != advice.Peer {
+			continue
+		}
+
+		advice.InFlight = append(advice.InFlight, in.Hash)
+		advice.SunkCost += in.State().Cost.Total()
+	}
+
+	advice.Wait = len(advice.InFlight) != 0
+
+	// If we have no rules set, there are no suggestions to report.
+	suggestions, err := m.SuggestSwaps(ctx, false)
+	switch err {
+	case nil:
+
+	case ErrNoRules:
+		return advice, nil
+
+	default:
+		return nil, err
+	}
+
+	for _, out := range suggestions.OutSwaps {
+		if chanSetContains(out.OutgoingChanSet, channel) {
+			advice.SuggestedOut = append(advice.SuggestedOut, out)
+		}
+	}
+
+	for _, in := range suggestions.InSwaps {
+		if in.LastHop != nil && *in.LastHop == advice.Peer {
+			advice.SuggestedIn = append(advice.SuggestedIn, in)
+		}
+	}
+
+	return advice, nil
+}
+
+// chanSetContains returns true if the channel set provided contains the
+// channel.
+func chanSetContains(chanSet loopdb.ChannelSet,
+	channel lnwire.ShortChannelID) bool {
+
+	for _, id := range chanSet {
+		if id == channel.ToUint64() {
+			return true
+		}
+	}
+
+	return false
+}
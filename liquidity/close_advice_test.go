@@ -0,0 +1,194 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCloseAdvice tests reporting of the swaps that involve a channel that we
+// want to close.
+func TestCloseAdvice(t *testing.T) {
+	var (
+		outHash = lntypes.Hash{1}
+		inHash  = lntypes.Hash{2}
+
+		pending = []*loopdb.LoopEvent{
+			{
+				SwapStateData: loopdb.SwapStateData{
+					State: loopdb.StateInitiated,
+				},
+			},
+		}
+
+		published = []*loopdb.LoopEvent{
+			{
+				SwapStateData: loopdb.SwapStateData{
+					State: loopdb.StateHtlcPublished,
+					Cost: loopdb.SwapCost{
+						Onchain: 200,
+					},
+				},
+			},
+		}
+	)
+
+	prepayInvoice, err := test.GetInvoice(outHash, 50, "prepay")
+	require.NoError(t, err)
+
+	var (
+		// pendingOut is a loop out on channel 1 that has not recorded
+		// its prepay yet.
+		pendingOut = &loopdb.LoopOut{
+			Loop: loopdb.Loop{
+				Hash:   outHash,
+				Events: pending,
+			},
+			Contract: &loopdb.LoopOutContract{
+				PrepayInvoice: prepayInvoice,
+				OutgoingChanSet: loopdb.ChannelSet{
+					chanID1.ToUint64(),
+				},
+			},
+		}
+
+		// pendingIn is a loop in via peer 1 that has published its
+		// htlc.
+		pendingIn = &loopdb.LoopIn{
+			Loop: loopdb.Loop{
+				Hash:   inHash,
+				Events: published,
+			},
+			Contract: &loopdb.LoopInContract{
+				LastHop: &peer1,
+			},
+		}
+	)
+
+	otherChanOut := *pendingOut
+	otherChanOut.Contract = &loopdb.LoopOutContract{
+		OutgoingChanSet: loopdb.ChannelSet{chanID2.ToUint64()},
+	}
+
+	tests := []struct {
+		name    string
+		channel lnwire.ShortChannelID
+		rules   map[lnwire.ShortChannelID]*SwapRule
+		loopOut []*loopdb.LoopOut
+		loopIn  []*loopdb.LoopIn
+		advice  *CloseAdvice
+		err     error
+	}{
+		{
+			name:    "unknown channel",
+			channel: chanID3,
+			err:     ErrUnknownChannel,
+		},
+		{
+			name:    "no swaps",
+			channel: chanID1,
+			advice: &CloseAdvice{
+				Channel: chanID1,
+				Peer:    peer1,
+			},
+		},
+		{
+			name:    "swap suggested",
+			channel: chanID1,
+			rules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			},
+			advice: &CloseAdvice{
+				Channel: chanID1,
+				Peer:    peer1,
+				SuggestedOut: []loop.OutRequest{
+					chan1Rec,
+				},
+			},
+		},
+		{
+			name:    "loop out in flight",
+			channel: chanID1,
+			rules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			},
+			loopOut: []*loopdb.LoopOut{
+				pendingOut,
+			},
+			advice: &CloseAdvice{
+				Channel:  chanID1,
+				Peer:     peer1,
+				InFlight: []lntypes.Hash{outHash},
+				SunkCost: 50,
+				Wait:     true,
+			},
+		},
+		{
+			name:    "loop out on other channel",
+			channel: chanID1,
+			loopOut: []*loopdb.LoopOut{
+				&otherChanOut,
+			},
+			advice: &CloseAdvice{
+				Channel: chanID1,
+				Peer:    peer1,
+			},
+		},
+		{
+			name:    "loop in via peer",
+			channel: chanID1,
+			loopIn: []*loopdb.LoopIn{
+				pendingIn,
+			},
+			advice: &CloseAdvice{
+				Channel:  chanID1,
+				Peer:     peer1,
+				InFlight: []lntypes.Hash{inHash},
+				SunkCost: btcutil.Amount(200),
+				Wait:     true,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			cfg.Swaps = &testSwaps{
+				loopOut: testCase.loopOut,
+				loopIn:  testCase.loopIn,
+			}
+
+			params := defaultParameters
+			if testCase.rules != nil {
+				params.ChannelRules = testCase.rules
+			}
+
+			manager := NewManager(cfg)
+			err := manager.SetParameters(
+				context.Background(), params,
+			)
+			require.NoError(t, err)
+
+			advice, err := manager.CloseAdvice(
+				context.Background(), testCase.channel,
+			)
+			require.Equal(t, testCase.err, err)
+			require.Equal(t, testCase.advice, advice)
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// maxDestinationIndex is the number of addresses that we derive from a rule's
+// xpub while looking for one that we have not used yet.
+const maxDestinationIndex = 10000
+
+var (
+	// ErrDestinationLoopIn is returned if a sweep destination is set for a
+	// loop in rule, because loop ins do not sweep to our wallet.
+	ErrDestinationLoopIn = errors.New("sweep destination may only be set " +
+		"for loop out rules")
+
+	// ErrExclusiveDestination is returned if a sweep destination sets
+	// both an address and an xpub, or neither.
+	ErrExclusiveDestination = errors.New("sweep destination must set " +
+		"exactly one of address or xpub")
+
+	// ErrPrivateXpub is returned if a sweep destination is set with an
+	// extended private key, which we do not want to hold.
+	ErrPrivateXpub = errors.New("sweep destination must be an extended " +
+		"public key")
+
+	// ErrDestinationExhausted is returned when we cannot find an unused
+	// address to derive from a rule's xpub.
+	ErrDestinationExhausted = errors.New("no unused sweep destination " +
+		"address found")
+)
+
+// SweepDestination is the destination that the sweeps of the swaps that a
+// loop out rule suggests are paid to, rather than our lnd wallet. Exactly one
+// of Address or Xpub is set.
+type SweepDestination struct {
+	// Address is a fixed address that all of the rule's swaps are swept
+	// to.
+	Address btcutil.Address
+
+	// Xpub is an extended public key that we derive a fresh address from
+	// for each of the rule's swaps. Addresses are derived as native segwit
+	// addresses on the external branch (m/0/i) of the key.
+	Xpub *hdkeychain.ExtendedKey
+
+	// Verification records how a fixed address was verified to be owned
+	// by the user, and is stored with each swap that sweeps to it. It is
+	// nil if the address was not verified.
+	Verification *loopdb.DestVerification
+
+	// XpubVerified indicates that Xpub is one of the destination xpubs
+	// that loopd is configured with. Addresses derived from a verified
+	// xpub are verified by their derivation, while addresses derived from
+	// other xpubs are not verified.
+	XpubVerified bool
+}
+
+// String returns the string representation of a sweep destination.
+func (d *SweepDestination) String() string {
+	if d.Xpub != nil {
+		return fmt.Sprintf("xpub: %v", d.Xpub)
+	}
+
+	return fmt.Sprintf("address: %v", d.Address)
+}
+
+// clone returns a copy of a sweep destination that does not share its
+// verification with the original. The address and xpub are not copied,
+// because they cannot be modified.
+func (d *SweepDestination) clone() *SweepDestination {
+	destCopy := *d
+	if d.Verification != nil {
+		verification := *d.Verification
+		destCopy.Verification = &verification
+	}
+
+	return &destCopy
+}
+
+// validate returns an error if a sweep destination is invalid.
+func (d *SweepDestination) validate() error {
+	if (d.Address == nil) == (d.Xpub == nil) {
+		return ErrExclusiveDestination
+	}
+
+	if d.Xpub != nil && d.Xpub.IsPrivate() {
+		return ErrPrivateXpub
+	}
+
+	return nil
+}
+
+// destinationState tracks the xpub addresses that we have handed out to swaps
+// since we started, so that swaps that are suggested before earlier swaps are
+// stored do not reuse their addresses.
+type destinationState struct {
+	// used is the set of addresses that we have handed out.
+	used map[string]bool
+
+	mu sync.Mutex
+}
+
+// newDestinationState creates an empty destination state.
+func newDestinationState() *destinationState {
+	return &destinationState{
+		used: make(map[string]bool),
+	}
+}
+
+// release removes an address that we handed out from our set of used
+// addresses, so that it may be handed out again.
+func (d *destinationState) release(addr btcutil.Address) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.used, addr.String())
+}
+
+// sweepAddress returns the address that a swap suggested by a rule with the
+// destination provided should sweep to, along with its verification. Xpub
+// destinations return the first address that is not the destination of any of
+// our stored swaps and has not been handed out since we started.
+func (m *Manager) sweepAddress(dest *SweepDestination) (btcutil.Address,
+	*loopdb.DestVerification, error) {
+
+	if dest.Xpub == nil {
+		return dest.Address, dest.Verification, nil
+	}
+
+	loopOuts, err := m.cfg.Swaps.ListLoopOut()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	state := m.destinations
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	used := make(map[string]bool, len(loopOuts)+len(state.used))
+	for addr := range state.used {
+		used[addr] = true
+	}
+
+	for _, loopOut := range loopOuts {
+		if loopOut.Contract.DestAddr != nil {
+			used[loopOut.Contract.DestAddr.String()] = true
+		}
+	}
+
+	for i := uint32(0); i < maxDestinationIndex; i++ {
+		addr, err := loop.XpubAddress(
+			dest.Xpub, 0, i, m.cfg.ChainParams,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if used[addr.String()] {
+			continue
+		}
+
+		state.used[addr.String()] = true
+
+		if !dest.XpubVerified {
+			return addr, nil, nil
+		}
+
+		return addr, loop.XpubVerification(dest.Xpub, 0, i), nil
+	}
+
+	return nil, nil, ErrDestinationExhausted
+}
+
+// dispatchLoopOut dispatches a loop out that sweeps to the destination
+// provided, or to the address that the swap already has if the destination is
+// nil. Addresses derived from an xpub are released if the swap fails to
+// dispatch, so that they are not skipped by our next swap.
+func (m *Manager) dispatchLoopOut(ctx context.Context, out *loop.OutRequest,
+	dest *SweepDestination) (*loop.LoopOutSwapInfo, error) {
+
+	if dest == nil {
+		return m.cfg.Dispatcher.LoopOut(ctx, out)
+	}
+
+	addr, verification, err := m.sweepAddress(dest)
+	if err != nil {
+		return nil, err
+	}
+
+	out.DestAddr = addr
+	out.DestVerification = verification
+
+	loopOut, err := m.cfg.Dispatcher.LoopOut(ctx, out)
+	if err != nil && dest.Xpub != nil {
+		m.destinations.release(addr)
+	}
+
+	return loopOut, err
+}
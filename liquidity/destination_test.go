@@ -0,0 +1,221 @@
+package liquidity
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// testXpub returns an extended public key for the network provided, along
+// with its private key.
+func testXpub(t *testing.T, params *chaincfg.Params) (*hdkeychain.ExtendedKey,
+	*hdkeychain.ExtendedKey) {
+
+	seed := bytes.Repeat([]byte{1}, hdkeychain.RecommendedSeedLen)
+	master, err := hdkeychain.NewMaster(seed, params)
+	require.NoError(t, err)
+
+	xpub, err := master.Neuter()
+	require.NoError(t, err)
+
+	return xpub, master
+}
+
+// TestSweepDestinationValidate tests validation of sweep destinations.
+func TestSweepDestinationValidate(t *testing.T) {
+	xpub, xpriv := testXpub(t, &chaincfg.TestNet3Params)
+
+	addr, err := loop.XpubAddress(xpub, 0, 0, &chaincfg.TestNet3Params)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		dest *SweepDestination
+		err  error
+	}{
+		{
+			name: "address",
+			dest: &SweepDestination{Address: addr},
+		},
+		{
+			name: "xpub",
+			dest: &SweepDestination{Xpub: xpub},
+		},
+		{
+			name: "neither set",
+			dest: &SweepDestination{},
+			err:  ErrExclusiveDestination,
+		},
+		{
+			name: "both set",
+			dest: &SweepDestination{Address: addr, Xpub: xpub},
+			err:  ErrExclusiveDestination,
+		},
+		{
+			name: "private key",
+			dest: &SweepDestination{Xpub: xpriv},
+			err:  ErrPrivateXpub,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.err, testCase.dest.validate())
+		})
+	}
+
+	// Destinations may only be set for loop out rules.
+	rule := &SwapRule{
+		ThresholdRule:    NewThresholdRule(10, 10),
+		Type:             swap.TypeIn,
+		SweepDestination: &SweepDestination{Xpub: xpub},
+	}
+	require.Equal(t, ErrDestinationLoopIn, rule.validate())
+}
+
+// TestSweepAddress tests that we derive fresh addresses from xpub
+// destinations, skipping addresses that our stored swaps were swept to and
+// addresses that we have already handed out.
+func TestSweepAddress(t *testing.T) {
+	cfg, _ := newTestConfig()
+	params := cfg.ChainParams
+
+	xpub, _ := testXpub(t, params)
+
+	var addrs []btcutil.Address
+	for i := uint32(0); i < 3; i++ {
+		addr, err := loop.XpubAddress(xpub, 0, i, params)
+		require.NoError(t, err)
+
+		addrs = append(addrs, addr)
+	}
+
+	// Our first address was used by a swap in a previous run.
+	cfg.Swaps = &testSwaps{
+		loopOut: []*loopdb.LoopOut{
+			{
+				Contract: &loopdb.LoopOutContract{
+					DestAddr: addrs[0],
+				},
+			},
+		},
+	}
+
+	manager := NewManager(cfg)
+	dest := &SweepDestination{Xpub: xpub, XpubVerified: true}
+
+	addr, verification, err := manager.sweepAddress(dest)
+	require.NoError(t, err)
+	require.Equal(t, addrs[1], addr)
+	require.Equal(t, loop.XpubVerification(xpub, 0, 1), verification)
+
+	// Our second address has not been stored yet, but we have handed it
+	// out so we skip it. Addresses derived from xpubs that are not one of
+	// our configured xpubs are not verified.
+	dest.XpubVerified = false
+	addr, verification, err = manager.sweepAddress(dest)
+	require.NoError(t, err)
+	require.Equal(t, addrs[2], addr)
+	require.Nil(t, verification)
+
+	// Fixed addresses are always used as is, along with the verification
+	// that they were set with.
+	fixed := &SweepDestination{
+		Address: addrs[0],
+		Verification: &loopdb.DestVerification{
+			Method: loopdb.DestVerificationSignature,
+			Proof:  "signature",
+		},
+	}
+
+	addr, verification, err = manager.sweepAddress(fixed)
+	require.NoError(t, err)
+	require.Equal(t, addrs[0], addr)
+	require.Equal(t, fixed.Verification, verification)
+}
+
+// TestSweepDestinationDispatch tests that autoloop sweeps the swaps of rules
+// with a sweep destination to it, and records the destination's verification.
+// Addresses of swaps that fail to dispatch are released for our next swap.
+func TestSweepDestinationDispatch(t *testing.T) {
+	cfg, lnd := newTestConfig()
+	lnd.Channels = []lndclient.ChannelInfo{
+		channel1,
+	}
+
+	xpub, _ := testXpub(t, cfg.ChainParams)
+	expected, err := loop.XpubAddress(xpub, 0, 0, cfg.ChainParams)
+	require.NoError(t, err)
+
+	var (
+		dispatched    []btcutil.Address
+		verifications []*loopdb.DestVerification
+		dispatchErr   = errors.New("dispatch failed")
+		fail          = true
+	)
+	cfg.Dispatcher = &testDispatcher{
+		loopOut: func(_ context.Context,
+			req *loop.OutRequest) (*loop.LoopOutSwapInfo, error) {
+
+			dispatched = append(dispatched, req.DestAddr)
+			verifications = append(
+				verifications, req.DestVerification,
+			)
+
+			if fail {
+				return nil, dispatchErr
+			}
+
+			return &loop.LoopOutSwapInfo{
+				SwapHash: lntypes.Hash{1},
+			}, nil
+		},
+	}
+
+	rule := *chanRule
+	rule.SweepDestination = &SweepDestination{
+		Xpub:         xpub,
+		XpubVerified: true,
+	}
+
+	params := defaultParameters
+	params.Autoloop = true
+	params.AutoFeeBudget = btcutil.SatoshiPerBitcoin
+	params.AutoFeeStartDate = testTime.Add(-time.Hour)
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: &rule,
+	}
+
+	manager := NewManager(cfg)
+	ctx := context.Background()
+
+	require.NoError(t, manager.SetParameters(ctx, params))
+
+	// Our first swap fails to dispatch, so its address is released and
+	// handed out again to the swap that we dispatch on our next tick.
+	require.Equal(t, dispatchErr, manager.autoloop(ctx))
+
+	fail = false
+	require.NoError(t, manager.autoloop(ctx))
+	require.Equal(t, []btcutil.Address{expected, expected}, dispatched)
+
+	verification := loop.XpubVerification(xpub, 0, 0)
+	require.Equal(t, []*loopdb.DestVerification{
+		verification, verification,
+	}, verifications)
+}
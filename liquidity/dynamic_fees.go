@@ -0,0 +1,218 @@
+package liquidity
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrZeroMinerFeePPM is returned if a dynamic fee limit does not
+	// allow any portion of the swap amount to be spent on miner fees.
+	ErrZeroMinerFeePPM = errors.New("miner fee ppm must be non-zero")
+
+	// ErrZeroMinerFeeMultiplier is returned if a dynamic fee limit has a
+	// zero miner fee multiplier, which would not allow us to pay any miner
+	// fees at all.
+	ErrZeroMinerFeeMultiplier = errors.New("miner fee multiplier must " +
+		"be non-zero")
+)
+
+// Compile time assertion that DynamicFeeLimit implements FeeLimit.
+var _ FeeLimit = (*DynamicFeeLimit)(nil)
+
+// DynamicFeeLimit is a fee limit which ties our on-chain fee limits to the
+// current fee estimate provided by our quotes, and our off-chain and server
+// fee limits to the swap amount. Unlike our other fee limits, it does not
+// set any absolute fee caps, so the same limit can be used in low and high
+// fee environments without being retuned.
+type DynamicFeeLimit struct {
+	// PartsPerMillion is the portion of the swap amount that may be spent
+	// on server fees, off-chain routing fees and the no-show penalty.
+	PartsPerMillion uint64
+
+	// MinerFeePPM is the largest portion of the swap amount that the
+	// estimated on-chain fees for a swap may consume. Swaps that are too
+	// small for current on-chain fees are not suggested.
+	MinerFeePPM uint64
+
+	// MinerFeeMultiplier is the multiple of our estimated on-chain fees
+	// that we are willing to pay on chain if fees spike after we have
+	// committed to a swap.
+	MinerFeeMultiplier uint64
+}
+
+// NewDynamicFeeLimit creates a fee limit which scales with the current fee
+// estimate and the swap amount.
+func NewDynamicFeeLimit(ppm, minerFeePPM,
+	minerFeeMultiplier uint64) *DynamicFeeLimit {
+
+	return &DynamicFeeLimit{
+		PartsPerMillion:    ppm,
+		MinerFeePPM:        minerFeePPM,
+		MinerFeeMultiplier: minerFeeMultiplier,
+	}
+}
+
+// String returns a string representation of the fee limit.
+func (f *DynamicFeeLimit) String() string {
+	return fmt.Sprintf("dynamic: parts per million: %v, miner fee ppm: "+
+		"%v, miner fee multiplier: %v", f.PartsPerMillion,
+		f.MinerFeePPM, f.MinerFeeMultiplier)
+}
+
+// validate returns an error if the values provided are invalid.
+func (f *DynamicFeeLimit) validate() error {
+	if f.PartsPerMillion == 0 {
+		return ErrInvalidPPM
+	}
+
+	if f.MinerFeePPM == 0 {
+		return ErrZeroMinerFeePPM
+	}
+
+	if f.MinerFeeMultiplier == 0 {
+		return ErrZeroMinerFeeMultiplier
+	}
+
+	return nil
+}
+
+// mayLoopOut does not check our fee estimate, because we need the swap
+// amount to decide whether on-chain fees are too high for a swap. This check
+// is performed against the quote's miner fee in loopOutLimits.
+func (f *DynamicFeeLimit) mayLoopOut(_ chainfee.SatPerKWeight) error {
+	return nil
+}
+
+// minerFeeLimit returns the largest on-chain fee estimate that we accept for
+// a swap of the amount provided.
+func (f *DynamicFeeLimit) minerFeeLimit(amount btcutil.Amount) btcutil.Amount {
+	return ppmToSat(amount, f.MinerFeePPM)
+}
+
+// loopOutLimits checks whether the quote provided is within our fee limits
+// for the swap amount. Our total cost ceiling is our portion of the swap
+// amount plus the quote's on-chain fee estimate, so that it rises with
+// on-chain fees.
+func (f *DynamicFeeLimit) loopOutLimits(amount btcutil.Amount,
+	quote *loop.LoopOutQuote) error {
+
+	minerLimit := f.minerFeeLimit(amount)
+	if quote.MinerFee > minerLimit {
+		log.Debugf("miner fee: %v greater than limit: %v, at %v ppm",
+			quote.MinerFee, minerLimit, f.MinerFeePPM)
+
+		return newReasonError(ReasonMinerFee)
+	}
+
+	feeLimit := ppmToSat(amount, f.PartsPerMillion)
+	if quote.SwapFee > feeLimit {
+		log.Debugf("swap fee: %v greater than fee limit: %v, at "+
+			"%v ppm", quote.SwapFee, feeLimit, f.PartsPerMillion)
+
+		return newReasonError(ReasonSwapFee)
+	}
+
+	if quote.PrepayAmount > feeLimit {
+		log.Debugf("prepay amount: %v greater than fee limit: %v, at "+
+			"%v ppm", quote.PrepayAmount, feeLimit,
+			f.PartsPerMillion)
+
+		return newReasonError(ReasonPrepay)
+	}
+
+	// If our swap fee equals our limit, we will have nothing left for
+	// off-chain fees, so we fail out early.
+	if quote.SwapFee >= feeLimit {
+		log.Debugf("no budget for off-chain routing with swap fee: "+
+			"%v and fee limit: %v, at %v ppm", quote.SwapFee,
+			feeLimit, f.PartsPerMillion)
+
+		return newReasonError(ReasonFeePPMInsufficient)
+	}
+
+	prepay, route, _ := f.loopOutFees(amount, quote)
+
+	// We check our worst case fees at the current estimate rather than
+	// at our miner fee ceiling, which only covers fee spikes.
+	fees := worstCaseOutFees(
+		prepay, route, quote.SwapFee, quote.MinerFee,
+		quote.PrepayAmount,
+	)
+
+	totalLimit := feeLimit + quote.MinerFee
+	if fees > totalLimit {
+		log.Debugf("total fees for swap: %v > total limit: %v",
+			fees, totalLimit)
+
+		return newReasonError(ReasonFeePPMInsufficient)
+	}
+
+	return nil
+}
+
+// loopOutFees returns the maximum prepay and invoice routing fees for a swap
+// amount and quote, along with our miner fee ceiling. We assume that the
+// quote has already been validated, so that we have some of our fee limit
+// left for off-chain routing.
+func (f *DynamicFeeLimit) loopOutFees(amount btcutil.Amount,
+	quote *loop.LoopOutQuote) (btcutil.Amount, btcutil.Amount,
+	btcutil.Amount) {
+
+	available := ppmToSat(amount, f.PartsPerMillion) - quote.SwapFee
+
+	prepayMaxFee, routeMaxFee := splitOffChain(
+		available, quote.PrepayAmount, amount,
+	)
+
+	minerFee := quote.MinerFee * btcutil.Amount(f.MinerFeeMultiplier)
+
+	return prepayMaxFee, routeMaxFee, minerFee
+}
+
+// loopInLimits checks whether the quote provided is within our fee limits
+// for the swap amount.
+func (f *DynamicFeeLimit) loopInLimits(amount btcutil.Amount,
+	quote *loop.LoopInQuote) error {
+
+	minerLimit := f.minerFeeLimit(amount)
+	if quote.MinerFee > minerLimit {
+		log.Debugf("miner fee: %v greater than limit: %v, at %v ppm",
+			quote.MinerFee, minerLimit, f.MinerFeePPM)
+
+		return newReasonError(ReasonMinerFee)
+	}
+
+	feeLimit := ppmToSat(amount, f.PartsPerMillion)
+	if quote.SwapFee > feeLimit {
+		log.Debugf("swap fee: %v greater than fee limit: %v, at "+
+			"%v ppm", quote.SwapFee, feeLimit, f.PartsPerMillion)
+
+		return newReasonError(ReasonSwapFee)
+	}
+
+	fees := worstCaseInFees(
+		quote.MinerFee, quote.SwapFee, defaultLoopInSweepFee,
+	)
+
+	totalLimit := feeLimit + quote.MinerFee
+	if fees > totalLimit {
+		log.Debugf("total fees for swap: %v > total limit: %v",
+			fees, totalLimit)
+
+		return newReasonError(ReasonFeePPMInsufficient)
+	}
+
+	return nil
+}
+
+// previewLoopOut returns the fee breakdown we would use for a loop out swap.
+func (f *DynamicFeeLimit) previewLoopOut(amount btcutil.Amount,
+	quote *loop.LoopOutQuote) (*FeeBreakdown, error) {
+
+	return previewLoopOut(f, amount, quote)
+}
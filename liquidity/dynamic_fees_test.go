@@ -0,0 +1,163 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDynamicLoopOutLimits tests that the same dynamic fee limit accepts
+// swaps in low and high fee environments, and that its miner fee ceiling
+// scales with the current fee estimate.
+func TestDynamicLoopOutLimits(t *testing.T) {
+	const amount = btcutil.Amount(1000000)
+
+	// Allow 1% of the swap amount for swap and off-chain fees, and 2% for
+	// our on-chain fee estimate.
+	limit := NewDynamicFeeLimit(10000, 20000, 3)
+
+	quote := func(minerFee, swapFee,
+		prepay btcutil.Amount) *loop.LoopOutQuote {
+
+		return &loop.LoopOutQuote{
+			MinerFee:     minerFee,
+			SwapFee:      swapFee,
+			PrepayAmount: prepay,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		quote    *loop.LoopOutQuote
+		err      error
+		minerFee btcutil.Amount
+	}{
+		{
+			name:     "low fee environment",
+			quote:    quote(200, 5000, 1000),
+			minerFee: 600,
+		},
+		{
+			name:     "high fee environment",
+			quote:    quote(20000, 5000, 1000),
+			minerFee: 60000,
+		},
+		{
+			name:  "miner fee too high for amount",
+			quote: quote(20001, 5000, 1000),
+			err:   newReasonError(ReasonMinerFee),
+		},
+		{
+			name:  "swap fee too high",
+			quote: quote(200, 10001, 1000),
+			err:   newReasonError(ReasonSwapFee),
+		},
+		{
+			name:  "prepay too high",
+			quote: quote(200, 5000, 10001),
+			err:   newReasonError(ReasonPrepay),
+		},
+		{
+			name:  "no off-chain budget",
+			quote: quote(200, 10000, 1000),
+			err:   newReasonError(ReasonFeePPMInsufficient),
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			err := limit.loopOutLimits(amount, testCase.quote)
+			require.Equal(t, testCase.err, err)
+			if err != nil {
+				return
+			}
+
+			prepay, route, miner := limit.loopOutFees(
+				amount, testCase.quote,
+			)
+			require.Equal(t, testCase.minerFee, miner)
+
+			// Our off-chain fees should use up our budget that is
+			// left after the swap fee, rounded down.
+			available := ppmToSat(amount, limit.PartsPerMillion) -
+				testCase.quote.SwapFee
+			require.LessOrEqual(t, int64(prepay+route),
+				int64(available))
+			require.Greater(t, int64(prepay+route),
+				int64(available)-2)
+		})
+	}
+}
+
+// TestDynamicLoopInLimits tests checking loop in quotes against a dynamic fee
+// limit.
+func TestDynamicLoopInLimits(t *testing.T) {
+	const amount = btcutil.Amount(1000000)
+
+	limit := NewDynamicFeeLimit(10000, 20000, 3)
+
+	tests := []struct {
+		name  string
+		quote *loop.LoopInQuote
+		err   error
+	}{
+		{
+			name: "low fee environment",
+			quote: &loop.LoopInQuote{
+				MinerFee: 200,
+				SwapFee:  5000,
+			},
+		},
+		{
+			name: "high fee environment",
+			quote: &loop.LoopInQuote{
+				MinerFee: 20000,
+				SwapFee:  5000,
+			},
+		},
+		{
+			name: "miner fee too high for amount",
+			quote: &loop.LoopInQuote{
+				MinerFee: 20001,
+				SwapFee:  5000,
+			},
+			err: newReasonError(ReasonMinerFee),
+		},
+		{
+			name: "swap fee too high",
+			quote: &loop.LoopInQuote{
+				MinerFee: 200,
+				SwapFee:  10001,
+			},
+			err: newReasonError(ReasonSwapFee),
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			err := limit.loopInLimits(amount, testCase.quote)
+			require.Equal(t, testCase.err, err)
+		})
+	}
+}
+
+// TestDynamicFeeLimitValidate tests validation of dynamic fee limits.
+func TestDynamicFeeLimitValidate(t *testing.T) {
+	require.NoError(t, NewDynamicFeeLimit(1, 1, 1).validate())
+	require.Equal(
+		t, ErrInvalidPPM, NewDynamicFeeLimit(0, 1, 1).validate(),
+	)
+	require.Equal(
+		t, ErrZeroMinerFeePPM, NewDynamicFeeLimit(1, 0, 1).validate(),
+	)
+	require.Equal(
+		t, ErrZeroMinerFeeMultiplier,
+		NewDynamicFeeLimit(1, 1, 0).validate(),
+	)
+}
@@ -0,0 +1,201 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// ErrInvalidEasyTarget is returned when easy autoloop is enabled with
+	// an outbound target that is not a percentage in [1, 99].
+	ErrInvalidEasyTarget = errors.New("easy autoloop outbound target " +
+		"must be a percentage in [1, 99]")
+
+	// ErrEasyWithRules is returned when easy autoloop is enabled while
+	// channel, peer or group rules are set.
+	ErrEasyWithRules = errors.New("easy autoloop cannot be enabled " +
+		"with channel, peer or group rules")
+)
+
+// easyRule is the rule that easy autoloop's swaps are suggested with. Easy
+// autoloop sets the amount for each of its swaps itself, so the rule only
+// provides its swap type.
+var easyRule = &SwapRule{
+	Type: swap.TypeOut,
+}
+
+// easyCandidate is a peer that easy autoloop may loop out from.
+type easyCandidate struct {
+	// balance is the total balance of our channels with the peer.
+	balance *balances
+
+	// available is the amount of local balance that the peer has above
+	// our node-wide outbound target.
+	available btcutil.Amount
+
+	// feePPM is the estimated routing fee to the swap server over our
+	// channels with the peer, expressed as parts per million.
+	feePPM uint64
+}
+
+// easyTarget returns the local balance that a set of balances with the total
+// capacity provided should have to meet our outbound target.
+func (p Parameters) easyTarget(capacity btcutil.Amount) btcutil.Amount {
+	return capacity * btcutil.Amount(p.EasyOutboundTarget) / 100
+}
+
+// easyAutoloopJobs returns the suggestion jobs for easy autoloop. We loop out
+// the local balance that our node holds above our node-wide outbound target,
+// less the amount of our pending loop outs that have not been paid yet,
+// spreading it over the peers that have local balance above the target
+// themselves. Peers are ranked by the estimated routing fee to the swap
+// server over their channels, so that we swap against the cheapest channels
+// first. Peers that cannot currently be used are added to the suggestions
+// provided with the reason they were disqualified.
+func (m *Manager) easyAutoloopJobs(ctx context.Context,
+	peerChannels map[route.Vertex]*balances, connected connectedPeers,
+	traffic *swapTraffic, unpaid btcutil.Amount,
+	restrictions *Restrictions, resp *Suggestions,
+	stats *tickStats) ([]*suggestionJob, error) {
+
+	var capacity, outgoing btcutil.Amount
+	for _, balance := range peerChannels {
+		capacity += balance.capacity
+		outgoing += balance.outgoing
+	}
+
+	excess := outgoing - unpaid - m.params.easyTarget(capacity)
+	if excess < restrictions.Minimum {
+		log.Debugf("easy autoloop: local balance %v (%v unpaid in "+
+			"pending swaps) of %v capacity within %v%% target",
+			outgoing, unpaid, capacity,
+			m.params.EasyOutboundTarget)
+
+		return nil, nil
+	}
+
+	// We need the swap server's pubkey to estimate the routing fee for
+	// each of our peers, which we get from a quote.
+	stats.addQuote()
+	quote, err := m.cfg.Quotes.LoopOutQuote(ctx, &loop.LoopOutQuoteRequest{
+		Amount:                  restrictions.Minimum,
+		SweepConfTarget:         m.params.SweepConfTarget,
+		SwapPublicationDeadline: m.cfg.Clock.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	server := route.Vertex(quote.SwapPaymentDest)
+
+	var (
+		builder    = newLoopOutBuilder(m.cfg)
+		candidates []*easyCandidate
+	)
+
+	for peer, balance := range peerChannels {
+		available := balance.outgoing -
+			m.params.easyTarget(balance.capacity)
+
+		// We cannot send the part of our balance that is held back
+		// by channel reserves.
+		if usable := balance.usable(swap.TypeOut); available > usable {
+			available = usable
+		}
+
+		if available < restrictions.Minimum {
+			continue
+		}
+
+		if connected.offline(peer) {
+			resp.DisqualifiedPeers[peer] = ReasonPeerOffline
+			continue
+		}
+
+		err := builder.inUse(traffic, peer, balance.channels)
+		if err != nil {
+			var reasonErr *reasonError
+			if !errors.As(err, &reasonErr) {
+				return nil, err
+			}
+
+			resp.DisqualifiedPeers[peer] = reasonErr.reason
+			continue
+		}
+
+		probe := available
+		if probe > excess {
+			probe = excess
+		}
+
+		fee, _, err := m.peerRouteFee(ctx, peer, server, nil, probe)
+		switch {
+		case errors.Is(err, lndclient.ErrNoRouteFound):
+			log.Debugf("easy autoloop: no route to server from "+
+				"peer %v", peer)
+
+			continue
+
+		case err != nil:
+			return nil, err
+		}
+
+		candidates = append(candidates, &easyCandidate{
+			balance:   balance,
+			available: available,
+			feePPM:    uint64(fee) * FeeBase / uint64(probe),
+		})
+	}
+
+	return easyAllocate(candidates, excess, restrictions), nil
+}
+
+// easyAllocate spreads the excess amount provided over our candidates,
+// starting with the cheapest, and returns a suggestion job for each of the
+// peers that we loop out from. No peer is allocated more than its available
+// balance or the maximum swap amount, and we do not create jobs below the
+// minimum swap amount.
+func easyAllocate(candidates []*easyCandidate, excess btcutil.Amount,
+	restrictions *Restrictions) []*suggestionJob {
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].feePPM != candidates[j].feePPM {
+			return candidates[i].feePPM < candidates[j].feePPM
+		}
+
+		return candidates[i].available > candidates[j].available
+	})
+
+	var jobs []*suggestionJob
+	for _, candidate := range candidates {
+		amount := candidate.available
+		if amount > excess {
+			amount = excess
+		}
+
+		if restrictions.Maximum != 0 && amount > restrictions.Maximum {
+			amount = restrictions.Maximum
+		}
+
+		if amount < restrictions.Minimum {
+			continue
+		}
+
+		jobs = append(jobs, &suggestionJob{
+			peer:    candidate.balance.pubkey,
+			balance: candidate.balance,
+			rule:    easyRule,
+			amount:  amount,
+		})
+
+		excess -= amount
+	}
+
+	return jobs
+}
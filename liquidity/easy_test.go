@@ -0,0 +1,282 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEasyAutoloopJobs tests selection of the peers that easy autoloop loops
+// out from.
+func TestEasyAutoloopJobs(t *testing.T) {
+	var (
+		serverPubkey = route.Vertex{9}
+		peer3        = route.Vertex{3}
+
+		// Our first two peers each have 5000 sats of local balance
+		// above our 50% target, and our third peer has none.
+		balance1 = &balances{
+			capacity: 10000,
+			outgoing: 10000,
+			channels: []lnwire.ShortChannelID{chanID1},
+			pubkey:   peer1,
+		}
+		balance2 = &balances{
+			capacity: 10000,
+			outgoing: 10000,
+			channels: []lnwire.ShortChannelID{chanID2},
+			pubkey:   peer2,
+		}
+		balance3 = &balances{
+			capacity: 10000,
+			incoming: 10000,
+			channels: []lnwire.ShortChannelID{chanID3},
+			pubkey:   peer3,
+		}
+	)
+
+	cfg, lnd := newTestConfig()
+	cfg.Quotes = &testQuotes{
+		loopOutQuote: func(context.Context,
+			*loop.LoopOutQuoteRequest) (*loop.LoopOutQuote, error) {
+
+			return &loop.LoopOutQuote{
+				SwapPaymentDest: serverPubkey,
+			}, nil
+		},
+	}
+
+	// Both of our peers route to the server over the same channel, with
+	// peer 2 charging a lower fee to forward over it.
+	lnd.Routes = map[route.Vertex]*lndclient.QueryRoutesResponse{
+		serverPubkey: {
+			Hops: []*lndclient.Hop{
+				{ChannelID: 4},
+			},
+			TotalFeesMsat: 1000,
+			TotalAmtMsat: lnwire.NewMSatFromSatoshis(5000) +
+				1000,
+		},
+	}
+	lnd.ChannelEdges = map[uint64]*lndclient.ChannelEdge{
+		4: {
+			ChannelID: 4,
+			Node1:     peer1,
+			Node2:     peer2,
+			Node1Policy: &lndclient.RoutingPolicy{
+				FeeBaseMsat: 5000,
+			},
+			Node2Policy: &lndclient.RoutingPolicy{
+				FeeBaseMsat: 1000,
+			},
+		},
+	}
+
+	manager := NewManager(cfg)
+	manager.params = defaultParameters
+	manager.params.EasyAutoloop = true
+	manager.params.EasyOutboundTarget = 50
+
+	peerChannels := map[route.Vertex]*balances{
+		peer1: balance1,
+		peer2: balance2,
+		peer3: balance3,
+	}
+
+	// Our node holds 20000 of 30000 sats as local balance, so we need to
+	// loop out 5000 sats. We expect all of it to come from our cheapest
+	// peer.
+	resp := newSuggestions()
+	jobs, err := manager.easyAutoloopJobs(
+		context.Background(), peerChannels, nil, newSwapTraffic(), 0,
+		testRestrictions, resp, &tickStats{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*suggestionJob{
+		{
+			peer:    peer2,
+			balance: balance2,
+			rule:    easyRule,
+			amount:  5000,
+		},
+	}, jobs)
+
+	// If peer 2 is in use, we fall back to peer 1 and report the reason
+	// that peer 2 was not used.
+	traffic := newSwapTraffic()
+	traffic.ongoingLoopOut[chanID2] = true
+
+	resp = newSuggestions()
+	jobs, err = manager.easyAutoloopJobs(
+		context.Background(), peerChannels, nil, traffic, 0,
+		testRestrictions, resp, &tickStats{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*suggestionJob{
+		{
+			peer:    peer1,
+			balance: balance1,
+			rule:    easyRule,
+			amount:  5000,
+		},
+	}, jobs)
+	require.Equal(t, map[route.Vertex]Reason{
+		peer2: ReasonLoopOut,
+	}, resp.DisqualifiedPeers)
+
+	// If peer 2's loop out has not been paid yet, its amount is still
+	// part of our local balance, so we do not loop it out again.
+	jobs, err = manager.easyAutoloopJobs(
+		context.Background(), peerChannels, nil, traffic, 5000,
+		testRestrictions, newSuggestions(), &tickStats{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, jobs)
+
+	// If peer 2 is offline, we also fall back to peer 1.
+	connected := connectedPeers{
+		peer1: {},
+	}
+
+	resp = newSuggestions()
+	jobs, err = manager.easyAutoloopJobs(
+		context.Background(), peerChannels, connected,
+		newSwapTraffic(), 0, testRestrictions, resp, &tickStats{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*suggestionJob{
+		{
+			peer:    peer1,
+			balance: balance1,
+			rule:    easyRule,
+			amount:  5000,
+		},
+	}, jobs)
+	require.Equal(t, map[route.Vertex]Reason{
+		peer2: ReasonPeerOffline,
+	}, resp.DisqualifiedPeers)
+
+	// Once our node is within its target, we do not loop out.
+	manager.params.EasyOutboundTarget = 70
+	jobs, err = manager.easyAutoloopJobs(
+		context.Background(), peerChannels, nil, newSwapTraffic(), 0,
+		testRestrictions, newSuggestions(), &tickStats{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, jobs)
+}
+
+// TestEasyAllocate tests spreading of easy autoloop's excess balance over the
+// peers that it may loop out from.
+func TestEasyAllocate(t *testing.T) {
+	var (
+		balance1 = &balances{pubkey: peer1}
+		balance2 = &balances{pubkey: peer2}
+	)
+
+	tests := []struct {
+		name         string
+		candidates   []*easyCandidate
+		excess       btcutil.Amount
+		restrictions *Restrictions
+		expected     map[route.Vertex]btcutil.Amount
+	}{
+		{
+			name: "cheapest first",
+			candidates: []*easyCandidate{
+				{
+					balance:   balance1,
+					available: 5000,
+					feePPM:    200,
+				},
+				{
+					balance:   balance2,
+					available: 3000,
+					feePPM:    100,
+				},
+			},
+			excess:       4000,
+			restrictions: NewRestrictions(100, 10000),
+			expected: map[route.Vertex]btcutil.Amount{
+				peer2: 3000,
+				peer1: 1000,
+			},
+		},
+		{
+			name: "equal fees, largest first",
+			candidates: []*easyCandidate{
+				{
+					balance:   balance1,
+					available: 3000,
+				},
+				{
+					balance:   balance2,
+					available: 5000,
+				},
+			},
+			excess:       4000,
+			restrictions: NewRestrictions(100, 10000),
+			expected: map[route.Vertex]btcutil.Amount{
+				peer2: 4000,
+			},
+		},
+		{
+			name: "capped at maximum",
+			candidates: []*easyCandidate{
+				{
+					balance:   balance1,
+					available: 5000,
+				},
+			},
+			excess:       5000,
+			restrictions: NewRestrictions(100, 2000),
+			expected: map[route.Vertex]btcutil.Amount{
+				peer1: 2000,
+			},
+		},
+		{
+			name: "remainder below minimum",
+			candidates: []*easyCandidate{
+				{
+					balance:   balance1,
+					available: 3000,
+					feePPM:    100,
+				},
+				{
+					balance:   balance2,
+					available: 3000,
+					feePPM:    200,
+				},
+			},
+			excess:       3050,
+			restrictions: NewRestrictions(100, 10000),
+			expected: map[route.Vertex]btcutil.Amount{
+				peer1: 3000,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			jobs := easyAllocate(
+				testCase.candidates, testCase.excess,
+				testCase.restrictions,
+			)
+
+			amounts := make(map[route.Vertex]btcutil.Amount)
+			for _, job := range jobs {
+				require.Equal(t, easyRule, job.rule)
+				amounts[job.peer] = job.amount
+			}
+			require.Equal(t, testCase.expected, amounts)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package liquidity
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ParameterValue is the effective value of a single liquidity parameter.
+type ParameterValue struct {
+	// Name is the name of the parameter.
+	Name string
+
+	// Value is the string representation of the parameter's value.
+	Value string
+
+	// Default is true if the parameter has its default value. Our
+	// parameters can only be changed over rpc, so parameters that do not
+	// have their default value were overridden over rpc.
+	Default bool
+}
+
+// EffectiveParameters returns the current value of each of our parameters,
+// along with whether it still has its default value.
+func (m *Manager) EffectiveParameters() []ParameterValue {
+	params := m.GetParameters()
+
+	var (
+		current  = reflect.ValueOf(params)
+		defaults = reflect.ValueOf(defaultParameters)
+		values   = make([]ParameterValue, 0, current.NumField())
+	)
+
+	for i := 0; i < current.NumField(); i++ {
+		value := current.Field(i)
+
+		values = append(values, ParameterValue{
+			Name:    current.Type().Field(i).Name,
+			Value:   fmt.Sprintf("%v", value.Interface()),
+			Default: isDefault(value, defaults.Field(i)),
+		})
+	}
+
+	return values
+}
+
+// isDefault returns true if a parameter value is equal to its default. Empty
+// maps and slices are considered equal to nil defaults, because parameters
+// that are set over rpc always have non-nil collections.
+func isDefault(value, defaultValue reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Map, reflect.Slice:
+		if value.Len() == 0 && defaultValue.Len() == 0 {
+			return true
+		}
+	}
+
+	return reflect.DeepEqual(value.Interface(), defaultValue.Interface())
+}
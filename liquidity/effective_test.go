@@ -0,0 +1,42 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEffectiveParameters tests reporting of whether our parameters have
+// been changed from their defaults.
+func TestEffectiveParameters(t *testing.T) {
+	cfg, _ := newTestConfig()
+	manager := NewManager(cfg)
+
+	// changed returns the names of the parameters that do not have their
+	// default value.
+	changed := func() []string {
+		var names []string
+		for _, value := range manager.EffectiveParameters() {
+			if !value.Default {
+				names = append(names, value.Name)
+			}
+		}
+
+		return names
+	}
+
+	require.Empty(t, changed())
+
+	// Set our parameters with empty, non-nil rules and a changed backoff,
+	// which should be the only parameter reported as overridden.
+	params := manager.GetParameters()
+	params.ChannelRules = make(map[lnwire.ShortChannelID]*SwapRule)
+	params.FailureBackOff *= 2
+
+	err := manager.SetParameters(context.Background(), params)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"FailureBackOff"}, changed())
+}
@@ -0,0 +1,57 @@
+package liquidity
+
+import (
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// isExcluded returns true if the channel provided is managed externally and
+// should not be touched by the liquidity manager.
+func (p Parameters) isExcluded(channel lnwire.ShortChannelID) bool {
+	for _, excluded := range p.ExcludedChannels {
+		if excluded == channel {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateExcluded checks that our set of excluded channels is valid.
+func (p Parameters) validateExcluded() error {
+	for _, channel := range p.ExcludedChannels {
+		if channel.ToUint64() == 0 {
+			return ErrZeroChannelID
+		}
+	}
+
+	return nil
+}
+
+// filterExcluded removes the channels that are managed externally from the
+// set of channels provided, returning the channels that we may manage and the
+// short channel IDs of the open channels that were excluded.
+func (p Parameters) filterExcluded(channels []lndclient.ChannelInfo) (
+	[]lndclient.ChannelInfo, []lnwire.ShortChannelID) {
+
+	if len(p.ExcludedChannels) == 0 {
+		return channels, nil
+	}
+
+	var (
+		managed  []lndclient.ChannelInfo
+		excluded []lnwire.ShortChannelID
+	)
+
+	for _, channel := range channels {
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		if p.isExcluded(chanID) {
+			excluded = append(excluded, chanID)
+			continue
+		}
+
+		managed = append(managed, channel)
+	}
+
+	return managed, excluded
+}
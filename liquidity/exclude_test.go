@@ -0,0 +1,97 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// TestExcludedChannels tests that we do not suggest swaps for channels that
+// are managed externally, even if they have a rule set.
+func TestExcludedChannels(t *testing.T) {
+	tests := []struct {
+		name        string
+		excluded    []lnwire.ShortChannelID
+		chanRules   map[lnwire.ShortChannelID]*SwapRule
+		peerRules   map[route.Vertex]*SwapRule
+		suggestions *Suggestions
+	}{
+		{
+			name:     "other channel excluded",
+			excluded: []lnwire.ShortChannelID{chanID2},
+			chanRules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonExcluded,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "channel rule excluded",
+			excluded: []lnwire.ShortChannelID{chanID1},
+			chanRules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonExcluded,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			// Peer 1 only has channel 1, which is excluded, so we
+			// do not have any balances to apply its rule to.
+			name:     "peer channel excluded",
+			excluded: []lnwire.ShortChannelID{chanID1},
+			peerRules: map[route.Vertex]*SwapRule{
+				peer1: chanRule,
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonExcluded,
+				},
+				DisqualifiedPeers: map[route.Vertex]Reason{
+					peer1: ReasonNoChannels,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			params := defaultParameters
+			params.ExcludedChannels = testCase.excluded
+
+			if testCase.chanRules != nil {
+				params.ChannelRules = testCase.chanRules
+			}
+
+			if testCase.peerRules != nil {
+				params.PeerRules = testCase.peerRules
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
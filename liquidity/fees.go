@@ -259,6 +259,13 @@ func (f *FeeCategoryLimit) loopOutFees(amount btcutil.Amount,
 	return prepayMaxFee, routeMaxFee, f.MaximumMinerFee
 }
 
+// previewLoopOut returns the fee breakdown we would use for a loop out swap.
+func (f *FeeCategoryLimit) previewLoopOut(amount btcutil.Amount,
+	quote *loop.LoopOutQuote) (*FeeBreakdown, error) {
+
+	return previewLoopOut(f, amount, quote)
+}
+
 // Compile time assertion that FeePortion implements FeeLimit interface.
 var _ FeeLimit = (*FeePortion)(nil)
 
@@ -388,6 +395,13 @@ func (f *FeePortion) loopOutFees(amount btcutil.Amount,
 	return prepayMaxFee, routeMaxFee, minerFee
 }
 
+// previewLoopOut returns the fee breakdown we would use for a loop out swap.
+func (f *FeePortion) previewLoopOut(amount btcutil.Amount,
+	quote *loop.LoopOutQuote) (*FeeBreakdown, error) {
+
+	return previewLoopOut(f, amount, quote)
+}
+
 // splitOffChain takes an available fee budget and divides it among our prepay
 // and swap payments proportional to their volume.
 func splitOffChain(available, prepayAmt,
@@ -0,0 +1,162 @@
+package liquidity
+
+import (
+	"context"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// flowPageSize is the number of forwarding events that we query from lnd at a
+// time when we forecast the flow of our channels.
+const flowPageSize = 1000
+
+// channelFlows maps channel IDs to the net amount that was forwarded out over
+// each channel in our flow window. A positive flow shifts a channel's balance
+// from our side to our peer's side, as a loop out does, and a negative flow
+// shifts it to our side, as a loop in does.
+type channelFlows map[uint64]btcutil.Amount
+
+// newChannelFlows tallies the net flow of each channel in the set of
+// forwarding events provided.
+func newChannelFlows(events []lndclient.ForwardingEvent) channelFlows {
+	flows := make(channelFlows)
+	for _, event := range events {
+		flows[event.ChannelOut] += event.AmountMsatOut.ToSatoshis()
+		flows[event.ChannelIn] -= event.AmountMsatIn.ToSatoshis()
+	}
+
+	return flows
+}
+
+// total returns the total net flow of the set of channels provided.
+func (c channelFlows) total(channels []lnwire.ShortChannelID) btcutil.Amount {
+	var total btcutil.Amount
+	for _, channel := range channels {
+		total += c[channel.ToUint64()]
+	}
+
+	return total
+}
+
+// forwardingHistory pages through the forwarding events that lnd has recorded
+// between the start and end times provided.
+func (m *Manager) forwardingHistory(ctx context.Context, start,
+	end time.Time) ([]lndclient.ForwardingEvent, error) {
+
+	var (
+		events []lndclient.ForwardingEvent
+		offset uint32
+	)
+
+	for {
+		resp, err := m.cfg.Node.ForwardingHistory(
+			ctx, lndclient.ForwardingHistoryRequest{
+				StartTime: start,
+				EndTime:   end,
+				MaxEvents: flowPageSize,
+				Offset:    offset,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, resp.Events...)
+
+		// We are done once lnd returns no more events, or our offset
+		// does not advance.
+		if len(resp.Events) == 0 || resp.LastIndexOffset <= offset {
+			return events, nil
+		}
+
+		offset = resp.LastIndexOffset
+	}
+}
+
+// eventsSince returns the forwarding events provided that occurred at or after
+// the time provided.
+func eventsSince(events []lndclient.ForwardingEvent,
+	since time.Time) []lndclient.ForwardingEvent {
+
+	var filtered []lndclient.ForwardingEvent
+	for _, event := range events {
+		if !event.Timestamp.Before(since) {
+			filtered = append(filtered, event)
+		}
+	}
+
+	return filtered
+}
+
+// addForwardingHistory sets the forwarding flow and routing revenue of the
+// channels of each of the jobs provided, if our flow forecasts or the jobs'
+// rules require them. Both are based on our forwarding history, so we look it
+// up once for the longest window that we need.
+func (m *Manager) addForwardingHistory(ctx context.Context,
+	jobs []*suggestionJob) error {
+
+	lookback := m.params.FlowWindow
+	for _, job := range jobs {
+		if job.rule.MinRevenuePercent == 0 {
+			continue
+		}
+
+		if window := job.rule.revenueWindow(); window > lookback {
+			lookback = window
+		}
+	}
+
+	if lookback == 0 {
+		return nil
+	}
+
+	now := m.cfg.Clock.Now()
+	events, err := m.forwardingHistory(ctx, now.Add(-lookback), now)
+	if err != nil {
+		return err
+	}
+
+	var flows channelFlows
+	if m.params.FlowWindow != 0 {
+		flows = newChannelFlows(
+			eventsSince(events, now.Add(-m.params.FlowWindow)),
+		)
+	}
+
+	for _, job := range jobs {
+		job.balance.flow = flows.total(job.balance.channels)
+
+		if job.rule.MinRevenuePercent == 0 {
+			continue
+		}
+
+		since := now.Add(-job.rule.revenueWindow())
+		job.balance.revenue = channelRevenue(
+			eventsSince(events, since), job.balance.channels,
+		)
+	}
+
+	return nil
+}
+
+// refill returns the amount of liquidity that we expect a set of channels to
+// regain from their forwarding flow over our next flow window, assuming that
+// their flow over the last window repeats. Loop outs add incoming liquidity,
+// which channels that forward out restore by themselves, and loop ins add
+// outgoing liquidity, which channels that forward in restore.
+func (b *balances) refill(swapType swap.Type) btcutil.Amount {
+	flow := b.flow
+	if swapType == swap.TypeIn {
+		flow = -flow
+	}
+
+	if flow < 0 {
+		return 0
+	}
+
+	return flow
+}
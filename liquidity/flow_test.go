@@ -0,0 +1,184 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChannelFlows tests tallying of the net forwarding flow of our channels.
+func TestChannelFlows(t *testing.T) {
+	flows := newChannelFlows([]lndclient.ForwardingEvent{
+		{
+			ChannelIn:     chanID1.ToUint64(),
+			ChannelOut:    chanID2.ToUint64(),
+			AmountMsatIn:  2_001_000,
+			AmountMsatOut: 2_000_000,
+		},
+		{
+			ChannelIn:     chanID2.ToUint64(),
+			ChannelOut:    chanID1.ToUint64(),
+			AmountMsatIn:  501_000,
+			AmountMsatOut: 500_000,
+		},
+	})
+
+	require.EqualValues(t, -1501, flows.total(
+		[]lnwire.ShortChannelID{chanID1},
+	))
+	require.EqualValues(t, 1499, flows.total(
+		[]lnwire.ShortChannelID{chanID2},
+	))
+	require.EqualValues(t, -2, flows.total(
+		[]lnwire.ShortChannelID{chanID1, chanID2},
+	))
+
+	// Channels that did not forward have no flow.
+	require.EqualValues(t, 0, flows.total(
+		[]lnwire.ShortChannelID{chanID3},
+	))
+
+	// Loop outs are refilled by outgoing flow, and loop ins by incoming
+	// flow.
+	out := &balances{flow: 1000}
+	require.EqualValues(t, 1000, out.refill(swap.TypeOut))
+	require.EqualValues(t, 0, out.refill(swap.TypeIn))
+
+	in := &balances{flow: -1000}
+	require.EqualValues(t, 0, in.refill(swap.TypeOut))
+	require.EqualValues(t, 1000, in.refill(swap.TypeIn))
+}
+
+// TestFlowSwapAmount tests lowering of swap amounts for channels that are
+// expected to refill themselves.
+func TestFlowSwapAmount(t *testing.T) {
+	rule := NewThresholdRule(50, 0)
+	restrictions := NewRestrictions(1000, 10000)
+
+	tests := []struct {
+		name   string
+		flow   btcutil.Amount
+		amount btcutil.Amount
+		err    error
+	}{
+		{
+			name:   "stagnant channel",
+			flow:   0,
+			amount: 7500,
+		},
+		{
+			name:   "flow against swap",
+			flow:   -3000,
+			amount: 7500,
+		},
+		{
+			name:   "flow with swap",
+			flow:   3000,
+			amount: 4500,
+		},
+		{
+			name: "flow refills swap",
+			flow: 7000,
+			err:  newReasonError(ReasonFlowRefill),
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			channel := &balances{
+				capacity: 10000,
+				outgoing: 10000,
+				flow:     testCase.flow,
+			}
+
+			amount, err := rule.swapAmount(
+				channel, restrictions, swap.TypeOut,
+			)
+			require.Equal(t, testCase.err, err)
+			require.Equal(t, testCase.amount, amount)
+		})
+	}
+}
+
+// TestFlowSuggestions tests that we do not suggest swaps for channels whose
+// forwarding flow is expected to refill them, and that forwarding flow is
+// ignored when flow forecasting is disabled.
+func TestFlowSuggestions(t *testing.T) {
+	// Channel 1 forwarded out as much as we would swap in the last day,
+	// and channel 2 was stagnant.
+	events := []lndclient.ForwardingEvent{{
+		Timestamp:     testTime.Add(time.Hour * -1),
+		ChannelIn:     chanID3.ToUint64(),
+		ChannelOut:    chanID1.ToUint64(),
+		AmountMsatIn:  7_500_000,
+		AmountMsatOut: 7_500_000,
+	}}
+
+	rules := map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+		chanID2: chanRule,
+	}
+
+	tests := []struct {
+		name        string
+		window      time.Duration
+		suggestions *Suggestions
+	}{
+		{
+			name:   "flow ignored",
+			window: 0,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:   "flow forecast",
+			window: time.Hour * 24,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan2Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonFlowRefill,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+			lnd.ForwardingEvents = events
+
+			params := defaultParameters
+			params.MaxAutoInFlight = 2
+			params.FlowWindow = testCase.window
+			params.ChannelRules = rules
+			params.PeerRules = make(map[route.Vertex]*SwapRule)
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
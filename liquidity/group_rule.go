@@ -0,0 +1,179 @@
+package liquidity
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrEmptyGroupName is returned if a channel group does not have a
+	// name.
+	ErrEmptyGroupName = errors.New("channel group name must be set")
+
+	// ErrEmptyGroup is returned if a channel group has no channels.
+	ErrEmptyGroup = errors.New("channel group must contain at least one " +
+		"channel")
+
+	// ErrChannelInGroups is returned if a channel belongs to more than one
+	// channel group.
+	ErrChannelInGroups = errors.New("channel may only belong to one " +
+		"channel group")
+)
+
+// ChannelGroup is a named set of channels that share a single liquidity rule.
+// The rule's thresholds are applied to the group's total balance, the way
+// that peer rules are applied across all of a peer's channels, so that swaps
+// can be suggested for any set of channels, even across several peers. The
+// rule's fee budget, if set, applies to all of the swaps for the group.
+type ChannelGroup struct {
+	// Channels is the set of channels in the group.
+	Channels []lnwire.ShortChannelID
+
+	// Rule is the rule that applies to the group.
+	Rule *SwapRule
+}
+
+// String returns the string representation of a channel group.
+func (g *ChannelGroup) String() string {
+	return fmt.Sprintf("channels: %v, %v", g.Channels, g.Rule)
+}
+
+// validate checks that a channel group is valid.
+func (g *ChannelGroup) validate() error {
+	if len(g.Channels) == 0 {
+		return ErrEmptyGroup
+	}
+
+	for _, channel := range g.Channels {
+		if channel.ToUint64() == 0 {
+			return ErrZeroChannelID
+		}
+	}
+
+	if g.Rule == nil {
+		return errors.New("channel group rule must be set")
+	}
+
+	// Loop ins are restricted to a single peer, so they cannot target a
+	// group of channels.
+	if g.Rule.Type == swap.TypeIn {
+		return errors.New("channel group rules not supported for " +
+			"loop in swaps")
+	}
+
+	return g.Rule.validate()
+}
+
+// channelGroup returns the name of the channel group that a channel belongs
+// to, if any.
+func (p Parameters) channelGroup(channel lnwire.ShortChannelID) (string,
+	bool) {
+
+	for name, group := range p.ChannelGroups {
+		for _, groupChannel := range group.Channels {
+			if groupChannel == channel {
+				return name, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// validateGroups checks that our channel groups are valid, and that each
+// channel only has one rule that applies to it: a channel may only belong to
+// one group, and may not be in a group if it has its own rule or its peer has
+// a rule.
+func (p Parameters) validateGroups(openChans []lndclient.ChannelInfo) error {
+	grouped := make(map[lnwire.ShortChannelID]string)
+
+	for name, group := range p.ChannelGroups {
+		if name == "" {
+			return ErrEmptyGroupName
+		}
+
+		if err := group.validate(); err != nil {
+			return fmt.Errorf("channel group: %v has invalid "+
+				"rule: %w", name, err)
+		}
+
+		for _, channel := range group.Channels {
+			if other, ok := grouped[channel]; ok {
+				return fmt.Errorf("%w: channel %v in groups "+
+					"%v and %v", ErrChannelInGroups,
+					channel, other, name)
+			}
+			grouped[channel] = name
+
+			if _, ok := p.ChannelRules[channel]; ok {
+				log.Debugf("Rules for channel: %v and its "+
+					"group: %v can't both be set", channel,
+					name)
+
+				return ErrExclusiveRules
+			}
+		}
+	}
+
+	for _, channel := range openChans {
+		_, ok := p.PeerRules[channel.PubKeyBytes]
+		if !ok {
+			continue
+		}
+
+		shortID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		if name, ok := grouped[shortID]; ok {
+			log.Debugf("Rules for peer: %v and the group: %v of "+
+				"its channel: %v can't both be set",
+				channel.PubKeyBytes, name, shortID)
+
+			return ErrExclusiveRules
+		}
+	}
+
+	return nil
+}
+
+// groupBalances returns the total balances of the channels in a group that
+// are currently open. If none of the group's channels are open, nil is
+// returned.
+func groupBalances(group *ChannelGroup,
+	channels []lndclient.ChannelInfo) *balances {
+
+	var balance *balances
+	for _, channel := range channels {
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+
+		var inGroup bool
+		for _, groupChannel := range group.Channels {
+			if groupChannel == chanID {
+				inGroup = true
+				break
+			}
+		}
+
+		if !inGroup {
+			continue
+		}
+
+		if balance == nil {
+			balance = &balances{}
+		}
+
+		balance.channels = append(balance.channels, chanID)
+		balance.capacity += channel.Capacity
+		balance.incoming += channel.RemoteBalance
+		balance.outgoing += channel.LocalBalance
+		balance.htlcs = append(balance.htlcs, newChannelHtlcs(channel))
+
+		outgoingReserve, incomingReserve := channelReserves(channel)
+		balance.outgoingReserve += outgoingReserve
+		balance.incomingReserve += incomingReserve
+	}
+
+	return balance
+}
@@ -0,0 +1,274 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidateGroups tests validation of channel groups.
+func TestValidateGroups(t *testing.T) {
+	openChans := []lndclient.ChannelInfo{
+		channel1, channel2,
+	}
+
+	group := func(channels ...lnwire.ShortChannelID) *ChannelGroup {
+		return &ChannelGroup{
+			Channels: channels,
+			Rule:     chanRule,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		groups      map[string]*ChannelGroup
+		chanRules   map[lnwire.ShortChannelID]*SwapRule
+		peerRules   map[route.Vertex]*SwapRule
+		expectedErr error
+	}{
+		{
+			name: "valid groups",
+			groups: map[string]*ChannelGroup{
+				"a": group(chanID1, chanID2),
+				"b": group(chanID3),
+			},
+		},
+		{
+			name: "empty name",
+			groups: map[string]*ChannelGroup{
+				"": group(chanID1),
+			},
+			expectedErr: ErrEmptyGroupName,
+		},
+		{
+			name: "no channels",
+			groups: map[string]*ChannelGroup{
+				"a": group(),
+			},
+			expectedErr: ErrEmptyGroup,
+		},
+		{
+			name: "zero channel",
+			groups: map[string]*ChannelGroup{
+				"a": group(lnwire.NewShortChanIDFromInt(0)),
+			},
+			expectedErr: ErrZeroChannelID,
+		},
+		{
+			name: "channel in two groups",
+			groups: map[string]*ChannelGroup{
+				"a": group(chanID1),
+				"b": group(chanID1),
+			},
+			expectedErr: ErrChannelInGroups,
+		},
+		{
+			name: "channel rule overlaps",
+			groups: map[string]*ChannelGroup{
+				"a": group(chanID1),
+			},
+			chanRules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			},
+			expectedErr: ErrExclusiveRules,
+		},
+		{
+			name: "peer rule overlaps",
+			groups: map[string]*ChannelGroup{
+				"a": group(chanID2),
+			},
+			peerRules: map[route.Vertex]*SwapRule{
+				peer2: chanRule,
+			},
+			expectedErr: ErrExclusiveRules,
+		},
+		{
+			name: "peer rule for other channel",
+			groups: map[string]*ChannelGroup{
+				"a": group(chanID2),
+			},
+			peerRules: map[route.Vertex]*SwapRule{
+				peer1: chanRule,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			params := Parameters{
+				ChannelGroups: testCase.groups,
+				ChannelRules:  testCase.chanRules,
+				PeerRules:     testCase.peerRules,
+			}
+
+			err := params.validateGroups(openChans)
+			require.ErrorIs(t, err, testCase.expectedErr)
+		})
+	}
+}
+
+// TestGroupRule tests that channel group rules are applied to the total
+// balance of the group's open channels.
+func TestGroupRule(t *testing.T) {
+	expectedAmt := btcutil.Amount(10000)
+	prepay, routing := testPPMFees(defaultFeePPM, testQuote, expectedAmt)
+
+	groupSwap := loop.OutRequest{
+		Amount: expectedAmt,
+		OutgoingChanSet: loopdb.ChannelSet{
+			chanID1.ToUint64(), chanID2.ToUint64(),
+		},
+		MaxPrepayRoutingFee: prepay,
+		MaxSwapRoutingFee:   routing,
+		MaxMinerFee:         scaleMinerFee(testQuote.MinerFee),
+		MaxSwapFee:          testQuote.SwapFee,
+		MaxPrepayAmount:     testQuote.PrepayAmount,
+		SweepConfTarget:     defaultConfTarget,
+		Initiator:           autoloopSwapInitiator,
+	}
+
+	tests := []struct {
+		name        string
+		groups      map[string]*ChannelGroup
+		suggestions *Suggestions
+	}{
+		{
+			// Channels 1 and 2 are with different peers, so our
+			// group swap is suggested across both of them.
+			name: "group across peers",
+			groups: map[string]*ChannelGroup{
+				"drain": {
+					Channels: []lnwire.ShortChannelID{
+						chanID1, chanID2,
+					},
+					Rule: chanRule,
+				},
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					groupSwap,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "no open channels in group",
+			groups: map[string]*ChannelGroup{
+				"closed": {
+					Channels: []lnwire.ShortChannelID{
+						chanID3,
+					},
+					Rule: chanRule,
+				},
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+				DisqualifiedGroups: map[string]Reason{
+					"closed": ReasonNoChannels,
+				},
+			},
+		},
+		{
+			name: "liquidity ok",
+			groups: map[string]*ChannelGroup{
+				"balanced": {
+					Channels: []lnwire.ShortChannelID{
+						chanID1, chanID2,
+					},
+					Rule: &SwapRule{
+						ThresholdRule: NewThresholdRule(
+							0, 10,
+						),
+						Type: swap.TypeOut,
+					},
+				},
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+				DisqualifiedGroups: map[string]Reason{
+					"balanced": ReasonLiquidityOk,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			params := defaultParameters
+			params.ChannelGroups = testCase.groups
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestGroupBudget tests attribution of autoloops to the fee budgets of our
+// channel groups.
+func TestGroupBudget(t *testing.T) {
+	params := defaultParameters
+	params.ChannelGroups = map[string]*ChannelGroup{
+		"a": {
+			Channels: []lnwire.ShortChannelID{chanID1, chanID2},
+			Rule: &SwapRule{
+				ThresholdRule: chanRule.ThresholdRule,
+				FeeBudget:     1000,
+			},
+		},
+	}
+
+	autoloops := []autoloopFees{
+		// A loop out over both of the group's channels counts
+		// towards its budget once.
+		{
+			channels: []lnwire.ShortChannelID{chanID1, chanID2},
+			fees:     100,
+		},
+		// A loop out over one of the group's channels counts towards
+		// its budget.
+		{
+			channels: []lnwire.ShortChannelID{chanID2},
+			fees:     200,
+		},
+		// A loop out over another channel does not.
+		{
+			channels: []lnwire.ShortChannelID{chanID3},
+			fees:     400,
+		},
+	}
+
+	budgets := newRuleBudgets(params, autoloops, nil)
+
+	available, limited := budgets.available(
+		[]lnwire.ShortChannelID{chanID1, chanID2}, nil,
+	)
+	require.True(t, limited)
+	require.Equal(t, btcutil.Amount(700), available)
+
+	budgets.spend([]lnwire.ShortChannelID{chanID1}, nil, 700)
+	require.True(t, budgets.exhausted(
+		[]lnwire.ShortChannelID{chanID2}, nil,
+	))
+}
@@ -0,0 +1,123 @@
+package liquidity
+
+import (
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// newSuggestionRound creates a record of the swaps that an autoloop tick
+// decided on and the targets that it disqualified. The swaps in the round are
+// in the same order as the swaps in the dispatch set and suggestions provided,
+// so that they can be marked as dispatched.
+func newSuggestionRound(dispatch bool, set *dispatchSet,
+	suggestion *Suggestions) *loopdb.SuggestionRound {
+
+	round := &loopdb.SuggestionRound{
+		Dispatch: dispatch,
+	}
+
+	for _, out := range set.outSwaps {
+		round.LoopOut = append(round.LoopOut, suggestedOut(out))
+	}
+
+	for _, in := range set.inSwaps {
+		round.LoopIn = append(round.LoopIn, suggestedIn(in))
+	}
+
+	for _, rebalance := range suggestion.Rebalances {
+		lastHop := rebalance.LastHop
+
+		suggested := &loopdb.SuggestedSwap{
+			Channels: []uint64{
+				rebalance.OutgoingChannel,
+				rebalance.IncomingChannel,
+			},
+			LastHop: &lastHop,
+			Amount:  rebalance.Amount,
+			Fees:    rebalance.MaxFee,
+		}
+		round.Rebalances = append(round.Rebalances, suggested)
+	}
+
+	for channel, reason := range suggestion.DisqualifiedChans {
+		round.Disqualified = append(
+			round.Disqualified, &loopdb.DisqualifiedTarget{
+				Channel: channel.ToUint64(),
+				Reason:  uint8(reason),
+			},
+		)
+	}
+
+	for peer, reason := range suggestion.DisqualifiedPeers {
+		peer := peer
+
+		round.Disqualified = append(
+			round.Disqualified, &loopdb.DisqualifiedTarget{
+				Peer:   &peer,
+				Reason: uint8(reason),
+			},
+		)
+	}
+
+	for group, reason := range suggestion.DisqualifiedGroups {
+		round.Disqualified = append(
+			round.Disqualified, &loopdb.DisqualifiedTarget{
+				Group:  group,
+				Reason: uint8(reason),
+			},
+		)
+	}
+
+	return round
+}
+
+// suggestedOut creates a record of a loop out swap that we decided on.
+func suggestedOut(out loop.OutRequest) *loopdb.SuggestedSwap {
+	return &loopdb.SuggestedSwap{
+		Channels: out.OutgoingChanSet,
+		Amount:   out.Amount,
+		Fees: worstCaseOutFees(
+			out.MaxPrepayRoutingFee, out.MaxSwapRoutingFee,
+			out.MaxSwapFee, out.MaxMinerFee, out.MaxPrepayAmount,
+		),
+	}
+}
+
+// suggestedIn creates a record of a loop in swap that we decided on.
+func suggestedIn(in loop.LoopInRequest) *loopdb.SuggestedSwap {
+	return &loopdb.SuggestedSwap{
+		LastHop: in.LastHop,
+		Amount:  in.Amount,
+		Fees: worstCaseInFees(
+			in.MaxMinerFee, in.MaxSwapFee, defaultLoopInSweepFee,
+		),
+	}
+}
+
+// recordRound stores the suggestion round provided for a tick that started
+// at the time provided, along with the error that the tick failed with, if
+// any. If we failed before we had a set of suggestions, we record a round that
+// only contains our error. Ticks that did nothing because we have no rules are
+// not recorded.
+func (m *Manager) recordRound(start time.Time,
+	round *loopdb.SuggestionRound, tickErr error) {
+
+	if tickErr == ErrNoRules || m.cfg.Recorder == nil {
+		return
+	}
+
+	if round == nil {
+		round = &loopdb.SuggestionRound{}
+	}
+	round.Time = start
+
+	if tickErr != nil {
+		round.Error = tickErr.Error()
+	}
+
+	if err := m.cfg.Recorder.RecordSuggestionRound(round); err != nil {
+		log.Errorf("could not record autoloop suggestions: %v", err)
+	}
+}
@@ -0,0 +1,146 @@
+package liquidity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewSuggestionRound tests recording of the swaps that we decided on and
+// the targets that we disqualified in an autoloop tick.
+func TestNewSuggestionRound(t *testing.T) {
+	suggestion := &Suggestions{
+		OutSwaps: []loop.OutRequest{chan1Rec},
+		Rebalances: []loop.RebalanceRequest{
+			{
+				Amount:          1000,
+				MaxFee:          10,
+				OutgoingChannel: chanID1.ToUint64(),
+				IncomingChannel: chanID2.ToUint64(),
+				LastHop:         peer2,
+			},
+		},
+		DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+			chanID2: ReasonLoopOut,
+		},
+		DisqualifiedPeers: map[route.Vertex]Reason{
+			peer1: ReasonNoChannels,
+		},
+		DisqualifiedGroups: map[string]Reason{
+			"group": ReasonRuleExpired,
+		},
+	}
+
+	// Our dispatch set has resized our loop out, so we expect its amount
+	// to be recorded.
+	set := newDispatchSet(suggestion)
+	resized := chan1Rec
+	resized.Amount = 5000
+	set.outSwaps = []loop.OutRequest{resized}
+
+	round := newSuggestionRound(true, set, suggestion)
+
+	expectedFees := worstCaseOutFees(
+		chan1Rec.MaxPrepayRoutingFee, chan1Rec.MaxSwapRoutingFee,
+		chan1Rec.MaxSwapFee, chan1Rec.MaxMinerFee,
+		chan1Rec.MaxPrepayAmount,
+	)
+
+	require.Equal(t, &loopdb.SuggestionRound{
+		Dispatch: true,
+		LoopOut: []*loopdb.SuggestedSwap{
+			{
+				Channels: chan1Rec.OutgoingChanSet,
+				Amount:   5000,
+				Fees:     expectedFees,
+			},
+		},
+		Rebalances: []*loopdb.SuggestedSwap{
+			{
+				Channels: []uint64{
+					chanID1.ToUint64(), chanID2.ToUint64(),
+				},
+				LastHop: &peer2,
+				Amount:  1000,
+				Fees:    10,
+			},
+		},
+		Disqualified: []*loopdb.DisqualifiedTarget{
+			{
+				Channel: chanID2.ToUint64(),
+				Reason:  uint8(ReasonLoopOut),
+			},
+			{
+				Peer:   &peer1,
+				Reason: uint8(ReasonNoChannels),
+			},
+			{
+				Group:  "group",
+				Reason: uint8(ReasonRuleExpired),
+			},
+		},
+	}, round)
+}
+
+// TestRecordRound tests storing of autoloop suggestion rounds.
+func TestRecordRound(t *testing.T) {
+	tests := []struct {
+		name     string
+		round    *loopdb.SuggestionRound
+		tickErr  error
+		expected *loopdb.SuggestionRound
+	}{
+		{
+			name: "successful round",
+			round: &loopdb.SuggestionRound{
+				Dispatch: true,
+			},
+			expected: &loopdb.SuggestionRound{
+				Time:     testTime,
+				Dispatch: true,
+			},
+		},
+		{
+			name:    "failed before suggestions",
+			tickErr: errors.New("tick failed"),
+			expected: &loopdb.SuggestionRound{
+				Time:  testTime,
+				Error: "tick failed",
+			},
+		},
+		{
+			name:     "no rules",
+			tickErr:  ErrNoRules,
+			expected: nil,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := &testRecorder{}
+
+			manager := NewManager(&Config{
+				Clock:    clock.NewTestClock(testTime),
+				Recorder: recorder,
+			})
+
+			manager.recordRound(
+				testTime, testCase.round, testCase.tickErr,
+			)
+
+			var expected []*loopdb.SuggestionRound
+			if testCase.expected != nil {
+				expected = append(expected, testCase.expected)
+			}
+			require.Equal(t, expected, recorder.rounds)
+		})
+	}
+}
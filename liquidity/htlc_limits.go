@@ -0,0 +1,171 @@
+package liquidity
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// channelHtlcs describes the number of htlcs and the amount that may still be
+// added to a channel in each direction before we reach the limits set by the
+// channel's constraints.
+type channelHtlcs struct {
+	// channel is the short channel ID of the channel.
+	channel lnwire.ShortChannelID
+
+	// peer is the public key of the channel's peer.
+	peer route.Vertex
+
+	// outgoingSlots is the number of htlcs that we may still offer to
+	// our peer.
+	outgoingSlots int
+
+	// outgoingAmount is the amount that we may still have in flight
+	// towards our peer.
+	outgoingAmount btcutil.Amount
+
+	// incomingSlots is the number of htlcs that our peer may still offer
+	// to us.
+	incomingSlots int
+
+	// incomingAmount is the amount that our peer may still have in flight
+	// towards us.
+	incomingAmount btcutil.Amount
+}
+
+// newChannelHtlcs creates a channelHtlcs struct from lndclient channel
+// information. lnd reports the constraints that our peer imposes on the
+// htlcs that we offer as our local constraints, and the constraints that we
+// impose on our peer's htlcs as its remote constraints. Channels that do not
+// report their constraints are only limited by the protocol's maximum number
+// of htlcs and their capacity.
+func newChannelHtlcs(info lndclient.ChannelInfo) *channelHtlcs {
+	htlcs := &channelHtlcs{
+		channel:        lnwire.NewShortChanIDFromInt(info.ChannelID),
+		peer:           info.PubKeyBytes,
+		outgoingSlots:  input.MaxHTLCNumber / 2,
+		outgoingAmount: info.Capacity,
+		incomingSlots:  input.MaxHTLCNumber / 2,
+		incomingAmount: info.Capacity,
+	}
+
+	if info.LocalConstraints != nil {
+		htlcs.outgoingSlots = int(
+			info.LocalConstraints.MaxAcceptedHtlcs,
+		)
+		htlcs.outgoingAmount = info.LocalConstraints.MaxPendingAmt.
+			ToSatoshis()
+	}
+
+	if info.RemoteConstraints != nil {
+		htlcs.incomingSlots = int(
+			info.RemoteConstraints.MaxAcceptedHtlcs,
+		)
+		htlcs.incomingAmount = info.RemoteConstraints.MaxPendingAmt.
+			ToSatoshis()
+	}
+
+	for _, htlc := range info.PendingHtlcs {
+		if htlc.Incoming {
+			htlcs.incomingSlots--
+			htlcs.incomingAmount -= htlc.Amount
+		} else {
+			htlcs.outgoingSlots--
+			htlcs.outgoingAmount -= htlc.Amount
+		}
+	}
+
+	return htlcs
+}
+
+// limit returns the largest amount that the channel can currently carry for
+// a swap of the type provided, given the maximum htlc size of the channel's
+// policy in the direction of the swap. A zero maximum htlc size indicates
+// that the policy is unknown, in which case we only limit by the channel's
+// htlc slots and in-flight amount. Loop outs also pay a prepayment, so we
+// reserve an outgoing slot for it.
+func (c *channelHtlcs) limit(swapType swap.Type,
+	maxHtlc btcutil.Amount) btcutil.Amount {
+
+	slots, amount := c.incomingSlots, c.incomingAmount
+	if swapType == swap.TypeOut {
+		slots, amount = c.outgoingSlots-1, c.outgoingAmount
+	}
+
+	if slots <= 0 || amount <= 0 {
+		return 0
+	}
+
+	if maxHtlc > 0 && btcutil.Amount(slots)*maxHtlc < amount {
+		return btcutil.Amount(slots) * maxHtlc
+	}
+
+	return amount
+}
+
+// maxHtlc looks up the maximum htlc size of the policy that applies to a swap
+// of the type provided over a channel. We send loop out payments to our peer,
+// so our own policy applies to them, while our peer forwards loop in payments
+// to us, so its policy applies to them. Zero is returned if lnd does not know
+// the policy.
+func (m *Manager) maxHtlc(ctx context.Context, htlcs *channelHtlcs,
+	swapType swap.Type) btcutil.Amount {
+
+	edge, err := m.cfg.Graph.GetChanInfo(ctx, htlcs.channel.ToUint64())
+	if err != nil {
+		log.Debugf("could not look up policy for channel %v: %v",
+			htlcs.channel, err)
+
+		return 0
+	}
+
+	// Node 1 or 2 is our peer, so we select its policy for loop ins, and
+	// the other node's policy for loop outs.
+	peerIsNode1 := edge.Node1 == htlcs.peer
+
+	policy := edge.Node1Policy
+	if peerIsNode1 == (swapType == swap.TypeOut) {
+		policy = edge.Node2Policy
+	}
+
+	if policy == nil {
+		return 0
+	}
+
+	return lnwire.MilliSatoshi(policy.MaxHtlcMsat).ToSatoshis()
+}
+
+// capHtlcLimits lowers the amount of a swap to the amount that its channels
+// can currently carry, so that its payment does not fail because the
+// channels' htlc slots or in-flight limits are saturated. A reason error is
+// returned if the channels cannot carry our minimum swap amount.
+func (m *Manager) capHtlcLimits(ctx context.Context, balance *balances,
+	swapType swap.Type, amount btcutil.Amount,
+	restrictions *Restrictions) (btcutil.Amount, error) {
+
+	var limit btcutil.Amount
+	for _, htlcs := range balance.htlcs {
+		limit += htlcs.limit(swapType, m.maxHtlc(ctx, htlcs, swapType))
+	}
+
+	if amount <= limit {
+		return amount, nil
+	}
+
+	if limit < restrictions.Minimum {
+		log.Debugf("htlc limit: %v for %v below minimum: %v", limit,
+			balance.channels, restrictions.Minimum)
+
+		return 0, newReasonError(ReasonHtlcLimits)
+	}
+
+	log.Debugf("lowering swap amount: %v for %v to htlc limit: %v", amount,
+		balance.channels, limit)
+
+	return limit, nil
+}
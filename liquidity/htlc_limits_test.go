@@ -0,0 +1,197 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChannelHtlcsLimit tests calculating the amount that a channel can carry
+// for a swap given its htlc constraints and pending htlcs.
+func TestChannelHtlcsLimit(t *testing.T) {
+	constraints := &lndclient.ChannelConstraints{
+		MaxAcceptedHtlcs: 3,
+		MaxPendingAmt:    lnwire.NewMSatFromSatoshis(5000),
+	}
+
+	tests := []struct {
+		name     string
+		info     lndclient.ChannelInfo
+		swapType swap.Type
+		maxHtlc  btcutil.Amount
+		limit    btcutil.Amount
+	}{
+		{
+			name: "no constraints",
+			info: lndclient.ChannelInfo{
+				Capacity: 10000,
+			},
+			swapType: swap.TypeOut,
+			limit:    10000,
+		},
+		{
+			name: "in flight limit",
+			info: lndclient.ChannelInfo{
+				Capacity:         10000,
+				LocalConstraints: constraints,
+			},
+			swapType: swap.TypeOut,
+			limit:    5000,
+		},
+		{
+			name: "pending htlcs",
+			info: lndclient.ChannelInfo{
+				Capacity:          10000,
+				LocalConstraints:  constraints,
+				RemoteConstraints: constraints,
+				PendingHtlcs: []lndclient.PendingHtlc{
+					{
+						Incoming: true,
+						Amount:   1000,
+					},
+				},
+			},
+			swapType: swap.TypeIn,
+			limit:    4000,
+		},
+		{
+			name: "max htlc, prepay slot reserved",
+			info: lndclient.ChannelInfo{
+				Capacity:         10000,
+				LocalConstraints: constraints,
+			},
+			swapType: swap.TypeOut,
+			maxHtlc:  1000,
+			limit:    2000,
+		},
+		{
+			name: "slots saturated",
+			info: lndclient.ChannelInfo{
+				Capacity:         10000,
+				LocalConstraints: constraints,
+				PendingHtlcs: []lndclient.PendingHtlc{
+					{Amount: 100}, {Amount: 100},
+				},
+			},
+			swapType: swap.TypeOut,
+			limit:    0,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			htlcs := newChannelHtlcs(testCase.info)
+			require.Equal(t, testCase.limit, htlcs.limit(
+				testCase.swapType, testCase.maxHtlc,
+			))
+		})
+	}
+}
+
+// TestHtlcLimits tests that we lower the amounts of suggested swaps to what
+// their channels can carry, and skip swaps whose channels cannot carry our
+// minimum swap amount.
+func TestHtlcLimits(t *testing.T) {
+	prepay, routing := testPPMFees(defaultFeePPM, testQuote, 6000)
+
+	limitedRec := chan1Rec
+	limitedRec.Amount = 6000
+	limitedRec.MaxPrepayRoutingFee = prepay
+	limitedRec.MaxSwapRoutingFee = routing
+
+	newConstraints := func(slots uint32,
+		pending btcutil.Amount) *lndclient.ChannelConstraints {
+
+		return &lndclient.ChannelConstraints{
+			MaxAcceptedHtlcs: slots,
+			MaxPendingAmt:    lnwire.NewMSatFromSatoshis(pending),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		constraints *lndclient.ChannelConstraints
+		pending     []lndclient.PendingHtlc
+		edge        *lndclient.ChannelEdge
+		suggestions *Suggestions
+	}{
+		{
+			name:        "in flight limit",
+			constraints: newConstraints(10, 6000),
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					limitedRec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:        "max htlc",
+			constraints: newConstraints(4, 10000),
+			edge: &lndclient.ChannelEdge{
+				Node1:       peer1,
+				Node1Policy: &lndclient.RoutingPolicy{},
+				Node2Policy: &lndclient.RoutingPolicy{
+					MaxHtlcMsat: 2000000,
+				},
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					limitedRec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:        "slots saturated",
+			constraints: newConstraints(2, 10000),
+			pending: []lndclient.PendingHtlc{
+				{Amount: 100},
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonHtlcLimits,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			channel := channel1
+			channel.LocalConstraints = testCase.constraints
+			channel.PendingHtlcs = testCase.pending
+			lnd.Channels = []lndclient.ChannelInfo{channel}
+
+			edges := make(map[uint64]*lndclient.ChannelEdge)
+			if testCase.edge != nil {
+				edges[chanID1.ToUint64()] = testCase.edge
+			}
+			lnd.ChannelEdges = edges
+
+			params := defaultParameters
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
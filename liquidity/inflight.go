@@ -0,0 +1,65 @@
+package liquidity
+
+import (
+	"errors"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// DefaultChannelsPerInFlight is the default number of managed channels that
+// we allow one in flight swap for when our in flight limit is adaptive.
+const DefaultChannelsPerInFlight = 10
+
+// ErrZeroChannelsPerInFlight is returned if an adaptive in flight limit is
+// set without a number of channels per swap.
+var ErrZeroChannelsPerInFlight = errors.New("channels per in flight swap " +
+	"must be > 0")
+
+// managedChannels returns the number of channels provided that autoloop
+// manages: channels that are not excluded and that have a channel rule,
+// belong to a peer that has a rule or are part of a channel group. When easy
+// autoloop is enabled, all of our channels that are not excluded are managed.
+func (p Parameters) managedChannels(channels []lndclient.ChannelInfo) int {
+	var count int
+	for _, channel := range channels {
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		if p.isExcluded(chanID) {
+			continue
+		}
+
+		_, haveChanRule := p.ChannelRules[chanID]
+		_, havePeerRule := p.PeerRules[channel.PubKeyBytes]
+		_, inGroup := p.channelGroup(chanID)
+
+		if p.EasyAutoloop || haveChanRule || havePeerRule || inGroup {
+			count++
+		}
+	}
+
+	return count
+}
+
+// inFlightLimit returns the number of automatically dispatched swaps that we
+// allow in flight at once. If our limit is adaptive, we allow one swap for
+// every ChannelsPerInFlight channels that we manage, rounded up so that we
+// always allow at least one swap. MaxAutoInFlight is an absolute ceiling in
+// either case.
+func (p Parameters) inFlightLimit(channels []lndclient.ChannelInfo) int {
+	if !p.AdaptiveInFlight {
+		return p.MaxAutoInFlight
+	}
+
+	managed := p.managedChannels(channels)
+	limit := (managed + p.ChannelsPerInFlight - 1) / p.ChannelsPerInFlight
+
+	if limit < 1 {
+		limit = 1
+	}
+
+	if limit > p.MaxAutoInFlight {
+		limit = p.MaxAutoInFlight
+	}
+
+	return limit
+}
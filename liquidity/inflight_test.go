@@ -0,0 +1,109 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAdaptiveInFlightLimit tests calculation of our in flight limit when it is
+// fixed and when it scales with the number of channels that we manage.
+func TestAdaptiveInFlightLimit(t *testing.T) {
+	// Create a set of channels with peer1 and a single channel with
+	// peer2.
+	var channels []lndclient.ChannelInfo
+	for i := 1; i <= 25; i++ {
+		channels = append(channels, lndclient.ChannelInfo{
+			ChannelID:   uint64(100 + i),
+			PubKeyBytes: peer1,
+		})
+	}
+	channels = append(channels, channel2)
+
+	peerRules := map[route.Vertex]*SwapRule{
+		peer1: chanRule,
+	}
+
+	tests := []struct {
+		name     string
+		params   Parameters
+		expected int
+	}{
+		{
+			name: "fixed limit",
+			params: Parameters{
+				MaxAutoInFlight: 3,
+				PeerRules:       peerRules,
+			},
+			expected: 3,
+		},
+		{
+			name: "adaptive rounded up",
+			params: Parameters{
+				MaxAutoInFlight:     10,
+				AdaptiveInFlight:    true,
+				ChannelsPerInFlight: 10,
+				PeerRules:           peerRules,
+			},
+			expected: 3,
+		},
+		{
+			name: "adaptive ceiling",
+			params: Parameters{
+				MaxAutoInFlight:     2,
+				AdaptiveInFlight:    true,
+				ChannelsPerInFlight: 10,
+				PeerRules:           peerRules,
+			},
+			expected: 2,
+		},
+		{
+			name: "adaptive with no managed channels",
+			params: Parameters{
+				MaxAutoInFlight:     10,
+				AdaptiveInFlight:    true,
+				ChannelsPerInFlight: 10,
+			},
+			expected: 1,
+		},
+		{
+			name: "adaptive excluded channels",
+			params: Parameters{
+				MaxAutoInFlight:     10,
+				AdaptiveInFlight:    true,
+				ChannelsPerInFlight: 5,
+				PeerRules:           peerRules,
+				ExcludedChannels: []lnwire.ShortChannelID{
+					lnwire.NewShortChanIDFromInt(101),
+					lnwire.NewShortChanIDFromInt(102),
+					lnwire.NewShortChanIDFromInt(103),
+					lnwire.NewShortChanIDFromInt(104),
+					lnwire.NewShortChanIDFromInt(105),
+				},
+			},
+			expected: 4,
+		},
+		{
+			name: "adaptive easy autoloop",
+			params: Parameters{
+				MaxAutoInFlight:     10,
+				AdaptiveInFlight:    true,
+				ChannelsPerInFlight: 13,
+				EasyAutoloop:        true,
+			},
+			expected: 2,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			limit := testCase.params.inFlightLimit(channels)
+			require.Equal(t, testCase.expected, limit)
+		})
+	}
+}
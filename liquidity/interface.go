@@ -37,6 +37,12 @@ type FeeLimit interface {
 	// limits for the swap amount.
 	loopInLimits(amount btcutil.Amount,
 		quote *loop.LoopInQuote) error
+
+	// previewLoopOut validates a loop out quote against our fee limits and
+	// returns the full breakdown of fees that we would set for a swap of
+	// the amount provided.
+	previewLoopOut(amount btcutil.Amount,
+		quote *loop.LoopOutQuote) (*FeeBreakdown, error)
 }
 
 // swapBuilder is an interface used to build our different swap types.
@@ -76,6 +82,9 @@ type swapSuggestion interface {
 	// amount returns the swap amount in satoshis.
 	amount() btcutil.Amount
 
+	// swapType returns the type of the swap.
+	swapType() swap.Type
+
 	// channels returns the set of channels involved in the swap.
 	channels() []lnwire.ShortChannelID
 
@@ -83,4 +92,8 @@ type swapSuggestion interface {
 	// of known channel IDs to peers as an argument so that channel peers
 	// can be looked up.
 	peers(knownChans map[uint64]route.Vertex) []route.Vertex
+
+	// vbytes returns the estimated on-chain footprint of the swap in
+	// vbytes.
+	vbytes() uint64
 }
@@ -41,6 +41,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
@@ -78,6 +79,15 @@ const (
 	// swap checks.
 	DefaultAutoloopTicker = time.Minute * 10
 
+	// DefaultQuoteWorkers is the default number of swap suggestions that
+	// we get concurrently, each of which requires a quote from the server.
+	DefaultQuoteWorkers = 4
+
+	// DefaultQuoteTimeout is the default amount of time that we allow for
+	// getting a single swap suggestion, including its quote. This is
+	// generous to allow for the latency of connections over Tor.
+	DefaultQuoteTimeout = time.Second * 30
+
 	// autoloopSwapInitiator is the value we send in the initiator field of
 	// a swap request when issuing an automatic swap.
 	autoloopSwapInitiator = "autoloop"
@@ -107,12 +117,19 @@ var (
 	defaultParameters = Parameters{
 		AutoFeeBudget:   defaultBudget,
 		MaxAutoInFlight: defaultMaxInFlight,
-		ChannelRules:    make(map[lnwire.ShortChannelID]*SwapRule),
-		PeerRules:       make(map[route.Vertex]*SwapRule),
-		FailureBackOff:  defaultFailureBackoff,
-		SweepConfTarget: defaultConfTarget,
-		HtlcConfTarget:  defaultHtlcConfTarget,
-		FeeLimit:        defaultFeePortion(),
+
+		ChannelsPerInFlight: DefaultChannelsPerInFlight,
+		ChannelRules:        make(map[lnwire.ShortChannelID]*SwapRule),
+		PeerRules:           make(map[route.Vertex]*SwapRule),
+		FailureBackOff:      defaultFailureBackoff,
+		SweepConfTarget:     defaultConfTarget,
+		HtlcConfTarget:      defaultHtlcConfTarget,
+		FeeLimit:            defaultFeePortion(),
+
+		VBytesBudgetPeriod: DefaultVBytesBudgetPeriod,
+		SwapRatePeriod:     DefaultSwapRatePeriod,
+		BackoffMultiplier:  DefaultBackoffMultiplier,
+		BackoffCap:         DefaultBackoffCap,
 	}
 
 	// ErrZeroChannelID is returned if we get a rule for a 0 channel ID.
@@ -121,9 +138,90 @@ var (
 	// ErrNegativeBudget is returned if a negative swap budget is set.
 	ErrNegativeBudget = errors.New("swap budget must be >= 0")
 
+	// ErrNegativeCooldown is returned if a negative rule cooldown is set.
+	ErrNegativeCooldown = errors.New("rule cooldown must be >= 0")
+
+	// ErrNegativeSuccessCooldown is returned if a negative success
+	// cooldown is set.
+	ErrNegativeSuccessCooldown = errors.New("success cooldown must be " +
+		">= 0")
+
+	// ErrNegativeDispatchSpacing is returned if a negative dispatch spacing
+	// is set.
+	ErrNegativeDispatchSpacing = errors.New("dispatch spacing must be " +
+		">= 0")
+
+	// ErrNegativeFlowWindow is returned if a negative flow window is set.
+	ErrNegativeFlowWindow = errors.New("flow window must be >= 0")
+
+	// ErrNegativePublicationDeadline is returned if a negative publication
+	// deadline is set.
+	ErrNegativePublicationDeadline = errors.New("publication deadline " +
+		"must be >= 0")
+
+	// ErrNegativeRevenueWindow is returned if a negative rule revenue
+	// window is set.
+	ErrNegativeRevenueWindow = errors.New("rule revenue window must be " +
+		">= 0")
+
+	// ErrRevenueLoopIn is returned if a minimum revenue is set for a loop
+	// in rule, because loop ins do not refill the channels that earn
+	// routing revenue by forwarding out.
+	ErrRevenueLoopIn = errors.New("minimum revenue may only be set for " +
+		"loop out rules")
+
+	// ErrPublicationDeadlineLoopIn is returned if a publication deadline
+	// is set for a loop in rule, because we publish loop in htlcs
+	// ourselves.
+	ErrPublicationDeadlineLoopIn = errors.New("publication deadline may " +
+		"only be set for loop out rules")
+
+	// ErrNegativeMaxSwapAmount is returned if a negative rule maximum swap
+	// amount is set.
+	ErrNegativeMaxSwapAmount = errors.New("rule maximum swap amount " +
+		"must be >= 0")
+
+	// ErrCustomRecordsLoopIn is returned if custom records are set for a
+	// loop in rule, because the client does not make the off-chain
+	// payments of loop in swaps.
+	ErrCustomRecordsLoopIn = errors.New("custom records may only be set " +
+		"for loop out rules")
+
+	// ErrNegativeTypeInFlight is returned if a negative in flight limit is
+	// set for a swap type.
+	ErrNegativeTypeInFlight = errors.New("swap type in flight limits " +
+		"must be >= 0")
+
 	// ErrZeroInFlight is returned is a zero in flight swaps value is set.
 	ErrZeroInFlight = errors.New("max in flight swaps must be >=0")
 
+	// ErrZeroVBytesPeriod is returned if a vbytes budget is set without a
+	// period to apply it to.
+	ErrZeroVBytesPeriod = errors.New("vbytes budget period must be > 0")
+
+	// ErrNegativeRefreshPeriod is returned if a negative budget refresh
+	// period is set.
+	ErrNegativeRefreshPeriod = errors.New("budget refresh period must " +
+		"be >= 0")
+
+	// ErrNegativeFeeCeiling is returned if a negative chain fee ceiling is
+	// set.
+	ErrNegativeFeeCeiling = errors.New("chain fee ceiling must be >= 0")
+
+	// ErrNegativeApprovalTTL is returned if a negative approval ttl is
+	// set.
+	ErrNegativeApprovalTTL = errors.New("approval ttl must be >= 0")
+
+	// ErrRefreshNoStart is returned if a budget refresh period is set
+	// without a budget start date to measure our periods from.
+	ErrRefreshNoStart = errors.New("budget refresh period requires a " +
+		"budget start date")
+
+	// ErrRolloverNoRefresh is returned if budget rollover is enabled
+	// without a budget refresh period.
+	ErrRolloverNoRefresh = errors.New("budget rollover requires a " +
+		"budget refresh period")
+
 	// ErrMinimumExceedsMaximumAmt is returned when the minimum configured
 	// swap amount is more than the maximum.
 	ErrMinimumExceedsMaximumAmt = errors.New("minimum swap amount " +
@@ -156,36 +254,41 @@ type Config struct {
 	// trigger autoloop in itests.
 	AutoloopTicker *ticker.Force
 
-	// Restrictions returns the restrictions that the server applies to
-	// swaps.
-	Restrictions func(ctx context.Context, swapType swap.Type) (
-		*Restrictions, error)
+	// Channels provides the channels that we assess for swaps.
+	Channels ChannelSource
+
+	// Peers provides the peers that we are currently connected to. If it
+	// is nil, we do not check whether peers are online.
+	Peers PeerSource
+
+	// Quotes provides the restrictions that the server applies to swaps,
+	// and quotes for the swaps that we suggest.
+	Quotes QuoteSource
 
-	// Lnd provides us with access to lnd's rpc servers.
-	Lnd *lndclient.LndServices
+	// Dispatcher dispatches the swaps that we decide to execute.
+	Dispatcher SwapDispatcher
 
-	// ListLoopOut returns all of the loop our swaps stored on disk.
-	ListLoopOut func() ([]*loopdb.LoopOut, error)
+	// Swaps provides all of the swaps that are stored on disk.
+	Swaps SwapStore
 
-	// ListLoopIn returns all of the loop in swaps stored on disk.
-	ListLoopIn func() ([]*loopdb.LoopIn, error)
+	// Wallet provides the fee estimates that we check our fee limits
+	// against, and the addresses that our loop outs sweep to.
+	Wallet WalletSource
 
-	// LoopOutQuote gets swap fee, estimated miner fee and prepay amount for
-	// a loop out swap.
-	LoopOutQuote func(ctx context.Context,
-		request *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote, error)
+	// Graph provides the routes and channel policies that we use to
+	// estimate routing fees.
+	Graph GraphSource
 
-	// LoopInQuote provides a quote for a loop in swap.
-	LoopInQuote func(ctx context.Context,
-		request *loop.LoopInQuoteRequest) (*loop.LoopInQuote, error)
+	// Node provides our node's block height, forwarding history, closed
+	// channels and invoice decoding.
+	Node NodeSource
 
-	// LoopOut dispatches a loop out.
-	LoopOut func(ctx context.Context, request *loop.OutRequest) (
-		*loop.LoopOutSwapInfo, error)
+	// ChainParams is the chain that our node runs on.
+	ChainParams *chaincfg.Params
 
-	// LoopIn dispatches a loop in swap.
-	LoopIn func(ctx context.Context,
-		request *loop.LoopInRequest) (*loop.LoopInSwapInfo, error)
+	// NodePubkey is our node's public key, which circular rebalances are
+	// routed back to.
+	NodePubkey route.Vertex
 
 	// Clock allows easy mocking of time in unit tests.
 	Clock clock.Clock
@@ -193,6 +296,62 @@ type Config struct {
 	// MinimumConfirmations is the minimum number of confirmations we allow
 	// setting for sweep target.
 	MinimumConfirmations int32
+
+	// Rebalancer executes circular rebalances between our channels and
+	// lists the rebalances that are stored on disk. If it is nil, we only
+	// suggest swaps.
+	Rebalancer Rebalancer
+
+	// PreSwapRebalancer is given the chance to rebalance the channels of
+	// each loop out that we dispatch off-chain first. If it rebalances the
+	// full amount of the swap, the swap is not dispatched. Otherwise, or if
+	// it fails, we fall back to the swap. If it is nil, we always dispatch
+	// our swaps.
+	PreSwapRebalancer PreSwapRebalancer
+
+	// Notes records the shrink policy that was applied to swaps that we
+	// adjusted at dispatch time. If it is nil, adjustments are only
+	// logged.
+	Notes SwapAnnotator
+
+	// QuoteWorkers is the maximum number of swap suggestions that we get
+	// concurrently. If it is zero, DefaultQuoteWorkers is used.
+	QuoteWorkers int
+
+	// QuoteTimeout is the amount of time that we allow for getting a
+	// single swap suggestion. If it is zero, DefaultQuoteTimeout is used.
+	QuoteTimeout time.Duration
+
+	// Recorder stores the metrics of our autoloop ticks, the suggestions
+	// that they made and the swaps that they simulated. If it is nil,
+	// these are only logged.
+	Recorder AutoloopRecorder
+
+	// Sync reports whether lnd is synced to the chain. Autoloop ticks are
+	// skipped while it is not, and resume once lnd has caught up. If it
+	// is nil, we assume that lnd is synced.
+	Sync SyncChecker
+
+	// RandomAmount returns a random amount in [0, max], which we use to
+	// randomize suggested swap amounts. If it is nil, a cryptographically
+	// secure source of randomness is used.
+	RandomAmount func(max btcutil.Amount) btcutil.Amount
+
+	// Notices provides the operational notices that the server has
+	// published. If it is nil, we do not take server notices into
+	// account.
+	Notices NoticeSource
+
+	// BudgetIncreaseDelay is the amount of time that increases to our fee
+	// budget or fee limit that exceed our increase threshold are held
+	// back for before they take effect. If it is zero, increases take
+	// effect immediately.
+	BudgetIncreaseDelay time.Duration
+
+	// BudgetIncreaseThreshold is the percentage that our fee budget and
+	// each of our fee limits may be increased by in a single update
+	// without being delayed.
+	BudgetIncreaseThreshold uint64
 }
 
 // Parameters is a set of parameters provided by the user which guide
@@ -211,24 +370,167 @@ type Parameters struct {
 	// dispatched swaps in our current budget, inclusive.
 	AutoFeeStartDate time.Time
 
+	// AutoFeeRefreshPeriod is the length of our budget period. If it is
+	// non-zero, our start date is moved forward by this period each time
+	// that it elapses, so that our budget resets. A zero value disables
+	// budget refreshes.
+	AutoFeeRefreshPeriod time.Duration
+
+	// AutoFeeRollover indicates whether budget that was not spent in a
+	// budget period is carried over to the next one when our budget
+	// refreshes.
+	AutoFeeRollover bool
+
+	// AutoFeeRolledOver is the unused budget that was carried over from
+	// our previous budget period, which is available in addition to our
+	// fee budget.
+	AutoFeeRolledOver btcutil.Amount
+
+	// AutoFeeBudgetPPM expresses our fee budget as parts per million of
+	// the volume swapped by automatically dispatched swaps in our current
+	// budget period. If it is non-zero, our budget for the period is the
+	// larger of AutoFeeBudget and this share of our swapped volume.
+	AutoFeeBudgetPPM uint64
+
+	// AutoFeeBudgetOut and AutoFeeBudgetIn are optional fee budgets for
+	// automatically dispatched loop out and loop in swaps since our
+	// budget start date. They apply in addition to our global budget, so
+	// that one swap type cannot consume the budget intended for the
+	// other. A zero value places no limit on the type beyond our global
+	// budget.
+	AutoFeeBudgetOut btcutil.Amount
+	AutoFeeBudgetIn  btcutil.Amount
+
+	// MaxAutoInFlightOut and MaxAutoInFlightIn are optional limits on the
+	// number of automatically dispatched loop out and loop in swaps that
+	// may be in flight. They apply in addition to our global in flight
+	// limit. A zero value places no limit on the type beyond our global
+	// limit.
+	MaxAutoInFlightOut int
+	MaxAutoInFlightIn  int
+
+	// AutoloopSchedule is the set of windows in which the autolooper may
+	// dispatch swaps. Outside of these windows, we still compute our swap
+	// suggestions but do not dispatch them. If no windows are set, swaps
+	// may be dispatched at any time.
+	AutoloopSchedule []ScheduleWindow
+
+	// ChainFeeCeiling is the maximum on-chain fee rate at which the
+	// autolooper dispatches swaps. Each tick, we estimate the fee rate for
+	// our htlc confirmation target and defer dispatch while it is above
+	// this ceiling. A zero value disables the ceiling.
+	ChainFeeCeiling chainfee.SatPerKWeight
+
 	// MaxAutoInFlight is the maximum number of in-flight automatically
-	// dispatched swaps we allow.
+	// dispatched swaps we allow. If AdaptiveInFlight is set, it is the
+	// ceiling for our adaptive limit.
 	MaxAutoInFlight int
 
+	// AdaptiveInFlight scales the number of in-flight automatically
+	// dispatched swaps that we allow with the number of channels that
+	// autoloop manages, so that large nodes are not throttled and small
+	// nodes do not run too many swaps at once.
+	AdaptiveInFlight bool
+
+	// ChannelsPerInFlight is the number of managed channels that we allow
+	// one in-flight swap for when AdaptiveInFlight is set.
+	ChannelsPerInFlight int
+
 	// FailureBackOff is the amount of time that we require passes after a
 	// channel has been part of a failed loop out swap before we suggest
-	// using it again.
-	// TODO(carla): add exponential backoff
+	// using it again. If ExponentialBackoff is set, it is the backoff for
+	// a single failure.
 	FailureBackOff time.Duration
 
+	// ExponentialBackoff indicates that our failure backoff grows with
+	// the number of consecutive failures for a channel or peer, rather
+	// than being fixed at FailureBackOff.
+	ExponentialBackoff bool
+
+	// BackoffMultiplier is the factor that our failure backoff grows by
+	// for each consecutive failure when ExponentialBackoff is set.
+	BackoffMultiplier float64
+
+	// BackoffCap is the maximum failure backoff when ExponentialBackoff
+	// is set, before jitter is added.
+	BackoffCap time.Duration
+
+	// BackoffJitter is the largest percentage of our failure backoff that
+	// is added to it as jitter when ExponentialBackoff is set.
+	BackoffJitter uint64
+
+	// SuccessCooldown is the amount of time that we require passes after
+	// a swap has completed successfully for a channel or peer before we
+	// suggest another swap for it, so that we do not immediately swap
+	// again when flow moves its balance back across its threshold. A zero
+	// value disables the cooldown.
+	SuccessCooldown time.Duration
+
+	// RetryDispatchFailures indicates that when a swap that autoloop
+	// dispatches fails at initiation, for example because the server
+	// rejects it, we dispatch the next-best swap that our in flight limit
+	// excluded in the same tick, rather than waiting for the next tick.
+	RetryDispatchFailures bool
+
+	// DispatchSpacing is the amount of time that we wait between
+	// dispatching the swaps that we decide on in a single autoloop tick,
+	// so that they do not compete for the same routes and their on-chain
+	// transactions are spread out. A zero value dispatches all swaps at
+	// once.
+	DispatchSpacing time.Duration
+
+	// MaxSwapsPerPeriod is the maximum number of automatically dispatched
+	// swaps that we allow to be initiated within SwapRatePeriod. If it is
+	// zero, the number of swaps is not limited.
+	MaxSwapsPerPeriod int
+
+	// MaxChannelSwapsPerPeriod is the maximum number of automatically
+	// dispatched swaps that we allow for a single channel or peer within
+	// SwapRatePeriod. If it is zero, the number of swaps is not limited.
+	MaxChannelSwapsPerPeriod int
+
+	// SwapRatePeriod is the rolling window that we limit the number of
+	// automatically dispatched swaps in.
+	SwapRatePeriod time.Duration
+
 	// SweepConfTarget is the number of blocks we aim to confirm our sweep
 	// transaction in. This value affects the on chain fees we will pay.
 	SweepConfTarget int32
 
+	// PublicationDeadline is the amount of time after a loop out is
+	// suggested that we allow the server to wait before publishing its
+	// htlc, so that it can batch the htlc with other swaps and wait for
+	// lower chain fees, which lowers our swap fee. A zero value asks the
+	// server to publish immediately.
+	PublicationDeadline time.Duration
+
 	// HtlcConfTarget is the confirmation target that we use for publishing
 	// loop in swap htlcs on chain.
 	HtlcConfTarget int32
 
+	// VBytesBudget is the maximum number of vbytes that loop transactions
+	// may occupy on chain per VBytesBudgetPeriod. Suggested swaps that
+	// would exceed this budget are disqualified. A zero value disables the
+	// budget.
+	VBytesBudget uint64
+
+	// VBytesBudgetPeriod is the rolling period that our vbytes budget
+	// applies to.
+	VBytesBudgetPeriod time.Duration
+
+	// RebalanceFeePPM is the maximum routing fee that we pay for a
+	// circular rebalance, expressed as parts per million of the rebalance
+	// amount. If a rebalance executor is configured and this value is
+	// non-zero, loop out suggestions for single channels are replaced by
+	// circular rebalances when a rebalance is cheaper and within this
+	// limit.
+	RebalanceFeePPM uint64
+
+	// RebalanceBudget is the total amount of routing fees that we allow
+	// to be spent on circular rebalances that were dispatched since our
+	// budget start date. It is separate from our swap budget.
+	RebalanceBudget btcutil.Amount
+
 	// FeeLimit controls the fee limit we place on swaps.
 	FeeLimit FeeLimit
 
@@ -246,11 +548,102 @@ type Parameters struct {
 	// ChannelRules are exclusively set to prevent overlap between peer
 	// and channel rules map to avoid ambiguity.
 	PeerRules map[route.Vertex]*SwapRule
+
+	// ChannelGroups maps a group name to a set of channels that share a
+	// single rule. A channel may only belong to one group, and channels
+	// in a group may not have a channel rule or belong to a peer that has
+	// a peer rule.
+	ChannelGroups map[string]*ChannelGroup
+
+	// ExcludedChannels is the set of channels that are managed externally,
+	// for example by another rebalancing tool. We never suggest swaps or
+	// rebalances that use these channels, and they are left out of the
+	// balances of peer and group rules, even if a rule is set for them.
+	ExcludedChannels []lnwire.ShortChannelID
+
+	// MinChannelAge is the number of blocks that must have been mined
+	// since a channel confirmed before we suggest swaps for it, so that
+	// freshly opened channels are not drained or filled before their
+	// natural flow is known. Young channels are left out of the balances
+	// of peer and group rules. If it is zero, channels of any age are
+	// managed.
+	MinChannelAge uint32
+
+	// FlowWindow is the period of forwarding history that we use to
+	// forecast the flow of our channels. Swaps are lowered by the
+	// liquidity that their channels regained from forwarding in the last
+	// window, on the assumption that the flow repeats, so that channels
+	// which refill themselves are swapped less than stagnant channels. If
+	// it is zero, swaps are not sized for forwarding flow.
+	FlowWindow time.Duration
+
+	// ApprovalMode indicates that swaps that autoloop would dispatch are
+	// instead queued as pending approvals, which must be approved before
+	// they are dispatched.
+	ApprovalMode bool
+
+	// ApprovalTTL is the amount of time that a pending approval may be
+	// approved for before it expires. If it is zero, pending approvals do
+	// not expire.
+	ApprovalTTL time.Duration
+
+	// SimulationMode indicates that swaps that autoloop would dispatch
+	// are recorded along with their projected costs rather than being
+	// dispatched. Swaps are simulated whether or not autoloop is
+	// enabled, so that a set of rules can be evaluated before autoloop
+	// dispatches swaps for them. It takes precedence over approval mode.
+	SimulationMode bool
+
+	// EasyAutoloop enables a simplified mode in which we manage our node's
+	// liquidity as a whole rather than with per-channel, peer or group
+	// rules. We loop out the local balance that our node holds above
+	// EasyOutboundTarget, choosing the peers that are cheapest to swap
+	// against.
+	EasyAutoloop bool
+
+	// EasyOutboundTarget is the percentage of our total channel capacity
+	// that easy autoloop aims to hold as local balance.
+	EasyOutboundTarget uint64
+
+	// AmountBucket is the multiple that suggested swap amounts are rounded
+	// down to, so that our swaps do not reveal our exact channel
+	// balances. If it is zero, amounts are not rounded.
+	AmountBucket btcutil.Amount
+
+	// AmountJitter is the largest percentage of a suggested swap amount
+	// that is randomly removed from it before it is rounded. If it is
+	// zero, amounts are not randomized.
+	AmountJitter uint64
+
+	// LoopInPrivate indicates whether autoloop may suggest loop ins for
+	// peers that we only have private channels with. If it is set, our
+	// loop in swaps include route hints for the private channels of
+	// their last hop, so that the server can route to them.
+	LoopInPrivate bool
+
+	// BatchLoopOuts indicates whether the loop outs that we suggest for
+	// different channels and peers in the same tick are combined into a
+	// single swap over all of their channels, up to the server's maximum
+	// swap amount. Only loop outs for rules that use our global fee
+	// limits, publication deadline and sweep address are combined.
+	BatchLoopOuts bool
+
+	// RuleConflict determines how we resolve the rules of channels that
+	// have a rule of their own, and whose peer also has a rule.
+	RuleConflict RuleConflict
+
+	// LoopInSpread determines how we order the loop ins that we suggest
+	// for different last hops, so that our inbound liquidity is spread
+	// across our peers over time.
+	LoopInSpread LoopInSpread
 }
 
 // String returns the string representation of our parameters.
 func (p Parameters) String() string {
-	ruleList := make([]string, 0, len(p.ChannelRules)+len(p.PeerRules))
+	ruleList := make(
+		[]string, 0,
+		len(p.ChannelRules)+len(p.PeerRules)+len(p.ChannelGroups),
+	)
 
 	for channel, rule := range p.ChannelRules {
 		ruleList = append(
@@ -265,18 +658,60 @@ func (p Parameters) String() string {
 
 	}
 
+	for name, group := range p.ChannelGroups {
+		ruleList = append(
+			ruleList, fmt.Sprintf("Group: %v: %v", name, group),
+		)
+	}
+
 	return fmt.Sprintf("rules: %v, failure backoff: %v, sweep "+
 		"sweep conf target: %v, htlc conf target: %v,fees: %v, "+
-		"auto budget: %v, budget start: %v, max auto in flight: %v, "+
-		"minimum swap size=%v, maximum swap size=%v",
+		"auto budget: %v, budget start: %v, budget refresh: %v, "+
+		"budget rollover: %v, rolled over: %v, schedule: %v, "+
+		"chain fee ceiling: %v, max auto in flight: %v, "+
+		"minimum swap size=%v, maximum swap size=%v, vbytes "+
+		"budget: %v per %v, rebalance fee ppm: %v, rebalance "+
+		"budget: %v, excluded channels: %v, approval mode: %v, "+
+		"approval ttl: %v, easy autoloop: %v, easy outbound "+
+		"target: %v%%, amount bucket: %v, amount jitter: %v%%, "+
+		"adaptive in flight: %v, channels per in flight: %v, "+
+		"success cooldown: %v, retry dispatch failures: %v, "+
+		"max swaps: %v per %v, max channel swaps: %v, exponential "+
+		"backoff: %v, backoff multiplier: %v, backoff cap: %v, "+
+		"backoff jitter: %v%%, budget ppm: %v, loop out budget: %v, "+
+		"loop in budget: %v, loop out in flight: %v, loop in in "+
+		"flight: %v, dispatch spacing: %v, min channel age: %v "+
+		"blocks, simulation mode: %v, flow window: %v, "+
+		"publication deadline: %v, loop in private: %v, batch "+
+		"loop outs: %v, rule conflict: %v, loop in spread: %v",
 		strings.Join(ruleList, ","), p.FailureBackOff,
 		p.SweepConfTarget, p.HtlcConfTarget, p.FeeLimit,
-		p.AutoFeeBudget, p.AutoFeeStartDate, p.MaxAutoInFlight,
-		p.ClientRestrictions.Minimum, p.ClientRestrictions.Maximum)
+		p.AutoFeeBudget, p.AutoFeeStartDate, p.AutoFeeRefreshPeriod,
+		p.AutoFeeRollover, p.AutoFeeRolledOver, p.AutoloopSchedule,
+		p.ChainFeeCeiling, p.MaxAutoInFlight,
+		p.ClientRestrictions.Minimum, p.ClientRestrictions.Maximum,
+		p.VBytesBudget, p.VBytesBudgetPeriod, p.RebalanceFeePPM,
+		p.RebalanceBudget, p.ExcludedChannels, p.ApprovalMode,
+		p.ApprovalTTL, p.EasyAutoloop, p.EasyOutboundTarget,
+		p.AmountBucket, p.AmountJitter, p.AdaptiveInFlight,
+		p.ChannelsPerInFlight, p.SuccessCooldown,
+		p.RetryDispatchFailures, p.MaxSwapsPerPeriod, p.SwapRatePeriod,
+		p.MaxChannelSwapsPerPeriod, p.ExponentialBackoff,
+		p.BackoffMultiplier, p.BackoffCap, p.BackoffJitter,
+		p.AutoFeeBudgetPPM, p.AutoFeeBudgetOut, p.AutoFeeBudgetIn,
+		p.MaxAutoInFlightOut, p.MaxAutoInFlightIn, p.DispatchSpacing,
+		p.MinChannelAge, p.SimulationMode, p.FlowWindow,
+		p.PublicationDeadline, p.LoopInPrivate, p.BatchLoopOuts,
+		p.RuleConflict, p.LoopInSpread)
 }
 
 // haveRules returns a boolean indicating whether we have any rules configured.
+// Easy autoloop is treated as a single node-wide rule.
 func (p Parameters) haveRules() bool {
+	if p.EasyAutoloop {
+		return true
+	}
+
 	if len(p.ChannelRules) != 0 {
 		return true
 	}
@@ -285,14 +720,19 @@ func (p Parameters) haveRules() bool {
 		return true
 	}
 
+	if len(p.ChannelGroups) != 0 {
+		return true
+	}
+
 	return false
 }
 
 // validate checks whether a set of parameters is valid. Our set of currently
 // open channels are required to check that there is no overlap between the
 // rules set on a per-peer level, and those set for specific channels. We can't
-// allow both, because then we're trying to cater for two separate liquidity
-// goals on the same channel. Since we use short channel ID, we don't need to
+// allow both unless our rule conflict setting resolves which of them applies,
+// because then we're trying to cater for two separate liquidity goals on the
+// same channel. Since we use short channel ID, we don't need to
 // worry about pending channels (users would need to work very hard to get the
 // short channel ID for a pending channel). Likewise, we don't care about closed
 // channels, since there is no action that may occur on them, and we want to
@@ -304,23 +744,10 @@ func (p Parameters) validate(minConfs int32, openChans []lndclient.ChannelInfo,
 	server *Restrictions) error {
 
 	// First, we check that the rules on a per peer and per channel do not
-	// overlap, since this could lead to contractions.
-	for _, channel := range openChans {
-		// If we don't have a rule for the peer, there's no way we have
-		// an overlap between this peer and the channel.
-		_, ok := p.PeerRules[channel.PubKeyBytes]
-		if !ok {
-			continue
-		}
-
-		shortID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
-		_, ok = p.ChannelRules[shortID]
-		if ok {
-			log.Debugf("Rules for peer: %v and its channel: %v "+
-				"can't both be set", channel.PubKeyBytes, shortID)
-
-			return ErrExclusiveRules
-		}
+	// overlap, unless our rule conflict setting can resolve which of them
+	// applies, since this could lead to contradictions.
+	if err := p.validateConflicts(openChans); err != nil {
+		return err
 	}
 
 	for channel, rule := range p.ChannelRules {
@@ -346,6 +773,14 @@ func (p Parameters) validate(minConfs int32, openChans []lndclient.ChannelInfo,
 		}
 	}
 
+	if err := p.validateGroups(openChans); err != nil {
+		return err
+	}
+
+	if err := p.LoopInSpread.validate(); err != nil {
+		return err
+	}
+
 	// Check that our confirmation target is above our required minimum.
 	if p.SweepConfTarget < minConfs {
 		return fmt.Errorf("confirmation target must be at least: %v",
@@ -360,14 +795,117 @@ func (p Parameters) validate(minConfs int32, openChans []lndclient.ChannelInfo,
 		return err
 	}
 
-	if p.AutoFeeBudget < 0 {
+	if p.AutoFeeBudget < 0 || p.AutoFeeRolledOver < 0 {
 		return ErrNegativeBudget
 	}
 
+	if p.AutoFeeBudgetOut < 0 || p.AutoFeeBudgetIn < 0 {
+		return ErrNegativeBudget
+	}
+
+	if p.AutoFeeRefreshPeriod < 0 {
+		return ErrNegativeRefreshPeriod
+	}
+
+	if p.AutoFeeRefreshPeriod != 0 && p.AutoFeeStartDate.IsZero() {
+		return ErrRefreshNoStart
+	}
+
+	if p.AutoFeeRollover && p.AutoFeeRefreshPeriod == 0 {
+		return ErrRolloverNoRefresh
+	}
+
 	if p.MaxAutoInFlight <= 0 {
 		return ErrZeroInFlight
 	}
 
+	if p.MaxAutoInFlightOut < 0 || p.MaxAutoInFlightIn < 0 {
+		return ErrNegativeTypeInFlight
+	}
+
+	if p.AdaptiveInFlight && p.ChannelsPerInFlight <= 0 {
+		return ErrZeroChannelsPerInFlight
+	}
+
+	if p.SuccessCooldown < 0 {
+		return ErrNegativeSuccessCooldown
+	}
+
+	if p.DispatchSpacing < 0 {
+		return ErrNegativeDispatchSpacing
+	}
+
+	if p.FlowWindow < 0 {
+		return ErrNegativeFlowWindow
+	}
+
+	if p.PublicationDeadline < 0 {
+		return ErrNegativePublicationDeadline
+	}
+
+	if p.ChainFeeCeiling < 0 {
+		return ErrNegativeFeeCeiling
+	}
+
+	for _, window := range p.AutoloopSchedule {
+		if err := window.validate(); err != nil {
+			return fmt.Errorf("schedule window %v: %w", window, err)
+		}
+	}
+
+	if p.VBytesBudget != 0 && p.VBytesBudgetPeriod <= 0 {
+		return ErrZeroVBytesPeriod
+	}
+
+	if p.MaxSwapsPerPeriod < 0 || p.MaxChannelSwapsPerPeriod < 0 {
+		return ErrNegativeSwapRateLimit
+	}
+
+	if p.haveSwapRateLimits() && p.SwapRatePeriod <= 0 {
+		return ErrZeroSwapRatePeriod
+	}
+
+	if p.RebalanceFeePPM > FeeBase {
+		return fmt.Errorf("%w: rebalance fee ppm %v", ErrInvalidPPM,
+			p.RebalanceFeePPM)
+	}
+
+	if p.RebalanceBudget < 0 {
+		return ErrNegativeBudget
+	}
+
+	if err := p.validateExcluded(); err != nil {
+		return err
+	}
+
+	if err := p.validateBackoff(); err != nil {
+		return err
+	}
+
+	if p.ApprovalTTL < 0 {
+		return ErrNegativeApprovalTTL
+	}
+
+	if p.EasyAutoloop {
+		if p.EasyOutboundTarget < 1 || p.EasyOutboundTarget > 99 {
+			return ErrInvalidEasyTarget
+		}
+
+		if len(p.ChannelRules) != 0 || len(p.PeerRules) != 0 ||
+			len(p.ChannelGroups) != 0 {
+
+			return ErrEasyWithRules
+		}
+	}
+
+	if p.AmountBucket < 0 {
+		return ErrNegativeAmountBucket
+	}
+
+	if p.AmountJitter > maxAmountJitter {
+		return ErrInvalidAmountJitter
+	}
+
 	err := validateRestrictions(server, &p.ClientRestrictions)
 	if err != nil {
 		return err
@@ -423,20 +961,69 @@ type Manager struct {
 	// updated at runtime.
 	params Parameters
 
+	// paramsVersion is incremented each time our parameters are set, so
+	// that changes to them can be detected.
+	paramsVersion uint64
+
 	// paramsLock is a lock for our current set of parameters.
 	paramsLock sync.Mutex
+
+	// profiles holds our saved parameter profiles, the schedule that we
+	// switch between them on and our parameters audit history. It is
+	// guarded by our params lock.
+	profiles *profileState
+
+	// approvals holds the swaps that are waiting for approval when
+	// autoloop is in approval mode.
+	approvals *approvalQueue
+
+	// simulation tracks the swaps that we have recently simulated.
+	simulation *simulationState
+
+	// deferred is the suggestion round of our last autoloop tick if it
+	// was not allowed to dispatch its swaps while autoloop is enabled. It
+	// is nil if our last tick dispatched its swaps.
+	deferred *loopdb.SuggestionRound
+
+	// deferredLock is a lock for our deferred suggestion round.
+	deferredLock sync.Mutex
+
+	// pendingIncrease is the increase to our fee budget or fee limit that
+	// is waiting for our increase delay to pass, or nil if there is none.
+	// It is guarded by our params lock.
+	pendingIncrease *PendingIncrease
+
+	// destinations tracks the sweep destination addresses that we have
+	// derived for our rules' swaps.
+	destinations *destinationState
+
+	// wg tracks the rebalance payments that autoloop has dispatched, so
+	// that we wait for them to exit when we shut down.
+	wg sync.WaitGroup
 }
 
 // Run periodically checks whether we should automatically dispatch a loop out.
 // We run this loop even if automated swaps are not currently enabled rather
 // than managing starting and stopping the ticker as our parameters are updated.
 func (m *Manager) Run(ctx context.Context) error {
+	defer m.wg.Wait()
+
 	m.cfg.AutoloopTicker.Resume()
 	defer m.cfg.AutoloopTicker.Stop()
 
 	for {
 		select {
 		case <-m.cfg.AutoloopTicker.Ticks():
+			// Switch to the profile that our schedule selects before
+			// we tick, so that the tick uses its parameters.
+			if err := m.switchProfile(ctx); err != nil {
+				log.Errorf("profile switch failed: %v", err)
+			}
+
+			// Apply any increase to our budget or fee limit that
+			// has waited out its delay.
+			m.applyPendingIncrease()
+
 			err := m.autoloop(ctx)
 			switch err {
 			case ErrNoRules:
@@ -457,8 +1044,12 @@ func (m *Manager) Run(ctx context.Context) error {
 // NewManager creates a liquidity manager which has no rules set.
 func NewManager(cfg *Config) *Manager {
 	return &Manager{
-		cfg:    cfg,
-		params: defaultParameters,
+		cfg:          cfg,
+		params:       defaultParameters,
+		profiles:     newProfileState(),
+		approvals:    newApprovalQueue(),
+		simulation:   newSimulationState(),
+		destinations: newDestinationState(),
 	}
 }
 
@@ -473,12 +1064,12 @@ func (m *Manager) GetParameters() Parameters {
 // SetParameters updates our current set of parameters if the new parameters
 // provided are valid.
 func (m *Manager) SetParameters(ctx context.Context, params Parameters) error {
-	restrictions, err := m.cfg.Restrictions(ctx, swap.TypeOut)
+	restrictions, err := m.cfg.Quotes.Restrictions(ctx, swap.TypeOut)
 	if err != nil {
 		return err
 	}
 
-	channels, err := m.cfg.Lnd.Client.ListChannels(ctx, false, false)
+	channels, err := m.cfg.Channels.ListChannels(ctx)
 	if err != nil {
 		return err
 	}
@@ -491,7 +1082,14 @@ func (m *Manager) SetParameters(ctx context.Context, params Parameters) error {
 	m.paramsLock.Lock()
 	defer m.paramsLock.Unlock()
 
+	// Increases that exceed our threshold are held back until our
+	// increase delay has passed.
+	params = m.delayIncreases(params)
+
 	m.params = cloneParameters(params)
+	m.paramsVersion++
+	m.recordParamsChange(ParamsChangeRPC, "")
+
 	return nil
 }
 
@@ -506,8 +1104,7 @@ func cloneParameters(params Parameters) Parameters {
 	)
 
 	for channel, rule := range params.ChannelRules {
-		ruleCopy := *rule
-		paramCopy.ChannelRules[channel] = &ruleCopy
+		paramCopy.ChannelRules[channel] = rule.clone()
 	}
 
 	paramCopy.PeerRules = make(
@@ -516,67 +1113,292 @@ func cloneParameters(params Parameters) Parameters {
 	)
 
 	for peer, rule := range params.PeerRules {
-		ruleCopy := *rule
-		paramCopy.PeerRules[peer] = &ruleCopy
+		paramCopy.PeerRules[peer] = rule.clone()
+	}
+
+	paramCopy.ChannelGroups = nil
+	for name, group := range params.ChannelGroups {
+		if paramCopy.ChannelGroups == nil {
+			paramCopy.ChannelGroups = make(
+				map[string]*ChannelGroup,
+				len(params.ChannelGroups),
+			)
+		}
+
+		paramCopy.ChannelGroups[name] = &ChannelGroup{
+			Channels: append(
+				[]lnwire.ShortChannelID(nil),
+				group.Channels...,
+			),
+			Rule: group.Rule.clone(),
+		}
+	}
+
+	paramCopy.ExcludedChannels = append(
+		[]lnwire.ShortChannelID(nil), params.ExcludedChannels...,
+	)
+
+	paramCopy.AutoloopSchedule = nil
+	for _, window := range params.AutoloopSchedule {
+		window.Days = append([]time.Weekday(nil), window.Days...)
+		paramCopy.AutoloopSchedule = append(
+			paramCopy.AutoloopSchedule, window,
+		)
 	}
 
 	return paramCopy
 }
 
 // autoloop gets a set of suggested swaps and dispatches them automatically if
-// we have automated looping enabled.
+// we have automated looping enabled. The duration and work done by each tick
+// is recorded so that the performance of our suggestions can be monitored.
 func (m *Manager) autoloop(ctx context.Context) error {
-	suggestion, err := m.SuggestSwaps(ctx, true)
+	// If lnd is catching up with the chain, we skip our tick rather than
+	// failing partway through it, and pick up again once lnd is synced.
+	if m.cfg.Sync != nil && !m.cfg.Sync.LndSynced() {
+		log.Infof("lnd is not synced to chain, skipping autoloop tick")
+		return nil
+	}
+
+	var (
+		start = m.cfg.Clock.Now()
+		stats = &tickStats{}
+	)
+
+	err := m.runAutoloop(ctx, stats)
+	m.recordTick(start, stats, err)
+	m.recordRound(start, stats.round, err)
+
+	return err
+}
+
+// runAutoloop performs a single autoloop tick, tracking the work that it does
+// in the stats provided.
+func (m *Manager) runAutoloop(ctx context.Context, stats *tickStats) error {
+	suggestion, err := m.suggestSwaps(ctx, true, stats)
+	if err != nil {
+		return err
+	}
+
+	// We only dispatch swaps if autoloop is enabled and our schedule and
+	// chain fee ceiling allow it. Otherwise, we just log our suggestions.
+	dispatch, err := m.dispatchAllowed(ctx)
 	if err != nil {
 		return err
 	}
 
-	for _, swap := range suggestion.OutSwaps {
+	// If we dispatch swaps, we first check that they still fall within
+	// the server's restrictions, which may have changed since they were
+	// suggested.
+	set := newDispatchSet(suggestion)
+	if dispatch {
+		set, err = m.applyShrinkPolicies(ctx, suggestion)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Record the swaps that we decided on, so that we can audit our
+	// decisions later.
+	stats.round = newSuggestionRound(dispatch, set, suggestion)
+	m.setDeferred(dispatch, stats.round)
+
+	// If we are in simulation mode, we record the swaps that we would
+	// dispatch rather than dispatching them.
+	if dispatch && m.params.SimulationMode {
+		return m.simulateSwaps(set, suggestion.Rebalances)
+	}
+
+	// If we are in approval mode, we queue our swaps for approval rather
+	// than dispatching them.
+	if dispatch && m.params.ApprovalMode {
+		queued := m.queueApprovals(set, suggestion.Rebalances)
+		log.Infof("queued %v swaps for approval", queued)
+
+		return nil
+	}
+
+	// spacing waits for our dispatch spacing before each dispatch other
+	// than the first.
+	spacing := m.newDispatchSpacing()
+
+	// We iterate over our swaps by index, because swaps that fail to
+	// dispatch may add a backup swap to the end of our set.
+	for i := 0; i < len(set.outSwaps); i++ {
+		out := set.outSwaps[i]
+
 		// If we don't actually have dispatch of swaps enabled, log
 		// suggestions.
-		if !m.params.Autoloop {
+		if !dispatch {
 			log.Debugf("recommended autoloop out: %v sats over "+
-				"%v", swap.Amount, swap.OutgoingChanSet)
+				"%v", out.Amount, out.OutgoingChanSet)
 
 			continue
 		}
 
-		// Create a copy of our range var so that we can reference it.
-		swap := swap
-		loopOut, err := m.cfg.LoopOut(ctx, &swap)
-		if err != nil {
+		if err := spacing(ctx); err != nil {
 			return err
 		}
 
+		if m.rebalanceBeforeSwap(ctx, &out) {
+			continue
+		}
+
+		loopOut, err := m.dispatchLoopOut(ctx, &out, set.outDests[i])
+		if err != nil {
+			if !m.params.RetryDispatchFailures {
+				return err
+			}
+
+			log.Warnf("loop out over %v failed to dispatch: %v",
+				out.OutgoingChanSet, err)
+
+			m.retryOut(set, suggestion, stats, out)
+
+			continue
+		}
+
 		log.Infof("loop out automatically dispatched: hash: %v, "+
 			"address: %v", loopOut.SwapHash,
 			loopOut.HtlcAddressP2WSH)
+
+		stats.dispatched++
+		stats.round.LoopOut[i].Dispatched = true
+		m.recordShrink(swap.TypeOut, loopOut.SwapHash, set.outNotes[i])
 	}
 
-	for _, in := range suggestion.InSwaps {
+	for i := 0; i < len(set.inSwaps); i++ {
+		in := set.inSwaps[i]
+
 		// If we don't actually have dispatch of swaps enabled, log
 		// suggestions.
-		if !m.params.Autoloop {
+		if !dispatch {
 			log.Debugf("recommended autoloop in: %v sats over "+
 				"%v", in.Amount, in.LastHop)
 
 			continue
 		}
 
-		in := in
-		loopIn, err := m.cfg.LoopIn(ctx, &in)
-		if err != nil {
+		if err := spacing(ctx); err != nil {
 			return err
 		}
 
+		loopIn, err := m.cfg.Dispatcher.LoopIn(ctx, &in)
+		if err != nil {
+			if !m.params.RetryDispatchFailures {
+				return err
+			}
+
+			log.Warnf("loop in over %v failed to dispatch: %v",
+				in.LastHop, err)
+
+			m.retryIn(set, suggestion, stats, in)
+
+			continue
+		}
+
 		log.Infof("loop in automatically dispatched: hash: %v, "+
 			"address: %v", loopIn.SwapHash,
 			loopIn.HtlcAddressNP2WSH)
+
+		stats.dispatched++
+		stats.round.LoopIn[i].Dispatched = true
+		m.recordShrink(swap.TypeIn, loopIn.SwapHash, set.inNotes[i])
+	}
+
+	for i, rebalance := range suggestion.Rebalances {
+		// If we don't actually have dispatch of swaps enabled, log
+		// suggestions.
+		if !dispatch {
+			log.Debugf("recommended rebalance: %v sats from %v "+
+				"to %v", rebalance.Amount,
+				rebalance.OutgoingChannel,
+				rebalance.IncomingChannel)
+
+			continue
+		}
+
+		if err := spacing(ctx); err != nil {
+			return err
+		}
+
+		rebalance := rebalance
+		m.dispatchRebalance(ctx, &rebalance)
+
+		stats.dispatched++
+		stats.round.Rebalances[i].Dispatched = true
 	}
 
 	return nil
 }
 
+// newDispatchSpacing returns a function that waits for our dispatch spacing
+// on every call other than the first, so that the swaps dispatched in a single
+// tick are spread out. The wait returns early with an error if the context
+// provided is canceled, so that spacing does not delay shutdown.
+func (m *Manager) newDispatchSpacing() func(context.Context) error {
+	var (
+		spacing    = m.params.DispatchSpacing
+		dispatched bool
+	)
+
+	return func(ctx context.Context) error {
+		if !dispatched || spacing == 0 {
+			dispatched = true
+			return nil
+		}
+
+		select {
+		case <-m.cfg.Clock.TickAfter(spacing):
+			return nil
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// dispatchAllowed returns true if autoloop is enabled, we are within one of
+// our schedule windows and the current on-chain fee estimate does not exceed
+// our chain fee ceiling. Dispatch is deferred rather than disabled, so we
+// resume dispatching swaps on a later tick once these conditions are met.
+func (m *Manager) dispatchAllowed(ctx context.Context) (bool, error) {
+	if !m.params.Autoloop && !m.params.SimulationMode {
+		return false, nil
+	}
+
+	now := m.cfg.Clock.Now()
+	if !inSchedule(m.params.AutoloopSchedule, now) {
+		log.Debugf("outside of autoloop schedule: %v, not dispatching "+
+			"swaps", m.params.AutoloopSchedule)
+
+		return false, nil
+	}
+
+	if m.params.ChainFeeCeiling == 0 {
+		return true, nil
+	}
+
+	estimate, err := m.cfg.Wallet.EstimateFee(
+		ctx, m.params.HtlcConfTarget,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if estimate > m.params.ChainFeeCeiling {
+		log.Infof("fee estimate: %v sat/vbyte for %v blocks exceeds "+
+			"chain fee ceiling: %v sat/vbyte, deferring swap "+
+			"dispatch", satPerKwToSatPerVByte(estimate),
+			m.params.HtlcConfTarget,
+			satPerKwToSatPerVByte(m.params.ChainFeeCeiling))
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
 // ForceAutoLoop force-ticks our auto-out ticker.
 func (m *Manager) ForceAutoLoop(ctx context.Context) error {
 	select {
@@ -597,6 +1419,10 @@ type Suggestions struct {
 	// InSwaps is the set of loop in swaps that we suggest executing.
 	InSwaps []loop.LoopInRequest
 
+	// Rebalances is the set of circular rebalances that we suggest
+	// executing instead of loop out swaps.
+	Rebalances []loop.RebalanceRequest
+
 	// DisqualifiedChans maps the set of channels that we do not recommend
 	// swaps on to the reason that we did not recommend a swap.
 	DisqualifiedChans map[lnwire.ShortChannelID]Reason
@@ -604,6 +1430,31 @@ type Suggestions struct {
 	// Disqualified peers maps the set of peers that we do not recommend
 	// swaps for to the reason that they were excluded.
 	DisqualifiedPeers map[route.Vertex]Reason
+
+	// DisqualifiedGroups maps the set of channel groups that we do not
+	// recommend swaps for to the reason that they were excluded. It is
+	// only allocated once a group is disqualified.
+	DisqualifiedGroups map[string]Reason
+
+	// ServerNotices is the set of operational notices published by the
+	// server that are currently in effect.
+	ServerNotices []*loop.ServerNotice
+
+	// Scores holds the priority scores of our suggestions, in the order
+	// that we considered them, if our fee budget could not cover all of
+	// them. It is nil if we did not need to prioritize our suggestions.
+	Scores []*SwapScore
+
+	// backups is the set of swaps that we did not suggest because of our
+	// in flight limit, in the order that we rank them. If a suggested
+	// swap fails to dispatch, autoloop may dispatch one of these swaps
+	// instead. It is only populated if RetryDispatchFailures is set.
+	backups []swapSuggestion
+
+	// outDests maps the index of each of our loop out swaps that sweeps
+	// to a rule's sweep destination to that destination. It is only
+	// allocated once such a swap is added.
+	outDests map[int]*SweepDestination
 }
 
 func newSuggestions() *Suggestions {
@@ -613,9 +1464,60 @@ func newSuggestions() *Suggestions {
 	}
 }
 
+// disqualifyGroup records the reason that we did not recommend a swap for a
+// channel group.
+func (s *Suggestions) disqualifyGroup(name string, reason Reason) {
+	if s.DisqualifiedGroups == nil {
+		s.DisqualifiedGroups = make(map[string]Reason)
+	}
+
+	s.DisqualifiedGroups[name] = reason
+}
+
+// disqualifyClosed records the channel and peer rules that we cannot suggest
+// swaps for because none of their channels are in the map of open channels to
+// peers provided. Channels that have already been disqualified, for example
+// because they are excluded, keep their existing reason.
+func (p Parameters) disqualifyClosed(resp *Suggestions,
+	channelPeers map[uint64]route.Vertex) {
+
+	peers := make(map[route.Vertex]bool, len(channelPeers))
+	for _, peer := range channelPeers {
+		peers[peer] = true
+	}
+
+	for peer := range p.PeerRules {
+		if peers[peer] {
+			continue
+		}
+
+		resp.DisqualifiedPeers[peer] = ReasonNoChannels
+	}
+
+	for channel := range p.ChannelRules {
+		if _, ok := channelPeers[channel.ToUint64()]; ok {
+			continue
+		}
+
+		if _, ok := resp.DisqualifiedChans[channel]; ok {
+			continue
+		}
+
+		resp.DisqualifiedChans[channel] = ReasonNoChannels
+	}
+}
+
 func (s *Suggestions) addSwap(swap swapSuggestion) error {
 	switch t := swap.(type) {
 	case *loopOutSwapSuggestion:
+		if t.sweepDest != nil {
+			if s.outDests == nil {
+				s.outDests = make(map[int]*SweepDestination)
+			}
+
+			s.outDests[len(s.OutSwaps)] = t.sweepDest
+		}
+
 		s.OutSwaps = append(s.OutSwaps, t.OutRequest)
 
 	case *loopInSwapSuggestion:
@@ -642,6 +1544,10 @@ func (m *Manager) singleReasonSuggestion(reason Reason) *Suggestions {
 		resp.DisqualifiedPeers[peer] = reason
 	}
 
+	for name := range m.params.ChannelGroups {
+		resp.disqualifyGroup(name, reason)
+	}
+
 	return resp
 }
 
@@ -654,6 +1560,14 @@ func (m *Manager) singleReasonSuggestion(reason Reason) *Suggestions {
 func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 	*Suggestions, error) {
 
+	return m.suggestSwaps(ctx, autoloop, &tickStats{})
+}
+
+// suggestSwaps returns a set of swap suggestions, tracking the work done to
+// produce them in the stats provided.
+func (m *Manager) suggestSwaps(ctx context.Context, autoloop bool,
+	stats *tickStats) (*Suggestions, error) {
+
 	m.paramsLock.Lock()
 	defer m.paramsLock.Unlock()
 
@@ -663,8 +1577,35 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 		return nil, ErrNoRules
 	}
 
-	// If our start date is in the future, we interpret this as meaning that
-	// we should start using our budget at this date. This means that we
+	// If the server has published a notice that it is in maintenance, we
+	// do not suggest any swaps, and we attach the server's active notices
+	// to our suggestions so that our decisions can be explained.
+	notices := m.activeNotices(ctx)
+	if maintenance := maintenanceNotice(notices); maintenance != nil {
+		log.Debugf("server in maintenance: %v", maintenance.Message)
+
+		resp := m.singleReasonSuggestion(ReasonServerMaintenance)
+		resp.ServerNotices = notices
+
+		return resp, nil
+	}
+
+	resp, err := m.suggestEligibleSwaps(ctx, autoloop, stats)
+	if err != nil {
+		return nil, err
+	}
+	resp.ServerNotices = notices
+
+	return resp, nil
+}
+
+// suggestEligibleSwaps returns a set of swap suggestions for our current
+// rules. It must be called with our params lock held.
+func (m *Manager) suggestEligibleSwaps(ctx context.Context, autoloop bool,
+	stats *tickStats) (*Suggestions, error) {
+
+	// If our start date is in the future, we interpret this as meaning that
+	// we should start using our budget at this date. This means that we
 	// have no budget for the present, so we just return.
 	if m.params.AutoFeeStartDate.After(m.cfg.Clock.Now()) {
 		log.Debugf("autoloop fee budget start time: %v is in "+
@@ -687,16 +1628,22 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 	// List our current set of swaps so that we can determine which channels
 	// are already being utilized by swaps. Note that these calls may race
 	// with manual initiation of swaps.
-	loopOut, err := m.cfg.ListLoopOut()
+	loopOut, err := m.cfg.Swaps.ListLoopOut()
 	if err != nil {
 		return nil, err
 	}
 
-	loopIn, err := m.cfg.ListLoopIn()
+	loopIn, err := m.cfg.Swaps.ListLoopIn()
 	if err != nil {
 		return nil, err
 	}
 
+	// Move our budget period forward if it has elapsed, before we check
+	// our budget.
+	if err := m.refreshBudget(ctx, loopOut, loopIn); err != nil {
+		return nil, err
+	}
+
 	// Get a summary of our existing swaps so that we can check our autoloop
 	// budget.
 	summary, err := m.checkExistingAutoLoops(ctx, loopOut, loopIn)
@@ -704,110 +1651,324 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 		return nil, err
 	}
 
-	if summary.totalFees() >= m.params.AutoFeeBudget {
+	totalBudget := m.params.totalBudget(summary.swappedVolume)
+	if summary.totalFees() >= totalBudget {
 		log.Debugf("autoloop fee budget: %v exhausted, %v spent on "+
 			"completed swaps, %v reserved for ongoing swaps "+
 			"(upper limit)",
-			m.params.AutoFeeBudget, summary.spentFees,
+			totalBudget, summary.spentFees,
 			summary.pendingFees)
 
 		return m.singleReasonSuggestion(ReasonBudgetElapsed), nil
 	}
 
+	allChannels, err := m.cfg.Channels.ListChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stats.channels = len(allChannels)
+
+	// Move the rules of channels that have been spliced over to the
+	// channels that replaced them before we look at our balances, so
+	// that their thresholds are evaluated against their new capacity.
+	if err := m.migrateSplices(ctx, allChannels); err != nil {
+		return nil, err
+	}
+
 	// If we have already reached our total allowed number of in flight
 	// swaps, we do not suggest any more at the moment.
-	allowedSwaps := m.params.MaxAutoInFlight - summary.inFlightCount
+	inFlightLimit := m.params.inFlightLimit(allChannels)
+	allowedSwaps := inFlightLimit - summary.inFlightCount
 	if allowedSwaps <= 0 {
 		log.Debugf("%v autoloops allowed, %v in flight",
-			m.params.MaxAutoInFlight, summary.inFlightCount)
+			inFlightLimit, summary.inFlightCount)
 
 		return m.singleReasonSuggestion(ReasonInFlight), nil
 	}
 
-	channels, err := m.cfg.Lnd.Client.ListChannels(ctx, false, false)
+	// If we have an on-chain vbytes budget, we check how much of it the
+	// swaps initiated in the current period have used. Note that we
+	// include manually dispatched swaps, because our budget applies to
+	// all loop transactions.
+	var availableVBytes uint64
+	if m.params.VBytesBudget != 0 {
+		start := m.cfg.Clock.Now().Add(-m.params.VBytesBudgetPeriod)
+		used := vbytesUsed(start, loopOut, loopIn)
+
+		if used >= m.params.VBytesBudget {
+			log.Debugf("vbytes budget: %v per %v exhausted, %v "+
+				"used", m.params.VBytesBudget,
+				m.params.VBytesBudgetPeriod, used)
+
+			return m.singleReasonSuggestion(ReasonVBytesBudget), nil
+		}
+
+		availableVBytes = m.params.VBytesBudget - used
+	}
+
+	// Remove channels that are managed externally before we look at our
+	// balances, so that they are never used for swaps, even if their peer
+	// or group has a rule.
+	channels, excluded := m.params.filterExcluded(allChannels)
+
+	// Remove channels that are younger than our minimum channel age, so
+	// that we do not act on them until their natural flow is known.
+	height, err := m.currentHeight(ctx)
 	if err != nil {
 		return nil, err
 	}
+	channels, young := m.params.filterYoung(channels, height)
 
 	// Collect a map of channel IDs to peer pubkeys, and a set of per-peer
 	// balances which we will use for peer-level liquidity rules.
 	channelPeers := make(map[uint64]route.Vertex)
-	peerChannels := make(map[route.Vertex]*balances)
 	for _, channel := range channels {
 		channelPeers[channel.ChannelID] = channel.PubKeyBytes
-
-		bal, ok := peerChannels[channel.PubKeyBytes]
-		if !ok {
-			bal = &balances{}
-		}
-
-		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
-		bal.channels = append(bal.channels, chanID)
-		bal.capacity += channel.Capacity
-		bal.incoming += channel.RemoteBalance
-		bal.outgoing += channel.LocalBalance
-		bal.pubkey = channel.PubKeyBytes
-
-		peerChannels[channel.PubKeyBytes] = bal
 	}
+	peerChannels := peerBalances(channels)
+
+	// Channels that have a rule of their own and whose peer also has a
+	// rule are only managed by one of the rules, so we remove the
+	// channels that are not managed by their peer's rule from the
+	// balances that we use for peer rules.
+	conflicts := m.params.ruleConflicts(channels)
+	rulePeers := peerBalances(conflicts.peerChannels(channels))
 
 	// Get a summary of the channels and peers that are not eligible due
 	// to ongoing swaps.
 	traffic := m.currentSwapTraffic(loopOut, loopIn)
 
+	// If we limit the number of automated swaps that we dispatch in our
+	// swap rate period and have already reached the limit, we do not
+	// suggest any more at the moment.
+	remainingSwaps, rateLimited := m.params.remainingSwaps(
+		traffic.recentAutoloops,
+	)
+	if rateLimited && remainingSwaps <= 0 {
+		log.Debugf("%v autoloops allowed per %v, %v dispatched",
+			m.params.MaxSwapsPerPeriod, m.params.SwapRatePeriod,
+			traffic.recentAutoloops.total)
+
+		return m.singleReasonSuggestion(ReasonSwapRateLimit), nil
+	}
+
 	var (
 		suggestions []swapSuggestion
 		resp        = newSuggestions()
 	)
 
-	for peer, balances := range peerChannels {
+	for _, channel := range excluded {
+		resp.DisqualifiedChans[channel] = ReasonExcluded
+	}
+
+	// Report any rules that we cannot suggest swaps for because none of
+	// their channels are open, so that they are not silently skipped.
+	m.params.disqualifyClosed(resp, channelPeers)
+	m.params.disqualifyYoung(resp, young, peerChannels)
+
+	// Get the remaining fee budgets of any of our rules that have their
+	// own budget.
+	budgets := newRuleBudgets(m.params, summary.autoloops, channelPeers)
+
+	// Get the set of peers that we are connected to, because swaps that
+	// route through offline peers predictably fail off-chain.
+	connected, err := m.connectedPeers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the rules that we need suggestions for, so that we can get
+	// their quotes concurrently. We skip rules that have expired or have
+	// already used their fee budget, because we will not be able to
+	// dispatch swaps for them.
+	var (
+		jobs []*suggestionJob
+		now  = m.cfg.Clock.Now()
+	)
+
+	// Easy autoloop derives its swaps from our node's total balance, and
+	// is never set together with rules.
+	// Loop outs that are in flight but have not been paid yet still hold
+	// their amount in our local balance, so it is subtracted from the
+	// balance that we loop out.
+	if m.params.EasyAutoloop {
+		unpaid := traffic.unpaidLoopOut(channels)
+
+		jobs, err = m.easyAutoloopJobs(
+			ctx, peerChannels, connected, traffic, unpaid,
+			outRestrictions, resp, stats,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for peer, balances := range rulePeers {
 		rule, haveRule := m.params.PeerRules[peer]
 		if !haveRule {
 			continue
 		}
 
-		suggestion, err := m.suggestSwap(
-			ctx, traffic, balances, rule, outRestrictions,
-			inRestrictions, autoloop,
-		)
-		var reasonErr *reasonError
-		if errors.As(err, &reasonErr) {
-			resp.DisqualifiedPeers[peer] = reasonErr.reason
+		if rule.expired(now) {
+			resp.DisqualifiedPeers[peer] = ReasonRuleExpired
 			continue
 		}
 
-		if err != nil {
-			return nil, err
+		if budgets.exhausted(nil, []route.Vertex{peer}) {
+			resp.DisqualifiedPeers[peer] = ReasonRuleBudget
+			continue
+		}
+
+		if connected.offline(peer) {
+			resp.DisqualifiedPeers[peer] = ReasonPeerOffline
+			continue
 		}
 
-		suggestions = append(suggestions, suggestion)
+		jobs = append(jobs, &suggestionJob{
+			peer:    peer,
+			balance: balances,
+			rule:    rule,
+		})
 	}
 
 	for _, channel := range channels {
-		balance := newBalances(channel)
-
 		channelID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
 		rule, ok := m.params.ChannelRules[channelID]
-		if !ok {
+		if !ok || conflicts.peerRule[channelID] {
 			continue
 		}
 
-		suggestion, err := m.suggestSwap(
-			ctx, traffic, balance, rule, outRestrictions,
-			inRestrictions, autoloop,
-		)
+		if conflicts.unresolved[channelID] {
+			resp.DisqualifiedChans[channelID] = ReasonRuleConflict
+			continue
+		}
+
+		if rule.expired(now) {
+			resp.DisqualifiedChans[channelID] = ReasonRuleExpired
+			continue
+		}
+
+		channels := []lnwire.ShortChannelID{channelID}
+		if budgets.exhausted(channels, nil) {
+			resp.DisqualifiedChans[channelID] = ReasonRuleBudget
+			continue
+		}
+
+		if connected.offline(channel.PubKeyBytes) {
+			resp.DisqualifiedChans[channelID] = ReasonPeerOffline
+			continue
+		}
+
+		jobs = append(jobs, &suggestionJob{
+			channel: channelID,
+			balance: newBalances(channel),
+			rule:    rule,
+		})
+	}
+
+	// Channel groups are treated like peers, with the group's rule
+	// applied to the total balance of all of its open channels.
+	for name, group := range m.params.ChannelGroups {
+		balance := groupBalances(group, channels)
+		if balance == nil {
+			reason := ReasonNoChannels
+			if groupBalances(group, young) != nil {
+				reason = ReasonChannelAge
+			}
+
+			resp.disqualifyGroup(name, reason)
+			continue
+		}
+
+		if group.Rule.expired(now) {
+			resp.disqualifyGroup(name, ReasonRuleExpired)
+			continue
+		}
+
+		if budgets.exhausted(balance.channels, nil) {
+			resp.disqualifyGroup(name, ReasonRuleBudget)
+			continue
+		}
+
+		// We only skip a group if all of its peers are offline,
+		// because a loop out may use any of the group's channels.
+		if connected.offline(groupPeers(balance, channelPeers)...) {
+			resp.disqualifyGroup(name, ReasonPeerOffline)
+			continue
+		}
+
+		jobs = append(jobs, &suggestionJob{
+			group:   name,
+			balance: balance,
+			rule:    group.Rule,
+		})
+	}
+
+	// If we forecast the flow of our channels, or rules require their
+	// channels to earn routing revenue, we lookup the forwarding history
+	// of each job's channels.
+	if err := m.addForwardingHistory(ctx, jobs); err != nil {
+		return nil, err
+	}
+
+	results := m.suggestSwapsConcurrently(
+		ctx, traffic, jobs, outRestrictions, inRestrictions, autoloop,
+		stats,
+	)
+
+	// Track the suggestions whose rules allow them to be combined with
+	// other loop outs, in case we batch our loop outs.
+	batchable := make(map[swapSuggestion]bool)
+
+	for i, result := range results {
+		job := jobs[i]
 
 		var reasonErr *reasonError
-		if errors.As(err, &reasonErr) {
-			resp.DisqualifiedChans[channelID] = reasonErr.reason
+		if errors.As(result.err, &reasonErr) {
+			switch {
+			case job.isChannel():
+				resp.DisqualifiedChans[job.channel] =
+					reasonErr.reason
+
+			case job.isGroup():
+				resp.disqualifyGroup(
+					job.group, reasonErr.reason,
+				)
+
+			default:
+				resp.DisqualifiedPeers[job.peer] =
+					reasonErr.reason
+			}
+
 			continue
 		}
 
+		if result.err != nil {
+			return nil, result.err
+		}
+
+		suggestions = append(suggestions, result.suggestion)
+		batchable[result.suggestion] = job.rule.batchable()
+	}
+
+	// If we can execute circular rebalances, we replace the loop out
+	// suggestions that a rebalance is cheaper for.
+	suggestions, resp.Rebalances, err = m.replaceWithRebalances(
+		ctx, channels, suggestions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// If we batch our loop outs, we combine the loop outs that are left
+	// into as few swaps as the server's maximum swap amount allows.
+	if m.params.BatchLoopOuts {
+		suggestions, err = m.batchLoopOuts(
+			ctx, suggestions, batchable, outRestrictions, stats,
+		)
 		if err != nil {
 			return nil, err
 		}
-
-		suggestions = append(suggestions, suggestion)
 	}
 
 	// If we have no swaps to execute after we have applied all of our
@@ -821,16 +1982,34 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 		return suggestions[i].amount() > suggestions[j].amount()
 	})
 
+	// If we spread our loop ins across last hops, we reorder them
+	// according to our strategy before we prioritize them.
+	spreadLoopIns(
+		m.params.LoopInSpread, suggestions, traffic, peerChannels,
+	)
+
 	// Run through our suggested swaps in descending order of amount and
 	// return all of the swaps which will fit within our remaining budget.
-	available := m.params.AutoFeeBudget - summary.totalFees()
+	available := totalBudget - summary.totalFees()
+
+	// If our remaining budget cannot cover all of our suggestions, we
+	// prioritize them by score rather than amount, so that the swaps that
+	// we can afford are the most valuable ones.
+	if totalFees(suggestions) > available {
+		suggestions, resp.Scores = scoreSuggestions(
+			suggestions, channels, peerChannels, channelPeers,
+		)
+	}
+
+	// Swap types may also have their own budgets and in flight limits.
+	limits := m.params.newTypeLimits(summary)
 
 	// setReason is a helper that adds a swap's channels to our disqualified
 	// list with the reason provided.
 	setReason := func(reason Reason, swap swapSuggestion) {
 		for _, peer := range swap.peers(channelPeers) {
 			_, ok := m.params.PeerRules[peer]
-			if !ok {
+			if !ok && !m.params.EasyAutoloop {
 				continue
 			}
 
@@ -838,6 +2017,10 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 		}
 
 		for _, channel := range swap.channels() {
+			if name, ok := m.params.channelGroup(channel); ok {
+				resp.disqualifyGroup(name, reason)
+			}
+
 			_, ok := m.params.ChannelRules[channel]
 			if !ok {
 				continue
@@ -847,7 +2030,7 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 		}
 	}
 
-	for _, swap := range suggestions {
+	for i, swap := range suggestions {
 		swap := swap
 
 		// If we do not have enough funds available, or we hit our
@@ -860,24 +2043,80 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 
 		case len(resp.OutSwaps) == allowedSwaps:
 			reason = ReasonInFlight
+
+		case rateLimited &&
+			len(resp.OutSwaps)+len(resp.InSwaps) == remainingSwaps:
+
+			reason = ReasonSwapRateLimit
+
+		case !limits.inFlightAllowed(swap.swapType()):
+			reason = ReasonInFlight
 		}
 
 		if reason != ReasonNone {
 			setReason(reason, swap)
+
+			// Swaps that only miss out because of our in flight
+			// limit may replace swaps that fail to dispatch.
+			if reason == ReasonInFlight &&
+				m.params.RetryDispatchFailures {
+
+				resp.addBackup(swap, budgets, channelPeers)
+			}
+
 			continue
 		}
 
+		// If we have a vbytes budget and this swap would exceed it, we
+		// skip it, since a smaller swap type may still fit.
+		if m.params.VBytesBudget != 0 {
+			if swap.vbytes() > availableVBytes {
+				setReason(ReasonVBytesBudget, swap)
+				continue
+			}
+		}
+
 		fees := swap.fees()
 
+		// If the rules that the swap is suggested for have their own
+		// fee budgets, the swap's fees must fit within each of them.
+		var (
+			swapChans = swap.channels()
+			swapPeers = swap.peers(channelPeers)
+		)
+		ruleAvailable, limited := budgets.available(swapChans, swapPeers)
+		if limited && fees > ruleAvailable {
+			setReason(ReasonRuleBudget, swap)
+			continue
+		}
+
+		// If the swap's type has its own budget, its fees must also
+		// fit within it.
+		typeAvailable, limited := limits.budget(swap.swapType())
+		if limited && fees > typeAvailable {
+			setReason(ReasonBudgetInsufficient, swap)
+			continue
+		}
+
 		// If the maximum fee we expect our swap to use is less than the
 		// amount we have available, we add it to our set of swaps that
 		// fall within the budget and decrement our available amount.
 		if fees <= available {
 			available -= fees
+			budgets.spend(swapChans, swapPeers, fees)
+			limits.add(swap.swapType(), fees)
+
+			if m.params.VBytesBudget != 0 {
+				availableVBytes -= swap.vbytes()
+			}
 
 			if err := resp.addSwap(swap); err != nil {
 				return nil, err
 			}
+
+			if resp.Scores != nil {
+				resp.Scores[i].Selected = true
+			}
 		} else {
 			setReason(ReasonBudgetInsufficient, swap)
 		}
@@ -889,8 +2128,10 @@ func (m *Manager) SuggestSwaps(ctx context.Context, autoloop bool) (
 // suggestSwap checks whether we can currently perform a swap, and creates a
 // swap request for the rule provided.
 func (m *Manager) suggestSwap(ctx context.Context, traffic *swapTraffic,
-	balance *balances, rule *SwapRule, outRestrictions *Restrictions,
-	inRestrictions *Restrictions, autoloop bool) (swapSuggestion, error) {
+	balance *balances, rule *SwapRule, amount btcutil.Amount,
+	outRestrictions *Restrictions,
+	inRestrictions *Restrictions, autoloop bool, stats *tickStats) (
+	swapSuggestion, error) {
 
 	var (
 		builder      swapBuilder
@@ -912,14 +2153,33 @@ func (m *Manager) suggestSwap(ctx context.Context, traffic *swapTraffic,
 		return nil, fmt.Errorf("unsupported swap type: %v", rule.Type)
 	}
 
+	// If the rule has its own fee limit, it overrides our global fee
+	// limit for all of the checks we perform for this swap. The same
+	// applies to its publication deadline.
+	params := m.params
+	if rule.FeeLimit != nil {
+		params.FeeLimit = rule.FeeLimit
+	}
+
+	if rule.PublicationDeadline != 0 {
+		params.PublicationDeadline = rule.PublicationDeadline
+	}
+
 	// Before we get any swap suggestions, we check what the current fee
 	// estimate is to sweep within our target number of confirmations. If
 	// This fee exceeds the fee limit we have set, we will not suggest any
 	// swaps at present.
-	if err := builder.maySwap(ctx, m.params); err != nil {
+	if err := builder.maySwap(ctx, params); err != nil {
 		return nil, err
 	}
 
+	// Loop ins to peers that we only have private channels with can only
+	// be routed with route hints, so we only suggest them if we are
+	// allowed to reveal our private channels in our swap invoices.
+	if rule.Type == swap.TypeIn && balance.private && !params.LoopInPrivate {
+		return nil, newReasonError(ReasonPrivateChannel)
+	}
+
 	// First, check whether this peer/channel combination is already in use
 	// for our swap.
 	err := builder.inUse(traffic, balance.pubkey, balance.channels)
@@ -927,17 +2187,120 @@ func (m *Manager) suggestSwap(ctx context.Context, traffic *swapTraffic,
 		return nil, err
 	}
 
+	// We also check whether a swap in the opposite direction has recently
+	// completed for this peer/channel combination, so that we do not
+	// undo it while our rule's cooldown is in effect.
+	err = traffic.coolingDown(
+		rule, m.cfg.Clock.Now(), balance.pubkey, balance.channels,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We also wait for our success cooldown to pass after any swap that
+	// completed for this peer/channel combination.
+	err = traffic.recentSuccess(
+		m.params.SuccessCooldown, m.cfg.Clock.Now(), balance.pubkey,
+		balance.channels,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We also check that the peer/channel combination has not reached
+	// our limit on the number of automated swaps in our swap rate period.
+	err = m.params.rateLimited(
+		traffic.recentAutoloops, balance.pubkey, balance.channels,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Next, get the amount that we need to swap for this entity, skipping
-	// over it if no change in liquidity is required.
-	amount := rule.swapAmount(balance, restrictions, rule.Type)
+	// over it if no change in liquidity is required or we cannot swap the
+	// amount required. Jobs that have their amount set already, such as
+	// easy autoloop's, skip this step.
 	if amount == 0 {
-		return nil, newReasonError(ReasonLiquidityOk)
+		// If the rule caps the size of its swaps, we lower our maximum
+		// so that we never suggest more than the cap.
+		restrictions, err = rule.capRestrictions(restrictions)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err = rule.swapAmount(balance, restrictions, rule.Type)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Payments that exceed the htlc slots or in-flight limits of our
+	// channels will fail, so we lower our amount to what they can carry.
+	amount, err = m.capHtlcLimits(
+		ctx, balance, rule.Type, amount, restrictions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Before we use the amount, we round or randomize it if our
+	// parameters ask us to, so that it does not fingerprint our balances.
+	amount, err = m.obfuscateAmount(amount, restrictions)
+	if err != nil {
+		return nil, err
+	}
+
+	// If our previous loop ins to this peer show that it is too expensive
+	// to reach within our fee limit, we do not need to request a quote.
+	if rule.Type == swap.TypeIn {
+		err := traffic.checkLoopInFees(
+			params.FeeLimit, balance.pubkey, amount,
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return builder.buildSwap(
+	// Building our swap requires a quote from the server.
+	stats.addQuote()
+
+	suggestion, err := builder.buildSwap(
 		ctx, balance.pubkey, balance.channels, amount, autoloop,
-		m.params,
+		params,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// If we have seen higher fees for loop ins to this peer than it was
+	// quoted for, we leave room for them in our swap's fee limits.
+	if in, ok := suggestion.(*loopInSwapSuggestion); ok {
+		traffic.adjustLoopInFees(params.FeeLimit, in)
+	}
+
+	// If the rule requires its channels to earn back the cost of their
+	// swaps, we check their routing revenue against our swap's fees now
+	// that we have a quote for it.
+	if err := rule.checkRevenue(balance, suggestion.fees()); err != nil {
+		return nil, err
+	}
+
+	// If the rule has custom records, we attach them to the payments of
+	// our loop out.
+	out, ok := suggestion.(*loopOutSwapSuggestion)
+	if ok {
+		out.CustomRecords = loop.CopyCustomRecords(rule.CustomRecords)
+	}
+
+	// If the rule has a sweep destination, our automatically dispatched
+	// loop outs sweep to it rather than to the wallet address that we
+	// set when we built the swap. The address is only derived once the
+	// swap is dispatched.
+	if ok && autoloop {
+		out.sweepDest = rule.SweepDestination
+	}
+
+	return suggestion, nil
 }
 
 // getSwapRestrictions queries the server for its latest swap size restrictions,
@@ -947,7 +2310,7 @@ func (m *Manager) suggestSwap(ctx context.Context, traffic *swapTraffic,
 func (m *Manager) getSwapRestrictions(ctx context.Context, swapType swap.Type) (
 	*Restrictions, error) {
 
-	restrictions, err := m.cfg.Restrictions(ctx, swapType)
+	restrictions, err := m.cfg.Quotes.Restrictions(ctx, swapType)
 	if err != nil {
 		return nil, err
 	}
@@ -1011,6 +2374,25 @@ type existingAutoLoopSummary struct {
 	// it can only lead to dispatching fewer swaps than we could have (not
 	// too many).
 	inFlightCount int
+
+	// outFees and inFees are the fees that our loop out and loop in
+	// autoloops count towards our budget, pending and spent.
+	outFees btcutil.Amount
+	inFees  btcutil.Amount
+
+	// outInFlight and inInFlight are the number of loop out and loop in
+	// autoloops that are currently in flight.
+	outInFlight int
+	inInFlight  int
+
+	// swappedVolume is the total amount of our in flight automated swaps
+	// and of the automated swaps that succeeded in our current budget
+	// period.
+	swappedVolume btcutil.Amount
+
+	// autoloops contains the fees that each automated swap counts towards
+	// our budget, so that they can be attributed to our rules' budgets.
+	autoloops []autoloopFees
 }
 
 // totalFees returns the total amount of fees that automatically dispatched
@@ -1019,6 +2401,18 @@ func (e *existingAutoLoopSummary) totalFees() btcutil.Amount {
 	return e.spentFees + e.pendingFees
 }
 
+// typeTotals returns the fees that our autoloops of the swap type provided
+// count towards our budget, and the number of them that are in flight.
+func (e *existingAutoLoopSummary) typeTotals(swapType swap.Type) (
+	btcutil.Amount, int) {
+
+	if swapType == swap.TypeIn {
+		return e.inFees, e.inInFlight
+	}
+
+	return e.outFees, e.outInFlight
+}
+
 // checkExistingAutoLoops calculates the total amount that has been spent by
 // automatically dispatched swaps that have completed, and the worst-case fee
 // total for our set of ongoing, automatically dispatched swaps as well as a
@@ -1042,26 +2436,51 @@ func (m *Manager) checkExistingAutoLoops(ctx context.Context,
 		// it has succeeded or failed so we just record our actual fees
 		// for the swap provided that the swap completed after our
 		// budget start date.
+		var fees btcutil.Amount
 		if out.State().State.Type() == loopdb.StateTypePending {
 			summary.inFlightCount++
+			summary.outInFlight++
 
-			prepay, err := m.cfg.Lnd.Client.DecodePaymentRequest(
+			prepay, err := m.cfg.Node.DecodePaymentRequest(
 				ctx, out.Contract.PrepayInvoice,
 			)
 			if err != nil {
 				return nil, err
 			}
 
-			summary.pendingFees += worstCaseOutFees(
+			fees = worstCaseOutFees(
 				out.Contract.MaxPrepayRoutingFee,
 				out.Contract.MaxSwapRoutingFee,
 				out.Contract.MaxSwapFee,
 				out.Contract.MaxMinerFee,
 				mSatToSatoshis(prepay.Value),
 			)
+			summary.pendingFees += fees
+			summary.swappedVolume += out.Contract.AmountRequested
 		} else if !out.LastUpdateTime().Before(m.params.AutoFeeStartDate) {
-			summary.spentFees += out.State().Cost.Total()
+			fees = out.State().Cost.Total()
+			summary.spentFees += fees
+
+			if out.State().State.Type() == loopdb.StateTypeSuccess {
+				summary.swappedVolume +=
+					out.Contract.AmountRequested
+			}
+		} else {
+			continue
 		}
+
+		summary.outFees += fees
+
+		chanSet := out.Contract.OutgoingChanSet
+		channels := make([]lnwire.ShortChannelID, len(chanSet))
+		for i, id := range chanSet {
+			channels[i] = lnwire.NewShortChanIDFromInt(id)
+		}
+
+		summary.autoloops = append(summary.autoloops, autoloopFees{
+			channels: channels,
+			fees:     fees,
+		})
 	}
 
 	for _, in := range loopIns {
@@ -1075,20 +2494,75 @@ func (m *Manager) checkExistingAutoLoops(ctx context.Context,
 		// If an autoloop is in a pending state, we always count it in
 		// our current budget, and record the worst-case fees for it,
 		// because we do not know how it will resolve.
-		if pending {
+		var fees btcutil.Amount
+		switch {
+		case pending:
 			summary.inFlightCount++
-			summary.pendingFees += worstCaseInFees(
+			summary.inInFlight++
+			fees = worstCaseInFees(
 				in.Contract.MaxMinerFee, in.Contract.MaxSwapFee,
 				defaultLoopInSweepFee,
 			)
-		} else if inBudget {
-			summary.spentFees += in.State().Cost.Total()
+			summary.pendingFees += fees
+			summary.swappedVolume += in.Contract.AmountRequested
+
+		case inBudget:
+			fees = in.State().Cost.Total()
+			summary.spentFees += fees
+
+			if in.State().State.Type() == loopdb.StateTypeSuccess {
+				summary.swappedVolume +=
+					in.Contract.AmountRequested
+			}
+
+		default:
+			continue
 		}
+
+		summary.inFees += fees
+
+		summary.autoloops = append(summary.autoloops, autoloopFees{
+			peer: in.Contract.LastHop,
+			fees: fees,
+		})
 	}
 
 	return &summary, nil
 }
 
+// BudgetStatus returns the amount of fees that automatically dispatched swaps
+// have spent on completed swaps in our current budget period, along with our
+// total autoloop fee budget, including any budget rolled over from our
+// previous period.
+func (m *Manager) BudgetStatus(ctx context.Context) (btcutil.Amount,
+	btcutil.Amount, error) {
+
+	loopOut, err := m.cfg.Swaps.ListLoopOut()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	loopIn, err := m.cfg.Swaps.ListLoopIn()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	if err := m.refreshBudget(ctx, loopOut, loopIn); err != nil {
+		return 0, 0, err
+	}
+
+	summary, err := m.checkExistingAutoLoops(ctx, loopOut, loopIn)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return summary.spentFees, m.params.totalBudget(summary.swappedVolume),
+		nil
+}
+
 // currentSwapTraffic examines our existing swaps and returns a summary of the
 // current activity which can be used to determine whether we should perform
 // any swaps.
@@ -1102,6 +2576,21 @@ func (m *Manager) currentSwapTraffic(loopOut []*loopdb.LoopOut,
 	// failed since this point will not be considered.
 	failureCutoff := m.cfg.Clock.Now().Add(m.params.FailureBackOff * -1)
 
+	// Fee history cutoff is the earliest completion time of the loop ins
+	// that we use to estimate the fees of loop ins to each peer.
+	feeHistoryCutoff := m.cfg.Clock.Now().Add(loopInFeeHistory * -1)
+
+	// Spread cutoff is the earliest initiation time of the loop ins that
+	// we use to spread our loop ins across last hops.
+	spreadCutoff := m.cfg.Clock.Now().Add(loopInSpreadHistory * -1)
+
+	// If we back off exponentially, we collect the failures for each
+	// channel and peer instead, because each of them has its own backoff.
+	var (
+		outFailures = make(map[lnwire.ShortChannelID][]failedSwap)
+		inFailures  = make(map[route.Vertex][]failedSwap)
+	)
+
 	for _, out := range loopOut {
 		var (
 			state   = out.State().State
@@ -1122,12 +2611,22 @@ func (m *Manager) currentSwapTraffic(loopOut []*loopdb.LoopOut,
 		if state == loopdb.StateFailOffchainPayments {
 			failedAt := out.LastUpdate().Time
 
-			if failedAt.After(failureCutoff) {
-				for _, id := range chanSet {
-					chanID := lnwire.NewShortChanIDFromInt(
-						id,
+			for _, id := range chanSet {
+				chanID := lnwire.NewShortChanIDFromInt(id)
+
+				// If we back off exponentially, we collect all
+				// of our failures so that we can count the
+				// consecutive failures for each channel.
+				switch {
+				case m.params.ExponentialBackoff:
+					outFailures[chanID] = append(
+						outFailures[chanID], failedSwap{
+							hash:     out.Hash,
+							failedAt: failedAt,
+						},
 					)
 
+				case failedAt.After(failureCutoff):
 					traffic.failedLoopOut[chanID] = failedAt
 				}
 			}
@@ -1139,6 +2638,23 @@ func (m *Manager) currentSwapTraffic(loopOut []*loopdb.LoopOut,
 		// these swaps. This is important, because a temporarily failed
 		// swap could be re-dispatched on restart, affecting our
 		// balances.
+		// Track the most recent successful loop out for each of the
+		// swap's channels, so that our rules can cool down before
+		// suggesting loop ins that would undo it.
+		if state == loopdb.StateSuccess {
+			completedAt := out.LastUpdate().Time
+
+			for _, id := range chanSet {
+				chanID := lnwire.NewShortChanIDFromInt(id)
+
+				last := traffic.completedLoopOut[chanID]
+				if completedAt.After(last) {
+					traffic.completedLoopOut[chanID] =
+						completedAt
+				}
+			}
+		}
+
 		if state.Type() != loopdb.StateTypePending {
 			continue
 		}
@@ -1147,6 +2663,13 @@ func (m *Manager) currentSwapTraffic(loopOut []*loopdb.LoopOut,
 			chanID := lnwire.NewShortChanIDFromInt(id)
 			traffic.ongoingLoopOut[chanID] = true
 		}
+
+		traffic.pendingLoopOut = append(
+			traffic.pendingLoopOut, pendingSwap{
+				hash:   out.Hash,
+				amount: out.Contract.AmountRequested,
+			},
+		)
 	}
 
 	for _, in := range loopIn {
@@ -1156,6 +2679,7 @@ func (m *Manager) currentSwapTraffic(loopOut []*loopdb.LoopOut,
 		}
 
 		pubkey := *in.Contract.LastHop
+		traffic.addLoopIn(pubkey, in, spreadCutoff)
 
 		switch {
 		// Include any pending swaps in our ongoing set of swaps.
@@ -1168,30 +2692,178 @@ func (m *Manager) currentSwapTraffic(loopOut []*loopdb.LoopOut,
 		case in.State().State == loopdb.StateFailTimeout:
 			failedAt := in.LastUpdate().Time
 
-			if failedAt.After(failureCutoff) {
+			switch {
+			case m.params.ExponentialBackoff:
+				inFailures[pubkey] = append(
+					inFailures[pubkey], failedSwap{
+						hash:     in.Hash,
+						failedAt: failedAt,
+					},
+				)
+
+			case failedAt.After(failureCutoff):
 				traffic.failedLoopIn[pubkey] = failedAt
 			}
+
+		// Track the most recent successful loop in for each peer, so
+		// that our rules can cool down before suggesting loop outs
+		// that would undo it.
+		case in.State().State == loopdb.StateSuccess:
+			completedAt := in.LastUpdate().Time
+
+			if completedAt.After(traffic.completedLoopIn[pubkey]) {
+				traffic.completedLoopIn[pubkey] = completedAt
+			}
+
+			// We also track the swap fees of our recent loop ins,
+			// so that we can anticipate the fees of our next loop
+			// in to the peer.
+			if completedAt.After(feeHistoryCutoff) {
+				traffic.addLoopInFees(
+					pubkey, in.Contract.AmountRequested,
+					in.State().Cost.Server,
+				)
+			}
 		}
 	}
 
+	// Once we know when each channel and peer last completed a swap, we
+	// work out whether we are still backing off from their most recent
+	// failures.
+	now := m.cfg.Clock.Now()
+	for chanID, failures := range outFailures {
+		lastFail, backingOff := m.params.backingOff(
+			now, traffic.completedLoopOut[chanID], failures,
+		)
+		if backingOff {
+			traffic.failedLoopOut[chanID] = lastFail
+		}
+	}
+
+	for peer, failures := range inFailures {
+		lastFail, backingOff := m.params.backingOff(
+			now, traffic.completedLoopIn[peer], failures,
+		)
+		if backingOff {
+			traffic.failedLoopIn[peer] = lastFail
+		}
+	}
+
+	// If we limit the number of automated swaps that we dispatch, we count
+	// the automated swaps that were initiated within our swap rate period.
+	if m.params.haveSwapRateLimits() {
+		start := now.Add(-m.params.SwapRatePeriod)
+		traffic.recentAutoloops = countAutoloops(start, loopOut, loopIn)
+	}
+
 	return traffic
 }
 
-// swapTraffic contains a summary of our current and previously failed swaps.
+// swapTraffic contains a summary of our current, previously failed and
+// previously completed swaps.
 type swapTraffic struct {
-	ongoingLoopOut map[lnwire.ShortChannelID]bool
-	ongoingLoopIn  map[route.Vertex]bool
-	failedLoopOut  map[lnwire.ShortChannelID]time.Time
-	failedLoopIn   map[route.Vertex]time.Time
+	ongoingLoopOut   map[lnwire.ShortChannelID]bool
+	ongoingLoopIn    map[route.Vertex]bool
+	failedLoopOut    map[lnwire.ShortChannelID]time.Time
+	failedLoopIn     map[route.Vertex]time.Time
+	completedLoopOut map[lnwire.ShortChannelID]time.Time
+	completedLoopIn  map[route.Vertex]time.Time
+	recentAutoloops  swapCounts
+
+	// pendingLoopOut holds the hashes and amounts of our loop outs that
+	// are in flight, including those that are not restricted to any
+	// channels.
+	pendingLoopOut []pendingSwap
+
+	// loopInFees holds the amounts and realized swap fees of our recent
+	// successful loop ins to each peer.
+	loopInFees map[route.Vertex]*peerFees
+
+	// lastLoopIn holds the most recent initiation time of a loop in
+	// through each last hop.
+	lastLoopIn map[route.Vertex]time.Time
+
+	// loopInVolume holds the amounts of our recent loop ins through each
+	// last hop that have not failed.
+	loopInVolume map[route.Vertex]btcutil.Amount
 }
 
 func newSwapTraffic() *swapTraffic {
 	return &swapTraffic{
-		ongoingLoopOut: make(map[lnwire.ShortChannelID]bool),
-		ongoingLoopIn:  make(map[route.Vertex]bool),
-		failedLoopOut:  make(map[lnwire.ShortChannelID]time.Time),
-		failedLoopIn:   make(map[route.Vertex]time.Time),
+		ongoingLoopOut:   make(map[lnwire.ShortChannelID]bool),
+		ongoingLoopIn:    make(map[route.Vertex]bool),
+		failedLoopOut:    make(map[lnwire.ShortChannelID]time.Time),
+		failedLoopIn:     make(map[route.Vertex]time.Time),
+		completedLoopOut: make(map[lnwire.ShortChannelID]time.Time),
+		completedLoopIn:  make(map[route.Vertex]time.Time),
+		loopInFees:       make(map[route.Vertex]*peerFees),
+		lastLoopIn:       make(map[route.Vertex]time.Time),
+		loopInVolume:     make(map[route.Vertex]btcutil.Amount),
+	}
+}
+
+// coolingDown returns a reason error if a swap in the opposite direction to
+// the rule's swap type has completed for the peer or channels provided within
+// the rule's cooldown. Loop ins are only tracked per-peer, so loop outs for
+// rules that span several peers are only checked against loop outs.
+func (s *swapTraffic) coolingDown(rule *SwapRule, now time.Time,
+	peer route.Vertex, channels []lnwire.ShortChannelID) error {
+
+	if rule.Cooldown == 0 {
+		return nil
+	}
+
+	var lastOpposite time.Time
+	switch rule.Type {
+	case swap.TypeOut:
+		lastOpposite = s.completedLoopIn[peer]
+
+	case swap.TypeIn:
+		for _, chanID := range channels {
+			completed := s.completedLoopOut[chanID]
+			if completed.After(lastOpposite) {
+				lastOpposite = completed
+			}
+		}
 	}
+
+	if lastOpposite.IsZero() || now.Sub(lastOpposite) >= rule.Cooldown {
+		return nil
+	}
+
+	log.Debugf("Peer: %v channels: %v not eligible for %v suggestions, "+
+		"opposite swap completed at: %v within cooldown: %v", peer,
+		channels, rule.Type, lastOpposite, rule.Cooldown)
+
+	return newReasonError(ReasonDirectionCooldown)
+}
+
+// recentSuccess returns a reason error if a swap in either direction has
+// completed for the peer or channels provided within the cooldown provided.
+func (s *swapTraffic) recentSuccess(cooldown time.Duration, now time.Time,
+	peer route.Vertex, channels []lnwire.ShortChannelID) error {
+
+	if cooldown == 0 {
+		return nil
+	}
+
+	lastSuccess := s.completedLoopIn[peer]
+	for _, chanID := range channels {
+		completed := s.completedLoopOut[chanID]
+		if completed.After(lastSuccess) {
+			lastSuccess = completed
+		}
+	}
+
+	if lastSuccess.IsZero() || now.Sub(lastSuccess) >= cooldown {
+		return nil
+	}
+
+	log.Debugf("Peer: %v channels: %v not eligible for suggestions, "+
+		"swap completed at: %v within success cooldown: %v", peer,
+		channels, lastSuccess, cooldown)
+
+	return newReasonError(ReasonSuccessCooldown)
 }
 
 // satPerKwToSatPerVByte converts sat per kWeight to sat per vByte.
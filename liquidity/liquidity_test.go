@@ -13,6 +13,7 @@ import (
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/test"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
@@ -137,26 +138,193 @@ func newTestConfig() (*Config, *test.LndMockServices) {
 	)
 
 	return &Config{
-		Restrictions: func(_ context.Context, _ swap.Type) (*Restrictions,
-			error) {
+		Channels:    NewLndChannelSource(lnd.Client),
+		Quotes:      &testQuotes{},
+		Swaps:       &testSwaps{},
+		Wallet:      lnd.WalletKit,
+		Graph:       lnd.Client,
+		Node:        lnd.Client,
+		ChainParams: lnd.ChainParams,
+		NodePubkey:  lnd.LndServices.NodePubkey,
+		Clock:       clock.NewTestClock(testTime),
+	}, lnd
+}
 
-			return testRestrictions, nil
-		},
-		Lnd:   &lnd.LndServices,
-		Clock: clock.NewTestClock(testTime),
-		ListLoopOut: func() ([]*loopdb.LoopOut, error) {
-			return nil, nil
-		},
-		ListLoopIn: func() ([]*loopdb.LoopIn, error) {
-			return nil, nil
-		},
-		LoopOutQuote: func(_ context.Context,
-			_ *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote,
-			error) {
+// testSwaps is a swap store that returns a fixed set of swaps.
+type testSwaps struct {
+	loopOut []*loopdb.LoopOut
+	loopIn  []*loopdb.LoopIn
+}
 
-			return testQuote, nil
-		},
-	}, lnd
+// ListLoopOut returns our test loop outs.
+func (s *testSwaps) ListLoopOut() ([]*loopdb.LoopOut, error) {
+	return s.loopOut, nil
+}
+
+// ListLoopIn returns our test loop ins.
+func (s *testSwaps) ListLoopIn() ([]*loopdb.LoopIn, error) {
+	return s.loopIn, nil
+}
+
+// testQuotes is a quote source that returns our test restrictions and loop
+// out quote, unless a test overrides them.
+type testQuotes struct {
+	restrictions func(context.Context, swap.Type) (*Restrictions, error)
+
+	loopOutQuote func(context.Context,
+		*loop.LoopOutQuoteRequest) (*loop.LoopOutQuote, error)
+
+	loopInQuote func(context.Context,
+		*loop.LoopInQuoteRequest) (*loop.LoopInQuote, error)
+}
+
+// Restrictions returns our test restrictions, unless they are overridden.
+func (q *testQuotes) Restrictions(ctx context.Context,
+	swapType swap.Type) (*Restrictions, error) {
+
+	if q.restrictions == nil {
+		return testRestrictions, nil
+	}
+
+	return q.restrictions(ctx, swapType)
+}
+
+// LoopOutQuote returns our test quote, unless it is overridden.
+func (q *testQuotes) LoopOutQuote(ctx context.Context,
+	request *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote, error) {
+
+	if q.loopOutQuote == nil {
+		return testQuote, nil
+	}
+
+	return q.loopOutQuote(ctx, request)
+}
+
+// LoopInQuote returns a loop in quote from the function provided by a test.
+func (q *testQuotes) LoopInQuote(ctx context.Context,
+	request *loop.LoopInQuoteRequest) (*loop.LoopInQuote, error) {
+
+	return q.loopInQuote(ctx, request)
+}
+
+// testDispatcher dispatches swaps with the functions provided by a test.
+type testDispatcher struct {
+	loopOut func(context.Context, *loop.OutRequest) (
+		*loop.LoopOutSwapInfo, error)
+
+	loopIn func(context.Context, *loop.LoopInRequest) (
+		*loop.LoopInSwapInfo, error)
+}
+
+// LoopOut dispatches a loop out.
+func (d *testDispatcher) LoopOut(ctx context.Context,
+	request *loop.OutRequest) (*loop.LoopOutSwapInfo, error) {
+
+	return d.loopOut(ctx, request)
+}
+
+// LoopIn dispatches a loop in.
+func (d *testDispatcher) LoopIn(ctx context.Context,
+	request *loop.LoopInRequest) (*loop.LoopInSwapInfo, error) {
+
+	return d.loopIn(ctx, request)
+}
+
+// testRebalancer executes rebalances with the function provided by a test,
+// and lists the rebalances that it was given.
+type testRebalancer struct {
+	rebalance func(context.Context, *loop.RebalanceRequest) (
+		*loop.RebalanceInfo, error)
+
+	existing []*loopdb.Rebalance
+}
+
+// Rebalance executes a rebalance.
+func (r *testRebalancer) Rebalance(ctx context.Context,
+	request *loop.RebalanceRequest) (*loop.RebalanceInfo, error) {
+
+	return r.rebalance(ctx, request)
+}
+
+// ListRebalances returns our test rebalances.
+func (r *testRebalancer) ListRebalances() ([]*loopdb.Rebalance, error) {
+	return r.existing, nil
+}
+
+// testPreSwapRebalancer rebalances with the function provided by a test.
+type testPreSwapRebalancer struct {
+	rebalance func(context.Context, *PreSwapRebalanceRequest) (
+		*PreSwapRebalanceResult, error)
+}
+
+// PreSwapRebalance rebalances ahead of a swap.
+func (r *testPreSwapRebalancer) PreSwapRebalance(ctx context.Context,
+	request *PreSwapRebalanceRequest) (*PreSwapRebalanceResult, error) {
+
+	return r.rebalance(ctx, request)
+}
+
+// testNotes collects the notes that are set on swaps.
+type testNotes struct {
+	notes []string
+}
+
+// SetSwapNotes records the notes provided.
+func (n *testNotes) SetSwapNotes(_ swap.Type, _ lntypes.Hash,
+	notes string) error {
+
+	n.notes = append(n.notes, notes)
+	return nil
+}
+
+// testRecorder collects the autoloop history that is recorded.
+type testRecorder struct {
+	ticks     []*loopdb.AutoloopTick
+	rounds    []*loopdb.SuggestionRound
+	simulated []*loopdb.SimulatedSwap
+}
+
+// RecordAutoloopTick records an autoloop tick.
+func (r *testRecorder) RecordAutoloopTick(tick *loopdb.AutoloopTick) error {
+	r.ticks = append(r.ticks, tick)
+	return nil
+}
+
+// RecordSuggestionRound records a suggestion round.
+func (r *testRecorder) RecordSuggestionRound(
+	round *loopdb.SuggestionRound) error {
+
+	r.rounds = append(r.rounds, round)
+	return nil
+}
+
+// RecordSimulatedSwaps records a set of simulated swaps.
+func (r *testRecorder) RecordSimulatedSwaps(
+	swaps []*loopdb.SimulatedSwap) error {
+
+	r.simulated = append(r.simulated, swaps...)
+	return nil
+}
+
+// testSync reports a fixed sync state.
+type testSync bool
+
+// LndSynced returns our sync state.
+func (s testSync) LndSynced() bool {
+	return bool(s)
+}
+
+// testNotices returns a fixed set of server notices.
+type testNotices struct {
+	notices []*loop.ServerNotice
+	err     error
+}
+
+// ServerNotices returns our test notices.
+func (n *testNotices) ServerNotices(
+	_ context.Context) ([]*loop.ServerNotice, error) {
+
+	return n.notices, n.err
 }
 
 // testPPMFees calculates the split of fees between prepay and swap invoice
@@ -338,6 +506,12 @@ func TestRestrictedSuggestions(t *testing.T) {
 			chanID1: chanRule,
 			chanID2: chanRule,
 		}
+
+		// chan2Closed is the disqualification for our rule on channel
+		// 2 in the test cases where it is not open.
+		chan2Closed = map[lnwire.ShortChannelID]Reason{
+			chanID2: ReasonNoChannels,
+		}
 	)
 
 	tests := []struct {
@@ -361,7 +535,7 @@ func TestRestrictedSuggestions(t *testing.T) {
 				OutSwaps: []loop.OutRequest{
 					chan1Rec,
 				},
-				DisqualifiedChans: noneDisqualified,
+				DisqualifiedChans: chan2Closed,
 				DisqualifiedPeers: noPeersDisqualified,
 			},
 		},
@@ -382,7 +556,7 @@ func TestRestrictedSuggestions(t *testing.T) {
 				OutSwaps: []loop.OutRequest{
 					chan1Rec,
 				},
-				DisqualifiedChans: noneDisqualified,
+				DisqualifiedChans: chan2Closed,
 				DisqualifiedPeers: noPeersDisqualified,
 			},
 		},
@@ -403,7 +577,7 @@ func TestRestrictedSuggestions(t *testing.T) {
 				OutSwaps: []loop.OutRequest{
 					chan1Rec,
 				},
-				DisqualifiedChans: noneDisqualified,
+				DisqualifiedChans: chan2Closed,
 				DisqualifiedPeers: noPeersDisqualified,
 			},
 		},
@@ -470,6 +644,7 @@ func TestRestrictedSuggestions(t *testing.T) {
 			expected: &Suggestions{
 				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
 					chanID1: ReasonFailureBackoff,
+					chanID2: ReasonNoChannels,
 				},
 				DisqualifiedPeers: noPeersDisqualified,
 			},
@@ -494,7 +669,7 @@ func TestRestrictedSuggestions(t *testing.T) {
 				OutSwaps: []loop.OutRequest{
 					chan1Rec,
 				},
-				DisqualifiedChans: noneDisqualified,
+				DisqualifiedChans: chan2Closed,
 				DisqualifiedPeers: noPeersDisqualified,
 			},
 		},
@@ -517,6 +692,7 @@ func TestRestrictedSuggestions(t *testing.T) {
 			expected: &Suggestions{
 				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
 					chanID1: ReasonLoopOut,
+					chanID2: ReasonNoChannels,
 				},
 				DisqualifiedPeers: noPeersDisqualified,
 			},
@@ -557,11 +733,9 @@ func TestRestrictedSuggestions(t *testing.T) {
 			// Create a manager config which will return the test
 			// case's set of existing swaps.
 			cfg, lnd := newTestConfig()
-			cfg.ListLoopOut = func() ([]*loopdb.LoopOut, error) {
-				return testCase.loopOut, nil
-			}
-			cfg.ListLoopIn = func() ([]*loopdb.LoopIn, error) {
-				return testCase.loopIn, nil
+			cfg.Swaps = &testSwaps{
+				loopOut: testCase.loopOut,
+				loopIn:  testCase.loopIn,
 			}
 
 			lnd.Channels = testCase.channels
@@ -630,11 +804,13 @@ func TestSweepFeeLimit(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			cfg, lnd := newTestConfig()
 
-			cfg.LoopOutQuote = func(_ context.Context,
-				_ *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote,
-				error) {
+			cfg.Quotes = &testQuotes{
+				loopOutQuote: func(_ context.Context,
+					_ *loop.LoopOutQuoteRequest) (
+					*loop.LoopOutQuote, error) {
 
-				return quote, nil
+					return quote, nil
+				},
 			}
 
 			// Set our test case's fee rate for our mock lnd.
@@ -716,7 +892,9 @@ func TestSuggestSwaps(t *testing.T) {
 				},
 			},
 			suggestions: &Suggestions{
-				DisqualifiedChans: noneDisqualified,
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonNoChannels,
+				},
 				DisqualifiedPeers: noPeersDisqualified,
 			},
 		},
@@ -883,11 +1061,13 @@ func TestFeeLimits(t *testing.T) {
 
 		t.Run(testCase.name, func(t *testing.T) {
 			cfg, lnd := newTestConfig()
-			cfg.LoopOutQuote = func(context.Context,
-				*loop.LoopOutQuoteRequest) (*loop.LoopOutQuote,
-				error) {
+			cfg.Quotes = &testQuotes{
+				loopOutQuote: func(context.Context,
+					*loop.LoopOutQuoteRequest) (
+					*loop.LoopOutQuote, error) {
 
-				return testCase.quote, nil
+					return testCase.quote, nil
+				},
 			}
 
 			lnd.Channels = []lndclient.ChannelInfo{
@@ -944,6 +1124,26 @@ func TestFeeBudget(t *testing.T) {
 		defaultRoutingFeePPM, *quote,
 	)
 
+	// When our budget only covers one of our swaps, we score them. Our
+	// channels are identical, so we keep our first swap.
+	newScore := func(channel lnwire.ShortChannelID,
+		selected bool) *SwapScore {
+
+		return &SwapScore{
+			Type:       swap.TypeOut,
+			Amount:     chan1.Amount,
+			Channels:   []lnwire.ShortChannelID{channel},
+			Capacity:   channel1.Capacity,
+			Efficiency: float64(chan1.Amount) / 5078,
+			Score:      2.0 / 3,
+			Selected:   selected,
+		}
+	}
+
+	scores := []*SwapScore{
+		newScore(chanID1, true), newScore(chanID2, false),
+	}
+
 	tests := []struct {
 		name string
 
@@ -957,6 +1157,12 @@ func TestFeeBudget(t *testing.T) {
 		// last update time to their total cost.
 		existingSwaps map[time.Time]btcutil.Amount
 
+		// refreshPeriod is our budget refresh period.
+		refreshPeriod time.Duration
+
+		// rollover indicates whether we roll over unused budget.
+		rollover bool
+
 		// suggestions is the set of swaps we expect to be suggested.
 		suggestions *Suggestions
 	}{
@@ -988,6 +1194,7 @@ func TestFeeBudget(t *testing.T) {
 					chanID2: ReasonBudgetInsufficient,
 				},
 				DisqualifiedPeers: noPeersDisqualified,
+				Scores:            scores,
 			},
 		},
 		{
@@ -1024,6 +1231,7 @@ func TestFeeBudget(t *testing.T) {
 					chanID2: ReasonBudgetInsufficient,
 				},
 				DisqualifiedPeers: noPeersDisqualified,
+				Scores:            scores,
 			},
 		},
 		{
@@ -1041,6 +1249,66 @@ func TestFeeBudget(t *testing.T) {
 				DisqualifiedPeers: noPeersDisqualified,
 			},
 		},
+		{
+			// Our budget period has elapsed twice since our start
+			// date, so our budget resets and the swap that used
+			// it falls in a previous period.
+			name:        "budget used, refreshed",
+			budget:      5078,
+			maxMinerFee: 5000,
+			existingSwaps: map[time.Time]btcutil.Amount{
+				testBudgetStart.Add(time.Minute * 10): 5078,
+			},
+			refreshPeriod: time.Minute * 30,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonBudgetInsufficient,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+				Scores:            scores,
+			},
+		},
+		{
+			// Our unused budget is rolled over, so we can afford
+			// two swaps.
+			name:          "budget refreshed with rollover",
+			budget:        5078,
+			maxMinerFee:   5000,
+			refreshPeriod: time.Minute * 30,
+			rollover:      true,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1, chan2,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			// Only the budget that our existing swap did not use
+			// is rolled over, so we can only afford one swap.
+			name:        "budget partially rolled over",
+			budget:      5078,
+			maxMinerFee: 5000,
+			existingSwaps: map[time.Time]btcutil.Amount{
+				testBudgetStart.Add(time.Minute * 10): 200,
+			},
+			refreshPeriod: time.Minute * 30,
+			rollover:      true,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonBudgetInsufficient,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+				Scores:            scores,
+			},
+		},
 	}
 
 	for _, testCase := range tests {
@@ -1079,15 +1347,17 @@ func TestFeeBudget(t *testing.T) {
 				})
 			}
 
-			cfg.ListLoopOut = func() ([]*loopdb.LoopOut, error) {
-				return swaps, nil
+			cfg.Swaps = &testSwaps{
+				loopOut: swaps,
 			}
 
-			cfg.LoopOutQuote = func(_ context.Context,
-				_ *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote,
-				error) {
+			cfg.Quotes = &testQuotes{
+				loopOutQuote: func(_ context.Context,
+					_ *loop.LoopOutQuoteRequest) (
+					*loop.LoopOutQuote, error) {
 
-				return quote, nil
+					return quote, nil
+				},
 			}
 
 			// Set two channels that need swaps.
@@ -1103,6 +1373,8 @@ func TestFeeBudget(t *testing.T) {
 			}
 			params.AutoFeeStartDate = testBudgetStart
 			params.AutoFeeBudget = testCase.budget
+			params.AutoFeeRefreshPeriod = testCase.refreshPeriod
+			params.AutoFeeRollover = testCase.rollover
 			params.MaxAutoInFlight = 2
 			params.FeeLimit = NewFeeCategoryLimit(
 				defaultSwapFeePPM, defaultRoutingFeePPM,
@@ -1127,125 +1399,117 @@ func TestFeeBudget(t *testing.T) {
 	}
 }
 
-// TestInFlightLimit tests the limit we place on the number of in-flight swaps
-// that are allowed.
-func TestInFlightLimit(t *testing.T) {
+// TestRuleFeeBudget tests limiting of swap suggestions to the fee budgets of
+// the rules that they are suggested for.
+func TestRuleFeeBudget(t *testing.T) {
+	quote := &loop.LoopOutQuote{
+		SwapFee:      btcutil.Amount(1),
+		PrepayAmount: btcutil.Amount(500),
+		MinerFee:     btcutil.Amount(50),
+	}
+
+	chan1 := applyFeeCategoryQuote(
+		chan1Rec, 5000, defaultPrepayRoutingFeePPM,
+		defaultRoutingFeePPM, *quote,
+	)
+	chan2 := applyFeeCategoryQuote(
+		chan2Rec, 5000, defaultPrepayRoutingFeePPM,
+		defaultRoutingFeePPM, *quote,
+	)
+
+	chan1.MaxMinerFee = 5000
+	chan2.MaxMinerFee = 5000
+
+	// existingSwap describes an existing autoloop in our budget period.
+	type existingSwap struct {
+		channel lnwire.ShortChannelID
+		cost    btcutil.Amount
+	}
+
 	tests := []struct {
-		name            string
-		maxInFlight     int
-		existingSwaps   []*loopdb.LoopOut
-		existingInSwaps []*loopdb.LoopIn
-		// peerRules will only be set (instead of test default values)
-		// is it is non-nil.
-		peerRules   map[route.Vertex]*SwapRule
+		name string
+
+		// budget is the fee budget of the rule for channel 1.
+		budget btcutil.Amount
+
+		// existingSwaps is our set of existing autoloops.
+		existingSwaps []existingSwap
+
+		// suggestions is the set of swaps we expect to be suggested.
 		suggestions *Suggestions
 	}{
 		{
-			name:        "none in flight, extra space",
-			maxInFlight: 3,
+			// Each swap will cost 78+5000, so our rule's budget
+			// covers exactly one swap.
+			name:   "rule budget sufficient",
+			budget: 5078,
 			suggestions: &Suggestions{
 				OutSwaps: []loop.OutRequest{
-					chan1Rec, chan2Rec,
+					chan1, chan2,
 				},
 				DisqualifiedChans: noneDisqualified,
 				DisqualifiedPeers: noPeersDisqualified,
 			},
 		},
 		{
-			name:        "none in flight, exact match",
-			maxInFlight: 2,
+			name:   "rule budget insufficient",
+			budget: 5077,
 			suggestions: &Suggestions{
 				OutSwaps: []loop.OutRequest{
-					chan1Rec, chan2Rec,
+					chan2,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonRuleBudget,
 				},
-				DisqualifiedChans: noneDisqualified,
 				DisqualifiedPeers: noPeersDisqualified,
 			},
 		},
 		{
-			name:        "one in flight, one allowed",
-			maxInFlight: 2,
-			existingSwaps: []*loopdb.LoopOut{
-				{
-					Contract: autoOutContract,
-				},
+			name:   "existing swap on channel",
+			budget: 5078 + 499,
+			existingSwaps: []existingSwap{
+				{channel: chanID1, cost: 500},
 			},
 			suggestions: &Suggestions{
 				OutSwaps: []loop.OutRequest{
-					chan1Rec,
+					chan2,
 				},
 				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
-					chanID2: ReasonInFlight,
+					chanID1: ReasonRuleBudget,
 				},
 				DisqualifiedPeers: noPeersDisqualified,
 			},
 		},
 		{
-			name:        "max in flight",
-			maxInFlight: 1,
-			existingSwaps: []*loopdb.LoopOut{
-				{
-					Contract: autoOutContract,
-				},
+			name:   "rule budget used",
+			budget: 500,
+			existingSwaps: []existingSwap{
+				{channel: chanID1, cost: 500},
 			},
 			suggestions: &Suggestions{
-				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
-					chanID1: ReasonInFlight,
-					chanID2: ReasonInFlight,
-				},
-				DisqualifiedPeers: noPeersDisqualified,
-			},
-		},
-		{
-			name:        "max swaps exceeded",
-			maxInFlight: 1,
-			existingSwaps: []*loopdb.LoopOut{
-				{
-					Contract: autoOutContract,
-				},
-			},
-			existingInSwaps: []*loopdb.LoopIn{
-				{
-					Contract: autoInContract,
+				OutSwaps: []loop.OutRequest{
+					chan2,
 				},
-			},
-			suggestions: &Suggestions{
 				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
-					chanID1: ReasonInFlight,
-					chanID2: ReasonInFlight,
+					chanID1: ReasonRuleBudget,
 				},
 				DisqualifiedPeers: noPeersDisqualified,
 			},
 		},
 		{
-			name:        "peer rules max swaps exceeded",
-			maxInFlight: 2,
-			existingSwaps: []*loopdb.LoopOut{
-				{
-					Contract: autoOutContract,
-				},
-			},
-			// Create two peer-level rules, both in need of a swap,
-			// but peer 1 needs a larger swap so will be
-			// prioritized.
-			peerRules: map[route.Vertex]*SwapRule{
-				peer1: {
-					ThresholdRule: NewThresholdRule(50, 0),
-					Type:          swap.TypeOut,
-				},
-				peer2: {
-					ThresholdRule: NewThresholdRule(40, 0),
-					Type:          swap.TypeOut,
-				},
+			// Swaps on other channels do not count towards our
+			// rule's budget.
+			name:   "existing swap on other channel",
+			budget: 5078,
+			existingSwaps: []existingSwap{
+				{channel: chanID2, cost: 500},
 			},
 			suggestions: &Suggestions{
 				OutSwaps: []loop.OutRequest{
-					chan1Rec,
+					chan1, chan2,
 				},
 				DisqualifiedChans: noneDisqualified,
-				DisqualifiedPeers: map[route.Vertex]Reason{
-					peer2: ReasonInFlight,
-				},
+				DisqualifiedPeers: noPeersDisqualified,
 			},
 		},
 	}
@@ -1255,35 +1519,768 @@ func TestInFlightLimit(t *testing.T) {
 
 		t.Run(testCase.name, func(t *testing.T) {
 			cfg, lnd := newTestConfig()
-			cfg.ListLoopOut = func() ([]*loopdb.LoopOut, error) {
-				return testCase.existingSwaps, nil
-			}
-			cfg.ListLoopIn = func() ([]*loopdb.LoopIn, error) {
-				return testCase.existingInSwaps, nil
-			}
 
-			lnd.Channels = []lndclient.ChannelInfo{
-				channel1, channel2,
-			}
+			swaps := make(
+				[]*loopdb.LoopOut, len(testCase.existingSwaps),
+			)
+			for i, existing := range testCase.existingSwaps {
+				contract := *autoOutContract
+				contract.OutgoingChanSet = loopdb.ChannelSet{
+					existing.channel.ToUint64(),
+				}
 
-			params := defaultParameters
+				event := &loopdb.LoopEvent{
+					SwapStateData: loopdb.SwapStateData{
+						Cost: loopdb.SwapCost{
+							Server: existing.cost,
+						},
+						State: loopdb.StateSuccess,
+					},
+					Time: testBudgetStart.Add(time.Hour),
+				}
 
-			if testCase.peerRules != nil {
-				params.PeerRules = testCase.peerRules
-			} else {
-				params.ChannelRules =
-					map[lnwire.ShortChannelID]*SwapRule{
+				swaps[i] = &loopdb.LoopOut{
+					Loop: loopdb.Loop{
+						Events: []*loopdb.LoopEvent{
+							event,
+						},
+					},
+					Contract: &contract,
+				}
+			}
+
+			cfg.Swaps = &testSwaps{
+				loopOut: swaps,
+			}
+
+			cfg.Quotes = &testQuotes{
+				loopOutQuote: func(_ context.Context,
+					_ *loop.LoopOutQuoteRequest) (
+					*loop.LoopOutQuote, error) {
+
+					return quote, nil
+				},
+			}
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1,
+				channel2,
+			}
+
+			budgetRule := *chanRule
+			budgetRule.FeeBudget = testCase.budget
+
+			params := defaultParameters
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: &budgetRule,
+				chanID2: chanRule,
+			}
+			params.AutoFeeStartDate = testBudgetStart
+			params.AutoFeeBudget = 100000
+			params.MaxAutoInFlight = 2
+			params.FeeLimit = NewFeeCategoryLimit(
+				defaultSwapFeePPM, defaultRoutingFeePPM,
+				defaultPrepayRoutingFeePPM, 5000,
+				defaultMaximumPrepay, defaultSweepFeeRateLimit,
+			)
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestRuleExpiry tests that we do not suggest swaps for rules that have
+// expired.
+func TestRuleExpiry(t *testing.T) {
+	tests := []struct {
+		name        string
+		expiry      time.Time
+		suggestions *Suggestions
+	}{
+		{
+			name: "no expiry",
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:   "expiry in future",
+			expiry: testTime.Add(time.Second),
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:   "expiry reached",
+			expiry: testTime,
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonRuleExpired,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1,
+			}
+
+			expiringRule := *chanRule
+			expiringRule.Expiry = testCase.expiry
+
+			params := defaultParameters
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: &expiringRule,
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestDirectionCooldown tests that we do not suggest swaps for rules while
+// their cooldown after a swap in the opposite direction is in effect.
+func TestDirectionCooldown(t *testing.T) {
+	succeeded := []*loopdb.LoopEvent{
+		{
+			SwapStateData: loopdb.SwapStateData{
+				State: loopdb.StateSuccess,
+			},
+			Time: testTime.Add(time.Hour * -1),
+		},
+	}
+
+	completedIn := []*loopdb.LoopIn{
+		{
+			Loop: loopdb.Loop{
+				Events: succeeded,
+			},
+			Contract: &loopdb.LoopInContract{
+				LastHop: &peer1,
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		cooldown    time.Duration
+		suggestions *Suggestions
+	}{
+		{
+			name: "no cooldown",
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "cooldown passed",
+			cooldown: time.Minute * 30,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "within cooldown",
+			cooldown: time.Hour * 2,
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonDirectionCooldown,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			cfg.Swaps = &testSwaps{
+				loopIn: completedIn,
+			}
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1,
+			}
+
+			rule := *chanRule
+			rule.Cooldown = testCase.cooldown
+
+			params := defaultParameters
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: &rule,
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestSuccessCooldown tests that we do not suggest swaps for channels while
+// our cooldown after a swap that completed for them is in effect.
+func TestSuccessCooldown(t *testing.T) {
+	succeeded := []*loopdb.LoopEvent{
+		{
+			SwapStateData: loopdb.SwapStateData{
+				State: loopdb.StateSuccess,
+			},
+			Time: testTime.Add(time.Hour * -1),
+		},
+	}
+
+	completedOut := []*loopdb.LoopOut{
+		{
+			Contract: chan1Out,
+			Loop: loopdb.Loop{
+				Events: succeeded,
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		cooldown    time.Duration
+		suggestions *Suggestions
+	}{
+		{
+			name: "no cooldown",
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "cooldown passed",
+			cooldown: time.Minute * 30,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "within cooldown",
+			cooldown: time.Hour * 2,
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonSuccessCooldown,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			cfg.Swaps = &testSwaps{
+				loopOut: completedOut,
+			}
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1,
+			}
+
+			params := defaultParameters
+			params.SuccessCooldown = testCase.cooldown
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestRuleMaxSwapAmount tests that we do not suggest swaps above a rule's
+// maximum swap amount.
+func TestRuleMaxSwapAmount(t *testing.T) {
+	var (
+		prepay, routing = testPPMFees(defaultFeePPM, testQuote, 7000)
+		cappedRec       = loop.OutRequest{
+			Amount:              7000,
+			OutgoingChanSet:     loopdb.ChannelSet{chanID1.ToUint64()},
+			MaxPrepayRoutingFee: prepay,
+			MaxSwapRoutingFee:   routing,
+			MaxMinerFee:         scaleMinerFee(testQuote.MinerFee),
+			MaxSwapFee:          testQuote.SwapFee,
+			MaxPrepayAmount:     testQuote.PrepayAmount,
+			SweepConfTarget:     defaultConfTarget,
+			Initiator:           autoloopSwapInitiator,
+		}
+	)
+
+	tests := []struct {
+		name          string
+		maxSwapAmount btcutil.Amount
+		suggestions   *Suggestions
+	}{
+		{
+			name: "no cap",
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:          "cap above amount",
+			maxSwapAmount: 8000,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:          "cap below amount",
+			maxSwapAmount: 7000,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					cappedRec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1,
+			}
+
+			rule := *chanRule
+			rule.MaxSwapAmount = testCase.maxSwapAmount
+
+			params := defaultParameters
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: &rule,
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestRuleFeeLimit tests that a rule's fee limit overrides our global fee
+// limit.
+func TestRuleFeeLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		feeLimit    FeeLimit
+		suggestions *Suggestions
+	}{
+		{
+			name: "global limit",
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonMinerFee,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "rule limit",
+			feeLimit: defaultFeePortion(),
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1,
+			}
+
+			rule := *chanRule
+			rule.FeeLimit = testCase.feeLimit
+
+			// Set a global fee limit that is too low for our
+			// test quote.
+			params := defaultParameters
+			params.FeeLimit = NewFeePortion(100)
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: &rule,
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestInFlightLimit tests the limit we place on the number of in-flight swaps
+// that are allowed.
+func TestInFlightLimit(t *testing.T) {
+	tests := []struct {
+		name            string
+		maxInFlight     int
+		existingSwaps   []*loopdb.LoopOut
+		existingInSwaps []*loopdb.LoopIn
+		// peerRules will only be set (instead of test default values)
+		// is it is non-nil.
+		peerRules   map[route.Vertex]*SwapRule
+		suggestions *Suggestions
+	}{
+		{
+			name:        "none in flight, extra space",
+			maxInFlight: 3,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:        "none in flight, exact match",
+			maxInFlight: 2,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:        "one in flight, one allowed",
+			maxInFlight: 2,
+			existingSwaps: []*loopdb.LoopOut{
+				{
+					Contract: autoOutContract,
+				},
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonInFlight,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:        "max in flight",
+			maxInFlight: 1,
+			existingSwaps: []*loopdb.LoopOut{
+				{
+					Contract: autoOutContract,
+				},
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonInFlight,
+					chanID2: ReasonInFlight,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:        "max swaps exceeded",
+			maxInFlight: 1,
+			existingSwaps: []*loopdb.LoopOut{
+				{
+					Contract: autoOutContract,
+				},
+			},
+			existingInSwaps: []*loopdb.LoopIn{
+				{
+					Contract: autoInContract,
+				},
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonInFlight,
+					chanID2: ReasonInFlight,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:        "peer rules max swaps exceeded",
+			maxInFlight: 2,
+			existingSwaps: []*loopdb.LoopOut{
+				{
+					Contract: autoOutContract,
+				},
+			},
+			// Create two peer-level rules, both in need of a swap,
+			// but peer 1 needs a larger swap so will be
+			// prioritized.
+			peerRules: map[route.Vertex]*SwapRule{
+				peer1: {
+					ThresholdRule: NewThresholdRule(50, 0),
+					Type:          swap.TypeOut,
+				},
+				peer2: {
+					ThresholdRule: NewThresholdRule(40, 0),
+					Type:          swap.TypeOut,
+				},
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: map[route.Vertex]Reason{
+					peer2: ReasonInFlight,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			cfg.Swaps = &testSwaps{
+				loopOut: testCase.existingSwaps,
+				loopIn:  testCase.existingInSwaps,
+			}
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			params := defaultParameters
+
+			if testCase.peerRules != nil {
+				params.PeerRules = testCase.peerRules
+			} else {
+				params.ChannelRules =
+					map[lnwire.ShortChannelID]*SwapRule{
 						chanID1: chanRule,
 						chanID2: chanRule,
 					}
 			}
 
-			params.MaxAutoInFlight = testCase.maxInFlight
+			params.MaxAutoInFlight = testCase.maxInFlight
+
+			// By default we only have budget for one swap, increase
+			// our budget so that we could recommend more than one
+			// swap at a time.
+			params.AutoFeeBudget = defaultBudget * 2
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
+
+// TestVBytesBudget tests limiting of our suggestions by our on-chain vbytes
+// budget, including the on-chain footprint of manually dispatched swaps.
+func TestVBytesBudget(t *testing.T) {
+	// recentOut is a manually dispatched loop out that was initiated
+	// within our default budget period.
+	recentOut := &loopdb.LoopOut{
+		Contract: &loopdb.LoopOutContract{
+			SwapContract: loopdb.SwapContract{
+				InitiationTime: testTime.Add(time.Hour * -1),
+			},
+			OutgoingChanSet: loopdb.ChannelSet{999},
+		},
+	}
+
+	// oldOut is a manually dispatched loop out that was initiated before
+	// our default budget period.
+	oldOut := &loopdb.LoopOut{
+		Contract: &loopdb.LoopOutContract{
+			SwapContract: loopdb.SwapContract{
+				InitiationTime: testTime.Add(
+					DefaultVBytesBudgetPeriod * -2,
+				),
+			},
+			OutgoingChanSet: loopdb.ChannelSet{999},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		budget        uint64
+		existingSwaps []*loopdb.LoopOut
+		suggestions   *Suggestions
+	}{
+		{
+			name:   "no budget",
+			budget: 0,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:   "budget for both swaps",
+			budget: loopOutVBytes * 2,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:   "budget for one swap",
+			budget: loopOutVBytes*2 - 1,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonVBytesBudget,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:   "recent swap uses budget",
+			budget: loopOutVBytes * 2,
+			existingSwaps: []*loopdb.LoopOut{
+				recentOut,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonVBytesBudget,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:   "old swap outside of period",
+			budget: loopOutVBytes * 2,
+			existingSwaps: []*loopdb.LoopOut{
+				oldOut,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:   "budget exhausted",
+			budget: loopOutVBytes,
+			existingSwaps: []*loopdb.LoopOut{
+				recentOut,
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonVBytesBudget,
+					chanID2: ReasonVBytesBudget,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			cfg.Swaps = &testSwaps{
+				loopOut: testCase.existingSwaps,
+			}
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
 
-			// By default we only have budget for one swap, increase
-			// our budget so that we could recommend more than one
-			// swap at a time.
+			params := defaultParameters
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+				chanID2: chanRule,
+			}
+
+			// Increase our fee budget and in flight limit so that
+			// we could recommend more than one swap at a time.
 			params.AutoFeeBudget = defaultBudget * 2
+			params.MaxAutoInFlight = 2
+			params.VBytesBudget = testCase.budget
 
 			testSuggestSwaps(
 				t, newSuggestSwapsSetup(cfg, lnd, params),
@@ -1363,7 +2360,7 @@ func TestSizeRestrictions(t *testing.T) {
 			},
 			suggestions: &Suggestions{
 				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
-					chanID1: ReasonLiquidityOk,
+					chanID1: ReasonBelowMinimum,
 				},
 				DisqualifiedPeers: noPeersDisqualified,
 			},
@@ -1450,7 +2447,9 @@ func TestSizeRestrictions(t *testing.T) {
 				).Return(&restrictions, nil)
 			}
 
-			cfg.Restrictions = mockServer.Restrictions
+			cfg.Quotes = &testQuotes{
+				restrictions: mockServer.Restrictions,
+			}
 
 			testSuggestSwaps(
 				t, newSuggestSwapsSetup(cfg, lnd, params),
@@ -1577,12 +2576,14 @@ func TestFeePercentage(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			cfg, lnd := newTestConfig()
 
-			cfg.LoopOutQuote = func(_ context.Context,
-				_ *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote,
-				error) {
+			cfg.Quotes = &testQuotes{
+				loopOutQuote: func(_ context.Context,
+					_ *loop.LoopOutQuoteRequest) (
+					*loop.LoopOutQuote, error) {
 
-				return testCase.quote, nil
+					return testCase.quote, nil
 
+				},
 			}
 
 			lnd.Channels = []lndclient.ChannelInfo{
@@ -1748,15 +2749,17 @@ func TestBudgetWithLoopin(t *testing.T) {
 				channel1,
 			}
 
-			cfg.ListLoopIn = func() ([]*loopdb.LoopIn, error) {
-				return testCase.loopIns, nil
+			cfg.Swaps = &testSwaps{
+				loopIn: testCase.loopIns,
 			}
 
-			cfg.LoopOutQuote = func(_ context.Context,
-				_ *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote,
-				error) {
+			cfg.Quotes = &testQuotes{
+				loopOutQuote: func(_ context.Context,
+					_ *loop.LoopOutQuoteRequest) (
+					*loop.LoopOutQuote, error) {
 
-				return okQuote, nil
+					return okQuote, nil
+				},
 			}
 
 			params := defaultParameters
@@ -1857,6 +2860,15 @@ func TestCurrentTraffic(t *testing.T) {
 			},
 		}
 
+		succeeded = []*loopdb.LoopEvent{
+			{
+				SwapStateData: loopdb.SwapStateData{
+					State: loopdb.StateSuccess,
+				},
+				Time: outsideBackoff,
+			},
+		}
+
 		failedInBackoff = []*loopdb.LoopEvent{
 			{
 				SwapStateData: loopdb.SwapStateData{
@@ -1925,7 +2937,13 @@ func TestCurrentTraffic(t *testing.T) {
 			name: "pending swaps included",
 			loopOut: []*loopdb.LoopOut{
 				{
+					Loop: loopdb.Loop{
+						Hash: lntypes.Hash{1},
+					},
 					Contract: &loopdb.LoopOutContract{
+						SwapContract: loopdb.SwapContract{
+							AmountRequested: 5000,
+						},
 						OutgoingChanSet: []uint64{
 							chanID1.ToUint64(),
 						},
@@ -1943,6 +2961,12 @@ func TestCurrentTraffic(t *testing.T) {
 				ongoingLoopOut: map[lnwire.ShortChannelID]bool{
 					chanID1: true,
 				},
+				pendingLoopOut: []pendingSwap{
+					{
+						hash:   lntypes.Hash{1},
+						amount: 5000,
+					},
+				},
 				ongoingLoopIn: map[route.Vertex]bool{
 					peer2: true,
 				},
@@ -1951,6 +2975,17 @@ func TestCurrentTraffic(t *testing.T) {
 					map[lnwire.ShortChannelID]time.Time,
 				),
 				failedLoopIn: make(map[route.Vertex]time.Time),
+				completedLoopOut: make(
+					map[lnwire.ShortChannelID]time.Time,
+				),
+				completedLoopIn: make(
+					map[route.Vertex]time.Time,
+				),
+				loopInFees: make(map[route.Vertex]*peerFees),
+				lastLoopIn: make(map[route.Vertex]time.Time),
+				loopInVolume: make(
+					map[route.Vertex]btcutil.Amount,
+				),
 			},
 		},
 		{
@@ -2006,6 +3041,65 @@ func TestCurrentTraffic(t *testing.T) {
 				failedLoopIn: map[route.Vertex]time.Time{
 					peer1: withinBackoff,
 				},
+				completedLoopOut: make(
+					map[lnwire.ShortChannelID]time.Time,
+				),
+				completedLoopIn: make(
+					map[route.Vertex]time.Time,
+				),
+				loopInFees: make(map[route.Vertex]*peerFees),
+				lastLoopIn: make(map[route.Vertex]time.Time),
+				loopInVolume: make(
+					map[route.Vertex]btcutil.Amount,
+				),
+			},
+		},
+		{
+			name: "completed swaps included",
+			loopOut: []*loopdb.LoopOut{
+				{
+					Contract: &loopdb.LoopOutContract{
+						OutgoingChanSet: []uint64{
+							chanID1.ToUint64(),
+						},
+					},
+					Loop: loopdb.Loop{
+						Events: succeeded,
+					},
+				},
+			},
+			loopIn: []*loopdb.LoopIn{
+				{
+					Contract: &loopdb.LoopInContract{
+						LastHop: &peer2,
+					},
+					Loop: loopdb.Loop{
+						Events: succeeded,
+					},
+				},
+			},
+			expected: &swapTraffic{
+				ongoingLoopOut: make(
+					map[lnwire.ShortChannelID]bool,
+				),
+				ongoingLoopIn: make(map[route.Vertex]bool),
+				failedLoopOut: make(
+					map[lnwire.ShortChannelID]time.Time,
+				),
+				failedLoopIn: make(map[route.Vertex]time.Time),
+				completedLoopOut: map[lnwire.ShortChannelID]time.Time{
+					chanID1: outsideBackoff,
+				},
+				completedLoopIn: map[route.Vertex]time.Time{
+					peer2: outsideBackoff,
+				},
+				loopInFees: map[route.Vertex]*peerFees{
+					peer2: {},
+				},
+				lastLoopIn: make(map[route.Vertex]time.Time),
+				loopInVolume: make(
+					map[route.Vertex]btcutil.Amount,
+				),
 			},
 		},
 	}
@@ -2022,3 +3116,316 @@ func TestCurrentTraffic(t *testing.T) {
 		require.Equal(t, testCase.expected, actual)
 	}
 }
+
+// TestRuleCustomRecords tests that the custom records set on a rule are
+// attached to the loop outs that we suggest for it.
+func TestRuleCustomRecords(t *testing.T) {
+	records := map[uint64][]byte{
+		65536: {1, 2, 3},
+	}
+
+	cfg, lnd := newTestConfig()
+	lnd.Channels = []lndclient.ChannelInfo{
+		channel1,
+	}
+
+	rule := *chanRule
+	rule.CustomRecords = records
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: &rule,
+	}
+
+	expected := chan1Rec
+	expected.CustomRecords = records
+
+	testSuggestSwaps(
+		t, newSuggestSwapsSetup(cfg, lnd, params),
+		&Suggestions{
+			OutSwaps: []loop.OutRequest{
+				expected,
+			},
+			DisqualifiedChans: noneDisqualified,
+			DisqualifiedPeers: noPeersDisqualified,
+		}, nil,
+	)
+
+	// Custom records may not be set for loop in rules.
+	rule.Type = swap.TypeIn
+	require.Equal(t, ErrCustomRecordsLoopIn, rule.validate())
+}
+
+// TestCloneParameters tests that the rules in a clone of our parameters do not
+// share their custom records or sweep destinations with our parameters.
+func TestCloneParameters(t *testing.T) {
+	newRule := func() *SwapRule {
+		rule := *chanRule
+		rule.CustomRecords = map[uint64][]byte{
+			65536: {1, 2, 3},
+		}
+		rule.SweepDestination = &SweepDestination{
+			Verification: &loopdb.DestVerification{
+				Method: loopdb.DestVerificationSignature,
+				Proof:  "signature",
+			},
+		}
+
+		return &rule
+	}
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: newRule(),
+	}
+	params.PeerRules = map[route.Vertex]*SwapRule{
+		peer1: newRule(),
+	}
+	params.ChannelGroups = map[string]*ChannelGroup{
+		"group": {
+			Channels: []lnwire.ShortChannelID{chanID2},
+			Rule:     newRule(),
+		},
+	}
+
+	paramCopy := cloneParameters(params)
+	require.Equal(t, params, paramCopy)
+
+	for _, rule := range []*SwapRule{
+		paramCopy.ChannelRules[chanID1],
+		paramCopy.PeerRules[peer1],
+		paramCopy.ChannelGroups["group"].Rule,
+	} {
+		rule.CustomRecords[65536][0] = 4
+		rule.CustomRecords[65537] = []byte{5}
+		rule.SweepDestination.Verification.Proof = "other"
+		rule.SweepDestination.XpubVerified = true
+	}
+
+	for _, rule := range []*SwapRule{
+		params.ChannelRules[chanID1],
+		params.PeerRules[peer1],
+		params.ChannelGroups["group"].Rule,
+	} {
+		expected := newRule()
+		require.Equal(t, expected.CustomRecords, rule.CustomRecords)
+		require.Equal(
+			t, expected.SweepDestination, rule.SweepDestination,
+		)
+	}
+}
+
+// TestPublicationDeadline tests that loop outs allow the server to delay
+// publication until our global publication deadline, and that rules override
+// it with their own deadline.
+func TestPublicationDeadline(t *testing.T) {
+	tests := []struct {
+		name         string
+		ruleDeadline time.Duration
+		expected     time.Time
+	}{
+		{
+			name:     "global deadline",
+			expected: testTime.Add(time.Minute * 30),
+		},
+		{
+			name:         "rule deadline",
+			ruleDeadline: time.Hour,
+			expected:     testTime.Add(time.Hour),
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1,
+			}
+
+			rule := *chanRule
+			rule.PublicationDeadline = testCase.ruleDeadline
+
+			rules := map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: &rule,
+			}
+
+			params := defaultParameters
+			params.PublicationDeadline = time.Minute * 30
+			params.ChannelRules = rules
+
+			expected := chan1Rec
+			expected.SwapPublicationDeadline = testCase.expected
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				&Suggestions{
+					OutSwaps: []loop.OutRequest{
+						expected,
+					},
+					DisqualifiedChans: noneDisqualified,
+					DisqualifiedPeers: noPeersDisqualified,
+				}, nil,
+			)
+		})
+	}
+
+	// Publication deadlines may not be set for loop in rules.
+	rule := *chanRule
+	rule.Type = swap.TypeIn
+	rule.PublicationDeadline = time.Hour
+	require.Equal(t, ErrPublicationDeadlineLoopIn, rule.validate())
+}
+
+// TestDispatchSpacing tests waiting between the swaps that we dispatch in a
+// single tick, and interruption of that wait when we shut down.
+func TestDispatchSpacing(t *testing.T) {
+	const spacing = time.Minute
+
+	tickSignal := make(chan time.Duration)
+	testClock := clock.NewTestClockWithTickSignal(testTime, tickSignal)
+
+	cfg, _ := newTestConfig()
+	cfg.Clock = testClock
+
+	manager := NewManager(cfg)
+	manager.params = defaultParameters
+	manager.params.DispatchSpacing = spacing
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Our first dispatch does not wait for our spacing.
+	wait := manager.newDispatchSpacing()
+	require.NoError(t, wait(ctx))
+
+	// Our second dispatch waits until our spacing has passed.
+	errChan := make(chan error)
+	go func() {
+		errChan <- wait(ctx)
+	}()
+
+	require.Equal(t, spacing, <-tickSignal)
+	testClock.SetTime(testTime.Add(spacing))
+	require.NoError(t, <-errChan)
+
+	// If we shut down while we are waiting, we stop waiting.
+	go func() {
+		errChan <- wait(ctx)
+	}()
+
+	require.Equal(t, spacing, <-tickSignal)
+	cancel()
+	require.ErrorIs(t, <-errChan, context.Canceled)
+
+	// If no spacing is set, we do not wait between dispatches.
+	manager.params.DispatchSpacing = 0
+	wait = manager.newDispatchSpacing()
+	require.NoError(t, wait(ctx))
+	require.NoError(t, wait(ctx))
+}
+
+// TestLoopInPrivate tests that we only suggest loop ins for peers that we
+// only have private channels with if we are allowed to, and that we ask for
+// route hints for their channels when we do.
+func TestLoopInPrivate(t *testing.T) {
+	var (
+		quote = &loop.LoopInQuote{
+			SwapFee:  1,
+			MinerFee: 2,
+		}
+
+		privateChannel = lndclient.ChannelInfo{
+			ChannelID:     chanID1.ToUint64(),
+			PubKeyBytes:   peer1,
+			LocalBalance:  0,
+			RemoteBalance: 10000,
+			Capacity:      10000,
+			Private:       true,
+		}
+
+		rule = &SwapRule{
+			ThresholdRule: NewThresholdRule(0, 50),
+			Type:          swap.TypeIn,
+		}
+
+		privateSwap = loop.LoopInRequest{
+			Amount:         7500,
+			MaxSwapFee:     quote.SwapFee,
+			MaxMinerFee:    quote.MinerFee,
+			HtlcConfTarget: defaultHtlcConfTarget,
+			LastHop:        &peer1,
+			Initiator:      autoloopSwapInitiator,
+			Private:        true,
+		}
+	)
+
+	tests := []struct {
+		name          string
+		loopInPrivate bool
+		expected      *Suggestions
+	}{
+		{
+			name: "private loop in not allowed",
+			expected: &Suggestions{
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: map[route.Vertex]Reason{
+					peer1: ReasonPrivateChannel,
+				},
+			},
+		},
+		{
+			name:          "private loop in allowed",
+			loopInPrivate: true,
+			expected: &Suggestions{
+				InSwaps: []loop.LoopInRequest{
+					privateSwap,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{
+				privateChannel,
+			}
+
+			// Our quote request must ask for route hints for our
+			// private channels whenever we are allowed to use them.
+			cfg.Quotes = &testQuotes{
+				loopInQuote: func(_ context.Context,
+					request *loop.LoopInQuoteRequest) (
+					*loop.LoopInQuote, error) {
+
+					require.Equal(
+						t, testCase.loopInPrivate,
+						request.Private,
+					)
+
+					return quote, nil
+				},
+			}
+
+			// We use a high fee limit so that our small swap can
+			// cover the worst case fees of a loop in.
+			params := defaultParameters
+			params.FeeLimit = NewFeePortion(200000)
+			params.LoopInPrivate = testCase.loopInPrivate
+			params.PeerRules = map[route.Vertex]*SwapRule{
+				peer1: rule,
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.expected, nil,
+			)
+		})
+	}
+}
@@ -22,6 +22,11 @@ func (l *loopInSwapSuggestion) amount() btcutil.Amount {
 	return l.Amount
 }
 
+// swapType returns the type of the swap.
+func (l *loopInSwapSuggestion) swapType() swap.Type {
+	return swap.TypeIn
+}
+
 // fees returns the highest fees that we could pay for the swap suggestion.
 func (l *loopInSwapSuggestion) fees() btcutil.Amount {
 	return worstCaseInFees(
@@ -29,6 +34,11 @@ func (l *loopInSwapSuggestion) fees() btcutil.Amount {
 	)
 }
 
+// vbytes returns the estimated size of the loop in swap's htlc transaction.
+func (l *loopInSwapSuggestion) vbytes() uint64 {
+	return loopInVBytes
+}
+
 // channels returns no channels for loop in swap suggestions because we do not
 // restrict loop in swaps by channel id.
 func (l *loopInSwapSuggestion) channels() []lnwire.ShortChannelID {
@@ -81,15 +81,18 @@ func (b *loopInBuilder) inUse(traffic *swapTraffic, peer route.Vertex,
 // buildSwap creates a swap for the target peer/channels provided. The autoloop
 // boolean indicates whether this swap will actually be executed.
 //
-// For loop in, we do not add the autoloop label for dry runs.
+// For loop in, we do not add the autoloop label for dry runs. If we may loop in
+// to private channels, we ask for route hints for the private channels of our
+// last hop, so that the server can reach them.
 func (b *loopInBuilder) buildSwap(ctx context.Context, pubkey route.Vertex,
 	_ []lnwire.ShortChannelID, amount btcutil.Amount,
 	autoloop bool, params Parameters) (swapSuggestion, error) {
 
-	quote, err := b.cfg.LoopInQuote(ctx, &loop.LoopInQuoteRequest{
+	quote, err := b.cfg.Quotes.LoopInQuote(ctx, &loop.LoopInQuoteRequest{
 		Amount:         amount,
 		LastHop:        &pubkey,
 		HtlcConfTarget: params.HtlcConfTarget,
+		Private:        params.LoopInPrivate,
 	})
 	if err != nil {
 		// If the server fails our quote, we're not reachable right
@@ -114,6 +117,7 @@ func (b *loopInBuilder) buildSwap(ctx context.Context, pubkey route.Vertex,
 		HtlcConfTarget: params.HtlcConfTarget,
 		LastHop:        &pubkey,
 		Initiator:      autoloopSwapInitiator,
+		Private:        params.LoopInPrivate,
 	}
 
 	if autoloop {
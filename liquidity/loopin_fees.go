@@ -0,0 +1,116 @@
+package liquidity
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// loopInFeeHistory is the period of successful loop ins that we use to
+// estimate the fees that we will pay for loop ins to each of our peers.
+const loopInFeeHistory = time.Hour * 24 * 30
+
+// peerFees tracks the total amount and the realized swap fees of the recent
+// successful loop ins to a peer. The server pays to route the off-chain leg of
+// a loop in to its last hop, and charges us for it as part of its swap fee, so
+// peers that are expensive to reach have higher realized fees.
+type peerFees struct {
+	amount btcutil.Amount
+	fees   btcutil.Amount
+}
+
+// expectedFee returns the swap fee that we expect to pay for a loop in of the
+// amount provided, based on the average fee rate of our previous loop ins.
+func (p *peerFees) expectedFee(amount btcutil.Amount) btcutil.Amount {
+	if p.amount == 0 {
+		return 0
+	}
+
+	return p.fees * amount / p.amount
+}
+
+// addLoopInFees records the amount and realized swap fee of a successful loop
+// in to the peer provided.
+func (s *swapTraffic) addLoopInFees(peer route.Vertex, amount,
+	fees btcutil.Amount) {
+
+	history, ok := s.loopInFees[peer]
+	if !ok {
+		history = &peerFees{}
+		s.loopInFees[peer] = history
+	}
+
+	history.amount += amount
+	history.fees += fees
+}
+
+// expectedLoopInFee returns the swap fee that we expect to pay for a loop in
+// to the peer provided, or zero if we have no recent loop ins to the peer.
+func (s *swapTraffic) expectedLoopInFee(peer route.Vertex,
+	amount btcutil.Amount) btcutil.Amount {
+
+	history, ok := s.loopInFees[peer]
+	if !ok {
+		return 0
+	}
+
+	return history.expectedFee(amount)
+}
+
+// checkLoopInFees returns a reason error if the swap fee that we expect to pay
+// for a loop in to the peer provided, based on our previous loop ins to it,
+// already exceeds our fee limit. This allows us to skip peers that are too
+// expensive to reach without requesting a quote for them.
+func (s *swapTraffic) checkLoopInFees(limit FeeLimit, peer route.Vertex,
+	amount btcutil.Amount) error {
+
+	expected := s.expectedLoopInFee(peer, amount)
+	if expected == 0 {
+		return nil
+	}
+
+	err := limit.loopInLimits(amount, &loop.LoopInQuote{
+		SwapFee: expected,
+	})
+	if err != nil {
+		log.Debugf("Peer: %v not eligible for loop in of %v, expected "+
+			"swap fee: %v based on previous loop ins exceeds fee "+
+			"limit", peer, amount, expected)
+	}
+
+	return err
+}
+
+// adjustLoopInFees raises the maximum swap fee of a loop in to the fee that we
+// expect to pay based on our previous loop ins to its peer, if our fee limit
+// allows it. The server quotes its swap fee again when we dispatch our loop
+// in, so this gives us room for the routing fees that we have seen for the
+// peer rather than failing our swap if its quote rises to meet them.
+func (s *swapTraffic) adjustLoopInFees(limit FeeLimit,
+	suggestion *loopInSwapSuggestion) {
+
+	if suggestion.LastHop == nil {
+		return
+	}
+
+	expected := s.expectedLoopInFee(*suggestion.LastHop, suggestion.Amount)
+	if expected <= suggestion.MaxSwapFee {
+		return
+	}
+
+	err := limit.loopInLimits(suggestion.Amount, &loop.LoopInQuote{
+		SwapFee:  expected,
+		MinerFee: suggestion.MaxMinerFee,
+	})
+	if err != nil {
+		return
+	}
+
+	log.Debugf("Raising maximum swap fee for loop in to: %v from %v to "+
+		"%v based on previous loop ins", *suggestion.LastHop,
+		suggestion.MaxSwapFee, expected)
+
+	suggestion.MaxSwapFee = expected
+}
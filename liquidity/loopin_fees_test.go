@@ -0,0 +1,189 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPeerFees tests estimating the swap fee of a loop in from the realized
+// fees of previous loop ins.
+func TestPeerFees(t *testing.T) {
+	traffic := newSwapTraffic()
+	require.Zero(t, traffic.expectedLoopInFee(peer1, 1000))
+
+	traffic.addLoopInFees(peer1, 10000, 100)
+	traffic.addLoopInFees(peer1, 30000, 100)
+	require.Equal(t, btcutil.Amount(5), traffic.expectedLoopInFee(
+		peer1, 1000,
+	))
+	require.Zero(t, traffic.expectedLoopInFee(peer2, 1000))
+}
+
+// TestLoopInFeeHistory tests that our previous loop ins to a peer are used to
+// leave room for its fees in our loop ins, or to skip it if it is too
+// expensive to reach.
+func TestLoopInFeeHistory(t *testing.T) {
+	var (
+		quote = &loop.LoopInQuote{
+			SwapFee:  1,
+			MinerFee: 2,
+		}
+
+		channel = lndclient.ChannelInfo{
+			ChannelID:     chanID1.ToUint64(),
+			PubKeyBytes:   peer1,
+			RemoteBalance: 10000,
+			Capacity:      10000,
+		}
+
+		rule = &SwapRule{
+			ThresholdRule: NewThresholdRule(0, 50),
+			Type:          swap.TypeIn,
+		}
+	)
+
+	// newLoopIn returns a successful loop in to our peer with the amount
+	// and swap fee provided.
+	newLoopIn := func(amount, fee btcutil.Amount,
+		completed time.Time) *loopdb.LoopIn {
+
+		event := &loopdb.LoopEvent{
+			SwapStateData: loopdb.SwapStateData{
+				State: loopdb.StateSuccess,
+				Cost: loopdb.SwapCost{
+					Server: fee,
+				},
+			},
+			Time: completed,
+		}
+
+		return &loopdb.LoopIn{
+			Loop: loopdb.Loop{
+				Events: []*loopdb.LoopEvent{event},
+			},
+			Contract: &loopdb.LoopInContract{
+				SwapContract: loopdb.SwapContract{
+					AmountRequested: amount,
+				},
+				LastHop: &peer1,
+			},
+		}
+	}
+
+	// newSwap returns the loop in that we expect to be suggested with the
+	// maximum swap fee provided.
+	newSwap := func(maxSwapFee btcutil.Amount) loop.LoopInRequest {
+		return loop.LoopInRequest{
+			Amount:         7500,
+			MaxSwapFee:     maxSwapFee,
+			MaxMinerFee:    quote.MinerFee,
+			HtlcConfTarget: defaultHtlcConfTarget,
+			LastHop:        &peer1,
+			Initiator:      autoloopSwapInitiator,
+		}
+	}
+
+	recent := testTime.Add(time.Hour * -1)
+
+	tests := []struct {
+		name     string
+		loopIns  []*loopdb.LoopIn
+		expected *Suggestions
+	}{
+		{
+			name: "no history",
+			expected: &Suggestions{
+				InSwaps: []loop.LoopInRequest{
+					newSwap(quote.SwapFee),
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "history within limit",
+			loopIns: []*loopdb.LoopIn{
+				newLoopIn(10000, 100, recent),
+			},
+			expected: &Suggestions{
+				InSwaps: []loop.LoopInRequest{
+					newSwap(75),
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "history exceeds limit",
+			loopIns: []*loopdb.LoopIn{
+				newLoopIn(10000, 3000, recent),
+			},
+			expected: &Suggestions{
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: map[route.Vertex]Reason{
+					peer1: ReasonSwapFee,
+				},
+			},
+		},
+		{
+			name: "old history",
+			loopIns: []*loopdb.LoopIn{
+				newLoopIn(
+					10000, 3000,
+					testTime.Add(loopInFeeHistory*-2),
+				),
+			},
+			expected: &Suggestions{
+				InSwaps: []loop.LoopInRequest{
+					newSwap(quote.SwapFee),
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{channel}
+
+			cfg.Swaps = &testSwaps{
+				loopIn: testCase.loopIns,
+			}
+
+			cfg.Quotes = &testQuotes{
+				loopInQuote: func(context.Context,
+					*loop.LoopInQuoteRequest) (
+					*loop.LoopInQuote, error) {
+
+					return quote, nil
+				},
+			}
+
+			// We use a high fee limit so that our small swap can
+			// cover the worst case fees of a loop in.
+			params := defaultParameters
+			params.FeeLimit = NewFeePortion(200000)
+			params.PeerRules = map[route.Vertex]*SwapRule{
+				peer1: rule,
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.expected, nil,
+			)
+		})
+	}
+}
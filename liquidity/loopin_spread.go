@@ -0,0 +1,151 @@
+package liquidity
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// loopInSpreadHistory is the period of loop ins that we use to decide how
+// much inbound liquidity we have recently acquired through each last hop.
+const loopInSpreadHistory = time.Hour * 24 * 30
+
+// LoopInSpread determines how we order the loop in swaps that we suggest for
+// different last hops, which decides which of them are dispatched when our
+// in flight limits or budget do not allow all of them.
+type LoopInSpread uint8
+
+const (
+	// LoopInSpreadNone orders loop in swaps by amount, so that the peers
+	// that need the most inbound liquidity are always swapped first.
+	LoopInSpreadNone LoopInSpread = iota
+
+	// LoopInSpreadRoundRobin prioritizes the last hops that we have gone
+	// the longest without looping in through, so that we cycle through
+	// our peers over time.
+	LoopInSpreadRoundRobin
+
+	// LoopInSpreadCapacity prioritizes the last hops whose recent loop
+	// ins are the smallest portion of our capacity with the peer, so that
+	// the inbound liquidity that we acquire is spread across our peers in
+	// proportion to the size of our channels with them.
+	LoopInSpreadCapacity
+)
+
+// errInvalidLoopInSpread is returned when our parameters have an unknown loop
+// in spreading strategy.
+var errInvalidLoopInSpread = errors.New("invalid loop in spread strategy")
+
+// String returns the string representation of a loop in spreading strategy.
+func (l LoopInSpread) String() string {
+	switch l {
+	case LoopInSpreadNone:
+		return "none"
+
+	case LoopInSpreadRoundRobin:
+		return "roundrobin"
+
+	case LoopInSpreadCapacity:
+		return "capacity"
+
+	default:
+		return "unknown"
+	}
+}
+
+// validate checks that a loop in spreading strategy is known.
+func (l LoopInSpread) validate() error {
+	if l > LoopInSpreadCapacity {
+		return errInvalidLoopInSpread
+	}
+
+	return nil
+}
+
+// addLoopIn records a loop in to the last hop provided, so that we can spread
+// our next loop ins across our peers. Failed swaps count towards the last time
+// that we looped in through a peer, but not towards the amount that we have
+// recently looped in, since they did not shift any liquidity.
+func (s *swapTraffic) addLoopIn(lastHop route.Vertex, in *loopdb.LoopIn,
+	cutoff time.Time) {
+
+	initiated := in.Contract.InitiationTime
+	if initiated.After(s.lastLoopIn[lastHop]) {
+		s.lastLoopIn[lastHop] = initiated
+	}
+
+	if initiated.Before(cutoff) ||
+		in.State().State.Type() == loopdb.StateTypeFail {
+
+		return
+	}
+
+	s.loopInVolume[lastHop] += in.Contract.AmountRequested
+}
+
+// loopInShare returns the portion of our capacity with a peer that we have
+// recently looped in through it. Peers that we have no capacity with are
+// given the largest possible share, so that they are not prioritized.
+func (s *swapTraffic) loopInShare(peer route.Vertex,
+	peerChannels map[route.Vertex]*balances) float64 {
+
+	balance, ok := peerChannels[peer]
+	if !ok || balance.capacity == 0 {
+		return math.MaxFloat64
+	}
+
+	return float64(s.loopInVolume[peer]) / float64(balance.capacity)
+}
+
+// spreadLoopIns reorders the loop in suggestions provided according to our
+// spreading strategy. Loop ins only swap places with each other, so that the
+// positions of our other suggestions are unchanged, and loop ins that our
+// strategy does not distinguish between keep their existing order.
+func spreadLoopIns(spread LoopInSpread, suggestions []swapSuggestion,
+	traffic *swapTraffic, peerChannels map[route.Vertex]*balances) {
+
+	var less func(a, b route.Vertex) bool
+	switch spread {
+	case LoopInSpreadRoundRobin:
+		less = func(a, b route.Vertex) bool {
+			return traffic.lastLoopIn[a].Before(
+				traffic.lastLoopIn[b],
+			)
+		}
+
+	case LoopInSpreadCapacity:
+		less = func(a, b route.Vertex) bool {
+			return traffic.loopInShare(a, peerChannels) <
+				traffic.loopInShare(b, peerChannels)
+		}
+
+	default:
+		return
+	}
+
+	var (
+		positions []int
+		loopIns   []*loopInSwapSuggestion
+	)
+	for i, suggestion := range suggestions {
+		loopIn, ok := suggestion.(*loopInSwapSuggestion)
+		if !ok || loopIn.LastHop == nil {
+			continue
+		}
+
+		positions = append(positions, i)
+		loopIns = append(loopIns, loopIn)
+	}
+
+	sort.SliceStable(loopIns, func(i, j int) bool {
+		return less(*loopIns[i].LastHop, *loopIns[j].LastHop)
+	})
+
+	for i, position := range positions {
+		suggestions[position] = loopIns[i]
+	}
+}
@@ -0,0 +1,163 @@
+package liquidity
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAddLoopIn tests tracking of our recent loop ins through each last hop.
+func TestAddLoopIn(t *testing.T) {
+	cutoff := testTime.Add(loopInSpreadHistory * -1)
+
+	// newLoopIn returns a loop in through our peer that was initiated at
+	// the time provided, and is in the state provided if it is non-nil.
+	newLoopIn := func(amount btcutil.Amount, initiated time.Time,
+		state *loopdb.SwapState) *loopdb.LoopIn {
+
+		loopIn := &loopdb.LoopIn{
+			Contract: &loopdb.LoopInContract{
+				SwapContract: loopdb.SwapContract{
+					AmountRequested: amount,
+					InitiationTime:  initiated,
+				},
+				LastHop: &peer1,
+			},
+		}
+
+		if state != nil {
+			loopIn.Events = []*loopdb.LoopEvent{
+				{
+					SwapStateData: loopdb.SwapStateData{
+						State: *state,
+					},
+				},
+			}
+		}
+
+		return loopIn
+	}
+
+	var (
+		success = loopdb.StateSuccess
+		failed  = loopdb.StateFailTimeout
+
+		lastLoopIn = testTime.Add(time.Hour * -1)
+	)
+
+	traffic := newSwapTraffic()
+	for _, loopIn := range []*loopdb.LoopIn{
+		newLoopIn(1000, testTime.Add(time.Hour*-3), &success),
+		newLoopIn(500, testTime.Add(time.Hour*-2), nil),
+		newLoopIn(2000, lastLoopIn, &failed),
+		newLoopIn(4000, cutoff.Add(time.Hour*-1), &success),
+	} {
+		traffic.addLoopIn(peer1, loopIn, cutoff)
+	}
+
+	// Our failed loop in is our most recent loop in through the peer, but
+	// only our recent loop ins that have not failed count towards the
+	// amount that we have looped in.
+	require.Equal(t, lastLoopIn, traffic.lastLoopIn[peer1])
+	require.Equal(t, btcutil.Amount(1500), traffic.loopInVolume[peer1])
+
+	// If we have no capacity with the peer, it has the largest possible
+	// share of our loop ins.
+	require.Equal(t, math.MaxFloat64, traffic.loopInShare(peer1, nil))
+}
+
+// TestSpreadLoopIns tests ordering of our loop in suggestions by each of our
+// spreading strategies.
+func TestSpreadLoopIns(t *testing.T) {
+	peer3 := route.Vertex{3}
+
+	newLoopIn := func(amount btcutil.Amount,
+		lastHop route.Vertex) *loopInSwapSuggestion {
+
+		return &loopInSwapSuggestion{
+			LoopInRequest: loop.LoopInRequest{
+				Amount:  amount,
+				LastHop: &lastHop,
+			},
+		}
+	}
+
+	var (
+		in1 = newLoopIn(3000, peer1)
+		in2 = newLoopIn(2000, peer2)
+		in3 = newLoopIn(1000, peer3)
+		out = &loopOutSwapSuggestion{
+			OutRequest: chan1Rec,
+		}
+
+		// We have looped in through our first peer more recently than
+		// our second peer, and have never looped in through our third
+		// peer.
+		traffic = &swapTraffic{
+			lastLoopIn: map[route.Vertex]time.Time{
+				peer1: testTime.Add(time.Hour * -1),
+				peer2: testTime.Add(time.Hour * -2),
+			},
+			loopInVolume: map[route.Vertex]btcutil.Amount{
+				peer1: 1000,
+				peer2: 2000,
+			},
+		}
+
+		// Our recent loop ins through our first peer are a smaller
+		// portion of our capacity with it than those of our second
+		// peer, and we have not looped in through our third peer.
+		peerChannels = map[route.Vertex]*balances{
+			peer1: {capacity: 100000},
+			peer2: {capacity: 10000},
+			peer3: {capacity: 1000000},
+		}
+	)
+
+	tests := []struct {
+		name     string
+		spread   LoopInSpread
+		expected []swapSuggestion
+	}{
+		{
+			name:   "no spreading",
+			spread: LoopInSpreadNone,
+			expected: []swapSuggestion{
+				in1, out, in2, in3,
+			},
+		},
+		{
+			name:   "round robin",
+			spread: LoopInSpreadRoundRobin,
+			expected: []swapSuggestion{
+				in3, out, in2, in1,
+			},
+		},
+		{
+			name:   "capacity",
+			spread: LoopInSpreadCapacity,
+			expected: []swapSuggestion{
+				in3, out, in1, in2,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			suggestions := []swapSuggestion{in1, out, in2, in3}
+			spreadLoopIns(
+				testCase.spread, suggestions, traffic,
+				peerChannels,
+			)
+			require.Equal(t, testCase.expected, suggestions)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package liquidity
 import (
 	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/swap"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
 )
@@ -16,6 +17,12 @@ var _ swapSuggestion = (*loopOutSwapSuggestion)(nil)
 // swap.
 type loopOutSwapSuggestion struct {
 	loop.OutRequest
+
+	// sweepDest is the sweep destination of the rule that suggested the
+	// swap, if any. We only derive the swap's sweep address from it when
+	// the swap is dispatched, so that swaps that are never dispatched do
+	// not use up addresses.
+	sweepDest *SweepDestination
 }
 
 // amount returns the amount being swapped.
@@ -23,6 +30,11 @@ func (l *loopOutSwapSuggestion) amount() btcutil.Amount {
 	return l.Amount
 }
 
+// swapType returns the type of the swap.
+func (l *loopOutSwapSuggestion) swapType() swap.Type {
+	return swap.TypeOut
+}
+
 // fees returns the maximum fees we could possibly pay for this swap.
 func (l *loopOutSwapSuggestion) fees() btcutil.Amount {
 	return worstCaseOutFees(
@@ -31,6 +43,11 @@ func (l *loopOutSwapSuggestion) fees() btcutil.Amount {
 	)
 }
 
+// vbytes returns the estimated size of the loop out swap's sweep.
+func (l *loopOutSwapSuggestion) vbytes() uint64 {
+	return loopOutVBytes
+}
+
 // channels returns the set of channels the loop out swap is restricted to.
 func (l *loopOutSwapSuggestion) channels() []lnwire.ShortChannelID {
 	channels := make([]lnwire.ShortChannelID, len(l.OutgoingChanSet))
@@ -40,7 +40,7 @@ func (b *loopOutBuilder) swapType() swap.Type {
 // For loop out, we check whether the fees required for our on-chain sweep
 // transaction exceed our fee limits.
 func (b *loopOutBuilder) maySwap(ctx context.Context, params Parameters) error {
-	estimate, err := b.cfg.Lnd.WalletKit.EstimateFee(
+	estimate, err := b.cfg.Wallet.EstimateFee(
 		ctx, params.SweepConfTarget,
 	)
 	if err != nil {
@@ -95,11 +95,15 @@ func (b *loopOutBuilder) buildSwap(ctx context.Context, pubkey route.Vertex,
 	channels []lnwire.ShortChannelID, amount btcutil.Amount,
 	autoloop bool, params Parameters) (swapSuggestion, error) {
 
-	quote, err := b.cfg.LoopOutQuote(
+	// We allow the server to wait until our publication deadline before
+	// publishing our htlc, which lowers the swap fee that it quotes.
+	deadline := b.cfg.Clock.Now().Add(params.PublicationDeadline)
+
+	quote, err := b.cfg.Quotes.LoopOutQuote(
 		ctx, &loop.LoopOutQuoteRequest{
 			Amount:                  amount,
 			SweepConfTarget:         params.SweepConfTarget,
-			SwapPublicationDeadline: b.cfg.Clock.Now(),
+			SwapPublicationDeadline: deadline,
 		},
 	)
 	if err != nil {
@@ -144,12 +148,21 @@ func (b *loopOutBuilder) buildSwap(ctx context.Context, pubkey route.Vertex,
 		MaxPrepayAmount:     quote.PrepayAmount,
 		SweepConfTarget:     params.SweepConfTarget,
 		Initiator:           autoloopSwapInitiator,
+		SwapPaymentDest:     quote.SwapPaymentDest,
+	}
+
+	// If we allow the server to delay publication, our swap must commit
+	// to the same deadline as our quote so that the server honors the
+	// swap fee that it quoted. Otherwise we leave the deadline unset,
+	// which asks the server to publish immediately.
+	if params.PublicationDeadline != 0 {
+		request.SwapPublicationDeadline = deadline
 	}
 
 	if autoloop {
 		request.Label = labels.AutoloopLabel(swap.TypeOut)
 
-		addr, err := b.cfg.Lnd.WalletKit.NextAddr(ctx)
+		addr, err := b.cfg.Wallet.NextAddr(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,66 @@
+package liquidity
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// tickStats tracks the work done in a single autoloop tick.
+type tickStats struct {
+	// quotes is the number of quotes that we requested from the server.
+	// It must be used atomically, because we get quotes concurrently.
+	quotes uint32
+
+	// channels is the number of channels that we evaluated.
+	channels int
+
+	// dispatched is the number of swaps and rebalances that we
+	// dispatched.
+	dispatched int
+
+	// round records the swaps that we decided on in the tick, and whether
+	// we dispatched them. It is nil if we failed before we had a set of
+	// suggestions.
+	round *loopdb.SuggestionRound
+}
+
+// addQuote records that we requested a quote from the server.
+func (t *tickStats) addQuote() {
+	atomic.AddUint32(&t.quotes, 1)
+}
+
+// recordTick logs and stores the metrics of an autoloop tick that started at
+// the time provided. Ticks that did nothing because we have no rules are not
+// recorded.
+func (m *Manager) recordTick(start time.Time, stats *tickStats, tickErr error) {
+	if tickErr == ErrNoRules {
+		return
+	}
+
+	tick := &loopdb.AutoloopTick{
+		Start:             start,
+		Duration:          m.cfg.Clock.Now().Sub(start),
+		ChannelsEvaluated: uint32(stats.channels),
+		QuotesFetched:     atomic.LoadUint32(&stats.quotes),
+		SwapsDispatched:   uint32(stats.dispatched),
+	}
+
+	if tickErr != nil {
+		tick.Error = tickErr.Error()
+	}
+
+	log.Debugf("autoloop tick took %v: %v channels evaluated, %v quotes "+
+		"fetched, %v swaps dispatched", tick.Duration,
+		tick.ChannelsEvaluated, tick.QuotesFetched,
+		tick.SwapsDispatched)
+
+	if m.cfg.Recorder == nil {
+		return
+	}
+
+	if err := m.cfg.Recorder.RecordAutoloopTick(tick); err != nil {
+		log.Errorf("could not record autoloop tick: %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRecordTick tests the metrics that we record for autoloop ticks.
+func TestRecordTick(t *testing.T) {
+	start := testTime.Add(-time.Second * 2)
+
+	tests := []struct {
+		name     string
+		tickErr  error
+		expected *loopdb.AutoloopTick
+	}{
+		{
+			name:    "successful tick",
+			tickErr: nil,
+			expected: &loopdb.AutoloopTick{
+				Start:             start,
+				Duration:          time.Second * 2,
+				ChannelsEvaluated: 3,
+				QuotesFetched:     2,
+				SwapsDispatched:   1,
+			},
+		},
+		{
+			name:    "failed tick",
+			tickErr: errors.New("tick failed"),
+			expected: &loopdb.AutoloopTick{
+				Start:             start,
+				Duration:          time.Second * 2,
+				ChannelsEvaluated: 3,
+				QuotesFetched:     2,
+				SwapsDispatched:   1,
+				Error:             "tick failed",
+			},
+		},
+		{
+			name:     "no rules",
+			tickErr:  ErrNoRules,
+			expected: nil,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := &testRecorder{}
+
+			manager := NewManager(&Config{
+				Clock:    clock.NewTestClock(testTime),
+				Recorder: recorder,
+			})
+
+			stats := &tickStats{
+				channels:   3,
+				dispatched: 1,
+			}
+			stats.addQuote()
+			stats.addQuote()
+
+			manager.recordTick(start, stats, testCase.tickErr)
+
+			var expected []*loopdb.AutoloopTick
+			if testCase.expected != nil {
+				expected = append(expected, testCase.expected)
+			}
+			require.Equal(t, expected, recorder.ticks)
+		})
+	}
+}
+
+// TestAutoloopLndSyncing tests that we skip autoloop ticks without recording
+// them while lnd is not synced to the chain.
+func TestAutoloopLndSyncing(t *testing.T) {
+	recorder := &testRecorder{}
+
+	// Our manager has no channel source or quote source, so any attempt
+	// to actually tick would fail.
+	manager := NewManager(&Config{
+		Clock:    clock.NewTestClock(testTime),
+		Recorder: recorder,
+		Sync:     testSync(false),
+	})
+
+	require.NoError(t, manager.autoloop(context.Background()))
+	require.Empty(t, recorder.ticks)
+}
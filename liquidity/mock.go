@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/swap"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -14,7 +15,7 @@ func newMockConfig() (*mockCfg, *Config) {
 
 	// Create a liquidity config which calls our mock.
 	config := &Config{
-		LoopInQuote: mockCfg.LoopInQuote,
+		Quotes: mockCfg,
 	}
 
 	return mockCfg, config
@@ -24,6 +25,22 @@ type mockCfg struct {
 	mock.Mock
 }
 
+// Restrictions mocks a call to get the server's swap restrictions.
+func (m *mockCfg) Restrictions(ctx context.Context,
+	swapType swap.Type) (*Restrictions, error) {
+
+	args := m.Called(ctx, swapType)
+	return args.Get(0).(*Restrictions), args.Error(1)
+}
+
+// LoopOutQuote mocks a call to get a loop out quote from the server.
+func (m *mockCfg) LoopOutQuote(ctx context.Context,
+	request *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote, error) {
+
+	args := m.Called(ctx, request)
+	return args.Get(0).(*loop.LoopOutQuote), args.Error(1)
+}
+
 // LoopInQuote mocks a call to get a loop in quote from the server.
 func (m *mockCfg) LoopInQuote(ctx context.Context,
 	request *loop.LoopInQuoteRequest) (*loop.LoopInQuote, error) {
@@ -0,0 +1,47 @@
+package liquidity
+
+import (
+	"context"
+
+	"github.com/lightninglabs/loop"
+)
+
+// activeNotices returns the operational notices published by the server that
+// are currently in effect. Failure to fetch notices is not fatal, because
+// notices are informational, so we just log the error.
+func (m *Manager) activeNotices(ctx context.Context) []*loop.ServerNotice {
+	if m.cfg.Notices == nil {
+		return nil
+	}
+
+	notices, err := m.cfg.Notices.ServerNotices(ctx)
+	if err != nil {
+		log.Warnf("could not fetch server notices: %v", err)
+		return nil
+	}
+
+	var (
+		now    = m.cfg.Clock.Now()
+		active []*loop.ServerNotice
+	)
+
+	for _, notice := range notices {
+		if notice.Active(now) {
+			active = append(active, notice)
+		}
+	}
+
+	return active
+}
+
+// maintenanceNotice returns the first maintenance notice in the set provided,
+// or nil if there is none.
+func maintenanceNotice(notices []*loop.ServerNotice) *loop.ServerNotice {
+	for _, notice := range notices {
+		if notice.Category == loop.NoticeMaintenance {
+			return notice
+		}
+	}
+
+	return nil
+}
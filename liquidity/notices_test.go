@@ -0,0 +1,135 @@
+package liquidity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestServerNotices tests that we do not suggest swaps while the server is
+// in maintenance, and that active server notices are attached to our
+// suggestions.
+func TestServerNotices(t *testing.T) {
+	var (
+		feeChange = &loop.ServerNotice{
+			ID:       "fees",
+			Category: loop.NoticeFeeChange,
+			Message:  "fees are changing",
+		}
+
+		maintenance = &loop.ServerNotice{
+			ID:       "maintenance",
+			Category: loop.NoticeMaintenance,
+			Message:  "server in maintenance",
+			Start:    testTime.Add(time.Hour * -1),
+			End:      testTime.Add(time.Hour),
+		}
+
+		pastMaintenance = &loop.ServerNotice{
+			ID:       "past",
+			Category: loop.NoticeMaintenance,
+			Message:  "server was in maintenance",
+			End:      testTime.Add(time.Hour * -1),
+		}
+
+		futureMaintenance = &loop.ServerNotice{
+			ID:       "future",
+			Category: loop.NoticeMaintenance,
+			Message:  "server will be in maintenance",
+			Start:    testTime.Add(time.Hour),
+		}
+
+		errNotices = errors.New("could not fetch notices")
+	)
+
+	tests := []struct {
+		name        string
+		notices     []*loop.ServerNotice
+		err         error
+		suggestions *Suggestions
+	}{
+		{
+			name: "no notices",
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "inactive maintenance",
+			notices: []*loop.ServerNotice{
+				pastMaintenance, futureMaintenance, feeChange,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+				ServerNotices: []*loop.ServerNotice{
+					feeChange,
+				},
+			},
+		},
+		{
+			name: "active maintenance",
+			notices: []*loop.ServerNotice{
+				feeChange, maintenance,
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonServerMaintenance,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+				ServerNotices: []*loop.ServerNotice{
+					feeChange, maintenance,
+				},
+			},
+		},
+		{
+			name: "notices unavailable",
+			err:  errNotices,
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			cfg.Notices = &testNotices{
+				notices: testCase.notices,
+				err:     testCase.err,
+			}
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1,
+			}
+
+			params := defaultParameters
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
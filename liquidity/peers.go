@@ -0,0 +1,67 @@
+package liquidity
+
+import (
+	"context"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// connectedPeers is the set of peers that our node is currently connected to.
+// A nil set indicates that we do not know which of our peers are connected,
+// in which case we treat all of them as online.
+type connectedPeers map[route.Vertex]struct{}
+
+// offline returns a boolean indicating whether all of the peers provided are
+// offline.
+func (c connectedPeers) offline(peers ...route.Vertex) bool {
+	if c == nil || len(peers) == 0 {
+		return false
+	}
+
+	for _, peer := range peers {
+		if _, ok := c[peer]; ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// connectedPeers returns the set of peers that we are currently connected to,
+// or nil if we do not have a source of peers.
+func (m *Manager) connectedPeers(ctx context.Context) (connectedPeers,
+	error) {
+
+	if m.cfg.Peers == nil {
+		return nil, nil
+	}
+
+	peers, err := m.cfg.Peers.ListPeers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	connected := make(connectedPeers, len(peers))
+	for _, peer := range peers {
+		connected[peer.Pubkey] = struct{}{}
+	}
+
+	return connected, nil
+}
+
+// groupPeers returns the peers of the channels in the balance provided.
+func groupPeers(balance *balances,
+	channelPeers map[uint64]route.Vertex) []route.Vertex {
+
+	peers := make([]route.Vertex, 0, len(balance.channels))
+	for _, channel := range balance.channels {
+		peer, ok := channelPeers[channel.ToUint64()]
+		if !ok {
+			continue
+		}
+
+		peers = append(peers, peer)
+	}
+
+	return peers
+}
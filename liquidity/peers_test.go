@@ -0,0 +1,110 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// testPeers is a peer source that reports a fixed set of connected peers.
+type testPeers struct {
+	connected []route.Vertex
+}
+
+// ListPeers returns our fixed set of connected peers.
+func (t *testPeers) ListPeers(_ context.Context) ([]lndclient.Peer, error) {
+	peers := make([]lndclient.Peer, len(t.connected))
+	for i, peer := range t.connected {
+		peers[i] = lndclient.Peer{
+			Pubkey: peer,
+		}
+	}
+
+	return peers, nil
+}
+
+// TestPeerOffline tests that we do not suggest swaps that route through
+// offline peers.
+func TestPeerOffline(t *testing.T) {
+	tests := []struct {
+		name        string
+		peers       PeerSource
+		rules       map[lnwire.ShortChannelID]*SwapRule
+		peerRules   map[route.Vertex]*SwapRule
+		suggestions *Suggestions
+	}{
+		{
+			name: "peer status unknown",
+			rules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+				chanID2: chanRule,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name: "channel peer offline",
+			peers: &testPeers{
+				connected: []route.Vertex{peer1},
+			},
+			rules: map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+				chanID2: chanRule,
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonPeerOffline,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:  "rule peer offline",
+			peers: &testPeers{},
+			peerRules: map[route.Vertex]*SwapRule{
+				peer1: chanRule,
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: map[route.Vertex]Reason{
+					peer1: ReasonPeerOffline,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			cfg.Peers = testCase.peers
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			params := defaultParameters
+			params.ChannelRules = testCase.rules
+			params.PeerRules = testCase.peerRules
+			params.MaxAutoInFlight = 2
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package liquidity
+
+import (
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// pendingSwap is a swap that is currently in flight.
+type pendingSwap struct {
+	// hash is the swap's hash, which is also the payment hash of its
+	// off-chain payment.
+	hash lntypes.Hash
+
+	// amount is the amount that the swap shifts.
+	amount btcutil.Amount
+}
+
+// unpaidLoopOut returns the total amount of our pending loop outs that is not
+// yet reflected in the local balances of the channels provided. lnd removes
+// the amount of an outgoing htlc from our local balance while it is in
+// flight, so a loop out whose payment is in flight has already been taken
+// into account. Loop outs that have not been paid yet, for example because
+// we restarted mid-swap or their payment is being retried, still hold their
+// amount in our local balance, so we need to subtract it ourselves to avoid
+// dispatching another swap for the same balance.
+func (s *swapTraffic) unpaidLoopOut(
+	channels []lndclient.ChannelInfo) btcutil.Amount {
+
+	if len(s.pendingLoopOut) == 0 {
+		return 0
+	}
+
+	// Sum the outgoing htlcs for each payment hash. Swap payments may be
+	// split into several parts, so we may only have part of a swap's
+	// amount in flight.
+	inFlight := make(map[lntypes.Hash]btcutil.Amount)
+	for _, channel := range channels {
+		for _, htlc := range channel.PendingHtlcs {
+			if htlc.Incoming {
+				continue
+			}
+
+			inFlight[htlc.Hash] += htlc.Amount
+		}
+	}
+
+	var unpaid btcutil.Amount
+	for _, pending := range s.pendingLoopOut {
+		// Our htlcs include the routing fees that we pay, so they may
+		// exceed the swap amount.
+		remaining := pending.amount - inFlight[pending.hash]
+		if remaining > 0 {
+			unpaid += remaining
+		}
+	}
+
+	return unpaid
+}
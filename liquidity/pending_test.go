@@ -0,0 +1,98 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUnpaidLoopOut tests calculation of the amount of our pending loop outs
+// that is not reflected in our channel balances.
+func TestUnpaidLoopOut(t *testing.T) {
+	var (
+		hash1 = lntypes.Hash{1}
+		hash2 = lntypes.Hash{2}
+	)
+
+	tests := []struct {
+		name     string
+		pending  []pendingSwap
+		htlcs    []lndclient.PendingHtlc
+		expected btcutil.Amount
+	}{
+		{
+			name:     "no pending swaps",
+			expected: 0,
+		},
+		{
+			name: "not paid",
+			pending: []pendingSwap{
+				{hash: hash1, amount: 5000},
+				{hash: hash2, amount: 3000},
+			},
+			expected: 8000,
+		},
+		{
+			// Our first swap's htlc includes routing fees, so it
+			// is fully in flight.
+			name: "paid",
+			pending: []pendingSwap{
+				{hash: hash1, amount: 5000},
+				{hash: hash2, amount: 3000},
+			},
+			htlcs: []lndclient.PendingHtlc{
+				{Hash: hash1, Amount: 5010},
+			},
+			expected: 3000,
+		},
+		{
+			name: "partially paid",
+			pending: []pendingSwap{
+				{hash: hash1, amount: 5000},
+			},
+			htlcs: []lndclient.PendingHtlc{
+				{Hash: hash1, Amount: 2000},
+			},
+			expected: 3000,
+		},
+		{
+			// Incoming htlcs do not reduce our local balance.
+			name: "incoming htlc",
+			pending: []pendingSwap{
+				{hash: hash1, amount: 5000},
+			},
+			htlcs: []lndclient.PendingHtlc{
+				{Hash: hash1, Amount: 5000, Incoming: true},
+			},
+			expected: 5000,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			traffic := newSwapTraffic()
+			traffic.pendingLoopOut = testCase.pending
+
+			// We split our htlcs over two channels to test that
+			// we sum them across all of our channels.
+			channels := []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+			for i, htlc := range testCase.htlcs {
+				channels[i%2].PendingHtlcs = append(
+					channels[i%2].PendingHtlcs, htlc,
+				)
+			}
+
+			require.Equal(
+				t, testCase.expected,
+				traffic.unpaidLoopOut(channels),
+			)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package liquidity
+
+import (
+	"context"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// preSwapRebalanceTimeout is the amount of time that we allow our pre-swap
+// rebalance hook to rebalance a channel before we fall back to the swap.
+const preSwapRebalanceTimeout = time.Minute * 2
+
+// PreSwapRebalanceRequest describes a loop out that we are about to dispatch,
+// which a pre-swap rebalance hook may replace with an off-chain circular
+// rebalance.
+type PreSwapRebalanceRequest struct {
+	// Amount is the amount of outgoing liquidity that the loop out would
+	// shift out of its channels.
+	Amount btcutil.Amount
+
+	// Channels is the set of channels that the loop out is restricted to.
+	Channels []lnwire.ShortChannelID
+
+	// MaxFee is the most that the loop out could cost us. A rebalance that
+	// costs more than this is more expensive than the swap, so hooks
+	// should not pay more.
+	MaxFee btcutil.Amount
+}
+
+// PreSwapRebalanceResult is the outcome of a pre-swap rebalance.
+type PreSwapRebalanceResult struct {
+	// Rebalanced indicates whether the full amount of the request was
+	// shifted out of its channels, in which case we do not dispatch the
+	// loop out.
+	Rebalanced bool
+
+	// Fee is the routing fee that was paid for the rebalance.
+	Fee btcutil.Amount
+}
+
+// rebalanceBeforeSwap gives our pre-swap rebalance hook the chance to shift
+// the liquidity of a loop out off-chain. It returns a boolean indicating
+// whether the hook rebalanced the swap's channels, in which case the swap
+// should not be dispatched. Hooks that fail or decline to rebalance fall back
+// to the swap.
+func (m *Manager) rebalanceBeforeSwap(ctx context.Context,
+	out *loop.OutRequest) bool {
+
+	if m.cfg.PreSwapRebalancer == nil {
+		return false
+	}
+
+	request := &PreSwapRebalanceRequest{
+		Amount: out.Amount,
+		MaxFee: worstCaseOutFees(
+			out.MaxPrepayRoutingFee, out.MaxSwapRoutingFee,
+			out.MaxSwapFee, out.MaxMinerFee, out.MaxPrepayAmount,
+		),
+	}
+
+	for _, channel := range out.OutgoingChanSet {
+		request.Channels = append(
+			request.Channels, lnwire.NewShortChanIDFromInt(channel),
+		)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, preSwapRebalanceTimeout)
+	defer cancel()
+
+	result, err := m.cfg.PreSwapRebalancer.PreSwapRebalance(ctx, request)
+	if err != nil {
+		log.Warnf("pre-swap rebalance of %v over %v failed, falling "+
+			"back to loop out: %v", out.Amount, out.OutgoingChanSet,
+			err)
+
+		return false
+	}
+
+	if !result.Rebalanced {
+		log.Debugf("pre-swap rebalance of %v over %v declined, "+
+			"falling back to loop out", out.Amount,
+			out.OutgoingChanSet)
+
+		return false
+	}
+
+	// The hook has already shifted our liquidity, so we skip the swap
+	// even if the rebalance cost more than it should have.
+	if result.Fee > request.MaxFee {
+		log.Warnf("pre-swap rebalance fee %v exceeds loop out cost %v",
+			result.Fee, request.MaxFee)
+	}
+
+	log.Infof("pre-swap rebalance of %v over %v replaced loop out: fee %v",
+		out.Amount, out.OutgoingChanSet, result.Fee)
+
+	return true
+}
@@ -0,0 +1,120 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPreSwapRebalance tests that loop outs are only dispatched if our
+// pre-swap rebalance hook does not rebalance their channels.
+func TestPreSwapRebalance(t *testing.T) {
+	errHook := errors.New("rebalance failed")
+
+	tests := []struct {
+		name       string
+		result     *PreSwapRebalanceResult
+		err        error
+		dispatched []loopdb.ChannelSet
+	}{
+		{
+			name:   "rebalanced",
+			result: &PreSwapRebalanceResult{Rebalanced: true},
+		},
+		{
+			name:   "declined",
+			result: &PreSwapRebalanceResult{},
+			dispatched: []loopdb.ChannelSet{
+				{chanID1.ToUint64()},
+			},
+		},
+		{
+			name: "hook failed",
+			err:  errHook,
+			dispatched: []loopdb.ChannelSet{
+				{chanID1.ToUint64()},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1,
+			}
+
+			var requests []*PreSwapRebalanceRequest
+			cfg.PreSwapRebalancer = &testPreSwapRebalancer{
+				rebalance: func(_ context.Context,
+					req *PreSwapRebalanceRequest) (
+					*PreSwapRebalanceResult, error) {
+
+					requests = append(requests, req)
+					return testCase.result, testCase.err
+				},
+			}
+
+			var dispatched []loopdb.ChannelSet
+			cfg.Dispatcher = &testDispatcher{
+				loopOut: func(_ context.Context,
+					req *loop.OutRequest) (
+					*loop.LoopOutSwapInfo, error) {
+
+					dispatched = append(
+						dispatched, req.OutgoingChanSet,
+					)
+
+					return &loop.LoopOutSwapInfo{
+						SwapHash: lntypes.Hash{1},
+					}, nil
+				},
+			}
+
+			params := defaultParameters
+			params.Autoloop = true
+			params.AutoFeeBudget = btcutil.SatoshiPerBitcoin
+			params.AutoFeeStartDate = testTime.Add(-time.Hour)
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			}
+
+			manager := NewManager(cfg)
+			ctx := context.Background()
+
+			err := manager.SetParameters(ctx, params)
+			require.NoError(t, err)
+
+			err = manager.autoloop(ctx)
+			require.NoError(t, err)
+			require.Equal(t, testCase.dispatched, dispatched)
+
+			// Our hook should be offered the swap that we would
+			// have dispatched, limited to its worst case cost.
+			require.Equal(t, []*PreSwapRebalanceRequest{{
+				Amount: chan1Rec.Amount,
+				Channels: []lnwire.ShortChannelID{
+					chanID1,
+				},
+				MaxFee: worstCaseOutFees(
+					chan1Rec.MaxPrepayRoutingFee,
+					chan1Rec.MaxSwapRoutingFee,
+					chan1Rec.MaxSwapFee,
+					chan1Rec.MaxMinerFee,
+					chan1Rec.MaxPrepayAmount,
+				),
+			}}, requests)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+)
+
+// FeeBreakdown is the full set of fees that a fee limit derives for a loop out
+// swap from the server's quote. It mirrors the values that autoloop would set
+// on a swap request, so that users can review them before a swap is executed.
+type FeeBreakdown struct {
+	// Amount is the swap amount that the fees were derived for.
+	Amount btcutil.Amount
+
+	// SwapFee is the server fee quoted for the swap.
+	SwapFee btcutil.Amount
+
+	// PrepayAmount is the no-show fee quoted for the swap.
+	PrepayAmount btcutil.Amount
+
+	// MaxMinerFee is the maximum miner fee we would allow for the swap's
+	// sweep.
+	MaxMinerFee btcutil.Amount
+
+	// MaxSwapRoutingFee is the maximum off-chain routing fee we would pay
+	// for the swap invoice.
+	MaxSwapRoutingFee btcutil.Amount
+
+	// MaxPrepayRoutingFee is the maximum off-chain routing fee we would
+	// pay for the prepay invoice.
+	MaxPrepayRoutingFee btcutil.Amount
+
+	// WorstCaseFees is the largest amount of fees that the swap could
+	// cost, which is the amount that autoloop reserves from its budget.
+	WorstCaseFees btcutil.Amount
+
+	// Reason is set if the quote is not within our fee limits, in which
+	// case the fees that we derive from our limits are not set. It is
+	// ReasonNone if the swap would be permitted.
+	Reason Reason
+}
+
+// previewLoopOut validates a loop out quote against the fee limit provided
+// and derives the full set of fees that the limit would set for the swap.
+func previewLoopOut(limit FeeLimit, amount btcutil.Amount,
+	quote *loop.LoopOutQuote) (*FeeBreakdown, error) {
+
+	breakdown := &FeeBreakdown{
+		Amount:       amount,
+		SwapFee:      quote.SwapFee,
+		PrepayAmount: quote.PrepayAmount,
+	}
+
+	// If the quote is not within our limits, we just return the reason.
+	// We do not derive our other fees, because our fee limits assume that
+	// they are only calculated for valid quotes.
+	err := limit.loopOutLimits(amount, quote)
+	var reasonErr *reasonError
+	if errors.As(err, &reasonErr) {
+		breakdown.Reason = reasonErr.reason
+		return breakdown, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	prepay, route, miner := limit.loopOutFees(amount, quote)
+
+	breakdown.MaxPrepayRoutingFee = prepay
+	breakdown.MaxSwapRoutingFee = route
+	breakdown.MaxMinerFee = miner
+	breakdown.WorstCaseFees = worstCaseOutFees(
+		prepay, route, quote.SwapFee, miner, quote.PrepayAmount,
+	)
+
+	return breakdown, nil
+}
+
+// PreviewFees gets a loop out quote for the amount provided and returns the
+// breakdown of fees that our current fee limit would set for a swap of that
+// amount.
+func (m *Manager) PreviewFees(ctx context.Context, amount btcutil.Amount) (
+	*FeeBreakdown, error) {
+
+	m.paramsLock.Lock()
+	params := m.params
+	m.paramsLock.Unlock()
+
+	if amount <= 0 {
+		return nil, errors.New("amount must be > 0")
+	}
+
+	deadline := m.cfg.Clock.Now().Add(params.PublicationDeadline)
+
+	quote, err := m.cfg.Quotes.LoopOutQuote(
+		ctx, &loop.LoopOutQuoteRequest{
+			Amount:                  amount,
+			SweepConfTarget:         params.SweepConfTarget,
+			SwapPublicationDeadline: deadline,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return params.FeeLimit.previewLoopOut(amount, quote)
+}
@@ -0,0 +1,117 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPreviewFees tests the fee breakdowns that we preview for our different
+// fee limits, and that they match the fees we set on suggested swaps.
+func TestPreviewFees(t *testing.T) {
+	categories := defaultFeeCategoryLimit()
+	catPrepay, catRoute, catMiner := categories.loopOutFees(
+		chan1Rec.Amount, testQuote,
+	)
+
+	tests := []struct {
+		name     string
+		feeLimit FeeLimit
+		quote    *loop.LoopOutQuote
+		expected *FeeBreakdown
+	}{
+		{
+			name:     "fee portion",
+			feeLimit: defaultFeePortion(),
+			quote:    testQuote,
+			expected: &FeeBreakdown{
+				Amount:              chan1Rec.Amount,
+				SwapFee:             testQuote.SwapFee,
+				PrepayAmount:        testQuote.PrepayAmount,
+				MaxMinerFee:         chan1Rec.MaxMinerFee,
+				MaxSwapRoutingFee:   chan1Rec.MaxSwapRoutingFee,
+				MaxPrepayRoutingFee: chan1Rec.MaxPrepayRoutingFee,
+				WorstCaseFees: worstCaseOutFees(
+					chan1Rec.MaxPrepayRoutingFee,
+					chan1Rec.MaxSwapRoutingFee,
+					testQuote.SwapFee, chan1Rec.MaxMinerFee,
+					testQuote.PrepayAmount,
+				),
+			},
+		},
+		{
+			name:     "fee categories",
+			feeLimit: categories,
+			quote:    testQuote,
+			expected: &FeeBreakdown{
+				Amount:              chan1Rec.Amount,
+				SwapFee:             testQuote.SwapFee,
+				PrepayAmount:        testQuote.PrepayAmount,
+				MaxMinerFee:         catMiner,
+				MaxSwapRoutingFee:   catRoute,
+				MaxPrepayRoutingFee: catPrepay,
+				WorstCaseFees: worstCaseOutFees(
+					catPrepay, catRoute, testQuote.SwapFee,
+					catMiner, testQuote.PrepayAmount,
+				),
+			},
+		},
+		{
+			name:     "swap fee too high",
+			feeLimit: defaultFeePortion(),
+			quote: &loop.LoopOutQuote{
+				SwapFee:      chan1Rec.Amount,
+				PrepayAmount: testQuote.PrepayAmount,
+				MinerFee:     testQuote.MinerFee,
+			},
+			expected: &FeeBreakdown{
+				Amount:       chan1Rec.Amount,
+				SwapFee:      chan1Rec.Amount,
+				PrepayAmount: testQuote.PrepayAmount,
+				Reason:       ReasonSwapFee,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, _ := newTestConfig()
+			cfg.Quotes = &testQuotes{
+				loopOutQuote: func(_ context.Context,
+					req *loop.LoopOutQuoteRequest) (
+					*loop.LoopOutQuote, error) {
+
+					require.Equal(
+						t, chan1Rec.Amount, req.Amount,
+					)
+
+					return testCase.quote, nil
+				},
+			}
+
+			manager := NewManager(cfg)
+
+			params := manager.GetParameters()
+			params.FeeLimit = testCase.feeLimit
+			manager.params = params
+
+			fees, err := manager.PreviewFees(
+				context.Background(), chan1Rec.Amount,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, fees)
+		})
+	}
+
+	// Finally, check that we fail on invalid amounts.
+	cfg, _ := newTestConfig()
+	_, err := NewManager(cfg).PreviewFees(
+		context.Background(), btcutil.Amount(0),
+	)
+	require.Error(t, err)
+}
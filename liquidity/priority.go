@@ -0,0 +1,162 @@
+package liquidity
+
+import (
+	"sort"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// SwapScore describes the priority that we assigned to a suggested swap
+// because our fee budget could not cover all of our suggestions.
+type SwapScore struct {
+	// Type is the type of the swap.
+	Type swap.Type
+
+	// Amount is the amount of the swap.
+	Amount btcutil.Amount
+
+	// Channels is the set of channels that the swap was scored on. For
+	// swaps that are restricted to a peer rather than a set of channels,
+	// this is all of our channels with that peer.
+	Channels []lnwire.ShortChannelID
+
+	// Volume is the total amount that has been sent and received over the
+	// swap's channels.
+	Volume btcutil.Amount
+
+	// Capacity is the total capacity of the swap's channels.
+	Capacity btcutil.Amount
+
+	// Efficiency is the amount that the swap shifts per satoshi of the
+	// maximum fees that it may pay.
+	Efficiency float64
+
+	// Score is the swap's priority, between 0 and 1. The swap's volume,
+	// capacity and efficiency are each scored relative to the highest
+	// value among our suggestions, and weighted equally.
+	Score float64
+
+	// Selected indicates whether the swap was suggested.
+	Selected bool
+}
+
+// scoreSuggestions scores each of the suggestions provided by the traffic
+// over its channels, the size of its channels and its cost efficiency. It
+// returns the suggestions and their scores sorted in descending order of
+// score. Suggestions with equal scores keep the order that they were provided
+// in.
+func scoreSuggestions(suggestions []swapSuggestion,
+	channels []lndclient.ChannelInfo,
+	peerChannels map[route.Vertex]*balances,
+	channelPeers map[uint64]route.Vertex) ([]swapSuggestion, []*SwapScore) {
+
+	chanInfo := make(map[lnwire.ShortChannelID]lndclient.ChannelInfo)
+	for _, channel := range channels {
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		chanInfo[chanID] = channel
+	}
+
+	var (
+		scores        = make([]*SwapScore, len(suggestions))
+		maxVolume     btcutil.Amount
+		maxCapacity   btcutil.Amount
+		maxEfficiency float64
+	)
+
+	for i, suggestion := range suggestions {
+		score := &SwapScore{
+			Type:     suggestion.swapType(),
+			Amount:   suggestion.amount(),
+			Channels: suggestion.channels(),
+		}
+
+		if len(score.Channels) == 0 {
+			for _, peer := range suggestion.peers(channelPeers) {
+				balance, ok := peerChannels[peer]
+				if !ok {
+					continue
+				}
+
+				score.Channels = append(
+					score.Channels, balance.channels...,
+				)
+			}
+		}
+
+		for _, channel := range score.Channels {
+			info := chanInfo[channel]
+			score.Volume += info.TotalSent + info.TotalReceived
+			score.Capacity += info.Capacity
+		}
+
+		if fees := suggestion.fees(); fees > 0 {
+			score.Efficiency = float64(score.Amount) / float64(fees)
+		}
+
+		if score.Volume > maxVolume {
+			maxVolume = score.Volume
+		}
+
+		if score.Capacity > maxCapacity {
+			maxCapacity = score.Capacity
+		}
+
+		if score.Efficiency > maxEfficiency {
+			maxEfficiency = score.Efficiency
+		}
+
+		scores[i] = score
+	}
+
+	// relative is a helper that scores a value relative to the highest
+	// value among our suggestions.
+	relative := func(value, max float64) float64 {
+		if max == 0 {
+			return 0
+		}
+
+		return value / max
+	}
+
+	for _, score := range scores {
+		score.Score = (relative(
+			float64(score.Volume), float64(maxVolume),
+		) + relative(
+			float64(score.Capacity), float64(maxCapacity),
+		) + relative(score.Efficiency, maxEfficiency)) / 3
+	}
+
+	order := make([]int, len(suggestions))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return scores[order[i]].Score > scores[order[j]].Score
+	})
+
+	var (
+		sortedSuggestions = make([]swapSuggestion, len(suggestions))
+		sortedScores      = make([]*SwapScore, len(scores))
+	)
+	for i, index := range order {
+		sortedSuggestions[i] = suggestions[index]
+		sortedScores[i] = scores[index]
+	}
+
+	return sortedSuggestions, sortedScores
+}
+
+// totalFees returns the sum of the maximum fees of the suggestions provided.
+func totalFees(suggestions []swapSuggestion) btcutil.Amount {
+	var total btcutil.Amount
+	for _, suggestion := range suggestions {
+		total += suggestion.fees()
+	}
+
+	return total
+}
@@ -0,0 +1,76 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScoreSuggestions tests prioritization of our suggestions by the
+// traffic over their channels, their channels' capacity and their cost
+// efficiency.
+func TestScoreSuggestions(t *testing.T) {
+	// Our second channel has forwarded payments, so the loop in with its
+	// peer should be prioritized over our larger loop out.
+	busyChannel := channel2
+	busyChannel.TotalSent = 20000
+	busyChannel.TotalReceived = 5000
+
+	loopIn := &loopInSwapSuggestion{
+		LoopInRequest: loop.LoopInRequest{
+			Amount:      5000,
+			MaxSwapFee:  50,
+			MaxMinerFee: 50,
+			LastHop:     &peer2,
+		},
+	}
+
+	loopOut := &loopOutSwapSuggestion{
+		OutRequest: chan1Rec,
+	}
+
+	var (
+		channels = []lndclient.ChannelInfo{
+			channel1, busyChannel,
+		}
+
+		channelPeers = map[uint64]route.Vertex{
+			chanID1.ToUint64(): peer1,
+			chanID2.ToUint64(): peer2,
+		}
+
+		peerChannels = map[route.Vertex]*balances{
+			peer1: newBalances(channel1),
+			peer2: newBalances(busyChannel),
+		}
+	)
+
+	sorted, scores := scoreSuggestions(
+		[]swapSuggestion{loopOut, loopIn}, channels, peerChannels,
+		channelPeers,
+	)
+	require.Equal(t, []swapSuggestion{loopIn, loopOut}, sorted)
+	require.Len(t, scores, 2)
+
+	// Our loop in is scored on the channel with its peer.
+	inScore := scores[0]
+	require.Equal(t, swap.TypeIn, inScore.Type)
+	require.Equal(t, []lnwire.ShortChannelID{chanID2}, inScore.Channels)
+	require.EqualValues(t, 25000, inScore.Volume)
+	require.Equal(t, busyChannel.Capacity, inScore.Capacity)
+	require.Equal(t, float64(5000)/float64(loopIn.fees()),
+		inScore.Efficiency)
+
+	outScore := scores[1]
+	require.Equal(t, swap.TypeOut, outScore.Type)
+	require.Equal(t, []lnwire.ShortChannelID{chanID1}, outScore.Channels)
+	require.Zero(t, outScore.Volume)
+
+	require.Greater(t, inScore.Score, outScore.Score)
+	require.LessOrEqual(t, inScore.Score, 1.0)
+}
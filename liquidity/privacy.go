@@ -0,0 +1,76 @@
+package liquidity
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// maxAmountJitter is the largest percentage of a suggested swap amount that
+// we allow to be randomly removed from it.
+const maxAmountJitter = 50
+
+var (
+	// ErrNegativeAmountBucket is returned if a negative amount bucket is
+	// set.
+	ErrNegativeAmountBucket = errors.New("amount bucket must be >= 0")
+
+	// ErrInvalidAmountJitter is returned if the amount jitter is not a
+	// percentage in [0, 50].
+	ErrInvalidAmountJitter = errors.New("amount jitter must be a " +
+		"percentage in [0, 50]")
+)
+
+// randomAmount returns a random amount in [0, max] using a cryptographically
+// secure source of randomness. If we fail to read randomness, we do not
+// randomize the amount at all.
+func randomAmount(max btcutil.Amount) btcutil.Amount {
+	if max <= 0 {
+		return 0
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(max)+1))
+	if err != nil {
+		log.Errorf("could not randomize swap amount: %v", err)
+		return 0
+	}
+
+	return btcutil.Amount(n.Int64())
+}
+
+// obfuscateAmount adjusts the amount that we need to swap so that it does not
+// reveal our exact channel balances on chain. If a jitter is set, we first
+// remove a random portion of up to that percentage of the amount, without
+// going below our minimum swap size. If a bucket is set, we then round the
+// amount down to a multiple of the bucket. We only ever reduce the amount, so
+// that we never swap more than our rules require. If rounding takes the
+// amount below our minimum swap size, we fail with ReasonBelowMinimum.
+func (m *Manager) obfuscateAmount(amount btcutil.Amount,
+	restrictions *Restrictions) (btcutil.Amount, error) {
+
+	if m.params.AmountJitter > 0 {
+		jitter := amount * btcutil.Amount(m.params.AmountJitter) / 100
+		if amount-jitter < restrictions.Minimum {
+			jitter = amount - restrictions.Minimum
+		}
+
+		random := m.cfg.RandomAmount
+		if random == nil {
+			random = randomAmount
+		}
+
+		amount -= random(jitter)
+	}
+
+	if m.params.AmountBucket > 0 {
+		amount -= amount % m.params.AmountBucket
+	}
+
+	if amount < restrictions.Minimum {
+		return 0, newReasonError(ReasonBelowMinimum)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,105 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestObfuscateAmount tests rounding and randomization of suggested swap
+// amounts.
+func TestObfuscateAmount(t *testing.T) {
+	restrictions := NewRestrictions(1000, 100000)
+
+	// maxRandom always removes the largest jitter allowed, so that our
+	// tests are deterministic.
+	maxRandom := func(max btcutil.Amount) btcutil.Amount {
+		return max
+	}
+
+	tests := []struct {
+		name     string
+		bucket   btcutil.Amount
+		jitter   uint64
+		amount   btcutil.Amount
+		expected btcutil.Amount
+		err      error
+	}{
+		{
+			name:     "no privacy options",
+			amount:   12345,
+			expected: 12345,
+		},
+		{
+			name:     "rounded down to bucket",
+			bucket:   5000,
+			amount:   12345,
+			expected: 10000,
+		},
+		{
+			name:     "exact bucket multiple",
+			bucket:   5000,
+			amount:   10000,
+			expected: 10000,
+		},
+		{
+			name:   "rounded below minimum",
+			bucket: 5000,
+			amount: 4999,
+			err:    newReasonError(ReasonBelowMinimum),
+		},
+		{
+			name:     "jitter",
+			jitter:   10,
+			amount:   10000,
+			expected: 9000,
+		},
+		{
+			name:     "jitter limited by minimum",
+			jitter:   50,
+			amount:   1500,
+			expected: 1000,
+		},
+		{
+			name:     "jitter then rounded",
+			bucket:   1000,
+			jitter:   10,
+			amount:   12345,
+			expected: 11000,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			m := &Manager{
+				cfg: &Config{
+					RandomAmount: maxRandom,
+				},
+				params: Parameters{
+					AmountBucket: testCase.bucket,
+					AmountJitter: testCase.jitter,
+				},
+			}
+
+			amount, err := m.obfuscateAmount(
+				testCase.amount, restrictions,
+			)
+			require.Equal(t, testCase.err, err)
+			require.Equal(t, testCase.expected, amount)
+		})
+	}
+}
+
+// TestRandomAmount tests that our default source of randomness stays within
+// the bounds requested.
+func TestRandomAmount(t *testing.T) {
+	require.Zero(t, randomAmount(0))
+
+	for i := 0; i < 100; i++ {
+		amount := randomAmount(10)
+		require.True(t, amount >= 0 && amount <= 10)
+	}
+}
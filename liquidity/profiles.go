@@ -0,0 +1,314 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/loop/swap"
+)
+
+const (
+	// maxParamsHistory is the number of parameter changes that we keep in
+	// our audit history.
+	maxParamsHistory = 100
+)
+
+var (
+	// ErrEmptyProfileName is returned when a profile is saved without a
+	// name.
+	ErrEmptyProfileName = errors.New("profile name required")
+
+	// ErrProfileNotFound is returned when a profile that we do not have
+	// saved is referenced.
+	ErrProfileNotFound = errors.New("profile not found")
+
+	// ErrProfileScheduled is returned when we are asked to remove a
+	// profile that our profile schedule switches to.
+	ErrProfileScheduled = errors.New("profile is used by the profile " +
+		"schedule")
+)
+
+// ProfileSwitch schedules a switch to a saved profile. The profile becomes
+// active when its window starts.
+type ProfileSwitch struct {
+	// Profile is the name of the profile that we switch to.
+	Profile string
+
+	// Window is the window in which the profile is active.
+	Window ScheduleWindow
+}
+
+// String returns the string representation of a profile switch.
+func (p ProfileSwitch) String() string {
+	return fmt.Sprintf("%v=%v", p.Profile, p.Window)
+}
+
+// ParamsChangeSource describes what changed our parameters.
+type ParamsChangeSource uint8
+
+const (
+	// ParamsChangeRPC indicates that our parameters were set over rpc.
+	ParamsChangeRPC ParamsChangeSource = iota
+
+	// ParamsChangeProfile indicates that our parameters were set by a
+	// scheduled profile switch.
+	ParamsChangeProfile
+
+	// ParamsChangeDelayedIncrease indicates that an increase to our fee
+	// budget or fee limit took effect once its delay had passed.
+	ParamsChangeDelayedIncrease
+)
+
+// String returns the string representation of a parameters change source.
+func (p ParamsChangeSource) String() string {
+	switch p {
+	case ParamsChangeRPC:
+		return "rpc"
+
+	case ParamsChangeProfile:
+		return "profile"
+
+	case ParamsChangeDelayedIncrease:
+		return "delayed increase"
+
+	default:
+		return "unknown"
+	}
+}
+
+// ParamsChange is an entry in our parameters audit history.
+type ParamsChange struct {
+	// Time is the time that our parameters changed.
+	Time time.Time
+
+	// Source is what changed our parameters.
+	Source ParamsChangeSource
+
+	// Profile is the profile that is active after the change. It is empty
+	// if our parameters were changed directly, because they no longer
+	// match a saved profile.
+	Profile string
+
+	// PreviousProfile is the profile that was active before the change,
+	// if any.
+	PreviousProfile string
+}
+
+// Profiles describes our saved parameter profiles and the schedule that we
+// switch between them on.
+type Profiles struct {
+	// Saved maps the names of our saved profiles to their parameters.
+	Saved map[string]Parameters
+
+	// Schedule is the set of scheduled switches between our profiles.
+	Schedule []ProfileSwitch
+
+	// Active is the name of the profile that our parameters were last set
+	// from, or empty if they have been changed since.
+	Active string
+}
+
+// profileState holds our saved profiles, profile schedule and parameters
+// audit history. It is guarded by our params lock.
+type profileState struct {
+	// saved maps profile names to the parameters they hold.
+	saved map[string]Parameters
+
+	// schedule is our set of scheduled profile switches.
+	schedule []ProfileSwitch
+
+	// active is the profile that our parameters were last set from.
+	active string
+
+	// scheduled is the profile that our schedule selected when we last
+	// checked it. We only switch profiles when the schedule selects a
+	// different profile, so that changes made to our parameters while a
+	// profile is active are kept until the next switch.
+	scheduled string
+
+	// history is our parameters audit history, oldest first.
+	history []ParamsChange
+}
+
+// newProfileState creates an empty profile state.
+func newProfileState() *profileState {
+	return &profileState{
+		saved: make(map[string]Parameters),
+	}
+}
+
+// recordParamsChange adds a change to our parameters audit history and
+// updates our active profile. It must be called with our params lock held.
+func (m *Manager) recordParamsChange(source ParamsChangeSource,
+	profile string) {
+
+	state := m.profiles
+	state.history = append(state.history, ParamsChange{
+		Time:            m.cfg.Clock.Now(),
+		Source:          source,
+		Profile:         profile,
+		PreviousProfile: state.active,
+	})
+
+	if excess := len(state.history) - maxParamsHistory; excess > 0 {
+		state.history = state.history[excess:]
+	}
+
+	state.active = profile
+}
+
+// SaveProfile saves our current parameters as a profile with the name
+// provided, replacing any profile that is already saved with that name.
+func (m *Manager) SaveProfile(name string) error {
+	if name == "" {
+		return ErrEmptyProfileName
+	}
+
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	m.profiles.saved[name] = cloneParameters(m.params)
+
+	return nil
+}
+
+// RemoveProfile removes a saved profile. Profiles that are used by our
+// profile schedule cannot be removed.
+func (m *Manager) RemoveProfile(name string) error {
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	if _, ok := m.profiles.saved[name]; !ok {
+		return ErrProfileNotFound
+	}
+
+	for _, profileSwitch := range m.profiles.schedule {
+		if profileSwitch.Profile == name {
+			return ErrProfileScheduled
+		}
+	}
+
+	delete(m.profiles.saved, name)
+
+	return nil
+}
+
+// GetProfiles returns a copy of our saved profiles and profile schedule.
+func (m *Manager) GetProfiles() Profiles {
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	profiles := Profiles{
+		Saved: make(map[string]Parameters, len(m.profiles.saved)),
+		Schedule: append(
+			[]ProfileSwitch(nil), m.profiles.schedule...,
+		),
+		Active: m.profiles.active,
+	}
+
+	for name, params := range m.profiles.saved {
+		profiles.Saved[name] = cloneParameters(params)
+	}
+
+	return profiles
+}
+
+// SetProfileSchedule replaces our profile schedule. Each switch must refer to
+// a saved profile. If the windows of switches overlap, the switch that is
+// listed first takes precedence. The schedule is checked on each autoloop
+// tick, so the profile that it selects is applied on the next tick.
+func (m *Manager) SetProfileSchedule(schedule []ProfileSwitch) error {
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	for _, profileSwitch := range schedule {
+		if _, ok := m.profiles.saved[profileSwitch.Profile]; !ok {
+			return fmt.Errorf("%w: %v", ErrProfileNotFound,
+				profileSwitch.Profile)
+		}
+
+		if err := profileSwitch.Window.validate(); err != nil {
+			return fmt.Errorf("profile %v window %v: %w",
+				profileSwitch.Profile, profileSwitch.Window,
+				err)
+		}
+	}
+
+	m.profiles.schedule = append([]ProfileSwitch(nil), schedule...)
+
+	// Clear the profile that our previous schedule selected, so that the
+	// new schedule is applied on our next check.
+	m.profiles.scheduled = ""
+
+	return nil
+}
+
+// ParamsHistory returns our parameters audit history, oldest first.
+func (m *Manager) ParamsHistory() []ParamsChange {
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	return append([]ParamsChange(nil), m.profiles.history...)
+}
+
+// scheduledProfile returns the profile that our schedule selects at the time
+// provided, or an empty string if none of our switches' windows contain it.
+func (p *profileState) scheduledProfile(now time.Time) string {
+	for _, profileSwitch := range p.schedule {
+		if profileSwitch.Window.contains(now) {
+			return profileSwitch.Profile
+		}
+	}
+
+	return ""
+}
+
+// switchProfile applies the profile that our schedule selects, if it has
+// changed since we last checked. If none of our windows contain the current
+// time, we keep the parameters that we have.
+func (m *Manager) switchProfile(ctx context.Context) error {
+	m.paramsLock.Lock()
+	profile := m.profiles.scheduledProfile(m.cfg.Clock.Now())
+	if profile == "" || profile == m.profiles.scheduled {
+		m.profiles.scheduled = profile
+		m.paramsLock.Unlock()
+
+		return nil
+	}
+	params := cloneParameters(m.profiles.saved[profile])
+	m.paramsLock.Unlock()
+
+	// Validate the profile against our current channels and restrictions,
+	// which may have changed since it was saved.
+	restrictions, err := m.cfg.Quotes.Restrictions(ctx, swap.TypeOut)
+	if err != nil {
+		return err
+	}
+
+	channels, err := m.cfg.Channels.ListChannels(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = params.validate(
+		m.cfg.MinimumConfirmations, channels, restrictions,
+	)
+	if err != nil {
+		return fmt.Errorf("profile %v: %w", profile, err)
+	}
+
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	log.Infof("Switching liquidity parameters from profile: %v to "+
+		"scheduled profile: %v", m.profiles.active, profile)
+
+	m.params = params
+	m.paramsVersion++
+	m.profiles.scheduled = profile
+	m.recordParamsChange(ParamsChangeProfile, profile)
+
+	return nil
+}
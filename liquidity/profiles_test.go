@@ -0,0 +1,135 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProfiles tests saving parameter profiles and switching between them on
+// a schedule. Our test time is a Thursday.
+func TestProfiles(t *testing.T) {
+	ctx := context.Background()
+
+	cfg, lnd := newTestConfig()
+	lnd.Channels = []lndclient.ChannelInfo{
+		channel1,
+	}
+
+	testClock := clock.NewTestClock(testTime)
+	cfg.Clock = testClock
+
+	manager := NewManager(cfg)
+
+	require.Equal(t, ErrEmptyProfileName, manager.SaveProfile(""))
+
+	// Save an aggressive profile for weekdays, which has a rule for our
+	// channel, and a conservative profile for weekends that has no rules.
+	weekday := manager.GetParameters()
+	weekday.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+	}
+	require.NoError(t, manager.SetParameters(ctx, weekday))
+	require.NoError(t, manager.SaveProfile("weekday"))
+
+	weekend := defaultParameters
+	require.NoError(t, manager.SetParameters(ctx, weekend))
+	require.NoError(t, manager.SaveProfile("weekend"))
+
+	// We cannot schedule switches to profiles that we have not saved.
+	err := manager.SetProfileSchedule([]ProfileSwitch{
+		{Profile: "holiday", Window: ScheduleWindow{End: fullDay}},
+	})
+	require.ErrorIs(t, err, ErrProfileNotFound)
+
+	schedule := []ProfileSwitch{
+		{
+			Profile: "weekday",
+			Window: ScheduleWindow{
+				Days: []time.Weekday{
+					time.Monday, time.Tuesday,
+					time.Wednesday, time.Thursday,
+					time.Friday,
+				},
+				End: fullDay,
+			},
+		},
+		{
+			Profile: "weekend",
+			Window: ScheduleWindow{
+				Days: []time.Weekday{
+					time.Saturday, time.Sunday,
+				},
+				End: fullDay,
+			},
+		},
+	}
+	require.NoError(t, manager.SetProfileSchedule(schedule))
+
+	// Profiles that are scheduled cannot be removed.
+	require.Equal(t, ErrProfileScheduled, manager.RemoveProfile("weekend"))
+	require.Equal(t, ErrProfileNotFound, manager.RemoveProfile("holiday"))
+
+	// We are in our weekday window, so we should switch to our weekday
+	// profile.
+	require.NoError(t, manager.switchProfile(ctx))
+	params := manager.GetParameters()
+	require.Equal(t, weekday.ChannelRules, params.ChannelRules)
+
+	profiles := manager.GetProfiles()
+	require.Equal(t, "weekday", profiles.Active)
+	require.Equal(t, schedule, profiles.Schedule)
+	require.Len(t, profiles.Saved, 2)
+
+	// Change our parameters directly, and assert that we do not switch
+	// back to our weekday profile while its window is still active.
+	require.NoError(t, manager.SetParameters(ctx, weekend))
+	require.NoError(t, manager.switchProfile(ctx))
+	require.Empty(t, manager.GetParameters().ChannelRules)
+	require.Empty(t, manager.GetProfiles().Active)
+
+	// Move on to Saturday, and assert that we switch to our weekend
+	// profile.
+	testClock.SetTime(testTime.Add(time.Hour * 48))
+	require.NoError(t, manager.switchProfile(ctx))
+	require.Empty(t, manager.GetParameters().ChannelRules)
+	require.Equal(t, "weekend", manager.GetProfiles().Active)
+
+	// Our audit history should contain each of our changes.
+	history := manager.ParamsHistory()
+	require.Equal(t, []ParamsChange{
+		{
+			Time:   testTime,
+			Source: ParamsChangeRPC,
+		},
+		{
+			Time:   testTime,
+			Source: ParamsChangeRPC,
+		},
+		{
+			Time:    testTime,
+			Source:  ParamsChangeProfile,
+			Profile: "weekday",
+		},
+		{
+			Time:            testTime,
+			Source:          ParamsChangeRPC,
+			PreviousProfile: "weekday",
+		},
+		{
+			Time:    testTime.Add(time.Hour * 48),
+			Source:  ParamsChangeProfile,
+			Profile: "weekend",
+		},
+	}, history)
+
+	// Once the profile is no longer scheduled, it can be removed.
+	require.NoError(t, manager.SetProfileSchedule(nil))
+	require.NoError(t, manager.RemoveProfile("weekend"))
+	require.Len(t, manager.GetProfiles().Saved, 1)
+}
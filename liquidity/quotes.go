@@ -0,0 +1,136 @@
+package liquidity
+
+import (
+	"context"
+	"sync"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// suggestionJob is a rule that we get a swap suggestion for. Exactly one of
+// peer, channel and group is set, depending on whether it is a peer, channel
+// or channel group rule. If amount is set, it is swapped rather than the
+// amount that the rule requires.
+type suggestionJob struct {
+	peer    route.Vertex
+	channel lnwire.ShortChannelID
+	group   string
+	balance *balances
+	rule    *SwapRule
+	amount  btcutil.Amount
+}
+
+// isChannel returns true if the job is for a channel rule.
+func (s *suggestionJob) isChannel() bool {
+	return s.channel.ToUint64() != 0
+}
+
+// isGroup returns true if the job is for a channel group rule.
+func (s *suggestionJob) isGroup() bool {
+	return s.group != ""
+}
+
+// String returns a string representation of the rule that a job is for.
+func (s *suggestionJob) String() string {
+	switch {
+	case s.isChannel():
+		return "channel " + s.channel.String()
+
+	case s.isGroup():
+		return "group " + s.group
+	}
+
+	return "peer " + s.peer.String()
+}
+
+// suggestionResult is the outcome of a suggestion job.
+type suggestionResult struct {
+	suggestion swapSuggestion
+	err        error
+}
+
+// suggestSwapsConcurrently gets a swap suggestion for each of the jobs
+// provided, using a bounded pool of workers so that the quote requests for
+// nodes with many rules do not run serially. Each suggestion is given its own
+// timeout, and a suggestion that times out is disqualified without failing
+// the others. If our context is canceled, we stop dispatching jobs and the
+// jobs that were not started fail with the context's error. Results are
+// returned in the order of the jobs provided.
+func (m *Manager) suggestSwapsConcurrently(ctx context.Context,
+	traffic *swapTraffic, jobs []*suggestionJob, outRestrictions,
+	inRestrictions *Restrictions, autoloop bool,
+	stats *tickStats) []suggestionResult {
+
+	workers := m.cfg.QuoteWorkers
+	if workers <= 0 {
+		workers = DefaultQuoteWorkers
+	}
+
+	timeout := m.cfg.QuoteTimeout
+	if timeout <= 0 {
+		timeout = DefaultQuoteTimeout
+	}
+
+	var (
+		results = make([]suggestionResult, len(jobs))
+		sem     = make(chan struct{}, workers)
+		wg      sync.WaitGroup
+	)
+
+	for i, job := range jobs {
+		i, job := i, job
+
+		// Wait for a free worker, unless our context is canceled, in
+		// which case we do not start any more jobs.
+		select {
+		case sem <- struct{}{}:
+
+		case <-ctx.Done():
+			results[i] = suggestionResult{
+				err: ctx.Err(),
+			}
+
+			continue
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			jobCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			suggestion, err := m.suggestSwap(
+				jobCtx, traffic, job.balance, job.rule,
+				job.amount, outRestrictions, inRestrictions,
+				autoloop, stats,
+			)
+
+			// If our own timeout expired, rather than our parent
+			// context, we disqualify the rule for this tick.
+			if err != nil && ctx.Err() == nil &&
+				jobCtx.Err() == context.DeadlineExceeded {
+
+				log.Infof("suggestion for %v timed out after "+
+					"%v", job, timeout)
+
+				err = newReasonError(ReasonQuoteTimeout)
+			}
+
+			results[i] = suggestionResult{
+				suggestion: suggestion,
+				err:        err,
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,188 @@
+package liquidity
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConcurrentQuotes tests that we get our swap suggestions concurrently.
+func TestConcurrentQuotes(t *testing.T) {
+	cfg, lnd := newTestConfig()
+	lnd.Channels = []lndclient.ChannelInfo{channel1, channel2}
+
+	// Our quotes only return once both of our quote requests are in
+	// flight, so our suggestions would time out if we got them serially.
+	var (
+		mu       sync.Mutex
+		requests int
+		inFlight = make(chan struct{})
+	)
+
+	cfg.QuoteWorkers = 2
+	cfg.QuoteTimeout = time.Second * 5
+	cfg.Quotes = &testQuotes{
+		loopOutQuote: func(ctx context.Context,
+			_ *loop.LoopOutQuoteRequest) (
+			*loop.LoopOutQuote, error) {
+
+			mu.Lock()
+			requests++
+			if requests == 2 {
+				close(inFlight)
+			}
+			mu.Unlock()
+
+			select {
+			case <-inFlight:
+				return testQuote, nil
+
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		},
+	}
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+		chanID2: chanRule,
+	}
+	params.MaxAutoInFlight = 2
+
+	expected := &Suggestions{
+		OutSwaps:          []loop.OutRequest{chan1Rec, chan2Rec},
+		DisqualifiedChans: noneDisqualified,
+		DisqualifiedPeers: noPeersDisqualified,
+	}
+
+	testSuggestSwaps(
+		t, newSuggestSwapsSetup(cfg, lnd, params), expected, nil,
+	)
+}
+
+// TestQuoteTimeout tests that a suggestion whose quote times out is
+// disqualified without failing our other suggestions.
+func TestQuoteTimeout(t *testing.T) {
+	cfg, lnd := newTestConfig()
+	lnd.Channels = []lndclient.ChannelInfo{channel1, channel2}
+
+	// We get our suggestions serially, so that our first quote request,
+	// which never returns, is the request for channel 1.
+	var (
+		mu    sync.Mutex
+		first = true
+	)
+
+	cfg.QuoteWorkers = 1
+	cfg.QuoteTimeout = time.Millisecond * 10
+	cfg.Quotes = &testQuotes{
+		loopOutQuote: func(ctx context.Context,
+			_ *loop.LoopOutQuoteRequest) (
+			*loop.LoopOutQuote, error) {
+
+			mu.Lock()
+			hang := first
+			first = false
+			mu.Unlock()
+
+			if !hang {
+				return testQuote, nil
+			}
+
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	}
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+		chanID2: chanRule,
+	}
+
+	expected := &Suggestions{
+		OutSwaps: []loop.OutRequest{chan2Rec},
+		DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+			chanID1: ReasonQuoteTimeout,
+		},
+		DisqualifiedPeers: noPeersDisqualified,
+	}
+
+	testSuggestSwaps(
+		t, newSuggestSwapsSetup(cfg, lnd, params), expected, nil,
+	)
+}
+
+// TestQuoteCancellation tests that we stop getting swap suggestions promptly
+// when our context is canceled, without dispatching the jobs that are still
+// waiting for a worker.
+func TestQuoteCancellation(t *testing.T) {
+	cfg, lnd := newTestConfig()
+	lnd.Channels = []lndclient.ChannelInfo{channel1, channel2}
+
+	// Our quote requests block until their context is canceled, and we
+	// only have a single worker, so our second request is never sent.
+	var (
+		mu       sync.Mutex
+		requests int
+		inFlight = make(chan struct{})
+	)
+
+	cfg.QuoteWorkers = 1
+	cfg.QuoteTimeout = time.Minute
+	cfg.Quotes = &testQuotes{
+		loopOutQuote: func(ctx context.Context,
+			_ *loop.LoopOutQuoteRequest) (
+			*loop.LoopOutQuote, error) {
+
+			mu.Lock()
+			requests++
+			if requests == 1 {
+				close(inFlight)
+			}
+			mu.Unlock()
+
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	}
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+		chanID2: chanRule,
+	}
+	params.MaxAutoInFlight = 2
+
+	manager := NewManager(cfg)
+	require.NoError(t, manager.SetParameters(context.Background(), params))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := manager.SuggestSwaps(ctx, false)
+		errChan <- err
+	}()
+
+	<-inFlight
+	cancel()
+
+	select {
+	case err := <-errChan:
+		require.ErrorIs(t, err, context.Canceled)
+
+	case <-time.After(time.Second * 5):
+		t.Fatal("suggestions not canceled")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, 1, requests)
+}
@@ -69,6 +69,96 @@ const (
 	// ReasonLoopInUnreachable indicates that the server does not have a
 	// path to the client, so cannot perform a loop in swap at this time.
 	ReasonLoopInUnreachable
+
+	// ReasonVBytesBudget indicates that we cannot perform a swap because it
+	// would exceed the on-chain vbytes budget that we have for the current
+	// period.
+	ReasonVBytesBudget
+
+	// ReasonQuoteTimeout indicates that we could not get a quote for a
+	// swap within our quote timeout.
+	ReasonQuoteTimeout
+
+	// ReasonRuleBudget indicates that we cannot perform a swap because
+	// the fee budget of the channel or peer rule that it is suggested for
+	// does not have enough remaining to cover its fees.
+	ReasonRuleBudget
+
+	// ReasonRuleExpired indicates that we do not perform swaps for a
+	// channel or peer because its rule has expired.
+	ReasonRuleExpired
+
+	// ReasonDirectionCooldown indicates that we do not perform a swap
+	// because a swap in the opposite direction recently completed on the
+	// channel or peer, and its rule's cooldown has not yet passed.
+	ReasonDirectionCooldown
+
+	// ReasonExcluded indicates that we do not perform swaps for a channel
+	// because it has been excluded from management by the liquidity
+	// manager.
+	ReasonExcluded
+
+	// ReasonNoChannels indicates that we do not perform swaps for a rule
+	// because none of the channels that it applies to are open.
+	ReasonNoChannels
+
+	// ReasonReserveInsufficient indicates that a target needs a swap to
+	// meet its rule, but it does not have enough balance available to
+	// swap without dropping below its reserve threshold.
+	ReasonReserveInsufficient
+
+	// ReasonBelowMinimum indicates that a target needs a swap, but the
+	// amount required is less than the minimum swap amount.
+	ReasonBelowMinimum
+
+	// ReasonSuccessCooldown indicates that we do not perform a swap
+	// because a swap recently completed for the channel or peer, and our
+	// success cooldown has not yet passed.
+	ReasonSuccessCooldown
+
+	// ReasonSwapRateLimit indicates that we do not perform a swap because
+	// we have reached our limit on the number of automated swaps that we
+	// dispatch in our swap rate period, in total or for the channel or
+	// peer.
+	ReasonSwapRateLimit
+
+	// ReasonServerMaintenance indicates that we do not perform a swap
+	// because the server has published a notice that it is in
+	// maintenance.
+	ReasonServerMaintenance
+
+	// ReasonPeerOffline indicates that we do not perform a swap because
+	// the peer that it would route through is not connected to our node.
+	ReasonPeerOffline
+
+	// ReasonHtlcLimits indicates that we do not perform a swap because
+	// the htlc slots or in-flight limits of its channels cannot carry our
+	// minimum swap amount.
+	ReasonHtlcLimits
+
+	// ReasonChannelAge indicates that we do not perform a swap because
+	// its channels are younger than our minimum channel age.
+	ReasonChannelAge
+
+	// ReasonFlowRefill indicates that we do not perform a swap because
+	// the forwarding flow of its channels is expected to restore the
+	// liquidity that it would add.
+	ReasonFlowRefill
+
+	// ReasonInsufficientRevenue indicates that we do not perform a swap
+	// because its channels have not earned enough routing revenue to
+	// justify its fees.
+	ReasonInsufficientRevenue
+
+	// ReasonPrivateChannel indicates that we do not perform a loop in
+	// because we only have private channels with its peer, and we are
+	// not allowed to include route hints for them.
+	ReasonPrivateChannel
+
+	// ReasonRuleConflict indicates that we do not perform a swap for a
+	// channel because it has a rule of its own, its peer also has a rule,
+	// and we could not resolve which of the rules applies to it.
+	ReasonRuleConflict
 )
 
 // String returns a string representation of a reason.
@@ -119,6 +209,63 @@ func (r Reason) String() string {
 	case ReasonLoopInUnreachable:
 		return "loop in unreachable"
 
+	case ReasonVBytesBudget:
+		return "vbytes budget insufficient"
+
+	case ReasonQuoteTimeout:
+		return "quote timed out"
+
+	case ReasonRuleBudget:
+		return "rule budget insufficient"
+
+	case ReasonRuleExpired:
+		return "rule expired"
+
+	case ReasonDirectionCooldown:
+		return "direction cooldown"
+
+	case ReasonExcluded:
+		return "channel excluded"
+
+	case ReasonNoChannels:
+		return "no open channels"
+
+	case ReasonReserveInsufficient:
+		return "reserve insufficient"
+
+	case ReasonBelowMinimum:
+		return "amount below minimum"
+
+	case ReasonSuccessCooldown:
+		return "success cooldown"
+
+	case ReasonSwapRateLimit:
+		return "swap rate limit"
+
+	case ReasonServerMaintenance:
+		return "server maintenance"
+
+	case ReasonPeerOffline:
+		return "peer offline"
+
+	case ReasonHtlcLimits:
+		return "htlc limits"
+
+	case ReasonChannelAge:
+		return "channel age"
+
+	case ReasonFlowRefill:
+		return "flow refill"
+
+	case ReasonInsufficientRevenue:
+		return "insufficient revenue"
+
+	case ReasonPrivateChannel:
+		return "private channel"
+
+	case ReasonRuleConflict:
+		return "rule conflict"
+
 	default:
 		return "unknown"
 	}
@@ -0,0 +1,355 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// ErrChannelNotFound is returned when a channel that a rebalance is
+	// compared for is not one of our open channels.
+	ErrChannelNotFound = errors.New("channel not found")
+
+	// ErrRebalanceSamePeer is returned when we are asked to compare a
+	// rebalance between two channels with the same peer, which cannot be
+	// done with a circular payment.
+	ErrRebalanceSamePeer = errors.New("outgoing and incoming channel " +
+		"must have different peers")
+
+	// ErrRebalanceBalance is returned when the outgoing channel does not
+	// have enough local balance, or the incoming channel does not have
+	// enough remote balance, to shift the amount requested.
+	ErrRebalanceBalance = errors.New("insufficient channel balance for " +
+		"rebalance")
+)
+
+// RebalanceMethod is a way of shifting liquidity out of a channel.
+type RebalanceMethod uint8
+
+const (
+	// RebalanceMethodNone indicates that neither a circular rebalance nor
+	// a swap is possible.
+	RebalanceMethodNone RebalanceMethod = iota
+
+	// RebalanceMethodCircular indicates an off-chain circular rebalance,
+	// which pays from the outgoing channel back to ourselves through the
+	// incoming channel.
+	RebalanceMethodCircular
+
+	// RebalanceMethodSwap indicates a loop out swap over the outgoing
+	// channel.
+	RebalanceMethodSwap
+)
+
+// String returns the string representation of a rebalance method.
+func (r RebalanceMethod) String() string {
+	switch r {
+	case RebalanceMethodNone:
+		return "none"
+
+	case RebalanceMethodCircular:
+		return "circular rebalance"
+
+	case RebalanceMethodSwap:
+		return "swap"
+
+	default:
+		return "unknown"
+	}
+}
+
+// RebalanceComparison compares the estimated cost of shifting an amount of
+// local balance out of a channel with an off-chain circular rebalance to the
+// estimated cost of doing so with a loop out swap.
+type RebalanceComparison struct {
+	// Amount is the amount of local balance that we want to shift out of
+	// the outgoing channel.
+	Amount btcutil.Amount
+
+	// OutgoingChannel is the channel that has excess local balance.
+	OutgoingChannel lnwire.ShortChannelID
+
+	// IncomingChannel is the channel that a circular rebalance would
+	// shift the local balance into.
+	IncomingChannel lnwire.ShortChannelID
+
+	// RebalanceAvailable indicates whether we found a route for a
+	// circular rebalance.
+	RebalanceAvailable bool
+
+	// RebalanceFee is the routing fee that the circular rebalance is
+	// estimated to cost.
+	RebalanceFee btcutil.Amount
+
+	// RebalanceHops is the number of hops in the route we found for the
+	// circular rebalance, including the outgoing and incoming channels.
+	RebalanceHops int
+
+	// SwapAvailable indicates whether the server would accept a swap of
+	// our amount, and we found a route for its payment.
+	SwapAvailable bool
+
+	// SwapFee is the server fee quoted for the swap.
+	SwapFee btcutil.Amount
+
+	// SwapMinerFee is the estimated on-chain fee for the swap's sweep.
+	SwapMinerFee btcutil.Amount
+
+	// SwapRoutingFee is the estimated off-chain routing fee for paying
+	// the swap amount to the server.
+	SwapRoutingFee btcutil.Amount
+
+	// Preferred is the cheaper of the methods that are available.
+	Preferred RebalanceMethod
+}
+
+// SwapCost returns the total estimated cost of the swap.
+func (r *RebalanceComparison) SwapCost() btcutil.Amount {
+	return r.SwapFee + r.SwapMinerFee + r.SwapRoutingFee
+}
+
+// preferred returns the cheaper of the methods that are available. If a
+// circular rebalance costs the same as a swap, we prefer the rebalance because
+// it does not depend on on-chain confirmation.
+func (r *RebalanceComparison) preferred() RebalanceMethod {
+	switch {
+	case r.RebalanceAvailable && r.SwapAvailable:
+		if r.RebalanceFee <= r.SwapCost() {
+			return RebalanceMethodCircular
+		}
+
+		return RebalanceMethodSwap
+
+	case r.RebalanceAvailable:
+		return RebalanceMethodCircular
+
+	case r.SwapAvailable:
+		return RebalanceMethodSwap
+
+	default:
+		return RebalanceMethodNone
+	}
+}
+
+// CompareRebalance estimates the cost of shifting the amount provided out of
+// our outgoing channel, either with a circular rebalance into our incoming
+// channel or with a loop out swap, and returns the comparison.
+func (m *Manager) CompareRebalance(ctx context.Context, amount btcutil.Amount,
+	outgoing, incoming lnwire.ShortChannelID) (*RebalanceComparison,
+	error) {
+
+	if amount <= 0 {
+		return nil, errors.New("amount must be > 0")
+	}
+
+	outChannel, inChannel, err := m.rebalanceChannels(
+		ctx, amount, outgoing, incoming,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m.paramsLock.Lock()
+	confTarget := m.params.SweepConfTarget
+	m.paramsLock.Unlock()
+
+	return m.compareRebalance(ctx, amount, outChannel, inChannel, confTarget)
+}
+
+// compareRebalance compares the cost of a circular rebalance between the
+// channels provided to the cost of a loop out swap over the outgoing channel.
+func (m *Manager) compareRebalance(ctx context.Context, amount btcutil.Amount,
+	outChannel, inChannel *lndclient.ChannelInfo, confTarget int32) (
+	*RebalanceComparison, error) {
+
+	var (
+		outgoing = lnwire.NewShortChanIDFromInt(outChannel.ChannelID)
+		incoming = lnwire.NewShortChanIDFromInt(inChannel.ChannelID)
+		outPeer  = outChannel.PubKeyBytes
+		inPeer   = inChannel.PubKeyBytes
+	)
+
+	comparison := &RebalanceComparison{
+		Amount:          amount,
+		OutgoingChannel: outgoing,
+		IncomingChannel: incoming,
+	}
+
+	// A circular rebalance pays from our outgoing channel's peer back to
+	// ourselves, with our incoming channel's peer as the last hop.
+	fee, hops, err := m.peerRouteFee(
+		ctx, outPeer, m.cfg.NodePubkey, &inPeer, amount,
+	)
+	switch {
+	case errors.Is(err, lndclient.ErrNoRouteFound):
+		log.Debugf("No circular rebalance route found from %v to %v",
+			outgoing, incoming)
+
+	case err != nil:
+		return nil, err
+
+	default:
+		comparison.RebalanceAvailable = true
+		comparison.RebalanceFee = fee
+		comparison.RebalanceHops = hops
+	}
+
+	quote, err := m.cfg.Quotes.LoopOutQuote(
+		ctx, &loop.LoopOutQuoteRequest{
+			Amount:                  amount,
+			SweepConfTarget:         confTarget,
+			SwapPublicationDeadline: m.cfg.Clock.Now(),
+		},
+	)
+	switch {
+	// If the server would not accept a swap of this size, we only report
+	// the circular rebalance.
+	case errors.Is(err, loop.ErrSwapAmountTooLow),
+		errors.Is(err, loop.ErrSwapAmountTooHigh):
+
+		log.Debugf("Swap of %v not possible: %v", amount, err)
+
+	case err != nil:
+		return nil, err
+
+	default:
+		fee, _, err := m.peerRouteFee(
+			ctx, outPeer, route.Vertex(quote.SwapPaymentDest), nil,
+			amount,
+		)
+		switch {
+		case errors.Is(err, lndclient.ErrNoRouteFound):
+			log.Debugf("No route found to swap server over %v",
+				outgoing)
+
+		case err != nil:
+			return nil, err
+
+		default:
+			comparison.SwapAvailable = true
+			comparison.SwapFee = quote.SwapFee
+			comparison.SwapMinerFee = quote.MinerFee
+			comparison.SwapRoutingFee = fee
+		}
+	}
+
+	comparison.Preferred = comparison.preferred()
+
+	return comparison, nil
+}
+
+// rebalanceChannels looks up the channels that we are comparing a rebalance
+// for and checks that they can shift the amount provided.
+func (m *Manager) rebalanceChannels(ctx context.Context, amount btcutil.Amount,
+	outgoing, incoming lnwire.ShortChannelID) (*lndclient.ChannelInfo,
+	*lndclient.ChannelInfo, error) {
+
+	channels, err := m.cfg.Channels.ListChannels(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var outChannel, inChannel *lndclient.ChannelInfo
+	for i, channel := range channels {
+		switch channel.ChannelID {
+		case outgoing.ToUint64():
+			outChannel = &channels[i]
+
+		case incoming.ToUint64():
+			inChannel = &channels[i]
+		}
+	}
+
+	if outChannel == nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrChannelNotFound,
+			outgoing)
+	}
+
+	if inChannel == nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrChannelNotFound,
+			incoming)
+	}
+
+	if outChannel.PubKeyBytes == inChannel.PubKeyBytes {
+		return nil, nil, ErrRebalanceSamePeer
+	}
+
+	if outChannel.LocalBalance < amount {
+		return nil, nil, fmt.Errorf("%w: channel %v has local balance "+
+			"%v", ErrRebalanceBalance, outgoing,
+			outChannel.LocalBalance)
+	}
+
+	if inChannel.RemoteBalance < amount {
+		return nil, nil, fmt.Errorf("%w: channel %v has remote "+
+			"balance %v", ErrRebalanceBalance, incoming,
+			inChannel.RemoteBalance)
+	}
+
+	return outChannel, inChannel, nil
+}
+
+// peerRouteFee queries a route for a payment of the amount provided that
+// leaves over our channel with the peer provided, and returns the total
+// routing fee it would cost along with its number of hops. Since lnd does not
+// allow us to restrict the outgoing channel when querying routes, we query
+// a route from our peer and add the fee that the peer charges for forwarding
+// into the route.
+func (m *Manager) peerRouteFee(ctx context.Context, peer, dest route.Vertex,
+	lastHop *route.Vertex, amount btcutil.Amount) (btcutil.Amount, int,
+	error) {
+
+	amtMsat := lnwire.NewMSatFromSatoshis(amount)
+
+	resp, err := m.cfg.Graph.QueryRoutes(
+		ctx, lndclient.QueryRoutesRequest{
+			Source:            &peer,
+			PubKey:            dest,
+			LastHop:           lastHop,
+			AmtMsat:           amtMsat,
+			FeeLimitMsat:      amtMsat,
+			UseMissionControl: true,
+		},
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if len(resp.Hops) == 0 {
+		return 0, 0, lndclient.ErrNoRouteFound
+	}
+
+	// Our peer forwards the full amount of the route over the route's
+	// first channel, so we look up its policy for that channel.
+	edge, err := m.cfg.Graph.GetChanInfo(ctx, resp.Hops[0].ChannelID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	policy := edge.Node1Policy
+	if edge.Node2 == peer {
+		policy = edge.Node2Policy
+	}
+
+	if policy == nil {
+		return 0, 0, fmt.Errorf("no policy for peer %v on channel %v",
+			peer, resp.Hops[0].ChannelID)
+	}
+
+	peerFee := lnwire.MilliSatoshi(policy.FeeBaseMsat) +
+		resp.TotalAmtMsat*lnwire.MilliSatoshi(policy.FeeRateMilliMsat)/
+			FeeBase
+
+	// Our own channel with our peer is the first hop of the payment, so
+	// we add it to the route's hops.
+	totalFee := resp.TotalFeesMsat + peerFee
+
+	return totalFee.ToSatoshis(), len(resp.Hops) + 1, nil
+}
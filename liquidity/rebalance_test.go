@@ -0,0 +1,210 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCompareRebalance tests comparison of the cost of circular rebalances
+// and swaps.
+func TestCompareRebalance(t *testing.T) {
+	const amount = btcutil.Amount(5000)
+
+	var (
+		serverPubkey = route.Vertex{9}
+
+		// incoming is a channel that has enough remote balance to
+		// receive our rebalance.
+		incoming = lndclient.ChannelInfo{
+			ChannelID:     chanID2.ToUint64(),
+			PubKeyBytes:   peer2,
+			RemoteBalance: 10000,
+			Capacity:      10000,
+		}
+
+		// rebalanceRoute is a route from peer 1 back to us, which
+		// costs 2 sat in fees plus our peer's forwarding fee.
+		rebalanceRoute = &lndclient.QueryRoutesResponse{
+			Hops: []*lndclient.Hop{
+				{ChannelID: chanID3.ToUint64()},
+				{ChannelID: 4},
+				{ChannelID: chanID2.ToUint64()},
+			},
+			TotalFeesMsat: 2000,
+			TotalAmtMsat:  lnwire.NewMSatFromSatoshis(amount) + 2000,
+		}
+
+		// serverRoute is a route from peer 1 to the server, which
+		// costs 1 sat in fees plus our peer's forwarding fee.
+		serverRoute = &lndclient.QueryRoutesResponse{
+			Hops: []*lndclient.Hop{
+				{ChannelID: chanID3.ToUint64()},
+			},
+			TotalFeesMsat: 1000,
+			TotalAmtMsat:  lnwire.NewMSatFromSatoshis(amount) + 1000,
+		}
+
+		// peerEdge is the channel that peer 1 forwards our payments
+		// over, for which it charges a 1 sat base fee.
+		peerEdge = &lndclient.ChannelEdge{
+			ChannelID: chanID3.ToUint64(),
+			Node1:     route.Vertex{3},
+			Node2:     peer1,
+			Node1Policy: &lndclient.RoutingPolicy{
+				FeeBaseMsat: 5000,
+			},
+			Node2Policy: &lndclient.RoutingPolicy{
+				FeeBaseMsat: 1000,
+			},
+		}
+
+		quote = &loop.LoopOutQuote{
+			SwapFee:         testQuote.SwapFee,
+			PrepayAmount:    testQuote.PrepayAmount,
+			MinerFee:        testQuote.MinerFee,
+			SwapPaymentDest: serverPubkey,
+		}
+
+		swapRoutingFee = btcutil.Amount(2)
+	)
+
+	tests := []struct {
+		name      string
+		channels  []lndclient.ChannelInfo
+		rebalance bool
+		server    bool
+		quoteErr  error
+		expected  *RebalanceComparison
+		err       error
+	}{
+		{
+			name:      "rebalance cheaper",
+			channels:  []lndclient.ChannelInfo{channel1, incoming},
+			rebalance: true,
+			server:    true,
+			expected: &RebalanceComparison{
+				RebalanceAvailable: true,
+				RebalanceFee:       3,
+				RebalanceHops:      4,
+				SwapAvailable:      true,
+				SwapFee:            quote.SwapFee,
+				SwapMinerFee:       quote.MinerFee,
+				SwapRoutingFee:     swapRoutingFee,
+				Preferred:          RebalanceMethodCircular,
+			},
+		},
+		{
+			name:     "no rebalance route",
+			channels: []lndclient.ChannelInfo{channel1, incoming},
+			server:   true,
+			expected: &RebalanceComparison{
+				SwapAvailable:  true,
+				SwapFee:        quote.SwapFee,
+				SwapMinerFee:   quote.MinerFee,
+				SwapRoutingFee: swapRoutingFee,
+				Preferred:      RebalanceMethodSwap,
+			},
+		},
+		{
+			name:      "swap too small",
+			channels:  []lndclient.ChannelInfo{channel1, incoming},
+			rebalance: true,
+			server:    true,
+			quoteErr:  loop.ErrSwapAmountTooLow,
+			expected: &RebalanceComparison{
+				RebalanceAvailable: true,
+				RebalanceFee:       3,
+				RebalanceHops:      4,
+				Preferred:          RebalanceMethodCircular,
+			},
+		},
+		{
+			name:     "no routes",
+			channels: []lndclient.ChannelInfo{channel1, incoming},
+			expected: &RebalanceComparison{
+				Preferred: RebalanceMethodNone,
+			},
+		},
+		{
+			name:     "channel not found",
+			channels: []lndclient.ChannelInfo{channel1},
+			err:      ErrChannelNotFound,
+		},
+		{
+			name:     "insufficient balance",
+			channels: []lndclient.ChannelInfo{channel1, channel2},
+			err:      ErrRebalanceBalance,
+		},
+		{
+			name: "same peer",
+			channels: []lndclient.ChannelInfo{
+				channel1, {
+					ChannelID:     chanID2.ToUint64(),
+					PubKeyBytes:   peer1,
+					RemoteBalance: 10000,
+				},
+			},
+			err: ErrRebalanceSamePeer,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = testCase.channels
+			lnd.ChannelEdges = map[uint64]*lndclient.ChannelEdge{
+				peerEdge.ChannelID: peerEdge,
+			}
+			lnd.Routes = make(
+				map[route.Vertex]*lndclient.QueryRoutesResponse,
+			)
+
+			if testCase.rebalance {
+				lnd.Routes[cfg.NodePubkey] = rebalanceRoute
+			}
+
+			if testCase.server {
+				lnd.Routes[serverPubkey] = serverRoute
+			}
+
+			cfg.Quotes = &testQuotes{
+				loopOutQuote: func(_ context.Context,
+					_ *loop.LoopOutQuoteRequest) (
+					*loop.LoopOutQuote, error) {
+
+					if testCase.quoteErr != nil {
+						return nil, testCase.quoteErr
+					}
+
+					return quote, nil
+				},
+			}
+
+			manager := NewManager(cfg)
+
+			comparison, err := manager.CompareRebalance(
+				context.Background(), amount, chanID1, chanID2,
+			)
+			require.ErrorIs(t, err, testCase.err)
+			if testCase.err != nil {
+				return
+			}
+
+			testCase.expected.Amount = amount
+			testCase.expected.OutgoingChannel = chanID1
+			testCase.expected.IncomingChannel = chanID2
+
+			require.Equal(t, testCase.expected, comparison)
+		})
+	}
+}
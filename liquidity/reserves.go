@@ -0,0 +1,76 @@
+package liquidity
+
+import (
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/input"
+)
+
+// feeBufferMultiplier is the multiple of the current commitment fee rate
+// that the channel initiator must be able to pay for, so that the channel
+// remains usable if fees spike.
+const feeBufferMultiplier = 2
+
+// commitFeeBuffer returns the amount that the initiator of a channel must
+// keep in its balance, on top of the current commitment fee, to add another
+// htlc to the commitment at our fee buffer multiple of the current fee rate.
+// lnd reports balances after the commitment fee and any anchor outputs have
+// been paid by the initiator, so we only need to account for the increase.
+func commitFeeBuffer(info lndclient.ChannelInfo) btcutil.Amount {
+	feeRate := info.FeePerKw * feeBufferMultiplier
+	buffer := feeRate.FeeForWeight(info.CommitWeight+input.HTLCWeight) -
+		info.CommitFee
+
+	if buffer < 0 {
+		return 0
+	}
+
+	return buffer
+}
+
+// channelReserves returns the portions of a channel's local and remote
+// balance that cannot be sent over the channel. Each side must keep the
+// channel reserve that its peer requires, and the initiator must also keep a
+// buffer to pay the fees for additional htlcs. Reserves are capped at their
+// side's balance, so that they can be summed over multiple channels.
+func channelReserves(info lndclient.ChannelInfo) (btcutil.Amount,
+	btcutil.Amount) {
+
+	var outgoing, incoming btcutil.Amount
+
+	if info.LocalConstraints != nil {
+		outgoing = info.LocalConstraints.Reserve
+	}
+
+	if info.RemoteConstraints != nil {
+		incoming = info.RemoteConstraints.Reserve
+	}
+
+	if info.Initiator {
+		outgoing += commitFeeBuffer(info)
+	} else {
+		incoming += commitFeeBuffer(info)
+	}
+
+	if outgoing > info.LocalBalance {
+		outgoing = info.LocalBalance
+	}
+
+	if incoming > info.RemoteBalance {
+		incoming = info.RemoteBalance
+	}
+
+	return outgoing, incoming
+}
+
+// usable returns the balance that can actually be shifted by a swap of the
+// type provided. Loop outs send our local balance, and loop ins are paid to
+// us from our peer's balance.
+func (b *balances) usable(swapType swap.Type) btcutil.Amount {
+	if swapType == swap.TypeIn {
+		return b.incoming - b.incomingReserve
+	}
+
+	return b.outgoing - b.outgoingReserve
+}
@@ -0,0 +1,114 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChannelReserves tests calculation of the portions of a channel's
+// balances that cannot be sent.
+func TestChannelReserves(t *testing.T) {
+	var (
+		// anchorChannel is a channel with an anchor commitment at
+		// 2500 sat/kw, which has a commitment fee of 2810 sats. Adding
+		// an htlc at double the fee rate costs 6480 sats, so the
+		// initiator must hold back another 3670 sats.
+		anchorChannel = lndclient.ChannelInfo{
+			Capacity:      100000,
+			LocalBalance:  50000,
+			RemoteBalance: 50000,
+			FeePerKw:      2500,
+			CommitWeight:  1124,
+			CommitFee:     2810,
+			LocalConstraints: &lndclient.ChannelConstraints{
+				Reserve: 1000,
+			},
+			RemoteConstraints: &lndclient.ChannelConstraints{
+				Reserve: 2000,
+			},
+		}
+
+		feeBuffer btcutil.Amount = 3670
+	)
+
+	tests := []struct {
+		name      string
+		channel   func() lndclient.ChannelInfo
+		outgoing  btcutil.Amount
+		incoming  btcutil.Amount
+		usableOut btcutil.Amount
+		usableIn  btcutil.Amount
+	}{
+		{
+			name: "no constraints",
+			channel: func() lndclient.ChannelInfo {
+				return channel1
+			},
+			usableOut: channel1.LocalBalance,
+			usableIn:  channel1.RemoteBalance,
+		},
+		{
+			name: "we initiated",
+			channel: func() lndclient.ChannelInfo {
+				channel := anchorChannel
+				channel.Initiator = true
+
+				return channel
+			},
+			outgoing:  1000 + feeBuffer,
+			incoming:  2000,
+			usableOut: 50000 - 1000 - feeBuffer,
+			usableIn:  48000,
+		},
+		{
+			name: "peer initiated",
+			channel: func() lndclient.ChannelInfo {
+				return anchorChannel
+			},
+			outgoing:  1000,
+			incoming:  2000 + feeBuffer,
+			usableOut: 49000,
+			usableIn:  50000 - 2000 - feeBuffer,
+		},
+		{
+			name: "balance below reserve",
+			channel: func() lndclient.ChannelInfo {
+				channel := anchorChannel
+				channel.LocalBalance = 500
+				channel.RemoteBalance = 99500
+
+				return channel
+			},
+			outgoing:  500,
+			incoming:  2000 + feeBuffer,
+			usableOut: 0,
+			usableIn:  99500 - 2000 - feeBuffer,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			channel := testCase.channel()
+
+			outgoing, incoming := channelReserves(channel)
+			require.Equal(t, testCase.outgoing, outgoing)
+			require.Equal(t, testCase.incoming, incoming)
+
+			balance := newBalances(channel)
+			require.Equal(
+				t, testCase.usableOut,
+				balance.usable(swap.TypeOut),
+			)
+			require.Equal(
+				t, testCase.usableIn,
+				balance.usable(swap.TypeIn),
+			)
+		})
+	}
+}
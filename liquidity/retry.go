@@ -0,0 +1,93 @@
+package liquidity
+
+import (
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// addBackup records a swap that we did not suggest because of our in flight
+// limit, so that it can replace a suggested swap that fails to dispatch. We
+// do not keep swaps that would not fit within the budgets of their rules.
+// Backups are added in the order that we rank our swaps, so that we always
+// replace a failed swap with the next-best candidate.
+func (s *Suggestions) addBackup(swap swapSuggestion, budgets *ruleBudgets,
+	channelPeers map[uint64]route.Vertex) {
+
+	available, limited := budgets.available(
+		swap.channels(), swap.peers(channelPeers),
+	)
+	if limited && swap.fees() > available {
+		return
+	}
+
+	s.backups = append(s.backups, swap)
+}
+
+// nextBackup removes and returns the first backup swap that may replace the
+// failed swap provided, or nil if there is none. A backup must have the same
+// swap type as the failed swap and may not have higher fees, so that it fits
+// within the budget that we reserved for the failed swap.
+func (s *Suggestions) nextBackup(failed swapSuggestion) swapSuggestion {
+	_, failedOut := failed.(*loopOutSwapSuggestion)
+
+	for i, backup := range s.backups {
+		_, backupOut := backup.(*loopOutSwapSuggestion)
+		if backupOut != failedOut {
+			continue
+		}
+
+		if backup.fees() > failed.fees() {
+			continue
+		}
+
+		s.backups = append(s.backups[:i], s.backups[i+1:]...)
+
+		return backup
+	}
+
+	return nil
+}
+
+// retryOut adds the next-best backup swap for a loop out that failed to
+// dispatch to our dispatch set and the round that we record, if we have one.
+func (m *Manager) retryOut(set *dispatchSet, suggestion *Suggestions,
+	stats *tickStats, failed loop.OutRequest) {
+
+	backup := suggestion.nextBackup(&loopOutSwapSuggestion{
+		OutRequest: failed,
+	})
+	if backup == nil {
+		return
+	}
+
+	outSuggestion := backup.(*loopOutSwapSuggestion)
+	out := outSuggestion.OutRequest
+	log.Infof("retrying failed loop out with %v sats over %v", out.Amount,
+		out.OutgoingChanSet)
+
+	set.outSwaps = append(set.outSwaps, out)
+	set.outNotes = append(set.outNotes, "")
+	set.outDests = append(set.outDests, outSuggestion.sweepDest)
+	stats.round.LoopOut = append(stats.round.LoopOut, suggestedOut(out))
+}
+
+// retryIn adds the next-best backup swap for a loop in that failed to
+// dispatch to our dispatch set and the round that we record, if we have one.
+func (m *Manager) retryIn(set *dispatchSet, suggestion *Suggestions,
+	stats *tickStats, failed loop.LoopInRequest) {
+
+	backup := suggestion.nextBackup(&loopInSwapSuggestion{
+		LoopInRequest: failed,
+	})
+	if backup == nil {
+		return
+	}
+
+	in := backup.(*loopInSwapSuggestion).LoopInRequest
+	log.Infof("retrying failed loop in with %v sats over %v", in.Amount,
+		in.LastHop)
+
+	set.inSwaps = append(set.inSwaps, in)
+	set.inNotes = append(set.inNotes, "")
+	stats.round.LoopIn = append(stats.round.LoopIn, suggestedIn(in))
+}
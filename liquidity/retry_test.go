@@ -0,0 +1,159 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNextBackup tests selection of backup swaps that replace swaps that
+// failed to dispatch.
+func TestNextBackup(t *testing.T) {
+	var (
+		failedOut = &loopOutSwapSuggestion{
+			OutRequest: loop.OutRequest{
+				MaxSwapFee: 100,
+			},
+		}
+
+		expensiveOut = &loopOutSwapSuggestion{
+			OutRequest: loop.OutRequest{
+				MaxSwapFee: 200,
+			},
+		}
+
+		cheapOut = &loopOutSwapSuggestion{
+			OutRequest: loop.OutRequest{
+				MaxSwapFee: 50,
+			},
+		}
+
+		cheapIn = &loopInSwapSuggestion{
+			LoopInRequest: loop.LoopInRequest{
+				MaxSwapFee: 10,
+			},
+		}
+	)
+
+	suggestions := &Suggestions{
+		backups: []swapSuggestion{
+			expensiveOut, cheapIn, cheapOut,
+		},
+	}
+
+	// We skip over backups that are more expensive than our failed swap
+	// or that have a different type.
+	require.Equal(t, cheapOut, suggestions.nextBackup(failedOut))
+	require.Equal(t, []swapSuggestion{
+		expensiveOut, cheapIn,
+	}, suggestions.backups)
+
+	// Once our cheaper backup has been used, we have nothing left that can
+	// replace our failed swap.
+	require.Nil(t, suggestions.nextBackup(failedOut))
+}
+
+// TestRetryDispatchFailures tests that we dispatch the next-best swap that
+// our in flight limit excluded when a swap fails to dispatch, if we are set
+// to retry dispatch failures.
+func TestRetryDispatchFailures(t *testing.T) {
+	var (
+		chanID3  = lnwire.NewShortChanIDFromInt(3)
+		channel3 = lndclient.ChannelInfo{
+			ChannelID:     chanID3.ToUint64(),
+			PubKeyBytes:   peer2,
+			LocalBalance:  9000,
+			RemoteBalance: 1000,
+			Capacity:      10000,
+		}
+
+		errRejected = errors.New("server rejected swap")
+	)
+
+	tests := []struct {
+		name       string
+		retry      bool
+		dispatched []loopdb.ChannelSet
+		err        error
+	}{
+		{
+			name: "no retry",
+			dispatched: []loopdb.ChannelSet{
+				{chanID1.ToUint64()},
+			},
+			err: errRejected,
+		},
+		{
+			name:  "retry with backup",
+			retry: true,
+			dispatched: []loopdb.ChannelSet{
+				{chanID1.ToUint64()},
+				{chanID3.ToUint64()},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel3,
+			}
+
+			// Fail to dispatch the first swap, which is our largest
+			// swap over channel 1, and succeed for any others.
+			var dispatched []loopdb.ChannelSet
+			cfg.Dispatcher = &testDispatcher{
+				loopOut: func(_ context.Context,
+					req *loop.OutRequest) (
+					*loop.LoopOutSwapInfo, error) {
+
+					dispatched = append(
+						dispatched, req.OutgoingChanSet,
+					)
+
+					if len(dispatched) == 1 {
+						return nil, errRejected
+					}
+
+					return &loop.LoopOutSwapInfo{
+						SwapHash: lntypes.Hash{1},
+					}, nil
+				},
+			}
+
+			params := defaultParameters
+			params.Autoloop = true
+			params.AutoFeeBudget = btcutil.SatoshiPerBitcoin
+			params.AutoFeeStartDate = testTime.Add(-time.Hour)
+			params.MaxAutoInFlight = 1
+			params.RetryDispatchFailures = testCase.retry
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+				chanID3: chanRule,
+			}
+
+			manager := NewManager(cfg)
+			ctx := context.Background()
+
+			err := manager.SetParameters(ctx, params)
+			require.NoError(t, err)
+
+			err = manager.autoloop(ctx)
+			require.Equal(t, testCase.err, err)
+			require.Equal(t, testCase.dispatched, dispatched)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package liquidity
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// defaultRevenueWindow is the period of forwarding history that a rule's
+// minimum revenue is applied to if the rule does not set a window.
+const defaultRevenueWindow = time.Hour * 24 * 30
+
+// channelRevenue returns the routing fees that the set of channels provided
+// earned in the forwarding events provided. Fees are earned by the channel
+// that a payment is forwarded out over, which is the balance that a loop out
+// refills.
+func channelRevenue(events []lndclient.ForwardingEvent,
+	channels []lnwire.ShortChannelID) btcutil.Amount {
+
+	set := make(map[uint64]bool, len(channels))
+	for _, channel := range channels {
+		set[channel.ToUint64()] = true
+	}
+
+	var revenue lnwire.MilliSatoshi
+	for _, event := range events {
+		if set[event.ChannelOut] {
+			revenue += event.FeeMsat
+		}
+	}
+
+	return revenue.ToSatoshis()
+}
+
+// revenueWindow returns the period of forwarding history that a rule's
+// minimum revenue is applied to.
+func (r *SwapRule) revenueWindow() time.Duration {
+	if r.RevenueWindow != 0 {
+		return r.RevenueWindow
+	}
+
+	return defaultRevenueWindow
+}
+
+// checkRevenue returns a reason error if the rule has a minimum revenue that
+// the routing revenue of the balances provided does not cover for a swap with
+// the fees provided.
+func (r *SwapRule) checkRevenue(balance *balances,
+	fees btcutil.Amount) error {
+
+	if r.MinRevenuePercent == 0 {
+		return nil
+	}
+
+	required := fees * btcutil.Amount(r.MinRevenuePercent) / 100
+	if balance.revenue < required {
+		log.Debugf("revenue: %v of %v over %v below required: %v "+
+			"(%v%% of fees: %v)", balance.revenue,
+			balance.channels, r.revenueWindow(), required,
+			r.MinRevenuePercent, fees)
+
+		return newReasonError(ReasonInsufficientRevenue)
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChannelRevenue tests tallying of the routing revenue that our channels
+// earned by forwarding out.
+func TestChannelRevenue(t *testing.T) {
+	events := []lndclient.ForwardingEvent{
+		{
+			ChannelIn:  chanID1.ToUint64(),
+			ChannelOut: chanID2.ToUint64(),
+			FeeMsat:    2000,
+		},
+		{
+			ChannelIn:  chanID2.ToUint64(),
+			ChannelOut: chanID1.ToUint64(),
+			FeeMsat:    1000,
+		},
+		{
+			ChannelIn:  chanID3.ToUint64(),
+			ChannelOut: chanID2.ToUint64(),
+			FeeMsat:    3000,
+		},
+	}
+
+	require.EqualValues(t, 1, channelRevenue(
+		events, []lnwire.ShortChannelID{chanID1},
+	))
+	require.EqualValues(t, 5, channelRevenue(
+		events, []lnwire.ShortChannelID{chanID2},
+	))
+	require.EqualValues(t, 6, channelRevenue(
+		events, []lnwire.ShortChannelID{chanID1, chanID2},
+	))
+
+	// Channels that only forwarded in did not earn revenue.
+	require.EqualValues(t, 0, channelRevenue(
+		events, []lnwire.ShortChannelID{chanID3},
+	))
+}
+
+// TestCheckRevenue tests checking of a swap's fees against a rule's minimum
+// revenue.
+func TestCheckRevenue(t *testing.T) {
+	tests := []struct {
+		name    string
+		percent uint64
+		revenue btcutil.Amount
+		err     error
+	}{
+		{
+			name:    "no minimum",
+			percent: 0,
+			revenue: 0,
+		},
+		{
+			name:    "revenue covers fees",
+			percent: 50,
+			revenue: 500,
+		},
+		{
+			name:    "revenue below minimum",
+			percent: 50,
+			revenue: 499,
+			err:     newReasonError(ReasonInsufficientRevenue),
+		},
+		{
+			name:    "minimum above fees",
+			percent: 200,
+			revenue: 1500,
+			err:     newReasonError(ReasonInsufficientRevenue),
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			rule := &SwapRule{
+				Type:              swap.TypeOut,
+				MinRevenuePercent: testCase.percent,
+			}
+
+			err := rule.checkRevenue(
+				&balances{revenue: testCase.revenue}, 1000,
+			)
+			require.Equal(t, testCase.err, err)
+		})
+	}
+
+	// Minimum revenue may only be set for loop out rules.
+	rule := &SwapRule{
+		ThresholdRule:     NewThresholdRule(0, 50),
+		Type:              swap.TypeIn,
+		MinRevenuePercent: 10,
+	}
+	require.Equal(t, ErrRevenueLoopIn, rule.validate())
+
+	rule.Type = swap.TypeOut
+	rule.RevenueWindow = -1
+	require.Equal(t, ErrNegativeRevenueWindow, rule.validate())
+}
+
+// TestRevenueSuggestions tests that we only suggest loop outs for channels
+// that earned enough routing revenue within their rule's window.
+func TestRevenueSuggestions(t *testing.T) {
+	// Channel 1 earned plenty of fees over the last day, while channel 2
+	// only earned fees before our revenue window.
+	events := []lndclient.ForwardingEvent{
+		{
+			Timestamp:  testTime.Add(time.Hour * -24),
+			ChannelIn:  chanID3.ToUint64(),
+			ChannelOut: chanID1.ToUint64(),
+			FeeMsat:    100_000_000,
+		},
+		{
+			Timestamp:  testTime.Add(time.Hour * -24 * 10),
+			ChannelIn:  chanID3.ToUint64(),
+			ChannelOut: chanID2.ToUint64(),
+			FeeMsat:    100_000_000,
+		},
+	}
+
+	rule := &SwapRule{
+		ThresholdRule:     NewThresholdRule(50, 0),
+		Type:              swap.TypeOut,
+		MinRevenuePercent: 100,
+		RevenueWindow:     time.Hour * 24 * 7,
+	}
+
+	cfg, lnd := newTestConfig()
+	lnd.Channels = []lndclient.ChannelInfo{
+		channel1, channel2,
+	}
+	lnd.ForwardingEvents = events
+
+	params := defaultParameters
+	params.MaxAutoInFlight = 2
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: rule,
+		chanID2: rule,
+	}
+	params.PeerRules = make(map[route.Vertex]*SwapRule)
+
+	testSuggestSwaps(
+		t, newSuggestSwapsSetup(cfg, lnd, params),
+		&Suggestions{
+			OutSwaps: []loop.OutRequest{
+				chan1Rec,
+			},
+			DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+				chanID2: ReasonInsufficientRevenue,
+			},
+			DisqualifiedPeers: noPeersDisqualified,
+		}, nil,
+	)
+}
@@ -0,0 +1,198 @@
+package liquidity
+
+import (
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// autoloopFees records the fees that an automatically dispatched swap counts
+// towards our budget, along with the channels and peer that it was dispatched
+// for, so that we can attribute its fees to the rules that suggested it.
+type autoloopFees struct {
+	// channels is the set of channels that a loop out was restricted to.
+	channels []lnwire.ShortChannelID
+
+	// peer is the last hop of a loop in, if it was set.
+	peer *route.Vertex
+
+	// fees is the amount that the swap counts towards our budget.
+	fees btcutil.Amount
+}
+
+// ruleBudgets tracks the remaining fee budget of each of our rules that has a
+// fee budget set.
+type ruleBudgets struct {
+	channels map[lnwire.ShortChannelID]btcutil.Amount
+	peers    map[route.Vertex]btcutil.Amount
+	groups   map[string]btcutil.Amount
+
+	// channelGroups maps the channels in groups that have a fee budget
+	// to the name of their group.
+	channelGroups map[lnwire.ShortChannelID]string
+}
+
+// newRuleBudgets returns the remaining budgets for the rules in the parameters
+// provided, deducting the fees of the autoloops in our current budget period.
+// Loop outs are attributed to the rules of each channel they were restricted
+// to, to the rules of those channels' peers and to the rules of those
+// channels' groups, and loop ins to the rule of their last hop. A swap that
+// falls under several rules counts towards each of them.
+func newRuleBudgets(params Parameters, autoloops []autoloopFees,
+	channelPeers map[uint64]route.Vertex) *ruleBudgets {
+
+	budgets := &ruleBudgets{
+		channels:      make(map[lnwire.ShortChannelID]btcutil.Amount),
+		peers:         make(map[route.Vertex]btcutil.Amount),
+		groups:        make(map[string]btcutil.Amount),
+		channelGroups: make(map[lnwire.ShortChannelID]string),
+	}
+
+	for channel, rule := range params.ChannelRules {
+		if rule.FeeBudget != 0 {
+			budgets.channels[channel] = rule.FeeBudget
+		}
+	}
+
+	for peer, rule := range params.PeerRules {
+		if rule.FeeBudget != 0 {
+			budgets.peers[peer] = rule.FeeBudget
+		}
+	}
+
+	for name, group := range params.ChannelGroups {
+		if group.Rule.FeeBudget == 0 {
+			continue
+		}
+
+		budgets.groups[name] = group.Rule.FeeBudget
+		for _, channel := range group.Channels {
+			budgets.channelGroups[channel] = name
+		}
+	}
+
+	for _, autoloop := range autoloops {
+		peers := channelsToPeers(autoloop.channels, channelPeers)
+		if autoloop.peer != nil {
+			peers = append(peers, *autoloop.peer)
+		}
+
+		budgets.spend(autoloop.channels, peers, autoloop.fees)
+	}
+
+	return budgets
+}
+
+// available returns the lowest remaining budget of the rules for the channels
+// and peers provided, and a boolean indicating whether any of these rules has
+// a fee budget set.
+func (r *ruleBudgets) available(channels []lnwire.ShortChannelID,
+	peers []route.Vertex) (btcutil.Amount, bool) {
+
+	var (
+		lowest  btcutil.Amount
+		limited bool
+	)
+
+	check := func(remaining btcutil.Amount) {
+		if !limited || remaining < lowest {
+			lowest = remaining
+		}
+		limited = true
+	}
+
+	for _, channel := range channels {
+		if remaining, ok := r.channels[channel]; ok {
+			check(remaining)
+		}
+	}
+
+	for _, peer := range peers {
+		if remaining, ok := r.peers[peer]; ok {
+			check(remaining)
+		}
+	}
+
+	for _, group := range r.groupsFor(channels) {
+		check(r.groups[group])
+	}
+
+	return lowest, limited
+}
+
+// exhausted returns true if any of the rules for the channels and peers
+// provided has no budget remaining.
+func (r *ruleBudgets) exhausted(channels []lnwire.ShortChannelID,
+	peers []route.Vertex) bool {
+
+	available, limited := r.available(channels, peers)
+
+	return limited && available <= 0
+}
+
+// spend deducts the fees provided from the budgets of the rules for the
+// channels and peers provided.
+func (r *ruleBudgets) spend(channels []lnwire.ShortChannelID,
+	peers []route.Vertex, fees btcutil.Amount) {
+
+	for _, channel := range channels {
+		if remaining, ok := r.channels[channel]; ok {
+			r.channels[channel] = remaining - fees
+		}
+	}
+
+	for _, peer := range peers {
+		if remaining, ok := r.peers[peer]; ok {
+			r.peers[peer] = remaining - fees
+		}
+	}
+
+	for _, group := range r.groupsFor(channels) {
+		r.groups[group] -= fees
+	}
+}
+
+// groupsFor returns the set of groups with a fee budget that the channels
+// provided belong to. Each group is only returned once, so that swaps over
+// several channels in a group only count towards its budget once.
+func (r *ruleBudgets) groupsFor(channels []lnwire.ShortChannelID) []string {
+	var (
+		groups []string
+		seen   = make(map[string]bool)
+	)
+
+	for _, channel := range channels {
+		group, ok := r.channelGroups[channel]
+		if !ok || seen[group] {
+			continue
+		}
+
+		seen[group] = true
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// channelsToPeers returns the set of peers that the channels provided are
+// with, skipping over channels that are not known.
+func channelsToPeers(channels []lnwire.ShortChannelID,
+	channelPeers map[uint64]route.Vertex) []route.Vertex {
+
+	var (
+		peers []route.Vertex
+		seen  = make(map[route.Vertex]bool)
+	)
+
+	for _, channel := range channels {
+		peer, ok := channelPeers[channel.ToUint64()]
+		if !ok || seen[peer] {
+			continue
+		}
+
+		seen[peer] = true
+		peers = append(peers, peer)
+	}
+
+	return peers
+}
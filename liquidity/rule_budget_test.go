@@ -0,0 +1,92 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRuleBudgets tests attribution of existing autoloops to the fee budgets
+// of our channel and peer rules.
+func TestRuleBudgets(t *testing.T) {
+	var (
+		peer2    = route.Vertex{2}
+		peer3    = route.Vertex{3}
+		chanID3  = lnwire.NewShortChanIDFromInt(3)
+		chanPeer = map[uint64]route.Vertex{
+			chanID1.ToUint64(): peer1,
+			chanID2.ToUint64(): peer2,
+			chanID3.ToUint64(): peer2,
+		}
+	)
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: {
+			ThresholdRule: chanRule.ThresholdRule,
+			FeeBudget:     1000,
+		},
+	}
+	params.PeerRules = map[route.Vertex]*SwapRule{
+		peer2: {
+			ThresholdRule: chanRule.ThresholdRule,
+			FeeBudget:     2000,
+		},
+		peer3: {
+			ThresholdRule: chanRule.ThresholdRule,
+		},
+	}
+
+	autoloops := []autoloopFees{
+		// A loop out over channel 1 counts towards its channel rule.
+		{
+			channels: []lnwire.ShortChannelID{chanID1},
+			fees:     100,
+		},
+		// A loop out over both of peer 2's channels counts towards
+		// its peer rule once.
+		{
+			channels: []lnwire.ShortChannelID{chanID2, chanID3},
+			fees:     200,
+		},
+		// A loop in through peer 2 counts towards its peer rule.
+		{
+			peer: &peer2,
+			fees: 300,
+		},
+		// A loop in that may come through any peer does not count
+		// towards any rule.
+		{
+			fees: 400,
+		},
+	}
+
+	budgets := newRuleBudgets(params, autoloops, chanPeer)
+
+	available, limited := budgets.available(
+		[]lnwire.ShortChannelID{chanID1}, []route.Vertex{peer1},
+	)
+	require.True(t, limited)
+	require.Equal(t, btcutil.Amount(900), available)
+
+	available, limited = budgets.available(
+		[]lnwire.ShortChannelID{chanID2, chanID3},
+		[]route.Vertex{peer2},
+	)
+	require.True(t, limited)
+	require.Equal(t, btcutil.Amount(1500), available)
+
+	// Peer 3's rule does not have a budget.
+	_, limited = budgets.available(nil, []route.Vertex{peer3})
+	require.False(t, limited)
+	require.False(t, budgets.exhausted(nil, []route.Vertex{peer3}))
+
+	// Spending the remainder of channel 1's budget exhausts it.
+	budgets.spend([]lnwire.ShortChannelID{chanID1}, nil, 900)
+	require.True(t, budgets.exhausted(
+		[]lnwire.ShortChannelID{chanID1}, nil,
+	))
+}
@@ -0,0 +1,293 @@
+package liquidity
+
+import (
+	"errors"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// RuleConflict determines how we resolve the rules of a channel that has a
+// rule of its own, and whose peer also has a rule.
+type RuleConflict uint8
+
+const (
+	// RuleConflictReject does not allow a channel and its peer to both
+	// have a rule. Parameters that set both are rejected, and channels
+	// that are opened with a peer after both rules were set are not
+	// managed by either rule.
+	RuleConflictReject RuleConflict = iota
+
+	// RuleConflictChannel applies the channel's rule to the channel, and
+	// applies the peer's rule to the peer's remaining channels.
+	RuleConflictChannel
+
+	// RuleConflictStrictest applies whichever of the two rules is
+	// stricter to the channel. If the channel's rule is stricter, the
+	// peer's rule applies to the peer's remaining channels. If the peer's
+	// rule is stricter, the channel's rule is not applied.
+	RuleConflictStrictest
+)
+
+var (
+	// errInvalidRuleConflict is returned when our parameters have an
+	// unknown rule conflict setting.
+	errInvalidRuleConflict = errors.New("invalid rule conflict setting")
+
+	// ErrAmbiguousRules is returned when we resolve conflicting rules by
+	// strictness, and neither of the rules of a channel and its peer is
+	// stricter than the other.
+	ErrAmbiguousRules = errors.New("channel and peer rules have no " +
+		"strictest rule, one rule must have the same type and no " +
+		"higher thresholds than the other")
+)
+
+// String returns the string representation of a rule conflict setting.
+func (r RuleConflict) String() string {
+	switch r {
+	case RuleConflictReject:
+		return "reject"
+
+	case RuleConflictChannel:
+		return "channel"
+
+	case RuleConflictStrictest:
+		return "strictest"
+
+	default:
+		return "unknown"
+	}
+}
+
+// validate checks that a rule conflict setting is known.
+func (r RuleConflict) validate() error {
+	if r > RuleConflictStrictest {
+		return errInvalidRuleConflict
+	}
+
+	return nil
+}
+
+// lowerThreshold compares two thresholds which are each set in percent or
+// in satoshis, and returns whether each of them is no higher than the other.
+// A threshold that is not set is lower than any other threshold. Thresholds
+// that are set in different units cannot be compared, so neither of them is
+// lower.
+func lowerThreshold(aPercent int, aAmount btcutil.Amount, bPercent int,
+	bAmount btcutil.Amount) (bool, bool) {
+
+	var (
+		aZero = aPercent == 0 && aAmount == 0
+		bZero = bPercent == 0 && bAmount == 0
+	)
+
+	switch {
+	case aZero || bZero:
+		return aZero, bZero
+
+	case aAmount == 0 && bAmount == 0:
+		return aPercent <= bPercent, bPercent <= aPercent
+
+	case aPercent == 0 && bPercent == 0:
+		return aAmount <= bAmount, bAmount <= aAmount
+
+	default:
+		return false, false
+	}
+}
+
+// stricterRule returns true if a channel's rule is at least as strict as its
+// peer's rule, and false if the peer's rule is stricter. A rule is stricter
+// than another if it has the same swap type, and neither of its thresholds is
+// higher than the other rule's, so that it does not require swaps that the
+// other rule does not. If neither rule is stricter, ErrAmbiguousRules is
+// returned.
+func stricterRule(channel, peer *SwapRule) (bool, error) {
+	if channel.Type != peer.Type {
+		return false, ErrAmbiguousRules
+	}
+
+	channelIn, peerIn := lowerThreshold(
+		channel.MinimumIncoming, channel.MinimumIncomingAmount,
+		peer.MinimumIncoming, peer.MinimumIncomingAmount,
+	)
+
+	channelOut, peerOut := lowerThreshold(
+		channel.MinimumOutgoing, channel.MinimumOutgoingAmount,
+		peer.MinimumOutgoing, peer.MinimumOutgoingAmount,
+	)
+
+	switch {
+	case channelIn && channelOut:
+		return true, nil
+
+	case peerIn && peerOut:
+		return false, nil
+
+	default:
+		return false, ErrAmbiguousRules
+	}
+}
+
+// resolveConflict returns true if the rule of a channel whose peer also has
+// a rule applies to the channel, and false if its peer's rule applies. An
+// error is returned if our rule conflict setting cannot resolve the rules.
+func (p Parameters) resolveConflict(channel *SwapRule,
+	peer *SwapRule) (bool, error) {
+
+	switch p.RuleConflict {
+	case RuleConflictChannel:
+		return true, nil
+
+	case RuleConflictStrictest:
+		return stricterRule(channel, peer)
+
+	default:
+		return false, ErrExclusiveRules
+	}
+}
+
+// channelRuleApplies returns a boolean indicating whether a channel's own rule
+// applies to it, which is the case if it has a rule and either its peer does
+// not have a rule, or our rule conflict setting resolves their rules in favor
+// of the channel.
+func (p Parameters) channelRuleApplies(chanID lnwire.ShortChannelID,
+	peer route.Vertex) bool {
+
+	channelRule, ok := p.ChannelRules[chanID]
+	if !ok {
+		return false
+	}
+
+	peerRule, ok := p.PeerRules[peer]
+	if !ok {
+		return true
+	}
+
+	applies, err := p.resolveConflict(channelRule, peerRule)
+	return err == nil && applies
+}
+
+// validateConflicts checks that our rule conflict setting is known, and that
+// it can resolve the rules of each of our open channels that has a rule of its
+// own and whose peer also has a rule.
+func (p Parameters) validateConflicts(
+	openChans []lndclient.ChannelInfo) error {
+
+	if err := p.RuleConflict.validate(); err != nil {
+		return err
+	}
+
+	for _, channel := range openChans {
+		// If we don't have a rule for the peer, there's no way we have
+		// a conflict between this peer and the channel.
+		peerRule, ok := p.PeerRules[channel.PubKeyBytes]
+		if !ok {
+			continue
+		}
+
+		shortID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		channelRule, ok := p.ChannelRules[shortID]
+		if !ok {
+			continue
+		}
+
+		_, err := p.resolveConflict(channelRule, peerRule)
+		if err != nil {
+			log.Debugf("Rules for peer: %v and its channel: %v "+
+				"can't be resolved with rule conflict "+
+				"setting: %v", channel.PubKeyBytes, shortID,
+				p.RuleConflict)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ruleConflicts describes how the conflicts between the rules of our open
+// channels and their peers are resolved.
+type ruleConflicts struct {
+	// channelRule is the set of conflicting channels that their own rule
+	// applies to. These channels are not managed by their peer's rule.
+	channelRule map[lnwire.ShortChannelID]bool
+
+	// peerRule is the set of conflicting channels that their peer's rule
+	// applies to, so their own rule is not applied.
+	peerRule map[lnwire.ShortChannelID]bool
+
+	// unresolved is the set of conflicting channels whose rules we could
+	// not resolve, which are not managed by either rule.
+	unresolved map[lnwire.ShortChannelID]bool
+}
+
+// ruleConflicts resolves the rules of each of the open channels provided that
+// has a rule of its own and whose peer also has a rule. Our parameters are
+// validated against our open channels when they are set, but channels may be
+// opened with a peer afterwards.
+func (p Parameters) ruleConflicts(
+	channels []lndclient.ChannelInfo) *ruleConflicts {
+
+	conflicts := &ruleConflicts{
+		channelRule: make(map[lnwire.ShortChannelID]bool),
+		peerRule:    make(map[lnwire.ShortChannelID]bool),
+		unresolved:  make(map[lnwire.ShortChannelID]bool),
+	}
+
+	for _, channel := range channels {
+		peerRule, ok := p.PeerRules[channel.PubKeyBytes]
+		if !ok {
+			continue
+		}
+
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		channelRule, ok := p.ChannelRules[chanID]
+		if !ok {
+			continue
+		}
+
+		applies, err := p.resolveConflict(channelRule, peerRule)
+		switch {
+		case err != nil:
+			log.Debugf("Channel: %v not eligible for suggestions, "+
+				"its rule conflicts with the rule of peer: "+
+				"%v: %v", chanID, channel.PubKeyBytes, err)
+
+			conflicts.unresolved[chanID] = true
+
+		case applies:
+			conflicts.channelRule[chanID] = true
+
+		default:
+			conflicts.peerRule[chanID] = true
+		}
+	}
+
+	return conflicts
+}
+
+// peerChannels returns the channels provided, less those that are not
+// managed by their peer's rule because their own rule applies to them or
+// their rules could not be resolved.
+func (c *ruleConflicts) peerChannels(
+	channels []lndclient.ChannelInfo) []lndclient.ChannelInfo {
+
+	if len(c.channelRule) == 0 && len(c.unresolved) == 0 {
+		return channels
+	}
+
+	var filtered []lndclient.ChannelInfo
+	for _, channel := range channels {
+		chanID := lnwire.NewShortChanIDFromInt(channel.ChannelID)
+		if c.channelRule[chanID] || c.unresolved[chanID] {
+			continue
+		}
+
+		filtered = append(filtered, channel)
+	}
+
+	return filtered
+}
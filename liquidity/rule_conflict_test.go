@@ -0,0 +1,313 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStricterRule tests comparing the strictness of a channel's rule and its
+// peer's rule.
+func TestStricterRule(t *testing.T) {
+	var (
+		amountRule5000 = NewAmountThresholdRule(5000, 0)
+		amountRule6000 = NewAmountThresholdRule(6000, 0)
+	)
+
+	outRule := func(threshold *ThresholdRule) *SwapRule {
+		return &SwapRule{
+			ThresholdRule: threshold,
+			Type:          swap.TypeOut,
+		}
+	}
+
+	tests := []struct {
+		name            string
+		channel         *SwapRule
+		peer            *SwapRule
+		channelStricter bool
+		err             error
+	}{
+		{
+			name:            "channel stricter",
+			channel:         outRule(NewThresholdRule(20, 10)),
+			peer:            outRule(NewThresholdRule(30, 10)),
+			channelStricter: true,
+		},
+		{
+			name:    "peer stricter",
+			channel: outRule(NewThresholdRule(30, 10)),
+			peer:    outRule(NewThresholdRule(30, 0)),
+		},
+		{
+			name:            "equal",
+			channel:         outRule(NewThresholdRule(30, 10)),
+			peer:            outRule(NewThresholdRule(30, 10)),
+			channelStricter: true,
+		},
+		{
+			name:    "crossed thresholds",
+			channel: outRule(NewThresholdRule(20, 20)),
+			peer:    outRule(NewThresholdRule(30, 10)),
+			err:     ErrAmbiguousRules,
+		},
+		{
+			name:    "different units",
+			channel: outRule(amountRule5000),
+			peer:    outRule(NewThresholdRule(30, 0)),
+			err:     ErrAmbiguousRules,
+		},
+		{
+			name:            "amounts",
+			channel:         outRule(amountRule5000),
+			peer:            outRule(amountRule6000),
+			channelStricter: true,
+		},
+		{
+			name:    "different types",
+			channel: outRule(NewThresholdRule(20, 10)),
+			peer: &SwapRule{
+				ThresholdRule: NewThresholdRule(30, 10),
+				Type:          swap.TypeIn,
+			},
+			err: ErrAmbiguousRules,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			stricter, err := stricterRule(
+				testCase.channel, testCase.peer,
+			)
+			require.Equal(t, testCase.err, err)
+			require.Equal(t, testCase.channelStricter, stricter)
+		})
+	}
+}
+
+// TestValidateConflicts tests validation of channel and peer rules that
+// overlap against our rule conflict setting.
+func TestValidateConflicts(t *testing.T) {
+	tests := []struct {
+		name     string
+		conflict RuleConflict
+		peerRule *SwapRule
+		err      error
+	}{
+		{
+			name:     "reject",
+			conflict: RuleConflictReject,
+			peerRule: chanRule,
+			err:      ErrExclusiveRules,
+		},
+		{
+			name:     "channel overrides peer",
+			conflict: RuleConflictChannel,
+			peerRule: &SwapRule{
+				ThresholdRule: NewThresholdRule(0, 50),
+				Type:          swap.TypeIn,
+			},
+		},
+		{
+			name:     "strictest",
+			conflict: RuleConflictStrictest,
+			peerRule: chanRule,
+		},
+		{
+			name:     "no strictest rule",
+			conflict: RuleConflictStrictest,
+			peerRule: &SwapRule{
+				ThresholdRule: NewThresholdRule(0, 50),
+				Type:          swap.TypeIn,
+			},
+			err: ErrAmbiguousRules,
+		},
+		{
+			name:     "invalid setting",
+			conflict: RuleConflictStrictest + 1,
+			peerRule: chanRule,
+			err:      errInvalidRuleConflict,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			rules := map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			}
+
+			params := defaultParameters
+			params.RuleConflict = testCase.conflict
+			params.ChannelRules = rules
+			params.PeerRules = map[route.Vertex]*SwapRule{
+				peer1: testCase.peerRule,
+			}
+
+			err := params.validateConflicts(
+				[]lndclient.ChannelInfo{channel1},
+			)
+			require.Equal(t, testCase.err, err)
+		})
+	}
+}
+
+// TestRuleConflictSuggestions tests the swaps that we suggest for a peer that
+// has a rule, and has a channel with a rule of its own.
+func TestRuleConflictSuggestions(t *testing.T) {
+	// channel3 is a second channel with peer1, which does not have a rule
+	// of its own.
+	channel3 := lndclient.ChannelInfo{
+		ChannelID:    chanID3.ToUint64(),
+		PubKeyBytes:  peer1,
+		LocalBalance: 10000,
+		Capacity:     10000,
+	}
+
+	// newSwap returns the loop out that we expect over the channels
+	// provided.
+	newSwap := func(amount btcutil.Amount,
+		channels ...lnwire.ShortChannelID) loop.OutRequest {
+
+		chanSet := make(loopdb.ChannelSet, len(channels))
+		for i, channel := range channels {
+			chanSet[i] = channel.ToUint64()
+		}
+
+		prepay, routing := testPPMFees(defaultFeePPM, testQuote, amount)
+
+		return loop.OutRequest{
+			Amount:              amount,
+			OutgoingChanSet:     chanSet,
+			MaxPrepayRoutingFee: prepay,
+			MaxSwapRoutingFee:   routing,
+			MaxMinerFee:         scaleMinerFee(testQuote.MinerFee),
+			MaxSwapFee:          testQuote.SwapFee,
+			MaxPrepayAmount:     testQuote.PrepayAmount,
+			SweepConfTarget:     defaultConfTarget,
+			Initiator:           autoloopSwapInitiator,
+		}
+	}
+
+	var (
+		// peerSwap is the swap for peer1's rule over channel3 alone.
+		peerSwap = newSwap(7500, chanID3)
+
+		// conflicted disqualifies chanID1 because its rule conflicts
+		// with the rule of its peer.
+		conflicted = map[lnwire.ShortChannelID]Reason{
+			chanID1: ReasonRuleConflict,
+		}
+
+		// looseRule is a peer rule that is less strict than chanRule.
+		looseRule = &SwapRule{
+			ThresholdRule: NewThresholdRule(60, 0),
+			Type:          swap.TypeOut,
+		}
+
+		// strictRule is a peer rule that is stricter than chanRule.
+		strictRule = &SwapRule{
+			ThresholdRule: NewThresholdRule(40, 0),
+			Type:          swap.TypeOut,
+		}
+	)
+
+	tests := []struct {
+		name     string
+		conflict RuleConflict
+		peerRule *SwapRule
+		expected *Suggestions
+	}{
+		{
+			// The channel was opened after both rules were set,
+			// so neither of the rules applies to it.
+			name:     "reject",
+			conflict: RuleConflictReject,
+			peerRule: chanRule,
+			expected: &Suggestions{
+				OutSwaps:          []loop.OutRequest{peerSwap},
+				DisqualifiedChans: conflicted,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "channel overrides peer",
+			conflict: RuleConflictChannel,
+			peerRule: strictRule,
+			expected: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, newSwap(7000, chanID3),
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "channel rule stricter",
+			conflict: RuleConflictStrictest,
+			peerRule: looseRule,
+			expected: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					newSwap(8000, chanID3), chan1Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "peer rule stricter",
+			conflict: RuleConflictStrictest,
+			peerRule: strictRule,
+			expected: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					newSwap(10000, chanID1, chanID3),
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel3,
+			}
+
+			rules := map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+			}
+
+			// We set our parameters directly, so that we can test
+			// rules that conflict at suggestion time.
+			manager := NewManager(cfg)
+			manager.params = defaultParameters
+			manager.params.MaxAutoInFlight = 2
+			manager.params.RuleConflict = testCase.conflict
+			manager.params.ChannelRules = rules
+			manager.params.PeerRules = map[route.Vertex]*SwapRule{
+				peer1: testCase.peerRule,
+			}
+
+			actual, err := manager.SuggestSwaps(
+				context.Background(), false,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package liquidity
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// fullDay is the length of the day that our schedule windows are
+	// expressed within.
+	fullDay = time.Hour * 24
+)
+
+var (
+	// ErrEmptyWindow is returned if a schedule window starts and ends at
+	// the same time.
+	ErrEmptyWindow = errors.New("schedule window start and end must " +
+		"differ")
+
+	// ErrInvalidWindowTime is returned if a schedule window starts or
+	// ends outside of the day.
+	ErrInvalidWindowTime = errors.New("schedule window times must be " +
+		"between 00:00 and 24:00")
+
+	// ErrInvalidWeekday is returned if a schedule window has an unknown
+	// day of the week.
+	ErrInvalidWeekday = errors.New("invalid schedule window weekday")
+
+	// weekdays maps the names that we accept for days of the week when
+	// parsing a schedule window to the day they represent.
+	weekdays = map[string]time.Weekday{
+		"sun": time.Sunday,
+		"mon": time.Monday,
+		"tue": time.Tuesday,
+		"wed": time.Wednesday,
+		"thu": time.Thursday,
+		"fri": time.Friday,
+		"sat": time.Saturday,
+	}
+)
+
+// ScheduleWindow is a period of the day in which the autolooper may dispatch
+// swaps. Times are expressed in the local time of the clock that loopd runs
+// with. A window that ends before it starts wraps past midnight, and applies
+// to the day that it starts on.
+type ScheduleWindow struct {
+	// Days is the set of days of the week that the window applies to. If
+	// it is empty, the window applies to every day.
+	Days []time.Weekday
+
+	// Start is the time of day that the window starts, expressed as an
+	// offset from midnight, inclusive.
+	Start time.Duration
+
+	// End is the time of day that the window ends, expressed as an offset
+	// from midnight, exclusive.
+	End time.Duration
+}
+
+// String returns the string representation of a schedule window.
+func (w ScheduleWindow) String() string {
+	days := "daily"
+	if len(w.Days) != 0 {
+		names := make([]string, len(w.Days))
+		for i, day := range w.Days {
+			names[i] = day.String()[:3]
+		}
+
+		days = strings.Join(names, ",")
+	}
+
+	return fmt.Sprintf("%v@%v-%v", days, formatTimeOfDay(w.Start),
+		formatTimeOfDay(w.End))
+}
+
+// validate checks that a schedule window is valid.
+func (w ScheduleWindow) validate() error {
+	if w.Start < 0 || w.Start > fullDay || w.End < 0 || w.End > fullDay {
+		return ErrInvalidWindowTime
+	}
+
+	if w.Start == w.End {
+		return ErrEmptyWindow
+	}
+
+	for _, day := range w.Days {
+		if day < time.Sunday || day > time.Saturday {
+			return fmt.Errorf("%w: %v", ErrInvalidWeekday, day)
+		}
+	}
+
+	return nil
+}
+
+// appliesTo returns true if the window applies to the day of the week
+// provided.
+func (w ScheduleWindow) appliesTo(weekday time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+
+	for _, day := range w.Days {
+		if day == weekday {
+			return true
+		}
+	}
+
+	return false
+}
+
+// contains returns true if the time provided falls within the window.
+func (w ScheduleWindow) contains(now time.Time) bool {
+	year, month, date := now.Date()
+	midnight := time.Date(year, month, date, 0, 0, 0, 0, now.Location())
+	offset := now.Sub(midnight)
+
+	// If our window does not wrap past midnight, we just need to check
+	// that we are within it on one of its days.
+	if w.Start < w.End {
+		return w.appliesTo(now.Weekday()) && offset >= w.Start &&
+			offset < w.End
+	}
+
+	// Otherwise, we are either in the part of the window that started
+	// today, or in the part that started yesterday.
+	if offset >= w.Start && w.appliesTo(now.Weekday()) {
+		return true
+	}
+
+	yesterday := (now.Weekday() + 6) % 7
+
+	return offset < w.End && w.appliesTo(yesterday)
+}
+
+// inSchedule returns true if the time provided falls within any of the
+// schedule windows provided, or if no windows are set.
+func inSchedule(windows []ScheduleWindow, now time.Time) bool {
+	if len(windows) == 0 {
+		return true
+	}
+
+	for _, window := range windows {
+		if window.contains(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseScheduleWindow parses a schedule window in the format
+// [days@]HH:MM-HH:MM, where days is a comma separated list of days or ranges
+// of days, such as mon-fri,sun. If no days are provided, the window applies
+// to every day.
+func ParseScheduleWindow(window string) (ScheduleWindow, error) {
+	var (
+		result ScheduleWindow
+		times  = window
+	)
+
+	if i := strings.Index(window, "@"); i != -1 {
+		days, err := parseWeekdays(window[:i])
+		if err != nil {
+			return result, err
+		}
+
+		result.Days = days
+		times = window[i+1:]
+	}
+
+	parts := strings.Split(times, "-")
+	if len(parts) != 2 {
+		return result, fmt.Errorf("schedule window times must be "+
+			"HH:MM-HH:MM, got: %v", times)
+	}
+
+	var err error
+	result.Start, err = parseTimeOfDay(parts[0])
+	if err != nil {
+		return result, err
+	}
+
+	result.End, err = parseTimeOfDay(parts[1])
+	if err != nil {
+		return result, err
+	}
+
+	return result, result.validate()
+}
+
+// parseWeekdays parses a comma separated list of days or ranges of days.
+func parseWeekdays(days string) ([]time.Weekday, error) {
+	var (
+		result []time.Weekday
+		seen   = make(map[time.Weekday]bool)
+	)
+
+	add := func(day time.Weekday) {
+		if !seen[day] {
+			seen[day] = true
+			result = append(result, day)
+		}
+	}
+
+	for _, entry := range strings.Split(days, ",") {
+		bounds := strings.Split(strings.ToLower(entry), "-")
+		if len(bounds) > 2 {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidWeekday,
+				entry)
+		}
+
+		first, ok := weekdays[bounds[0]]
+		if !ok {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidWeekday,
+				bounds[0])
+		}
+
+		last := first
+		if len(bounds) == 2 {
+			last, ok = weekdays[bounds[1]]
+			if !ok {
+				return nil, fmt.Errorf("%w: %v",
+					ErrInvalidWeekday, bounds[1])
+			}
+		}
+
+		// Ranges may wrap around the end of the week, for example
+		// fri-mon.
+		for day := first; ; day = (day + 1) % 7 {
+			add(day)
+
+			if day == last {
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// parseTimeOfDay parses a time of day in the format HH:MM, returning it as an
+// offset from midnight.
+func parseTimeOfDay(timeOfDay string) (time.Duration, error) {
+	parts := strings.Split(timeOfDay, ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("time of day must be HH:MM, got: %v",
+			timeOfDay)
+	}
+
+	hours, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid hours: %v", parts[0])
+	}
+
+	minutes, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil || minutes >= 60 {
+		return 0, fmt.Errorf("invalid minutes: %v", parts[1])
+	}
+
+	offset := time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute
+
+	if offset > fullDay {
+		return 0, ErrInvalidWindowTime
+	}
+
+	return offset, nil
+}
+
+// formatTimeOfDay formats an offset from midnight as HH:MM.
+func formatTimeOfDay(offset time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(offset.Hours()),
+		int(offset.Minutes())%60)
+}
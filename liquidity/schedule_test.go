@@ -0,0 +1,175 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseScheduleWindow tests parsing of schedule windows.
+func TestParseScheduleWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		window   string
+		expected ScheduleWindow
+		err      error
+	}{
+		{
+			name:   "every day",
+			window: "22:00-06:30",
+			expected: ScheduleWindow{
+				Start: time.Hour * 22,
+				End:   time.Hour*6 + time.Minute*30,
+			},
+		},
+		{
+			name:   "days and ranges",
+			window: "Mon-Wed,sun@00:00-24:00",
+			expected: ScheduleWindow{
+				Days: []time.Weekday{
+					time.Monday, time.Tuesday,
+					time.Wednesday, time.Sunday,
+				},
+				Start: 0,
+				End:   time.Hour * 24,
+			},
+		},
+		{
+			name:   "range wraps week",
+			window: "fri-mon@01:00-02:00",
+			expected: ScheduleWindow{
+				Days: []time.Weekday{
+					time.Friday, time.Saturday,
+					time.Sunday, time.Monday,
+				},
+				Start: time.Hour,
+				End:   time.Hour * 2,
+			},
+		},
+		{
+			name:   "empty window",
+			window: "10:00-10:00",
+			err:    ErrEmptyWindow,
+		},
+		{
+			name:   "time past end of day",
+			window: "10:00-24:01",
+			err:    ErrInvalidWindowTime,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			window, err := ParseScheduleWindow(testCase.window)
+			require.ErrorIs(t, err, testCase.err)
+			if testCase.err != nil {
+				return
+			}
+
+			require.Equal(t, testCase.expected, window)
+		})
+	}
+
+	// Assert that we fail on malformed windows.
+	for _, window := range []string{
+		"funday@10:00-11:00", "10:00", "10-11", "10:60-11:00",
+		"mon-tue-wed@10:00-11:00",
+	} {
+		_, err := ParseScheduleWindow(window)
+		require.Error(t, err, window)
+	}
+}
+
+// TestInSchedule tests checking whether a time falls within our schedule
+// windows.
+func TestInSchedule(t *testing.T) {
+	// thursday is a Thursday at midnight.
+	thursday := time.Date(2020, 2, 13, 0, 0, 0, 0, time.UTC)
+
+	weekdayNights := ScheduleWindow{
+		Days: []time.Weekday{
+			time.Monday, time.Tuesday, time.Wednesday,
+			time.Thursday, time.Friday,
+		},
+		Start: time.Hour * 22,
+		End:   time.Hour * 6,
+	}
+
+	afternoons := ScheduleWindow{
+		Start: time.Hour * 12,
+		End:   time.Hour * 18,
+	}
+
+	tests := []struct {
+		name     string
+		windows  []ScheduleWindow
+		now      time.Time
+		expected bool
+	}{
+		{
+			name:     "no windows",
+			now:      thursday,
+			expected: true,
+		},
+		{
+			name:     "window started today",
+			windows:  []ScheduleWindow{weekdayNights},
+			now:      thursday.Add(time.Hour * 23),
+			expected: true,
+		},
+		{
+			name:     "window started yesterday",
+			windows:  []ScheduleWindow{weekdayNights},
+			now:      thursday.Add(time.Hour * 5),
+			expected: true,
+		},
+		{
+			name:     "window ended",
+			windows:  []ScheduleWindow{weekdayNights},
+			now:      thursday.Add(time.Hour * 6),
+			expected: false,
+		},
+		{
+			// Our Friday night window carries over into Saturday
+			// morning, but does not start on Saturday night.
+			name:     "window started on friday",
+			windows:  []ScheduleWindow{weekdayNights},
+			now:      thursday.Add(time.Hour * (48 + 2)),
+			expected: true,
+		},
+		{
+			name:     "no window on saturday night",
+			windows:  []ScheduleWindow{weekdayNights},
+			now:      thursday.Add(time.Hour * (48 + 23)),
+			expected: false,
+		},
+		{
+			name:     "no window on monday morning",
+			windows:  []ScheduleWindow{weekdayNights},
+			now:      thursday.Add(time.Hour * (96 + 2)),
+			expected: false,
+		},
+		{
+			name: "second window",
+			windows: []ScheduleWindow{
+				weekdayNights, afternoons,
+			},
+			now:      thursday.Add(time.Hour * 12),
+			expected: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t, testCase.expected,
+				inSchedule(testCase.windows, testCase.now),
+			)
+		})
+	}
+}
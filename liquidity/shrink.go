@@ -0,0 +1,485 @@
+package liquidity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// ShrinkPolicy determines how autoloop handles a suggested swap whose amount
+// exceeds the server's maximum swap amount by the time that the swap is
+// dispatched, which happens when the server lowers its maximum after the swap
+// was suggested.
+type ShrinkPolicy uint8
+
+const (
+	// ShrinkPolicySkip does not dispatch the swap. The swap will be
+	// suggested again within the server's new restrictions on our next
+	// autoloop tick.
+	ShrinkPolicySkip ShrinkPolicy = iota
+
+	// ShrinkPolicyResize lowers the amount of the swap to the server's new
+	// maximum.
+	ShrinkPolicyResize
+
+	// ShrinkPolicySplit splits the swap into multiple swaps that each fall
+	// within the server's new maximum. The number of swaps is limited by
+	// our maximum number of in flight swaps.
+	ShrinkPolicySplit
+)
+
+// errInvalidShrinkPolicy is returned when a rule has an unknown shrink
+// policy.
+var errInvalidShrinkPolicy = errors.New("invalid shrink policy")
+
+// String returns the string representation of a shrink policy.
+func (s ShrinkPolicy) String() string {
+	switch s {
+	case ShrinkPolicySkip:
+		return "skip"
+
+	case ShrinkPolicyResize:
+		return "resize"
+
+	case ShrinkPolicySplit:
+		return "split"
+
+	default:
+		return "unknown"
+	}
+}
+
+// validate checks that a shrink policy is known.
+func (s ShrinkPolicy) validate() error {
+	if s > ShrinkPolicySplit {
+		return errInvalidShrinkPolicy
+	}
+
+	return nil
+}
+
+// shrinkAmounts returns the amounts that a swap is dispatched with when its
+// amount exceeds the maximum of the restrictions provided. A split swap is
+// divided into near-equal parts, or into at most maxParts swaps of the
+// maximum amount if we are not allowed enough parts to cover the full amount.
+// If a split produces parts below the minimum, we fall back to resizing the
+// swap. No amounts are returned when the swap is skipped or when the
+// restrictions leave no valid amount.
+func shrinkAmounts(policy ShrinkPolicy, amount btcutil.Amount,
+	restrictions *Restrictions, maxParts int) []btcutil.Amount {
+
+	if restrictions.Maximum <= 0 ||
+		restrictions.Maximum < restrictions.Minimum {
+
+		return nil
+	}
+
+	resized := []btcutil.Amount{restrictions.Maximum}
+
+	switch policy {
+	case ShrinkPolicyResize:
+		return resized
+
+	case ShrinkPolicySplit:
+		parts := int(
+			(amount + restrictions.Maximum - 1) /
+				restrictions.Maximum,
+		)
+
+		if maxParts < 1 {
+			maxParts = 1
+		}
+
+		if parts > maxParts {
+			amounts := make([]btcutil.Amount, maxParts)
+			for i := range amounts {
+				amounts[i] = restrictions.Maximum
+			}
+
+			return amounts
+		}
+
+		base := amount / btcutil.Amount(parts)
+		if base < restrictions.Minimum {
+			return resized
+		}
+
+		// Spread any remainder across our first parts, one satoshi
+		// each, so that our parts add up to the original amount.
+		remainder := int(amount % btcutil.Amount(parts))
+		amounts := make([]btcutil.Amount, parts)
+		for i := range amounts {
+			amounts[i] = base
+			if i < remainder {
+				amounts[i]++
+			}
+		}
+
+		return amounts
+
+	default:
+		return nil
+	}
+}
+
+// scaleFee scales a fee limit down to the share that amount has of total.
+func scaleFee(limit, amount, total btcutil.Amount) btcutil.Amount {
+	return limit * amount / total
+}
+
+// shrinkOutRequest returns a copy of a loop out request for the smaller amount
+// provided. Our swap fee and swap routing fee limits depend on the swap amount,
+// so they are always scaled down. When a swap is split, our remaining limits
+// are also divided between its parts so that the parts do not reserve more of
+// our budget than the original swap. The prepay amount is set by the server
+// independently of the swap amount, so it is never scaled.
+func shrinkOutRequest(request loop.OutRequest, amount btcutil.Amount,
+	split bool) loop.OutRequest {
+
+	total := request.Amount
+
+	request.Amount = amount
+	request.MaxSwapFee = scaleFee(request.MaxSwapFee, amount, total)
+	request.MaxSwapRoutingFee = scaleFee(
+		request.MaxSwapRoutingFee, amount, total,
+	)
+
+	if split {
+		request.MaxPrepayRoutingFee = scaleFee(
+			request.MaxPrepayRoutingFee, amount, total,
+		)
+		request.MaxMinerFee = scaleFee(
+			request.MaxMinerFee, amount, total,
+		)
+		request.MaxTotalCost = scaleFee(
+			request.MaxTotalCost, amount, total,
+		)
+	}
+
+	return request
+}
+
+// shrinkInRequest returns a copy of a loop in request for the smaller amount
+// provided, scaling its fee limits in the same way as shrinkOutRequest.
+func shrinkInRequest(request loop.LoopInRequest, amount btcutil.Amount,
+	split bool) loop.LoopInRequest {
+
+	total := request.Amount
+
+	request.Amount = amount
+	request.MaxSwapFee = scaleFee(request.MaxSwapFee, amount, total)
+
+	if split {
+		request.MaxMinerFee = scaleFee(
+			request.MaxMinerFee, amount, total,
+		)
+		request.MaxTotalCost = scaleFee(
+			request.MaxTotalCost, amount, total,
+		)
+	}
+
+	return request
+}
+
+// shrinkNote returns the note that we attach to a swap that was dispatched
+// with a shrink policy.
+func shrinkNote(policy ShrinkPolicy, original, maximum btcutil.Amount, part,
+	parts int) string {
+
+	note := fmt.Sprintf("autoloop: server maximum %v below suggested "+
+		"amount %v, %v policy applied", maximum, original, policy)
+
+	if parts > 1 {
+		note = fmt.Sprintf("%v (part %v of %v)", note, part, parts)
+	}
+
+	return note
+}
+
+// dispatchSet is the set of swaps that autoloop dispatches once our shrink
+// policies have been applied to its suggestions. Each swap has a note that
+// records the shrink policy that was applied to it, which is empty if the
+// swap was not adjusted. Each loop out also has the sweep destination that
+// its address is derived from when it is dispatched, which is nil if it
+// sweeps to our wallet.
+type dispatchSet struct {
+	outSwaps []loop.OutRequest
+	outNotes []string
+	outDests []*SweepDestination
+	inSwaps  []loop.LoopInRequest
+	inNotes  []string
+}
+
+// newDispatchSet returns a dispatch set that contains our suggested swaps
+// without any adjustments.
+func newDispatchSet(suggestion *Suggestions) *dispatchSet {
+	outDests := make([]*SweepDestination, len(suggestion.OutSwaps))
+	for i, dest := range suggestion.outDests {
+		outDests[i] = dest
+	}
+
+	return &dispatchSet{
+		outSwaps: suggestion.OutSwaps,
+		outNotes: make([]string, len(suggestion.OutSwaps)),
+		outDests: outDests,
+		inSwaps:  suggestion.InSwaps,
+		inNotes:  make([]string, len(suggestion.InSwaps)),
+	}
+}
+
+// dispatchRestrictions returns the current restrictions for a swap type,
+// limited by our client restrictions. Unlike getSwapRestrictions, we do not
+// fail if the server's new restrictions conflict with our client
+// restrictions, because this is exactly the case that our shrink policies
+// handle.
+func (m *Manager) dispatchRestrictions(ctx context.Context,
+	swapType swap.Type) (*Restrictions, error) {
+
+	restrictions, err := m.cfg.Quotes.Restrictions(ctx, swapType)
+	if err != nil {
+		return nil, err
+	}
+
+	client := m.params.ClientRestrictions
+	if client.Minimum > restrictions.Minimum {
+		restrictions.Minimum = client.Minimum
+	}
+
+	if client.Maximum != 0 && client.Maximum < restrictions.Maximum {
+		restrictions.Maximum = client.Maximum
+	}
+
+	return restrictions, nil
+}
+
+// applyShrinkPolicies checks the swaps that we suggested against the server's
+// current restrictions, which may have changed since our suggestions were
+// made. Swaps that exceed the server's maximum are skipped, resized or split
+// according to the shrink policy of the rule that they were suggested for.
+func (m *Manager) applyShrinkPolicies(ctx context.Context,
+	suggestion *Suggestions) (*dispatchSet, error) {
+
+	m.paramsLock.Lock()
+	defer m.paramsLock.Unlock()
+
+	set := newDispatchSet(suggestion)
+
+	var outRestrictions, inRestrictions *Restrictions
+	if len(suggestion.OutSwaps) > 0 {
+		var err error
+		outRestrictions, err = m.dispatchRestrictions(ctx, swap.TypeOut)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(suggestion.InSwaps) > 0 {
+		var err error
+		inRestrictions, err = m.dispatchRestrictions(ctx, swap.TypeIn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var shrunk bool
+	for _, out := range suggestion.OutSwaps {
+		if out.Amount > outRestrictions.Maximum {
+			shrunk = true
+		}
+	}
+
+	for _, in := range suggestion.InSwaps {
+		if in.Amount > inRestrictions.Maximum {
+			shrunk = true
+		}
+	}
+
+	// If all of our swaps are still within the server's maximum, we can
+	// dispatch them as suggested.
+	if !shrunk {
+		return set, nil
+	}
+
+	// We need our channels' peers to lookup the peer rules that loop outs
+	// were suggested for.
+	channels, err := m.cfg.Channels.ListChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	channelPeers := make(map[uint64]route.Vertex, len(channels))
+	for _, channel := range channels {
+		channelPeers[channel.ChannelID] = channel.PubKeyBytes
+	}
+
+	// Split swaps may not take us over our in flight limit, so we work
+	// out how many additional swaps we have room for.
+	loopOut, err := m.cfg.Swaps.ListLoopOut()
+	if err != nil {
+		return nil, err
+	}
+
+	loopIn, err := m.cfg.Swaps.ListLoopIn()
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := m.checkExistingAutoLoops(ctx, loopOut, loopIn)
+	if err != nil {
+		return nil, err
+	}
+
+	spare := m.params.inFlightLimit(channels) - summary.inFlightCount -
+		len(suggestion.OutSwaps) - len(suggestion.InSwaps)
+
+	set.outSwaps = nil
+	set.outNotes = nil
+	set.outDests = nil
+	for j, out := range suggestion.OutSwaps {
+		dest := suggestion.outDests[j]
+
+		if out.Amount <= outRestrictions.Maximum {
+			set.outSwaps = append(set.outSwaps, out)
+			set.outNotes = append(set.outNotes, "")
+			set.outDests = append(set.outDests, dest)
+
+			continue
+		}
+
+		policy := m.outShrinkPolicy(&out, channelPeers)
+		amounts := shrinkAmounts(
+			policy, out.Amount, outRestrictions, spare+1,
+		)
+		if len(amounts) == 0 {
+			log.Infof("autoloop out of %v over %v not dispatched: "+
+				"server maximum %v below amount, %v policy "+
+				"applied", out.Amount, out.OutgoingChanSet,
+				outRestrictions.Maximum, policy)
+
+			continue
+		}
+
+		spare -= len(amounts) - 1
+		split := len(amounts) > 1
+		for i, amount := range amounts {
+			set.outSwaps = append(
+				set.outSwaps, shrinkOutRequest(out, amount, split),
+			)
+			set.outNotes = append(set.outNotes, shrinkNote(
+				policy, out.Amount, outRestrictions.Maximum,
+				i+1, len(amounts),
+			))
+			set.outDests = append(set.outDests, dest)
+		}
+	}
+
+	set.inSwaps = nil
+	set.inNotes = nil
+	for _, in := range suggestion.InSwaps {
+		if in.Amount <= inRestrictions.Maximum {
+			set.inSwaps = append(set.inSwaps, in)
+			set.inNotes = append(set.inNotes, "")
+
+			continue
+		}
+
+		policy := m.inShrinkPolicy(&in)
+		amounts := shrinkAmounts(
+			policy, in.Amount, inRestrictions, spare+1,
+		)
+		if len(amounts) == 0 {
+			log.Infof("autoloop in of %v over %v not dispatched: "+
+				"server maximum %v below amount, %v policy "+
+				"applied", in.Amount, in.LastHop,
+				inRestrictions.Maximum, policy)
+
+			continue
+		}
+
+		spare -= len(amounts) - 1
+		split := len(amounts) > 1
+		for i, amount := range amounts {
+			set.inSwaps = append(
+				set.inSwaps, shrinkInRequest(in, amount, split),
+			)
+			set.inNotes = append(set.inNotes, shrinkNote(
+				policy, in.Amount, inRestrictions.Maximum,
+				i+1, len(amounts),
+			))
+		}
+	}
+
+	return set, nil
+}
+
+// recordShrink records the shrink policy that was applied to a dispatched swap
+// in its notes. Swaps that were not adjusted have no note, so are left as is.
+func (m *Manager) recordShrink(swapType swap.Type, hash lntypes.Hash,
+	note string) {
+
+	if note == "" || m.cfg.Notes == nil {
+		return
+	}
+
+	if err := m.cfg.Notes.SetSwapNotes(swapType, hash, note); err != nil {
+		log.Errorf("could not record shrink policy for swap %v: %v",
+			hash, err)
+	}
+}
+
+// outShrinkPolicy returns the shrink policy of the rule that a loop out was
+// suggested for. Swaps over a single channel with a channel rule use that
+// rule unless its peer's rule applies to it instead, swaps over a channel
+// group use the group's rule, and all other swaps use the rule of their
+// channels' peer.
+func (m *Manager) outShrinkPolicy(request *loop.OutRequest,
+	channelPeers map[uint64]route.Vertex) ShrinkPolicy {
+
+	if len(request.OutgoingChanSet) == 0 {
+		return ShrinkPolicySkip
+	}
+
+	channel := request.OutgoingChanSet[0]
+	chanID := lnwire.NewShortChanIDFromInt(channel)
+	peer, havePeer := channelPeers[channel]
+
+	rule, ok := m.params.ChannelRules[chanID]
+	if ok && (!havePeer || m.params.channelRuleApplies(chanID, peer)) {
+		return rule.ShrinkPolicy
+	}
+
+	if name, ok := m.params.channelGroup(chanID); ok {
+		return m.params.ChannelGroups[name].Rule.ShrinkPolicy
+	}
+
+	if !havePeer {
+		return ShrinkPolicySkip
+	}
+
+	if rule, ok := m.params.PeerRules[peer]; ok {
+		return rule.ShrinkPolicy
+	}
+
+	return ShrinkPolicySkip
+}
+
+// inShrinkPolicy returns the shrink policy of the peer rule that a loop in was
+// suggested for.
+func (m *Manager) inShrinkPolicy(request *loop.LoopInRequest) ShrinkPolicy {
+	if request.LastHop == nil {
+		return ShrinkPolicySkip
+	}
+
+	if rule, ok := m.params.PeerRules[*request.LastHop]; ok {
+		return rule.ShrinkPolicy
+	}
+
+	return ShrinkPolicySkip
+}
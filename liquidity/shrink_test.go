@@ -0,0 +1,124 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/stretchr/testify/require"
+)
+
+// TestShrinkAmounts tests the amounts that we dispatch swaps with when their
+// amount exceeds the server's maximum.
+func TestShrinkAmounts(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       ShrinkPolicy
+		amount       btcutil.Amount
+		restrictions *Restrictions
+		maxParts     int
+		expected     []btcutil.Amount
+	}{
+		{
+			name:         "skip",
+			policy:       ShrinkPolicySkip,
+			amount:       1000,
+			restrictions: NewRestrictions(100, 600),
+			maxParts:     2,
+		},
+		{
+			name:         "resize",
+			policy:       ShrinkPolicyResize,
+			amount:       1000,
+			restrictions: NewRestrictions(100, 600),
+			maxParts:     2,
+			expected:     []btcutil.Amount{600},
+		},
+		{
+			name:         "resize below minimum",
+			policy:       ShrinkPolicyResize,
+			amount:       1000,
+			restrictions: NewRestrictions(700, 600),
+			maxParts:     2,
+		},
+		{
+			name:         "split evenly",
+			policy:       ShrinkPolicySplit,
+			amount:       1000,
+			restrictions: NewRestrictions(100, 600),
+			maxParts:     2,
+			expected:     []btcutil.Amount{500, 500},
+		},
+		{
+			name:         "split with remainder",
+			policy:       ShrinkPolicySplit,
+			amount:       1001,
+			restrictions: NewRestrictions(100, 400),
+			maxParts:     3,
+			expected:     []btcutil.Amount{334, 334, 333},
+		},
+		{
+			name:         "split limited by in flight",
+			policy:       ShrinkPolicySplit,
+			amount:       1000,
+			restrictions: NewRestrictions(100, 400),
+			maxParts:     2,
+			expected:     []btcutil.Amount{400, 400},
+		},
+		{
+			name:         "split parts below minimum",
+			policy:       ShrinkPolicySplit,
+			amount:       1000,
+			restrictions: NewRestrictions(550, 600),
+			maxParts:     2,
+			expected:     []btcutil.Amount{600},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			amounts := shrinkAmounts(
+				testCase.policy, testCase.amount,
+				testCase.restrictions, testCase.maxParts,
+			)
+			require.Equal(t, testCase.expected, amounts)
+		})
+	}
+}
+
+// TestShrinkOutRequest tests scaling of loop out fee limits for shrunk swaps.
+func TestShrinkOutRequest(t *testing.T) {
+	request := loop.OutRequest{
+		Amount:              1000,
+		MaxSwapFee:          100,
+		MaxSwapRoutingFee:   50,
+		MaxPrepayRoutingFee: 20,
+		MaxPrepayAmount:     300,
+		MaxMinerFee:         40,
+	}
+
+	// When we resize a swap, only our amount based limits are scaled.
+	resized := shrinkOutRequest(request, 500, false)
+	require.Equal(t, loop.OutRequest{
+		Amount:              500,
+		MaxSwapFee:          50,
+		MaxSwapRoutingFee:   25,
+		MaxPrepayRoutingFee: 20,
+		MaxPrepayAmount:     300,
+		MaxMinerFee:         40,
+	}, resized)
+
+	// When we split a swap, our per-swap limits are divided as well, but
+	// the prepay amount is left unchanged.
+	part := shrinkOutRequest(request, 500, true)
+	require.Equal(t, loop.OutRequest{
+		Amount:              500,
+		MaxSwapFee:          50,
+		MaxSwapRoutingFee:   25,
+		MaxPrepayRoutingFee: 10,
+		MaxPrepayAmount:     300,
+		MaxMinerFee:         20,
+	}, part)
+}
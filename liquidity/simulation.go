@@ -0,0 +1,178 @@
+package liquidity
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+)
+
+// defaultSimulationCooldown is the amount of time that we wait before we
+// simulate another swap for the same channels or peer if no success cooldown
+// is set.
+const defaultSimulationCooldown = time.Hour * 24
+
+// simulationState tracks the targets that we have recently simulated swaps
+// for. Simulated swaps do not change our channel balances, so without it we
+// would simulate the same swap on every tick.
+type simulationState struct {
+	// simulated maps the targets of simulated swaps to the time until
+	// which we do not simulate another swap for them.
+	simulated map[string]time.Time
+
+	sync.Mutex
+}
+
+// newSimulationState creates an empty simulation state.
+func newSimulationState() *simulationState {
+	return &simulationState{
+		simulated: make(map[string]time.Time),
+	}
+}
+
+// simulationCooldown returns the amount of time that we wait before we
+// simulate another swap for a target. A real swap would have corrected the
+// target's balance, so we use our success cooldown if it is set.
+func (p Parameters) simulationCooldown() time.Duration {
+	if p.SuccessCooldown != 0 {
+		return p.SuccessCooldown
+	}
+
+	return defaultSimulationCooldown
+}
+
+// simulatedOut creates a record of a loop out that we would have dispatched.
+func simulatedOut(out loop.OutRequest) *loopdb.SimulatedSwap {
+	return &loopdb.SimulatedSwap{
+		Type:       loopdb.SimulatedLoopOut,
+		Channels:   out.OutgoingChanSet,
+		Amount:     out.Amount,
+		SwapFee:    out.MaxSwapFee,
+		MinerFee:   out.MaxMinerFee,
+		RoutingFee: out.MaxSwapRoutingFee + out.MaxPrepayRoutingFee,
+	}
+}
+
+// simulatedIn creates a record of a loop in that we would have dispatched.
+func simulatedIn(in loop.LoopInRequest) *loopdb.SimulatedSwap {
+	return &loopdb.SimulatedSwap{
+		Type:     loopdb.SimulatedLoopIn,
+		LastHop:  in.LastHop,
+		Amount:   in.Amount,
+		SwapFee:  in.MaxSwapFee,
+		MinerFee: in.MaxMinerFee,
+	}
+}
+
+// simulatedRebalance creates a record of a circular rebalance that we would
+// have dispatched.
+func simulatedRebalance(
+	rebalance loop.RebalanceRequest) *loopdb.SimulatedSwap {
+
+	lastHop := rebalance.LastHop
+
+	return &loopdb.SimulatedSwap{
+		Type: loopdb.SimulatedRebalance,
+		Channels: []uint64{
+			rebalance.OutgoingChannel, rebalance.IncomingChannel,
+		},
+		LastHop:    &lastHop,
+		Amount:     rebalance.Amount,
+		RoutingFee: rebalance.MaxFee,
+	}
+}
+
+// simulateSwaps records the swaps that we would have dispatched rather than
+// dispatching them. Swaps are not simulated for targets that we have
+// simulated a swap for within our simulation cooldown.
+func (m *Manager) simulateSwaps(set *dispatchSet,
+	rebalances []loop.RebalanceRequest) error {
+
+	var (
+		now      = m.cfg.Clock.Now()
+		until    = now.Add(m.params.simulationCooldown())
+		swaps    []*loopdb.SimulatedSwap
+		targets  []string
+		recorded = make(map[string]bool)
+	)
+
+	m.simulation.Lock()
+	defer m.simulation.Unlock()
+
+	for target, cooldown := range m.simulation.simulated {
+		if !now.Before(cooldown) {
+			delete(m.simulation.simulated, target)
+		}
+	}
+
+	// We identify our targets in the same way as the swaps that we queue
+	// for approval.
+	simulate := func(approval *PendingApproval,
+		swap *loopdb.SimulatedSwap) {
+
+		target := approval.target()
+		if _, ok := m.simulation.simulated[target]; ok {
+			return
+		}
+
+		if recorded[target] {
+			return
+		}
+		recorded[target] = true
+
+		swap.Time = now
+		swaps = append(swaps, swap)
+		targets = append(targets, target)
+	}
+
+	for _, out := range set.outSwaps {
+		out := out
+		simulate(&PendingApproval{LoopOut: &out}, simulatedOut(out))
+	}
+
+	for _, in := range set.inSwaps {
+		in := in
+		simulate(&PendingApproval{LoopIn: &in}, simulatedIn(in))
+	}
+
+	for _, rebalance := range rebalances {
+		rebalance := rebalance
+		simulate(
+			&PendingApproval{Rebalance: &rebalance},
+			simulatedRebalance(rebalance),
+		)
+	}
+
+	if len(swaps) == 0 {
+		return nil
+	}
+
+	for _, swap := range swaps {
+		log.Infof("simulated autoloop %v: %v sats over %v, projected "+
+			"cost: %v", swap.Type, swap.Amount, swap.Channels,
+			swap.Cost())
+	}
+
+	if m.cfg.Recorder != nil {
+		err := m.cfg.Recorder.RecordSimulatedSwaps(swaps)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, target := range targets {
+		m.simulation.simulated[target] = until
+	}
+
+	return nil
+}
+
+// ResetSimulation clears the targets that we have recently simulated swaps
+// for, so that a new simulation starts from scratch.
+func (m *Manager) ResetSimulation() {
+	m.simulation.Lock()
+	defer m.simulation.Unlock()
+
+	m.simulation.simulated = make(map[string]time.Time)
+}
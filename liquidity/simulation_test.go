@@ -0,0 +1,97 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSimulateSwaps tests recording of the swaps that we would dispatch in
+// simulation mode, and the cooldown that we apply to their targets.
+func TestSimulateSwaps(t *testing.T) {
+	cfg, _ := newTestConfig()
+
+	testClock := clock.NewTestClock(testTime)
+	cfg.Clock = testClock
+
+	recorder := &testRecorder{}
+	cfg.Recorder = recorder
+
+	manager := NewManager(cfg)
+	manager.params.SimulationMode = true
+	manager.params.SuccessCooldown = time.Hour
+
+	// Autoloop is not enabled, but we should still simulate swaps.
+	allowed, err := manager.dispatchAllowed(context.Background())
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	rebalance := loop.RebalanceRequest{
+		Amount:          1000,
+		MaxFee:          10,
+		OutgoingChannel: chanID1.ToUint64(),
+		IncomingChannel: chanID2.ToUint64(),
+		LastHop:         peer2,
+	}
+
+	suggestion := &Suggestions{
+		OutSwaps: []loop.OutRequest{chan1Rec},
+	}
+	rebalances := []loop.RebalanceRequest{rebalance}
+
+	simulate := func() {
+		err := manager.simulateSwaps(
+			newDispatchSet(suggestion), rebalances,
+		)
+		require.NoError(t, err)
+	}
+
+	simulate()
+
+	expected := []*loopdb.SimulatedSwap{
+		{
+			Time:     testTime,
+			Type:     loopdb.SimulatedLoopOut,
+			Channels: chan1Rec.OutgoingChanSet,
+			Amount:   chan1Rec.Amount,
+			SwapFee:  chan1Rec.MaxSwapFee,
+			MinerFee: chan1Rec.MaxMinerFee,
+			RoutingFee: chan1Rec.MaxSwapRoutingFee +
+				chan1Rec.MaxPrepayRoutingFee,
+		},
+		{
+			Time: testTime,
+			Type: loopdb.SimulatedRebalance,
+			Channels: []uint64{
+				chanID1.ToUint64(), chanID2.ToUint64(),
+			},
+			LastHop:    &peer2,
+			Amount:     1000,
+			RoutingFee: 10,
+		},
+	}
+	require.Equal(t, expected, recorder.simulated)
+
+	// Our swaps did not change our balances, so they are suggested again,
+	// but we should not simulate them again within our cooldown.
+	testClock.SetTime(testTime.Add(time.Minute))
+	simulate()
+	require.Len(t, recorder.simulated, 2)
+
+	// Once our cooldown has passed, our swaps should be simulated again.
+	later := testTime.Add(time.Hour)
+	testClock.SetTime(later)
+	simulate()
+	require.Len(t, recorder.simulated, 4)
+	require.Equal(t, later, recorder.simulated[2].Time)
+
+	// Resetting our simulation should clear our cooldown.
+	manager.ResetSimulation()
+	simulate()
+	require.Len(t, recorder.simulated, 6)
+}
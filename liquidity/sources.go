@@ -0,0 +1,199 @@
+package liquidity
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// ChannelSource provides the set of channels that the liquidity manager
+// assesses. Projects that reuse the liquidity manager may provide channels
+// from any source, or a subset of their node's channels.
+type ChannelSource interface {
+	// ListChannels returns the channels that the manager may suggest
+	// swaps for.
+	ListChannels(ctx context.Context) ([]lndclient.ChannelInfo, error)
+}
+
+// PeerSource provides the set of peers that our node is currently connected
+// to, so that the liquidity manager does not suggest swaps that route through
+// offline peers.
+type PeerSource interface {
+	// ListPeers returns the peers that we are currently connected to.
+	ListPeers(ctx context.Context) ([]lndclient.Peer, error)
+}
+
+// QuoteSource provides the restrictions and quotes for the swaps that the
+// liquidity manager suggests.
+type QuoteSource interface {
+	// Restrictions returns the minimum and maximum amounts for a swap of
+	// the type provided.
+	Restrictions(ctx context.Context, swapType swap.Type) (*Restrictions,
+		error)
+
+	// LoopOutQuote returns a quote for a loop out.
+	LoopOutQuote(ctx context.Context,
+		request *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote, error)
+
+	// LoopInQuote returns a quote for a loop in.
+	LoopInQuote(ctx context.Context,
+		request *loop.LoopInQuoteRequest) (*loop.LoopInQuote, error)
+}
+
+// SwapDispatcher executes the swaps that the liquidity manager decides to
+// dispatch.
+type SwapDispatcher interface {
+	// LoopOut dispatches a loop out.
+	LoopOut(ctx context.Context, request *loop.OutRequest) (
+		*loop.LoopOutSwapInfo, error)
+
+	// LoopIn dispatches a loop in.
+	LoopIn(ctx context.Context,
+		request *loop.LoopInRequest) (*loop.LoopInSwapInfo, error)
+}
+
+// SwapStore provides the history of swaps that the liquidity manager uses to
+// account for budgets, backoff and swaps that are in flight.
+type SwapStore interface {
+	// ListLoopOut returns all of our loop out swaps.
+	ListLoopOut() ([]*loopdb.LoopOut, error)
+
+	// ListLoopIn returns all of our loop in swaps.
+	ListLoopIn() ([]*loopdb.LoopIn, error)
+}
+
+// WalletSource provides the on-chain fee estimates that the liquidity manager
+// checks its fee limits against, and the wallet addresses that its loop outs
+// sweep to. lnd's wallet kit client implements this interface.
+type WalletSource interface {
+	// EstimateFee returns a fee estimate for the confirmation target
+	// provided.
+	EstimateFee(ctx context.Context, confTarget int32) (
+		chainfee.SatPerKWeight, error)
+
+	// NextAddr returns a fresh address from our wallet.
+	NextAddr(ctx context.Context) (btcutil.Address, error)
+}
+
+// GraphSource provides the routes and channel policies that the liquidity
+// manager uses to estimate routing fees. lnd's lightning client implements
+// this interface.
+type GraphSource interface {
+	// QueryRoutes returns a route for the payment provided.
+	QueryRoutes(ctx context.Context, req lndclient.QueryRoutesRequest) (
+		*lndclient.QueryRoutesResponse, error)
+
+	// GetChanInfo returns the policies of the channel provided.
+	GetChanInfo(ctx context.Context, chanID uint64) (
+		*lndclient.ChannelEdge, error)
+}
+
+// NodeSource provides information about our node, its history and its
+// invoices. lnd's lightning client implements this interface.
+type NodeSource interface {
+	// GetInfo returns information about our node, including its current
+	// block height.
+	GetInfo(ctx context.Context) (*lndclient.Info, error)
+
+	// ForwardingHistory returns a page of our node's forwarding events.
+	ForwardingHistory(ctx context.Context,
+		req lndclient.ForwardingHistoryRequest) (
+		*lndclient.ForwardingHistoryResponse, error)
+
+	// ClosedChannels returns the channels that our node has closed.
+	ClosedChannels(ctx context.Context) ([]lndclient.ClosedChannel, error)
+
+	// DecodePaymentRequest decodes a payment request.
+	DecodePaymentRequest(ctx context.Context, payReq string) (
+		*lndclient.PaymentRequest, error)
+}
+
+// SyncChecker reports whether our node is synced to the chain, so that the
+// liquidity manager does not dispatch swaps based on stale information.
+type SyncChecker interface {
+	// LndSynced returns true if our node is synced to the chain.
+	LndSynced() bool
+}
+
+// NoticeSource provides the operational notices that the swap server has
+// published, such as maintenance windows.
+type NoticeSource interface {
+	// ServerNotices returns the notices that the server has published.
+	ServerNotices(ctx context.Context) ([]*loop.ServerNotice, error)
+}
+
+// Rebalancer executes the circular rebalances that the liquidity manager
+// suggests in place of loop outs, and provides the rebalances that it has
+// already executed.
+type Rebalancer interface {
+	// Rebalance executes a circular rebalance between two of our
+	// channels.
+	Rebalance(ctx context.Context, request *loop.RebalanceRequest) (
+		*loop.RebalanceInfo, error)
+
+	// ListRebalances returns all of our circular rebalances.
+	ListRebalances() ([]*loopdb.Rebalance, error)
+}
+
+// PreSwapRebalancer is given the chance to rebalance the channels of each
+// loop out that the liquidity manager dispatches off-chain first.
+type PreSwapRebalancer interface {
+	// PreSwapRebalance rebalances up to the amount of the swap provided,
+	// returning the amount that it shifted.
+	PreSwapRebalance(ctx context.Context,
+		request *PreSwapRebalanceRequest) (*PreSwapRebalanceResult,
+		error)
+}
+
+// SwapAnnotator records notes on the swaps that the liquidity manager
+// dispatched.
+type SwapAnnotator interface {
+	// SetSwapNotes replaces the notes of a swap.
+	SetSwapNotes(swapType swap.Type, hash lntypes.Hash,
+		notes string) error
+}
+
+// AutoloopRecorder stores the history of the liquidity manager's autoloop
+// ticks and the decisions that it made in them. loop's swap store implements
+// this interface.
+type AutoloopRecorder interface {
+	// RecordAutoloopTick stores the metrics of an autoloop tick.
+	RecordAutoloopTick(tick *loopdb.AutoloopTick) error
+
+	// RecordSuggestionRound stores the suggestions made by an autoloop
+	// tick and the decisions made for them.
+	RecordSuggestionRound(round *loopdb.SuggestionRound) error
+
+	// RecordSimulatedSwaps stores the swaps that autoloop would have
+	// dispatched while it is in simulation mode.
+	RecordSimulatedSwaps(swaps []*loopdb.SimulatedSwap) error
+}
+
+var _ ChannelSource = (*lndChannelSource)(nil)
+
+// lndChannelSource is a channel source that provides all of the channels of
+// an lnd node.
+type lndChannelSource struct {
+	client lndclient.LightningClient
+}
+
+// NewLndChannelSource returns a channel source that provides all of the
+// channels of the lnd node provided.
+func NewLndChannelSource(client lndclient.LightningClient) ChannelSource {
+	return &lndChannelSource{
+		client: client,
+	}
+}
+
+// ListChannels returns all of the channels of our lnd node.
+func (l *lndChannelSource) ListChannels(ctx context.Context) (
+	[]lndclient.ChannelInfo, error) {
+
+	return l.client.ListChannels(ctx, false, false)
+}
@@ -0,0 +1,25 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLndChannelSource tests that our lnd channel source provides all of the
+// channels of our lnd node.
+func TestLndChannelSource(t *testing.T) {
+	lnd := test.NewMockLnd()
+	lnd.Channels = []lndclient.ChannelInfo{
+		channel1, channel2,
+	}
+
+	source := NewLndChannelSource(lnd.Client)
+
+	channels, err := source.ListChannels(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, lnd.Channels, channels)
+}
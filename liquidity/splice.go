@@ -0,0 +1,243 @@
+package liquidity
+
+import (
+	"context"
+	"strings"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// splice describes a channel that was spliced, which spends its funding
+// output in a transaction that funds a new channel with the same peer. The
+// new channel has a new short channel ID and may have a different capacity.
+type splice struct {
+	// oldID is the short channel ID of the channel before the splice.
+	oldID lnwire.ShortChannelID
+
+	// newID is the short channel ID of the channel after the splice.
+	newID lnwire.ShortChannelID
+
+	// oldCapacity is the capacity of the channel before the splice.
+	oldCapacity btcutil.Amount
+
+	// newCapacity is the capacity of the channel after the splice.
+	newCapacity btcutil.Amount
+}
+
+// fundingTxid returns the txid of a channel point, which is formatted as
+// txid:index.
+func fundingTxid(channelPoint string) string {
+	parts := strings.Split(channelPoint, ":")
+	return parts[0]
+}
+
+// findSplices returns the splices that replaced the channels provided with
+// channels that are currently open. A closed channel is considered to be
+// spliced if its closing transaction funds an open channel with the same
+// peer. Channels that were spliced more than once are mapped to the channel
+// that is currently open.
+func findSplices(ids []lnwire.ShortChannelID, closed []lndclient.ClosedChannel,
+	open []lndclient.ChannelInfo) map[lnwire.ShortChannelID]*splice {
+
+	type fundingKey struct {
+		txid string
+		peer route.Vertex
+	}
+
+	// Index the channels that each transaction funded, so that we can
+	// follow a channel from its closing transaction to its successor.
+	var (
+		openByTx   = make(map[fundingKey]lndclient.ChannelInfo)
+		closedByTx = make(map[fundingKey]lndclient.ClosedChannel)
+		closedByID = make(map[uint64]lndclient.ClosedChannel)
+	)
+
+	for _, channel := range open {
+		key := fundingKey{
+			txid: fundingTxid(channel.ChannelPoint),
+			peer: channel.PubKeyBytes,
+		}
+		openByTx[key] = channel
+	}
+
+	for _, channel := range closed {
+		key := fundingKey{
+			txid: fundingTxid(channel.ChannelPoint),
+			peer: channel.PubKeyBytes,
+		}
+		closedByTx[key] = channel
+		closedByID[channel.ChannelID] = channel
+	}
+
+	splices := make(map[lnwire.ShortChannelID]*splice)
+	for _, id := range ids {
+		channel, ok := closedByID[id.ToUint64()]
+		if !ok {
+			continue
+		}
+		oldCapacity := channel.Capacity
+
+		// Follow the channel through each of its splices until we
+		// reach a channel that is open, bounding our search by the
+		// number of closed channels so that we always terminate.
+		for i := 0; i <= len(closed); i++ {
+			key := fundingKey{
+				txid: channel.ClosingTxHash,
+				peer: channel.PubKeyBytes,
+			}
+
+			if next, ok := openByTx[key]; ok {
+				splices[id] = &splice{
+					oldID: id,
+					newID: lnwire.NewShortChanIDFromInt(
+						next.ChannelID,
+					),
+					oldCapacity: oldCapacity,
+					newCapacity: next.Capacity,
+				}
+
+				break
+			}
+
+			next, ok := closedByTx[key]
+			if !ok {
+				break
+			}
+			channel = next
+		}
+	}
+
+	return splices
+}
+
+// replaceChannel replaces a channel in a set of channels with another, and
+// drops it instead if the set already contains its replacement.
+func replaceChannel(channels []lnwire.ShortChannelID, oldID,
+	newID lnwire.ShortChannelID) []lnwire.ShortChannelID {
+
+	var haveNew bool
+	for _, id := range channels {
+		if id == newID {
+			haveNew = true
+			break
+		}
+	}
+
+	replaced := make([]lnwire.ShortChannelID, 0, len(channels))
+	for _, id := range channels {
+		switch {
+		case id != oldID:
+			replaced = append(replaced, id)
+
+		case !haveNew:
+			replaced = append(replaced, newID)
+		}
+	}
+
+	return replaced
+}
+
+// splicedCandidates returns the channels that our parameters refer to by
+// short channel ID that are no longer open, and may have been spliced.
+func (p Parameters) splicedCandidates(
+	open []lndclient.ChannelInfo) []lnwire.ShortChannelID {
+
+	openIDs := make(map[lnwire.ShortChannelID]bool)
+	for _, channel := range open {
+		openIDs[lnwire.NewShortChanIDFromInt(channel.ChannelID)] = true
+	}
+
+	var (
+		candidates []lnwire.ShortChannelID
+		seen       = make(map[lnwire.ShortChannelID]bool)
+	)
+
+	add := func(id lnwire.ShortChannelID) {
+		if openIDs[id] || seen[id] {
+			return
+		}
+
+		seen[id] = true
+		candidates = append(candidates, id)
+	}
+
+	for id := range p.ChannelRules {
+		add(id)
+	}
+
+	for _, group := range p.ChannelGroups {
+		for _, id := range group.Channels {
+			add(id)
+		}
+	}
+
+	for _, id := range p.ExcludedChannels {
+		add(id)
+	}
+
+	return candidates
+}
+
+// migrateSplices moves the rules, group memberships and exclusions of
+// channels that have been spliced to the short channel ID of the channel that
+// replaced them, so that they are evaluated against the spliced channel's
+// capacity rather than treated as closed. A rule is not migrated if the new
+// channel already has a rule of its own. It must be called with our params
+// lock held.
+func (m *Manager) migrateSplices(ctx context.Context,
+	open []lndclient.ChannelInfo) error {
+
+	candidates := m.params.splicedCandidates(open)
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	closed, err := m.cfg.Node.ClosedChannels(ctx)
+	if err != nil {
+		return err
+	}
+
+	splices := findSplices(candidates, closed, open)
+	if len(splices) == 0 {
+		return nil
+	}
+
+	// We copy our parameters before we migrate them, so that we do not
+	// mutate maps that were shared with callers before this tick.
+	params := cloneParameters(m.params)
+
+	for _, s := range splices {
+		log.Infof("Channel %v spliced into %v, capacity changed from "+
+			"%v to %v", s.oldID, s.newID, s.oldCapacity,
+			s.newCapacity)
+
+		if rule, ok := params.ChannelRules[s.oldID]; ok {
+			delete(params.ChannelRules, s.oldID)
+
+			if _, ok := params.ChannelRules[s.newID]; ok {
+				log.Warnf("Channel %v already has a rule, "+
+					"dropping rule of spliced channel %v: "+
+					"%v", s.newID, s.oldID, rule)
+			} else {
+				params.ChannelRules[s.newID] = rule
+			}
+		}
+
+		for _, group := range params.ChannelGroups {
+			group.Channels = replaceChannel(
+				group.Channels, s.oldID, s.newID,
+			)
+		}
+
+		params.ExcludedChannels = replaceChannel(
+			params.ExcludedChannels, s.oldID, s.newID,
+		)
+	}
+
+	m.params = params
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// splicedChannel is channel 1 after it was spliced, which doubled its
+	// capacity and gave it a new short channel ID.
+	splicedChannel = lndclient.ChannelInfo{
+		ChannelID:    chanID3.ToUint64(),
+		ChannelPoint: "bb:1",
+		PubKeyBytes:  peer1,
+		LocalBalance: 20000,
+		Capacity:     20000,
+	}
+
+	// closedChannel1 is channel 1 closed by the splice that funded
+	// splicedChannel.
+	closedChannel1 = lndclient.ClosedChannel{
+		ChannelID:     chanID1.ToUint64(),
+		ChannelPoint:  "aa:0",
+		ClosingTxHash: "bb",
+		PubKeyBytes:   peer1,
+		Capacity:      10000,
+	}
+)
+
+// TestFindSplices tests matching closed channels to the open channels that
+// replaced them in a splice.
+func TestFindSplices(t *testing.T) {
+	chanID4 := lnwire.NewShortChanIDFromInt(4)
+
+	tests := []struct {
+		name     string
+		closed   []lndclient.ClosedChannel
+		open     []lndclient.ChannelInfo
+		expected map[lnwire.ShortChannelID]*splice
+	}{
+		{
+			name:   "spliced",
+			closed: []lndclient.ClosedChannel{closedChannel1},
+			open:   []lndclient.ChannelInfo{splicedChannel},
+			expected: map[lnwire.ShortChannelID]*splice{
+				chanID1: {
+					oldID:       chanID1,
+					newID:       chanID3,
+					oldCapacity: 10000,
+					newCapacity: 20000,
+				},
+			},
+		},
+		{
+			name: "closed",
+			closed: []lndclient.ClosedChannel{
+				{
+					ChannelID:     chanID1.ToUint64(),
+					ChannelPoint:  "aa:0",
+					ClosingTxHash: "cc",
+					PubKeyBytes:   peer1,
+				},
+			},
+			open:     []lndclient.ChannelInfo{splicedChannel},
+			expected: map[lnwire.ShortChannelID]*splice{},
+		},
+		{
+			// A close that funds a channel with another peer, for
+			// example in a batch, is not a splice.
+			name:   "other peer",
+			closed: []lndclient.ClosedChannel{closedChannel1},
+			open: []lndclient.ChannelInfo{
+				{
+					ChannelID:    chanID3.ToUint64(),
+					ChannelPoint: "bb:1",
+					PubKeyBytes:  peer2,
+				},
+			},
+			expected: map[lnwire.ShortChannelID]*splice{},
+		},
+		{
+			name: "spliced twice",
+			closed: []lndclient.ClosedChannel{
+				closedChannel1,
+				{
+					ChannelID:     chanID3.ToUint64(),
+					ChannelPoint:  "bb:1",
+					ClosingTxHash: "dd",
+					PubKeyBytes:   peer1,
+					Capacity:      20000,
+				},
+			},
+			open: []lndclient.ChannelInfo{
+				{
+					ChannelID:    chanID4.ToUint64(),
+					ChannelPoint: "dd:0",
+					PubKeyBytes:  peer1,
+					Capacity:     5000,
+				},
+			},
+			expected: map[lnwire.ShortChannelID]*splice{
+				chanID1: {
+					oldID:       chanID1,
+					newID:       chanID4,
+					oldCapacity: 10000,
+					newCapacity: 5000,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			splices := findSplices(
+				[]lnwire.ShortChannelID{chanID1},
+				testCase.closed, testCase.open,
+			)
+			require.Equal(t, testCase.expected, splices)
+		})
+	}
+}
+
+// TestMigrateSplices tests that the rules, group memberships and exclusions
+// of spliced channels are moved to the channels that replaced them.
+func TestMigrateSplices(t *testing.T) {
+	cfg, lnd := newTestConfig()
+	lnd.ClosedChannels = []lndclient.ClosedChannel{closedChannel1}
+
+	open := []lndclient.ChannelInfo{splicedChannel, channel2}
+
+	manager := NewManager(cfg)
+	manager.params = defaultParameters
+	manager.params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+	}
+	manager.params.ChannelGroups = map[string]*ChannelGroup{
+		"group": {
+			Channels: []lnwire.ShortChannelID{chanID1, chanID2},
+			Rule:     chanRule,
+		},
+	}
+	manager.params.ExcludedChannels = []lnwire.ShortChannelID{chanID1}
+
+	// Parameters that were handed out before the splice are not changed
+	// by our migration.
+	before := manager.GetParameters()
+
+	require.NoError(t, manager.migrateSplices(context.Background(), open))
+
+	params := manager.GetParameters()
+	require.Equal(t, map[lnwire.ShortChannelID]*SwapRule{
+		chanID3: chanRule,
+	}, params.ChannelRules)
+	require.Equal(t, []lnwire.ShortChannelID{chanID3, chanID2},
+		params.ChannelGroups["group"].Channels)
+	require.Equal(t, []lnwire.ShortChannelID{chanID3},
+		params.ExcludedChannels)
+
+	require.Contains(t, before.ChannelRules, chanID1)
+	require.Equal(t, []lnwire.ShortChannelID{chanID1},
+		before.ExcludedChannels)
+}
+
+// TestSplicedSuggestions tests that swaps for a channel rule are sized for
+// the capacity of its channel after it was spliced, rather than the rule
+// being treated as closed.
+func TestSplicedSuggestions(t *testing.T) {
+	const amount btcutil.Amount = 10000
+
+	cfg, lnd := newTestConfig()
+	lnd.Channels = []lndclient.ChannelInfo{splicedChannel}
+	lnd.ClosedChannels = []lndclient.ClosedChannel{closedChannel1}
+
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+	}
+
+	prepay, routing := testPPMFees(defaultFeePPM, testQuote, amount)
+
+	testSuggestSwaps(
+		t, newSuggestSwapsSetup(cfg, lnd, params), &Suggestions{
+			OutSwaps: []loop.OutRequest{
+				{
+					Amount: amount,
+					OutgoingChanSet: loopdb.ChannelSet{
+						chanID3.ToUint64(),
+					},
+					MaxPrepayRoutingFee: prepay,
+					MaxSwapRoutingFee:   routing,
+					MaxMinerFee: scaleMinerFee(
+						testQuote.MinerFee,
+					),
+					MaxSwapFee:      testQuote.SwapFee,
+					MaxPrepayAmount: testQuote.PrepayAmount,
+					SweepConfTarget: defaultConfTarget,
+					Initiator:       autoloopSwapInitiator,
+				},
+			},
+			DisqualifiedChans: noneDisqualified,
+			DisqualifiedPeers: noPeersDisqualified,
+		}, nil,
+	)
+}
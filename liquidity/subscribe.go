@@ -0,0 +1,158 @@
+package liquidity
+
+import (
+	"context"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/swap"
+)
+
+const (
+	// DefaultSuggestionDelta is the default change in a channel's balance
+	// that we consider to be a material change for suggestion
+	// subscribers.
+	DefaultSuggestionDelta = btcutil.Amount(10000)
+
+	// suggestionPollInterval is how often we check the inputs to our swap
+	// suggestions for changes for subscribers.
+	suggestionPollInterval = time.Second * 30
+)
+
+// suggestionInputs is a summary of the inputs to our swap suggestions, which
+// we use to decide whether subscribers need a new set of suggestions.
+type suggestionInputs struct {
+	// balances maps each of our open channels to its local balance.
+	balances map[uint64]btcutil.Amount
+
+	// outRestrictions is the server's current loop out restrictions.
+	outRestrictions Restrictions
+
+	// inRestrictions is the server's current loop in restrictions.
+	inRestrictions Restrictions
+
+	// paramsVersion is the version of our parameters, which is
+	// incremented each time they are set.
+	paramsVersion uint64
+}
+
+// changed returns true if the inputs provided differ materially from our
+// inputs. A channel's balance must move by at least the delta provided to be
+// considered a material change, while any other change is material.
+func (s *suggestionInputs) changed(other *suggestionInputs,
+	delta btcutil.Amount) bool {
+
+	if s.paramsVersion != other.paramsVersion ||
+		s.outRestrictions != other.outRestrictions ||
+		s.inRestrictions != other.inRestrictions ||
+		len(s.balances) != len(other.balances) {
+
+		return true
+	}
+
+	for channel, balance := range s.balances {
+		otherBalance, ok := other.balances[channel]
+		if !ok {
+			return true
+		}
+
+		shift := balance - otherBalance
+		if shift < 0 {
+			shift = -shift
+		}
+
+		if shift >= delta {
+			return true
+		}
+	}
+
+	return false
+}
+
+// currentInputs returns a summary of the current inputs to our swap
+// suggestions.
+func (m *Manager) currentInputs(ctx context.Context) (*suggestionInputs,
+	error) {
+
+	channels, err := m.cfg.Channels.ListChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	outRestrictions, err := m.cfg.Quotes.Restrictions(ctx, swap.TypeOut)
+	if err != nil {
+		return nil, err
+	}
+
+	inRestrictions, err := m.cfg.Quotes.Restrictions(ctx, swap.TypeIn)
+	if err != nil {
+		return nil, err
+	}
+
+	m.paramsLock.Lock()
+	version := m.paramsVersion
+	m.paramsLock.Unlock()
+
+	inputs := &suggestionInputs{
+		balances:        make(map[uint64]btcutil.Amount, len(channels)),
+		outRestrictions: *outRestrictions,
+		inRestrictions:  *inRestrictions,
+		paramsVersion:   version,
+	}
+
+	for _, channel := range channels {
+		inputs.balances[channel.ChannelID] = channel.LocalBalance
+	}
+
+	return inputs, nil
+}
+
+// SubscribeSuggestions sends our current swap suggestions to the send
+// function provided, and then sends a new set of suggestions each time the
+// inputs to our suggestions change materially: a channel is opened or closed,
+// a channel's balance moves by at least the delta provided since the last set
+// of suggestions was sent, the server's restrictions change or our parameters
+// are updated. If we have no rules set, an empty set of suggestions is sent.
+// This call blocks until the context provided is cancelled, or we fail to
+// get or send suggestions.
+func (m *Manager) SubscribeSuggestions(ctx context.Context,
+	delta btcutil.Amount, send func(*Suggestions) error) error {
+
+	if delta == 0 {
+		delta = DefaultSuggestionDelta
+	}
+
+	var last *suggestionInputs
+	for {
+		inputs, err := m.currentInputs(ctx)
+		if err != nil {
+			return err
+		}
+
+		if last == nil || inputs.changed(last, delta) {
+			suggestions, err := m.SuggestSwaps(ctx, false)
+			switch err {
+			case nil:
+
+			case ErrNoRules:
+				suggestions = newSuggestions()
+
+			default:
+				return err
+			}
+
+			if err := send(suggestions); err != nil {
+				return err
+			}
+
+			last = inputs
+		}
+
+		select {
+		case <-m.cfg.Clock.TickAfter(suggestionPollInterval):
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package liquidity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSuggestionInputsChanged tests detection of material changes to the
+// inputs of our swap suggestions.
+func TestSuggestionInputsChanged(t *testing.T) {
+	inputs := func(balance btcutil.Amount) *suggestionInputs {
+		return &suggestionInputs{
+			balances: map[uint64]btcutil.Amount{
+				1: balance,
+			},
+			outRestrictions: *testRestrictions,
+			inRestrictions:  *testRestrictions,
+			paramsVersion:   1,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		current *suggestionInputs
+		changed bool
+	}{
+		{
+			name:    "no change",
+			current: inputs(1000),
+		},
+		{
+			name:    "shift below delta",
+			current: inputs(1099),
+		},
+		{
+			name:    "decrease by delta",
+			current: inputs(900),
+			changed: true,
+		},
+		{
+			name: "channel opened",
+			current: &suggestionInputs{
+				balances: map[uint64]btcutil.Amount{
+					1: 1000,
+					2: 0,
+				},
+				outRestrictions: *testRestrictions,
+				inRestrictions:  *testRestrictions,
+				paramsVersion:   1,
+			},
+			changed: true,
+		},
+		{
+			name: "restrictions changed",
+			current: &suggestionInputs{
+				balances: map[uint64]btcutil.Amount{
+					1: 1000,
+				},
+				outRestrictions: *NewRestrictions(1, 2),
+				inRestrictions:  *testRestrictions,
+				paramsVersion:   1,
+			},
+			changed: true,
+		},
+		{
+			name: "parameters set",
+			current: &suggestionInputs{
+				balances: map[uint64]btcutil.Amount{
+					1: 1000,
+				},
+				outRestrictions: *testRestrictions,
+				inRestrictions:  *testRestrictions,
+				paramsVersion:   2,
+			},
+			changed: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			changed := testCase.current.changed(inputs(1000), 100)
+			require.Equal(t, testCase.changed, changed)
+		})
+	}
+}
+
+// TestSubscribeSuggestions tests that subscribers are sent our current
+// suggestions, and new suggestions once our inputs change materially.
+func TestSubscribeSuggestions(t *testing.T) {
+	cfg, lnd := newTestConfig()
+
+	tickSignal := make(chan time.Duration)
+	testClock := clock.NewTestClockWithTickSignal(testTime, tickSignal)
+	cfg.Clock = testClock
+
+	lnd.Channels = []lndclient.ChannelInfo{
+		channel1,
+	}
+
+	manager := NewManager(cfg)
+
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		sent        = make(chan *Suggestions)
+		errChan     = make(chan error)
+	)
+	defer cancel()
+
+	go func() {
+		errChan <- manager.SubscribeSuggestions(
+			ctx, 0, func(suggestions *Suggestions) error {
+				sent <- suggestions
+				return nil
+			},
+		)
+	}()
+
+	// We have no rules set, so we expect an empty set of suggestions.
+	require.Equal(t, newSuggestions(), <-sent)
+	<-tickSignal
+
+	// tick advances our clock past our poll interval, and waits for our
+	// subscription to check our inputs again.
+	now := testTime
+	tick := func() {
+		now = now.Add(suggestionPollInterval)
+		testClock.SetTime(now)
+	}
+
+	// A balance shift below our default delta should not produce new
+	// suggestions.
+	lnd.Channels[0].LocalBalance -= DefaultSuggestionDelta - 1
+	tick()
+	<-tickSignal
+
+	select {
+	case <-sent:
+		t.Fatal("unexpected suggestions sent")
+
+	default:
+	}
+
+	// Once we set a rule, our new suggestions should be sent.
+	lnd.Channels[0].LocalBalance = channel1.LocalBalance
+	params := defaultParameters
+	params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+		chanID1: chanRule,
+	}
+	require.NoError(t, manager.SetParameters(ctx, params))
+
+	tick()
+	require.Equal(t, &Suggestions{
+		OutSwaps:          []loop.OutRequest{chan1Rec},
+		DisqualifiedChans: noneDisqualified,
+		DisqualifiedPeers: noPeersDisqualified,
+	}, <-sent)
+	<-tickSignal
+
+	cancel()
+	require.NoError(t, <-errChan)
+}
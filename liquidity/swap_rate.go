@@ -0,0 +1,136 @@
+package liquidity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// DefaultSwapRatePeriod is the default rolling window that we limit the
+// number of automatically dispatched swaps in.
+const DefaultSwapRatePeriod = time.Hour * 24
+
+var (
+	// ErrNegativeSwapRateLimit is returned if a negative swap rate limit
+	// is set.
+	ErrNegativeSwapRateLimit = errors.New("swap rate limits must be >= 0")
+
+	// ErrZeroSwapRatePeriod is returned if a swap rate limit is set
+	// without a period to apply it to.
+	ErrZeroSwapRatePeriod = errors.New("swap rate period must be > 0")
+)
+
+// swapCounts contains the number of automatically dispatched swaps that were
+// initiated within our swap rate period. Its zero value holds no swaps.
+type swapCounts struct {
+	// total is the total number of automated swaps.
+	total int
+
+	// channels is the number of automated loop outs that used each
+	// channel.
+	channels map[lnwire.ShortChannelID]int
+
+	// peers is the number of automated loop ins that were made through
+	// each peer.
+	peers map[route.Vertex]int
+}
+
+func newSwapCounts() swapCounts {
+	return swapCounts{
+		channels: make(map[lnwire.ShortChannelID]int),
+		peers:    make(map[route.Vertex]int),
+	}
+}
+
+// countAutoloops counts the automatically dispatched swaps that were
+// initiated after the start time provided. We include swaps that failed,
+// because the limits that we count swaps for are intended to stop rules from
+// churning through swaps, successful or not.
+func countAutoloops(start time.Time, loopOuts []*loopdb.LoopOut,
+	loopIns []*loopdb.LoopIn) swapCounts {
+
+	counts := newSwapCounts()
+
+	for _, out := range loopOuts {
+		if out.Contract.Label != labels.AutoloopLabel(swap.TypeOut) {
+			continue
+		}
+
+		if out.Contract.InitiationTime.Before(start) {
+			continue
+		}
+
+		counts.total++
+		for _, id := range out.Contract.OutgoingChanSet {
+			counts.channels[lnwire.NewShortChanIDFromInt(id)]++
+		}
+	}
+
+	for _, in := range loopIns {
+		if in.Contract.Label != labels.AutoloopLabel(swap.TypeIn) {
+			continue
+		}
+
+		if in.Contract.InitiationTime.Before(start) {
+			continue
+		}
+
+		counts.total++
+		if in.Contract.LastHop != nil {
+			counts.peers[*in.Contract.LastHop]++
+		}
+	}
+
+	return counts
+}
+
+// haveSwapRateLimits returns true if we limit the number of automated swaps
+// that we dispatch in our swap rate period, in total or per channel.
+func (p Parameters) haveSwapRateLimits() bool {
+	return p.MaxSwapsPerPeriod != 0 || p.MaxChannelSwapsPerPeriod != 0
+}
+
+// remainingSwaps returns the number of automated swaps that we may still
+// dispatch in our current swap rate period, and a boolean that is false if
+// we do not limit the number of swaps that we dispatch.
+func (p Parameters) remainingSwaps(counts swapCounts) (int, bool) {
+	if p.MaxSwapsPerPeriod == 0 {
+		return 0, false
+	}
+
+	return p.MaxSwapsPerPeriod - counts.total, true
+}
+
+// rateLimited returns a reason error if the peer or any of the channels
+// provided have reached our limit on the number of automated swaps per
+// channel in our swap rate period.
+func (p Parameters) rateLimited(counts swapCounts, peer route.Vertex,
+	channels []lnwire.ShortChannelID) error {
+
+	limit := p.MaxChannelSwapsPerPeriod
+	if limit == 0 {
+		return nil
+	}
+
+	limited := counts.peers[peer] >= limit
+	for _, channel := range channels {
+		if counts.channels[channel] >= limit {
+			limited = true
+		}
+	}
+
+	if !limited {
+		return nil
+	}
+
+	log.Debugf("Peer: %v channels: %v not eligible for suggestions, "+
+		"reached limit of %v swaps per %v", peer, channels, limit,
+		p.SwapRatePeriod)
+
+	return newReasonError(ReasonSwapRateLimit)
+}
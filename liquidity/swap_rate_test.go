@@ -0,0 +1,198 @@
+package liquidity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// autoOut returns an automatically dispatched loop out over the channel
+// provided that timed out at the time provided.
+func autoOut(channel lnwire.ShortChannelID,
+	initiated time.Time) *loopdb.LoopOut {
+
+	return &loopdb.LoopOut{
+		Loop: loopdb.Loop{
+			Events: []*loopdb.LoopEvent{
+				{
+					SwapStateData: loopdb.SwapStateData{
+						State: loopdb.StateFailTimeout,
+					},
+					Time: initiated,
+				},
+			},
+		},
+		Contract: &loopdb.LoopOutContract{
+			SwapContract: loopdb.SwapContract{
+				Label: labels.AutoloopLabel(
+					swap.TypeOut,
+				),
+				InitiationTime: initiated,
+			},
+			OutgoingChanSet: loopdb.ChannelSet{
+				channel.ToUint64(),
+			},
+		},
+	}
+}
+
+// TestCountAutoloops tests counting of the automated swaps that were
+// initiated within our swap rate period.
+func TestCountAutoloops(t *testing.T) {
+	var (
+		start  = testTime.Add(time.Hour * -24)
+		recent = testTime.Add(time.Hour * -1)
+		old    = testTime.Add(time.Hour * -48)
+	)
+
+	manual := autoOut(chanID2, recent)
+	manual.Contract.Label = ""
+
+	loopOuts := []*loopdb.LoopOut{
+		autoOut(chanID1, recent),
+		autoOut(chanID1, recent),
+		autoOut(chanID2, old),
+		manual,
+	}
+
+	loopIns := []*loopdb.LoopIn{
+		{
+			Contract: &loopdb.LoopInContract{
+				SwapContract: loopdb.SwapContract{
+					Label: labels.AutoloopLabel(
+						swap.TypeIn,
+					),
+					InitiationTime: recent,
+				},
+				LastHop: &peer2,
+			},
+		},
+	}
+
+	counts := countAutoloops(start, loopOuts, loopIns)
+	require.Equal(t, swapCounts{
+		total: 3,
+		channels: map[lnwire.ShortChannelID]int{
+			chanID1: 2,
+		},
+		peers: map[route.Vertex]int{
+			peer2: 1,
+		},
+	}, counts)
+}
+
+// TestSwapRateLimits tests limiting of the number of automated swaps that we
+// suggest in our swap rate period, in total and per channel.
+func TestSwapRateLimits(t *testing.T) {
+	var (
+		recent   = testTime.Add(time.Hour * -1)
+		unusedID = lnwire.NewShortChanIDFromInt(999)
+	)
+
+	tests := []struct {
+		name            string
+		maxSwaps        int
+		maxChannelSwaps int
+		existing        []*loopdb.LoopOut
+		suggestions     *Suggestions
+	}{
+		{
+			name: "no limits",
+			existing: []*loopdb.LoopOut{
+				autoOut(chanID1, recent),
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "total limit reached",
+			maxSwaps: 1,
+			existing: []*loopdb.LoopOut{
+				autoOut(chanID1, recent),
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonSwapRateLimit,
+					chanID2: ReasonSwapRateLimit,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "total limit partially used",
+			maxSwaps: 2,
+			existing: []*loopdb.LoopOut{
+				autoOut(unusedID, recent),
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonSwapRateLimit,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:            "channel limit reached",
+			maxChannelSwaps: 1,
+			existing: []*loopdb.LoopOut{
+				autoOut(chanID1, recent),
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan2Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonSwapRateLimit,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+
+			cfg.Swaps = &testSwaps{
+				loopOut: testCase.existing,
+			}
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			params := defaultParameters
+			params.MaxAutoInFlight = 2
+			params.MaxSwapsPerPeriod = testCase.maxSwaps
+			params.MaxChannelSwapsPerPeriod =
+				testCase.maxChannelSwaps
+			params.ChannelRules = map[lnwire.ShortChannelID]*SwapRule{
+				chanID1: chanRule,
+				chanID2: chanRule,
+			}
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
@@ -3,8 +3,10 @@ package liquidity
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/swap"
 )
 
@@ -18,12 +20,195 @@ var (
 	// provided for a threshold rule is >= 100.
 	errInvalidThresholdSum = errors.New("sum of incoming and outgoing " +
 		"percentages must be < 100")
+
+	// errNegativeThresholdAmount is returned when an absolute liquidity
+	// threshold is negative.
+	errNegativeThresholdAmount = errors.New("liquidity threshold " +
+		"amount must be >= 0")
+
+	// errThresholdBothSet is returned when both a percentage and an
+	// absolute amount are set for the same side of a threshold rule.
+	errThresholdBothSet = errors.New("liquidity threshold may be set as " +
+		"a percentage or an amount, not both")
 )
 
 // SwapRule is a liquidity rule with a specific swap type.
 type SwapRule struct {
 	*ThresholdRule
 	swap.Type
+
+	// ShrinkPolicy determines how we handle swaps suggested for this rule
+	// when the server lowers its maximum swap amount before they are
+	// dispatched.
+	ShrinkPolicy ShrinkPolicy
+
+	// FeeBudget is the total amount of fees that we allow automatically
+	// dispatched swaps for this rule to spend since our budget start
+	// date. It applies in addition to our global budget, so that we can
+	// cap our spending on expensive peers without starving others. A zero
+	// value places no limit on the rule beyond our global budget.
+	FeeBudget btcutil.Amount
+
+	// Expiry is the time at which the rule stops being applied, so that
+	// temporary rules do not need to be removed manually. Expired rules
+	// remain set until they are removed, but do not produce swap
+	// suggestions. A zero value means that the rule does not expire.
+	Expiry time.Time
+
+	// Cooldown is the amount of time after a swap in the opposite
+	// direction to the rule's swap type completes on its channels or peer
+	// that we wait before suggesting swaps for the rule. This guards
+	// against rules that move liquidity back and forth when they are
+	// configured with overlapping thresholds. A zero value disables the
+	// cooldown.
+	Cooldown time.Duration
+
+	// FeeLimit is an optional fee limit for swaps suggested for this rule,
+	// which overrides our global fee limit so that we can pay more to
+	// rebalance some channels or peers than others. If it is nil, our
+	// global fee limit is used.
+	FeeLimit FeeLimit
+
+	// MaxSwapAmount is the largest swap that we suggest for this rule,
+	// even if its thresholds require a larger swap, so that we can limit
+	// our exposure to unreliable peers. A zero value places no limit on
+	// the rule beyond our swap restrictions.
+	MaxSwapAmount btcutil.Amount
+
+	// CustomRecords is an optional set of custom tlv records that are
+	// attached to the off-chain payments of loop outs suggested for this
+	// rule, so that they can be correlated downstream. Custom records may
+	// only be set for loop out rules.
+	CustomRecords map[uint64][]byte
+
+	// MinRevenuePercent is the percentage of the fees that a loop out
+	// suggested for this rule could cost that its channels must have
+	// earned in routing fees over the rule's revenue window, so that we
+	// do not pay to refill channels that never earn back the cost of
+	// their swaps. A zero value does not require any revenue. It may
+	// only be set for loop out rules.
+	MinRevenuePercent uint64
+
+	// RevenueWindow is the period of forwarding history that the rule's
+	// minimum revenue is applied to. If it is zero, our default revenue
+	// window is used.
+	RevenueWindow time.Duration
+
+	// SweepDestination is an optional destination that autoloop sweeps
+	// the loop outs suggested for this rule to, rather than our lnd
+	// wallet. It may only be set for loop out rules.
+	SweepDestination *SweepDestination
+
+	// PublicationDeadline is an optional amount of time after a loop out
+	// is suggested for this rule that we allow the server to wait before
+	// publishing its htlc, which overrides our global publication
+	// deadline. Longer deadlines allow the server to batch htlcs, which
+	// lowers the swap fee. If it is zero, our global publication deadline
+	// is used. It may only be set for loop out rules.
+	PublicationDeadline time.Duration
+}
+
+// clone returns a copy of the rule that does not share its custom records or
+// sweep destination with the original.
+func (r *SwapRule) clone() *SwapRule {
+	ruleCopy := *r
+	ruleCopy.CustomRecords = loop.CopyCustomRecords(r.CustomRecords)
+
+	if r.SweepDestination != nil {
+		ruleCopy.SweepDestination = r.SweepDestination.clone()
+	}
+
+	return &ruleCopy
+}
+
+// expired returns true if the rule has an expiry set and the time provided is
+// at or after it.
+func (r *SwapRule) expired(now time.Time) bool {
+	return !r.Expiry.IsZero() && !now.Before(r.Expiry)
+}
+
+// capRestrictions returns the restrictions provided with their maximum
+// lowered to the rule's maximum swap amount, if it is set. If the rule's
+// maximum is below the minimum swap amount, we cannot swap for the rule.
+func (r *SwapRule) capRestrictions(restrictions *Restrictions) (*Restrictions,
+	error) {
+
+	if r.MaxSwapAmount == 0 || r.MaxSwapAmount >= restrictions.Maximum {
+		return restrictions, nil
+	}
+
+	if r.MaxSwapAmount < restrictions.Minimum {
+		log.Debugf("rule maximum swap amount: %v below minimum: %v",
+			r.MaxSwapAmount, restrictions.Minimum)
+
+		return nil, newReasonError(ReasonBelowMinimum)
+	}
+
+	return NewRestrictions(restrictions.Minimum, r.MaxSwapAmount), nil
+}
+
+// validate validates the parameters that a swap rule was created with.
+func (r *SwapRule) validate() error {
+	if err := r.ThresholdRule.validate(); err != nil {
+		return err
+	}
+
+	if r.FeeBudget < 0 {
+		return ErrNegativeBudget
+	}
+
+	if r.Cooldown < 0 {
+		return ErrNegativeCooldown
+	}
+
+	if r.MaxSwapAmount < 0 {
+		return ErrNegativeMaxSwapAmount
+	}
+
+	if r.RevenueWindow < 0 {
+		return ErrNegativeRevenueWindow
+	}
+
+	if r.MinRevenuePercent != 0 && r.Type != swap.TypeOut {
+		return ErrRevenueLoopIn
+	}
+
+	if r.PublicationDeadline < 0 {
+		return ErrNegativePublicationDeadline
+	}
+
+	if r.PublicationDeadline != 0 && r.Type != swap.TypeOut {
+		return ErrPublicationDeadlineLoopIn
+	}
+
+	if r.FeeLimit != nil {
+		if err := r.FeeLimit.validate(); err != nil {
+			return err
+		}
+	}
+
+	if len(r.CustomRecords) != 0 {
+		if r.Type != swap.TypeOut {
+			return ErrCustomRecordsLoopIn
+		}
+
+		err := loop.ValidateCustomRecords(r.CustomRecords)
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.SweepDestination != nil {
+		if r.Type != swap.TypeOut {
+			return ErrDestinationLoopIn
+		}
+
+		if err := r.SweepDestination.validate(); err != nil {
+			return err
+		}
+	}
+
+	return r.ShrinkPolicy.validate()
 }
 
 // ThresholdRule is a liquidity rule that implements minimum incoming and
@@ -36,6 +221,17 @@ type ThresholdRule struct {
 	// MinimumOutgoing is the percentage of outgoing liquidity that we do
 	// not want to drop below.
 	MinimumOutgoing int
+
+	// MinimumIncomingAmount is an absolute amount of incoming liquidity
+	// that we do not want to drop below. Absolute amounts behave more
+	// predictably than percentages across channels of very different
+	// sizes. It may not be set in conjunction with MinimumIncoming.
+	MinimumIncomingAmount btcutil.Amount
+
+	// MinimumOutgoingAmount is an absolute amount of outgoing liquidity
+	// that we do not want to drop below. It may not be set in conjunction
+	// with MinimumOutgoing.
+	MinimumOutgoingAmount btcutil.Amount
 }
 
 // NewThresholdRule returns a new threshold rule.
@@ -46,14 +242,68 @@ func NewThresholdRule(minIncoming, minOutgoing int) *ThresholdRule {
 	}
 }
 
+// NewAmountThresholdRule returns a new threshold rule with absolute
+// thresholds.
+func NewAmountThresholdRule(minIncoming,
+	minOutgoing btcutil.Amount) *ThresholdRule {
+
+	return &ThresholdRule{
+		MinimumIncomingAmount: minIncoming,
+		MinimumOutgoingAmount: minOutgoing,
+	}
+}
+
 // String returns a string representation of a rule.
 func (r *ThresholdRule) String() string {
-	return fmt.Sprintf("threshold rule: minimum incoming: %v%%, minimum "+
-		"outgoing: %v%%", r.MinimumIncoming, r.MinimumOutgoing)
+	incoming := fmt.Sprintf("%v%%", r.MinimumIncoming)
+	if r.MinimumIncomingAmount != 0 {
+		incoming = r.MinimumIncomingAmount.String()
+	}
+
+	outgoing := fmt.Sprintf("%v%%", r.MinimumOutgoing)
+	if r.MinimumOutgoingAmount != 0 {
+		outgoing = r.MinimumOutgoingAmount.String()
+	}
+
+	return fmt.Sprintf("threshold rule: minimum incoming: %v, minimum "+
+		"outgoing: %v", incoming, outgoing)
+}
+
+// thresholds returns the minimum incoming and outgoing balances that the rule
+// requires for the capacity provided, using the rule's absolute amounts if
+// they are set and its percentages otherwise.
+func (r *ThresholdRule) thresholds(capacity btcutil.Amount) (btcutil.Amount,
+	btcutil.Amount) {
+
+	minIncoming := r.MinimumIncomingAmount
+	if minIncoming == 0 {
+		minIncoming = btcutil.Amount(
+			uint64(capacity) * uint64(r.MinimumIncoming) / 100,
+		)
+	}
+
+	minOutgoing := r.MinimumOutgoingAmount
+	if minOutgoing == 0 {
+		minOutgoing = btcutil.Amount(
+			uint64(capacity) * uint64(r.MinimumOutgoing) / 100,
+		)
+	}
+
+	return minIncoming, minOutgoing
 }
 
 // validate validates the parameters that a rule was created with.
 func (r *ThresholdRule) validate() error {
+	if r.MinimumIncomingAmount < 0 || r.MinimumOutgoingAmount < 0 {
+		return errNegativeThresholdAmount
+	}
+
+	if (r.MinimumIncomingAmount != 0 && r.MinimumIncoming != 0) ||
+		(r.MinimumOutgoingAmount != 0 && r.MinimumOutgoing != 0) {
+
+		return errThresholdBothSet
+	}
+
 	if r.MinimumIncoming < 0 || r.MinimumIncoming > 100 {
 		return errInvalidLiquidityThreshold
 	}
@@ -70,9 +320,13 @@ func (r *ThresholdRule) validate() error {
 }
 
 // swapAmount suggests a swap based on the liquidity thresholds configured,
-// returning zero if no swap is recommended.
+// returning a reason error describing why no swap is recommended if we do not
+// need to, or cannot, swap.
 func (r *ThresholdRule) swapAmount(channel *balances,
-	restrictions *Restrictions, swapType swap.Type) btcutil.Amount {
+	restrictions *Restrictions, swapType swap.Type) (btcutil.Amount,
+	error) {
+
+	minIncoming, minOutgoing := r.thresholds(channel.capacity)
 
 	var (
 		// For loop out swaps, we want to adjust our incoming liquidity
@@ -81,45 +335,92 @@ func (r *ThresholdRule) swapAmount(channel *balances,
 
 		// For loop out swaps, we target a minimum amount of incoming
 		// liquidity, so the minimum incoming threshold is our target
-		// percentage.
-		targetPercentage = uint64(r.MinimumIncoming)
+		// goal.
+		targetGoal = minIncoming
 
 		// For loop out swaps, we may want to preserve some of our
 		// outgoing balance, so the channel's outgoing balance is our
 		// reserve.
 		reserveBalance = channel.outgoing
 
-		// For loop out swaps, we may want to preserve some percentage
-		// of our outgoing balance, so the minimum outgoing threshold
-		// is our reserve percentage.
-		reservePercentage = uint64(r.MinimumOutgoing)
+		// For loop out swaps, we may want to preserve some of our
+		// outgoing balance, so the minimum outgoing threshold is our
+		// reserve minimum.
+		reserveMinimum = minOutgoing
 	)
 
 	// For loop in swaps, we reverse our target and reserve values.
 	if swapType == swap.TypeIn {
 		targetBalance = channel.outgoing
-		targetPercentage = uint64(r.MinimumOutgoing)
+		targetGoal = minOutgoing
 		reserveBalance = channel.incoming
-		reservePercentage = uint64(r.MinimumIncoming)
+		reserveMinimum = minIncoming
+	}
+
+	// If we already have sufficient target balance, we do not need to
+	// swap.
+	if targetBalance >= targetGoal {
+		return 0, newReasonError(ReasonLiquidityOk)
 	}
 
-	// Examine our total balance and required ratios to decide whether we
-	// need to swap.
+	// Examine our total balance and required thresholds to decide whether
+	// we can swap. If we need a swap but no amount is returned, we do not
+	// have enough reserve balance available.
 	amount := calculateSwapAmount(
-		targetBalance, reserveBalance, channel.capacity,
-		targetPercentage, reservePercentage,
+		targetBalance, reserveBalance, channel.capacity, targetGoal,
+		reserveMinimum,
 	)
 
+	// Channel reserves and the fees that the channel initiator must keep
+	// for additional htlcs cannot be sent, so we lower our amount to the
+	// balance that the swap can actually shift.
+	if usable := channel.usable(swapType); amount > usable {
+		log.Debugf("lowering swap amount: %v for %v to usable "+
+			"balance: %v", amount, channel.channels, usable)
+
+		amount = usable
+	}
+
+	if amount == 0 {
+		return 0, newReasonError(ReasonReserveInsufficient)
+	}
+
+	// Channels that forward in the direction of our swap restore the
+	// liquidity that it would add by themselves, so we lower our amount
+	// by the liquidity that we expect them to regain. Stagnant channels
+	// are swapped the full amount. If our channels are expected to
+	// refill all but a swap that is too small to dispatch, we leave them
+	// to do so.
+	if refill := channel.refill(swapType); refill > 0 &&
+		amount >= restrictions.Minimum {
+
+		if amount < refill+restrictions.Minimum {
+			log.Debugf("forwarding flow: %v for %v expected to "+
+				"refill swap amount: %v", refill,
+				channel.channels, amount)
+
+			return 0, newReasonError(ReasonFlowRefill)
+		}
+
+		log.Debugf("lowering swap amount: %v for %v by forwarding "+
+			"flow: %v", amount, channel.channels, refill)
+
+		amount -= refill
+	}
+
 	// Limit our swap amount by the minimum/maximum thresholds set.
 	switch {
 	case amount < restrictions.Minimum:
-		return 0
+		log.Debugf("swap amount: %v below minimum: %v", amount,
+			restrictions.Minimum)
+
+		return 0, newReasonError(ReasonBelowMinimum)
 
 	case amount > restrictions.Maximum:
-		return restrictions.Maximum
+		return restrictions.Maximum, nil
 
 	default:
-		return amount
+		return amount, nil
 	}
 }
 
@@ -131,17 +432,8 @@ func (r *ThresholdRule) swapAmount(channel *balances,
 //   liquidity. We aim for this liquidity to reach the threshold amount set.
 // * reserve: this is the side of the channel(s) that we will move liquidity
 //   away from. This may not drop below a certain reserve threshold.
-func calculateSwapAmount(targetAmount, reserveAmount,
-	capacity btcutil.Amount, targetThresholdPercentage,
-	reserveThresholdPercentage uint64) btcutil.Amount {
-
-	targetGoal := btcutil.Amount(
-		uint64(capacity) * targetThresholdPercentage / 100,
-	)
-
-	reserveMinimum := btcutil.Amount(
-		uint64(capacity) * reserveThresholdPercentage / 100,
-	)
+func calculateSwapAmount(targetAmount, reserveAmount, capacity, targetGoal,
+	reserveMinimum btcutil.Amount) btcutil.Amount {
 
 	switch {
 	// If we have sufficient target capacity, we do not need to swap.
@@ -153,6 +445,10 @@ func calculateSwapAmount(targetAmount, reserveAmount,
 	case reserveAmount <= reserveMinimum:
 		return 0
 
+	// Absolute thresholds may require more liquidity than the capacity
+	// that they are applied to, in which case we cannot meet both.
+	case targetGoal+reserveMinimum >= capacity:
+		return 0
 	}
 
 	// Express our minimum reserve amount as a maximum target amount.
@@ -80,6 +80,29 @@ func TestValidateThreshold(t *testing.T) {
 			},
 			err: errInvalidThresholdSum,
 		},
+		{
+			name: "amounts set",
+			threshold: ThresholdRule{
+				MinimumIncomingAmount: 2000000,
+				MinimumOutgoing:       20,
+			},
+			err: nil,
+		},
+		{
+			name: "negative amount",
+			threshold: ThresholdRule{
+				MinimumOutgoingAmount: -1,
+			},
+			err: errNegativeThresholdAmount,
+		},
+		{
+			name: "amount and percentage set",
+			threshold: ThresholdRule{
+				MinimumIncoming:       20,
+				MinimumIncomingAmount: 2000000,
+			},
+			err: errThresholdBothSet,
+		},
 	}
 
 	for _, testCase := range tests {
@@ -99,8 +122,8 @@ func TestValidateThreshold(t *testing.T) {
 func TestCalculateAmount(t *testing.T) {
 	tests := []struct {
 		name        string
-		minIncoming uint64
-		minOutgoing uint64
+		minIncoming btcutil.Amount
+		minOutgoing btcutil.Amount
 		balances    *balances
 		amt         btcutil.Amount
 	}{
@@ -159,6 +182,17 @@ func TestCalculateAmount(t *testing.T) {
 			minIncoming: 40,
 			amt:         0,
 		},
+		{
+			name: "thresholds exceed capacity",
+			balances: &balances{
+				capacity: 100,
+				incoming: 20,
+				outgoing: 80,
+			},
+			minOutgoing: 50,
+			minIncoming: 60,
+			amt:         0,
+		},
 	}
 
 	for _, test := range tests {
@@ -187,6 +221,7 @@ func TestSuggestSwap(t *testing.T) {
 		channel         *balances
 		outRestrictions *Restrictions
 		swap            btcutil.Amount
+		reason          Reason
 	}{
 		{
 			name:            "liquidity ok",
@@ -197,6 +232,7 @@ func TestSuggestSwap(t *testing.T) {
 				incoming: 50,
 				outgoing: 50,
 			},
+			reason: ReasonLiquidityOk,
 		},
 		{
 			name:            "loop out",
@@ -218,7 +254,18 @@ func TestSuggestSwap(t *testing.T) {
 				incoming: 0,
 				outgoing: 100,
 			},
-			swap: 0,
+			reason: ReasonBelowMinimum,
+		},
+		{
+			name:            "reserve insufficient",
+			rule:            NewThresholdRule(40, 40),
+			outRestrictions: NewRestrictions(10, 100),
+			channel: &balances{
+				capacity: 100,
+				incoming: 20,
+				outgoing: 30,
+			},
+			reason: ReasonReserveInsufficient,
 		},
 		{
 			name:            "amount above maximum",
@@ -231,6 +278,41 @@ func TestSuggestSwap(t *testing.T) {
 			},
 			swap: 20,
 		},
+		{
+			name:            "absolute thresholds",
+			rule:            NewAmountThresholdRule(400, 100),
+			outRestrictions: NewRestrictions(10, 1000),
+			channel: &balances{
+				capacity: 1000,
+				incoming: 0,
+				outgoing: 1000,
+			},
+			swap: 650,
+		},
+		{
+			name:            "channel reserve",
+			rule:            NewThresholdRule(60, 0),
+			outRestrictions: NewRestrictions(10, 100),
+			channel: &balances{
+				capacity:        100,
+				incoming:        0,
+				outgoing:        100,
+				outgoingReserve: 30,
+			},
+			swap: 70,
+		},
+		{
+			name:            "balance held in reserve",
+			rule:            NewThresholdRule(40, 40),
+			outRestrictions: NewRestrictions(10, 100),
+			channel: &balances{
+				capacity:        100,
+				incoming:        0,
+				outgoing:        100,
+				outgoingReserve: 100,
+			},
+			reason: ReasonReserveInsufficient,
+		},
 		{
 			name:            "loop in",
 			rule:            NewThresholdRule(10, 10),
@@ -240,7 +322,7 @@ func TestSuggestSwap(t *testing.T) {
 				incoming: 100,
 				outgoing: 0,
 			},
-			swap: 0,
+			reason: ReasonLiquidityOk,
 		},
 	}
 
@@ -248,11 +330,76 @@ func TestSuggestSwap(t *testing.T) {
 		test := test
 
 		t.Run(test.name, func(t *testing.T) {
-			swap := test.rule.swapAmount(
+			swap, err := test.rule.swapAmount(
 				test.channel, test.outRestrictions,
 				swap.TypeOut,
 			)
 			require.Equal(t, test.swap, swap)
+
+			if test.reason == ReasonNone {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Equal(t, newReasonError(test.reason), err)
+		})
+	}
+}
+
+// TestCapRestrictions tests limiting of our swap restrictions to a rule's
+// maximum swap amount.
+func TestCapRestrictions(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxSwapAmount btcutil.Amount
+		restrictions  *Restrictions
+		expected      *Restrictions
+		reason        Reason
+	}{
+		{
+			name:         "no cap",
+			restrictions: NewRestrictions(10, 100),
+			expected:     NewRestrictions(10, 100),
+		},
+		{
+			name:          "cap above maximum",
+			maxSwapAmount: 200,
+			restrictions:  NewRestrictions(10, 100),
+			expected:      NewRestrictions(10, 100),
+		},
+		{
+			name:          "cap below maximum",
+			maxSwapAmount: 50,
+			restrictions:  NewRestrictions(10, 100),
+			expected:      NewRestrictions(10, 50),
+		},
+		{
+			name:          "cap below minimum",
+			maxSwapAmount: 5,
+			restrictions:  NewRestrictions(10, 100),
+			reason:        ReasonBelowMinimum,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			rule := &SwapRule{
+				MaxSwapAmount: test.maxSwapAmount,
+			}
+
+			restrictions, err := rule.capRestrictions(
+				test.restrictions,
+			)
+			require.Equal(t, test.expected, restrictions)
+
+			if test.reason == ReasonNone {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Equal(t, newReasonError(test.reason), err)
 		})
 	}
 }
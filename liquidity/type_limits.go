@@ -0,0 +1,78 @@
+package liquidity
+
+import (
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/swap"
+)
+
+// typeLimits tracks the budget and in flight allowance that remain for each
+// swap type that has its own limits, as we select swaps to suggest.
+type typeLimits struct {
+	// budgets is the fee budget that is still available for each swap
+	// type that has a budget.
+	budgets map[swap.Type]btcutil.Amount
+
+	// inFlight is the number of swaps that we may still dispatch for each
+	// swap type that has an in flight limit.
+	inFlight map[swap.Type]int
+}
+
+// newTypeLimits returns the budget and in flight allowances that remain for
+// each swap type that has its own limits, given our existing autoloops.
+func (p Parameters) newTypeLimits(
+	summary *existingAutoLoopSummary) *typeLimits {
+
+	limits := &typeLimits{
+		budgets:  make(map[swap.Type]btcutil.Amount),
+		inFlight: make(map[swap.Type]int),
+	}
+
+	setLimits := func(swapType swap.Type, budget btcutil.Amount,
+		maxInFlight int) {
+
+		fees, inFlight := summary.typeTotals(swapType)
+
+		if budget != 0 {
+			available := budget - fees
+			if available < 0 {
+				available = 0
+			}
+
+			limits.budgets[swapType] = available
+		}
+
+		if maxInFlight != 0 {
+			limits.inFlight[swapType] = maxInFlight - inFlight
+		}
+	}
+
+	setLimits(swap.TypeOut, p.AutoFeeBudgetOut, p.MaxAutoInFlightOut)
+	setLimits(swap.TypeIn, p.AutoFeeBudgetIn, p.MaxAutoInFlightIn)
+
+	return limits
+}
+
+// budget returns the fee budget that is available for the swap type provided
+// and a boolean indicating whether the type has its own budget.
+func (t *typeLimits) budget(swapType swap.Type) (btcutil.Amount, bool) {
+	available, ok := t.budgets[swapType]
+	return available, ok
+}
+
+// inFlightAllowed returns false if the swap type provided has reached its in
+// flight limit.
+func (t *typeLimits) inFlightAllowed(swapType swap.Type) bool {
+	allowed, ok := t.inFlight[swapType]
+	return !ok || allowed > 0
+}
+
+// add records a swap that we suggest against the limits of its type.
+func (t *typeLimits) add(swapType swap.Type, fees btcutil.Amount) {
+	if available, ok := t.budgets[swapType]; ok {
+		t.budgets[swapType] = available - fees
+	}
+
+	if allowed, ok := t.inFlight[swapType]; ok {
+		t.inFlight[swapType] = allowed - 1
+	}
+}
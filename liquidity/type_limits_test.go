@@ -0,0 +1,139 @@
+package liquidity
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestTypeLimits tests limiting of our suggestions by the budgets and in
+// flight limits that are set for individual swap types.
+func TestTypeLimits(t *testing.T) {
+	tests := []struct {
+		name           string
+		budgetOut      btcutil.Amount
+		budgetIn       btcutil.Amount
+		maxInFlightOut int
+		maxInFlightIn  int
+		existingSwaps  []*loopdb.LoopOut
+		suggestions    *Suggestions
+	}{
+		{
+			name: "no type limits",
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:     "loop in limits do not affect loop out",
+			budgetIn: 1,
+			// We set a loop in in flight limit of 1 and have an
+			// existing loop out in flight, which should not count
+			// towards it.
+			maxInFlightIn: 1,
+			existingSwaps: []*loopdb.LoopOut{
+				{
+					Contract: autoOutContract,
+				},
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec, chan2Rec,
+				},
+				DisqualifiedChans: noneDisqualified,
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:      "loop out budget insufficient",
+			budgetOut: 1,
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonBudgetInsufficient,
+					chanID2: ReasonBudgetInsufficient,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:           "loop out in flight, one allowed",
+			maxInFlightOut: 2,
+			existingSwaps: []*loopdb.LoopOut{
+				{
+					Contract: autoOutContract,
+				},
+			},
+			suggestions: &Suggestions{
+				OutSwaps: []loop.OutRequest{
+					chan1Rec,
+				},
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID2: ReasonInFlight,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+		{
+			name:           "loop out in flight limit reached",
+			maxInFlightOut: 1,
+			existingSwaps: []*loopdb.LoopOut{
+				{
+					Contract: autoOutContract,
+				},
+			},
+			suggestions: &Suggestions{
+				DisqualifiedChans: map[lnwire.ShortChannelID]Reason{
+					chanID1: ReasonInFlight,
+					chanID2: ReasonInFlight,
+				},
+				DisqualifiedPeers: noPeersDisqualified,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, lnd := newTestConfig()
+			cfg.Swaps = &testSwaps{
+				loopOut: testCase.existingSwaps,
+			}
+
+			lnd.Channels = []lndclient.ChannelInfo{
+				channel1, channel2,
+			}
+
+			params := defaultParameters
+			params.ChannelRules =
+				map[lnwire.ShortChannelID]*SwapRule{
+					chanID1: chanRule,
+					chanID2: chanRule,
+				}
+
+			// Set our global limits high enough that they do not
+			// restrict our suggestions, so that we only test our
+			// type limits.
+			params.MaxAutoInFlight = 5
+			params.AutoFeeBudget = defaultBudget * 2
+
+			params.AutoFeeBudgetOut = testCase.budgetOut
+			params.AutoFeeBudgetIn = testCase.budgetIn
+			params.MaxAutoInFlightOut = testCase.maxInFlightOut
+			params.MaxAutoInFlightIn = testCase.maxInFlightIn
+
+			testSuggestSwaps(
+				t, newSuggestSwapsSetup(cfg, lnd, params),
+				testCase.suggestions, nil,
+			)
+		})
+	}
+}
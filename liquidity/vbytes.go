@@ -0,0 +1,90 @@
+package liquidity
+
+import (
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/input"
+)
+
+const (
+	// DefaultVBytesBudgetPeriod is the default period over which we
+	// apply our on-chain vbytes budget.
+	DefaultVBytesBudgetPeriod = time.Hour * 24
+)
+
+var (
+	// loopOutVBytes is our estimate of the on-chain footprint of a loop
+	// out swap, which is the size of the transaction that sweeps the htlc
+	// to our destination address. We use a p2wsh output so that our
+	// estimate holds for the largest of the destination types that lnd
+	// provides.
+	loopOutVBytes = sweepVBytes(
+		(&swap.HtlcScriptV2{}).MaxSuccessWitnessSize(),
+	)
+
+	// loopInVBytes is our estimate of the on-chain footprint of a loop in
+	// swap, which is the size of the transaction that publishes the htlc.
+	// We assume that lnd funds the htlc with a single p2wkh input and adds
+	// a change output.
+	loopInVBytes = uint64(
+		(&input.TxWeightEstimator{}).
+			AddP2WKHInput().
+			AddP2WSHOutput().
+			AddP2WKHOutput().
+			VSize(),
+	)
+)
+
+// sweepVBytes returns the size of a transaction that sweeps a single htlc with
+// the witness size provided.
+func sweepVBytes(witnessSize int) uint64 {
+	var estimator input.TxWeightEstimator
+
+	estimator.AddWitnessInput(witnessSize)
+	estimator.AddP2WSHOutput()
+
+	return uint64(estimator.VSize())
+}
+
+// vbytesUsed returns the total estimated on-chain footprint of the swaps that
+// were initiated after the start time provided. Swaps that failed are not
+// included, because we do not know whether they made it on chain. Loop in
+// swaps with externally published htlcs are not included because we do not
+// publish any on-chain transactions for them.
+func vbytesUsed(start time.Time, loopOuts []*loopdb.LoopOut,
+	loopIns []*loopdb.LoopIn) uint64 {
+
+	var total uint64
+
+	for _, out := range loopOuts {
+		if out.Contract.InitiationTime.Before(start) {
+			continue
+		}
+
+		if out.State().State.Type() == loopdb.StateTypeFail {
+			continue
+		}
+
+		total += loopOutVBytes
+	}
+
+	for _, in := range loopIns {
+		if in.Contract.InitiationTime.Before(start) {
+			continue
+		}
+
+		if in.Contract.ExternalHtlc {
+			continue
+		}
+
+		if in.State().State.Type() == loopdb.StateTypeFail {
+			continue
+		}
+
+		total += loopInVBytes
+	}
+
+	return total
+}
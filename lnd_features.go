@@ -0,0 +1,225 @@
+package loop
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
+)
+
+// LndFeature is a feature that depends on the version and build of the lnd
+// node that we are connected to.
+type LndFeature uint8
+
+const (
+	// LndFeatureMuSig2V040 is MuSig2 signing with version 0.4.0 of the
+	// MuSig2 BIP draft, provided by lnd's experimental signrpc MuSig2
+	// calls.
+	LndFeatureMuSig2V040 LndFeature = iota
+
+	// LndFeatureMuSig2V100RC2 is MuSig2 signing with version 1.0.0-rc2 of
+	// the MuSig2 BIP.
+	LndFeatureMuSig2V100RC2
+
+	// LndFeatureTaprootSigning is signing of taproot inputs with
+	// SignOutputRaw.
+	LndFeatureTaprootSigning
+
+	// LndFeatureInboundFees is support for inbound routing fees.
+	LndFeatureInboundFees
+)
+
+// ErrLndFeatureUnsupported is returned when a feature is used that the lnd
+// node that we are connected to does not support.
+var ErrLndFeatureUnsupported = errors.New("feature not supported by lnd")
+
+// String returns the string representation of an lnd feature.
+func (f LndFeature) String() string {
+	switch f {
+	case LndFeatureMuSig2V040:
+		return "MuSig2 v0.4.0"
+
+	case LndFeatureMuSig2V100RC2:
+		return "MuSig2 v1.0.0-rc2"
+
+	case LndFeatureTaprootSigning:
+		return "Taproot signing"
+
+	case LndFeatureInboundFees:
+		return "Inbound fees"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// lndFeatureVersions is the minimum lnd version and set of build tags that
+// each of our lnd features requires.
+var lndFeatureVersions = map[LndFeature]*verrpc.Version{
+	LndFeatureMuSig2V040: {
+		AppMinor:  15,
+		BuildTags: []string{"signrpc"},
+	},
+	LndFeatureMuSig2V100RC2: {
+		AppMinor:  16,
+		BuildTags: []string{"signrpc"},
+	},
+	LndFeatureTaprootSigning: {
+		AppMinor:  15,
+		BuildTags: []string{"signrpc"},
+	},
+	LndFeatureInboundFees: {
+		AppMinor: 18,
+	},
+}
+
+// LndFeatureStatus describes whether our lnd node supports a feature.
+type LndFeatureStatus struct {
+	// Feature is the feature that the status describes.
+	Feature LndFeature
+
+	// Supported is true if our lnd node supports the feature.
+	Supported bool
+
+	// MinVersion is the minimum lnd version that supports the feature.
+	MinVersion string
+
+	// Reason describes why the feature is not supported. It is empty if
+	// the feature is supported.
+	Reason string
+}
+
+// LndFeatures is the set of version dependent features that the lnd node
+// that we are connected to supports. It is determined once on startup so that
+// features can be gated up front, rather than failing deep in a swap when an
+// older lnd lacks an rpc.
+type LndFeatures struct {
+	// version is the version of our lnd node.
+	version *verrpc.Version
+
+	// status contains the status of each of our features.
+	status map[LndFeature]*LndFeatureStatus
+}
+
+// NewLndFeatures determines the features that an lnd node with the version
+// provided supports.
+func NewLndFeatures(version *verrpc.Version) *LndFeatures {
+	features := &LndFeatures{
+		version: version,
+		status: make(
+			map[LndFeature]*LndFeatureStatus,
+			len(lndFeatureVersions),
+		),
+	}
+
+	for feature, required := range lndFeatureVersions {
+		status := &LndFeatureStatus{
+			Feature:    feature,
+			Supported:  true,
+			MinVersion: versionString(required),
+		}
+
+		switch {
+		case version == nil:
+			status.Supported = false
+			status.Reason = "lnd version unknown"
+
+		case lndclient.AssertVersionCompatible(
+			version, required,
+		) != nil:
+			status.Supported = false
+			status.Reason = fmt.Sprintf("requires lnd %v, "+
+				"connected to %v", status.MinVersion,
+				lndclient.VersionString(version))
+
+		default:
+			if tag := missingBuildTag(
+				version, required.BuildTags,
+			); tag != "" {
+
+				status.Supported = false
+				status.Reason = fmt.Sprintf("requires lnd "+
+					"built with %v", tag)
+			}
+		}
+
+		features.status[feature] = status
+	}
+
+	return features
+}
+
+// Supports returns true if our lnd node supports the feature provided.
+func (l *LndFeatures) Supports(feature LndFeature) bool {
+	status, ok := l.status[feature]
+	return ok && status.Supported
+}
+
+// Require returns an error describing why a feature cannot be used if our lnd
+// node does not support it. Code paths that depend on a feature should call
+// this before they start, so that unsupported features fail early and
+// clearly.
+func (l *LndFeatures) Require(feature LndFeature) error {
+	status, ok := l.status[feature]
+	if !ok {
+		return fmt.Errorf("%w: unknown feature %v",
+			ErrLndFeatureUnsupported, feature)
+	}
+
+	if !status.Supported {
+		return fmt.Errorf("%w: %v %v", ErrLndFeatureUnsupported,
+			feature, status.Reason)
+	}
+
+	return nil
+}
+
+// Status returns the status of each of our features, ordered by feature.
+func (l *LndFeatures) Status() []*LndFeatureStatus {
+	status := make([]*LndFeatureStatus, 0, len(l.status))
+	for feature := LndFeature(0); feature <= LndFeatureInboundFees; feature++ {
+		if featureStatus, ok := l.status[feature]; ok {
+			status = append(status, featureStatus)
+		}
+	}
+
+	return status
+}
+
+// logStatus logs the status of each of our features.
+func (l *LndFeatures) logStatus() {
+	for _, status := range l.Status() {
+		if status.Supported {
+			log.Infof("lnd feature %v: supported", status.Feature)
+			continue
+		}
+
+		log.Infof("lnd feature %v: disabled, %v", status.Feature,
+			status.Reason)
+	}
+}
+
+// versionString returns the major, minor and patch version of a required lnd
+// version.
+func versionString(version *verrpc.Version) string {
+	return fmt.Sprintf("v%d.%d.%d", version.AppMajor, version.AppMinor,
+		version.AppPatch)
+}
+
+// missingBuildTag returns the first of the required build tags that an lnd
+// version was not built with, or an empty string if all tags are present.
+func missingBuildTag(version *verrpc.Version, required []string) string {
+	tags := make(map[string]struct{}, len(version.BuildTags))
+	for _, tag := range version.BuildTags {
+		tags[tag] = struct{}{}
+	}
+
+	for _, tag := range required {
+		if _, ok := tags[tag]; !ok {
+			return tag
+		}
+	}
+
+	return ""
+}
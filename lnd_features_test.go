@@ -0,0 +1,96 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLndFeatures tests detection of the lnd features that a version of lnd
+// supports.
+func TestLndFeatures(t *testing.T) {
+	signTags := []string{"signrpc", "walletrpc", "chainrpc", "invoicesrpc"}
+
+	tests := []struct {
+		name        string
+		version     *verrpc.Version
+		supported   []LndFeature
+		unsupported []LndFeature
+	}{
+		{
+			name: "old lnd",
+			version: &verrpc.Version{
+				AppMinor:  14,
+				AppPatch:  2,
+				BuildTags: signTags,
+			},
+			unsupported: []LndFeature{
+				LndFeatureMuSig2V040,
+				LndFeatureMuSig2V100RC2,
+				LndFeatureTaprootSigning,
+				LndFeatureInboundFees,
+			},
+		},
+		{
+			name: "musig2 v0.4.0",
+			version: &verrpc.Version{
+				AppMinor:  15,
+				AppPatch:  5,
+				BuildTags: signTags,
+			},
+			supported: []LndFeature{
+				LndFeatureMuSig2V040,
+				LndFeatureTaprootSigning,
+			},
+			unsupported: []LndFeature{
+				LndFeatureMuSig2V100RC2,
+				LndFeatureInboundFees,
+			},
+		},
+		{
+			name: "missing signrpc",
+			version: &verrpc.Version{
+				AppMinor: 18,
+			},
+			supported: []LndFeature{
+				LndFeatureInboundFees,
+			},
+			unsupported: []LndFeature{
+				LndFeatureMuSig2V040,
+				LndFeatureMuSig2V100RC2,
+				LndFeatureTaprootSigning,
+			},
+		},
+		{
+			name: "unknown version",
+			unsupported: []LndFeature{
+				LndFeatureMuSig2V040,
+				LndFeatureInboundFees,
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			features := NewLndFeatures(testCase.version)
+
+			for _, feature := range testCase.supported {
+				require.True(t, features.Supports(feature))
+				require.NoError(t, features.Require(feature))
+			}
+
+			for _, feature := range testCase.unsupported {
+				require.False(t, features.Supports(feature))
+				require.ErrorIs(
+					t, features.Require(feature),
+					ErrLndFeatureUnsupported,
+				)
+			}
+
+			require.Len(t, features.Status(), len(lndFeatureVersions))
+		})
+	}
+}
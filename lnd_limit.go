@@ -0,0 +1,580 @@
+package loop
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// lndLimiter bounds the number of calls that we make to lnd concurrently, so
+// that a burst of swap activity cannot saturate lnd's rpc workers and degrade
+// its other duties, such as forwarding.
+type lndLimiter struct {
+	sem chan struct{}
+}
+
+// acquire blocks until we may make a call to lnd, returning a function that
+// must be called once the call has completed. If our context is canceled
+// while we wait, its error is returned.
+func (l *lndLimiter) acquire(ctx context.Context) (func(), error) {
+	select {
+	case l.sem <- struct{}{}:
+		return func() {
+			<-l.sem
+		}, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// LimitLndServices returns a copy of the lnd services provided which makes at
+// most maxCalls calls to lnd concurrently. The calls that loop makes during
+// swaps and autoloop ticks are limited, including router payments, signer
+// requests and chain notifications. Calls that return a stream only count
+// towards the limit while the stream is being set up. If maxCalls is zero,
+// the services are returned unchanged.
+func LimitLndServices(lnd *lndclient.LndServices,
+	maxCalls int) *lndclient.LndServices {
+
+	if maxCalls <= 0 {
+		return lnd
+	}
+
+	limiter := &lndLimiter{
+		sem: make(chan struct{}, maxCalls),
+	}
+
+	limited := *lnd
+	limited.Client = &limitedLightningClient{
+		LightningClient: lnd.Client,
+		limiter:         limiter,
+	}
+	limited.WalletKit = &limitedWalletKit{
+		WalletKitClient: lnd.WalletKit,
+		limiter:         limiter,
+	}
+	limited.ChainNotifier = &limitedChainNotifier{
+		ChainNotifierClient: lnd.ChainNotifier,
+		limiter:             limiter,
+	}
+	limited.Signer = &limitedSigner{
+		SignerClient: lnd.Signer,
+		limiter:      limiter,
+	}
+	limited.Invoices = &limitedInvoices{
+		InvoicesClient: lnd.Invoices,
+		limiter:        limiter,
+	}
+	limited.Router = &limitedRouter{
+		RouterClient: lnd.Router,
+		limiter:      limiter,
+	}
+
+	return &limited
+}
+
+// limitedLightningClient limits the lightning client calls that loop makes.
+type limitedLightningClient struct {
+	lndclient.LightningClient
+	limiter *lndLimiter
+}
+
+// GetInfo returns information about the lnd node.
+func (l *limitedLightningClient) GetInfo(ctx context.Context) (
+	*lndclient.Info, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.LightningClient.GetInfo(ctx)
+}
+
+// EstimateFeeToP2WSH estimates the total chain fees in satoshis to send the
+// given amount to a single P2WSH output with the given target confirmation.
+func (l *limitedLightningClient) EstimateFeeToP2WSH(ctx context.Context,
+	amt btcutil.Amount, confTarget int32) (btcutil.Amount, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	return l.LightningClient.EstimateFeeToP2WSH(ctx, amt, confTarget)
+}
+
+// AddInvoice adds a new invoice to lnd.
+func (l *limitedLightningClient) AddInvoice(ctx context.Context,
+	in *invoicesrpc.AddInvoiceData) (lntypes.Hash, string, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return lntypes.Hash{}, "", err
+	}
+	defer release()
+
+	return l.LightningClient.AddInvoice(ctx, in)
+}
+
+// LookupInvoice looks up an invoice by hash.
+func (l *limitedLightningClient) LookupInvoice(ctx context.Context,
+	hash lntypes.Hash) (*lndclient.Invoice, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.LightningClient.LookupInvoice(ctx, hash)
+}
+
+// ListChannels retrieves all channels of the backing lnd node.
+func (l *limitedLightningClient) ListChannels(ctx context.Context,
+	activeOnly, publicOnly bool) ([]lndclient.ChannelInfo, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.LightningClient.ListChannels(ctx, activeOnly, publicOnly)
+}
+
+// ClosedChannels returns all closed channels of the backing lnd node.
+func (l *limitedLightningClient) ClosedChannels(ctx context.Context) (
+	[]lndclient.ClosedChannel, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.LightningClient.ClosedChannels(ctx)
+}
+
+// DecodePaymentRequest decodes a payment request.
+func (l *limitedLightningClient) DecodePaymentRequest(ctx context.Context,
+	payReq string) (*lndclient.PaymentRequest, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.LightningClient.DecodePaymentRequest(ctx, payReq)
+}
+
+// GetChanInfo returns the channel info for the passed channel, including the
+// routing policy for both end.
+func (l *limitedLightningClient) GetChanInfo(ctx context.Context,
+	chanID uint64) (*lndclient.ChannelEdge, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.LightningClient.GetChanInfo(ctx, chanID)
+}
+
+// GetNodeInfo looks up information for a specific node.
+func (l *limitedLightningClient) GetNodeInfo(ctx context.Context,
+	pubkey route.Vertex, includeChannels bool) (*lndclient.NodeInfo,
+	error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.LightningClient.GetNodeInfo(ctx, pubkey, includeChannels)
+}
+
+// QueryRoutes can query LND to return a route (with fees) between two
+// vertices.
+func (l *limitedLightningClient) QueryRoutes(ctx context.Context,
+	req lndclient.QueryRoutesRequest) (*lndclient.QueryRoutesResponse,
+	error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.LightningClient.QueryRoutes(ctx, req)
+}
+
+// limitedWalletKit limits the wallet kit calls that loop makes.
+type limitedWalletKit struct {
+	lndclient.WalletKitClient
+	limiter *lndLimiter
+}
+
+// DeriveNextKey derives the next key in the family provided.
+func (l *limitedWalletKit) DeriveNextKey(ctx context.Context, family int32) (
+	*keychain.KeyDescriptor, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.WalletKitClient.DeriveNextKey(ctx, family)
+}
+
+// DeriveKey derives the key at the locator provided.
+func (l *limitedWalletKit) DeriveKey(ctx context.Context,
+	locator *keychain.KeyLocator) (*keychain.KeyDescriptor, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.WalletKitClient.DeriveKey(ctx, locator)
+}
+
+// NextAddr returns a new address from the wallet.
+func (l *limitedWalletKit) NextAddr(ctx context.Context) (btcutil.Address,
+	error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.WalletKitClient.NextAddr(ctx)
+}
+
+// PublishTransaction publishes a transaction with the label provided.
+func (l *limitedWalletKit) PublishTransaction(ctx context.Context,
+	tx *wire.MsgTx, label string) error {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return l.WalletKitClient.PublishTransaction(ctx, tx, label)
+}
+
+// SendOutputs funds and publishes a transaction with the outputs provided.
+func (l *limitedWalletKit) SendOutputs(ctx context.Context,
+	outputs []*wire.TxOut, feeRate chainfee.SatPerKWeight,
+	label string) (*wire.MsgTx, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.WalletKitClient.SendOutputs(ctx, outputs, feeRate, label)
+}
+
+// EstimateFee returns a fee estimate for the confirmation target provided.
+func (l *limitedWalletKit) EstimateFee(ctx context.Context,
+	confTarget int32) (chainfee.SatPerKWeight, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	return l.WalletKitClient.EstimateFee(ctx, confTarget)
+}
+
+// limitedChainNotifier limits the chain notifier registrations that loop
+// makes. Registrations only count towards our limit while they are being set
+// up, so that long-lived notifications do not block other calls.
+type limitedChainNotifier struct {
+	lndclient.ChainNotifierClient
+	limiter *lndLimiter
+}
+
+// RegisterBlockEpochNtfn registers for notifications of new blocks.
+func (l *limitedChainNotifier) RegisterBlockEpochNtfn(ctx context.Context) (
+	chan int32, chan error, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	return l.ChainNotifierClient.RegisterBlockEpochNtfn(ctx)
+}
+
+// RegisterConfirmationsNtfn registers for a notification when the transaction
+// provided confirms.
+func (l *limitedChainNotifier) RegisterConfirmationsNtfn(ctx context.Context,
+	txid *chainhash.Hash, pkScript []byte, numConfs, heightHint int32) (
+	chan *chainntnfs.TxConfirmation, chan error, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	return l.ChainNotifierClient.RegisterConfirmationsNtfn(
+		ctx, txid, pkScript, numConfs, heightHint,
+	)
+}
+
+// RegisterSpendNtfn registers for a notification when the outpoint provided
+// is spent.
+func (l *limitedChainNotifier) RegisterSpendNtfn(ctx context.Context,
+	outpoint *wire.OutPoint, pkScript []byte, heightHint int32) (
+	chan *chainntnfs.SpendDetail, chan error, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	return l.ChainNotifierClient.RegisterSpendNtfn(
+		ctx, outpoint, pkScript, heightHint,
+	)
+}
+
+// limitedSigner limits the signer calls that loop makes.
+type limitedSigner struct {
+	lndclient.SignerClient
+	limiter *lndLimiter
+}
+
+// SignOutputRaw signs the inputs of the transaction provided.
+func (l *limitedSigner) SignOutputRaw(ctx context.Context, tx *wire.MsgTx,
+	signDescriptors []*lndclient.SignDescriptor) ([][]byte, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.SignerClient.SignOutputRaw(ctx, tx, signDescriptors)
+}
+
+// ComputeInputScript generates the input scripts for the inputs of the
+// transaction provided.
+func (l *limitedSigner) ComputeInputScript(ctx context.Context,
+	tx *wire.MsgTx, signDescriptors []*lndclient.SignDescriptor) (
+	[]*input.Script, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.SignerClient.ComputeInputScript(ctx, tx, signDescriptors)
+}
+
+// SignMessage signs a message with the key specified in the key locator.
+func (l *limitedSigner) SignMessage(ctx context.Context, msg []byte,
+	locator keychain.KeyLocator) ([]byte, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.SignerClient.SignMessage(ctx, msg, locator)
+}
+
+// VerifyMessage verifies a signature over a message using the public key
+// provided.
+func (l *limitedSigner) VerifyMessage(ctx context.Context, msg, sig []byte,
+	pubkey [33]byte) (bool, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer release()
+
+	return l.SignerClient.VerifyMessage(ctx, msg, sig, pubkey)
+}
+
+// DeriveSharedKey returns a shared secret key by performing Diffie-Hellman
+// key derivation between the ephemeral public key and the key specified by
+// the key locator.
+func (l *limitedSigner) DeriveSharedKey(ctx context.Context,
+	ephemeralPubKey *btcec.PublicKey,
+	keyLocator *keychain.KeyLocator) ([32]byte, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	defer release()
+
+	return l.SignerClient.DeriveSharedKey(ctx, ephemeralPubKey, keyLocator)
+}
+
+// limitedInvoices limits the invoices calls that loop makes. Invoice
+// subscriptions only count towards our limit while they are being set up.
+type limitedInvoices struct {
+	lndclient.InvoicesClient
+	limiter *lndLimiter
+}
+
+// SubscribeSingleInvoice subscribes to updates for the invoice provided.
+func (l *limitedInvoices) SubscribeSingleInvoice(ctx context.Context,
+	hash lntypes.Hash) (<-chan lndclient.InvoiceUpdate, <-chan error,
+	error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	return l.InvoicesClient.SubscribeSingleInvoice(ctx, hash)
+}
+
+// SettleInvoice settles the hold invoice with the preimage provided.
+func (l *limitedInvoices) SettleInvoice(ctx context.Context,
+	preimage lntypes.Preimage) error {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return l.InvoicesClient.SettleInvoice(ctx, preimage)
+}
+
+// CancelInvoice cancels the invoice provided.
+func (l *limitedInvoices) CancelInvoice(ctx context.Context,
+	hash lntypes.Hash) error {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return l.InvoicesClient.CancelInvoice(ctx, hash)
+}
+
+// AddHoldInvoice adds a new hold invoice.
+func (l *limitedInvoices) AddHoldInvoice(ctx context.Context,
+	in *invoicesrpc.AddInvoiceData) (string, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+
+	return l.InvoicesClient.AddHoldInvoice(ctx, in)
+}
+
+// limitedRouter limits the router calls that loop makes. Payments only count
+// towards our limit while they are being dispatched, not while they are in
+// flight.
+type limitedRouter struct {
+	lndclient.RouterClient
+	limiter *lndLimiter
+}
+
+// SendPayment attempts to route a payment to the final destination.
+func (l *limitedRouter) SendPayment(ctx context.Context,
+	request lndclient.SendPaymentRequest) (chan lndclient.PaymentStatus,
+	chan error, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	return l.RouterClient.SendPayment(ctx, request)
+}
+
+// TrackPayment picks up a previously started payment.
+func (l *limitedRouter) TrackPayment(ctx context.Context,
+	hash lntypes.Hash) (chan lndclient.PaymentStatus, chan error, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	return l.RouterClient.TrackPayment(ctx, hash)
+}
+
+// EstimateRouteFee estimates the routing cost of the given amount to the
+// destination node.
+func (l *limitedRouter) EstimateRouteFee(ctx context.Context,
+	dest route.Vertex, amt btcutil.Amount) (lnwire.MilliSatoshi, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	return l.RouterClient.EstimateRouteFee(ctx, dest, amt)
+}
+
+// QueryMissionControl queries lnd's mission control state.
+func (l *limitedRouter) QueryMissionControl(ctx context.Context) (
+	[]lndclient.MissionControlEntry, error) {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return l.RouterClient.QueryMissionControl(ctx)
+}
+
+// ImportMissionControl imports a set of pathfinding results to lnd.
+func (l *limitedRouter) ImportMissionControl(ctx context.Context,
+	entries []lndclient.MissionControlEntry, force bool) error {
+
+	release, err := l.limiter.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return l.RouterClient.ImportMissionControl(ctx, entries, force)
+}
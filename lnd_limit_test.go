@@ -0,0 +1,101 @@
+package loop
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingWalletKit is a wallet kit that blocks fee estimates until they are
+// released, reporting each call that is in flight.
+type blockingWalletKit struct {
+	lndclient.WalletKitClient
+
+	calls   chan struct{}
+	release chan struct{}
+}
+
+// EstimateFee reports the call and blocks until it is released.
+func (b *blockingWalletKit) EstimateFee(ctx context.Context,
+	confTarget int32) (chainfee.SatPerKWeight, error) {
+
+	b.calls <- struct{}{}
+	<-b.release
+
+	return chainfee.FeePerKwFloor, nil
+}
+
+// TestLimitLndServices tests that we bound the number of concurrent calls
+// that we make to lnd, and that calls that are waiting on the limit can be
+// canceled.
+func TestLimitLndServices(t *testing.T) {
+	walletKit := &blockingWalletKit{
+		calls:   make(chan struct{}, 10),
+		release: make(chan struct{}),
+	}
+
+	lnd := &lndclient.LndServices{
+		WalletKit: walletKit,
+	}
+
+	// A zero limit should leave our services unchanged.
+	require.Equal(t, lnd, LimitLndServices(lnd, 0))
+
+	limited := LimitLndServices(lnd, 2)
+
+	// Dispatch more calls than our limit allows.
+	const numCalls = 4
+	errs := make(chan error, numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func() {
+			_, err := limited.WalletKit.EstimateFee(
+				context.Background(), 6,
+			)
+			errs <- err
+		}()
+	}
+
+	// Only two calls should reach lnd.
+	for i := 0; i < 2; i++ {
+		select {
+		case <-walletKit.calls:
+		case <-time.After(test.Timeout):
+			t.Fatalf("call %v did not reach lnd", i)
+		}
+	}
+
+	select {
+	case <-walletKit.calls:
+		t.Fatal("call exceeded limit")
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	// A call that is waiting on our limit should fail when its context
+	// is canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := limited.WalletKit.EstimateFee(ctx, 6)
+	require.Equal(t, context.Canceled, err)
+
+	// Once we release the first calls, the remaining calls should be able
+	// to proceed.
+	for i := 0; i < numCalls; i++ {
+		walletKit.release <- struct{}{}
+	}
+
+	for i := 0; i < numCalls; i++ {
+		select {
+		case err := <-errs:
+			require.NoError(t, err)
+
+		case <-time.After(test.Timeout):
+			t.Fatalf("call %v did not complete", i)
+		}
+	}
+}
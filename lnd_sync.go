@@ -0,0 +1,146 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+)
+
+// DefaultSyncCheckInterval is the interval at which we check whether the lnd
+// node that we are connected to is synced to the chain.
+const DefaultSyncCheckInterval = time.Minute
+
+// ErrLndNotSynced is returned when a swap is requested while lnd is not synced
+// to the chain.
+var ErrLndNotSynced = errors.New("lnd is not synced to chain, swaps are " +
+	"suspended until it has caught up")
+
+// LndSyncState describes whether the lnd node that we are connected to is
+// synced to the chain. While lnd is syncing, we do not dispatch new swaps, but
+// we keep monitoring our existing swaps at the last block height that we were
+// notified of.
+type LndSyncState struct {
+	// Synced is true if lnd was synced to the chain when we last checked.
+	Synced bool
+
+	// BlockHeight is the block height that we monitor our swaps at.
+	BlockHeight uint32
+
+	// LndBlockHeight is the block height that lnd reported when we last
+	// checked its sync state.
+	LndBlockHeight uint32
+
+	// DegradedSince is the time at which we found that lnd was not synced,
+	// or zero if it is synced.
+	DegradedSince time.Time
+
+	// LastChecked is the time at which we last checked lnd's sync state,
+	// or zero if we have not checked it since we started.
+	LastChecked time.Time
+}
+
+// lndSyncMonitor tracks whether lnd is synced to the chain.
+type lndSyncMonitor struct {
+	lnd lndclient.LightningClient
+
+	// now returns the current time.
+	now func() time.Time
+
+	// state is our last known sync state. Its block height is not set,
+	// because our executor tracks it.
+	state LndSyncState
+
+	sync.Mutex
+}
+
+// newLndSyncMonitor creates a sync monitor. We only start once lnd is synced
+// to the chain, so the monitor starts in the synced state.
+func newLndSyncMonitor(lnd lndclient.LightningClient) *lndSyncMonitor {
+	return &lndSyncMonitor{
+		lnd: lnd,
+		now: time.Now,
+		state: LndSyncState{
+			Synced: true,
+		},
+	}
+}
+
+// check queries lnd's sync state and updates our state, logging any change.
+// If we cannot reach lnd, our last known state is kept.
+func (l *lndSyncMonitor) check(ctx context.Context) error {
+	info, err := l.lnd.GetInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	now := l.now()
+	switch {
+	case l.state.Synced && !info.SyncedToChain:
+		log.Warnf("lnd is not synced to chain at height %v, "+
+			"suspending swap dispatch", info.BlockHeight)
+
+		l.state.DegradedSince = now
+
+	case !l.state.Synced && info.SyncedToChain:
+		log.Infof("lnd synced to chain at height %v after %v, "+
+			"resuming swap dispatch", info.BlockHeight,
+			now.Sub(l.state.DegradedSince))
+
+		l.state.DegradedSince = time.Time{}
+	}
+
+	l.state.Synced = info.SyncedToChain
+	l.state.LndBlockHeight = info.BlockHeight
+	l.state.LastChecked = now
+
+	return nil
+}
+
+// run checks lnd's sync state on the interval provided until the context is
+// canceled.
+func (l *lndSyncMonitor) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := l.check(ctx); err != nil {
+				log.Errorf("could not check lnd sync state: %v",
+					err)
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// getState returns our last known sync state.
+func (l *lndSyncMonitor) getState() LndSyncState {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.state
+}
+
+// LndSyncState returns our last known view of whether lnd is synced to the
+// chain, along with the block height that we monitor our swaps at.
+func (s *Client) LndSyncState() LndSyncState {
+	state := s.syncMonitor.getState()
+	state.BlockHeight = uint32(s.executor.height())
+
+	return state
+}
+
+// LndSynced returns a boolean indicating whether lnd was synced to the chain
+// when we last checked.
+func (s *Client) LndSynced() bool {
+	return s.syncMonitor.getState().Synced
+}
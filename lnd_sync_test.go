@@ -0,0 +1,93 @@
+package loop
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/test"
+	"github.com/stretchr/testify/require"
+)
+
+// syncTestClient is a lightning client that reports the info provided.
+type syncTestClient struct {
+	lndclient.LightningClient
+
+	info *lndclient.Info
+}
+
+// GetInfo returns our test client's info.
+func (s *syncTestClient) GetInfo(_ context.Context) (*lndclient.Info, error) {
+	return s.info, nil
+}
+
+// TestLndSyncMonitor tests that our sync monitor tracks lnd losing and
+// regaining sync with the chain, and that we refuse new swaps while lnd is
+// not synced.
+func TestLndSyncMonitor(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := createClientTestContext(t, nil)
+
+	var (
+		start = time.Unix(1000, 0)
+		now   = start
+		lnd   = &syncTestClient{
+			info: &lndclient.Info{
+				BlockHeight:   600,
+				SyncedToChain: true,
+			},
+		}
+	)
+
+	monitor := ctx.swapClient.syncMonitor
+	monitor.lnd = lnd
+	monitor.now = func() time.Time {
+		return now
+	}
+
+	// We start synced, and should stay synced when lnd reports that it is
+	// synced.
+	require.True(t, ctx.swapClient.LndSynced())
+	require.NoError(t, monitor.check(context.Background()))
+	require.Equal(t, LndSyncState{
+		Synced:         true,
+		LndBlockHeight: 600,
+		LastChecked:    start,
+	}, monitor.getState())
+
+	// When lnd falls out of sync, we should record the time that we
+	// degraded and refuse new swaps.
+	lnd.info = &lndclient.Info{
+		BlockHeight: 590,
+	}
+	now = start.Add(time.Minute)
+	require.NoError(t, monitor.check(context.Background()))
+	require.Equal(t, LndSyncState{
+		LndBlockHeight: 590,
+		DegradedSince:  now,
+		LastChecked:    now,
+	}, monitor.getState())
+
+	_, err := ctx.swapClient.LoopOut(context.Background(), testRequest)
+	require.ErrorIs(t, err, ErrLndNotSynced)
+
+	// Checking again while lnd is still syncing should not change the time
+	// that we degraded.
+	degraded := now
+	now = start.Add(time.Minute * 2)
+	require.NoError(t, monitor.check(context.Background()))
+	require.Equal(t, degraded, monitor.getState().DegradedSince)
+
+	// Once lnd is synced again, we should resume.
+	lnd.info = &lndclient.Info{
+		BlockHeight:   600,
+		SyncedToChain: true,
+	}
+	require.NoError(t, monitor.check(context.Background()))
+	require.True(t, ctx.swapClient.LndSynced())
+	require.True(t, monitor.getState().DegradedSince.IsZero())
+
+	ctx.finish()
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/aperture/lsat"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/secrets"
 	"github.com/lightningnetwork/lnd/cert"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -38,6 +40,14 @@ var (
 	defaultLoopOutMaxParts     = uint32(5)
 	defaultTotalPaymentTimeout = time.Minute * 60
 	defaultMaxPaymentRetries   = 3
+	defaultLoopInAlarmDelta    = int32(24)
+	defaultLoopInCancelDelta   = int32(6)
+
+	defaultBudgetIncreaseThreshold = uint64(20)
+
+	defaultDestXpubLookahead = uint32(1000)
+
+	defaultStartupAttempts = uint32(30)
 
 	// DefaultTLSCertFilename is the default file name for the autogenerated
 	// TLS certificate.
@@ -114,6 +124,27 @@ type loopServerConfig struct {
 	TLSPath string `long:"tlspath" description:"Path to loop server tls certificate [testing only]"`
 }
 
+type notifyConfig struct {
+	Rules []string `long:"rule" description:"A notification rule formatted as trigger:threshold:transport[,transport]. Supported triggers are swapinitiated (threshold in sats), sweepunconfirmed (threshold in blocks), budgetconsumed (threshold in percent of the autoloop budget) and swapoverdue (threshold in sats). Supported transports are webhook and email. May be set multiple times."`
+
+	WebhookURL string `long:"webhookurl" description:"The url that notifications routed to the webhook transport are posted to."`
+
+	AutoloopWebhookURL string `long:"autoloopwebhookurl" description:"A url that autoloop events are posted to as json. Events are sent when autoloop dispatches a swap, when a swap dispatched by autoloop succeeds or fails and when the autoloop fee budget is exhausted."`
+
+	SMTPHost     string   `long:"smtphost" description:"The host:port of the smtp server used by the email transport."`
+	SMTPUser     string   `long:"smtpuser" description:"The username used to authenticate with the smtp server."`
+	SMTPPassword string   `long:"smtppassword" description:"The password used to authenticate with the smtp server."`
+	EmailFrom    string   `long:"emailfrom" description:"The sender address for notification e-mails."`
+	EmailTo      []string `long:"emailto" description:"A recipient address for notification e-mails. May be set multiple times."`
+}
+
+type vaultConfig struct {
+	Addr  string `long:"addr" description:"The address of the vault server that swap preimages are stored in, including its scheme, for example https://vault.example.com:8200."`
+	Token string `long:"token" description:"The token that loopd authenticates with vault with. The token must be able to read and write secrets under the preimage path."`
+	Mount string `long:"mount" description:"The mount path of the kv version 2 secrets engine that swap preimages are stored in."`
+	Path  string `long:"path" description:"The path under the mount that swap preimages are stored at."`
+}
+
 type viewParameters struct{}
 
 type Config struct {
@@ -140,6 +171,9 @@ type Config struct {
 	MaxLogFiles    int    `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)."`
 	MaxLogFileSize int    `long:"maxlogfilesize" description:"Maximum logfile size in MB."`
 
+	LogSinks  []string `long:"logsink" description:"A sink that logs are written to. Supported sinks are stdout (plain text), json (json lines on stdout), file (rotated log files in logdir), syslog and journald (stdout with journald priority prefixes). Only one sink that writes to stdout may be set. May be set multiple times, defaults to stdout and file."`
+	SyslogTag string   `long:"syslogtag" description:"The tag that log entries written to the syslog sink are tagged with."`
+
 	DebugLevel  string `long:"debuglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
 	MaxLSATCost uint32 `long:"maxlsatcost" description:"Maximum cost in satoshis that loopd is going to pay for an LSAT token automatically. Does not include routing fees."`
 	MaxLSATFee  uint32 `long:"maxlsatfee" description:"Maximum routing fee in satoshis that we are willing to pay while paying for an LSAT token."`
@@ -149,11 +183,43 @@ type Config struct {
 	TotalPaymentTimeout time.Duration `long:"totalpaymenttimeout" description:"The timeout to use for off-chain payments."`
 	MaxPaymentRetries   int           `long:"maxpaymentretries" description:"The maximum number of times an off-chain payment may be retried."`
 
+	LoopInAlarmDelta  int32 `long:"loopinalarmdelta" description:"The number of blocks before a loop in htlc's expiry at which loopd raises an alarm if the server has not yet paid the swap invoice. Set to 0 to disable the alarm."`
+	LoopInCancelDelta int32 `long:"loopincanceldelta" description:"The number of blocks before a loop in htlc's expiry at which loopd cancels the swap invoice if the server has not yet paid it, so that it cannot be settled while loopd reclaims its funds. Set to 0 to disable cancellation."`
+
+	WaitForLnd      bool   `long:"waitforlnd" description:"Retry connecting to lnd on startup, up to the number of startup attempts, rather than exiting if lnd is not reachable."`
+	StartupAttempts uint32 `long:"startupattempts" description:"The number of attempts that are made to connect to lnd, if waitforlnd is set, and to reach the swap server before systemd is notified that loopd is ready. Attempts are made every 10 seconds."`
+
+	MaxLndRPCs int `long:"maxlndrpcs" description:"The maximum number of calls that loopd makes to lnd concurrently, including router payments, signer requests and chain notification registrations. Calls above this limit wait until an earlier call completes. Set to 0 to disable the limit."`
+
+	AccountingCheckInterval time.Duration `long:"accountingcheckinterval" description:"The interval at which loopd reconciles the costs recorded for completed swaps against lnd's payment and transaction records, logging any discrepancies and reporting them with the GetAccountingReport rpc. Set to 0 to disable accounting checks."`
+
+	MaxLoopOutLifetime time.Duration `long:"maxloopoutlifetime" description:"The maximum amount of time that a loop out may be pending for. Loop outs that exceed it are flagged, get a post-mortem report that is available with the GetPostMortems rpc and fire swapoverdue notification rules. Set to 0 to disable the limit."`
+	MaxLoopInLifetime  time.Duration `long:"maxloopinlifetime" description:"The maximum amount of time that a loop in may be pending for. Loop ins that exceed it are flagged, get a post-mortem report that is available with the GetPostMortems rpc and fire swapoverdue notification rules. Set to 0 to disable the limit."`
+
+	BudgetIncreaseDelay     time.Duration `long:"budgetincreasedelay" description:"The delay before increases to the autoloop fee budget or fee limit that exceed the budget increase threshold take effect. Delayed increases can be cancelled with the CancelPendingIncrease rpc until they take effect, which protects against a compromised macaroon instantly authorizing large automated spending. Set to 0 to apply increases immediately."`
+	BudgetIncreaseThreshold uint64        `long:"budgetincreasethreshold" description:"The percentage that the autoloop fee budget and each fee limit may be increased by in a single update before the increase is delayed by the budget increase delay."`
+
+	RebalanceHookURL string `long:"rebalancehookurl" description:"A url that autoloop posts each loop out to as json before dispatching it, so that an external tool can rebalance the loop out's channels off-chain first. If the tool responds that it rebalanced the full amount, the loop out is not dispatched. If it fails or declines, autoloop falls back to the loop out."`
+
+	DestXpubs               []string `long:"destxpub" description:"An extended public key that custom loop out destinations may be derived from, which verifies that they are owned by the user. Addresses on the key's external and change branches are checked, up to the destination xpub lookahead. May be set multiple times."`
+	DestXpubLookahead       uint32   `long:"destxpublookahead" description:"The number of addresses on each branch of the destination xpubs that are checked when verifying a loop out destination."`
+	RequireDestVerification bool     `long:"requiredestverification" description:"Reject loop outs and autoloop rules with custom destinations whose ownership cannot be verified with a signature or a destination xpub."`
+
+	SecretStore string `long:"secretstore" description:"The store that the preimages of new swaps are held in. The loopdb store keeps preimages in loop's database. The keychain store keeps them in the OS keychain, using the security tool on macOS and secret-tool on linux. The vault store keeps them in a HashiCorp Vault kv version 2 secrets engine, configured with the vault options. Swaps keep their preimages in the store that they were created with, so loopd refuses to start with the loopdb store while swaps that were created with the keychain or vault store are pending." choice:"loopdb" choice:"keychain" choice:"vault"`
+
 	Lnd *lndConfig `group:"lnd" namespace:"lnd"`
 
 	Server *loopServerConfig `group:"server" namespace:"server"`
 
+	Notify *notifyConfig `group:"notify" namespace:"notify"`
+
+	Vault *vaultConfig `group:"vault" namespace:"vault"`
+
 	View viewParameters `command:"view" alias:"v" description:"View all swaps in the database. This command can only be executed when loopd is not running."`
+
+	// sources maps the long names of the options that were not left at
+	// their default values to the source that set them.
+	sources map[string]looprpc.ConfigSource
 }
 
 const (
@@ -161,6 +227,17 @@ const (
 	testnetServer = "test.swap.lightning.today:11010"
 )
 
+const (
+	// secretStoreLoopDB holds swap preimages in our database.
+	secretStoreLoopDB = "loopdb"
+
+	// secretStoreKeychain holds swap preimages in the OS keychain.
+	secretStoreKeychain = "keychain"
+
+	// secretStoreVault holds swap preimages in vault.
+	secretStoreVault = "vault"
+)
+
 // DefaultConfig returns all default values for the Config struct.
 func DefaultConfig() Config {
 	return Config{
@@ -185,10 +262,23 @@ func DefaultConfig() Config {
 		LoopOutMaxParts:     defaultLoopOutMaxParts,
 		TotalPaymentTimeout: defaultTotalPaymentTimeout,
 		MaxPaymentRetries:   defaultMaxPaymentRetries,
+		LoopInAlarmDelta:    defaultLoopInAlarmDelta,
+		LoopInCancelDelta:   defaultLoopInCancelDelta,
+		SecretStore:         secretStoreLoopDB,
+
+		BudgetIncreaseThreshold: defaultBudgetIncreaseThreshold,
+		DestXpubLookahead:       defaultDestXpubLookahead,
+		StartupAttempts:         defaultStartupAttempts,
+
 		Lnd: &lndConfig{
 			Host:         "localhost:10009",
 			MacaroonPath: DefaultLndMacaroonPath,
 		},
+		Notify: &notifyConfig{},
+		Vault: &vaultConfig{
+			Mount: secrets.DefaultVaultMount,
+			Path:  secrets.DefaultVaultPath,
+		},
 	}
 }
 
@@ -273,6 +363,10 @@ func Validate(cfg *Config) error {
 		)
 	}
 
+	if err := validateLogSinks(cfg.LogSinks); err != nil {
+		return err
+	}
+
 	// If either of these directories do not exist, create them.
 	if err := os.MkdirAll(cfg.DataDir, os.ModePerm); err != nil {
 		return err
@@ -317,6 +411,57 @@ func Validate(cfg *Config) error {
 		return fmt.Errorf("max payment retries must be positive")
 	}
 
+	if err := validateLoopInHold(cfg); err != nil {
+		return err
+	}
+
+	if cfg.SecretStore == secretStoreVault &&
+		(cfg.Vault.Addr == "" || cfg.Vault.Token == "") {
+
+		return fmt.Errorf("vault secret store requires --vault.addr " +
+			"and --vault.token")
+	}
+
+	if cfg.StartupAttempts == 0 {
+		return fmt.Errorf("at least one startup attempt required")
+	}
+
+	if cfg.MaxLndRPCs < 0 {
+		return fmt.Errorf("max lnd rpcs may not be negative")
+	}
+
+	if cfg.AccountingCheckInterval < 0 {
+		return fmt.Errorf("accounting check interval may not be " +
+			"negative")
+	}
+
+	if cfg.MaxLoopOutLifetime < 0 || cfg.MaxLoopInLifetime < 0 {
+		return fmt.Errorf("maximum swap lifetimes may not be negative")
+	}
+
+	if cfg.BudgetIncreaseDelay < 0 {
+		return fmt.Errorf("budget increase delay may not be negative")
+	}
+
+	return nil
+}
+
+// validateLoopInHold validates our loop in alarm and cancel deltas. We cancel
+// unpaid invoices after raising our alarm, so the cancel delta may not
+// exceed the alarm delta when both are set.
+func validateLoopInHold(cfg *Config) error {
+	if cfg.LoopInAlarmDelta < 0 || cfg.LoopInCancelDelta < 0 {
+		return fmt.Errorf("loop in alarm and cancel deltas may not be " +
+			"negative")
+	}
+
+	if cfg.LoopInAlarmDelta != 0 &&
+		cfg.LoopInCancelDelta > cfg.LoopInAlarmDelta {
+
+		return fmt.Errorf("loop in cancel delta %v exceeds alarm "+
+			"delta %v", cfg.LoopInCancelDelta, cfg.LoopInAlarmDelta)
+	}
+
 	return nil
 }
 
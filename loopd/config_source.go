@@ -0,0 +1,105 @@
+package loopd
+
+import (
+	"fmt"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/lightninglabs/loop/looprpc"
+)
+
+// redactedOptions is the set of config options whose values are not exposed
+// over rpc, because they may contain credentials.
+var redactedOptions = map[string]bool{
+	"notify.smtppassword": true,
+	"notify.webhookurl":   true,
+	"vault.token":         true,
+}
+
+// eachOption calls the function provided for every option in a group and its
+// subgroups.
+func eachOption(group *flags.Group, f func(*flags.Option)) {
+	for _, option := range group.Options() {
+		f(option)
+	}
+
+	for _, subGroup := range group.Groups() {
+		eachOption(subGroup, f)
+	}
+}
+
+// setOptions returns the set of long option names that have been set in the
+// parser provided.
+func setOptions(parser *flags.Parser) map[string]bool {
+	set := make(map[string]bool)
+
+	eachOption(parser.Command.Group, func(option *flags.Option) {
+		if option.IsSet() {
+			set[option.LongNameWithNamespace()] = true
+		}
+	})
+
+	return set
+}
+
+// configFileOptions returns the set of long option names that are set in the
+// config file provided.
+func configFileOptions(configFile string) (map[string]bool, error) {
+	scratch := DefaultConfig()
+	parser := flags.NewParser(&scratch, flags.None)
+
+	err := flags.NewIniParser(parser).ParseFile(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return setOptions(parser), nil
+}
+
+// newConfigSources returns the source of each config option that was not
+// left at its default value. Options set on the command line take precedence
+// over the config file.
+func newConfigSources(cliOptions,
+	fileOptions map[string]bool) map[string]looprpc.ConfigSource {
+
+	var (
+		sources = make(map[string]looprpc.ConfigSource)
+		file    = looprpc.ConfigSource_CONFIG_SOURCE_CONFIG_FILE
+		cli     = looprpc.ConfigSource_CONFIG_SOURCE_COMMAND_LINE
+	)
+
+	for option := range fileOptions {
+		sources[option] = file
+	}
+
+	for option := range cliOptions {
+		sources[option] = cli
+	}
+
+	return sources
+}
+
+// effectiveConfig returns the effective value of each of the config's
+// options, along with the source that it was set by.
+func effectiveConfig(cfg *Config) []*looprpc.ConfigValue {
+	var (
+		parser = flags.NewParser(cfg, flags.None)
+		values []*looprpc.ConfigValue
+	)
+
+	eachOption(parser.Command.Group, func(option *flags.Option) {
+		name := option.LongNameWithNamespace()
+
+		value := fmt.Sprintf("%v", option.Value())
+		if redactedOptions[name] && value != "" {
+			value = "[redacted]"
+		}
+
+		values = append(values, &looprpc.ConfigValue{
+			Name:   name,
+			Value:  value,
+			Source: cfg.sources[name],
+		})
+	})
+
+	return values
+}
@@ -0,0 +1,68 @@
+package loopd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEffectiveConfig tests reporting of the source of our config options.
+func TestEffectiveConfig(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), defaultConfigFilename)
+	err := os.WriteFile(configFile, []byte(
+		"maxlndrpcs=5\ndebuglevel=debug\n\n[notify]\n"+
+			"notify.smtppassword=secret\n",
+	), 0600)
+	require.NoError(t, err)
+
+	config := DefaultConfig()
+	parser := flags.NewParser(&config, flags.None)
+	parser.SubcommandsOptional = true
+
+	// The debug level is set in our config file and on the command line,
+	// so the command line value takes precedence.
+	_, err = parser.ParseArgs([]string{"--debuglevel=trace"})
+	require.NoError(t, err)
+	cliOptions := setOptions(parser)
+
+	require.NoError(t, flags.IniParse(configFile, &config))
+	_, err = parser.ParseArgs([]string{"--debuglevel=trace"})
+	require.NoError(t, err)
+
+	fileOptions, err := configFileOptions(configFile)
+	require.NoError(t, err)
+	config.sources = newConfigSources(cliOptions, fileOptions)
+
+	values := make(map[string]*looprpc.ConfigValue)
+	for _, value := range effectiveConfig(&config) {
+		values[value.Name] = value
+	}
+
+	require.Equal(t, &looprpc.ConfigValue{
+		Name:   "maxlndrpcs",
+		Value:  "5",
+		Source: looprpc.ConfigSource_CONFIG_SOURCE_CONFIG_FILE,
+	}, values["maxlndrpcs"])
+
+	require.Equal(t, &looprpc.ConfigValue{
+		Name:   "debuglevel",
+		Value:  "trace",
+		Source: looprpc.ConfigSource_CONFIG_SOURCE_COMMAND_LINE,
+	}, values["debuglevel"])
+
+	require.Equal(t, &looprpc.ConfigValue{
+		Name:   "notify.smtppassword",
+		Value:  "[redacted]",
+		Source: looprpc.ConfigSource_CONFIG_SOURCE_CONFIG_FILE,
+	}, values["notify.smtppassword"])
+
+	require.Equal(t, &looprpc.ConfigValue{
+		Name:   "network",
+		Value:  DefaultNetwork,
+		Source: looprpc.ConfigSource_CONFIG_SOURCE_DEFAULT,
+	}, values["network"])
+}
@@ -11,6 +11,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightninglabs/lndclient"
@@ -101,10 +102,10 @@ func New(config *Config, lisCfg *ListenerCfg) *Daemon {
 		cfg:         config,
 		listenerCfg: lisCfg,
 
-		// We have 4 goroutines that could potentially send an error.
+		// We have 5 goroutines that could potentially send an error.
 		// We react on the first error but in case more than one exits
 		// with an error we don't want them to block.
-		internalErrChan: make(chan error, 4),
+		internalErrChan: make(chan error, 5),
 	}
 }
 
@@ -120,8 +121,22 @@ func (d *Daemon) Start() error {
 
 	network := lndclient.Network(d.cfg.Network)
 
-	var err error
-	d.lnd, err = d.listenerCfg.getLnd(network, d.cfg.Lnd)
+	// If we are configured to wait for lnd, we retry our connection so
+	// that we can be started before lnd is reachable, for example by a
+	// service manager that starts both at once.
+	attempts := uint32(1)
+	if d.cfg.WaitForLnd {
+		attempts = d.cfg.StartupAttempts
+	}
+
+	err := retryStartup(
+		"lnd", attempts, startupRetryInterval,
+		interceptor.ShutdownChannel(), func() error {
+			var err error
+			d.lnd, err = d.listenerCfg.getLnd(network, d.cfg.Lnd)
+			return err
+		},
+	)
 	if err != nil {
 		return err
 	}
@@ -155,6 +170,15 @@ func (d *Daemon) Start() error {
 		return startErr
 	}
 
+	// Now that we have fully started, we let systemd know that we are
+	// ready once we can reach the swap server, if we are run by it.
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+
+		d.notifyService(d.mainCtx)
+	}()
+
 	return nil
 }
 
@@ -220,9 +244,15 @@ func (d *Daemon) startWebServers() error {
 	if err != nil {
 		return fmt.Errorf("error with macaroon interceptor: %v", err)
 	}
+	// Our error interceptors are chained after the macaroon interceptors,
+	// so that they attach error details to the errors of our handlers.
 	d.grpcServer = grpc.NewServer(
-		grpc.UnaryInterceptor(unaryInterceptor),
-		grpc.StreamInterceptor(streamInterceptor),
+		grpc.ChainUnaryInterceptor(
+			unaryInterceptor, errorUnaryInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			streamInterceptor, errorStreamInterceptor,
+		),
 	)
 	looprpc.RegisterSwapClientServer(d.grpcServer, d)
 
@@ -365,13 +395,43 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 
 	log.Infof("Swap server address: %v", d.cfg.Server.Host)
 
+	// Limit the number of calls that we make to lnd concurrently, if
+	// required. Our macaroon service only makes calls to lnd on startup,
+	// so it uses our unlimited connection.
+	lndServices := loop.LimitLndServices(
+		&d.lnd.LndServices, d.cfg.MaxLndRPCs,
+	)
+
 	// Create an instance of the loop client library.
-	swapclient, clientCleanup, err := getClient(d.cfg, &d.lnd.LndServices)
+	swapclient, clientCleanup, err := getClient(d.cfg, lndServices)
 	if err != nil {
 		return err
 	}
 	d.clientCleanup = clientCleanup
 
+	destVerifier, err := getDestVerifier(d.cfg, lndServices)
+	if err != nil {
+		clientCleanup()
+		return err
+	}
+
+	// Create our notification manager, which is nil if no notification
+	// rules or autoloop webhook are configured. Our liquidity manager is
+	// only created below, so we look it up when the budget status is
+	// queried.
+	notifier, err := getNotificationManager(
+		d.cfg.Notify, lndServices,
+		func(ctx context.Context) (btcutil.Amount, btcutil.Amount,
+			error) {
+
+			return d.liquidityMgr.BudgetStatus(ctx)
+		},
+	)
+	if err != nil {
+		clientCleanup()
+		return err
+	}
+
 	// Both the client RPC server and and the swap server client should
 	// stop on main context cancel. So we create it early and pass it down.
 	d.mainCtx, d.mainCtxCancel = context.WithCancel(context.Background())
@@ -418,9 +478,14 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 	// Now finally fully initialize the swap client RPC server instance.
 	d.swapClientServer = swapClientServer{
 		network:      lndclient.Network(d.cfg.Network),
+		config:       d.cfg,
 		impl:         swapclient,
-		liquidityMgr: getLiquidityManager(swapclient),
-		lnd:          &d.lnd.LndServices,
+		liquidityMgr: getLiquidityManager(d.cfg, swapclient),
+		notifier:     notifier,
+		accounting:   getAccountingChecker(d.cfg, swapclient),
+		lifetime:     getLifetimeChecker(d.cfg, swapclient, notifier),
+		destVerifier: destVerifier,
+		lnd:          lndServices,
 		swaps:        make(map[lntypes.Hash]loop.SwapInfo),
 		subscribers:  make(map[int]chan<- interface{}),
 		statusChan:   make(chan loop.SwapInfo),
@@ -489,6 +554,51 @@ func (d *Daemon) initialize(withMacaroonService bool) error {
 		log.Info("Liquidity manager stopped")
 	}()
 
+	if d.accounting != nil {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+
+			log.Info("Starting accounting checker")
+			err := d.accounting.Run(d.mainCtx)
+			if err != nil && err != context.Canceled {
+				d.internalErrChan <- err
+			}
+
+			log.Info("Accounting checker stopped")
+		}()
+	}
+
+	if d.lifetime != nil {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+
+			log.Info("Starting swap lifetime checker")
+			err := d.lifetime.Run(d.mainCtx)
+			if err != nil && err != context.Canceled {
+				d.internalErrChan <- err
+			}
+
+			log.Info("Swap lifetime checker stopped")
+		}()
+	}
+
+	if d.notifier != nil {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+
+			log.Info("Starting notification manager")
+			err := d.notifier.Run(d.mainCtx)
+			if err != nil && err != context.Canceled {
+				d.internalErrChan <- err
+			}
+
+			log.Info("Notification manager stopped")
+		}()
+	}
+
 	// Last, start our internal error handler. This will return exactly one
 	// error or nil on the main error channel to inform the caller that
 	// something went wrong or that shutdown is complete. We don't add to
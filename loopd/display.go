@@ -0,0 +1,61 @@
+package loopd
+
+import (
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	clientrpc "github.com/lightninglabs/loop/looprpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateDisplayUnit checks that a display unit requested over rpc is known.
+func validateDisplayUnit(unit clientrpc.DisplayUnit) error {
+	if _, ok := clientrpc.DisplayUnit_name[int32(unit)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown display "+
+			"unit: %v", unit)
+	}
+
+	return nil
+}
+
+// displayAmounts formats the amounts provided, keyed by the name of the rpc
+// field that holds each amount, in the display unit requested. If no display
+// unit is requested, nil is returned so that responses are unchanged.
+func displayAmounts(unit clientrpc.DisplayUnit,
+	amounts map[string]btcutil.Amount) map[string]string {
+
+	var displayUnit loop.DisplayUnit
+	switch unit {
+	case clientrpc.DisplayUnit_DISPLAY_UNIT_SAT:
+		displayUnit = loop.DisplayUnitSat
+
+	case clientrpc.DisplayUnit_DISPLAY_UNIT_BTC:
+		displayUnit = loop.DisplayUnitBTC
+
+	case clientrpc.DisplayUnit_DISPLAY_UNIT_MSAT:
+		displayUnit = loop.DisplayUnitMSat
+
+	default:
+		return nil
+	}
+
+	display := make(map[string]string, len(amounts))
+	for field, amount := range amounts {
+		display[field] = displayUnit.FormatAmount(amount)
+	}
+
+	return display
+}
+
+// swapDisplayAmounts returns the amounts of a swap formatted in the display
+// unit requested.
+func swapDisplayAmounts(unit clientrpc.DisplayUnit,
+	swap *clientrpc.SwapStatus) map[string]string {
+
+	return displayAmounts(unit, map[string]btcutil.Amount{
+		"amt":           btcutil.Amount(swap.Amt),
+		"cost_server":   btcutil.Amount(swap.CostServer),
+		"cost_onchain":  btcutil.Amount(swap.CostOnchain),
+		"cost_offchain": btcutil.Amount(swap.CostOffchain),
+	})
+}
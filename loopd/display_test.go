@@ -0,0 +1,38 @@
+package loopd
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	clientrpc "github.com/lightninglabs/loop/looprpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDisplayAmounts tests formatting of rpc amounts in the display unit
+// requested.
+func TestDisplayAmounts(t *testing.T) {
+	amounts := map[string]btcutil.Amount{
+		"amt":         150_000,
+		"cost_server": 12,
+	}
+
+	// If no display unit is requested, we do not return display amounts.
+	require.Nil(t, displayAmounts(
+		clientrpc.DisplayUnit_DISPLAY_UNIT_NONE, amounts,
+	))
+
+	require.Equal(t, map[string]string{
+		"amt":         "0.00150000 BTC",
+		"cost_server": "0.00000012 BTC",
+	}, displayAmounts(clientrpc.DisplayUnit_DISPLAY_UNIT_BTC, amounts))
+
+	require.Equal(t, map[string]string{
+		"amt":         "150000000 msat",
+		"cost_server": "12000 msat",
+	}, displayAmounts(clientrpc.DisplayUnit_DISPLAY_UNIT_MSAT, amounts))
+
+	require.NoError(t, validateDisplayUnit(
+		clientrpc.DisplayUnit_DISPLAY_UNIT_SAT,
+	))
+	require.Error(t, validateDisplayUnit(clientrpc.DisplayUnit(10)))
+}
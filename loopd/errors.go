@@ -0,0 +1,273 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightninglabs/loop/loopdb"
+	clientrpc "github.com/lightninglabs/loop/looprpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorClass is a set of errors that our rpc handlers may return, and the
+// error code that we report for them.
+type errorClass struct {
+	code clientrpc.ErrorCode
+	errs []error
+}
+
+// errorClasses is the set of errors that we classify. Errors are matched with
+// errors.Is, so wrapped errors are classified as well.
+var errorClasses = []errorClass{
+	{
+		code: clientrpc.ErrorCode_ERROR_CODE_INVALID_PARAMETERS,
+		errs: []error{
+			errIncorrectChain,
+			loop.ErrChangeToleranceExternal,
+			loop.ErrCustomRecordsTooLarge,
+			loop.ErrInvalidDestSignature,
+			loop.ErrUnverifiedDest,
+			loop.ErrUnverifiedXpub,
+			loop.ErrCustomRecordType,
+			errConfTargetTooLow,
+			labels.ErrLabelTooLong,
+			labels.ErrReservedPrefix,
+			loopdb.ErrNotesTooLong,
+			liquidity.ErrZeroMinerFee,
+			liquidity.ErrZeroSwapFeePPM,
+			liquidity.ErrZeroRoutingPPM,
+			liquidity.ErrZeroPrepayPPM,
+			liquidity.ErrZeroPrepay,
+			liquidity.ErrInvalidPPM,
+			liquidity.ErrZeroMinerFeePPM,
+			liquidity.ErrZeroMinerFeeMultiplier,
+			liquidity.ErrInvalidSweepFeeRateLimit,
+			liquidity.ErrZeroChannelID,
+			liquidity.ErrNegativeBudget,
+			liquidity.ErrNegativeMaxSwapAmount,
+			liquidity.ErrZeroInFlight,
+			liquidity.ErrZeroVBytesPeriod,
+			liquidity.ErrMinimumExceedsMaximumAmt,
+			liquidity.ErrExclusiveRules,
+			liquidity.ErrAmbiguousRules,
+			liquidity.ErrRebalanceSamePeer,
+			liquidity.ErrNegativeApprovalTTL,
+			liquidity.ErrInvalidEasyTarget,
+			liquidity.ErrEasyWithRules,
+			liquidity.ErrInvalidCalendarHorizon,
+			liquidity.ErrNegativeAmountBucket,
+			liquidity.ErrInvalidAmountJitter,
+			liquidity.ErrZeroChannelsPerInFlight,
+			liquidity.ErrNegativeSuccessCooldown,
+			liquidity.ErrNegativeSwapRateLimit,
+			liquidity.ErrZeroSwapRatePeriod,
+			liquidity.ErrInvalidBackoffMultiplier,
+			liquidity.ErrBackoffCapTooLow,
+			liquidity.ErrInvalidBackoffJitter,
+			liquidity.ErrCustomRecordsLoopIn,
+			liquidity.ErrNegativeTypeInFlight,
+			liquidity.ErrNegativeDispatchSpacing,
+			liquidity.ErrNegativeFlowWindow,
+			liquidity.ErrNegativeRevenueWindow,
+			liquidity.ErrRevenueLoopIn,
+			liquidity.ErrNegativePublicationDeadline,
+			liquidity.ErrPublicationDeadlineLoopIn,
+			liquidity.ErrEmptyRuleSelector,
+			liquidity.ErrEmptyProfileName,
+			liquidity.ErrDestinationLoopIn,
+			liquidity.ErrExclusiveDestination,
+			liquidity.ErrPrivateXpub,
+		},
+	},
+	{
+		code: clientrpc.ErrorCode_ERROR_CODE_AMOUNT_OUT_OF_BOUNDS,
+		errs: []error{
+			loop.ErrSwapAmountTooLow,
+			loop.ErrSwapAmountTooHigh,
+			errBalanceTooLow,
+			liquidity.ErrMaxExceedsServer,
+			liquidity.ErrMinLessThanServer,
+			liquidity.ErrRebalanceBalance,
+		},
+	},
+	{
+		code: clientrpc.ErrorCode_ERROR_CODE_BUDGET_EXHAUSTED,
+		errs: []error{
+			loop.ErrSwapFeeTooHigh,
+			loop.ErrPrepayAmountTooHigh,
+			loop.ErrTotalCostTooHigh,
+		},
+	},
+	{
+		code: clientrpc.ErrorCode_ERROR_CODE_NOT_FOUND,
+		errs: []error{
+			loopdb.ErrSwapNotFound,
+			liquidity.ErrChannelNotFound,
+			liquidity.ErrApprovalNotFound,
+			liquidity.ErrProfileNotFound,
+			liquidity.ErrNoPendingIncrease,
+		},
+	},
+	{
+		code: clientrpc.ErrorCode_ERROR_CODE_FAILED_PRECONDITION,
+		errs: []error{
+			loop.ErrLndFeatureUnsupported,
+			loop.ErrLndNotSynced,
+			loop.ErrRecoveryDrillNetwork,
+			loop.ErrChangeUnavoidable,
+			loop.ErrNoAccountingReport,
+			loop.ErrSwapNotExternal,
+			loopdb.ErrWatchedSwapExists,
+			loopdb.ErrSwapExists,
+			loopdb.ErrNoSwapConflict,
+			liquidity.ErrNoRules,
+			liquidity.ErrRulePreviewStale,
+			liquidity.ErrProfileScheduled,
+		},
+	},
+	{
+		code: clientrpc.ErrorCode_ERROR_CODE_SERVER_UNAVAILABLE,
+		errs: []error{
+			loop.ErrServerQueueFull,
+		},
+	},
+	{
+		code: clientrpc.ErrorCode_ERROR_CODE_SERVER_INVOICE_MISMATCH,
+		errs: []error{
+			loop.ErrInvoiceMismatch,
+		},
+	},
+}
+
+// statusCodes maps our error codes to the gRPC status code that we use for
+// errors that do not already carry a status.
+var statusCodes = map[clientrpc.ErrorCode]codes.Code{
+	clientrpc.ErrorCode_ERROR_CODE_UNKNOWN:                 codes.Unknown,
+	clientrpc.ErrorCode_ERROR_CODE_INVALID_PARAMETERS:      codes.InvalidArgument,
+	clientrpc.ErrorCode_ERROR_CODE_AMOUNT_OUT_OF_BOUNDS:    codes.OutOfRange,
+	clientrpc.ErrorCode_ERROR_CODE_BUDGET_EXHAUSTED:        codes.FailedPrecondition,
+	clientrpc.ErrorCode_ERROR_CODE_SERVER_UNAVAILABLE:      codes.Unavailable,
+	clientrpc.ErrorCode_ERROR_CODE_NOT_FOUND:               codes.NotFound,
+	clientrpc.ErrorCode_ERROR_CODE_FAILED_PRECONDITION:     codes.FailedPrecondition,
+	clientrpc.ErrorCode_ERROR_CODE_SERVER_INVOICE_MISMATCH: codes.Aborted,
+}
+
+// errorCode classifies an error that does not carry a gRPC status.
+func errorCode(err error) clientrpc.ErrorCode {
+	for _, class := range errorClasses {
+		for _, classErr := range class.errs {
+			if errors.Is(err, classErr) {
+				return class.code
+			}
+		}
+	}
+
+	return clientrpc.ErrorCode_ERROR_CODE_UNKNOWN
+}
+
+// statusErrorCode classifies an error that already carries a gRPC status,
+// which is the case for errors that we relay from the swap server and for
+// errors that our handlers create with a status code.
+func statusErrorCode(code codes.Code) clientrpc.ErrorCode {
+	switch code {
+	case codes.InvalidArgument:
+		return clientrpc.ErrorCode_ERROR_CODE_INVALID_PARAMETERS
+
+	case codes.OutOfRange:
+		return clientrpc.ErrorCode_ERROR_CODE_AMOUNT_OUT_OF_BOUNDS
+
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return clientrpc.ErrorCode_ERROR_CODE_SERVER_UNAVAILABLE
+
+	case codes.NotFound:
+		return clientrpc.ErrorCode_ERROR_CODE_NOT_FOUND
+
+	case codes.FailedPrecondition:
+		return clientrpc.ErrorCode_ERROR_CODE_FAILED_PRECONDITION
+
+	default:
+		return clientrpc.ErrorCode_ERROR_CODE_UNKNOWN
+	}
+}
+
+// rpcError converts an error returned by one of our rpc handlers into a gRPC
+// status error that has an ErrorDetail attached. The status code and message
+// of errors that already carry a status are preserved.
+func rpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var (
+		st   *status.Status
+		code clientrpc.ErrorCode
+	)
+
+	switch {
+	// If the error already carries a status, we keep its code and
+	// message. Errors that already have a detail are returned as-is.
+	case isStatusError(err):
+		st = status.Convert(err)
+
+		for _, detail := range st.Details() {
+			if _, ok := detail.(*clientrpc.ErrorDetail); ok {
+				return err
+			}
+		}
+
+		code = statusErrorCode(st.Code())
+
+	// Context errors have a status code of their own, which we preserve.
+	case errors.Is(err, context.Canceled),
+		errors.Is(err, context.DeadlineExceeded):
+
+		st = status.FromContextError(err)
+		code = clientrpc.ErrorCode_ERROR_CODE_UNKNOWN
+
+	default:
+		code = errorCode(err)
+		st = status.New(statusCodes[code], err.Error())
+	}
+
+	detailed, detailErr := st.WithDetails(&clientrpc.ErrorDetail{
+		Code:    code,
+		Message: st.Message(),
+	})
+	if detailErr != nil {
+		log.Errorf("Could not attach error detail: %v", detailErr)
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// isStatusError returns a boolean indicating whether an error carries a gRPC
+// status.
+func isStatusError(err error) bool {
+	_, ok := status.FromError(err)
+	return ok
+}
+
+// errorUnaryInterceptor is a unary interceptor that converts the errors
+// returned by our rpc handlers into status errors with error details.
+func errorUnaryInterceptor(ctx context.Context, req interface{},
+	_ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{},
+	error) {
+
+	resp, err := handler(ctx, req)
+	return resp, rpcError(err)
+}
+
+// errorStreamInterceptor is a stream interceptor that converts the errors
+// returned by our streaming rpc handlers into status errors with error
+// details.
+func errorStreamInterceptor(srv interface{}, ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+	return rpcError(handler(srv, ss))
+}
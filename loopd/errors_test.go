@@ -0,0 +1,109 @@
+package loopd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightninglabs/loop/looprpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestRPCError tests classification of the errors that our rpc handlers
+// return.
+func TestRPCError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		statusCode codes.Code
+		code       looprpc.ErrorCode
+	}{
+		{
+			name:       "unclassified",
+			err:        errors.New("unclassified"),
+			statusCode: codes.Unknown,
+			code:       looprpc.ErrorCode_ERROR_CODE_UNKNOWN,
+		},
+		{
+			name: "wrapped invalid parameter",
+			err: fmt.Errorf("%w: target 1",
+				errConfTargetTooLow),
+			statusCode: codes.InvalidArgument,
+			code:       looprpc.ErrorCode_ERROR_CODE_INVALID_PARAMETERS,
+		},
+		{
+			name:       "amount out of bounds",
+			err:        loop.ErrSwapAmountTooHigh,
+			statusCode: codes.OutOfRange,
+			code:       looprpc.ErrorCode_ERROR_CODE_AMOUNT_OUT_OF_BOUNDS,
+		},
+		{
+			name:       "budget exhausted",
+			err:        loop.ErrSwapFeeTooHigh,
+			statusCode: codes.FailedPrecondition,
+			code:       looprpc.ErrorCode_ERROR_CODE_BUDGET_EXHAUSTED,
+		},
+		{
+			name:       "no rules",
+			err:        liquidity.ErrNoRules,
+			statusCode: codes.FailedPrecondition,
+			code:       looprpc.ErrorCode_ERROR_CODE_FAILED_PRECONDITION,
+		},
+		{
+			name: "server invoice mismatch",
+			err: &loop.InvoiceMismatchError{
+				Invoice:  "swap",
+				Field:    "amount",
+				Expected: "at most 100 sat",
+				Actual:   "200 sat",
+			},
+			statusCode: codes.Aborted,
+			code:       looprpc.ErrorCode_ERROR_CODE_SERVER_INVOICE_MISMATCH,
+		},
+		{
+			name: "server unavailable",
+			err: status.Error(
+				codes.Unavailable, "cannot initiate swap",
+			),
+			statusCode: codes.Unavailable,
+			code:       looprpc.ErrorCode_ERROR_CODE_SERVER_UNAVAILABLE,
+		},
+		{
+			name:       "context canceled",
+			err:        context.Canceled,
+			statusCode: codes.Canceled,
+			code:       looprpc.ErrorCode_ERROR_CODE_UNKNOWN,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			err := rpcError(testCase.err)
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, testCase.statusCode, st.Code())
+
+			details := st.Details()
+			require.Len(t, details, 1)
+
+			detail, ok := details[0].(*looprpc.ErrorDetail)
+			require.True(t, ok)
+			require.Equal(t, testCase.code, detail.Code)
+			require.Equal(t, st.Message(), detail.Message)
+
+			// Converting an error that already has a detail
+			// should not change it.
+			require.Equal(t, err, rpcError(err))
+		})
+	}
+
+	require.NoError(t, rpcError(nil))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/liquidity"
 	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/notifications"
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/signal"
@@ -29,13 +30,27 @@ func SetupLoggers(root *build.RotatingLogWriter, intercept signal.Interceptor) {
 	interceptor = intercept
 
 	lnd.SetSubLogger(root, Subsystem, log)
-	lnd.AddSubLogger(root, "LOOP", intercept, loop.UseLogger)
-	lnd.AddSubLogger(root, "LNDC", intercept, lndclient.UseLogger)
-	lnd.AddSubLogger(root, "STORE", intercept, loopdb.UseLogger)
-	lnd.AddSubLogger(root, lsat.Subsystem, intercept, lsat.UseLogger)
-	lnd.AddSubLogger(
-		root, liquidity.Subsystem, intercept, liquidity.UseLogger,
+	addSubLogger(root, "LOOP", genLogger, loop.UseLogger)
+	addSubLogger(root, "LNDC", genLogger, lndclient.UseLogger)
+	addSubLogger(root, "STORE", genLogger, loopdb.UseLogger)
+	addSubLogger(root, lsat.Subsystem, genLogger, lsat.UseLogger)
+	addSubLogger(
+		root, liquidity.Subsystem, genLogger, liquidity.UseLogger,
 	)
+	addSubLogger(
+		root, notifications.Subsystem, genLogger,
+		notifications.UseLogger,
+	)
+}
+
+// addSubLogger creates a logger for a subsystem and registers it with our root
+// log writer, so that its log level can be set.
+func addSubLogger(root *build.RotatingLogWriter, subsystem string,
+	genLogger func(string) btclog.Logger,
+	useLoggers ...func(btclog.Logger)) {
+
+	logger := build.NewSubLogger(subsystem, genLogger)
+	lnd.SetSubLogger(root, subsystem, logger, useLoggers...)
 }
 
 // genSubLogger creates a logger for a subsystem. We provide an instance of
@@ -53,6 +68,17 @@ func genSubLogger(root *build.RotatingLogWriter,
 		interceptor.RequestShutdown()
 	}
 
+	// If loopd writes to its own log sinks, we create our subloggers from
+	// their backend. The root logger is then only used to manage the log
+	// levels of our subsystems.
+	if logSinks != nil {
+		return func(tag string) btclog.Logger {
+			return build.NewShutdownLogger(
+				logSinks.backend.Logger(tag), shutdown,
+			)
+		}
+	}
+
 	// Return a function which will create a sublogger from our root
 	// logger without shutdown fn.
 	return func(tag string) btclog.Logger {
@@ -0,0 +1,425 @@
+package loopd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btclog"
+	"github.com/jrick/logrotate/rotator"
+)
+
+const (
+	// logSinkStdout writes plain text logs to stdout.
+	logSinkStdout = "stdout"
+
+	// logSinkJSON writes logs to stdout as json lines.
+	logSinkJSON = "json"
+
+	// logSinkFile writes plain text logs to rotated files in our log
+	// directory.
+	logSinkFile = "file"
+
+	// logSinkSyslog writes logs to the local syslog daemon.
+	logSinkSyslog = "syslog"
+
+	// logSinkJournald writes logs to stdout with the priority prefixes
+	// that journald parses, so that systemd services have their log
+	// levels recorded.
+	logSinkJournald = "journald"
+
+	// defaultSyslogTag is the tag we use for syslog entries if none is
+	// configured.
+	defaultSyslogTag = "loopd"
+
+	// logTimeFormat is the time format that btclog uses for the header
+	// of its log entries.
+	logTimeFormat = "2006-01-02 15:04:05.000"
+)
+
+var (
+	// defaultLogSinks is the set of sinks that we write our logs to if
+	// none are configured.
+	defaultLogSinks = []string{logSinkStdout, logSinkFile}
+
+	// stdoutSinks is the set of sinks that write to stdout, of which only
+	// one may be configured.
+	stdoutSinks = map[string]bool{
+		logSinkStdout:   true,
+		logSinkJSON:     true,
+		logSinkJournald: true,
+	}
+
+	// logLevels maps the level tags of btclog's log entries to their log
+	// levels.
+	logLevels = map[string]btclog.Level{
+		"TRC": btclog.LevelTrace,
+		"DBG": btclog.LevelDebug,
+		"INF": btclog.LevelInfo,
+		"WRN": btclog.LevelWarn,
+		"ERR": btclog.LevelError,
+		"CRT": btclog.LevelCritical,
+	}
+
+	// logLevelNames maps log levels to the names that we report them with
+	// in structured log entries.
+	logLevelNames = map[btclog.Level]string{
+		btclog.LevelTrace:    "trace",
+		btclog.LevelDebug:    "debug",
+		btclog.LevelInfo:     "info",
+		btclog.LevelWarn:     "warn",
+		btclog.LevelError:    "error",
+		btclog.LevelCritical: "critical",
+	}
+
+	// logSinks is the set of sinks that loopd writes its logs to. If it is
+	// nil, our subsystem loggers are created from the root log writer
+	// instead, which is the case when an application that embeds loopd
+	// sets up our loggers.
+	logSinks *sinkWriter
+)
+
+// logEntry is a single log entry that has been formatted by our log backend.
+type logEntry struct {
+	Time      time.Time    `json:"time"`
+	Level     btclog.Level `json:"-"`
+	LevelName string       `json:"level"`
+	Subsystem string       `json:"subsystem,omitempty"`
+	Message   string       `json:"message"`
+}
+
+// parseLogEntry parses a log entry that has been formatted by btclog, which
+// has the form "2006-01-02 15:04:05.000 [INF] SUBS: message". If the entry
+// does not have this form, its full content is used as the message.
+func parseLogEntry(b []byte) *logEntry {
+	line := strings.TrimRight(string(b), "\n")
+
+	entry := &logEntry{
+		Time:      time.Now(),
+		Level:     btclog.LevelInfo,
+		LevelName: logLevelNames[btclog.LevelInfo],
+		Message:   line,
+	}
+
+	header := len(logTimeFormat)
+	if len(line) < header+len(" [INF] ") {
+		return entry
+	}
+
+	ts, err := time.ParseInLocation(
+		logTimeFormat, line[:header], time.Local,
+	)
+	if err != nil {
+		return entry
+	}
+
+	rest := line[header:]
+	if !strings.HasPrefix(rest, " [") || rest[5:7] != "] " {
+		return entry
+	}
+
+	level, ok := logLevels[rest[2:5]]
+	if !ok {
+		return entry
+	}
+
+	entry.Time = ts
+	entry.Level = level
+	entry.LevelName = logLevelNames[level]
+	entry.Message = rest[7:]
+
+	if i := strings.Index(entry.Message, ": "); i > 0 {
+		entry.Subsystem = entry.Message[:i]
+		entry.Message = entry.Message[i+2:]
+	}
+
+	return entry
+}
+
+// logSink is a destination that our log entries are written to.
+type logSink interface {
+	// write writes a log entry to the sink. The raw entry, as formatted
+	// by our log backend, is provided along with its parsed form.
+	write(raw []byte, entry *logEntry) error
+
+	// close shuts down the sink.
+	close() error
+}
+
+// textSink writes log entries to a writer in the plain text format of our log
+// backend.
+type textSink struct {
+	w io.Writer
+}
+
+// write writes a raw log entry to the sink.
+func (t *textSink) write(raw []byte, _ *logEntry) error {
+	_, err := t.w.Write(raw)
+	return err
+}
+
+// close is a no-op for text sinks.
+func (t *textSink) close() error {
+	return nil
+}
+
+// jsonSink writes log entries to a writer as json lines.
+type jsonSink struct {
+	w io.Writer
+}
+
+// write writes a log entry to the sink as a single json line.
+func (j *jsonSink) write(_ []byte, entry *logEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = j.w.Write(append(b, '\n'))
+	return err
+}
+
+// close is a no-op for json sinks.
+func (j *jsonSink) close() error {
+	return nil
+}
+
+// journaldSink writes log entries to a writer, prefixing each line with the
+// syslog priority of the entry as described in sd-daemon(3). Journald adds its
+// own timestamps, so we omit ours.
+type journaldSink struct {
+	w io.Writer
+}
+
+// write writes a log entry to the sink with priority prefixes.
+func (j *journaldSink) write(_ []byte, entry *logEntry) error {
+	prefix := fmt.Sprintf("<%d>", syslogPriority(entry.Level))
+	if entry.Subsystem != "" {
+		prefix += entry.Subsystem + ": "
+	}
+
+	var buf bytes.Buffer
+	for _, line := range strings.Split(entry.Message, "\n") {
+		buf.WriteString(prefix)
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+	}
+
+	_, err := j.w.Write(buf.Bytes())
+	return err
+}
+
+// close is a no-op for journald sinks.
+func (j *journaldSink) close() error {
+	return nil
+}
+
+// syslogPriority returns the syslog severity for a log level.
+func syslogPriority(level btclog.Level) int {
+	switch level {
+	case btclog.LevelTrace, btclog.LevelDebug:
+		return 7
+
+	case btclog.LevelInfo:
+		return 6
+
+	case btclog.LevelWarn:
+		return 4
+
+	case btclog.LevelError:
+		return 3
+
+	default:
+		return 2
+	}
+}
+
+// fileSink writes plain text log entries to a set of rotated log files.
+type fileSink struct {
+	rotator *rotator.Rotator
+	pipe    *io.PipeWriter
+}
+
+// newFileSink creates a sink that writes to the log file provided and rolls it
+// over once it reaches the maximum size in MB, keeping at most maxLogFiles
+// files.
+func newFileSink(logFile string, maxLogFileSize, maxLogFiles int) (*fileSink,
+	error) {
+
+	logDir, _ := filepath.Split(logFile)
+	if err := os.MkdirAll(logDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %v",
+			err)
+	}
+
+	r, err := rotator.New(
+		logFile, int64(maxLogFileSize*1024), false, maxLogFiles,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file rotator: %v", err)
+	}
+
+	// Run the rotator in a goroutine, reporting any errors that happen
+	// during rotation (such as running out of disk space) on stderr,
+	// because we cannot log them.
+	pr, pw := io.Pipe()
+	go func() {
+		if err := r.Run(pr); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "failed to run file "+
+				"rotator: %v\n", err)
+		}
+	}()
+
+	return &fileSink{
+		rotator: r,
+		pipe:    pw,
+	}, nil
+}
+
+// write writes a raw log entry to our log file.
+func (f *fileSink) write(raw []byte, _ *logEntry) error {
+	_, err := f.pipe.Write(raw)
+	return err
+}
+
+// close closes our log file.
+func (f *fileSink) close() error {
+	if err := f.pipe.Close(); err != nil {
+		return err
+	}
+
+	return f.rotator.Close()
+}
+
+// sinkWriter is a writer that parses the log entries formatted by our log
+// backend and writes them to a set of sinks.
+type sinkWriter struct {
+	sinks   []logSink
+	backend *btclog.Backend
+}
+
+// newSinkWriter creates a writer for the sinks set in our config, and a log
+// backend that writes to it.
+func newSinkWriter(cfg *Config) (*sinkWriter, error) {
+	names := cfg.LogSinks
+	if len(names) == 0 {
+		names = defaultLogSinks
+	}
+
+	writer := &sinkWriter{}
+	for _, name := range names {
+		sink, err := newLogSink(cfg, name)
+		if err != nil {
+			_ = writer.close()
+			return nil, err
+		}
+
+		writer.sinks = append(writer.sinks, sink)
+	}
+
+	writer.backend = btclog.NewBackend(writer)
+
+	return writer, nil
+}
+
+// newLogSink creates the sink with the name provided.
+func newLogSink(cfg *Config, name string) (logSink, error) {
+	switch name {
+	case logSinkStdout:
+		return &textSink{w: os.Stdout}, nil
+
+	case logSinkJSON:
+		return &jsonSink{w: os.Stdout}, nil
+
+	case logSinkJournald:
+		return &journaldSink{w: os.Stdout}, nil
+
+	case logSinkFile:
+		return newFileSink(
+			filepath.Join(cfg.LogDir, defaultLogFilename),
+			cfg.MaxLogFileSize, cfg.MaxLogFiles,
+		)
+
+	case logSinkSyslog:
+		tag := cfg.SyslogTag
+		if tag == "" {
+			tag = defaultSyslogTag
+		}
+
+		return newSyslogSink(tag)
+
+	default:
+		return nil, fmt.Errorf("unknown log sink: %v", name)
+	}
+}
+
+// Write parses a log entry and writes it to all of our sinks. Our log backend
+// serializes its writes, so this function does not need to be safe for
+// concurrent use.
+func (s *sinkWriter) Write(b []byte) (int, error) {
+	entry := parseLogEntry(b)
+
+	// We do not fail the write if one of our sinks fails, so that the
+	// other sinks still receive the entry. Since we cannot log the error,
+	// we report it on stderr.
+	for _, sink := range s.sinks {
+		if err := sink.write(b, entry); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "failed to write log "+
+				"entry: %v\n", err)
+		}
+	}
+
+	return len(b), nil
+}
+
+// close closes all of our sinks.
+func (s *sinkWriter) close() error {
+	var closeErr error
+	for _, sink := range s.sinks {
+		if err := sink.close(); err != nil {
+			closeErr = err
+		}
+	}
+
+	return closeErr
+}
+
+// validateLogSinks checks that the set of log sinks provided is valid.
+func validateLogSinks(sinks []string) error {
+	var (
+		seen   = make(map[string]bool)
+		stdout string
+	)
+
+	for _, sink := range sinks {
+		switch sink {
+		case logSinkStdout, logSinkJSON, logSinkFile, logSinkSyslog,
+			logSinkJournald:
+
+		default:
+			return fmt.Errorf("unknown log sink: %v", sink)
+		}
+
+		if seen[sink] {
+			return fmt.Errorf("log sink %v set more than once", sink)
+		}
+		seen[sink] = true
+
+		if !stdoutSinks[sink] {
+			continue
+		}
+
+		if stdout != "" {
+			return fmt.Errorf("log sinks %v and %v both write to "+
+				"stdout", stdout, sink)
+		}
+		stdout = sink
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+//go:build windows || plan9
+// +build windows plan9
+
+package loopd
+
+import "errors"
+
+// errSyslogUnsupported is returned when the syslog sink is used on a platform
+// that does not support syslog.
+var errSyslogUnsupported = errors.New("syslog is not supported on this " +
+	"platform")
+
+// newSyslogSink fails because syslog is not available on this platform.
+func newSyslogSink(_ string) (logSink, error) {
+	return nil, errSyslogUnsupported
+}
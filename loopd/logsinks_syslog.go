@@ -0,0 +1,57 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package loopd
+
+import (
+	"log/syslog"
+
+	"github.com/btcsuite/btclog"
+)
+
+// syslogSink writes log entries to the local syslog daemon.
+type syslogSink struct {
+	w *syslog.Writer
+}
+
+// newSyslogSink creates a sink that writes to the local syslog daemon with the
+// tag provided.
+func newSyslogSink(tag string) (logSink, error) {
+	w, err := syslog.New(syslog.LOG_INFO|syslog.LOG_DAEMON, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &syslogSink{w: w}, nil
+}
+
+// write writes a log entry to syslog with the priority of its level. Syslog
+// adds its own timestamps, so we omit ours.
+func (s *syslogSink) write(_ []byte, entry *logEntry) error {
+	msg := entry.Message
+	if entry.Subsystem != "" {
+		msg = entry.Subsystem + ": " + msg
+	}
+
+	switch entry.Level {
+	case btclog.LevelTrace, btclog.LevelDebug:
+		return s.w.Debug(msg)
+
+	case btclog.LevelInfo:
+		return s.w.Info(msg)
+
+	case btclog.LevelWarn:
+		return s.w.Warning(msg)
+
+	case btclog.LevelError:
+		return s.w.Err(msg)
+
+	default:
+		return s.w.Crit(msg)
+	}
+}
+
+// close closes our connection to the syslog daemon.
+func (s *syslogSink) close() error {
+	return s.w.Close()
+}
@@ -0,0 +1,101 @@
+package loopd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btclog"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLogSinks tests writing the entries of a log backend to our different
+// sinks.
+func TestLogSinks(t *testing.T) {
+	var text, jsonLines, journald bytes.Buffer
+
+	writer := &sinkWriter{
+		sinks: []logSink{
+			&textSink{w: &text},
+			&jsonSink{w: &jsonLines},
+			&journaldSink{w: &journald},
+		},
+	}
+
+	logger := btclog.NewBackend(writer).Logger("LOOP")
+	logger.SetLevel(btclog.LevelDebug)
+
+	logger.Warnf("first line\nsecond line")
+	logger.Tracef("not logged")
+
+	require.Contains(t, text.String(), "[WRN] LOOP: first line\n")
+
+	var entry logEntry
+	require.NoError(t, json.Unmarshal(jsonLines.Bytes(), &entry))
+	require.Equal(t, "warn", entry.LevelName)
+	require.Equal(t, "LOOP", entry.Subsystem)
+	require.Equal(t, "first line\nsecond line", entry.Message)
+	require.WithinDuration(t, time.Now(), entry.Time, time.Minute)
+
+	require.Equal(
+		t, "<4>LOOP: first line\n<4>LOOP: second line\n",
+		journald.String(),
+	)
+}
+
+// TestParseLogEntry tests parsing of log entries that do not have the format
+// of our log backend.
+func TestParseLogEntry(t *testing.T) {
+	entry := parseLogEntry([]byte("unformatted entry\n"))
+	require.Equal(t, btclog.LevelInfo, entry.Level)
+	require.Equal(t, "unformatted entry", entry.Message)
+	require.Empty(t, entry.Subsystem)
+
+	entry = parseLogEntry(
+		[]byte("2022-01-01 10:00:00.000 [ERR] no subsystem\n"),
+	)
+	require.Equal(t, btclog.LevelError, entry.Level)
+	require.Equal(t, "no subsystem", entry.Message)
+	require.Empty(t, entry.Subsystem)
+}
+
+// TestValidateLogSinks tests validation of our log sink config.
+func TestValidateLogSinks(t *testing.T) {
+	tests := []struct {
+		name  string
+		sinks []string
+		valid bool
+	}{
+		{
+			name:  "defaults",
+			valid: true,
+		},
+		{
+			name:  "all valid",
+			sinks: []string{"json", "file", "syslog"},
+			valid: true,
+		},
+		{
+			name:  "unknown sink",
+			sinks: []string{"kafka"},
+		},
+		{
+			name:  "duplicate sink",
+			sinks: []string{"file", "file"},
+		},
+		{
+			name:  "multiple stdout sinks",
+			sinks: []string{"stdout", "journald"},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateLogSinks(testCase.sinks)
+			require.Equal(t, testCase.valid, err == nil)
+		})
+	}
+}
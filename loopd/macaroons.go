@@ -40,6 +40,38 @@ var (
 			Entity: "swap",
 			Action: "read",
 		}},
+		"/looprpc.SwapClient/SearchSwaps": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/GetFeeReport": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/GetAccountingReport": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/WatchSwap": {{
+			Entity: "swap",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/ListWatchedSwaps": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/SetSwapNotes": {{
+			Entity: "swap",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/ListSwapConflicts": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/ResolveSwapConflict": {{
+			Entity: "swap",
+			Action: "write",
+		}},
 		"/looprpc.SwapClient/LoopOutTerms": {{
 			Entity: "terms",
 			Action: "read",
@@ -72,18 +104,139 @@ var (
 			Entity: "auth",
 			Action: "read",
 		}},
+		"/looprpc.SwapClient/GetLndFeatures": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/GetInfo": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/RecoveryTest": {{
+			Entity: "loop",
+			Action: "out",
+		}, {
+			Entity: "loop",
+			Action: "in",
+		}},
 		"/looprpc.SwapClient/SuggestSwaps": {{
 			Entity: "suggestions",
 			Action: "read",
 		}},
+		"/looprpc.SwapClient/PreviewFees": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/CompareRebalance": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/CloseAdvice": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/GetAutoloopStats": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/GetSuggestionHistory": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/GetAutoloopCalendar": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/GetSimulatedSwaps": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/ClearSimulatedSwaps": {{
+			Entity: "suggestions",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/ListApprovals": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/ApproveSwap": {{
+			Entity: "swap",
+			Action: "execute",
+		}, {
+			Entity: "loop",
+			Action: "out",
+		}, {
+			Entity: "loop",
+			Action: "in",
+		}},
+		"/looprpc.SwapClient/RejectSwap": {{
+			Entity: "suggestions",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/SubscribeSuggestions": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
 		"/looprpc.SwapClient/GetLiquidityParams": {{
 			Entity: "suggestions",
 			Action: "read",
 		}},
+		"/looprpc.SwapClient/GetEffectiveConfig": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
 		"/looprpc.SwapClient/SetLiquidityParams": {{
 			Entity: "suggestions",
 			Action: "write",
 		}},
+		"/looprpc.SwapClient/ApplyRules": {{
+			Entity: "suggestions",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/SaveProfile": {{
+			Entity: "suggestions",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/ListProfiles": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/SetProfileSchedule": {{
+			Entity: "suggestions",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/GetParamsHistory": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/GetPendingIncrease": {{
+			Entity: "suggestions",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/CancelPendingIncrease": {{
+			Entity: "suggestions",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/GetServerNotices": {{
+			Entity: "terms",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/DebugLevel": {{
+			Entity: "debug",
+			Action: "write",
+		}},
+		"/looprpc.SwapClient/ReplaySwap": {{
+			Entity: "debug",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/GetSwapStateGraph": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/looprpc.SwapClient/GetPostMortems": {{
+			Entity: "swap",
+			Action: "read",
+		}},
 		"/looprpc.SwapClient/Probe": {{
 			Entity: "swap",
 			Action: "execute",
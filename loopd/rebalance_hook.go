@@ -0,0 +1,99 @@
+package loopd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/liquidity"
+)
+
+// rebalanceHookRequest is the json body that we post to our rebalance hook
+// before autoloop dispatches a loop out.
+type rebalanceHookRequest struct {
+	AmountSat int64    `json:"amount_sat"`
+	Channels  []uint64 `json:"channels"`
+	MaxFeeSat int64    `json:"max_fee_sat"`
+}
+
+// rebalanceHookResponse is the json body that our rebalance hook responds
+// with once it has attempted to rebalance.
+type rebalanceHookResponse struct {
+	Rebalanced bool  `json:"rebalanced"`
+	FeeSat     int64 `json:"fee_sat"`
+}
+
+// rebalanceHook is a pre-swap rebalancer that delegates rebalances to an
+// external tool by posting them to a url.
+type rebalanceHook struct {
+	url    string
+	client *http.Client
+}
+
+// Compile-time assertion that rebalanceHook satisfies the PreSwapRebalancer
+// interface.
+var _ liquidity.PreSwapRebalancer = (*rebalanceHook)(nil)
+
+// newRebalanceHook returns a pre-swap rebalance hook that posts rebalances to
+// the url provided.
+func newRebalanceHook(url string, client *http.Client) *rebalanceHook {
+	return &rebalanceHook{
+		url:    url,
+		client: client,
+	}
+}
+
+// PreSwapRebalance posts the rebalance requested to our hook and returns the
+// outcome that it reports.
+func (h *rebalanceHook) PreSwapRebalance(ctx context.Context,
+	request *liquidity.PreSwapRebalanceRequest) (
+	*liquidity.PreSwapRebalanceResult, error) {
+
+	payload := rebalanceHookRequest{
+		AmountSat: int64(request.Amount),
+		Channels:  make([]uint64, len(request.Channels)),
+		MaxFeeSat: int64(request.MaxFee),
+	}
+
+	for i, channel := range request.Channels {
+		payload.Channels[i] = channel.ToUint64()
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, h.url, bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("rebalance hook returned "+
+			"status: %v", resp.Status)
+	}
+
+	var result rebalanceHookResponse
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &liquidity.PreSwapRebalanceResult{
+		Rebalanced: result.Rebalanced,
+		Fee:        btcutil.Amount(result.FeeSat),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package loopd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRebalanceHook tests delegation of pre-swap rebalances to an external
+// tool over http.
+func TestRebalanceHook(t *testing.T) {
+	var (
+		received rebalanceHookRequest
+		status   = http.StatusOK
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			err := json.NewDecoder(r.Body).Decode(&received)
+			require.NoError(t, err)
+
+			w.WriteHeader(status)
+			err = json.NewEncoder(w).Encode(rebalanceHookResponse{
+				Rebalanced: true,
+				FeeSat:     25,
+			})
+			require.NoError(t, err)
+		},
+	))
+	defer server.Close()
+
+	hook := newRebalanceHook(server.URL, server.Client())
+	request := &liquidity.PreSwapRebalanceRequest{
+		Amount: 100000,
+		Channels: []lnwire.ShortChannelID{
+			lnwire.NewShortChanIDFromInt(123),
+		},
+		MaxFee: 500,
+	}
+
+	result, err := hook.PreSwapRebalance(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, &liquidity.PreSwapRebalanceResult{
+		Rebalanced: true,
+		Fee:        25,
+	}, result)
+
+	require.Equal(t, rebalanceHookRequest{
+		AmountSat: 100000,
+		Channels:  []uint64{123},
+		MaxFeeSat: 500,
+	}, received)
+
+	// Hooks that respond with an error status fail, so that we fall back
+	// to our swap.
+	status = http.StatusInternalServerError
+	_, err = hook.PreSwapRebalance(context.Background(), request)
+	require.Error(t, err)
+}
@@ -147,6 +147,10 @@ func Run(rpcCfg RPCConfig) error {
 		return err
 	}
 
+	// Record the options that were set on the command line, so that we
+	// can report where our effective config was set.
+	cliOptions := setOptions(parser)
+
 	// Parse ini file.
 	loopDir := lncfg.CleanAndExpandPath(config.LoopDir)
 	configFile, hasExplicitConfig := getConfigPath(config, loopDir)
@@ -170,6 +174,16 @@ func Run(rpcCfg RPCConfig) error {
 		return err
 	}
 
+	// We parse our config file separately to find the options that it
+	// sets, because the options set on the command line are also set on
+	// our parser. We have already checked that the file can be parsed if
+	// it exists.
+	fileOptions, err := configFileOptions(configFile)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	config.sources = newConfigSources(cliOptions, fileOptions)
+
 	// Show the version and exit if the version flag was specified.
 	appName := filepath.Base(os.Args[0])
 	appName = strings.TrimSuffix(appName, filepath.Ext(appName))
@@ -199,17 +213,23 @@ func Run(rpcCfg RPCConfig) error {
 		return err
 	}
 
+	// Set up the sinks that we write our logs to, so that our loggers are
+	// created from their backend.
+	logSinks, err = newSinkWriter(&config)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := logSinks.close(); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to close log sinks: %v\n",
+				err)
+		}
+	}()
+
 	// Initialize logging at the default logging level.
 	logWriter := build.NewRotatingLogWriter()
 	SetupLoggers(logWriter, shutdownInterceptor)
 
-	err = logWriter.InitLogRotator(
-		filepath.Join(config.LogDir, defaultLogFilename),
-		config.MaxLogFileSize, config.MaxLogFiles,
-	)
-	if err != nil {
-		return err
-	}
 	err = build.ParseAndSetDebugLevels(config.DebugLevel, logWriter)
 	if err != nil {
 		return err
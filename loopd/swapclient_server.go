@@ -2,6 +2,7 @@ package loopd
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -12,14 +13,17 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/labels"
 	"github.com/lightninglabs/loop/liquidity"
 	"github.com/lightninglabs/loop/loopdb"
 	clientrpc "github.com/lightninglabs/loop/looprpc"
+	"github.com/lightninglabs/loop/notifications"
 	"github.com/lightninglabs/loop/swap"
 	looprpc "github.com/lightninglabs/loop/swapserverrpc"
+	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -28,6 +32,7 @@ import (
 	"github.com/lightningnetwork/lnd/zpay32"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -40,6 +45,9 @@ const (
 )
 
 var (
+	// errNoFeeCategories is returned when no fee limit values are set.
+	errNoFeeCategories = errors.New("no fee categories set")
+
 	// errIncorrectChain is returned when the format of the
 	// destination address provided does not match the active chain.
 	errIncorrectChain = errors.New("invalid address format for the " +
@@ -65,7 +73,12 @@ type swapClientServer struct {
 
 	network          lndclient.Network
 	impl             *loop.Client
+	config           *Config
 	liquidityMgr     *liquidity.Manager
+	notifier         *notifications.Manager
+	accounting       *loop.AccountingChecker
+	lifetime         *loop.LifetimeChecker
+	destVerifier     *loop.DestVerifier
 	lnd              *lndclient.LndServices
 	swaps            map[lntypes.Hash]loop.SwapInfo
 	subscribers      map[int]chan<- interface{}
@@ -87,9 +100,14 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 
 	var sweepAddr btcutil.Address
 	if in.Dest == "" {
+		if in.DestSignature != "" {
+			return nil, errors.New("dest_signature may only be " +
+				"set with dest")
+		}
+
 		// Generate sweep address if none specified.
 		var err error
-		sweepAddr, err = s.lnd.WalletKit.NextAddr(context.Background())
+		sweepAddr, err = s.lnd.WalletKit.NextAddr(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("NextAddr error: %v", err)
 		}
@@ -111,6 +129,26 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 		return nil, err
 	}
 
+	// If we are sweeping to a custom destination, we verify that it is
+	// owned by the user so that a mistyped address does not lose the
+	// swap.
+	var destVerification *loopdb.DestVerification
+	if in.Dest != "" {
+		destVerification, err = s.destVerifier.Verify(
+			sweepAddr, in.DestSignature,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	maxTotalCost, err := validateMaxTotalCost(
+		in.Amt, in.MaxTotalCostSat, in.MaxTotalCostPpm,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &loop.OutRequest{
 		Amount:              btcutil.Amount(in.Amt),
 		DestAddr:            sweepAddr,
@@ -119,19 +157,23 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 		MaxPrepayRoutingFee: btcutil.Amount(in.MaxPrepayRoutingFee),
 		MaxSwapRoutingFee:   btcutil.Amount(in.MaxSwapRoutingFee),
 		MaxSwapFee:          btcutil.Amount(in.MaxSwapFee),
+		MaxTotalCost:        maxTotalCost,
 		SweepConfTarget:     sweepConfTarget,
 		HtlcConfirmations:   in.HtlcConfirmations,
 		SwapPublicationDeadline: time.Unix(
 			int64(in.SwapPublicationDeadline), 0,
 		),
-		Label:     in.Label,
-		Initiator: in.Initiator,
+		Label:            in.Label,
+		Initiator:        in.Initiator,
+		CustomRecords:    in.CustomRecords,
+		DestVerification: destVerification,
 	}
 
 	switch {
 	case in.LoopOutChannel != 0 && len(in.OutgoingChanSet) > 0: // nolint:staticcheck
-		return nil, errors.New("loop_out_channel and outgoing_" +
-			"chan_ids are mutually exclusive")
+		return nil, status.Error(codes.InvalidArgument,
+			"loop_out_channel and outgoing_chan_ids are mutually "+
+				"exclusive")
 
 	case in.LoopOutChannel != 0: // nolint:staticcheck
 		req.OutgoingChanSet = loopdb.ChannelSet{in.LoopOutChannel} // nolint:staticcheck
@@ -140,6 +182,10 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 		req.OutgoingChanSet = in.OutgoingChanSet
 	}
 
+	if in.ValidateOnly {
+		return s.validateLoopOut(ctx, in, req)
+	}
+
 	info, err := s.impl.LoopOut(ctx, req)
 	if err != nil {
 		log.Errorf("LoopOut: %v", err)
@@ -147,11 +193,15 @@ func (s *swapClientServer) LoopOut(ctx context.Context,
 	}
 
 	return &clientrpc.SwapResponse{
-		Id:               info.SwapHash.String(),
-		IdBytes:          info.SwapHash[:],
-		HtlcAddress:      info.HtlcAddressP2WSH.String(),
-		HtlcAddressP2Wsh: info.HtlcAddressP2WSH.String(),
-		ServerMessage:    info.ServerMessage,
+		Id:                    info.SwapHash.String(),
+		IdBytes:               info.SwapHash[:],
+		HtlcAddress:           info.HtlcAddressP2WSH.String(),
+		HtlcAddressP2Wsh:      info.HtlcAddressP2WSH.String(),
+		ServerMessage:         info.ServerMessage,
+		HtlcValueSat:          int64(info.HtlcValue),
+		SwapInvoiceAmtSat:     int64(info.SwapInvoiceAmount),
+		PrepayAmtSat:          int64(info.PrepayAmount),
+		ExpectedSweepValueSat: int64(info.ExpectedSweepValue),
 	}, nil
 }
 
@@ -202,6 +252,9 @@ func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
 	case loopdb.StateFailIncorrectHtlcAmt:
 		failureReason = clientrpc.FailureReason_FAILURE_REASON_INCORRECT_AMOUNT
 
+	case loopdb.StateFailMaxTotalCost:
+		failureReason = clientrpc.FailureReason_FAILURE_REASON_MAX_TOTAL_COST
+
 	default:
 		return nil, fmt.Errorf("unknown swap state: %v", loopSwap.State)
 	}
@@ -252,21 +305,60 @@ func (s *swapClientServer) marshallSwap(loopSwap *loop.SwapInfo) (
 		CostOnchain:       int64(loopSwap.Cost.Onchain),
 		CostOffchain:      int64(loopSwap.Cost.Offchain),
 		Label:             loopSwap.Label,
+		Notes:             loopSwap.Notes,
+		InvoiceHoldAlarm:  loopSwap.InvoiceHoldAlarm,
+		LifetimeExceeded: s.lifetime != nil &&
+			s.lifetime.Flagged(loopSwap.SwapHash),
+		DestVerification: newRPCDestVerification(
+			loopSwap.DestVerification,
+		),
 	}, nil
 }
 
+// newRPCDestVerification converts a destination verification to its rpc
+// representation, returning nil if the destination was not verified.
+func newRPCDestVerification(
+	verification *loopdb.DestVerification) *clientrpc.DestVerification {
+
+	if verification == nil {
+		return nil
+	}
+
+	method := clientrpc.DestVerificationMethod_DEST_VERIFICATION_NONE
+	switch verification.Method {
+	case loopdb.DestVerificationSignature:
+		method = clientrpc.DestVerificationMethod_DEST_VERIFICATION_SIGNATURE
+
+	case loopdb.DestVerificationXpub:
+		method = clientrpc.DestVerificationMethod_DEST_VERIFICATION_XPUB
+	}
+
+	return &clientrpc.DestVerification{
+		Method: method,
+		Proof:  verification.Proof,
+	}
+}
+
 // Monitor will return a stream of swap updates for currently active swaps.
 func (s *swapClientServer) Monitor(in *clientrpc.MonitorRequest,
 	server clientrpc.SwapClient_MonitorServer) error {
 
 	log.Infof("Monitor request received")
 
+	if err := validateDisplayUnit(in.DisplayUnit); err != nil {
+		return err
+	}
+
 	send := func(info loop.SwapInfo) error {
 		rpcSwap, err := s.marshallSwap(&info)
 		if err != nil {
 			return err
 		}
 
+		rpcSwap.DisplayAmounts = swapDisplayAmounts(
+			in.DisplayUnit, rpcSwap,
+		)
+
 		return server.Send(rpcSwap)
 	}
 
@@ -358,7 +450,11 @@ func (s *swapClientServer) Monitor(in *clientrpc.MonitorRequest,
 // ListSwaps returns a list of all currently known swaps and their current
 // status.
 func (s *swapClientServer) ListSwaps(_ context.Context,
-	_ *clientrpc.ListSwapsRequest) (*clientrpc.ListSwapsResponse, error) {
+	req *clientrpc.ListSwapsRequest) (*clientrpc.ListSwapsResponse, error) {
+
+	if err := validateDisplayUnit(req.DisplayUnit); err != nil {
+		return nil, err
+	}
 
 	var (
 		rpcSwaps = make([]*clientrpc.SwapStatus, len(s.swaps))
@@ -379,6 +475,10 @@ func (s *swapClientServer) ListSwaps(_ context.Context,
 		if err != nil {
 			return nil, err
 		}
+
+		rpcSwaps[idx].DisplayAmounts = swapDisplayAmounts(
+			req.DisplayUnit, rpcSwaps[idx],
+		)
 		idx++
 	}
 	return &clientrpc.ListSwapsResponse{Swaps: rpcSwaps}, nil
@@ -388,18 +488,408 @@ func (s *swapClientServer) ListSwaps(_ context.Context,
 func (s *swapClientServer) SwapInfo(_ context.Context,
 	req *clientrpc.SwapInfoRequest) (*clientrpc.SwapStatus, error) {
 
+	if err := validateDisplayUnit(req.DisplayUnit); err != nil {
+		return nil, err
+	}
+
 	swapHash, err := lntypes.MakeHash(req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing swap hash: %v", err)
+		return nil, status.Errorf(
+			codes.InvalidArgument, "error parsing swap hash: %v",
+			err,
+		)
 	}
 
 	// Just return the server's in-memory cache here too as we also want to
 	// return temporary failures to the client.
 	swp, ok := s.swaps[swapHash]
 	if !ok {
-		return nil, fmt.Errorf("swap with hash %s not found", req.Id)
+		return nil, fmt.Errorf("swap with hash %s: %w", req.Id,
+			loopdb.ErrSwapNotFound)
+	}
+
+	rpcSwap, err := s.marshallSwap(&swp)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcSwap.DisplayAmounts = swapDisplayAmounts(req.DisplayUnit, rpcSwap)
+
+	return rpcSwap, nil
+}
+
+// SearchSwaps returns all swaps that have a field which contains the query
+// provided.
+func (s *swapClientServer) SearchSwaps(ctx context.Context,
+	req *clientrpc.SearchSwapsRequest) (*clientrpc.SearchSwapsResponse,
+	error) {
+
+	log.Infof("Search swaps request received: %v", req.Query)
+
+	hashes, err := s.impl.SearchSwaps(ctx, req.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	s.swapsLock.Lock()
+	defer s.swapsLock.Unlock()
+
+	// Marshal our matches from the in-memory cache, because it contains
+	// the most up-to-date state of our swaps.
+	rpcSwaps := make([]*clientrpc.SwapStatus, 0, len(hashes))
+	for _, hash := range hashes {
+		swp, ok := s.swaps[hash]
+		if !ok {
+			continue
+		}
+
+		rpcSwap, err := s.marshallSwap(&swp)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcSwaps = append(rpcSwaps, rpcSwap)
+	}
+
+	return &clientrpc.SearchSwapsResponse{
+		Swaps: rpcSwaps,
+	}, nil
+}
+
+// GetFeeReport compares the cost of each of our successful loop outs to the
+// cost of on-chain alternatives at the time of the swap.
+func (s *swapClientServer) GetFeeReport(ctx context.Context,
+	req *clientrpc.FeeReportRequest) (*clientrpc.FeeReportResponse, error) {
+
+	log.Infof("Fee report request received")
+
+	if err := validateDisplayUnit(req.DisplayUnit); err != nil {
+		return nil, err
+	}
+
+	report, err := s.impl.FeeReport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := map[string]btcutil.Amount{
+		"total_loop_cost_sat":         report.TotalLoopCost,
+		"total_onchain_cost_sat":      report.TotalOnChainCost,
+		"total_close_reopen_cost_sat": report.TotalCloseReopenCost,
+	}
+
+	resp := &clientrpc.FeeReportResponse{
+		Swaps: make(
+			[]*clientrpc.LoopOutFeeComparison, len(report.Swaps),
+		),
+		TotalLoopCostSat:        int64(report.TotalLoopCost),
+		TotalOnchainCostSat:     int64(report.TotalOnChainCost),
+		TotalCloseReopenCostSat: int64(report.TotalCloseReopenCost),
+		Skipped:                 uint32(report.Skipped),
+		DisplayAmounts:          displayAmounts(req.DisplayUnit, totals),
+	}
+
+	for i, comparison := range report.Swaps {
+		satPerKVByte := comparison.FeeRate.FeePerKVByte()
+
+		amounts := map[string]btcutil.Amount{
+			"amt":                   comparison.Amount,
+			"loop_cost_sat":         comparison.LoopCost,
+			"onchain_cost_sat":      comparison.OnChainCost,
+			"close_reopen_cost_sat": comparison.CloseReopenCost,
+		}
+
+		resp.Swaps[i] = &clientrpc.LoopOutFeeComparison{
+			Id:                 comparison.SwapHash[:],
+			Amt:                int64(comparison.Amount),
+			CompletionTime:     comparison.CompletedAt.UnixNano(),
+			SatPerVbyte:        float64(satPerKVByte) / 1000,
+			LoopCostSat:        int64(comparison.LoopCost),
+			OnchainCostSat:     int64(comparison.OnChainCost),
+			CloseReopenCostSat: int64(comparison.CloseReopenCost),
+			DisplayAmounts: displayAmounts(
+				req.DisplayUnit, amounts,
+			),
+		}
+	}
+
+	return resp, nil
+}
+
+// GetAccountingReport returns the outcome of our last accounting check, or
+// performs a new check if a refresh is requested.
+func (s *swapClientServer) GetAccountingReport(ctx context.Context,
+	req *clientrpc.AccountingReportRequest) (
+	*clientrpc.AccountingReportResponse, error) {
+
+	log.Infof("Accounting report request received")
+
+	if s.accounting == nil {
+		return nil, status.Error(
+			codes.FailedPrecondition, "accounting checks are not "+
+				"enabled, set accountingcheckinterval",
+		)
+	}
+
+	var (
+		report *loop.AccountingReport
+		err    error
+	)
+	if req.Refresh {
+		report, err = s.accounting.Refresh(ctx)
+	} else {
+		report, err = s.accounting.Report()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &clientrpc.AccountingReportResponse{
+		CheckedAt:    report.CheckedAt.UnixNano(),
+		SwapsChecked: uint32(report.SwapsChecked),
+		Skipped:      uint32(report.Skipped),
+		Discrepancies: make(
+			[]*clientrpc.AccountingDiscrepancy,
+			len(report.Discrepancies),
+		),
+	}
+
+	for i, discrepancy := range report.Discrepancies {
+		swapType := clientrpc.SwapType_LOOP_OUT
+		if discrepancy.SwapType == swap.TypeIn {
+			swapType = clientrpc.SwapType_LOOP_IN
+		}
+
+		resp.Discrepancies[i] = &clientrpc.AccountingDiscrepancy{
+			Id:          discrepancy.SwapHash[:],
+			Type:        swapType,
+			Check:       rpcAccountingCheck(discrepancy.Check),
+			RecordedSat: int64(discrepancy.Recorded),
+			ActualSat:   int64(discrepancy.Actual),
+		}
+	}
+
+	return resp, nil
+}
+
+// rpcAccountingCheck converts an accounting check to its rpc representation.
+func rpcAccountingCheck(
+	check loop.AccountingCheck) clientrpc.AccountingCheck {
+
+	switch check {
+	case loop.AccountingCheckOffchainFees:
+		return clientrpc.AccountingCheck_ACCOUNTING_CHECK_OFFCHAIN_FEES
+
+	case loop.AccountingCheckOnchainFees:
+		return clientrpc.AccountingCheck_ACCOUNTING_CHECK_ONCHAIN_FEES
+
+	default:
+		return clientrpc.AccountingCheck_ACCOUNTING_CHECK_SERVER_COST
+	}
+}
+
+// WatchSwap adds a swap that was initiated by another loop client using our
+// lnd node to our watch list.
+func (s *swapClientServer) WatchSwap(_ context.Context,
+	req *clientrpc.WatchSwapRequest) (*clientrpc.WatchSwapResponse, error) {
+
+	log.Infof("Watch swap request received")
+
+	swapHash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "error parsing swap hash: %v",
+			err,
+		)
+	}
+
+	swapType := swap.TypeOut
+	if req.Type == clientrpc.SwapType_LOOP_IN {
+		swapType = swap.TypeIn
+	}
+
+	err = s.impl.WatchSwap(swapHash, swapType, req.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientrpc.WatchSwapResponse{}, nil
+}
+
+// ListWatchedSwaps returns the swaps in our watch list, along with the state
+// and costs that lnd's records imply for them.
+func (s *swapClientServer) ListWatchedSwaps(ctx context.Context,
+	_ *clientrpc.ListWatchedSwapsRequest) (
+	*clientrpc.ListWatchedSwapsResponse, error) {
+
+	log.Infof("List watched swaps request received")
+
+	watched, err := s.impl.WatchedSwaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &clientrpc.ListWatchedSwapsResponse{
+		Swaps: make([]*clientrpc.WatchedSwap, len(watched)),
+	}
+
+	for i, info := range watched {
+		swapType := clientrpc.SwapType_LOOP_OUT
+		if info.Type == swap.TypeIn {
+			swapType = clientrpc.SwapType_LOOP_IN
+		}
+
+		hash := info.Hash
+		resp.Swaps[i] = &clientrpc.WatchedSwap{
+			Id:             hash[:],
+			Type:           swapType,
+			Label:          info.Label,
+			AddedAt:        info.AddedTime.UnixNano(),
+			State:          rpcWatchedSwapState(info.State),
+			AmtSat:         int64(info.Amount),
+			OffchainFeeSat: int64(info.OffchainFees),
+			OnchainFeeSat:  int64(info.OnchainFees),
+		}
+	}
+
+	return resp, nil
+}
+
+// rpcWatchedSwapState converts a watched swap state to its rpc representation.
+func rpcWatchedSwapState(
+	state loop.WatchedSwapState) clientrpc.WatchedSwapState {
+
+	switch state {
+	case loop.WatchedSwapPending:
+		return clientrpc.WatchedSwapState_WATCHED_SWAP_PENDING
+
+	case loop.WatchedSwapSuccess:
+		return clientrpc.WatchedSwapState_WATCHED_SWAP_SUCCESS
+
+	case loop.WatchedSwapFailed:
+		return clientrpc.WatchedSwapState_WATCHED_SWAP_FAILED
+
+	default:
+		return clientrpc.WatchedSwapState_WATCHED_SWAP_UNKNOWN
+	}
+}
+
+// SetSwapNotes attaches free-text notes to an existing swap, replacing any
+// notes that were previously set.
+func (s *swapClientServer) SetSwapNotes(_ context.Context,
+	req *clientrpc.SetSwapNotesRequest) (*clientrpc.SetSwapNotesResponse,
+	error) {
+
+	swapHash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "error parsing swap hash: %v",
+			err,
+		)
+	}
+
+	log.Infof("Set swap notes request received for %v", swapHash)
+
+	s.swapsLock.Lock()
+	defer s.swapsLock.Unlock()
+
+	swp, ok := s.swaps[swapHash]
+	if !ok {
+		return nil, fmt.Errorf("swap with hash %s: %w", req.Id,
+			loopdb.ErrSwapNotFound)
+	}
+
+	err = s.impl.SetSwapNotes(swp.SwapType, swapHash, req.Notes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update our in-memory cache so that the notes are reflected in
+	// subsequent listings without waiting for a swap update.
+	swp.Notes = req.Notes
+	s.swaps[swapHash] = swp
+
+	return &clientrpc.SetSwapNotesResponse{}, nil
+}
+
+// ListSwapConflicts returns the swap hashes that are used by both a loop out
+// and a loop in swap in our store.
+func (s *swapClientServer) ListSwapConflicts(_ context.Context,
+	_ *clientrpc.ListSwapConflictsRequest) (
+	*clientrpc.ListSwapConflictsResponse, error) {
+
+	log.Infof("List swap conflicts request received")
+
+	conflicts, err := s.impl.SwapConflicts()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcConflicts := make([]*clientrpc.SwapConflict, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		loopOut, err := s.marshallSwap(conflict.LoopOut)
+		if err != nil {
+			return nil, err
+		}
+
+		loopIn, err := s.marshallSwap(conflict.LoopIn)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcConflicts = append(rpcConflicts, &clientrpc.SwapConflict{
+			Id:      conflict.Hash[:],
+			LoopOut: loopOut,
+			LoopIn:  loopIn,
+		})
+	}
+
+	return &clientrpc.ListSwapConflictsResponse{
+		Conflicts: rpcConflicts,
+	}, nil
+}
+
+// ResolveSwapConflict resolves a conflict between a loop out and a loop in
+// swap that share a hash by keeping the swap of the type requested.
+func (s *swapClientServer) ResolveSwapConflict(_ context.Context,
+	req *clientrpc.ResolveSwapConflictRequest) (
+	*clientrpc.ResolveSwapConflictResponse, error) {
+
+	swapHash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "error parsing swap hash: %v",
+			err,
+		)
+	}
+
+	log.Infof("Resolve swap conflict request received for %v", swapHash)
+
+	keep := swap.TypeOut
+	if req.Keep == clientrpc.SwapType_LOOP_IN {
+		keep = swap.TypeIn
+	}
+
+	s.swapsLock.Lock()
+	defer s.swapsLock.Unlock()
+
+	kept, err := s.impl.ResolveSwapConflict(swapHash, keep)
+	if err != nil {
+		return nil, err
+	}
+
+	// Our cache holds whichever of the two swaps was loaded last, so we
+	// replace it with the swap that was kept.
+	s.swaps[swapHash] = *kept
+
+	rpcSwap, err := s.marshallSwap(kept)
+	if err != nil {
+		return nil, err
 	}
-	return s.marshallSwap(&swp)
+
+	return &clientrpc.ResolveSwapConflictResponse{
+		Swap: rpcSwap,
+	}, nil
 }
 
 // LoopOutTerms returns the terms that the server enforces for loop out swaps.
@@ -408,6 +898,10 @@ func (s *swapClientServer) LoopOutTerms(ctx context.Context,
 
 	log.Infof("Loop out terms request received")
 
+	if err := validateDisplayUnit(req.DisplayUnit); err != nil {
+		return nil, err
+	}
+
 	terms, err := s.impl.LoopOutTerms(ctx)
 	if err != nil {
 		log.Errorf("Terms request: %v", err)
@@ -419,6 +913,12 @@ func (s *swapClientServer) LoopOutTerms(ctx context.Context,
 		MaxSwapAmount: int64(terms.MaxSwapAmount),
 		MinCltvDelta:  terms.MinCltvDelta,
 		MaxCltvDelta:  terms.MaxCltvDelta,
+		DisplayAmounts: displayAmounts(
+			req.DisplayUnit, map[string]btcutil.Amount{
+				"min_swap_amount": terms.MinSwapAmount,
+				"max_swap_amount": terms.MaxSwapAmount,
+			},
+		),
 	}, nil
 }
 
@@ -427,6 +927,10 @@ func (s *swapClientServer) LoopOutTerms(ctx context.Context,
 func (s *swapClientServer) LoopOutQuote(ctx context.Context,
 	req *clientrpc.QuoteRequest) (*clientrpc.OutQuoteResponse, error) {
 
+	if err := validateDisplayUnit(req.DisplayUnit); err != nil {
+		return nil, err
+	}
+
 	confTarget, err := validateConfTarget(
 		req.ConfTarget, loop.DefaultSweepConfTarget,
 	)
@@ -450,6 +954,13 @@ func (s *swapClientServer) LoopOutQuote(ctx context.Context,
 		SwapFeeSat:      int64(quote.SwapFee),
 		SwapPaymentDest: quote.SwapPaymentDest[:],
 		ConfTarget:      confTarget,
+		DisplayAmounts: displayAmounts(
+			req.DisplayUnit, map[string]btcutil.Amount{
+				"htlc_sweep_fee_sat": quote.MinerFee,
+				"prepay_amt_sat":     quote.PrepayAmount,
+				"swap_fee_sat":       quote.SwapFee,
+			},
+		),
 	}, nil
 }
 
@@ -459,6 +970,10 @@ func (s *swapClientServer) GetLoopInTerms(ctx context.Context,
 
 	log.Infof("Loop in terms request received")
 
+	if err := validateDisplayUnit(req.DisplayUnit); err != nil {
+		return nil, err
+	}
+
 	terms, err := s.impl.LoopInTerms(ctx)
 	if err != nil {
 		log.Errorf("Terms request: %v", err)
@@ -468,6 +983,12 @@ func (s *swapClientServer) GetLoopInTerms(ctx context.Context,
 	return &clientrpc.InTermsResponse{
 		MinSwapAmount: int64(terms.MinSwapAmount),
 		MaxSwapAmount: int64(terms.MaxSwapAmount),
+		DisplayAmounts: displayAmounts(
+			req.DisplayUnit, map[string]btcutil.Amount{
+				"min_swap_amount": terms.MinSwapAmount,
+				"max_swap_amount": terms.MaxSwapAmount,
+			},
+		),
 	}, nil
 }
 
@@ -477,6 +998,10 @@ func (s *swapClientServer) GetLoopInQuote(ctx context.Context,
 
 	log.Infof("Loop in quote request received")
 
+	if err := validateDisplayUnit(req.DisplayUnit); err != nil {
+		return nil, err
+	}
+
 	htlcConfTarget, err := validateLoopInRequest(
 		req.ConfTarget, req.ExternalHtlc,
 	)
@@ -522,6 +1047,12 @@ func (s *swapClientServer) GetLoopInQuote(ctx context.Context,
 		HtlcPublishFeeSat: int64(quote.MinerFee),
 		SwapFeeSat:        int64(quote.SwapFee),
 		ConfTarget:        htlcConfTarget,
+		DisplayAmounts: displayAmounts(
+			req.DisplayUnit, map[string]btcutil.Amount{
+				"htlc_publish_fee_sat": quote.MinerFee,
+				"swap_fee_sat":         quote.SwapFee,
+			},
+		),
 	}, nil
 }
 
@@ -603,39 +1134,109 @@ func (s *swapClientServer) Probe(ctx context.Context,
 	return &clientrpc.ProbeResponse{}, nil
 }
 
-func (s *swapClientServer) LoopIn(ctx context.Context,
-	in *clientrpc.LoopInRequest) (
+// validateLoopOut validates a loop out request without creating a swap. It
+// returns the request as it would be executed, with its defaults filled in,
+// along with the costs quoted for it.
+func (s *swapClientServer) validateLoopOut(ctx context.Context,
+	in *clientrpc.LoopOutRequest, req *loop.OutRequest) (
 	*clientrpc.SwapResponse, error) {
 
-	log.Infof("Loop in request received")
-
-	htlcConfTarget, err := validateLoopInRequest(
-		in.HtlcConfTarget, in.ExternalHtlc,
-	)
+	quote, err := s.impl.ValidateLoopOut(ctx, req)
 	if err != nil {
+		log.Errorf("Validate loop out: %v", err)
 		return nil, err
 	}
 
-	// Check that the label is valid.
-	if err := labels.Validate(in.Label); err != nil {
-		return nil, err
-	}
+	resolved := proto.Clone(in).(*clientrpc.LoopOutRequest)
+	resolved.Dest = req.DestAddr.String()
+	resolved.SweepConfTarget = req.SweepConfTarget
+	resolved.LoopOutChannel = 0 // nolint:staticcheck
+	resolved.OutgoingChanSet = req.OutgoingChanSet
+	resolved.MaxTotalCostSat = int64(req.MaxTotalCost)
+	resolved.MaxTotalCostPpm = 0
+
+	return &clientrpc.SwapResponse{
+		Validation: &clientrpc.SwapValidation{
+			LoopOutRequest: resolved,
+			SwapFeeSat:     int64(quote.SwapFee),
+			PrepayAmtSat:   int64(quote.PrepayAmount),
+			MinerFeeSat:    int64(quote.MinerFee),
+		},
+	}, nil
+}
+
+// validateLoopIn validates a loop in request without creating a swap. It
+// returns the request as it would be executed, with its defaults filled in,
+// along with the costs quoted for it.
+func (s *swapClientServer) validateLoopIn(ctx context.Context,
+	in *clientrpc.LoopInRequest, req *loop.LoopInRequest) (
+	*clientrpc.SwapResponse, error) {
+
+	quote, err := s.impl.ValidateLoopIn(ctx, req)
+	if err != nil {
+		log.Errorf("Validate loop in: %v", err)
+		return nil, err
+	}
+
+	resolved := proto.Clone(in).(*clientrpc.LoopInRequest)
+	resolved.Amt = int64(req.Amount)
+	resolved.HtlcConfTarget = req.HtlcConfTarget
+	resolved.MaxTotalCostSat = int64(req.MaxTotalCost)
+	resolved.MaxTotalCostPpm = 0
+
+	return &clientrpc.SwapResponse{
+		Validation: &clientrpc.SwapValidation{
+			LoopInRequest: resolved,
+			SwapFeeSat:    int64(quote.SwapFee),
+			MinerFeeSat:   int64(quote.MinerFee),
+		},
+	}, nil
+}
+
+func (s *swapClientServer) LoopIn(ctx context.Context,
+	in *clientrpc.LoopInRequest) (
+	*clientrpc.SwapResponse, error) {
+
+	log.Infof("Loop in request received")
+
+	htlcConfTarget, err := validateLoopInRequest(
+		in.HtlcConfTarget, in.ExternalHtlc,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the label is valid.
+	if err := labels.Validate(in.Label); err != nil {
+		return nil, err
+	}
 
 	routeHints, err := unmarshallRouteHints(in.RouteHints)
 	if err != nil {
 		return nil, err
 	}
 
+	maxTotalCost, err := validateMaxTotalCost(
+		in.Amt, in.MaxTotalCostSat, in.MaxTotalCostPpm,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &loop.LoopInRequest{
 		Amount:         btcutil.Amount(in.Amt),
 		MaxMinerFee:    btcutil.Amount(in.MaxMinerFee),
 		MaxSwapFee:     btcutil.Amount(in.MaxSwapFee),
+		MaxTotalCost:   maxTotalCost,
 		HtlcConfTarget: htlcConfTarget,
 		ExternalHtlc:   in.ExternalHtlc,
 		Label:          in.Label,
 		Initiator:      in.Initiator,
 		Private:        in.Private,
 		RouteHints:     routeHints,
+		ChangeTolerance: btcutil.Amount(
+			in.ChangeToleranceSat,
+		),
 	}
 	if in.LastHop != nil {
 		lastHop, err := route.NewVertexFromBytes(in.LastHop)
@@ -644,6 +1245,11 @@ func (s *swapClientServer) LoopIn(ctx context.Context,
 		}
 		req.LastHop = &lastHop
 	}
+
+	if in.ValidateOnly {
+		return s.validateLoopIn(ctx, in, req)
+	}
+
 	swapInfo, err := s.impl.LoopIn(ctx, req)
 	if err != nil {
 		log.Errorf("Loop in: %v", err)
@@ -701,14 +1307,125 @@ func (s *swapClientServer) GetLsatTokens(ctx context.Context,
 	return &clientrpc.TokensResponse{Tokens: rpcTokens}, nil
 }
 
+// GetLndFeatures returns the status of the version dependent lnd features that
+// we detected on startup.
+func (s *swapClientServer) GetLndFeatures(_ context.Context,
+	_ *clientrpc.LndFeaturesRequest) (*clientrpc.LndFeaturesResponse,
+	error) {
+
+	status := s.impl.LndFeatures().Status()
+
+	resp := &clientrpc.LndFeaturesResponse{
+		LndVersion: lndclient.VersionString(s.lnd.Version),
+		Features: make(
+			[]*clientrpc.LndFeatureStatus, 0, len(status),
+		),
+	}
+
+	for _, feature := range status {
+		resp.Features = append(
+			resp.Features, &clientrpc.LndFeatureStatus{
+				Name:       feature.Feature.String(),
+				Supported:  feature.Supported,
+				MinVersion: feature.MinVersion,
+				Reason:     feature.Reason,
+			},
+		)
+	}
+
+	return resp, nil
+}
+
+// GetInfo returns basic information about the daemon, including whether lnd
+// is synced to the chain.
+func (s *swapClientServer) GetInfo(_ context.Context,
+	_ *clientrpc.GetInfoRequest) (*clientrpc.GetInfoResponse, error) {
+
+	state := s.impl.LndSyncState()
+
+	resp := &clientrpc.GetInfoResponse{
+		Version:          loop.Version(),
+		Network:          string(s.network),
+		LndSyncedToChain: state.Synced,
+		BlockHeight:      state.BlockHeight,
+		LndBlockHeight:   state.LndBlockHeight,
+	}
+
+	if !state.DegradedSince.IsZero() {
+		resp.DegradedSince = state.DegradedSince.Unix()
+	}
+
+	if !state.LastChecked.IsZero() {
+		resp.LastSyncCheck = state.LastChecked.Unix()
+	}
+
+	return resp, nil
+}
+
+// RecoveryTest runs a recovery drill, which exercises the paths that we use
+// to recover our funds from swaps that do not complete cooperatively.
+func (s *swapClientServer) RecoveryTest(ctx context.Context,
+	req *clientrpc.RecoveryTestRequest) (*clientrpc.RecoveryTestResponse,
+	error) {
+
+	result, err := s.impl.RecoveryDrill(ctx, &loop.RecoveryDrillRequest{
+		Amount:  btcutil.Amount(req.Amt),
+		Publish: req.Publish,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &clientrpc.RecoveryTestResponse{
+		Passed: result.Passed(),
+		Checks: make(
+			[]*clientrpc.RecoveryCheck, 0, len(result.Checks),
+		),
+	}
+
+	for _, check := range result.Checks {
+		rpcCheck := &clientrpc.RecoveryCheck{
+			Name:   check.Name,
+			Passed: check.Err == nil,
+		}
+
+		if check.Err != nil {
+			rpcCheck.Error = check.Err.Error()
+		}
+
+		resp.Checks = append(resp.Checks, rpcCheck)
+	}
+
+	if result.FundingTx != nil {
+		resp.FundingTxid = result.FundingTx.String()
+	}
+
+	if result.TimeoutTx != nil {
+		resp.TimeoutTxid = result.TimeoutTx.String()
+	}
+
+	if result.SuccessTx != nil {
+		resp.SuccessTxid = result.SuccessTx.String()
+	}
+
+	return resp, nil
+}
+
 // GetLiquidityParams gets our current liquidity manager's parameters.
 func (s *swapClientServer) GetLiquidityParams(_ context.Context,
 	_ *clientrpc.GetLiquidityParamsRequest) (*clientrpc.LiquidityParameters,
 	error) {
 
-	cfg := s.liquidityMgr.GetParameters()
+	return newRPCParams(s.liquidityMgr.GetParameters())
+}
+
+// newRPCParams converts a set of liquidity parameters to their rpc
+// representation.
+func newRPCParams(cfg liquidity.Parameters) (*clientrpc.LiquidityParameters,
+	error) {
 
-	totalRules := len(cfg.ChannelRules) + len(cfg.PeerRules)
+	totalRules := len(cfg.ChannelRules) + len(cfg.PeerRules) +
+		len(cfg.ChannelGroups)
 
 	rpcCfg := &clientrpc.LiquidityParameters{
 		SweepConfTarget:   cfg.SweepConfTarget,
@@ -722,6 +1439,72 @@ func (s *swapClientServer) GetLiquidityParams(_ context.Context,
 		MinSwapAmount:  uint64(cfg.ClientRestrictions.Minimum),
 		MaxSwapAmount:  uint64(cfg.ClientRestrictions.Maximum),
 		HtlcConfTarget: cfg.HtlcConfTarget,
+		VbyteBudget:    cfg.VBytesBudget,
+		VbyteBudgetPeriodSec: uint64(
+			cfg.VBytesBudgetPeriod.Seconds(),
+		),
+		RebalanceFeePpm:    cfg.RebalanceFeePPM,
+		RebalanceBudgetSat: uint64(cfg.RebalanceBudget),
+		AutoloopBudgetRefreshPeriodSec: uint64(
+			cfg.AutoFeeRefreshPeriod.Seconds(),
+		),
+		AutoloopBudgetRollover:      cfg.AutoFeeRollover,
+		AutoloopBudgetRolledOverSat: uint64(cfg.AutoFeeRolledOver),
+		AutoloopSchedule: make(
+			[]*clientrpc.AutoloopWindow, len(cfg.AutoloopSchedule),
+		),
+		ChainFeeCeilingSatPerVbyte: uint64(
+			cfg.ChainFeeCeiling.FeePerKVByte() / 1000,
+		),
+		ApprovalMode:              cfg.ApprovalMode,
+		ApprovalTtlSec:            uint64(cfg.ApprovalTTL.Seconds()),
+		EasyAutoloop:              cfg.EasyAutoloop,
+		EasyOutboundTargetPercent: cfg.EasyOutboundTarget,
+		AmountBucketSat:           uint64(cfg.AmountBucket),
+		AmountJitterPercent:       cfg.AmountJitter,
+		AdaptiveInFlight:          cfg.AdaptiveInFlight,
+		ChannelsPerInFlight:       uint32(cfg.ChannelsPerInFlight),
+		SuccessCooldownSec:        uint64(cfg.SuccessCooldown.Seconds()),
+		RetryDispatchFailures:     cfg.RetryDispatchFailures,
+		MaxSwapsPerPeriod:         uint32(cfg.MaxSwapsPerPeriod),
+		MaxChannelSwapsPerPeriod: uint32(
+			cfg.MaxChannelSwapsPerPeriod,
+		),
+		SwapRatePeriodSec:    uint64(cfg.SwapRatePeriod.Seconds()),
+		ExponentialBackoff:   cfg.ExponentialBackoff,
+		BackoffMultiplier:    cfg.BackoffMultiplier,
+		BackoffCapSec:        uint64(cfg.BackoffCap.Seconds()),
+		BackoffJitterPercent: uint32(cfg.BackoffJitter),
+		AutoloopBudgetPpm:    cfg.AutoFeeBudgetPPM,
+		AutoloopBudgetOutSat: uint64(cfg.AutoFeeBudgetOut),
+		AutoloopBudgetInSat:  uint64(cfg.AutoFeeBudgetIn),
+		AutoMaxInFlightOut:   uint64(cfg.MaxAutoInFlightOut),
+		AutoMaxInFlightIn:    uint64(cfg.MaxAutoInFlightIn),
+		DispatchSpacingSec:   uint64(cfg.DispatchSpacing.Seconds()),
+		MinChannelAgeBlocks:  cfg.MinChannelAge,
+		SimulationMode:       cfg.SimulationMode,
+		FlowWindowSec:        uint64(cfg.FlowWindow.Seconds()),
+		PublicationDeadlineSec: uint64(
+			cfg.PublicationDeadline.Seconds(),
+		),
+		LoopInPrivate: cfg.LoopInPrivate,
+		BatchLoopOuts: cfg.BatchLoopOuts,
+		RuleConflict: clientrpc.RuleConflict(
+			cfg.RuleConflict,
+		),
+		LoopInSpread: clientrpc.LoopInSpread(
+			cfg.LoopInSpread,
+		),
+	}
+
+	for i, window := range cfg.AutoloopSchedule {
+		rpcCfg.AutoloopSchedule[i] = newRPCWindow(window)
+	}
+
+	for _, channel := range cfg.ExcludedChannels {
+		rpcCfg.ExcludedChannels = append(
+			rpcCfg.ExcludedChannels, channel.ToUint64(),
+		)
 	}
 
 	switch f := cfg.FeeLimit.(type) {
@@ -739,6 +1522,11 @@ func (s *swapClientServer) GetLiquidityParams(_ context.Context,
 	case *liquidity.FeePortion:
 		rpcCfg.FeePpm = f.PartsPerMillion
 
+	case *liquidity.DynamicFeeLimit:
+		rpcCfg.FeePpm = f.PartsPerMillion
+		rpcCfg.MinerFeePpm = f.MinerFeePPM
+		rpcCfg.MinerFeeMultiplier = f.MinerFeeMultiplier
+
 	default:
 		return nil, fmt.Errorf("unknown fee limit: %T", cfg.FeeLimit)
 	}
@@ -762,9 +1550,47 @@ func (s *swapClientServer) GetLiquidityParams(_ context.Context,
 		rpcCfg.Rules = append(rpcCfg.Rules, rpcRule)
 	}
 
+	for name, group := range cfg.ChannelGroups {
+		rpcRule := newRPCRule(0, nil, group.Rule)
+		rpcRule.GroupName = name
+		for _, channel := range group.Channels {
+			rpcRule.GroupChannels = append(
+				rpcRule.GroupChannels, channel.ToUint64(),
+			)
+		}
+
+		rpcCfg.Rules = append(rpcCfg.Rules, rpcRule)
+	}
+
 	return rpcCfg, nil
 }
 
+// GetEffectiveConfig returns the fully resolved settings of the daemon and its
+// liquidity manager, along with the source of each value.
+func (s *swapClientServer) GetEffectiveConfig(_ context.Context,
+	_ *clientrpc.GetEffectiveConfigRequest) (
+	*clientrpc.GetEffectiveConfigResponse, error) {
+
+	resp := &clientrpc.GetEffectiveConfigResponse{
+		Daemon: effectiveConfig(s.config),
+	}
+
+	for _, param := range s.liquidityMgr.EffectiveParameters() {
+		source := clientrpc.ConfigSource_CONFIG_SOURCE_RPC
+		if param.Default {
+			source = clientrpc.ConfigSource_CONFIG_SOURCE_DEFAULT
+		}
+
+		resp.Liquidity = append(resp.Liquidity, &clientrpc.ConfigValue{
+			Name:   param.Name,
+			Value:  param.Value,
+			Source: source,
+		})
+	}
+
+	return resp, nil
+}
+
 func newRPCRule(channelID uint64, peer []byte,
 	rule *liquidity.SwapRule) *clientrpc.LiquidityRule {
 
@@ -775,6 +1601,62 @@ func newRPCRule(channelID uint64, peer []byte,
 		IncomingThreshold: uint32(rule.MinimumIncoming),
 		OutgoingThreshold: uint32(rule.MinimumOutgoing),
 		SwapType:          clientrpc.SwapType_LOOP_OUT,
+		ShrinkPolicy: clientrpc.ShrinkPolicy(
+			rule.ShrinkPolicy,
+		),
+		FeeBudgetSat: uint64(rule.FeeBudget),
+		CooldownSec:  uint64(rule.Cooldown.Seconds()),
+		IncomingThresholdSat: uint64(
+			rule.MinimumIncomingAmount,
+		),
+		OutgoingThresholdSat: uint64(
+			rule.MinimumOutgoingAmount,
+		),
+		MaxSwapAmountSat: uint64(rule.MaxSwapAmount),
+		CustomRecords: loop.CopyCustomRecords(
+			rule.CustomRecords,
+		),
+		MinRevenuePercent: rule.MinRevenuePercent,
+		RevenueWindowSec:  uint64(rule.RevenueWindow.Seconds()),
+		PublicationDeadlineSec: uint64(
+			rule.PublicationDeadline.Seconds(),
+		),
+	}
+
+	if dest := rule.SweepDestination; dest != nil {
+		if dest.Xpub != nil {
+			rpcRule.SweepXpub = dest.Xpub.String()
+		} else {
+			rpcRule.SweepAddress = dest.Address.String()
+		}
+
+		// We return the signature that the address was verified with
+		// so that the rule can be set again as is.
+		verification := dest.Verification
+		if verification != nil &&
+			verification.Method == loopdb.DestVerificationSignature {
+
+			rpcRule.SweepAddressSignature = verification.Proof
+		}
+	}
+
+	if !rule.Expiry.IsZero() {
+		rpcRule.ExpirySec = uint64(rule.Expiry.Unix())
+	}
+
+	switch f := rule.FeeLimit.(type) {
+	case *liquidity.FeeCategoryLimit:
+		satPerByte := f.SweepFeeRateLimit.FeePerKVByte() / 1000
+
+		rpcRule.SweepFeeRateSatPerVbyte = uint64(satPerByte)
+		rpcRule.MaxMinerFeeSat = uint64(f.MaximumMinerFee)
+		rpcRule.MaxSwapFeePpm = f.MaximumSwapFeePPM
+		rpcRule.MaxRoutingFeePpm = f.MaximumRoutingFeePPM
+		rpcRule.MaxPrepayRoutingFeePpm = f.MaximumPrepayRoutingFeePPM
+		rpcRule.MaxPrepaySat = uint64(f.MaximumPrepay)
+
+	case *liquidity.FeePortion:
+		rpcRule.FeePpm = f.PartsPerMillion
 	}
 
 	if rule.Type == swap.TypeIn {
@@ -814,6 +1696,103 @@ func (s *swapClientServer) SetLiquidityParams(ctx context.Context,
 			Maximum: btcutil.Amount(in.Parameters.MaxSwapAmount),
 		},
 		HtlcConfTarget: in.Parameters.HtlcConfTarget,
+		VBytesBudget:   in.Parameters.VbyteBudget,
+		VBytesBudgetPeriod: time.Duration(
+			in.Parameters.VbyteBudgetPeriodSec,
+		) * time.Second,
+		RebalanceFeePPM: in.Parameters.RebalanceFeePpm,
+		RebalanceBudget: btcutil.Amount(
+			in.Parameters.RebalanceBudgetSat,
+		),
+		AutoFeeRefreshPeriod: time.Duration(
+			in.Parameters.AutoloopBudgetRefreshPeriodSec,
+		) * time.Second,
+		AutoFeeRollover: in.Parameters.AutoloopBudgetRollover,
+		AutoFeeRolledOver: btcutil.Amount(
+			in.Parameters.AutoloopBudgetRolledOverSat,
+		),
+		AutoloopSchedule: make(
+			[]liquidity.ScheduleWindow,
+			len(in.Parameters.AutoloopSchedule),
+		),
+		ChainFeeCeiling: chainfee.SatPerKVByte(
+			in.Parameters.ChainFeeCeilingSatPerVbyte * 1000,
+		).FeePerKWeight(),
+		ApprovalMode: in.Parameters.ApprovalMode,
+		ApprovalTTL: time.Duration(
+			in.Parameters.ApprovalTtlSec,
+		) * time.Second,
+		EasyAutoloop:       in.Parameters.EasyAutoloop,
+		EasyOutboundTarget: in.Parameters.EasyOutboundTargetPercent,
+		AmountBucket: btcutil.Amount(
+			in.Parameters.AmountBucketSat,
+		),
+		AmountJitter:     in.Parameters.AmountJitterPercent,
+		AdaptiveInFlight: in.Parameters.AdaptiveInFlight,
+		ChannelsPerInFlight: int(
+			in.Parameters.ChannelsPerInFlight,
+		),
+		SuccessCooldown: time.Duration(
+			in.Parameters.SuccessCooldownSec,
+		) * time.Second,
+		RetryDispatchFailures: in.Parameters.RetryDispatchFailures,
+		MaxSwapsPerPeriod:     int(in.Parameters.MaxSwapsPerPeriod),
+		MaxChannelSwapsPerPeriod: int(
+			in.Parameters.MaxChannelSwapsPerPeriod,
+		),
+		SwapRatePeriod: time.Duration(
+			in.Parameters.SwapRatePeriodSec,
+		) * time.Second,
+		ExponentialBackoff: in.Parameters.ExponentialBackoff,
+		BackoffMultiplier:  in.Parameters.BackoffMultiplier,
+		BackoffCap: time.Duration(
+			in.Parameters.BackoffCapSec,
+		) * time.Second,
+		BackoffJitter:    uint64(in.Parameters.BackoffJitterPercent),
+		AutoFeeBudgetPPM: in.Parameters.AutoloopBudgetPpm,
+		AutoFeeBudgetOut: btcutil.Amount(
+			in.Parameters.AutoloopBudgetOutSat,
+		),
+		AutoFeeBudgetIn: btcutil.Amount(
+			in.Parameters.AutoloopBudgetInSat,
+		),
+		MaxAutoInFlightOut: int(in.Parameters.AutoMaxInFlightOut),
+		MaxAutoInFlightIn:  int(in.Parameters.AutoMaxInFlightIn),
+		DispatchSpacing: time.Duration(
+			in.Parameters.DispatchSpacingSec,
+		) * time.Second,
+		MinChannelAge:  in.Parameters.MinChannelAgeBlocks,
+		SimulationMode: in.Parameters.SimulationMode,
+		FlowWindow: time.Duration(
+			in.Parameters.FlowWindowSec,
+		) * time.Second,
+		PublicationDeadline: time.Duration(
+			in.Parameters.PublicationDeadlineSec,
+		) * time.Second,
+		LoopInPrivate: in.Parameters.LoopInPrivate,
+		BatchLoopOuts: in.Parameters.BatchLoopOuts,
+		RuleConflict: liquidity.RuleConflict(
+			in.Parameters.RuleConflict,
+		),
+		LoopInSpread: liquidity.LoopInSpread(
+			in.Parameters.LoopInSpread,
+		),
+	}
+
+	for i, window := range in.Parameters.AutoloopSchedule {
+		params.AutoloopSchedule[i] = rpcToWindow(window)
+	}
+
+	for _, channel := range in.Parameters.ExcludedChannels {
+		params.ExcludedChannels = append(
+			params.ExcludedChannels,
+			lnwire.NewShortChanIDFromInt(channel),
+		)
+	}
+
+	// If no vbyte budget period is provided, we fall back to our default.
+	if params.VBytesBudgetPeriod == 0 {
+		params.VBytesBudgetPeriod = liquidity.DefaultVBytesBudgetPeriod
 	}
 
 	// Zero unix time is different to zero golang time.
@@ -826,8 +1805,11 @@ func (s *swapClientServer) SetLiquidityParams(ctx context.Context,
 	for _, rule := range in.Parameters.Rules {
 		peerRule := rule.Pubkey != nil
 		chanRule := rule.ChannelId != 0
+		groupRule := rule.GroupName != ""
 
-		liquidityRule, err := rpcToRule(rule)
+		liquidityRule, err := rpcToRule(
+			rule, s.lnd.ChainParams, s.destVerifier,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -838,6 +1820,33 @@ func (s *swapClientServer) SetLiquidityParams(ctx context.Context,
 				"peer: %v fields in rule", rule.ChannelId,
 				rule.Pubkey)
 
+		case groupRule && (peerRule || chanRule):
+			return nil, fmt.Errorf("cannot set channel or peer "+
+				"fields in rule for group: %v", rule.GroupName)
+
+		case groupRule:
+			if _, ok := params.ChannelGroups[rule.GroupName]; ok {
+				return nil, fmt.Errorf("multiple rules set "+
+					"for group: %v", rule.GroupName)
+			}
+
+			group := &liquidity.ChannelGroup{
+				Rule: liquidityRule,
+			}
+			for _, channel := range rule.GroupChannels {
+				group.Channels = append(
+					group.Channels,
+					lnwire.NewShortChanIDFromInt(channel),
+				)
+			}
+
+			if params.ChannelGroups == nil {
+				params.ChannelGroups = make(
+					map[string]*liquidity.ChannelGroup,
+				)
+			}
+			params.ChannelGroups[rule.GroupName] = group
+
 		case peerRule:
 			pubkey, err := route.NewVertexFromBytes(rule.Pubkey)
 			if err != nil {
@@ -862,8 +1871,8 @@ func (s *swapClientServer) SetLiquidityParams(ctx context.Context,
 			params.ChannelRules[shortID] = liquidityRule
 
 		default:
-			return nil, errors.New("please set channel id or " +
-				"pubkey for rule")
+			return nil, errors.New("please set channel id, " +
+				"pubkey or group name for rule")
 		}
 	}
 
@@ -871,158 +1880,1688 @@ func (s *swapClientServer) SetLiquidityParams(ctx context.Context,
 		return nil, err
 	}
 
-	return &clientrpc.SetLiquidityParamsResponse{}, nil
+	pending, err := s.rpcPendingIncrease(s.liquidityMgr.PendingIncrease())
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientrpc.SetLiquidityParamsResponse{
+		PendingIncrease: pending,
+	}, nil
 }
 
-// rpcToFee converts the values provided over rpc to a fee limit interface,
-// failing if an inconsistent set of fields are set.
-func rpcToFee(req *clientrpc.LiquidityParameters) (liquidity.FeeLimit,
+// ApplyRules previews a bulk rule change, or applies it if the id of its
+// preview is provided.
+func (s *swapClientServer) ApplyRules(ctx context.Context,
+	in *clientrpc.ApplyRulesRequest) (*clientrpc.ApplyRulesResponse,
 	error) {
 
-	// Check which fee limit type we have values set for. If any fields
-	// relevant to our individual categories are set, we count that type
-	// as set.
-	isFeePPM := req.FeePpm != 0
-	isCategories := req.MaxSwapFeePpm != 0 || req.MaxRoutingFeePpm != 0 ||
-		req.MaxPrepayRoutingFeePpm != 0 || req.MaxMinerFeeSat != 0 ||
-		req.MaxPrepaySat != 0 || req.SweepFeeRateSatPerVbyte != 0
-
-	switch {
-	case isFeePPM && isCategories:
-		return nil, errors.New("set either fee ppm, or individual " +
-			"fee categories")
-	case isFeePPM:
-		return liquidity.NewFeePortion(req.FeePpm), nil
+	change := &liquidity.RuleChange{
+		Selector: liquidity.RuleSelector{
+			AllChannels: in.AllChannels,
+			AllPeers:    in.AllPeers,
+		},
+	}
 
-	case isCategories:
-		satPerVbyte := chainfee.SatPerKVByte(
-			req.SweepFeeRateSatPerVbyte * 1000,
+	for _, channel := range in.Channels {
+		change.Selector.Channels = append(
+			change.Selector.Channels,
+			lnwire.NewShortChanIDFromInt(channel),
 		)
+	}
 
-		return liquidity.NewFeeCategoryLimit(
-			req.MaxSwapFeePpm,
-			req.MaxRoutingFeePpm,
-			req.MaxPrepayRoutingFeePpm,
-			btcutil.Amount(req.MaxMinerFeeSat),
-			btcutil.Amount(req.MaxPrepaySat),
-			satPerVbyte.FeePerKWeight(),
-		), nil
+	for _, peer := range in.Peers {
+		pubkey, err := route.NewVertexFromBytes(peer)
+		if err != nil {
+			return nil, err
+		}
 
-	default:
-		return nil, errors.New("no fee categories set")
+		change.Selector.Peers = append(change.Selector.Peers, pubkey)
 	}
-}
 
-// rpcToRule switches on rpc rule type to convert to our rule interface.
-func rpcToRule(rule *clientrpc.LiquidityRule) (*liquidity.SwapRule, error) {
-	swapType := swap.TypeOut
-	if rule.SwapType == clientrpc.SwapType_LOOP_IN {
-		swapType = swap.TypeIn
-	}
+	switch {
+	case in.Remove && in.Rule != nil:
+		return nil, errors.New("cannot set rule template when " +
+			"removing rules")
 
-	switch rule.Type {
-	case clientrpc.LiquidityRuleType_UNKNOWN:
-		return nil, fmt.Errorf("rule type field must be set")
+	case in.Remove:
 
-	case clientrpc.LiquidityRuleType_THRESHOLD:
-		return &liquidity.SwapRule{
-			ThresholdRule: liquidity.NewThresholdRule(
-				int(rule.IncomingThreshold),
-				int(rule.OutgoingThreshold),
-			),
-			Type: swapType,
-		}, nil
+	case in.Rule == nil:
+		return nil, errors.New("rule template required")
 
-	default:
-		return nil, fmt.Errorf("unknown rule: %T", rule)
-	}
+	case in.Rule.ChannelId != 0 || in.Rule.Pubkey != nil ||
+		in.Rule.GroupName != "":
 
-}
+		return nil, errors.New("cannot set channel, peer or group " +
+			"fields in rule template")
 
-// SuggestSwaps provides a list of suggested swaps based on lnd's current
-// channel balances and rules set by the liquidity manager.
-func (s *swapClientServer) SuggestSwaps(ctx context.Context,
-	_ *clientrpc.SuggestSwapsRequest) (*clientrpc.SuggestSwapsResponse, error) {
+	default:
+		rule, err := rpcToRule(
+			in.Rule, s.lnd.ChainParams, s.destVerifier,
+		)
+		if err != nil {
+			return nil, err
+		}
+		change.Rule = rule
+	}
 
-	suggestions, err := s.liquidityMgr.SuggestSwaps(ctx, false)
-	switch err {
-	case liquidity.ErrNoRules:
-		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	var (
+		preview *liquidity.RulePreview
+		err     error
+	)
 
-	case nil:
+	applied := len(in.PreviewId) != 0
+	if applied {
+		var previewID [sha256.Size]byte
+		if len(in.PreviewId) != sha256.Size {
+			return nil, fmt.Errorf("preview id must be %v bytes",
+				sha256.Size)
+		}
+		copy(previewID[:], in.PreviewId)
 
-	default:
+		preview, err = s.liquidityMgr.ApplyRules(ctx, change, previewID)
+	} else {
+		preview, err = s.liquidityMgr.PreviewRules(ctx, change)
+	}
+	if err != nil {
 		return nil, err
 	}
 
-	resp := &clientrpc.SuggestSwapsResponse{
-		LoopOut: make(
-			[]*clientrpc.LoopOutRequest, len(suggestions.OutSwaps),
+	resp := &clientrpc.ApplyRulesResponse{
+		CurrentSwaps:   rpcProjectedSwaps(preview.Current),
+		ProjectedSwaps: rpcProjectedSwaps(preview.Projected),
+		PreviewId:      preview.ID[:],
+		Applied:        applied,
+	}
+
+	for _, diff := range preview.Diffs {
+		var (
+			channelID uint64
+			peer      []byte
+		)
+
+		if diff.Channel.ToUint64() != 0 {
+			channelID = diff.Channel.ToUint64()
+		} else {
+			peer = diff.Peer[:]
+		}
+
+		rpcChange := &clientrpc.RuleChange{
+			ChannelId: channelID,
+			Pubkey:    peer,
+			Type:      rpcRuleChangeType(diff.Type),
+		}
+
+		if diff.Old != nil {
+			rpcChange.OldRule = newRPCRule(channelID, peer, diff.Old)
+		}
+
+		if diff.New != nil {
+			rpcChange.NewRule = newRPCRule(channelID, peer, diff.New)
+		}
+
+		resp.Changes = append(resp.Changes, rpcChange)
+	}
+
+	return resp, nil
+}
+
+// SaveProfile saves our current liquidity parameters as a profile, or removes
+// a saved profile.
+func (s *swapClientServer) SaveProfile(_ context.Context,
+	in *clientrpc.SaveProfileRequest) (*clientrpc.SaveProfileResponse,
+	error) {
+
+	var err error
+	if in.Remove {
+		err = s.liquidityMgr.RemoveProfile(in.Name)
+	} else {
+		err = s.liquidityMgr.SaveProfile(in.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientrpc.SaveProfileResponse{}, nil
+}
+
+// ListProfiles returns our saved liquidity parameter profiles and the
+// schedule that we switch between them on.
+func (s *swapClientServer) ListProfiles(_ context.Context,
+	_ *clientrpc.ListProfilesRequest) (*clientrpc.ListProfilesResponse,
+	error) {
+
+	profiles := s.liquidityMgr.GetProfiles()
+
+	resp := &clientrpc.ListProfilesResponse{
+		ActiveProfile: profiles.Active,
+	}
+
+	for name, params := range profiles.Saved {
+		rpcParams, err := newRPCParams(params)
+		if err != nil {
+			return nil, err
+		}
+
+		profile := &clientrpc.LiquidityProfile{
+			Name:       name,
+			Parameters: rpcParams,
+		}
+		resp.Profiles = append(resp.Profiles, profile)
+	}
+
+	sort.Slice(resp.Profiles, func(i, j int) bool {
+		return resp.Profiles[i].Name < resp.Profiles[j].Name
+	})
+
+	for _, profileSwitch := range profiles.Schedule {
+		resp.Schedule = append(resp.Schedule, &clientrpc.ProfileSwitch{
+			Profile: profileSwitch.Profile,
+			Window:  newRPCWindow(profileSwitch.Window),
+		})
+	}
+
+	return resp, nil
+}
+
+// SetProfileSchedule replaces the schedule that we switch between our saved
+// profiles on.
+func (s *swapClientServer) SetProfileSchedule(_ context.Context,
+	in *clientrpc.SetProfileScheduleRequest) (
+	*clientrpc.SetProfileScheduleResponse, error) {
+
+	schedule := make([]liquidity.ProfileSwitch, len(in.Schedule))
+	for i, profileSwitch := range in.Schedule {
+		if profileSwitch.Window == nil {
+			return nil, fmt.Errorf("window required for profile: %v",
+				profileSwitch.Profile)
+		}
+
+		schedule[i] = liquidity.ProfileSwitch{
+			Profile: profileSwitch.Profile,
+			Window:  rpcToWindow(profileSwitch.Window),
+		}
+	}
+
+	if err := s.liquidityMgr.SetProfileSchedule(schedule); err != nil {
+		return nil, err
+	}
+
+	return &clientrpc.SetProfileScheduleResponse{}, nil
+}
+
+// GetParamsHistory returns the audit history of changes to our liquidity
+// parameters.
+func (s *swapClientServer) GetParamsHistory(_ context.Context,
+	_ *clientrpc.GetParamsHistoryRequest) (
+	*clientrpc.GetParamsHistoryResponse, error) {
+
+	history := s.liquidityMgr.ParamsHistory()
+
+	resp := &clientrpc.GetParamsHistoryResponse{
+		Changes: make([]*clientrpc.ParamsChange, len(history)),
+	}
+
+	for i, change := range history {
+		resp.Changes[i] = &clientrpc.ParamsChange{
+			Timestamp:       change.Time.Unix(),
+			Source:          rpcParamsChangeSource(change.Source),
+			Profile:         change.Profile,
+			PreviousProfile: change.PreviousProfile,
+		}
+	}
+
+	return resp, nil
+}
+
+// rpcParamsChangeSource converts the source of a parameters change to its rpc
+// representation.
+func rpcParamsChangeSource(
+	source liquidity.ParamsChangeSource) clientrpc.ParamsChangeSource {
+
+	switch source {
+	case liquidity.ParamsChangeProfile:
+		return clientrpc.ParamsChangeSource_PARAMS_CHANGE_PROFILE
+
+	case liquidity.ParamsChangeDelayedIncrease:
+		return clientrpc.ParamsChangeSource_PARAMS_CHANGE_DELAYED_INCREASE
+
+	default:
+		return clientrpc.ParamsChangeSource_PARAMS_CHANGE_RPC
+	}
+}
+
+// GetPendingIncrease returns the increase to our fee budget or fee limit that
+// is waiting for its delay to pass, if any.
+func (s *swapClientServer) GetPendingIncrease(_ context.Context,
+	_ *clientrpc.GetPendingIncreaseRequest) (
+	*clientrpc.GetPendingIncreaseResponse, error) {
+
+	increase, err := s.rpcPendingIncrease(
+		s.liquidityMgr.PendingIncrease(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientrpc.GetPendingIncreaseResponse{
+		Increase: increase,
+	}, nil
+}
+
+// CancelPendingIncrease cancels the increase to our fee budget or fee limit
+// that is waiting for its delay to pass.
+func (s *swapClientServer) CancelPendingIncrease(_ context.Context,
+	_ *clientrpc.CancelPendingIncreaseRequest) (
+	*clientrpc.CancelPendingIncreaseResponse, error) {
+
+	pending, err := s.liquidityMgr.CancelPendingIncrease()
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled, err := s.rpcPendingIncrease(pending)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientrpc.CancelPendingIncreaseResponse{
+		Cancelled: cancelled,
+	}, nil
+}
+
+// rpcPendingIncrease converts a pending increase to its rpc representation,
+// including the parameters that will be in effect once it is applied to our
+// current parameters. If the increase is nil, nil is returned.
+func (s *swapClientServer) rpcPendingIncrease(
+	pending *liquidity.PendingIncrease) (*clientrpc.PendingIncrease,
+	error) {
+
+	if pending == nil {
+		return nil, nil
+	}
+
+	params, err := newRPCParams(
+		pending.Apply(s.liquidityMgr.GetParameters()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientrpc.PendingIncrease{
+		RequestedTime:    pending.Requested.Unix(),
+		EffectiveTime:    pending.Effective.Unix(),
+		BudgetIncrease:   pending.AutoFeeBudget != nil,
+		FeeLimitIncrease: pending.FeeLimit != nil,
+		Parameters:       params,
+	}, nil
+}
+
+// rpcRuleChangeType converts a rule diff type to its rpc representation.
+func rpcRuleChangeType(
+	diffType liquidity.RuleDiffType) clientrpc.RuleChangeType {
+
+	switch diffType {
+	case liquidity.RuleChanged:
+		return clientrpc.RuleChangeType_RULE_CHANGE_CHANGED
+
+	case liquidity.RuleRemoved:
+		return clientrpc.RuleChangeType_RULE_CHANGE_REMOVED
+
+	default:
+		return clientrpc.RuleChangeType_RULE_CHANGE_ADDED
+	}
+}
+
+// rpcProjectedSwaps converts a summary of projected swaps to its rpc
+// representation.
+func rpcProjectedSwaps(
+	swaps liquidity.ProjectedSwaps) *clientrpc.ProjectedSwaps {
+
+	return &clientrpc.ProjectedSwaps{
+		LoopOutCount:  uint32(swaps.LoopOutCount),
+		LoopOutAmtSat: uint64(swaps.LoopOutAmount),
+		LoopInCount:   uint32(swaps.LoopInCount),
+		LoopInAmtSat:  uint64(swaps.LoopInAmount),
+	}
+}
+
+// rpcToRuleFee converts the fee limit values set on a rpc rule to a fee limit
+// that overrides our global fee limit, returning nil if none are set.
+func rpcToRuleFee(rule *clientrpc.LiquidityRule) (liquidity.FeeLimit,
+	error) {
+
+	feeLimit, err := rpcToFee(&clientrpc.LiquidityParameters{
+		FeePpm:                  rule.FeePpm,
+		SweepFeeRateSatPerVbyte: rule.SweepFeeRateSatPerVbyte,
+		MaxSwapFeePpm:           rule.MaxSwapFeePpm,
+		MaxRoutingFeePpm:        rule.MaxRoutingFeePpm,
+		MaxPrepayRoutingFeePpm:  rule.MaxPrepayRoutingFeePpm,
+		MaxPrepaySat:            rule.MaxPrepaySat,
+		MaxMinerFeeSat:          rule.MaxMinerFeeSat,
+	})
+	if errors.Is(err, errNoFeeCategories) {
+		return nil, nil
+	}
+
+	return feeLimit, err
+}
+
+// rpcToFee converts the values provided over rpc to a fee limit interface,
+// failing if an inconsistent set of fields are set.
+func rpcToFee(req *clientrpc.LiquidityParameters) (liquidity.FeeLimit,
+	error) {
+
+	// Check which fee limit type we have values set for. If any fields
+	// relevant to our individual categories are set, we count that type
+	// as set.
+	isFeePPM := req.FeePpm != 0
+	isCategories := req.MaxSwapFeePpm != 0 || req.MaxRoutingFeePpm != 0 ||
+		req.MaxPrepayRoutingFeePpm != 0 || req.MaxMinerFeeSat != 0 ||
+		req.MaxPrepaySat != 0 || req.SweepFeeRateSatPerVbyte != 0
+	isDynamic := req.MinerFeePpm != 0 || req.MinerFeeMultiplier != 0
+
+	switch {
+	case isFeePPM && isCategories:
+		return nil, errors.New("set either fee ppm, or individual " +
+			"fee categories")
+
+	case isDynamic && !isFeePPM:
+		return nil, errors.New("miner fee ppm and multiplier may " +
+			"only be set with fee ppm")
+
+	case isDynamic:
+		return liquidity.NewDynamicFeeLimit(
+			req.FeePpm, req.MinerFeePpm, req.MinerFeeMultiplier,
+		), nil
+
+	case isFeePPM:
+		return liquidity.NewFeePortion(req.FeePpm), nil
+
+	case isCategories:
+		satPerVbyte := chainfee.SatPerKVByte(
+			req.SweepFeeRateSatPerVbyte * 1000,
+		)
+
+		return liquidity.NewFeeCategoryLimit(
+			req.MaxSwapFeePpm,
+			req.MaxRoutingFeePpm,
+			req.MaxPrepayRoutingFeePpm,
+			btcutil.Amount(req.MaxMinerFeeSat),
+			btcutil.Amount(req.MaxPrepaySat),
+			satPerVbyte.FeePerKWeight(),
+		), nil
+
+	default:
+		return nil, errNoFeeCategories
+	}
+}
+
+// rpcToSweepDestination converts the sweep destination of an rpc rule to a
+// sweep destination for the network provided, verifying the ownership of
+// fixed addresses. It returns nil if the rule does not set a destination.
+func rpcToSweepDestination(rule *clientrpc.LiquidityRule,
+	chainParams *chaincfg.Params,
+	verifier *loop.DestVerifier) (*liquidity.SweepDestination, error) {
+
+	if rule.SweepAddressSignature != "" && rule.SweepAddress == "" {
+		return nil, errors.New("sweep address signature may only be " +
+			"set with sweep address")
+	}
+
+	if rule.SweepAddress == "" && rule.SweepXpub == "" {
+		return nil, nil
+	}
+
+	dest := &liquidity.SweepDestination{}
+
+	if rule.SweepAddress != "" {
+		addr, err := btcutil.DecodeAddress(
+			rule.SweepAddress, chainParams,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("decode sweep address: %v", err)
+		}
+
+		if !addr.IsForNet(chainParams) {
+			return nil, fmt.Errorf("%w: Current active network "+
+				"is %s", errIncorrectChain, chainParams.Name)
+		}
+
+		dest.Address = addr
+
+		dest.Verification, err = verifier.Verify(
+			addr, rule.SweepAddressSignature,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rule.SweepXpub != "" {
+		xpub, err := hdkeychain.NewKeyFromString(rule.SweepXpub)
+		if err != nil {
+			return nil, fmt.Errorf("decode sweep xpub: %v", err)
+		}
+
+		if !xpub.IsForNet(chainParams) {
+			return nil, fmt.Errorf("%w: Current active network "+
+				"is %s", errIncorrectChain, chainParams.Name)
+		}
+
+		dest.Xpub = xpub
+
+		// We check that the xpub is one of our configured xpubs, so
+		// that a mistyped xpub is rejected when we require verified
+		// destinations.
+		dest.XpubVerified, err = verifier.VerifyXpub(xpub)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return dest, nil
+}
+
+// rpcToRule switches on rpc rule type to convert to our rule interface.
+func rpcToRule(rule *clientrpc.LiquidityRule, chainParams *chaincfg.Params,
+	verifier *loop.DestVerifier) (*liquidity.SwapRule, error) {
+
+	swapType := swap.TypeOut
+	if rule.SwapType == clientrpc.SwapType_LOOP_IN {
+		swapType = swap.TypeIn
+	}
+
+	switch rule.Type {
+	case clientrpc.LiquidityRuleType_UNKNOWN:
+		return nil, fmt.Errorf("rule type field must be set")
+
+	case clientrpc.LiquidityRuleType_THRESHOLD:
+		swapRule := &liquidity.SwapRule{
+			ThresholdRule: &liquidity.ThresholdRule{
+				MinimumIncoming: int(rule.IncomingThreshold),
+				MinimumOutgoing: int(rule.OutgoingThreshold),
+				MinimumIncomingAmount: btcutil.Amount(
+					rule.IncomingThresholdSat,
+				),
+				MinimumOutgoingAmount: btcutil.Amount(
+					rule.OutgoingThresholdSat,
+				),
+			},
+			Type: swapType,
+			ShrinkPolicy: liquidity.ShrinkPolicy(
+				rule.ShrinkPolicy,
+			),
+			FeeBudget: btcutil.Amount(rule.FeeBudgetSat),
+			Cooldown: time.Duration(rule.CooldownSec) *
+				time.Second,
+			MaxSwapAmount: btcutil.Amount(rule.MaxSwapAmountSat),
+			CustomRecords: loop.CopyCustomRecords(
+				rule.CustomRecords,
+			),
+			RevenueWindow: time.Duration(rule.RevenueWindowSec) *
+				time.Second,
+			MinRevenuePercent: rule.MinRevenuePercent,
+			PublicationDeadline: time.Duration(
+				rule.PublicationDeadlineSec,
+			) * time.Second,
+		}
+
+		if rule.ExpirySec != 0 {
+			swapRule.Expiry = time.Unix(int64(rule.ExpirySec), 0)
+		}
+
+		feeLimit, err := rpcToRuleFee(rule)
+		if err != nil {
+			return nil, err
+		}
+		swapRule.FeeLimit = feeLimit
+
+		dest, err := rpcToSweepDestination(
+			rule, chainParams, verifier,
+		)
+		if err != nil {
+			return nil, err
+		}
+		swapRule.SweepDestination = dest
+
+		return swapRule, nil
+
+	default:
+		return nil, fmt.Errorf("unknown rule: %T", rule)
+	}
+
+}
+
+// newRPCWindow converts a schedule window to its rpc representation.
+func newRPCWindow(window liquidity.ScheduleWindow) *clientrpc.AutoloopWindow {
+	rpcWindow := &clientrpc.AutoloopWindow{
+		Days:        make([]uint32, len(window.Days)),
+		StartMinute: uint32(window.Start / time.Minute),
+		EndMinute:   uint32(window.End / time.Minute),
+	}
+
+	for i, day := range window.Days {
+		rpcWindow.Days[i] = uint32(day)
+	}
+
+	return rpcWindow
+}
+
+// rpcToWindow converts an rpc schedule window to a schedule window. The window
+// is validated when our parameters are set.
+func rpcToWindow(window *clientrpc.AutoloopWindow) liquidity.ScheduleWindow {
+	result := liquidity.ScheduleWindow{
+		Start: time.Duration(window.StartMinute) * time.Minute,
+		End:   time.Duration(window.EndMinute) * time.Minute,
+	}
+
+	for _, day := range window.Days {
+		result.Days = append(result.Days, time.Weekday(day))
+	}
+
+	return result
+}
+
+// SuggestSwaps provides a list of suggested swaps based on lnd's current
+// channel balances and rules set by the liquidity manager.
+func (s *swapClientServer) SuggestSwaps(ctx context.Context,
+	_ *clientrpc.SuggestSwapsRequest) (*clientrpc.SuggestSwapsResponse, error) {
+
+	suggestions, err := s.liquidityMgr.SuggestSwaps(ctx, false)
+	switch err {
+	case liquidity.ErrNoRules:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+
+	case nil:
+
+	default:
+		return nil, err
+	}
+
+	return rpcSuggestions(suggestions)
+}
+
+// SubscribeSuggestions streams our swap suggestions to the client, sending a
+// new set each time the inputs to our suggestions change materially.
+func (s *swapClientServer) SubscribeSuggestions(
+	req *clientrpc.SubscribeSuggestionsRequest,
+	server clientrpc.SwapClient_SubscribeSuggestionsServer) error {
+
+	log.Infof("Subscribe suggestions request received")
+
+	send := func(suggestions *liquidity.Suggestions) error {
+		resp, err := rpcSuggestions(suggestions)
+		if err != nil {
+			return err
+		}
+
+		return server.Send(resp)
+	}
+
+	return s.liquidityMgr.SubscribeSuggestions(
+		server.Context(), btcutil.Amount(req.BalanceDeltaSat), send,
+	)
+}
+
+// rpcSuggestions converts a set of swap suggestions to its rpc
+// representation.
+func rpcSuggestions(suggestions *liquidity.Suggestions) (
+	*clientrpc.SuggestSwapsResponse, error) {
+
+	resp := &clientrpc.SuggestSwapsResponse{
+		LoopOut: make(
+			[]*clientrpc.LoopOutRequest, len(suggestions.OutSwaps),
+		),
+		LoopIn: make(
+			[]*clientrpc.LoopInRequest, len(suggestions.InSwaps),
+		),
+		Rebalances: make(
+			[]*clientrpc.RebalanceSuggestion,
+			len(suggestions.Rebalances),
+		),
+	}
+
+	for i, swap := range suggestions.OutSwaps {
+		resp.LoopOut[i] = newRPCLoopOutRequest(swap)
+	}
+
+	for i, swap := range suggestions.InSwaps {
+		resp.LoopIn[i] = newRPCLoopInRequest(swap)
+	}
+
+	for i, rebalance := range suggestions.Rebalances {
+		resp.Rebalances[i] = newRPCRebalanceSuggestion(rebalance)
+	}
+
+	for id, reason := range suggestions.DisqualifiedChans {
+		autoloopReason, err := rpcAutoloopReason(reason)
+		if err != nil {
+			return nil, err
+		}
+
+		exclChan := &clientrpc.Disqualified{
+			Reason:    autoloopReason,
+			ChannelId: id.ToUint64(),
+		}
+
+		resp.Disqualified = append(resp.Disqualified, exclChan)
+	}
+
+	for pubkey, reason := range suggestions.DisqualifiedPeers {
+		autoloopReason, err := rpcAutoloopReason(reason)
+		if err != nil {
+			return nil, err
+		}
+
+		exclChan := &clientrpc.Disqualified{
+			Reason: autoloopReason,
+			Pubkey: pubkey[:],
+		}
+
+		resp.Disqualified = append(resp.Disqualified, exclChan)
+	}
+
+	for name, reason := range suggestions.DisqualifiedGroups {
+		autoloopReason, err := rpcAutoloopReason(reason)
+		if err != nil {
+			return nil, err
+		}
+
+		exclGroup := &clientrpc.Disqualified{
+			Reason:    autoloopReason,
+			GroupName: name,
+		}
+
+		resp.Disqualified = append(resp.Disqualified, exclGroup)
+	}
+
+	now := time.Now()
+	for _, notice := range suggestions.ServerNotices {
+		resp.ServerNotices = append(
+			resp.ServerNotices, rpcServerNotice(notice, now),
+		)
+	}
+
+	for _, score := range suggestions.Scores {
+		resp.Scores = append(resp.Scores, newRPCSwapScore(score))
+	}
+
+	return resp, nil
+}
+
+// cachedSwaps returns the swaps with the hashes provided from our in-memory
+// cache.
+func (s *swapClientServer) cachedSwaps(hashes []lntypes.Hash) (
+	[]loop.SwapInfo, error) {
+
+	s.swapsLock.Lock()
+	defer s.swapsLock.Unlock()
+
+	swaps := make([]loop.SwapInfo, len(hashes))
+	for i, hash := range hashes {
+		swp, ok := s.swaps[hash]
+		if !ok {
+			return nil, fmt.Errorf("swap with hash %v: %w", hash,
+				loopdb.ErrSwapNotFound)
+		}
+
+		swaps[i] = swp
+	}
+
+	return swaps, nil
+}
+
+// newRPCLoopOutRequest converts a suggested loop out to its rpc
+// representation.
+func newRPCLoopOutRequest(swap loop.OutRequest) *clientrpc.LoopOutRequest {
+	return &clientrpc.LoopOutRequest{
+		Amt:                 int64(swap.Amount),
+		OutgoingChanSet:     swap.OutgoingChanSet,
+		MaxSwapFee:          int64(swap.MaxSwapFee),
+		MaxMinerFee:         int64(swap.MaxMinerFee),
+		MaxPrepayAmt:        int64(swap.MaxPrepayAmount),
+		MaxSwapRoutingFee:   int64(swap.MaxSwapRoutingFee),
+		MaxPrepayRoutingFee: int64(swap.MaxPrepayRoutingFee),
+		SweepConfTarget:     swap.SweepConfTarget,
+		CustomRecords:       swap.CustomRecords,
+	}
+}
+
+// newRPCLoopInRequest converts a suggested loop in to its rpc representation.
+func newRPCLoopInRequest(swap loop.LoopInRequest) *clientrpc.LoopInRequest {
+	loopIn := &clientrpc.LoopInRequest{
+		Amt:            int64(swap.Amount),
+		MaxSwapFee:     int64(swap.MaxSwapFee),
+		MaxMinerFee:    int64(swap.MaxMinerFee),
+		HtlcConfTarget: swap.HtlcConfTarget,
+	}
+
+	if swap.LastHop != nil {
+		loopIn.LastHop = swap.LastHop[:]
+	}
+
+	return loopIn
+}
+
+// newRPCRebalanceSuggestion converts a suggested rebalance to its rpc
+// representation.
+func newRPCRebalanceSuggestion(
+	rebalance loop.RebalanceRequest) *clientrpc.RebalanceSuggestion {
+
+	return &clientrpc.RebalanceSuggestion{
+		Amt:            uint64(rebalance.Amount),
+		OutgoingChanId: rebalance.OutgoingChannel,
+		IncomingChanId: rebalance.IncomingChannel,
+		MaxFeeSat:      uint64(rebalance.MaxFee),
+	}
+}
+
+// newRPCSwapScore converts a swap score to its rpc representation.
+func newRPCSwapScore(score *liquidity.SwapScore) *clientrpc.SwapScore {
+	rpcScore := &clientrpc.SwapScore{
+		Type:        clientrpc.SwapType_LOOP_OUT,
+		Amt:         uint64(score.Amount),
+		VolumeSat:   uint64(score.Volume),
+		CapacitySat: uint64(score.Capacity),
+		Efficiency:  score.Efficiency,
+		Score:       score.Score,
+		Selected:    score.Selected,
+	}
+
+	if score.Type == swap.TypeIn {
+		rpcScore.Type = clientrpc.SwapType_LOOP_IN
+	}
+
+	for _, channel := range score.Channels {
+		rpcScore.Channels = append(
+			rpcScore.Channels, channel.ToUint64(),
+		)
+	}
+
+	return rpcScore
+}
+
+// PreviewFees returns the breakdown of fees that our current fee limits would
+// set for a loop out swap.
+func (s *swapClientServer) PreviewFees(ctx context.Context,
+	in *clientrpc.PreviewFeesRequest) (*clientrpc.PreviewFeesResponse,
+	error) {
+
+	if in.Amt == 0 {
+		return nil, status.Error(
+			codes.InvalidArgument, "amount must be set",
+		)
+	}
+
+	fees, err := s.liquidityMgr.PreviewFees(ctx, btcutil.Amount(in.Amt))
+	if err != nil {
+		return nil, err
+	}
+
+	reason, err := rpcAutoloopReason(fees.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientrpc.PreviewFeesResponse{
+		SwapFeeSat:             uint64(fees.SwapFee),
+		PrepayAmtSat:           uint64(fees.PrepayAmount),
+		MaxMinerFeeSat:         uint64(fees.MaxMinerFee),
+		MaxSwapRoutingFeeSat:   uint64(fees.MaxSwapRoutingFee),
+		MaxPrepayRoutingFeeSat: uint64(fees.MaxPrepayRoutingFee),
+		WorstCaseFeeSat:        uint64(fees.WorstCaseFees),
+		WithinLimits:           fees.Reason == liquidity.ReasonNone,
+		Reason:                 reason,
+	}, nil
+}
+
+// CompareRebalance compares the estimated cost of shifting local balance out
+// of a channel with a circular rebalance to the cost of a loop out swap.
+func (s *swapClientServer) CompareRebalance(ctx context.Context,
+	in *clientrpc.CompareRebalanceRequest) (
+	*clientrpc.CompareRebalanceResponse, error) {
+
+	switch {
+	case in.Amt == 0:
+		return nil, status.Error(
+			codes.InvalidArgument, "amount must be set",
+		)
+
+	case in.OutgoingChanId == 0 || in.IncomingChanId == 0:
+		return nil, status.Error(
+			codes.InvalidArgument, "outgoing and incoming "+
+				"channel must be set",
+		)
+
+	case in.OutgoingChanId == in.IncomingChanId:
+		return nil, status.Error(
+			codes.InvalidArgument, "outgoing and incoming "+
+				"channel must differ",
+		)
+	}
+
+	comparison, err := s.liquidityMgr.CompareRebalance(
+		ctx, btcutil.Amount(in.Amt),
+		lnwire.NewShortChanIDFromInt(in.OutgoingChanId),
+		lnwire.NewShortChanIDFromInt(in.IncomingChanId),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	preferred, err := rpcRebalanceMethod(comparison.Preferred)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientrpc.CompareRebalanceResponse{
+		RebalanceAvailable: comparison.RebalanceAvailable,
+		RebalanceFeeSat:    uint64(comparison.RebalanceFee),
+		RebalanceHops:      uint32(comparison.RebalanceHops),
+		SwapAvailable:      comparison.SwapAvailable,
+		SwapFeeSat:         uint64(comparison.SwapFee),
+		SwapMinerFeeSat:    uint64(comparison.SwapMinerFee),
+		SwapRoutingFeeSat:  uint64(comparison.SwapRoutingFee),
+		SwapCostSat:        uint64(comparison.SwapCost()),
+		Preferred:          preferred,
+	}, nil
+}
+
+// CloseAdvice reports the swaps that involve a channel that is to be closed,
+// and whether we recommend waiting for them to complete before closing it.
+func (s *swapClientServer) CloseAdvice(ctx context.Context,
+	in *clientrpc.CloseAdviceRequest) (*clientrpc.CloseAdviceResponse,
+	error) {
+
+	if in.ChannelId == 0 {
+		return nil, status.Error(
+			codes.InvalidArgument, "channel id must be set",
+		)
+	}
+
+	advice, err := s.liquidityMgr.CloseAdvice(
+		ctx, lnwire.NewShortChanIDFromInt(in.ChannelId),
+	)
+	switch err {
+	case liquidity.ErrUnknownChannel:
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case nil:
+
+	default:
+		return nil, err
+	}
+
+	resp := &clientrpc.CloseAdviceResponse{
+		Wait:        advice.Wait,
+		SunkCostSat: uint64(advice.SunkCost),
+		SuggestedLoopOut: make(
+			[]*clientrpc.LoopOutRequest, len(advice.SuggestedOut),
 		),
-		LoopIn: make(
-			[]*clientrpc.LoopInRequest, len(suggestions.InSwaps),
+		SuggestedLoopIn: make(
+			[]*clientrpc.LoopInRequest, len(advice.SuggestedIn),
+		),
+	}
+
+	// Our in-memory cache holds all of our swaps, so we look up the swaps
+	// in flight there.
+	inFlight, err := s.cachedSwaps(advice.InFlight)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, swp := range inFlight {
+		swp := swp
+
+		rpcSwap, err := s.marshallSwap(&swp)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.InFlight = append(resp.InFlight, rpcSwap)
+	}
+
+	for i, swap := range advice.SuggestedOut {
+		resp.SuggestedLoopOut[i] = newRPCLoopOutRequest(swap)
+	}
+
+	for i, swap := range advice.SuggestedIn {
+		resp.SuggestedLoopIn[i] = newRPCLoopInRequest(swap)
+	}
+
+	return resp, nil
+}
+
+// GetAutoloopStats returns the metrics of our most recent autoloop ticks,
+// along with aggregates over all of the ticks that we have stored.
+func (s *swapClientServer) GetAutoloopStats(_ context.Context,
+	req *clientrpc.AutoloopStatsRequest) (*clientrpc.AutoloopStatsResponse,
+	error) {
+
+	ticks, err := s.impl.Store.FetchAutoloopTicks()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &clientrpc.AutoloopStatsResponse{
+		TickCount: uint32(len(ticks)),
+	}
+
+	var total time.Duration
+	for _, tick := range ticks {
+		total += tick.Duration
+
+		duration := uint64(tick.Duration.Milliseconds())
+		if duration > resp.MaxDurationMs {
+			resp.MaxDurationMs = duration
+		}
+
+		if tick.Error != "" {
+			resp.FailedTicks++
+		}
+	}
+
+	if len(ticks) > 0 {
+		resp.MeanDurationMs = uint64(
+			(total / time.Duration(len(ticks))).Milliseconds(),
+		)
+	}
+
+	// Our ticks are stored from oldest to newest, so we run through them
+	// backwards to return the most recent first.
+	for i := len(ticks) - 1; i >= 0; i-- {
+		if req.MaxTicks != 0 && len(resp.Ticks) == int(req.MaxTicks) {
+			break
+		}
+
+		tick := ticks[i]
+		resp.Ticks = append(resp.Ticks, &clientrpc.AutoloopTick{
+			StartTime:         tick.Start.Unix(),
+			DurationMs:        uint64(tick.Duration.Milliseconds()),
+			ChannelsEvaluated: tick.ChannelsEvaluated,
+			QuotesFetched:     tick.QuotesFetched,
+			SwapsDispatched:   tick.SwapsDispatched,
+			Error:             tick.Error,
+		})
+	}
+
+	return resp, nil
+}
+
+// GetSuggestionHistory returns our stored autoloop suggestion rounds that
+// started within the time range requested, most recent first.
+func (s *swapClientServer) GetSuggestionHistory(_ context.Context,
+	req *clientrpc.SuggestionHistoryRequest) (
+	*clientrpc.SuggestionHistoryResponse, error) {
+
+	if req.EndTime != 0 && req.EndTime < req.StartTime {
+		return nil, status.Error(
+			codes.InvalidArgument, "end time before start time",
+		)
+	}
+
+	rounds, err := s.impl.Store.FetchSuggestionRounds()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &clientrpc.SuggestionHistoryResponse{}
+
+	// Our rounds are stored from oldest to newest, so we run through them
+	// backwards to return the most recent first.
+	for i := len(rounds) - 1; i >= 0; i-- {
+		if req.MaxRounds != 0 && len(resp.Rounds) == int(req.MaxRounds) {
+			break
+		}
+
+		round := rounds[i]
+
+		start := round.Time.Unix()
+		if start < req.StartTime {
+			break
+		}
+
+		if req.EndTime != 0 && start >= req.EndTime {
+			continue
+		}
+
+		rpcRound, err := rpcSuggestionRound(round)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Rounds = append(resp.Rounds, rpcRound)
+	}
+
+	return resp, nil
+}
+
+// GetAutoloopCalendar returns the actions that autoloop plans to take within
+// the horizon requested.
+func (s *swapClientServer) GetAutoloopCalendar(_ context.Context,
+	req *clientrpc.AutoloopCalendarRequest) (
+	*clientrpc.AutoloopCalendarResponse, error) {
+
+	horizon := liquidity.DefaultCalendarHorizon
+	if req.HorizonSec != 0 {
+		horizon = time.Duration(req.HorizonSec) * time.Second
+	}
+
+	events, err := s.liquidityMgr.Calendar(horizon)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &clientrpc.AutoloopCalendarResponse{
+		Events: make([]*clientrpc.CalendarEvent, len(events)),
+	}
+
+	for i, event := range events {
+		eventType, err := rpcCalendarEventType(event.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Events[i] = &clientrpc.CalendarEvent{
+			Type:      eventType,
+			StartTime: event.Start.Unix(),
+			EndTime:   event.End.Unix(),
+			Summary:   event.Summary,
+			Amt:       int64(event.Amount),
+		}
+	}
+
+	if req.Ical {
+		resp.Ical = liquidity.ICalendar(events, time.Now())
+	}
+
+	return resp, nil
+}
+
+// rpcCalendarEventType converts a calendar event type to its rpc
+// representation.
+func rpcCalendarEventType(eventType liquidity.CalendarEventType) (
+	clientrpc.CalendarEventType, error) {
+
+	switch eventType {
+	case liquidity.CalendarDispatchWindow:
+		return clientrpc.CalendarEventType_DISPATCH_WINDOW, nil
+
+	case liquidity.CalendarDeferredSwap:
+		return clientrpc.CalendarEventType_DEFERRED_SWAP, nil
+
+	case liquidity.CalendarApprovalExpiry:
+		return clientrpc.CalendarEventType_APPROVAL_EXPIRY, nil
+
+	case liquidity.CalendarBudgetRefresh:
+		return clientrpc.CalendarEventType_BUDGET_REFRESH, nil
+
+	case liquidity.CalendarRuleExpiry:
+		return clientrpc.CalendarEventType_RULE_EXPIRY, nil
+
+	default:
+		return 0, fmt.Errorf("unknown calendar event type: %v",
+			eventType)
+	}
+}
+
+// rpcSuggestionRound converts a stored suggestion round to its rpc
+// representation.
+func rpcSuggestionRound(round *loopdb.SuggestionRound) (
+	*clientrpc.SuggestionRound, error) {
+
+	rpcRound := &clientrpc.SuggestionRound{
+		StartTime:  round.Time.Unix(),
+		Dispatch:   round.Dispatch,
+		LoopOut:    rpcSuggestedSwaps(round.LoopOut),
+		LoopIn:     rpcSuggestedSwaps(round.LoopIn),
+		Rebalances: rpcSuggestedSwaps(round.Rebalances),
+		Error:      round.Error,
+	}
+
+	for _, target := range round.Disqualified {
+		reason, err := rpcAutoloopReason(
+			liquidity.Reason(target.Reason),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		disqualified := &clientrpc.Disqualified{
+			ChannelId: target.Channel,
+			GroupName: target.Group,
+			Reason:    reason,
+		}
+
+		if target.Peer != nil {
+			disqualified.Pubkey = target.Peer[:]
+		}
+
+		rpcRound.Disqualified = append(
+			rpcRound.Disqualified, disqualified,
+		)
+	}
+
+	return rpcRound, nil
+}
+
+// rpcSuggestedSwaps converts a set of stored suggested swaps to their rpc
+// representation.
+func rpcSuggestedSwaps(set []*loopdb.SuggestedSwap) []*clientrpc.SuggestedSwap {
+	rpcSwaps := make([]*clientrpc.SuggestedSwap, len(set))
+	for i, suggested := range set {
+		rpcSwaps[i] = &clientrpc.SuggestedSwap{
+			Channels:   suggested.Channels,
+			Amt:        int64(suggested.Amount),
+			MaxFees:    int64(suggested.Fees),
+			Dispatched: suggested.Dispatched,
+		}
+
+		if suggested.LastHop != nil {
+			rpcSwaps[i].LastHop = suggested.LastHop[:]
+		}
+	}
+
+	return rpcSwaps
+}
+
+// GetSimulatedSwaps returns the swaps that autoloop simulated within the time
+// range requested, most recent first, along with their totals by swap type.
+func (s *swapClientServer) GetSimulatedSwaps(_ context.Context,
+	req *clientrpc.SimulatedSwapsRequest) (*clientrpc.SimulatedSwapsResponse,
+	error) {
+
+	if req.EndTime != 0 && req.EndTime < req.StartTime {
+		return nil, status.Error(
+			codes.InvalidArgument, "end time before start time",
+		)
+	}
+
+	swaps, err := s.impl.Store.FetchSimulatedSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &clientrpc.SimulatedSwapsResponse{
+		LoopOut:   &clientrpc.SimulationTotals{},
+		LoopIn:    &clientrpc.SimulationTotals{},
+		Rebalance: &clientrpc.SimulationTotals{},
+	}
+
+	totals := map[loopdb.SimulatedSwapType]*clientrpc.SimulationTotals{
+		loopdb.SimulatedLoopOut:   resp.LoopOut,
+		loopdb.SimulatedLoopIn:    resp.LoopIn,
+		loopdb.SimulatedRebalance: resp.Rebalance,
+	}
+
+	// Our swaps are stored from oldest to newest, so we run through them
+	// backwards to return the most recent first.
+	for i := len(swaps) - 1; i >= 0; i-- {
+		simulated := swaps[i]
+
+		timestamp := simulated.Time.Unix()
+		if timestamp < req.StartTime {
+			break
+		}
+
+		if req.EndTime != 0 && timestamp >= req.EndTime {
+			continue
+		}
+
+		swapType, err := rpcSimulatedSwapType(simulated.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcSwap := &clientrpc.SimulatedSwap{
+			Timestamp:  timestamp,
+			Type:       swapType,
+			Channels:   simulated.Channels,
+			Amt:        int64(simulated.Amount),
+			SwapFee:    int64(simulated.SwapFee),
+			MinerFee:   int64(simulated.MinerFee),
+			RoutingFee: int64(simulated.RoutingFee),
+		}
+
+		if simulated.LastHop != nil {
+			rpcSwap.LastHop = simulated.LastHop[:]
+		}
+
+		typeTotals := totals[simulated.Type]
+		typeTotals.Count++
+		typeTotals.Amt += int64(simulated.Amount)
+		typeTotals.Cost += int64(simulated.Cost())
+
+		resp.Swaps = append(resp.Swaps, rpcSwap)
+	}
+
+	return resp, nil
+}
+
+// rpcSimulatedSwapType converts a simulated swap type to its rpc
+// representation.
+func rpcSimulatedSwapType(swapType loopdb.SimulatedSwapType) (
+	clientrpc.SimulatedSwapType, error) {
+
+	switch swapType {
+	case loopdb.SimulatedLoopOut:
+		return clientrpc.SimulatedSwapType_SIMULATED_LOOP_OUT, nil
+
+	case loopdb.SimulatedLoopIn:
+		return clientrpc.SimulatedSwapType_SIMULATED_LOOP_IN, nil
+
+	case loopdb.SimulatedRebalance:
+		return clientrpc.SimulatedSwapType_SIMULATED_REBALANCE, nil
+
+	default:
+		return 0, fmt.Errorf("unknown simulated swap type: %v",
+			swapType)
+	}
+}
+
+// ClearSimulatedSwaps removes all of our simulated swaps, so that a new
+// simulation starts from scratch.
+func (s *swapClientServer) ClearSimulatedSwaps(_ context.Context,
+	_ *clientrpc.ClearSimulatedSwapsRequest) (
+	*clientrpc.ClearSimulatedSwapsResponse, error) {
+
+	if err := s.impl.Store.ClearSimulatedSwaps(); err != nil {
+		return nil, err
+	}
+	s.liquidityMgr.ResetSimulation()
+
+	return &clientrpc.ClearSimulatedSwapsResponse{}, nil
+}
+
+// ListApprovals returns the swaps that autoloop has queued for approval.
+func (s *swapClientServer) ListApprovals(_ context.Context,
+	_ *clientrpc.ListApprovalsRequest) (*clientrpc.ListApprovalsResponse,
+	error) {
+
+	approvals := s.liquidityMgr.ListApprovals()
+
+	resp := &clientrpc.ListApprovalsResponse{
+		Approvals: make(
+			[]*clientrpc.PendingApproval, len(approvals),
 		),
 	}
 
-	for i, swap := range suggestions.OutSwaps {
-		resp.LoopOut[i] = &clientrpc.LoopOutRequest{
-			Amt:                 int64(swap.Amount),
-			OutgoingChanSet:     swap.OutgoingChanSet,
-			MaxSwapFee:          int64(swap.MaxSwapFee),
-			MaxMinerFee:         int64(swap.MaxMinerFee),
-			MaxPrepayAmt:        int64(swap.MaxPrepayAmount),
-			MaxSwapRoutingFee:   int64(swap.MaxSwapRoutingFee),
-			MaxPrepayRoutingFee: int64(swap.MaxPrepayRoutingFee),
-			SweepConfTarget:     swap.SweepConfTarget,
+	for i, approval := range approvals {
+		rpcApproval := &clientrpc.PendingApproval{
+			Id:          approval.ID,
+			CreatedTime: approval.Created.Unix(),
+		}
+
+		if !approval.Expiry.IsZero() {
+			rpcApproval.ExpiryTime = approval.Expiry.Unix()
+		}
+
+		switch {
+		case approval.LoopOut != nil:
+			rpcApproval.LoopOut = newRPCLoopOutRequest(
+				*approval.LoopOut,
+			)
+
+		case approval.LoopIn != nil:
+			rpcApproval.LoopIn = newRPCLoopInRequest(
+				*approval.LoopIn,
+			)
+
+		case approval.Rebalance != nil:
+			rpcApproval.Rebalance = newRPCRebalanceSuggestion(
+				*approval.Rebalance,
+			)
 		}
+
+		resp.Approvals[i] = rpcApproval
 	}
 
-	for i, swap := range suggestions.InSwaps {
-		loopIn := &clientrpc.LoopInRequest{
-			Amt:            int64(swap.Amount),
-			MaxSwapFee:     int64(swap.MaxSwapFee),
-			MaxMinerFee:    int64(swap.MaxMinerFee),
-			HtlcConfTarget: swap.HtlcConfTarget,
+	return resp, nil
+}
+
+// ApproveSwap dispatches a swap that autoloop has queued for approval.
+func (s *swapClientServer) ApproveSwap(ctx context.Context,
+	req *clientrpc.ApproveSwapRequest) (*clientrpc.ApproveSwapResponse,
+	error) {
+
+	hash, err := s.liquidityMgr.ApproveSwap(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientrpc.ApproveSwapResponse{
+		IdBytes: hash[:],
+	}, nil
+}
+
+// RejectSwap removes a swap that autoloop has queued for approval from the
+// queue without dispatching it.
+func (s *swapClientServer) RejectSwap(_ context.Context,
+	req *clientrpc.RejectSwapRequest) (*clientrpc.RejectSwapResponse,
+	error) {
+
+	if err := s.liquidityMgr.RejectSwap(req.Id); err != nil {
+		return nil, err
+	}
+
+	return &clientrpc.RejectSwapResponse{}, nil
+}
+
+// rpcRebalanceMethod converts a rebalance method to its rpc representation.
+func rpcRebalanceMethod(method liquidity.RebalanceMethod) (
+	clientrpc.RebalanceMethod, error) {
+
+	switch method {
+	case liquidity.RebalanceMethodNone:
+		return clientrpc.RebalanceMethod_REBALANCE_METHOD_NONE, nil
+
+	case liquidity.RebalanceMethodCircular:
+		return clientrpc.RebalanceMethod_REBALANCE_METHOD_CIRCULAR, nil
+
+	case liquidity.RebalanceMethodSwap:
+		return clientrpc.RebalanceMethod_REBALANCE_METHOD_SWAP, nil
+
+	default:
+		return 0, fmt.Errorf("unknown rebalance method: %v", method)
+	}
+}
+
+// GetServerNotices returns the operational notices that the swap server has
+// published.
+func (s *swapClientServer) GetServerNotices(ctx context.Context,
+	req *clientrpc.ServerNoticesRequest) (*clientrpc.ServerNoticesResponse,
+	error) {
+
+	log.Infof("Server notices request received")
+
+	notices, err := s.impl.ServerNotices(ctx)
+	if err != nil {
+		log.Errorf("Server notices request: %v", err)
+		return nil, err
+	}
+
+	var (
+		now  = time.Now()
+		resp = &clientrpc.ServerNoticesResponse{}
+	)
+
+	for _, notice := range notices {
+		if !req.IncludeInactive && !notice.Active(now) {
+			continue
+		}
+
+		resp.Notices = append(resp.Notices, rpcServerNotice(notice, now))
+	}
+
+	return resp, nil
+}
+
+// rpcServerNotice converts a server notice to its rpc representation.
+func rpcServerNotice(notice *loop.ServerNotice,
+	now time.Time) *clientrpc.ServerNotice {
+
+	var category clientrpc.NoticeCategory
+	switch notice.Category {
+	case loop.NoticeMaintenance:
+		category = clientrpc.NoticeCategory_NOTICE_MAINTENANCE
+
+	case loop.NoticeFeeChange:
+		category = clientrpc.NoticeCategory_NOTICE_FEE_CHANGE
+
+	default:
+		category = clientrpc.NoticeCategory_NOTICE_GENERAL
+	}
+
+	rpcNotice := &clientrpc.ServerNotice{
+		Id:       notice.ID,
+		Category: category,
+		Message:  notice.Message,
+		Active:   notice.Active(now),
+	}
+
+	if !notice.Start.IsZero() {
+		rpcNotice.StartUnix = notice.Start.Unix()
+	}
+
+	if !notice.End.IsZero() {
+		rpcNotice.EndUnix = notice.End.Unix()
+	}
+
+	return rpcNotice
+}
+
+// DebugLevel sets the log level of all or individual subsystems at runtime, or
+// lists the subsystems that log levels can be set for.
+func (s *swapClientServer) DebugLevel(_ context.Context,
+	req *clientrpc.DebugLevelRequest) (*clientrpc.DebugLevelResponse,
+	error) {
+
+	if logWriter == nil {
+		return nil, status.Error(
+			codes.FailedPrecondition, "logging is not set up",
+		)
+	}
+
+	if req.Show {
+		return &clientrpc.DebugLevelResponse{
+			SubSystems: logWriter.SupportedSubsystems(),
+		}, nil
+	}
+
+	log.Infof("Debug level request received: %v", req.LevelSpec)
+
+	err := build.ParseAndSetDebugLevels(req.LevelSpec, logWriter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &clientrpc.DebugLevelResponse{}, nil
+}
+
+// ReplaySwap reconstructs the full timeline of a swap from our store, lnd's
+// records and the chain.
+func (s *swapClientServer) ReplaySwap(ctx context.Context,
+	req *clientrpc.ReplaySwapRequest) (*clientrpc.ReplaySwapResponse,
+	error) {
+
+	swapHash, err := lntypes.MakeHash(req.Id)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "error parsing swap hash: %v",
+			err,
+		)
+	}
+
+	log.Infof("Replay swap request received: %v", swapHash)
+
+	replay, err := s.impl.ReplaySwap(ctx, swapHash)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcSwap, err := s.marshallSwap(replay.Swap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clientrpc.ReplaySwapResponse{
+		Swap:          rpcSwap,
+		CurrentHeight: uint32(replay.CurrentHeight),
+		Events:        rpcReplayEvents(replay.Events),
+	}, nil
+}
+
+// rpcReplayEvents converts the events of a swap's timeline to their rpc
+// representation.
+func rpcReplayEvents(events []*loop.ReplayEvent) []*clientrpc.ReplayEvent {
+	rpcEvents := make([]*clientrpc.ReplayEvent, len(events))
+	for i, event := range events {
+		rpcEvents[i] = &clientrpc.ReplayEvent{
+			Height:      uint32(event.Height),
+			Source:      rpcReplaySource(event.Source),
+			Description: event.Description,
+			Failure:     event.Failure,
 		}
 
-		if swap.LastHop != nil {
-			loopIn.LastHop = swap.LastHop[:]
+		if !event.Time.IsZero() {
+			rpcEvents[i].TimestampNs = event.Time.UnixNano()
 		}
 
-		resp.LoopIn[i] = loopIn
+		if event.TxHash != nil {
+			rpcEvents[i].Txid = event.TxHash.String()
+		}
 	}
 
-	for id, reason := range suggestions.DisqualifiedChans {
-		autoloopReason, err := rpcAutoloopReason(reason)
+	return rpcEvents
+}
+
+// GetSwapStateGraph exports the state machine of a swap type, or of the
+// swap requested along with the path that it took.
+func (s *swapClientServer) GetSwapStateGraph(_ context.Context,
+	req *clientrpc.SwapStateGraphRequest) (
+	*clientrpc.SwapStateGraphResponse, error) {
+
+	var swapHash *lntypes.Hash
+	if len(req.Id) != 0 {
+		hash, err := lntypes.MakeHash(req.Id)
 		if err != nil {
-			return nil, err
+			return nil, status.Errorf(
+				codes.InvalidArgument, "error parsing swap "+
+					"hash: %v", err,
+			)
 		}
 
-		exclChan := &clientrpc.Disqualified{
-			Reason:    autoloopReason,
-			ChannelId: id.ToUint64(),
+		swapHash = &hash
+	}
+
+	log.Infof("Swap state graph request received")
+
+	swapType := swap.TypeOut
+	if req.SwapType == clientrpc.SwapType_LOOP_IN {
+		swapType = swap.TypeIn
+	}
+
+	var format loop.StateGraphFormat
+	switch req.Format {
+	case clientrpc.StateGraphFormat_STATE_GRAPH_FORMAT_DOT:
+		format = loop.StateGraphDOT
+
+	case clientrpc.StateGraphFormat_STATE_GRAPH_FORMAT_MERMAID:
+		format = loop.StateGraphMermaid
+
+	default:
+		return nil, status.Errorf(
+			codes.InvalidArgument, "unknown state graph format: "+
+				"%v", req.Format,
+		)
+	}
+
+	graph, err := s.impl.SwapStateGraph(swapHash, swapType, format)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &clientrpc.SwapStateGraphResponse{
+		SwapType: clientrpc.SwapType_LOOP_OUT,
+		Graph:    graph.Graph,
+		Transitions: make(
+			[]*clientrpc.StateTransition, len(graph.Transitions),
+		),
+		Path:      make([]string, len(graph.Path)),
+		Reachable: make([]string, len(graph.Reachable)),
+	}
+
+	if graph.SwapType == swap.TypeIn {
+		resp.SwapType = clientrpc.SwapType_LOOP_IN
+	}
+
+	for i, transition := range graph.Transitions {
+		resp.Transitions[i] = &clientrpc.StateTransition{
+			From: transition.From.String(),
+			To:   transition.To.String(),
 		}
+	}
 
-		resp.Disqualified = append(resp.Disqualified, exclChan)
+	for i, state := range graph.Path {
+		resp.Path[i] = state.String()
 	}
 
-	for pubkey, reason := range suggestions.DisqualifiedPeers {
-		autoloopReason, err := rpcAutoloopReason(reason)
+	for i, state := range graph.Reachable {
+		resp.Reachable[i] = state.String()
+	}
+
+	return resp, nil
+}
+
+// GetPostMortems returns the post-mortems of the swaps that have exceeded
+// their maximum lifetime.
+func (s *swapClientServer) GetPostMortems(_ context.Context,
+	_ *clientrpc.PostMortemsRequest) (*clientrpc.PostMortemsResponse,
+	error) {
+
+	log.Infof("Post-mortems request received")
+
+	if s.lifetime == nil {
+		return nil, status.Error(
+			codes.FailedPrecondition, "swap lifetimes are not "+
+				"limited, set maxloopoutlifetime or "+
+				"maxloopinlifetime",
+		)
+	}
+
+	postMortems := s.lifetime.PostMortems()
+	resp := &clientrpc.PostMortemsResponse{
+		PostMortems: make([]*clientrpc.PostMortem, len(postMortems)),
+	}
+
+	for i, postMortem := range postMortems {
+		rpcSwap, err := s.marshallSwap(postMortem.Swap)
 		if err != nil {
 			return nil, err
 		}
 
-		exclChan := &clientrpc.Disqualified{
-			Reason: autoloopReason,
-			Pubkey: pubkey[:],
+		rpcPostMortem := &clientrpc.PostMortem{
+			Swap:           rpcSwap,
+			CreatedAt:      postMortem.CreatedAt.UnixNano(),
+			AgeSec:         uint64(postMortem.Age.Seconds()),
+			MaxLifetimeSec: uint64(postMortem.MaxLifetime.Seconds()),
+			LastErrors:     rpcReplayEvents(postMortem.LastErrors),
+			ReplayError:    postMortem.ReplayError,
 		}
 
-		resp.Disqualified = append(resp.Disqualified, exclChan)
+		if replay := postMortem.Replay; replay != nil {
+			height := uint32(replay.CurrentHeight)
+			rpcPostMortem.CurrentHeight = height
+			rpcPostMortem.BlocksUntilExpiry =
+				postMortem.BlocksUntilExpiry()
+			rpcPostMortem.Events = rpcReplayEvents(replay.Events)
+		}
+
+		resp.PostMortems[i] = rpcPostMortem
 	}
 
 	return resp, nil
 }
 
+// rpcReplaySource converts the source of a replay event to its rpc
+// equivalent.
+func rpcReplaySource(source loop.ReplaySource) clientrpc.ReplayEventSource {
+	switch source {
+	case loop.ReplaySourcePayment:
+		return clientrpc.ReplayEventSource_REPLAY_SOURCE_PAYMENT
+
+	case loop.ReplaySourceInvoice:
+		return clientrpc.ReplayEventSource_REPLAY_SOURCE_INVOICE
+
+	case loop.ReplaySourceChain:
+		return clientrpc.ReplayEventSource_REPLAY_SOURCE_CHAIN
+
+	default:
+		return clientrpc.ReplayEventSource_REPLAY_SOURCE_STORE
+	}
+}
+
 func rpcAutoloopReason(reason liquidity.Reason) (clientrpc.AutoReason, error) {
 	switch reason {
 	case liquidity.ReasonNone:
@@ -1067,6 +3606,65 @@ func rpcAutoloopReason(reason liquidity.Reason) (clientrpc.AutoReason, error) {
 	case liquidity.ReasonFeePPMInsufficient:
 		return clientrpc.AutoReason_AUTO_REASON_SWAP_FEE, nil
 
+	case liquidity.ReasonVBytesBudget:
+		return clientrpc.AutoReason_AUTO_REASON_VBYTE_BUDGET, nil
+
+	case liquidity.ReasonQuoteTimeout:
+		return clientrpc.AutoReason_AUTO_REASON_QUOTE_TIMEOUT, nil
+
+	case liquidity.ReasonRuleBudget:
+		return clientrpc.AutoReason_AUTO_REASON_RULE_BUDGET, nil
+
+	case liquidity.ReasonRuleExpired:
+		return clientrpc.AutoReason_AUTO_REASON_RULE_EXPIRED, nil
+
+	case liquidity.ReasonDirectionCooldown:
+		return clientrpc.AutoReason_AUTO_REASON_DIRECTION_COOLDOWN, nil
+
+	case liquidity.ReasonExcluded:
+		return clientrpc.AutoReason_AUTO_REASON_EXCLUDED, nil
+
+	case liquidity.ReasonNoChannels:
+		return clientrpc.AutoReason_AUTO_REASON_NO_CHANNELS, nil
+
+	case liquidity.ReasonReserveInsufficient:
+		return clientrpc.AutoReason_AUTO_REASON_RESERVE_INSUFFICIENT,
+			nil
+
+	case liquidity.ReasonBelowMinimum:
+		return clientrpc.AutoReason_AUTO_REASON_BELOW_MINIMUM, nil
+
+	case liquidity.ReasonSuccessCooldown:
+		return clientrpc.AutoReason_AUTO_REASON_SUCCESS_COOLDOWN, nil
+
+	case liquidity.ReasonSwapRateLimit:
+		return clientrpc.AutoReason_AUTO_REASON_SWAP_RATE_LIMIT, nil
+
+	case liquidity.ReasonServerMaintenance:
+		return clientrpc.AutoReason_AUTO_REASON_SERVER_MAINTENANCE, nil
+
+	case liquidity.ReasonPeerOffline:
+		return clientrpc.AutoReason_AUTO_REASON_PEER_OFFLINE, nil
+
+	case liquidity.ReasonHtlcLimits:
+		return clientrpc.AutoReason_AUTO_REASON_HTLC_LIMITS, nil
+
+	case liquidity.ReasonChannelAge:
+		return clientrpc.AutoReason_AUTO_REASON_CHANNEL_AGE, nil
+
+	case liquidity.ReasonFlowRefill:
+		return clientrpc.AutoReason_AUTO_REASON_FLOW_REFILL, nil
+
+	case liquidity.ReasonInsufficientRevenue:
+		return clientrpc.AutoReason_AUTO_REASON_INSUFFICIENT_REVENUE,
+			nil
+
+	case liquidity.ReasonPrivateChannel:
+		return clientrpc.AutoReason_AUTO_REASON_PRIVATE_CHANNEL, nil
+
+	case liquidity.ReasonRuleConflict:
+		return clientrpc.AutoReason_AUTO_REASON_RULE_CONFLICT, nil
+
 	default:
 		return 0, fmt.Errorf("unknown autoloop reason: %v", reason)
 	}
@@ -1083,6 +3681,13 @@ func (s *swapClientServer) processStatusUpdates(mainCtx context.Context) {
 		// subscribers about the changes.
 		case swp := <-s.statusChan:
 			s.swapsLock.Lock()
+
+			// Notes may be edited while a swap is in flight, so
+			// the swap's own copy of its contract could be stale.
+			// Our cache always holds the latest notes.
+			if cached, ok := s.swaps[swp.SwapHash]; ok {
+				swp.Notes = cached.Notes
+			}
 			s.swaps[swp.SwapHash] = swp
 
 			for _, subscriber := range s.subscribers {
@@ -1096,6 +3701,17 @@ func (s *swapClientServer) processStatusUpdates(mainCtx context.Context) {
 
 			s.swapsLock.Unlock()
 
+			// We deliver our own copy of the update to the
+			// notification manager once we have released our lock,
+			// so that rpcs that need the lock are not blocked while
+			// the manager is busy.
+			if s.notifier != nil {
+				err := s.notifier.SwapUpdate(mainCtx, swp)
+				if err != nil {
+					return
+				}
+			}
+
 		// Server is shutting down.
 		case <-mainCtx.Done():
 			return
@@ -1140,6 +3756,33 @@ func validateLoopInRequest(htlcConfTarget int32, external bool) (int32, error) {
 	return validateConfTarget(htlcConfTarget, loop.DefaultHtlcConfTarget)
 }
 
+// validateMaxTotalCost returns the total cost ceiling of a swap in satoshis.
+// The ceiling may be set as an absolute amount or in parts per million of the
+// swap amount, but not both. A zero ceiling indicates that the swap has none.
+func validateMaxTotalCost(amt, costSat int64, costPPM uint64) (btcutil.Amount,
+	error) {
+
+	switch {
+	case costSat != 0 && costPPM != 0:
+		return 0, errors.New("max total cost sat and ppm cannot both " +
+			"be set")
+
+	case costSat < 0:
+		return 0, errors.New("max total cost must be >= 0")
+
+	case costPPM > liquidity.FeeBase:
+		return 0, fmt.Errorf("max total cost ppm must be <= %v",
+			liquidity.FeeBase)
+
+	case costPPM != 0:
+		return btcutil.Amount(uint64(amt) * costPPM / liquidity.FeeBase),
+			nil
+
+	default:
+		return btcutil.Amount(costSat), nil
+	}
+}
+
 // validateLoopOutRequest validates the confirmation target, destination
 // address and label of the loop out request. It also checks that the requested
 // loop amount is valid given the available balance.
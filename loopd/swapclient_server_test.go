@@ -7,9 +7,11 @@ import (
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/liquidity"
 	"github.com/lightninglabs/loop/looprpc"
 	mock_lnd "github.com/lightninglabs/loop/test"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -209,6 +211,65 @@ func TestValidateLoopInRequest(t *testing.T) {
 	}
 }
 
+// TestValidateMaxTotalCost tests resolving of the total cost ceiling that is
+// set on swap requests.
+func TestValidateMaxTotalCost(t *testing.T) {
+	tests := []struct {
+		name      string
+		costSat   int64
+		costPPM   uint64
+		expected  btcutil.Amount
+		expectErr bool
+	}{
+		{
+			name: "no ceiling",
+		},
+		{
+			name:     "absolute ceiling",
+			costSat:  500,
+			expected: 500,
+		},
+		{
+			name:     "ppm ceiling",
+			costPPM:  10000,
+			expected: 1000,
+		},
+		{
+			name:      "both set",
+			costSat:   500,
+			costPPM:   10000,
+			expectErr: true,
+		},
+		{
+			name:      "negative ceiling",
+			costSat:   -1,
+			expectErr: true,
+		},
+		{
+			name:      "ppm too high",
+			costPPM:   1000001,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			maxCost, err := validateMaxTotalCost(
+				100000, test.costSat, test.costPPM,
+			)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, maxCost)
+		})
+	}
+}
+
 // TestValidateLoopOutRequest tests validation of loop out requests.
 func TestValidateLoopOutRequest(t *testing.T) {
 	tests := []struct {
@@ -568,3 +629,136 @@ func TestHasBandwidth(t *testing.T) {
 		})
 	}
 }
+
+// TestRPCToSweepDestination tests parsing of the sweep destinations of rpc
+// rules.
+func TestRPCToSweepDestination(t *testing.T) {
+	newXpub := func(params *chaincfg.Params) *hdkeychain.ExtendedKey {
+		seed := make([]byte, hdkeychain.RecommendedSeedLen)
+		master, err := hdkeychain.NewMaster(seed, params)
+		require.NoError(t, err)
+
+		xpub, err := master.Neuter()
+		require.NoError(t, err)
+
+		return xpub
+	}
+
+	testnetXpub := newXpub(&chaincfg.TestNet3Params)
+	mainnetXpub := newXpub(&chaincfg.MainNetParams)
+
+	// Our verifier does not require verification, so fixed addresses
+	// that are not signed for are accepted without a verification.
+	verifier := loop.NewDestVerifier(
+		nil, 0, false, &chaincfg.TestNet3Params,
+	)
+
+	// When verification is required, rule xpubs must be one of our
+	// configured xpubs.
+	requiredVerifier := loop.NewDestVerifier(
+		[]*hdkeychain.ExtendedKey{testnetXpub}, 0, true,
+		&chaincfg.TestNet3Params,
+	)
+
+	otherXpub := newXpub(&chaincfg.TestNet3Params)
+	otherXpub, err := otherXpub.Derive(1)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		rule     *looprpc.LiquidityRule
+		verifier *loop.DestVerifier
+		dest     *liquidity.SweepDestination
+		fail     bool
+	}{
+		{
+			name: "no destination",
+			rule: &looprpc.LiquidityRule{},
+		},
+		{
+			name: "address",
+			rule: &looprpc.LiquidityRule{
+				SweepAddress: testnetAddr.String(),
+			},
+			dest: &liquidity.SweepDestination{
+				Address: testnetAddr,
+			},
+		},
+		{
+			name: "address for other network",
+			rule: &looprpc.LiquidityRule{
+				SweepAddress: mainnetAddr.String(),
+			},
+			fail: true,
+		},
+		{
+			name: "xpub",
+			rule: &looprpc.LiquidityRule{
+				SweepXpub: testnetXpub.String(),
+			},
+			dest: &liquidity.SweepDestination{
+				Xpub: testnetXpub,
+			},
+		},
+		{
+			name: "configured xpub",
+			rule: &looprpc.LiquidityRule{
+				SweepXpub: testnetXpub.String(),
+			},
+			verifier: requiredVerifier,
+			dest: &liquidity.SweepDestination{
+				Xpub:         testnetXpub,
+				XpubVerified: true,
+			},
+		},
+		{
+			name: "unconfigured xpub",
+			rule: &looprpc.LiquidityRule{
+				SweepXpub: otherXpub.String(),
+			},
+			verifier: requiredVerifier,
+			fail:     true,
+		},
+		{
+			name: "signature without address",
+			rule: &looprpc.LiquidityRule{
+				SweepXpub:             testnetXpub.String(),
+				SweepAddressSignature: "signature",
+			},
+			fail: true,
+		},
+		{
+			name: "invalid address signature",
+			rule: &looprpc.LiquidityRule{
+				SweepAddress:          testnetAddr.String(),
+				SweepAddressSignature: "signature",
+			},
+			fail: true,
+		},
+		{
+			name: "xpub for other network",
+			rule: &looprpc.LiquidityRule{
+				SweepXpub: mainnetXpub.String(),
+			},
+			fail: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			testVerifier := verifier
+			if test.verifier != nil {
+				testVerifier = test.verifier
+			}
+
+			dest, err := rpcToSweepDestination(
+				test.rule, &chaincfg.TestNet3Params,
+				testVerifier,
+			)
+			require.Equal(t, test.fail, err != nil)
+			require.Equal(t, test.dest, dest)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package loopd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// sdNotifySocketEnv is the environment variable that systemd sets to
+	// the socket that services send their state notifications to.
+	sdNotifySocketEnv = "NOTIFY_SOCKET"
+
+	// sdWatchdogUsecEnv is the environment variable that systemd sets to
+	// the watchdog timeout of a service, in microseconds.
+	sdWatchdogUsecEnv = "WATCHDOG_USEC"
+
+	// sdWatchdogPidEnv is the environment variable that systemd sets to
+	// the pid of the process that the watchdog applies to.
+	sdWatchdogPidEnv = "WATCHDOG_PID"
+
+	// sdReady notifies systemd that we have started up.
+	sdReady = "READY=1"
+
+	// sdStopping notifies systemd that we are shutting down.
+	sdStopping = "STOPPING=1"
+
+	// sdWatchdog is the keep-alive that we send to systemd's watchdog.
+	sdWatchdog = "WATCHDOG=1"
+
+	// startupRetryInterval is the amount of time that we wait between
+	// attempts to reach lnd or the swap server on startup.
+	startupRetryInterval = time.Second * 10
+)
+
+// sdNotify sends a state notification to systemd over the socket in our
+// environment. It returns false if we were not started by systemd with
+// notifications enabled, in which case no notification is sent.
+func sdNotify(state string) (bool, error) {
+	socket := os.Getenv(sdNotifySocketEnv)
+	if socket == "" {
+		return false, nil
+	}
+
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{
+		Name: socket,
+		Net:  "unixgram",
+	})
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte(state)); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// sdWatchdogInterval returns the timeout of the watchdog that systemd runs
+// for us, or zero if the watchdog is not enabled for our process.
+func sdWatchdogInterval() (time.Duration, error) {
+	usecStr := os.Getenv(sdWatchdogUsecEnv)
+	if usecStr == "" {
+		return 0, nil
+	}
+
+	usec, err := strconv.ParseInt(usecStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %v: %v", sdWatchdogUsecEnv, err)
+	}
+
+	if usec <= 0 {
+		return 0, fmt.Errorf("invalid %v: %v", sdWatchdogUsecEnv, usec)
+	}
+
+	// If the watchdog is set for a specific process, it is only enabled
+	// if that process is us.
+	if pidStr := os.Getenv(sdWatchdogPidEnv); pidStr != "" {
+		pid, err := strconv.Atoi(pidStr)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %v: %v", sdWatchdogPidEnv,
+				err)
+		}
+
+		if pid != os.Getpid() {
+			return 0, nil
+		}
+	}
+
+	return time.Duration(usec) * time.Microsecond, nil
+}
+
+// retryStartup calls the function provided until it succeeds, up to the
+// number of attempts provided, waiting for the interval provided between
+// attempts. It returns the last error if all attempts fail, or early if the
+// quit channel is closed.
+func retryStartup(name string, attempts uint32, interval time.Duration,
+	quit <-chan struct{}, f func() error) error {
+
+	var err error
+	for i := uint32(1); ; i++ {
+		err = f()
+		if err == nil || i >= attempts {
+			return err
+		}
+
+		log.Warnf("Could not connect to %v (attempt %v of %v), "+
+			"retrying in %v: %v", name, i, attempts, interval, err)
+
+		select {
+		case <-time.After(interval):
+
+		case <-quit:
+			return fmt.Errorf("shutdown while connecting to %v: %v",
+				name, err)
+		}
+	}
+}
+
+// notifyService waits until we can reach the swap server, and then notifies
+// systemd that we are ready and keeps its watchdog alive until the context
+// provided is canceled. It does nothing if we were not started by systemd
+// with notifications enabled.
+func (d *Daemon) notifyService(ctx context.Context) {
+	if os.Getenv(sdNotifySocketEnv) == "" {
+		return
+	}
+
+	// Our database migrations have completed and lnd is connected by the
+	// time we start, but our swap server connection is only established
+	// by our first call to it.
+	err := retryStartup(
+		"swap server", d.cfg.StartupAttempts, startupRetryInterval,
+		ctx.Done(), func() error {
+			_, err := d.impl.LoopOutTerms(ctx)
+			return err
+		},
+	)
+	if err != nil {
+		log.Errorf("Swap server unavailable, not notifying systemd "+
+			"of readiness: %v", err)
+		return
+	}
+
+	if _, err := sdNotify(sdReady); err != nil {
+		log.Errorf("Could not notify systemd of readiness: %v", err)
+		return
+	}
+	log.Infof("Notified systemd of readiness")
+
+	interval, err := sdWatchdogInterval()
+	if err != nil {
+		log.Errorf("Systemd watchdog not enabled: %v", err)
+	}
+
+	// Systemd expects keep-alives within its watchdog timeout, so we send
+	// them at half the timeout.
+	var watchdog <-chan time.Time
+	if interval > 0 {
+		log.Infof("Sending systemd watchdog keep-alives every %v",
+			interval/2)
+
+		ticker := time.NewTicker(interval / 2)
+		defer ticker.Stop()
+
+		watchdog = ticker.C
+	}
+
+	for {
+		select {
+		case <-watchdog:
+			if _, err := sdNotify(sdWatchdog); err != nil {
+				log.Errorf("Could not send systemd watchdog "+
+					"keep-alive: %v", err)
+			}
+
+		case <-ctx.Done():
+			if _, err := sdNotify(sdStopping); err != nil {
+				log.Errorf("Could not notify systemd of "+
+					"shutdown: %v", err)
+			}
+
+			return
+		}
+	}
+}
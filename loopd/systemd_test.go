@@ -0,0 +1,140 @@
+package loopd
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btclog"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSdNotify tests sending notifications to a systemd notification socket.
+func TestSdNotify(t *testing.T) {
+	// If we are not run by systemd, no notification is sent.
+	require.NoError(t, os.Unsetenv(sdNotifySocketEnv))
+
+	sent, err := sdNotify(sdReady)
+	require.NoError(t, err)
+	require.False(t, sent)
+
+	socket := filepath.Join(t.TempDir(), "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{
+		Name: socket,
+		Net:  "unixgram",
+	})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, os.Setenv(sdNotifySocketEnv, socket))
+	defer os.Unsetenv(sdNotifySocketEnv)
+
+	sent, err = sdNotify(sdReady)
+	require.NoError(t, err)
+	require.True(t, sent)
+
+	buf := make([]byte, 64)
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, sdReady, string(buf[:n]))
+}
+
+// TestSdWatchdogInterval tests reading our watchdog timeout from the
+// environment.
+func TestSdWatchdogInterval(t *testing.T) {
+	defer os.Unsetenv(sdWatchdogUsecEnv)
+	defer os.Unsetenv(sdWatchdogPidEnv)
+
+	tests := []struct {
+		name     string
+		usec     string
+		pid      string
+		interval time.Duration
+		err      bool
+	}{
+		{
+			name: "not enabled",
+		},
+		{
+			name:     "enabled",
+			usec:     "30000000",
+			interval: time.Second * 30,
+		},
+		{
+			name:     "our pid",
+			usec:     "30000000",
+			pid:      strconv.Itoa(os.Getpid()),
+			interval: time.Second * 30,
+		},
+		{
+			name: "other pid",
+			usec: "30000000",
+			pid:  strconv.Itoa(os.Getpid() + 1),
+		},
+		{
+			name: "invalid timeout",
+			usec: "0",
+			err:  true,
+		},
+	}
+
+	for _, testCase := range tests {
+		require.NoError(t, os.Setenv(sdWatchdogUsecEnv, testCase.usec))
+		require.NoError(t, os.Setenv(sdWatchdogPidEnv, testCase.pid))
+
+		interval, err := sdWatchdogInterval()
+		if testCase.err {
+			require.Error(t, err, testCase.name)
+			continue
+		}
+
+		require.NoError(t, err, testCase.name)
+		require.Equal(t, testCase.interval, interval, testCase.name)
+	}
+}
+
+// TestRetryStartup tests that we retry startup connections up to our number
+// of attempts, and stop retrying on shutdown.
+func TestRetryStartup(t *testing.T) {
+	// Our logger is only set up when loopd runs, so we disable it for
+	// our failed attempts.
+	log = btclog.Disabled
+
+	errUnreachable := errors.New("unreachable")
+
+	var calls int
+	connect := func(succeedAt int) func() error {
+		calls = 0
+
+		return func() error {
+			calls++
+			if calls == succeedAt {
+				return nil
+			}
+
+			return errUnreachable
+		}
+	}
+
+	quit := make(chan struct{})
+
+	// We succeed on our last attempt.
+	err := retryStartup("lnd", 3, time.Millisecond, quit, connect(3))
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	// We give up after our last attempt.
+	err = retryStartup("lnd", 2, time.Millisecond, quit, connect(3))
+	require.Equal(t, errUnreachable, err)
+	require.Equal(t, 2, calls)
+
+	// We stop retrying when we shut down.
+	close(quit)
+	err = retryStartup("lnd", 3, time.Hour, quit, connect(3))
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+}
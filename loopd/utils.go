@@ -2,11 +2,21 @@ package loopd
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"time"
 
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop"
 	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/notifications"
+	"github.com/lightninglabs/loop/secrets"
 	"github.com/lightninglabs/loop/swap"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/ticker"
@@ -27,8 +37,16 @@ func getClient(config *Config, lnd *lndclient.LndServices) (*loop.Client,
 		LoopOutMaxParts:     config.LoopOutMaxParts,
 		TotalPaymentTimeout: config.TotalPaymentTimeout,
 		MaxPaymentRetries:   config.MaxPaymentRetries,
+		LoopInAlarmDelta:    config.LoopInAlarmDelta,
+		LoopInCancelDelta:   config.LoopInCancelDelta,
 	}
 
+	secretStore, err := getSecretStore(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	clientConfig.SecretStore = secretStore
+
 	swapClient, cleanUp, err := loop.NewClient(config.DataDir, clientConfig)
 	if err != nil {
 		return nil, nil, err
@@ -37,42 +55,275 @@ func getClient(config *Config, lnd *lndclient.LndServices) (*loop.Client,
 	return swapClient, cleanUp, nil
 }
 
-func getLiquidityManager(client *loop.Client) *liquidity.Manager {
+// getDestVerifier returns the verifier for the custom destinations of loop
+// outs, which accepts addresses derived from our configured destination xpubs.
+func getDestVerifier(config *Config,
+	lnd *lndclient.LndServices) (*loop.DestVerifier, error) {
+
+	xpubs := make([]*hdkeychain.ExtendedKey, len(config.DestXpubs))
+	for i, xpubStr := range config.DestXpubs {
+		xpub, err := hdkeychain.NewKeyFromString(xpubStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid destxpub: %v", err)
+		}
+
+		if xpub.IsPrivate() {
+			return nil, errors.New("destxpub must be an extended " +
+				"public key")
+		}
+
+		if !xpub.IsForNet(lnd.ChainParams) {
+			return nil, fmt.Errorf("destxpub is not for network: %v",
+				lnd.ChainParams.Name)
+		}
+
+		xpubs[i] = xpub
+	}
+
+	return loop.NewDestVerifier(
+		xpubs, config.DestXpubLookahead,
+		config.RequireDestVerification, lnd.ChainParams,
+	), nil
+}
+
+// getSecretStore returns the secret store that swap preimages should be held
+// in, or nil if they should be held in our database.
+func getSecretStore(config *Config) (loopdb.SecretStore, error) {
+	switch config.SecretStore {
+	case secretStoreKeychain:
+		return secrets.NewKeychainStore(secrets.DefaultKeychainService)
+
+	case secretStoreVault:
+		return secrets.NewVaultStore(
+			config.Vault.Addr, config.Vault.Token,
+			config.Vault.Mount, config.Vault.Path,
+		), nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func getLiquidityManager(config *Config,
+	client *loop.Client) *liquidity.Manager {
+
+	sources := &liquiditySources{client}
+
 	mngrCfg := &liquidity.Config{
 		AutoloopTicker: ticker.NewForce(liquidity.DefaultAutoloopTicker),
-		LoopOut:        client.LoopOut,
-		LoopIn:         client.LoopIn,
-		Restrictions: func(ctx context.Context,
-			swapType swap.Type) (*liquidity.Restrictions, error) {
-
-			if swapType == swap.TypeOut {
-				outTerms, err := client.Server.GetLoopOutTerms(ctx)
-				if err != nil {
-					return nil, err
-				}
-
-				return liquidity.NewRestrictions(
-					outTerms.MinSwapAmount, outTerms.MaxSwapAmount,
-				), nil
+		Channels: liquidity.NewLndChannelSource(
+			client.LndServices.Client,
+		),
+		Peers:                client.LndServices.Client,
+		Quotes:               sources,
+		Dispatcher:           client,
+		Swaps:                sources,
+		Wallet:               client.LndServices.WalletKit,
+		Graph:                client.LndServices.Client,
+		Node:                 client.LndServices.Client,
+		ChainParams:          client.LndServices.ChainParams,
+		NodePubkey:           client.LndServices.NodePubkey,
+		Clock:                clock.NewDefaultClock(),
+		MinimumConfirmations: minConfTarget,
+		Rebalancer:           sources,
+		Notes:                client,
+		Recorder:             client.Store,
+		Sync:                 client,
+		Notices:              client,
+
+		BudgetIncreaseDelay:     config.BudgetIncreaseDelay,
+		BudgetIncreaseThreshold: config.BudgetIncreaseThreshold,
+	}
+
+	if config.RebalanceHookURL != "" {
+		mngrCfg.PreSwapRebalancer = newRebalanceHook(
+			config.RebalanceHookURL, http.DefaultClient,
+		)
+	}
+
+	return liquidity.NewManager(mngrCfg)
+}
+
+// liquiditySources provides our liquidity manager with the restrictions and
+// quotes of the swap server and the swaps and rebalances in our store. The
+// server calls that our liquidity manager makes have background priority, so
+// that they do not hold up quotes that are requested over rpc.
+type liquiditySources struct {
+	*loop.Client
+}
+
+// Restrictions returns the swap server's minimum and maximum amounts for the
+// swap type provided.
+func (l *liquiditySources) Restrictions(ctx context.Context,
+	swapType swap.Type) (*liquidity.Restrictions, error) {
+
+	ctx = loop.WithServerPriority(ctx, loop.PriorityBackground)
+
+	if swapType == swap.TypeOut {
+		outTerms, err := l.Server.GetLoopOutTerms(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return liquidity.NewRestrictions(
+			outTerms.MinSwapAmount, outTerms.MaxSwapAmount,
+		), nil
+	}
+
+	inTerms, err := l.Server.GetLoopInTerms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return liquidity.NewRestrictions(
+		inTerms.MinSwapAmount, inTerms.MaxSwapAmount,
+	), nil
+}
+
+// LoopOutQuote returns a quote for a loop out.
+func (l *liquiditySources) LoopOutQuote(ctx context.Context,
+	request *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote, error) {
+
+	return l.Client.LoopOutQuote(
+		loop.WithServerPriority(ctx, loop.PriorityBackground), request,
+	)
+}
+
+// LoopInQuote returns a quote for a loop in.
+func (l *liquiditySources) LoopInQuote(ctx context.Context,
+	request *loop.LoopInQuoteRequest) (*loop.LoopInQuote, error) {
+
+	return l.Client.LoopInQuote(
+		loop.WithServerPriority(ctx, loop.PriorityBackground), request,
+	)
+}
+
+// ListLoopOut returns all of the loop outs in our store.
+func (l *liquiditySources) ListLoopOut() ([]*loopdb.LoopOut, error) {
+	return l.Store.FetchLoopOutSwaps()
+}
+
+// ListLoopIn returns all of the loop ins in our store.
+func (l *liquiditySources) ListLoopIn() ([]*loopdb.LoopIn, error) {
+	return l.Store.FetchLoopInSwaps()
+}
+
+// ListRebalances returns all of the circular rebalances in our store.
+func (l *liquiditySources) ListRebalances() ([]*loopdb.Rebalance, error) {
+	return l.Store.FetchRebalances()
+}
+
+// getAccountingChecker returns an accounting checker for our swap client, or
+// nil if accounting checks are not enabled in our config.
+func getAccountingChecker(config *Config,
+	client *loop.Client) *loop.AccountingChecker {
+
+	if config.AccountingCheckInterval == 0 {
+		return nil
+	}
+
+	return loop.NewAccountingChecker(
+		client.CheckAccounting, config.AccountingCheckInterval,
+	)
+}
+
+// getLifetimeChecker returns a checker that flags swaps that exceed the
+// maximum lifetimes in our config, or nil if no maximum lifetime is set.
+// Post-mortems of flagged swaps are delivered to our notifier if it is
+// non-nil.
+func getLifetimeChecker(config *Config, client *loop.Client,
+	notifier *notifications.Manager) *loop.LifetimeChecker {
+
+	if config.MaxLoopOutLifetime == 0 && config.MaxLoopInLifetime == 0 {
+		return nil
+	}
+
+	return loop.NewLifetimeChecker(&loop.LifetimeConfig{
+		MaxLifetime: map[swap.Type]time.Duration{
+			swap.TypeOut: config.MaxLoopOutLifetime,
+			swap.TypeIn:  config.MaxLoopInLifetime,
+		},
+		FetchSwaps: client.FetchSwaps,
+		ReplaySwap: client.ReplaySwap,
+		Notify: func(ctx context.Context,
+			postMortem *loop.PostMortem) {
+
+			if notifier == nil {
+				return
 			}
 
-			inTerms, err := client.Server.GetLoopInTerms(ctx)
+			err := notifier.SwapOverdue(ctx, postMortem)
+			if err != nil {
+				log.Errorf("Could not notify overdue swap: %v",
+					err)
+			}
+		},
+		Clock: clock.NewDefaultClock(),
+	})
+}
+
+// getNotificationManager returns a notification manager for the rules and
+// autoloop webhook in our config, or nil if neither is configured.
+func getNotificationManager(config *notifyConfig, lnd *lndclient.LndServices,
+	budgetStatus func(context.Context) (btcutil.Amount, btcutil.Amount,
+		error)) (*notifications.Manager, error) {
+
+	if len(config.Rules) == 0 && config.AutoloopWebhookURL == "" {
+		return nil, nil
+	}
+
+	transports := make(map[string]notifications.Transport)
+	if config.WebhookURL != "" {
+		webhook := notifications.NewWebhookTransport(config.WebhookURL)
+		transports[webhook.Name()] = webhook
+	}
+
+	if config.SMTPHost != "" {
+		email := &notifications.EmailTransport{
+			Host: config.SMTPHost,
+			From: config.EmailFrom,
+			To:   config.EmailTo,
+		}
+
+		if config.SMTPUser != "" {
+			host, _, err := net.SplitHostPort(config.SMTPHost)
 			if err != nil {
 				return nil, err
 			}
 
-			return liquidity.NewRestrictions(
-				inTerms.MinSwapAmount, inTerms.MaxSwapAmount,
-			), nil
-		},
-		Lnd:                  client.LndServices,
-		Clock:                clock.NewDefaultClock(),
-		LoopOutQuote:         client.LoopOutQuote,
-		LoopInQuote:          client.LoopInQuote,
-		ListLoopOut:          client.Store.FetchLoopOutSwaps,
-		ListLoopIn:           client.Store.FetchLoopInSwaps,
-		MinimumConfirmations: minConfTarget,
+			email.Auth = smtp.PlainAuth(
+				"", config.SMTPUser, config.SMTPPassword, host,
+			)
+		}
+
+		transports[email.Name()] = email
 	}
 
-	return liquidity.NewManager(mngrCfg)
+	// Autoloop events are posted to their own webhook, which is not
+	// available to rules.
+	var autoloopTransport notifications.Transport
+	if config.AutoloopWebhookURL != "" {
+		autoloopTransport = notifications.NewWebhookTransport(
+			config.AutoloopWebhookURL,
+		)
+	}
+
+	rules := make([]*notifications.Rule, len(config.Rules))
+	for i, ruleStr := range config.Rules {
+		rule, err := notifications.ParseRule(ruleStr)
+		if err != nil {
+			return nil, err
+		}
+
+		rules[i] = rule
+	}
+
+	return notifications.NewManager(&notifications.Config{
+		Rules:                  rules,
+		Transports:             transports,
+		RegisterBlockEpochNtfn: lnd.ChainNotifier.RegisterBlockEpochNtfn,
+		BudgetStatus:           budgetStatus,
+		AutoloopTransport:      autoloopTransport,
+		Clock:                  clock.NewDefaultClock(),
+	})
 }
@@ -64,7 +64,7 @@ func viewOut(swapClient *loop.Client, chainParams *chaincfg.Params) error {
 		fmt.Printf("   Created: %v (height %v)\n",
 			s.Contract.InitiationTime, s.Contract.InitiationHeight,
 		)
-		fmt.Printf("   Preimage: %v\n", s.Contract.Preimage)
+		printPreimage(&s.Contract.SwapContract)
 		fmt.Printf("   Htlc address: %v\n", htlc.Address)
 
 		fmt.Printf("   Uncharge channels: %v\n",
@@ -73,6 +73,9 @@ func viewOut(swapClient *loop.Client, chainParams *chaincfg.Params) error {
 		fmt.Printf("   Amt: %v, Expiry: %v\n",
 			s.Contract.AmountRequested, s.Contract.CltvExpiry,
 		)
+		if s.Contract.Notes != "" {
+			fmt.Printf("   Notes: %v\n", s.Contract.Notes)
+		}
 		for i, e := range s.Events {
 			fmt.Printf("   Update %v, Time %v, State: %v",
 				i, e.Time, e.State,
@@ -116,11 +119,14 @@ func viewIn(swapClient *loop.Client, chainParams *chaincfg.Params) error {
 		fmt.Printf("   Created: %v (height %v)\n",
 			s.Contract.InitiationTime, s.Contract.InitiationHeight,
 		)
-		fmt.Printf("   Preimage: %v\n", s.Contract.Preimage)
+		printPreimage(&s.Contract.SwapContract)
 		fmt.Printf("   Htlc address: %v\n", htlc.Address)
 		fmt.Printf("   Amt: %v, Expiry: %v\n",
 			s.Contract.AmountRequested, s.Contract.CltvExpiry,
 		)
+		if s.Contract.Notes != "" {
+			fmt.Printf("   Notes: %v\n", s.Contract.Notes)
+		}
 		for i, e := range s.Events {
 			fmt.Printf("   Update %v, Time %v, State: %v\n",
 				i, e.Time, e.State,
@@ -131,3 +137,14 @@ func viewIn(swapClient *loop.Client, chainParams *chaincfg.Params) error {
 
 	return nil
 }
+
+// printPreimage prints a swap's preimage, or notes that it is held in a
+// secret store if our database does not hold it.
+func printPreimage(contract *loopdb.SwapContract) {
+	if contract.PreimageInSecretStore {
+		fmt.Printf("   Preimage: held in secret store\n")
+		return
+	}
+
+	fmt.Printf("   Preimage: %v\n", contract.Preimage)
+}
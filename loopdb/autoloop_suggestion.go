@@ -0,0 +1,345 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// MaxSuggestionRounds is the number of autoloop suggestion rounds that we
+// keep in the store. Once this limit is reached, the oldest round is removed
+// for each new round that is recorded.
+const MaxSuggestionRounds = 1000
+
+// SuggestedSwap records a swap or circular rebalance that autoloop suggested.
+type SuggestedSwap struct {
+	// Channels is the set of channels that the swap was restricted to.
+	// For loop outs, this is the outgoing channel set, and for
+	// rebalances it contains the outgoing channel followed by the
+	// incoming channel.
+	Channels []uint64
+
+	// LastHop is the peer that a loop in or rebalance was restricted to,
+	// if any.
+	LastHop *route.Vertex
+
+	// Amount is the amount of the swap.
+	Amount btcutil.Amount
+
+	// Fees is the maximum amount of fees that the swap could pay, based on
+	// the quote that we got from the server.
+	Fees btcutil.Amount
+
+	// Dispatched is true if autoloop dispatched the swap.
+	Dispatched bool
+}
+
+// DisqualifiedTarget records a channel, peer or channel group that autoloop
+// did not suggest a swap for.
+type DisqualifiedTarget struct {
+	// Channel is the short channel ID of the channel that was
+	// disqualified, or zero if the target is not a channel.
+	Channel uint64
+
+	// Peer is the peer that was disqualified, if any.
+	Peer *route.Vertex
+
+	// Group is the name of the channel group that was disqualified, or an
+	// empty string if the target is not a group.
+	Group string
+
+	// Reason is the numeric value of the liquidity manager's reason for
+	// not suggesting a swap for the target.
+	Reason uint8
+}
+
+// SuggestionRound records the suggestions made by a single autoloop tick,
+// along with the decision that was made for each of them.
+type SuggestionRound struct {
+	// Time is the time at which the round started.
+	Time time.Time
+
+	// Dispatch is true if autoloop was allowed to dispatch swaps in this
+	// round. If it is false, the round's suggestions were only logged.
+	Dispatch bool
+
+	// LoopOut is the set of loop out swaps that were suggested.
+	LoopOut []*SuggestedSwap
+
+	// LoopIn is the set of loop in swaps that were suggested.
+	LoopIn []*SuggestedSwap
+
+	// Rebalances is the set of circular rebalances that were suggested.
+	Rebalances []*SuggestedSwap
+
+	// Disqualified is the set of targets that swaps were not suggested
+	// for.
+	Disqualified []*DisqualifiedTarget
+
+	// Error is the error that the round failed with, or an empty string if
+	// it succeeded.
+	Error string
+}
+
+// writeFields writes a set of fixed size fields to the writer provided.
+func writeFields(w io.Writer, fields ...interface{}) error {
+	for _, field := range fields {
+		if err := binary.Write(w, byteOrder, field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readFields reads a set of fixed size fields from the reader provided.
+func readFields(r io.Reader, fields ...interface{}) error {
+	for _, field := range fields {
+		if err := binary.Read(r, byteOrder, field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeString writes a length prefixed string.
+func writeString(w io.Writer, s string) error {
+	if err := writeFields(w, uint32(len(s))); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, s)
+	return err
+}
+
+// readString reads a length prefixed string.
+func readString(r io.Reader) (string, error) {
+	var length uint32
+	if err := readFields(r, &length); err != nil {
+		return "", err
+	}
+
+	b := make([]byte, length)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// writeVertex writes an optional vertex, prefixed with a flag indicating
+// whether it is present.
+func writeVertex(w io.Writer, vertex *route.Vertex) error {
+	if vertex == nil {
+		return writeFields(w, false)
+	}
+
+	return writeFields(w, true, *vertex)
+}
+
+// readVertex reads an optional vertex.
+func readVertex(r io.Reader) (*route.Vertex, error) {
+	var present bool
+	if err := readFields(r, &present); err != nil {
+		return nil, err
+	}
+
+	if !present {
+		return nil, nil
+	}
+
+	var vertex route.Vertex
+	if err := readFields(r, &vertex); err != nil {
+		return nil, err
+	}
+
+	return &vertex, nil
+}
+
+// writeSuggestedSwaps writes a count prefixed set of suggested swaps.
+func writeSuggestedSwaps(w io.Writer, swaps []*SuggestedSwap) error {
+	if err := writeFields(w, uint32(len(swaps))); err != nil {
+		return err
+	}
+
+	for _, swap := range swaps {
+		err := writeFields(w, uint32(len(swap.Channels)), swap.Channels)
+		if err != nil {
+			return err
+		}
+
+		if err := writeVertex(w, swap.LastHop); err != nil {
+			return err
+		}
+
+		err = writeFields(
+			w, int64(swap.Amount), int64(swap.Fees),
+			swap.Dispatched,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readSuggestedSwaps reads a count prefixed set of suggested swaps.
+func readSuggestedSwaps(r io.Reader) ([]*SuggestedSwap, error) {
+	var count uint32
+	if err := readFields(r, &count); err != nil {
+		return nil, err
+	}
+
+	var swaps []*SuggestedSwap
+	for i := uint32(0); i < count; i++ {
+		var (
+			swap        = &SuggestedSwap{}
+			chanCount   uint32
+			amount, fee int64
+			err         error
+		)
+
+		if err := readFields(r, &chanCount); err != nil {
+			return nil, err
+		}
+
+		if chanCount != 0 {
+			swap.Channels = make([]uint64, chanCount)
+			if err := readFields(r, swap.Channels); err != nil {
+				return nil, err
+			}
+		}
+
+		swap.LastHop, err = readVertex(r)
+		if err != nil {
+			return nil, err
+		}
+
+		err = readFields(r, &amount, &fee, &swap.Dispatched)
+		if err != nil {
+			return nil, err
+		}
+
+		swap.Amount = btcutil.Amount(amount)
+		swap.Fees = btcutil.Amount(fee)
+
+		swaps = append(swaps, swap)
+	}
+
+	return swaps, nil
+}
+
+// serializeSuggestionRound serializes an autoloop suggestion round.
+func serializeSuggestionRound(round *SuggestionRound) ([]byte, error) {
+	var b bytes.Buffer
+
+	err := writeFields(&b, round.Time.UnixNano(), round.Dispatch)
+	if err != nil {
+		return nil, err
+	}
+
+	swapSets := [][]*SuggestedSwap{
+		round.LoopOut, round.LoopIn, round.Rebalances,
+	}
+	for _, swaps := range swapSets {
+		if err := writeSuggestedSwaps(&b, swaps); err != nil {
+			return nil, err
+		}
+	}
+
+	err = writeFields(&b, uint32(len(round.Disqualified)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, target := range round.Disqualified {
+		if err := writeFields(&b, target.Channel); err != nil {
+			return nil, err
+		}
+
+		if err := writeVertex(&b, target.Peer); err != nil {
+			return nil, err
+		}
+
+		if err := writeString(&b, target.Group); err != nil {
+			return nil, err
+		}
+
+		if err := writeFields(&b, target.Reason); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writeString(&b, round.Error); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// deserializeSuggestionRound deserializes an autoloop suggestion round.
+func deserializeSuggestionRound(value []byte) (*SuggestionRound, error) {
+	var (
+		r     = bytes.NewReader(value)
+		round = &SuggestionRound{}
+		start int64
+		err   error
+	)
+
+	if err := readFields(r, &start, &round.Dispatch); err != nil {
+		return nil, err
+	}
+	round.Time = time.Unix(0, start)
+
+	swapSets := []*[]*SuggestedSwap{
+		&round.LoopOut, &round.LoopIn, &round.Rebalances,
+	}
+	for _, swaps := range swapSets {
+		*swaps, err = readSuggestedSwaps(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var count uint32
+	if err := readFields(r, &count); err != nil {
+		return nil, err
+	}
+
+	for i := uint32(0); i < count; i++ {
+		target := &DisqualifiedTarget{}
+
+		if err := readFields(r, &target.Channel); err != nil {
+			return nil, err
+		}
+
+		target.Peer, err = readVertex(r)
+		if err != nil {
+			return nil, err
+		}
+
+		target.Group, err = readString(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := readFields(r, &target.Reason); err != nil {
+			return nil, err
+		}
+
+		round.Disqualified = append(round.Disqualified, target)
+	}
+
+	round.Error, err = readString(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return round, nil
+}
@@ -0,0 +1,109 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// MaxAutoloopTicks is the number of autoloop ticks that we keep in the store.
+// Once this limit is reached, the oldest tick is removed for each new tick
+// that is recorded.
+const MaxAutoloopTicks = 1000
+
+// AutoloopTick records the duration and work done by a single autoloop tick.
+type AutoloopTick struct {
+	// Start is the time at which the tick started.
+	Start time.Time
+
+	// Duration is the amount of time that the tick took.
+	Duration time.Duration
+
+	// ChannelsEvaluated is the number of channels that the tick assessed
+	// for swaps.
+	ChannelsEvaluated uint32
+
+	// QuotesFetched is the number of swap quotes that the tick requested
+	// from the server.
+	QuotesFetched uint32
+
+	// SwapsDispatched is the number of swaps and circular rebalances that
+	// the tick dispatched.
+	SwapsDispatched uint32
+
+	// Error is the error that the tick failed with, or an empty string if
+	// it succeeded.
+	Error string
+}
+
+// autoloopTickKey returns the key that we store a tick under, which orders
+// our ticks by start time.
+func autoloopTickKey(start time.Time) []byte {
+	var key [8]byte
+	byteOrder.PutUint64(key[:], uint64(start.UnixNano()))
+
+	return key[:]
+}
+
+// serializeAutoloopTick serializes an autoloop tick.
+func serializeAutoloopTick(tick *AutoloopTick) ([]byte, error) {
+	var b bytes.Buffer
+
+	fields := []interface{}{
+		tick.Start.UnixNano(),
+		int64(tick.Duration),
+		tick.ChannelsEvaluated,
+		tick.QuotesFetched,
+		tick.SwapsDispatched,
+		uint32(len(tick.Error)),
+	}
+
+	for _, field := range fields {
+		if err := binary.Write(&b, byteOrder, field); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := b.WriteString(tick.Error); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// deserializeAutoloopTick deserializes an autoloop tick.
+func deserializeAutoloopTick(value []byte) (*AutoloopTick, error) {
+	var (
+		r               = bytes.NewReader(value)
+		tick            = &AutoloopTick{}
+		start, duration int64
+		errLen          uint32
+	)
+
+	fields := []interface{}{
+		&start,
+		&duration,
+		&tick.ChannelsEvaluated,
+		&tick.QuotesFetched,
+		&tick.SwapsDispatched,
+		&errLen,
+	}
+
+	for _, field := range fields {
+		if err := binary.Read(r, byteOrder, field); err != nil {
+			return nil, err
+		}
+	}
+
+	errBytes := make([]byte, errLen)
+	if _, err := io.ReadFull(r, errBytes); err != nil {
+		return nil, err
+	}
+
+	tick.Start = time.Unix(0, start)
+	tick.Duration = time.Duration(duration)
+	tick.Error = string(errBytes)
+
+	return tick, nil
+}
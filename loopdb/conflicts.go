@@ -0,0 +1,172 @@
+package loopdb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+var (
+	// conflictedSwapBucketKey is a bucket that holds the records of swaps
+	// that were removed from our active swaps when resolving a conflict.
+	// We keep these records rather than deleting them so that no swap
+	// data is lost when a conflict is resolved.
+	//
+	// maps: swap type key -> swapHash -> swap bucket
+	conflictedSwapBucketKey = []byte("conflicted-swaps")
+
+	// ErrSwapExists is returned when we try to create a swap with a hash
+	// that is already used by a loop out or loop in swap in the store.
+	ErrSwapExists = errors.New("swap already exists")
+
+	// ErrNoSwapConflict is returned when we try to resolve a conflict for
+	// a swap hash that is not shared by a loop out and a loop in swap.
+	ErrNoSwapConflict = errors.New("swap has no conflicting records")
+)
+
+// SwapConflict describes a swap hash that is used by both a loop out and a
+// loop in swap in the store. This should never happen in normal operation,
+// but can occur when a restored backup is merged with a live database.
+type SwapConflict struct {
+	// Hash is the swap hash shared by both records.
+	Hash lntypes.Hash
+
+	// LoopOut is the loop out swap that uses the hash.
+	LoopOut *LoopOut
+
+	// LoopIn is the loop in swap that uses the hash.
+	LoopIn *LoopIn
+}
+
+// FindSwapConflicts returns the swap hashes that are used by both a loop out
+// and a loop in swap in the set of swaps provided, ordered by loop out.
+func FindSwapConflicts(loopOuts []*LoopOut,
+	loopIns []*LoopIn) []*SwapConflict {
+
+	loopInsByHash := make(map[lntypes.Hash]*LoopIn, len(loopIns))
+	for _, loopIn := range loopIns {
+		loopInsByHash[loopIn.Hash] = loopIn
+	}
+
+	var conflicts []*SwapConflict
+	for _, loopOut := range loopOuts {
+		loopIn, ok := loopInsByHash[loopOut.Hash]
+		if !ok {
+			continue
+		}
+
+		conflicts = append(conflicts, &SwapConflict{
+			Hash:    loopOut.Hash,
+			LoopOut: loopOut,
+			LoopIn:  loopIn,
+		})
+	}
+
+	return conflicts
+}
+
+// swapExists returns a boolean indicating whether a loop out or loop in swap
+// with the hash provided is present in the store.
+func swapExists(tx *bbolt.Tx, hash lntypes.Hash) bool {
+	for _, key := range [][]byte{loopOutBucketKey, loopInBucketKey} {
+		rootBucket := tx.Bucket(key)
+		if rootBucket == nil {
+			continue
+		}
+
+		if rootBucket.Bucket(hash[:]) != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ResolveSwapConflict resolves a conflict between a loop out and a loop in
+// swap that share the same hash by keeping the swap of the type provided. The
+// record of the other swap is moved to the conflicted swaps bucket so that it
+// is no longer loaded with our active swaps.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) ResolveSwapConflict(hash lntypes.Hash,
+	keep swap.Type) error {
+
+	removeKey := loopInBucketKey
+	if keep == swap.TypeIn {
+		removeKey = loopOutBucketKey
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		loopOutBucket := tx.Bucket(loopOutBucketKey)
+		loopInBucket := tx.Bucket(loopInBucketKey)
+		if loopOutBucket == nil || loopInBucket == nil {
+			return ErrNoSwapConflict
+		}
+
+		if loopOutBucket.Bucket(hash[:]) == nil ||
+			loopInBucket.Bucket(hash[:]) == nil {
+
+			return ErrNoSwapConflict
+		}
+
+		conflictedBucket, err := tx.CreateBucketIfNotExists(
+			conflictedSwapBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		typeBucket, err := conflictedBucket.CreateBucketIfNotExists(
+			removeKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		// If we have previously set aside a record for this hash, we
+		// replace it with the record that we are removing now.
+		if typeBucket.Bucket(hash[:]) != nil {
+			if err := typeBucket.DeleteBucket(hash[:]); err != nil {
+				return err
+			}
+		}
+
+		archived, err := typeBucket.CreateBucket(hash[:])
+		if err != nil {
+			return err
+		}
+
+		removeBucket := tx.Bucket(removeKey)
+		err = copyBucket(removeBucket.Bucket(hash[:]), archived)
+		if err != nil {
+			return fmt.Errorf("could not set aside swap %v: %v",
+				hash, err)
+		}
+
+		return removeBucket.DeleteBucket(hash[:])
+	})
+}
+
+// copyBucket copies all of the keys and nested buckets in the source bucket
+// to the destination bucket.
+func copyBucket(src, dst *bbolt.Bucket) error {
+	return src.ForEach(func(k, v []byte) error {
+		// A nil value indicates that the key is a nested bucket.
+		if v == nil {
+			nested, err := dst.CreateBucket(k)
+			if err != nil {
+				return err
+			}
+
+			return copyBucket(src.Bucket(k), nested)
+		}
+
+		value := make([]byte, len(v))
+		copy(value, v)
+
+		return dst.Put(k, value)
+	})
+}
@@ -3,6 +3,8 @@ package loopdb
 import (
 	"time"
 
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/swap"
 	"github.com/lightningnetwork/lnd/lntypes"
 )
 
@@ -33,6 +35,64 @@ type SwapStore interface {
 	UpdateLoopIn(hash lntypes.Hash, time time.Time,
 		state SwapStateData) error
 
+	// SetLoopOutNotes replaces the free-text notes attached to a loop out
+	// swap. An empty string clears the notes.
+	SetLoopOutNotes(hash lntypes.Hash, notes string) error
+
+	// SetLoopInNotes replaces the free-text notes attached to a loop in
+	// swap. An empty string clears the notes.
+	SetLoopInNotes(hash lntypes.Hash, notes string) error
+
+	// ResolveSwapConflict resolves a conflict between a loop out and a
+	// loop in swap that share a hash by keeping the swap of the type
+	// provided and setting aside the record of the other swap.
+	ResolveSwapConflict(hash lntypes.Hash, keep swap.Type) error
+
+	// FetchRebalances returns all circular rebalances currently in the
+	// store.
+	FetchRebalances() ([]*Rebalance, error)
+
+	// CreateRebalance adds an initiated circular rebalance to the store.
+	CreateRebalance(hash lntypes.Hash, contract *RebalanceContract) error
+
+	// UpdateRebalance updates the state of a circular rebalance, along
+	// with the routing fee that it paid.
+	UpdateRebalance(hash lntypes.Hash, time time.Time,
+		state RebalanceState, fee btcutil.Amount) error
+
+	// FetchWatchedSwaps returns all of the swaps initiated by other loop
+	// clients that we monitor.
+	FetchWatchedSwaps() ([]*WatchedSwap, error)
+
+	// CreateWatchedSwap adds a swap initiated by another loop client to
+	// our watch list.
+	CreateWatchedSwap(watched *WatchedSwap) error
+
+	// RecordAutoloopTick stores the metrics of an autoloop tick.
+	RecordAutoloopTick(tick *AutoloopTick) error
+
+	// FetchAutoloopTicks returns the autoloop ticks in the store, ordered
+	// from oldest to newest.
+	FetchAutoloopTicks() ([]*AutoloopTick, error)
+
+	// RecordSuggestionRound stores the suggestions made by an autoloop
+	// tick.
+	RecordSuggestionRound(round *SuggestionRound) error
+
+	// FetchSuggestionRounds returns the autoloop suggestion rounds in the
+	// store, ordered from oldest to newest.
+	FetchSuggestionRounds() ([]*SuggestionRound, error)
+
+	// RecordSimulatedSwaps stores a set of swaps that autoloop simulated.
+	RecordSimulatedSwaps(swaps []*SimulatedSwap) error
+
+	// FetchSimulatedSwaps returns the simulated swaps in the store,
+	// ordered from oldest to newest.
+	FetchSimulatedSwaps() ([]*SimulatedSwap, error)
+
+	// ClearSimulatedSwaps removes all simulated swaps from the store.
+	ClearSimulatedSwaps() error
+
 	// Close closes the underlying database.
 	Close() error
 }
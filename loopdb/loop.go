@@ -12,9 +12,17 @@ import (
 // SwapContract contains the base data that is serialized to persistent storage
 // for pending swaps.
 type SwapContract struct {
-	// Preimage is the preimage for the swap.
+	// Preimage is the preimage for the swap. It is empty if the preimage
+	// is held in a secret store and was not read from it.
 	Preimage lntypes.Preimage
 
+	// PreimageInSecretStore indicates that the swap's preimage is held in
+	// a secret store rather than our database. Our secret swap store only
+	// reads the preimages of pending swaps back, so completed swaps that
+	// have this flag set have an empty preimage. The flag is not part of
+	// the serialized contract, because it was added later on.
+	PreimageInSecretStore bool
+
 	// AmountRequested is the total amount of the swap.
 	AmountRequested btcutil.Amount
 
@@ -37,6 +45,12 @@ type SwapContract struct {
 	// spend.
 	MaxMinerFee btcutil.Amount
 
+	// MaxTotalCost is an optional ceiling on the total cost of the swap,
+	// which covers the server fee, off-chain routing fees and on-chain
+	// fees. It is zero if the swap has no ceiling. The ceiling is not part
+	// of the serialized contract, because it was added later on.
+	MaxTotalCost btcutil.Amount
+
 	// InitiationHeight is the block height at which the swap was
 	// initiated.
 	InitiationHeight int32
@@ -47,6 +61,11 @@ type SwapContract struct {
 	// Label contains an optional label for the swap.
 	Label string
 
+	// Notes contains optional free-text notes that the operator attached
+	// to the swap. Notes are not part of the serialized contract and may
+	// be edited after the swap was created.
+	Notes string
+
 	// ProtocolVersion stores the protocol version when the swap was
 	// created.
 	ProtocolVersion ProtocolVersion
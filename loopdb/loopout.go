@@ -57,6 +57,55 @@ type LoopOutContract struct {
 	// allow the server to delay the publication in exchange for possibly
 	// lower fees.
 	SwapPublicationDeadline time.Time
+
+	// CustomRecords is an optional set of custom tlv records that are
+	// attached to the swap and prepay payments, keyed by record type.
+	CustomRecords map[uint64][]byte
+
+	// DestVerification records how we verified that a custom destination
+	// address is owned by the user. It is nil if the swap sweeps to our
+	// wallet, or its destination was not verified.
+	DestVerification *DestVerification
+}
+
+// DestVerificationMethod is the method that was used to verify that the
+// destination address of a loop out is owned by the user.
+type DestVerificationMethod uint8
+
+const (
+	// DestVerificationSignature indicates that ownership was verified with
+	// a message signed by the key of the destination address.
+	DestVerificationSignature DestVerificationMethod = 1
+
+	// DestVerificationXpub indicates that the destination address was
+	// derived from an extended public key that is known to be ours.
+	DestVerificationXpub DestVerificationMethod = 2
+)
+
+// String returns the string representation of a verification method.
+func (m DestVerificationMethod) String() string {
+	switch m {
+	case DestVerificationSignature:
+		return "signature"
+
+	case DestVerificationXpub:
+		return "xpub"
+
+	default:
+		return "unknown"
+	}
+}
+
+// DestVerification records how the destination address of a loop out was
+// verified to be owned by the user.
+type DestVerification struct {
+	// Method is the method that the destination was verified with.
+	Method DestVerificationMethod
+
+	// Proof is the evidence that the destination was verified with. It is
+	// the base64 encoded signature for signature verification, or the
+	// xpub and derivation path of the address for xpub verification.
+	Proof string
 }
 
 // ChannelSet stores a set of channels.
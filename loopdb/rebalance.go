@@ -0,0 +1,162 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// RebalanceState indicates the current state of a circular rebalance.
+type RebalanceState uint8
+
+const (
+	// RebalanceStateInitiated is the initial state of a rebalance, which
+	// is set before its payment is dispatched.
+	RebalanceStateInitiated RebalanceState = 0
+
+	// RebalanceStateSuccess indicates that the rebalance payment
+	// succeeded.
+	RebalanceStateSuccess RebalanceState = 1
+
+	// RebalanceStateFailed indicates that the rebalance payment failed.
+	RebalanceStateFailed RebalanceState = 2
+)
+
+// String returns the string representation of a rebalance state.
+func (r RebalanceState) String() string {
+	switch r {
+	case RebalanceStateInitiated:
+		return "Initiated"
+
+	case RebalanceStateSuccess:
+		return "Success"
+
+	case RebalanceStateFailed:
+		return "Failed"
+
+	default:
+		return "Unknown"
+	}
+}
+
+// IsPending returns true if the rebalance has not reached a final state.
+func (r RebalanceState) IsPending() bool {
+	return r == RebalanceStateInitiated
+}
+
+// RebalanceContract contains the immutable parameters of a circular rebalance,
+// which is an off-chain payment from one of our channels back to ourselves
+// through another one of our channels.
+type RebalanceContract struct {
+	// InitiationTime is the time at which the rebalance was initiated.
+	InitiationTime time.Time
+
+	// Amount is the amount that the rebalance shifts between our
+	// channels.
+	Amount btcutil.Amount
+
+	// MaxFee is the maximum routing fee that the rebalance may pay.
+	MaxFee btcutil.Amount
+
+	// OutgoingChannel is the short channel ID of the channel that the
+	// rebalance payment leaves over.
+	OutgoingChannel uint64
+
+	// IncomingChannel is the short channel ID of the channel that the
+	// rebalance payment arrives over.
+	IncomingChannel uint64
+
+	// Autoloop indicates that the rebalance was dispatched by autoloop,
+	// rather than requested manually.
+	Autoloop bool
+}
+
+// Rebalance is a circular rebalance along with its current state.
+type Rebalance struct {
+	RebalanceContract
+
+	// Hash is the payment hash of the rebalance's invoice.
+	Hash lntypes.Hash
+
+	// State is the current state of the rebalance.
+	State RebalanceState
+
+	// Fee is the routing fee that the rebalance paid. It is only set once
+	// the rebalance succeeded.
+	Fee btcutil.Amount
+
+	// LastUpdate is the time of the rebalance's last state update.
+	LastUpdate time.Time
+}
+
+// serializeRebalance serializes a rebalance's contract and state.
+func serializeRebalance(rebalance *Rebalance) ([]byte, error) {
+	var b bytes.Buffer
+
+	fields := []interface{}{
+		rebalance.InitiationTime.UnixNano(),
+		rebalance.Amount,
+		rebalance.MaxFee,
+		rebalance.OutgoingChannel,
+		rebalance.IncomingChannel,
+		rebalance.State,
+		rebalance.Fee,
+		rebalance.LastUpdate.UnixNano(),
+		rebalance.Autoloop,
+	}
+
+	for _, field := range fields {
+		if err := binary.Write(&b, byteOrder, field); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// deserializeRebalance deserializes a rebalance that is stored under the hash
+// provided.
+func deserializeRebalance(hash lntypes.Hash, value []byte) (*Rebalance,
+	error) {
+
+	var (
+		r                  = bytes.NewReader(value)
+		rebalance          = &Rebalance{Hash: hash}
+		initiated, updated int64
+	)
+
+	fields := []interface{}{
+		&initiated,
+		&rebalance.Amount,
+		&rebalance.MaxFee,
+		&rebalance.OutgoingChannel,
+		&rebalance.IncomingChannel,
+		&rebalance.State,
+		&rebalance.Fee,
+		&updated,
+	}
+
+	for _, field := range fields {
+		if err := binary.Read(r, byteOrder, field); err != nil {
+			return nil, err
+		}
+	}
+
+	// Rebalances that were stored before we recorded whether autoloop
+	// dispatched them do not have this field, and are treated as manual
+	// rebalances.
+	if r.Len() > 0 {
+		err := binary.Read(r, byteOrder, &rebalance.Autoloop)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rebalance.InitiationTime = time.Unix(0, initiated)
+	rebalance.LastUpdate = time.Unix(0, updated)
+
+	return rebalance, nil
+}
@@ -0,0 +1,246 @@
+package loopdb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+var (
+	// ErrSecretNotFound is returned by a secret store when it does not
+	// hold a preimage for the hash provided.
+	ErrSecretNotFound = errors.New("secret not found")
+
+	// ErrPreimageInSecretStore is returned when we try to create a swap
+	// whose preimage is held in a secret store without a secret store.
+	ErrPreimageInSecretStore = errors.New("swaps with preimages held in " +
+		"a secret store must be created with a secret store")
+
+	// ErrNoSecretStore is returned when we have pending swaps whose
+	// preimages are held in a secret store, but no secret store is
+	// configured to read them from.
+	ErrNoSecretStore = errors.New("pending swaps hold their preimages " +
+		"in a secret store, but no secret store is configured")
+)
+
+// SecretStore is an interface implemented by the external systems that swap
+// preimages may be held in, so that they are not stored in plaintext in our
+// database.
+type SecretStore interface {
+	// Name returns the name of the secret store.
+	Name() string
+
+	// StorePreimage stores the preimage for the swap hash provided.
+	StorePreimage(hash lntypes.Hash, preimage lntypes.Preimage) error
+
+	// FetchPreimage returns the preimage for the swap hash provided, or
+	// ErrSecretNotFound if the store does not hold it.
+	FetchPreimage(hash lntypes.Hash) (lntypes.Preimage, error)
+}
+
+// secretSwapStore wraps our bolt store, holding the preimages of new swaps in
+// a secret store rather than the underlying store.
+type secretSwapStore struct {
+	*boltSwapStore
+
+	secrets SecretStore
+}
+
+// A compile time assertion that secretSwapStore satisfies the SwapStore
+// interface.
+var _ SwapStore = (*secretSwapStore)(nil)
+
+// NewSecretSwapStore returns a swap store that holds the preimages of swaps
+// in the secret store provided, and persists the rest of their contracts in
+// the store that it wraps. Swaps that were created before the secret store
+// was used keep their preimages in the underlying store.
+func NewSecretSwapStore(store *boltSwapStore, secrets SecretStore) SwapStore {
+	return &secretSwapStore{
+		boltSwapStore: store,
+		secrets:       secrets,
+	}
+}
+
+// storePreimage stores a swap's preimage in our secret store, and reads it
+// back so that we never persist a swap that we cannot recover the preimage
+// for.
+func (s *secretSwapStore) storePreimage(hash lntypes.Hash,
+	preimage lntypes.Preimage) error {
+
+	if hash != preimage.Hash() {
+		return errors.New("hash and preimage do not match")
+	}
+
+	err := s.secrets.StorePreimage(hash, preimage)
+	if err != nil {
+		return fmt.Errorf("%v secret store: swap %v: %w",
+			s.secrets.Name(), hash, err)
+	}
+
+	stored, err := s.secrets.FetchPreimage(hash)
+	if err != nil {
+		return fmt.Errorf("%v secret store: swap %v: %w",
+			s.secrets.Name(), hash, err)
+	}
+
+	if stored != preimage {
+		return fmt.Errorf("%v secret store: stored preimage does "+
+			"not match swap %v", s.secrets.Name(), hash)
+	}
+
+	return nil
+}
+
+// CreateLoopOut stores the swap's preimage in our secret store, then adds the
+// swap to the underlying store without it.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *secretSwapStore) CreateLoopOut(hash lntypes.Hash,
+	swap *LoopOutContract) error {
+
+	if err := s.storePreimage(hash, swap.Preimage); err != nil {
+		return err
+	}
+
+	// Copy the contract so that we do not clear the preimage of the
+	// caller's swap.
+	contract := *swap
+	contract.Preimage = lntypes.Preimage{}
+	contract.PreimageInSecretStore = true
+
+	return s.createLoopOut(hash, &contract)
+}
+
+// CreateLoopIn stores the swap's preimage in our secret store, then adds the
+// swap to the underlying store without it.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *secretSwapStore) CreateLoopIn(hash lntypes.Hash,
+	swap *LoopInContract) error {
+
+	if err := s.storePreimage(hash, swap.Preimage); err != nil {
+		return err
+	}
+
+	contract := *swap
+	contract.Preimage = lntypes.Preimage{}
+	contract.PreimageInSecretStore = true
+
+	return s.createLoopIn(hash, &contract)
+}
+
+// FetchLoopOutSwaps returns all loop out swaps in the underlying store, with
+// the preimages of pending swaps filled in from our secret store. Completed
+// swaps no longer need their preimages, so we do not fetch them, and callers
+// can tell that their preimages are missing by the PreimageInSecretStore flag
+// of their contracts.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *secretSwapStore) FetchLoopOutSwaps() ([]*LoopOut, error) {
+	swaps, err := s.boltSwapStore.FetchLoopOutSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, swap := range swaps {
+		if swap.State().State.Type() != StateTypePending {
+			continue
+		}
+
+		err := s.fillPreimage(swap.Hash, &swap.Contract.SwapContract)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return swaps, nil
+}
+
+// FetchLoopInSwaps returns all loop in swaps in the underlying store, with the
+// preimages of pending swaps filled in from our secret store.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *secretSwapStore) FetchLoopInSwaps() ([]*LoopIn, error) {
+	swaps, err := s.boltSwapStore.FetchLoopInSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, swap := range swaps {
+		if swap.State().State.Type() != StateTypePending {
+			continue
+		}
+
+		err := s.fillPreimage(swap.Hash, &swap.Contract.SwapContract)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return swaps, nil
+}
+
+// fillPreimage sets the preimage of a swap contract from our secret store if
+// it is held there, and checks that it matches the swap's hash.
+func (s *secretSwapStore) fillPreimage(hash lntypes.Hash,
+	contract *SwapContract) error {
+
+	if !contract.PreimageInSecretStore {
+		return nil
+	}
+
+	preimage, err := s.secrets.FetchPreimage(hash)
+	if err != nil {
+		return fmt.Errorf("%v secret store: swap %v: %w",
+			s.secrets.Name(), hash, err)
+	}
+
+	if preimage.Hash() != hash {
+		return fmt.Errorf("%v secret store: preimage does not match "+
+			"swap %v", s.secrets.Name(), hash)
+	}
+
+	contract.Preimage = preimage
+
+	return nil
+}
+
+// CheckSecretPreimages returns ErrNoSecretStore if any of the pending swaps in
+// the store provided hold their preimage in a secret store. It should be used
+// when no secret store is configured, since we cannot resume these swaps
+// without their preimages.
+func CheckSecretPreimages(store SwapStore) error {
+	loopOuts, err := store.FetchLoopOutSwaps()
+	if err != nil {
+		return err
+	}
+
+	loopIns, err := store.FetchLoopInSwaps()
+	if err != nil {
+		return err
+	}
+
+	var pending []lntypes.Hash
+	for _, loopOut := range loopOuts {
+		if loopOut.State().State.Type() == StateTypePending &&
+			loopOut.Contract.PreimageInSecretStore {
+
+			pending = append(pending, loopOut.Hash)
+		}
+	}
+
+	for _, loopIn := range loopIns {
+		if loopIn.State().State.Type() == StateTypePending &&
+			loopIn.Contract.PreimageInSecretStore {
+
+			pending = append(pending, loopIn.Hash)
+		}
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w: swaps: %v", ErrNoSecretStore, pending)
+}
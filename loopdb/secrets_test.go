@@ -0,0 +1,201 @@
+package loopdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSecretStore is an in-memory secret store.
+type mockSecretStore struct {
+	preimages map[lntypes.Hash]lntypes.Preimage
+}
+
+func (m *mockSecretStore) Name() string {
+	return "mock"
+}
+
+func (m *mockSecretStore) StorePreimage(hash lntypes.Hash,
+	preimage lntypes.Preimage) error {
+
+	m.preimages[hash] = preimage
+	return nil
+}
+
+func (m *mockSecretStore) FetchPreimage(hash lntypes.Hash) (lntypes.Preimage,
+	error) {
+
+	preimage, ok := m.preimages[hash]
+	if !ok {
+		return lntypes.Preimage{}, ErrSecretNotFound
+	}
+
+	return preimage, nil
+}
+
+// TestSecretSwapStore tests holding swap preimages in a secret store rather
+// than our database.
+func TestSecretSwapStore(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	boltStore, err := NewBoltSwapStore(tempDirName, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	defer boltStore.Close()
+
+	secrets := &mockSecretStore{
+		preimages: make(map[lntypes.Hash]lntypes.Preimage),
+	}
+	store := NewSecretSwapStore(boltStore, secrets)
+
+	var (
+		outPreimage    = lntypes.Preimage{1}
+		inPreimage     = lntypes.Preimage{2}
+		legacyPreimage = lntypes.Preimage{3}
+	)
+
+	newContract := func(preimage lntypes.Preimage) SwapContract {
+		return SwapContract{
+			AmountRequested: 100,
+			Preimage:        preimage,
+			CltvExpiry:      144,
+			SenderKey:       senderKey,
+			ReceiverKey:     receiverKey,
+			InitiationTime:  time.Unix(0, testTime.UnixNano()),
+		}
+	}
+
+	loopOut := &LoopOutContract{
+		SwapContract:      newContract(outPreimage),
+		DestAddr:          test.GetDestAddr(t, 0),
+		SwapInvoice:       "swapinvoice",
+		PrepayInvoice:     "prepayinvoice",
+		HtlcConfirmations: 1,
+	}
+	require.NoError(t, store.CreateLoopOut(outPreimage.Hash(), loopOut))
+
+	// The caller's contract should keep its preimage.
+	require.Equal(t, outPreimage, loopOut.Preimage)
+
+	loopIn := &LoopInContract{
+		SwapContract: newContract(inPreimage),
+	}
+	require.NoError(t, store.CreateLoopIn(inPreimage.Hash(), loopIn))
+
+	// Swaps that were created before we used a secret store hold their
+	// preimages in our database.
+	legacy := &LoopOutContract{
+		SwapContract:      newContract(legacyPreimage),
+		DestAddr:          test.GetDestAddr(t, 0),
+		SwapInvoice:       "swapinvoice",
+		PrepayInvoice:     "prepayinvoice",
+		HtlcConfirmations: 1,
+	}
+	require.NoError(t, boltStore.CreateLoopOut(
+		legacyPreimage.Hash(), legacy,
+	))
+
+	// Our database should not hold the preimages of the new swaps.
+	rawOut, err := boltStore.FetchLoopOutSwaps()
+	require.NoError(t, err)
+	require.Len(t, rawOut, 2)
+
+	rawIn, err := boltStore.FetchLoopInSwaps()
+	require.NoError(t, err)
+	require.Len(t, rawIn, 1)
+	require.Equal(t, lntypes.Preimage{}, rawIn[0].Contract.Preimage)
+	require.True(t, rawIn[0].Contract.PreimageInSecretStore)
+
+	// Without a secret store, we can't resume our pending swaps whose
+	// preimages are held in one.
+	require.ErrorIs(t, CheckSecretPreimages(boltStore), ErrNoSecretStore)
+
+	// Our database should not accept swaps whose preimage does not match
+	// their hash, or that are marked as holding their preimage in a
+	// secret store, unless they are created through our secret store.
+	invalid := *legacy
+	invalid.Preimage = lntypes.Preimage{}
+	require.Error(t, boltStore.CreateLoopOut(lntypes.Hash{4}, &invalid))
+
+	invalid.Preimage = lntypes.Preimage{4}
+	invalid.PreimageInSecretStore = true
+	require.ErrorIs(t, boltStore.CreateLoopOut(
+		invalid.Preimage.Hash(), &invalid,
+	), ErrPreimageInSecretStore)
+
+	preimages := func() map[lntypes.Hash]lntypes.Preimage {
+		swaps, err := store.FetchLoopOutSwaps()
+		require.NoError(t, err)
+
+		preimages := make(map[lntypes.Hash]lntypes.Preimage)
+		for _, swap := range swaps {
+			preimages[swap.Hash] = swap.Contract.Preimage
+		}
+
+		return preimages
+	}
+
+	require.Equal(t, map[lntypes.Hash]lntypes.Preimage{
+		outPreimage.Hash():    outPreimage,
+		legacyPreimage.Hash(): legacyPreimage,
+	}, preimages())
+
+	in, err := store.FetchLoopInSwaps()
+	require.NoError(t, err)
+	require.Len(t, in, 1)
+	require.Equal(t, inPreimage, in[0].Contract.Preimage)
+
+	// Once a swap has completed, we no longer fetch its preimage, but its
+	// contract notes that the preimage is held in our secret store.
+	require.NoError(t, store.UpdateLoopOut(
+		outPreimage.Hash(), testTime, SwapStateData{
+			State: StateSuccess,
+		},
+	))
+
+	require.Equal(t, map[lntypes.Hash]lntypes.Preimage{
+		outPreimage.Hash():    {},
+		legacyPreimage.Hash(): legacyPreimage,
+	}, preimages())
+
+	swaps, err := store.FetchLoopOutSwaps()
+	require.NoError(t, err)
+	for _, swap := range swaps {
+		require.Equal(
+			t, swap.Hash == outPreimage.Hash(),
+			swap.Contract.PreimageInSecretStore,
+		)
+	}
+
+	// Once our pending loop in has completed as well, we no longer need a
+	// secret store to resume our swaps.
+	require.NoError(t, store.UpdateLoopIn(
+		inPreimage.Hash(), testTime, SwapStateData{
+			State: StateSuccess,
+		},
+	))
+	require.NoError(t, CheckSecretPreimages(boltStore))
+
+	// If the secret store does not hold a pending swap's preimage, or
+	// holds a preimage that does not match the swap, we fail.
+	pendingPreimage := lntypes.Preimage{5}
+	pending := &LoopInContract{
+		SwapContract: newContract(pendingPreimage),
+	}
+	require.NoError(t, store.CreateLoopIn(pendingPreimage.Hash(), pending))
+
+	secrets.preimages[pendingPreimage.Hash()] = outPreimage
+	_, err = store.FetchLoopInSwaps()
+	require.Error(t, err)
+
+	delete(secrets.preimages, pendingPreimage.Hash())
+	_, err = store.FetchLoopInSwaps()
+	require.ErrorIs(t, err, ErrSecretNotFound)
+}
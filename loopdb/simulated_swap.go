@@ -0,0 +1,167 @@
+package loopdb
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// MaxSimulatedSwaps is the number of simulated swaps that we keep in the
+// store. Once this limit is reached, the oldest swaps are removed as new swaps
+// are recorded.
+const MaxSimulatedSwaps = 10000
+
+// SimulatedSwapType describes the kind of swap that autoloop simulated.
+type SimulatedSwapType uint8
+
+const (
+	// SimulatedLoopOut indicates that a loop out was simulated.
+	SimulatedLoopOut SimulatedSwapType = iota
+
+	// SimulatedLoopIn indicates that a loop in was simulated.
+	SimulatedLoopIn
+
+	// SimulatedRebalance indicates that a circular rebalance was
+	// simulated.
+	SimulatedRebalance
+)
+
+// String returns the string representation of a simulated swap type.
+func (s SimulatedSwapType) String() string {
+	switch s {
+	case SimulatedLoopOut:
+		return "loop out"
+
+	case SimulatedLoopIn:
+		return "loop in"
+
+	case SimulatedRebalance:
+		return "rebalance"
+
+	default:
+		return "unknown"
+	}
+}
+
+// SimulatedSwap records a swap that autoloop would have dispatched if it was
+// not running in simulation mode, along with the costs that we projected for
+// it from the server's quote.
+type SimulatedSwap struct {
+	// Time is the time at which the swap would have been dispatched.
+	Time time.Time
+
+	// Type is the type of swap that was simulated.
+	Type SimulatedSwapType
+
+	// Channels is the set of channels that the swap was restricted to.
+	// For loop outs, this is the outgoing channel set, and for
+	// rebalances it contains the outgoing channel followed by the
+	// incoming channel.
+	Channels []uint64
+
+	// LastHop is the peer that a loop in or rebalance was restricted to,
+	// if any.
+	LastHop *route.Vertex
+
+	// Amount is the amount of the swap.
+	Amount btcutil.Amount
+
+	// SwapFee is the swap fee that the server quoted.
+	SwapFee btcutil.Amount
+
+	// MinerFee is the maximum on-chain fee that we would have allowed the
+	// swap to pay.
+	MinerFee btcutil.Amount
+
+	// RoutingFee is the maximum off-chain routing fee that we would have
+	// allowed the swap to pay.
+	RoutingFee btcutil.Amount
+}
+
+// Cost returns the total projected cost of a simulated swap.
+func (s *SimulatedSwap) Cost() btcutil.Amount {
+	return s.SwapFee + s.MinerFee + s.RoutingFee
+}
+
+// simulatedSwapKey returns the key that we store a simulated swap under,
+// which orders our swaps by time, and then by their index within the tick
+// that simulated them.
+func simulatedSwapKey(swap *SimulatedSwap, index int) []byte {
+	var key [12]byte
+	byteOrder.PutUint64(key[:8], uint64(swap.Time.UnixNano()))
+	byteOrder.PutUint32(key[8:], uint32(index))
+
+	return key[:]
+}
+
+// serializeSimulatedSwap serializes a simulated swap.
+func serializeSimulatedSwap(swap *SimulatedSwap) ([]byte, error) {
+	var b bytes.Buffer
+
+	err := writeFields(
+		&b, swap.Time.UnixNano(), swap.Type,
+		uint32(len(swap.Channels)), swap.Channels,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeVertex(&b, swap.LastHop); err != nil {
+		return nil, err
+	}
+
+	err = writeFields(
+		&b, int64(swap.Amount), int64(swap.SwapFee),
+		int64(swap.MinerFee), int64(swap.RoutingFee),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// deserializeSimulatedSwap deserializes a simulated swap.
+func deserializeSimulatedSwap(value []byte) (*SimulatedSwap, error) {
+	var (
+		r                 = bytes.NewReader(value)
+		swap              = &SimulatedSwap{}
+		start             int64
+		chanCount         uint32
+		amount, swapFee   int64
+		minerFee, routing int64
+		err               error
+	)
+
+	err = readFields(r, &start, &swap.Type, &chanCount)
+	if err != nil {
+		return nil, err
+	}
+	swap.Time = time.Unix(0, start)
+
+	if chanCount != 0 {
+		swap.Channels = make([]uint64, chanCount)
+		if err := readFields(r, swap.Channels); err != nil {
+			return nil, err
+		}
+	}
+
+	swap.LastHop, err = readVertex(r)
+	if err != nil {
+		return nil, err
+	}
+
+	err = readFields(r, &amount, &swapFee, &minerFee, &routing)
+	if err != nil {
+		return nil, err
+	}
+
+	swap.Amount = btcutil.Amount(amount)
+	swap.SwapFee = btcutil.Amount(swapFee)
+	swap.MinerFee = btcutil.Amount(minerFee)
+	swap.RoutingFee = btcutil.Amount(routing)
+
+	return swap, nil
+}
@@ -8,10 +8,13 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
+	"sync/atomic"
 	"time"
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
 	"github.com/lightningnetwork/lnd/lntypes"
 )
@@ -69,6 +72,16 @@ var (
 	// value: string label
 	labelKey = []byte("label")
 
+	// notesKey is the key that stores optional free-text notes that the
+	// operator attached to the swap. Unlike the label, notes may be edited
+	// at any point in the swap's lifetime. If no notes were ever set for a
+	// swap, this key will not be present.
+	//
+	// path: loopInBucket/loopOutBucket -> swapBucket[hash] -> notesKey
+	//
+	// value: string notes
+	notesKey = []byte("notes")
+
 	// protocolVersionKey is used to optionally store the protocol version
 	// for the serialized swap contract. It is nested within the sub-bucket
 	// for each active swap.
@@ -94,6 +107,75 @@ var (
 	// value: uint32 confirmation value
 	confirmationsKey = []byte("confirmations")
 
+	// maxTotalCostKey is the key that stores an optional ceiling on the
+	// total cost of a swap. If a swap was created without a ceiling, this
+	// key will not be present.
+	//
+	// path: loopInBucket/loopOutBucket -> swapBucket[hash] -> maxTotalCostKey
+	//
+	// value: int64 amount in satoshis
+	maxTotalCostKey = []byte("max-total-cost")
+
+	// customRecordsKey is the key that stores the optional custom tlv
+	// records that are attached to the off-chain payments of a loop out
+	// swap. If a swap was created without custom records, this key will
+	// not be present.
+	//
+	// path: loopOutBucket -> swapBucket[hash] -> customRecordsKey
+	//
+	// value: concatenation of uint64 record type, uint32 value length and
+	// value for each record
+	customRecordsKey = []byte("custom-records")
+
+	// destVerificationKey is the key that stores the verification of the
+	// custom destination address of a loop out swap. If a swap's
+	// destination was not verified, this key will not be present.
+	//
+	// path: loopOutBucket -> swapBucket[hash] -> destVerificationKey
+	//
+	// value: uint8 verification method followed by the proof
+	destVerificationKey = []byte("dest-verification")
+
+	// secretPreimageKey is the key that marks a swap whose preimage is
+	// held in a secret store rather than in its contract. If a swap's
+	// preimage is held in its contract, this key will not be present.
+	//
+	// path: loopInBucket/loopOutBucket -> swapBucket[hash] ->
+	// secretPreimageKey
+	//
+	// value: empty
+	secretPreimageKey = []byte("secret-preimage")
+
+	// rebalanceBucketKey is a bucket that contains all circular
+	// rebalances that the liquidity manager dispatched.
+	//
+	// maps: paymentHash -> serialized rebalance
+	rebalanceBucketKey = []byte("rebalances")
+
+	// autoloopTickBucketKey is a bucket that contains metrics on the most
+	// recent autoloop ticks.
+	//
+	// maps: startTime -> serialized autoloop tick
+	autoloopTickBucketKey = []byte("autoloop-ticks")
+
+	// suggestionRoundBucketKey is a bucket that contains the most recent
+	// autoloop suggestion rounds.
+	//
+	// maps: startTime -> serialized suggestion round
+	suggestionRoundBucketKey = []byte("autoloop-suggestions")
+
+	// watchedSwapBucketKey is a bucket that contains the swaps initiated
+	// by other loop clients that we monitor.
+	//
+	// maps: swapHash -> serialized watched swap
+	watchedSwapBucketKey = []byte("watched-swaps")
+
+	// simulatedSwapBucketKey is a bucket that contains the most recent
+	// swaps that autoloop simulated.
+	//
+	// maps: time || index -> serialized simulated swap
+	simulatedSwapBucketKey = []byte("simulated-swaps")
+
 	byteOrder = binary.BigEndian
 
 	keyLength = 33
@@ -110,6 +192,38 @@ const (
 	// timeout we error out after the given time instead of just blocking
 	// for forever.
 	DefaultLoopDBTimeout = 5 * time.Second
+
+	// UpdateBatchDelay is the maximum time that we wait to coalesce swap
+	// updates from concurrent swaps into a single database transaction.
+	// Each transaction syncs the database to disk, so batching updates
+	// reduces write amplification when many swaps update at once, for
+	// example when a new block triggers a round of sweep fee bumps.
+	UpdateBatchDelay = 5 * time.Millisecond
+
+	// MaxNotesLength is the maximum length we allow for the free-text notes
+	// attached to a swap.
+	MaxNotesLength = 2000
+)
+
+var (
+	// ErrSwapNotFound is returned when a swap that is being modified is
+	// not present in the store.
+	ErrSwapNotFound = errors.New("swap not found")
+
+	// ErrRebalanceNotFound is returned when a rebalance that is being
+	// updated is not present in the store.
+	ErrRebalanceNotFound = errors.New("rebalance not found")
+
+	// ErrRebalanceExists is returned when we try to create a rebalance
+	// that is already present in the store.
+	ErrRebalanceExists = errors.New("rebalance already exists")
+
+	// ErrWatchedSwapExists is returned when we try to watch a swap that
+	// is already in our watch list.
+	ErrWatchedSwapExists = errors.New("swap is already watched")
+
+	// ErrNotesTooLong is returned when notes exceed our length limit.
+	ErrNotesTooLong = errors.New("notes exceed maximum length")
 )
 
 // fileExists returns true if the file exists, and false otherwise.
@@ -127,6 +241,10 @@ func fileExists(path string) bool {
 type boltSwapStore struct {
 	db          *bbolt.DB
 	chainParams *chaincfg.Params
+
+	// pendingUpdates is the number of swap updates that are currently
+	// being written. It must be used atomically.
+	pendingUpdates int32
 }
 
 // A compile-time flag to ensure that boltSwapStore implements the SwapStore
@@ -159,6 +277,7 @@ func NewBoltSwapStore(dbPath string, chainParams *chaincfg.Params) (
 	if err != nil {
 		return nil, err
 	}
+	bdb.MaxBatchDelay = UpdateBatchDelay
 
 	// We'll create all the buckets we need if this is the first time we're
 	// starting up. If they already exist, then these calls will be noops.
@@ -190,6 +309,31 @@ func NewBoltSwapStore(dbPath string, chainParams *chaincfg.Params) (
 			return err
 		}
 
+		_, err = tx.CreateBucketIfNotExists(rebalanceBucketKey)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists(autoloopTickBucketKey)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists(suggestionRoundBucketKey)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists(watchedSwapBucketKey)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists(simulatedSwapBucketKey)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -256,6 +400,33 @@ func (s *boltSwapStore) FetchLoopOutSwaps() ([]*LoopOut, error) {
 			// Get our label for this swap, if it is present.
 			contract.Label = getLabel(swapBucket)
 
+			// Get the operator's notes for this swap, if present.
+			contract.Notes = getNotes(swapBucket)
+
+			// Get the swap's total cost ceiling, if present.
+			contract.MaxTotalCost, err = getMaxTotalCost(swapBucket)
+			if err != nil {
+				return err
+			}
+
+			// Check whether the swap's preimage is held in a secret
+			// store.
+			contract.PreimageInSecretStore =
+				swapBucket.Get(secretPreimageKey) != nil
+
+			// Get the swap's custom records, if present.
+			contract.CustomRecords, err = getCustomRecords(
+				swapBucket,
+			)
+			if err != nil {
+				return err
+			}
+
+			// Get the swap's destination verification, if present.
+			contract.DestVerification = getDestVerification(
+				swapBucket,
+			)
+
 			// Read the list of concatenated outgoing channel ids
 			// that form the outgoing set.
 			setBytes := swapBucket.Get(outgoingChanSetKey)
@@ -433,6 +604,20 @@ func (s *boltSwapStore) FetchLoopInSwaps() ([]*LoopIn, error) {
 			// Get our label for this swap, if it is present.
 			contract.Label = getLabel(swapBucket)
 
+			// Get the operator's notes for this swap, if present.
+			contract.Notes = getNotes(swapBucket)
+
+			// Get the swap's total cost ceiling, if present.
+			contract.MaxTotalCost, err = getMaxTotalCost(swapBucket)
+			if err != nil {
+				return err
+			}
+
+			// Check whether the swap's preimage is held in a secret
+			// store.
+			contract.PreimageInSecretStore =
+				swapBucket.Get(secretPreimageKey) != nil
+
 			updates, err := deserializeUpdates(swapBucket)
 			if err != nil {
 				return err
@@ -485,10 +670,11 @@ func createLoopBucket(tx *bbolt.Tx, swapTypeKey []byte, hash lntypes.Hash) (
 		return nil, err
 	}
 
-	// If the swap already exists, then we'll exit as we don't want
-	// to override a swap.
-	if swapTypeBucket.Get(hash[:]) != nil {
-		return nil, fmt.Errorf("swap %v already exists", hash)
+	// If a loop out or loop in swap already uses this hash, then we'll
+	// exit as we don't want to override a swap or create two swaps that
+	// share a hash.
+	if swapExists(tx, hash) {
+		return nil, fmt.Errorf("%w: %v", ErrSwapExists, hash)
 	}
 
 	// From the swap type bucket, we'll make a new sub swap bucket using the
@@ -508,6 +694,30 @@ func (s *boltSwapStore) CreateLoopOut(hash lntypes.Hash,
 		return errors.New("hash and preimage do not match")
 	}
 
+	// Only our secret swap store may create swaps whose preimage is held
+	// in a secret store.
+	if swap.PreimageInSecretStore {
+		return ErrPreimageInSecretStore
+	}
+
+	return s.createLoopOut(hash, swap)
+}
+
+// createLoopOut adds an initiated swap to the store without checking its
+// preimage, so that swaps whose preimage is held in a secret store can be
+// created.
+func (s *boltSwapStore) createLoopOut(hash lntypes.Hash,
+	swap *LoopOutContract) error {
+
+	// We never want to persist the preimage of a swap that is held in
+	// a secret store.
+	if swap.PreimageInSecretStore &&
+		swap.Preimage != (lntypes.Preimage{}) {
+
+		return errors.New("preimage held in secret store must not " +
+			"be persisted")
+	}
+
 	// Otherwise, we'll create a new swap within the database.
 	return s.db.Update(func(tx *bbolt.Tx) error {
 		// Create the swap bucket.
@@ -561,6 +771,26 @@ func (s *boltSwapStore) CreateLoopOut(hash lntypes.Hash,
 			return err
 		}
 
+		err = putMaxTotalCost(swapBucket, swap.MaxTotalCost)
+		if err != nil {
+			return err
+		}
+
+		err = putSecretPreimage(swapBucket, swap.PreimageInSecretStore)
+		if err != nil {
+			return err
+		}
+
+		err = putCustomRecords(swapBucket, swap.CustomRecords)
+		if err != nil {
+			return err
+		}
+
+		err = putDestVerification(swapBucket, swap.DestVerification)
+		if err != nil {
+			return err
+		}
+
 		// Store the current protocol version.
 		err = swapBucket.Put(protocolVersionKey,
 			MarshalProtocolVersion(swap.ProtocolVersion),
@@ -588,6 +818,30 @@ func (s *boltSwapStore) CreateLoopIn(hash lntypes.Hash,
 		return errors.New("hash and preimage do not match")
 	}
 
+	// Only our secret swap store may create swaps whose preimage is held
+	// in a secret store.
+	if swap.PreimageInSecretStore {
+		return ErrPreimageInSecretStore
+	}
+
+	return s.createLoopIn(hash, swap)
+}
+
+// createLoopIn adds an initiated swap to the store without checking its
+// preimage, so that swaps whose preimage is held in a secret store can be
+// created.
+func (s *boltSwapStore) createLoopIn(hash lntypes.Hash,
+	swap *LoopInContract) error {
+
+	// We never want to persist the preimage of a swap that is held in
+	// a secret store.
+	if swap.PreimageInSecretStore &&
+		swap.Preimage != (lntypes.Preimage{}) {
+
+		return errors.New("preimage held in secret store must not " +
+			"be persisted")
+	}
+
 	// Otherwise, we'll create a new swap within the database.
 	return s.db.Update(func(tx *bbolt.Tx) error {
 		// Create the swap bucket.
@@ -620,6 +874,16 @@ func (s *boltSwapStore) CreateLoopIn(hash lntypes.Hash,
 			return err
 		}
 
+		err = putMaxTotalCost(swapBucket, swap.MaxTotalCost)
+		if err != nil {
+			return err
+		}
+
+		err = putSecretPreimage(swapBucket, swap.PreimageInSecretStore)
+		if err != nil {
+			return err
+		}
+
 		// Finally, we'll create an empty updates bucket for this swap
 		// to track any future updates to the swap itself.
 		_, err = swapBucket.CreateBucket(updatesBucketKey)
@@ -632,7 +896,19 @@ func (s *boltSwapStore) CreateLoopIn(hash lntypes.Hash,
 func (s *boltSwapStore) updateLoop(bucketKey []byte, hash lntypes.Hash,
 	time time.Time, state SwapStateData) error {
 
-	return s.db.Update(func(tx *bbolt.Tx) error {
+	// If other swap updates are being written, we batch our update so
+	// that concurrent updates share a single transaction. A lone update is
+	// written immediately, so that it does not wait out the batch delay.
+	// Our update may be retried in a new transaction if another update in
+	// its batch fails, which is safe because a failed transaction is
+	// rolled back entirely.
+	write := s.db.Update
+	if atomic.AddInt32(&s.pendingUpdates, 1) > 1 {
+		write = s.db.Batch
+	}
+	defer atomic.AddInt32(&s.pendingUpdates, -1)
+
+	return write(func(tx *bbolt.Tx) error {
 		// Starting from the root bucket, we'll traverse the bucket
 		// hierarchy all the way down to the swap bucket, and the
 		// update sub-bucket within that.
@@ -706,6 +982,595 @@ func (s *boltSwapStore) UpdateLoopIn(hash lntypes.Hash, time time.Time,
 	return s.updateLoop(loopInBucketKey, hash, time, state)
 }
 
+// setNotes overwrites the notes of an existing swap. It takes in a bucket key
+// so that this function can be used for both in and out swaps.
+func (s *boltSwapStore) setNotes(bucketKey []byte, hash lntypes.Hash,
+	notes string) error {
+
+	if len(notes) > MaxNotesLength {
+		return ErrNotesTooLong
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		rootBucket := tx.Bucket(bucketKey)
+		if rootBucket == nil {
+			return ErrSwapNotFound
+		}
+		swapBucket := rootBucket.Bucket(hash[:])
+		if swapBucket == nil {
+			return ErrSwapNotFound
+		}
+
+		// An empty string clears any notes that were previously set.
+		if len(notes) == 0 {
+			return swapBucket.Delete(notesKey)
+		}
+
+		return swapBucket.Put(notesKey, []byte(notes))
+	})
+}
+
+// SetLoopOutNotes replaces the free-text notes of a loop out swap.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) SetLoopOutNotes(hash lntypes.Hash,
+	notes string) error {
+
+	return s.setNotes(loopOutBucketKey, hash, notes)
+}
+
+// SetLoopInNotes replaces the free-text notes of a loop in swap.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) SetLoopInNotes(hash lntypes.Hash,
+	notes string) error {
+
+	return s.setNotes(loopInBucketKey, hash, notes)
+}
+
+// getNotes returns the notes stored under the notes key in a swap bucket. If
+// no notes are present, an empty string is returned.
+func getNotes(bucket *bbolt.Bucket) string {
+	notes := bucket.Get(notesKey)
+	if notes == nil {
+		return ""
+	}
+
+	return string(notes)
+}
+
+// putMaxTotalCost writes a swap's total cost ceiling to the bucket provided if
+// it is non-zero.
+func putMaxTotalCost(bucket *bbolt.Bucket, maxCost btcutil.Amount) error {
+	if maxCost == 0 {
+		return nil
+	}
+
+	var b bytes.Buffer
+	if err := binary.Write(&b, byteOrder, maxCost); err != nil {
+		return err
+	}
+
+	return bucket.Put(maxTotalCostKey, b.Bytes())
+}
+
+// putSecretPreimage marks a swap bucket as holding a swap whose preimage is
+// held in a secret store, if it is.
+func putSecretPreimage(bucket *bbolt.Bucket, inSecretStore bool) error {
+	if !inSecretStore {
+		return nil
+	}
+
+	return bucket.Put(secretPreimageKey, []byte{})
+}
+
+// getMaxTotalCost returns the total cost ceiling stored in a swap bucket. If
+// no ceiling is present, zero is returned.
+func getMaxTotalCost(bucket *bbolt.Bucket) (btcutil.Amount, error) {
+	maxCostBytes := bucket.Get(maxTotalCostKey)
+	if maxCostBytes == nil {
+		return 0, nil
+	}
+
+	var maxCost btcutil.Amount
+	err := binary.Read(bytes.NewReader(maxCostBytes), byteOrder, &maxCost)
+	if err != nil {
+		return 0, err
+	}
+
+	return maxCost, nil
+}
+
+// putCustomRecords writes a swap's custom records to the bucket provided if
+// it has any. Records are written in ascending order of type.
+func putCustomRecords(bucket *bbolt.Bucket, records map[uint64][]byte) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	keys := make([]uint64, 0, len(records))
+	for key := range records {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	var b bytes.Buffer
+	for _, key := range keys {
+		value := records[key]
+
+		if err := binary.Write(&b, byteOrder, key); err != nil {
+			return err
+		}
+
+		err := binary.Write(&b, byteOrder, uint32(len(value)))
+		if err != nil {
+			return err
+		}
+
+		if _, err := b.Write(value); err != nil {
+			return err
+		}
+	}
+
+	return bucket.Put(customRecordsKey, b.Bytes())
+}
+
+// getCustomRecords returns the custom records stored in a swap bucket. If no
+// records are present, nil is returned.
+func getCustomRecords(bucket *bbolt.Bucket) (map[uint64][]byte, error) {
+	recordBytes := bucket.Get(customRecordsKey)
+	if recordBytes == nil {
+		return nil, nil
+	}
+
+	var (
+		r       = bytes.NewReader(recordBytes)
+		records = make(map[uint64][]byte)
+	)
+
+	for r.Len() > 0 {
+		var (
+			key    uint64
+			length uint32
+		)
+
+		if err := binary.Read(r, byteOrder, &key); err != nil {
+			return nil, err
+		}
+
+		if err := binary.Read(r, byteOrder, &length); err != nil {
+			return nil, err
+		}
+
+		value := make([]byte, length)
+		if _, err := io.ReadFull(r, value); err != nil {
+			return nil, err
+		}
+
+		records[key] = value
+	}
+
+	return records, nil
+}
+
+// putDestVerification writes a swap's destination verification to the bucket
+// provided if it has one.
+func putDestVerification(bucket *bbolt.Bucket,
+	verification *DestVerification) error {
+
+	if verification == nil {
+		return nil
+	}
+
+	value := append(
+		[]byte{byte(verification.Method)}, verification.Proof...,
+	)
+
+	return bucket.Put(destVerificationKey, value)
+}
+
+// getDestVerification returns the destination verification stored in a swap
+// bucket. If no verification is present, nil is returned.
+func getDestVerification(bucket *bbolt.Bucket) *DestVerification {
+	value := bucket.Get(destVerificationKey)
+	if len(value) == 0 {
+		return nil
+	}
+
+	return &DestVerification{
+		Method: DestVerificationMethod(value[0]),
+		Proof:  string(value[1:]),
+	}
+}
+
+// FetchRebalances returns all rebalances currently in the store.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchRebalances() ([]*Rebalance, error) {
+	var rebalances []*Rebalance
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		rootBucket := tx.Bucket(rebalanceBucketKey)
+		if rootBucket == nil {
+			return errors.New("bucket does not exist")
+		}
+
+		return rootBucket.ForEach(func(k, v []byte) error {
+			hash, err := lntypes.MakeHash(k)
+			if err != nil {
+				return err
+			}
+
+			rebalance, err := deserializeRebalance(hash, v)
+			if err != nil {
+				return err
+			}
+
+			rebalances = append(rebalances, rebalance)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rebalances, nil
+}
+
+// CreateRebalance adds an initiated rebalance to the store.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) CreateRebalance(hash lntypes.Hash,
+	contract *RebalanceContract) error {
+
+	rebalance := &Rebalance{
+		RebalanceContract: *contract,
+		Hash:              hash,
+		State:             RebalanceStateInitiated,
+		LastUpdate:        contract.InitiationTime,
+	}
+
+	value, err := serializeRebalance(rebalance)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		rootBucket, err := tx.CreateBucketIfNotExists(
+			rebalanceBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		if rootBucket.Get(hash[:]) != nil {
+			return ErrRebalanceExists
+		}
+
+		return rootBucket.Put(hash[:], value)
+	})
+}
+
+// UpdateRebalance updates the state of a rebalance, along with the routing fee
+// that it paid.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) UpdateRebalance(hash lntypes.Hash, time time.Time,
+	state RebalanceState, fee btcutil.Amount) error {
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		rootBucket := tx.Bucket(rebalanceBucketKey)
+		if rootBucket == nil {
+			return ErrRebalanceNotFound
+		}
+
+		value := rootBucket.Get(hash[:])
+		if value == nil {
+			return ErrRebalanceNotFound
+		}
+
+		rebalance, err := deserializeRebalance(hash, value)
+		if err != nil {
+			return err
+		}
+
+		rebalance.State = state
+		rebalance.Fee = fee
+		rebalance.LastUpdate = time
+
+		value, err = serializeRebalance(rebalance)
+		if err != nil {
+			return err
+		}
+
+		return rootBucket.Put(hash[:], value)
+	})
+}
+
+// FetchWatchedSwaps returns all of the swaps in our watch list.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchWatchedSwaps() ([]*WatchedSwap, error) {
+	var watched []*WatchedSwap
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		rootBucket := tx.Bucket(watchedSwapBucketKey)
+		if rootBucket == nil {
+			return errors.New("bucket does not exist")
+		}
+
+		return rootBucket.ForEach(func(k, v []byte) error {
+			hash, err := lntypes.MakeHash(k)
+			if err != nil {
+				return err
+			}
+
+			swap, err := deserializeWatchedSwap(hash, v)
+			if err != nil {
+				return err
+			}
+
+			watched = append(watched, swap)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return watched, nil
+}
+
+// CreateWatchedSwap adds a swap initiated by another loop client to our watch
+// list.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) CreateWatchedSwap(watched *WatchedSwap) error {
+	value, err := serializeWatchedSwap(watched)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		rootBucket, err := tx.CreateBucketIfNotExists(
+			watchedSwapBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		if rootBucket.Get(watched.Hash[:]) != nil {
+			return ErrWatchedSwapExists
+		}
+
+		return rootBucket.Put(watched.Hash[:], value)
+	})
+}
+
+// RecordAutoloopTick stores the metrics of an autoloop tick. Only the most
+// recent MaxAutoloopTicks ticks are kept, so older ticks are removed.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) RecordAutoloopTick(tick *AutoloopTick) error {
+	value, err := serializeAutoloopTick(tick)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		rootBucket, err := tx.CreateBucketIfNotExists(
+			autoloopTickBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = rootBucket.Put(autoloopTickKey(tick.Start), value)
+		if err != nil {
+			return err
+		}
+
+		return pruneOldest(rootBucket, MaxAutoloopTicks)
+	})
+}
+
+// pruneOldest removes the oldest entries from a bucket that is keyed by time
+// until it contains no more than the maximum number of entries provided. Our
+// keys are ordered by time, so we walk backwards from the most recent entry
+// and collect every key past our limit. We delete these keys once we are done
+// with the cursor, because deleting while iterating can skip keys.
+func pruneOldest(bucket *bbolt.Bucket, max int) error {
+	var (
+		count  int
+		oldest [][]byte
+		cursor = bucket.Cursor()
+	)
+	for k, _ := cursor.Last(); k != nil; k, _ = cursor.Prev() {
+		count++
+		if count <= max {
+			continue
+		}
+
+		oldest = append(oldest, append([]byte(nil), k...))
+	}
+
+	for _, k := range oldest {
+		if err := bucket.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FetchAutoloopTicks returns the autoloop ticks in the store, ordered from
+// oldest to newest.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchAutoloopTicks() ([]*AutoloopTick, error) {
+	var ticks []*AutoloopTick
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		rootBucket := tx.Bucket(autoloopTickBucketKey)
+		if rootBucket == nil {
+			return errors.New("bucket does not exist")
+		}
+
+		return rootBucket.ForEach(func(_, v []byte) error {
+			tick, err := deserializeAutoloopTick(v)
+			if err != nil {
+				return err
+			}
+
+			ticks = append(ticks, tick)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ticks, nil
+}
+
+// RecordSuggestionRound stores an autoloop suggestion round, removing our
+// oldest round if we have more than MaxSuggestionRounds stored.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) RecordSuggestionRound(round *SuggestionRound) error {
+	value, err := serializeSuggestionRound(round)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		rootBucket, err := tx.CreateBucketIfNotExists(
+			suggestionRoundBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = rootBucket.Put(autoloopTickKey(round.Time), value)
+		if err != nil {
+			return err
+		}
+
+		return pruneOldest(rootBucket, MaxSuggestionRounds)
+	})
+}
+
+// FetchSuggestionRounds returns the autoloop suggestion rounds in the store,
+// ordered from oldest to newest.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchSuggestionRounds() ([]*SuggestionRound, error) {
+	var rounds []*SuggestionRound
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		rootBucket := tx.Bucket(suggestionRoundBucketKey)
+		if rootBucket == nil {
+			return errors.New("bucket does not exist")
+		}
+
+		return rootBucket.ForEach(func(_, v []byte) error {
+			round, err := deserializeSuggestionRound(v)
+			if err != nil {
+				return err
+			}
+
+			rounds = append(rounds, round)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rounds, nil
+}
+
+// RecordSimulatedSwaps stores a set of simulated swaps, removing our oldest
+// swaps if we have more than MaxSimulatedSwaps stored.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) RecordSimulatedSwaps(swaps []*SimulatedSwap) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		rootBucket, err := tx.CreateBucketIfNotExists(
+			simulatedSwapBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		for i, swap := range swaps {
+			value, err := serializeSimulatedSwap(swap)
+			if err != nil {
+				return err
+			}
+
+			err = rootBucket.Put(simulatedSwapKey(swap, i), value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return pruneOldest(rootBucket, MaxSimulatedSwaps)
+	})
+}
+
+// FetchSimulatedSwaps returns the simulated swaps in the store, ordered from
+// oldest to newest.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) FetchSimulatedSwaps() ([]*SimulatedSwap, error) {
+	var swaps []*SimulatedSwap
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		rootBucket := tx.Bucket(simulatedSwapBucketKey)
+		if rootBucket == nil {
+			return errors.New("bucket does not exist")
+		}
+
+		return rootBucket.ForEach(func(_, v []byte) error {
+			swap, err := deserializeSimulatedSwap(v)
+			if err != nil {
+				return err
+			}
+
+			swaps = append(swaps, swap)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return swaps, nil
+}
+
+// ClearSimulatedSwaps removes all simulated swaps from the store.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *boltSwapStore) ClearSimulatedSwaps() error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		err := tx.DeleteBucket(simulatedSwapBucketKey)
+		if err != nil && err != bbolt.ErrBucketNotFound {
+			return err
+		}
+
+		_, err = tx.CreateBucket(simulatedSwapBucketKey)
+		return err
+	})
+}
+
 // Close closes the underlying database.
 //
 // NOTE: Part of the loopdb.SwapStore interface.
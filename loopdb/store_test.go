@@ -2,16 +2,20 @@ package loopdb
 
 import (
 	"crypto/sha256"
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
+	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/test"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/routing/route"
@@ -91,6 +95,30 @@ func TestLoopOutStore(t *testing.T) {
 		testLoopOutStore(t, &labelledSwap)
 	})
 
+	cappedSwap := unrestrictedSwap
+	cappedSwap.MaxTotalCost = 50
+	t.Run("total cost ceiling", func(t *testing.T) {
+		testLoopOutStore(t, &cappedSwap)
+	})
+
+	recordsSwap := unrestrictedSwap
+	recordsSwap.CustomRecords = map[uint64][]byte{
+		65536: {1, 2, 3},
+		65537: {},
+	}
+	t.Run("custom records", func(t *testing.T) {
+		testLoopOutStore(t, &recordsSwap)
+	})
+
+	verifiedSwap := unrestrictedSwap
+	verifiedSwap.DestVerification = &DestVerification{
+		Method: DestVerificationXpub,
+		Proof:  "xpub/0/1",
+	}
+	t.Run("verified destination", func(t *testing.T) {
+		testLoopOutStore(t, &verifiedSwap)
+	})
+
 }
 
 // testLoopOutStore tests the basic functionality of the current bbolt
@@ -239,6 +267,12 @@ func TestLoopInStore(t *testing.T) {
 	t.Run("loop in with label", func(t *testing.T) {
 		testLoopInStore(t, labelledSwap)
 	})
+
+	cappedSwap := pendingSwap
+	cappedSwap.MaxTotalCost = 50
+	t.Run("loop in with total cost ceiling", func(t *testing.T) {
+		testLoopInStore(t, cappedSwap)
+	})
 }
 
 func testLoopInStore(t *testing.T, pendingSwap LoopInContract) {
@@ -472,3 +506,603 @@ func TestLegacyOutgoingChannel(t *testing.T) {
 		t.Fatal("invalid outgoing channel")
 	}
 }
+
+// TestSwapNotes tests setting, editing and clearing the notes of loop in and
+// loop out swaps.
+func TestSwapNotes(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	defer store.Close()
+
+	hash := testPreimage.Hash()
+
+	inPreimage := lntypes.Preimage{1}
+	inHash := inPreimage.Hash()
+
+	// Setting notes on swaps that don't exist should fail.
+	err = store.SetLoopOutNotes(hash, "notes")
+	require.Equal(t, ErrSwapNotFound, err)
+
+	err = store.SetLoopInNotes(inHash, "notes")
+	require.Equal(t, ErrSwapNotFound, err)
+
+	contract := SwapContract{
+		AmountRequested: 100,
+		Preimage:        testPreimage,
+		CltvExpiry:      144,
+		SenderKey:       senderKey,
+		ReceiverKey:     receiverKey,
+		InitiationTime:  time.Unix(0, testTime.UnixNano()),
+	}
+
+	require.NoError(t, store.CreateLoopOut(hash, &LoopOutContract{
+		SwapContract:      contract,
+		DestAddr:          test.GetDestAddr(t, 0),
+		SwapInvoice:       "swapinvoice",
+		PrepayInvoice:     "prepayinvoice",
+		HtlcConfirmations: 2,
+	}))
+
+	inContract := contract
+	inContract.Preimage = inPreimage
+	require.NoError(t, store.CreateLoopIn(inHash, &LoopInContract{
+		SwapContract: inContract,
+	}))
+
+	// Notes that exceed our maximum length should be rejected.
+	longNotes := string(make([]byte, MaxNotesLength+1))
+	err = store.SetLoopOutNotes(hash, longNotes)
+	require.Equal(t, ErrNotesTooLong, err)
+
+	outNotes := func() string {
+		swaps, err := store.FetchLoopOutSwaps()
+		require.NoError(t, err)
+		require.Len(t, swaps, 1)
+
+		return swaps[0].Contract.Notes
+	}
+
+	inNotes := func() string {
+		swaps, err := store.FetchLoopInSwaps()
+		require.NoError(t, err)
+		require.Len(t, swaps, 1)
+
+		return swaps[0].Contract.Notes
+	}
+
+	// Swaps start out without notes.
+	require.Equal(t, "", outNotes())
+	require.Equal(t, "", inNotes())
+
+	// Set notes on both swaps, and assert that they do not affect each
+	// other.
+	require.NoError(t, store.SetLoopOutNotes(hash, "out notes"))
+	require.Equal(t, "out notes", outNotes())
+	require.Equal(t, "", inNotes())
+
+	require.NoError(t, store.SetLoopInNotes(inHash, "in notes"))
+	require.Equal(t, "in notes", inNotes())
+
+	// Edit, then clear our loop out notes.
+	require.NoError(t, store.SetLoopOutNotes(hash, "edited"))
+	require.Equal(t, "edited", outNotes())
+
+	require.NoError(t, store.SetLoopOutNotes(hash, ""))
+	require.Equal(t, "", outNotes())
+	require.Equal(t, "in notes", inNotes())
+}
+
+// TestRebalances tests storing and updating circular rebalances.
+func TestRebalances(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	defer store.Close()
+
+	hash := testPreimage.Hash()
+
+	// Updating a rebalance that does not exist should fail.
+	err = store.UpdateRebalance(hash, testTime, RebalanceStateSuccess, 1)
+	require.Equal(t, ErrRebalanceNotFound, err)
+
+	contract := &RebalanceContract{
+		InitiationTime:  time.Unix(0, testTime.UnixNano()),
+		Amount:          10000,
+		MaxFee:          20,
+		OutgoingChannel: 1,
+		IncomingChannel: 2,
+		Autoloop:        true,
+	}
+	require.NoError(t, store.CreateRebalance(hash, contract))
+
+	// We should not be able to create the same rebalance twice.
+	err = store.CreateRebalance(hash, contract)
+	require.Equal(t, ErrRebalanceExists, err)
+
+	rebalances, err := store.FetchRebalances()
+	require.NoError(t, err)
+	require.Equal(t, []*Rebalance{{
+		RebalanceContract: *contract,
+		Hash:              hash,
+		State:             RebalanceStateInitiated,
+		LastUpdate:        contract.InitiationTime,
+	}}, rebalances)
+
+	updateTime := time.Unix(0, testTime.Add(time.Minute).UnixNano())
+	err = store.UpdateRebalance(
+		hash, updateTime, RebalanceStateSuccess, 12,
+	)
+	require.NoError(t, err)
+
+	rebalances, err = store.FetchRebalances()
+	require.NoError(t, err)
+	require.Equal(t, []*Rebalance{{
+		RebalanceContract: *contract,
+		Hash:              hash,
+		State:             RebalanceStateSuccess,
+		Fee:               12,
+		LastUpdate:        updateTime,
+	}}, rebalances)
+
+	// Rebalances that were stored before we recorded whether autoloop
+	// dispatched them are read as manual rebalances.
+	value, err := serializeRebalance(rebalances[0])
+	require.NoError(t, err)
+
+	rebalance, err := deserializeRebalance(hash, value[:len(value)-1])
+	require.NoError(t, err)
+	require.False(t, rebalance.Autoloop)
+}
+
+// TestWatchedSwaps tests adding swaps to our watch list and fetching them.
+func TestWatchedSwaps(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	defer store.Close()
+
+	watched, err := store.FetchWatchedSwaps()
+	require.NoError(t, err)
+	require.Empty(t, watched)
+
+	labelled := &WatchedSwap{
+		Hash:      testPreimage.Hash(),
+		Type:      swap.TypeIn,
+		Label:     "external loop in",
+		AddedTime: time.Unix(0, testTime.UnixNano()),
+	}
+	require.NoError(t, store.CreateWatchedSwap(labelled))
+
+	// We should not be able to watch the same swap twice.
+	err = store.CreateWatchedSwap(labelled)
+	require.Equal(t, ErrWatchedSwapExists, err)
+
+	// Swaps do not need to have a label.
+	unlabelled := &WatchedSwap{
+		Hash:      lntypes.Hash{1},
+		Type:      swap.TypeOut,
+		AddedTime: time.Unix(0, testTime.UnixNano()),
+	}
+	require.NoError(t, store.CreateWatchedSwap(unlabelled))
+
+	watched, err = store.FetchWatchedSwaps()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*WatchedSwap{labelled, unlabelled}, watched)
+}
+
+// TestAutoloopTicks tests recording and fetching autoloop ticks, and the
+// pruning of old ticks once we exceed our maximum.
+func TestAutoloopTicks(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	defer store.Close()
+
+	ticks, err := store.FetchAutoloopTicks()
+	require.NoError(t, err)
+	require.Empty(t, ticks)
+
+	// Record more ticks than we store, the last of which failed.
+	total := MaxAutoloopTicks + 5
+	for i := 0; i < total; i++ {
+		tick := &AutoloopTick{
+			Start: time.Unix(
+				0, testTime.Add(time.Duration(i)*time.Minute).
+					UnixNano(),
+			),
+			Duration:          time.Duration(i) * time.Millisecond,
+			ChannelsEvaluated: uint32(i),
+			QuotesFetched:     2,
+			SwapsDispatched:   1,
+		}
+		if i == total-1 {
+			tick.Error = "tick failed"
+		}
+
+		require.NoError(t, store.RecordAutoloopTick(tick))
+	}
+
+	// Only our most recent ticks should be kept, oldest first.
+	ticks, err = store.FetchAutoloopTicks()
+	require.NoError(t, err)
+	require.Len(t, ticks, MaxAutoloopTicks)
+
+	first := ticks[0]
+	require.Equal(t, uint32(5), first.ChannelsEvaluated)
+	require.Equal(t, 5*time.Millisecond, first.Duration)
+	require.Empty(t, first.Error)
+
+	last := ticks[len(ticks)-1]
+	require.Equal(t, &AutoloopTick{
+		Start: time.Unix(
+			0, testTime.Add(time.Duration(total-1)*time.Minute).
+				UnixNano(),
+		),
+		Duration:          time.Duration(total-1) * time.Millisecond,
+		ChannelsEvaluated: uint32(total - 1),
+		QuotesFetched:     2,
+		SwapsDispatched:   1,
+		Error:             "tick failed",
+	}, last)
+}
+
+// TestSuggestionRounds tests recording and fetching autoloop suggestion
+// rounds, and the pruning of old rounds once we exceed our maximum.
+func TestSuggestionRounds(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	defer store.Close()
+
+	rounds, err := store.FetchSuggestionRounds()
+	require.NoError(t, err)
+	require.Empty(t, rounds)
+
+	peer := route.Vertex{2}
+
+	roundAt := func(i int) *SuggestionRound {
+		return &SuggestionRound{
+			Time: time.Unix(
+				0, testTime.Add(time.Duration(i)*time.Minute).
+					UnixNano(),
+			),
+		}
+	}
+
+	// Record more rounds than we store, the last of which contains each
+	// of our suggestion types.
+	total := MaxSuggestionRounds + 5
+	for i := 0; i < total-1; i++ {
+		require.NoError(t, store.RecordSuggestionRound(roundAt(i)))
+	}
+
+	last := roundAt(total - 1)
+	last.Dispatch = true
+	last.LoopOut = []*SuggestedSwap{
+		{
+			Channels:   []uint64{1, 2},
+			Amount:     10000,
+			Fees:       100,
+			Dispatched: true,
+		},
+	}
+	last.LoopIn = []*SuggestedSwap{
+		{
+			LastHop: &peer,
+			Amount:  20000,
+			Fees:    200,
+		},
+	}
+	last.Rebalances = []*SuggestedSwap{
+		{
+			Channels: []uint64{1, 3},
+			LastHop:  &peer,
+			Amount:   3000,
+			Fees:     3,
+		},
+	}
+	last.Disqualified = []*DisqualifiedTarget{
+		{
+			Channel: 4,
+			Reason:  8,
+		},
+		{
+			Peer:   &peer,
+			Reason: 10,
+		},
+		{
+			Group:  "group",
+			Reason: 11,
+		},
+	}
+	last.Error = "dispatch failed"
+	require.NoError(t, store.RecordSuggestionRound(last))
+
+	// Only our most recent rounds should be kept, oldest first.
+	rounds, err = store.FetchSuggestionRounds()
+	require.NoError(t, err)
+	require.Len(t, rounds, MaxSuggestionRounds)
+
+	require.Equal(t, roundAt(5), rounds[0])
+	require.Equal(t, last, rounds[len(rounds)-1])
+}
+
+// TestSimulatedSwaps tests recording, fetching and clearing simulated swaps.
+func TestSimulatedSwaps(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	defer store.Close()
+
+	swaps, err := store.FetchSimulatedSwaps()
+	require.NoError(t, err)
+	require.Empty(t, swaps)
+
+	var (
+		peer  = route.Vertex{2}
+		start = time.Unix(0, testTime.UnixNano())
+		later = start.Add(time.Minute)
+	)
+
+	// Record two ticks of swaps, where the first tick simulates more than
+	// one swap at the same time.
+	first := []*SimulatedSwap{
+		{
+			Time:       start,
+			Type:       SimulatedLoopOut,
+			Channels:   []uint64{1, 2},
+			Amount:     10000,
+			SwapFee:    100,
+			MinerFee:   200,
+			RoutingFee: 10,
+		},
+		{
+			Time:     start,
+			Type:     SimulatedLoopIn,
+			LastHop:  &peer,
+			Amount:   20000,
+			SwapFee:  150,
+			MinerFee: 300,
+		},
+	}
+	require.NoError(t, store.RecordSimulatedSwaps(first))
+
+	second := []*SimulatedSwap{
+		{
+			Time:       later,
+			Type:       SimulatedRebalance,
+			Channels:   []uint64{1, 3},
+			LastHop:    &peer,
+			Amount:     3000,
+			RoutingFee: 3,
+		},
+	}
+	require.NoError(t, store.RecordSimulatedSwaps(second))
+
+	swaps, err = store.FetchSimulatedSwaps()
+	require.NoError(t, err)
+	require.Equal(t, append(first, second...), swaps)
+	require.Equal(t, btcutil.Amount(310), swaps[0].Cost())
+
+	require.NoError(t, store.ClearSimulatedSwaps())
+
+	swaps, err = store.FetchSimulatedSwaps()
+	require.NoError(t, err)
+	require.Empty(t, swaps)
+}
+
+// TestConcurrentUpdates tests that swap updates that are written concurrently
+// are all persisted in order, and that a failed update does not affect the
+// other updates that are batched with it.
+func TestConcurrentUpdates(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	defer store.Close()
+
+	const (
+		swapCount   = 20
+		updateCount = 10
+	)
+
+	hashes := make([]lntypes.Hash, swapCount)
+	for i := range hashes {
+		preimage := lntypes.Preimage{byte(i + 1)}
+		hashes[i] = preimage.Hash()
+
+		err := store.CreateLoopIn(hashes[i], &LoopInContract{
+			SwapContract: SwapContract{
+				Preimage:       preimage,
+				SenderKey:      senderKey,
+				ReceiverKey:    receiverKey,
+				InitiationTime: time.Unix(0, testTime.UnixNano()),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	// Write the updates for each of our swaps concurrently, along with
+	// updates for a swap that does not exist. We record the onchain cost
+	// of each update so that we can check their order.
+	var (
+		wg      sync.WaitGroup
+		errChan = make(chan error, (swapCount+1)*updateCount)
+	)
+
+	update := func(hash lntypes.Hash) {
+		defer wg.Done()
+
+		for i := 0; i < updateCount; i++ {
+			errChan <- store.UpdateLoopIn(
+				hash, testTime, SwapStateData{
+					State: StateHtlcPublished,
+					Cost: SwapCost{
+						Onchain: btcutil.Amount(i),
+					},
+				},
+			)
+		}
+	}
+
+	for _, hash := range hashes {
+		wg.Add(1)
+		go update(hash)
+	}
+
+	wg.Add(1)
+	go update(lntypes.Hash{1})
+
+	wg.Wait()
+	close(errChan)
+
+	var failed int
+	for err := range errChan {
+		if err != nil {
+			failed++
+		}
+	}
+	require.Equal(t, updateCount, failed)
+
+	swaps, err := store.FetchLoopInSwaps()
+	require.NoError(t, err)
+	require.Len(t, swaps, swapCount)
+
+	for _, swap := range swaps {
+		require.Len(t, swap.Events, updateCount)
+
+		for i, event := range swap.Events {
+			require.Equal(
+				t, btcutil.Amount(i), event.Cost.Onchain,
+			)
+		}
+	}
+}
+
+// TestSwapConflicts tests that we do not create swaps that share a hash, and
+// that conflicting records that were written to the store by other means can
+// be found and resolved.
+func TestSwapConflicts(t *testing.T) {
+	tempDirName, err := ioutil.TempDir("", "clientstore")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDirName)
+
+	store, err := NewBoltSwapStore(tempDirName, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	defer store.Close()
+
+	hash := testPreimage.Hash()
+
+	inPreimage := lntypes.Preimage{1}
+	inHash := inPreimage.Hash()
+
+	contract := SwapContract{
+		AmountRequested: 100,
+		Preimage:        testPreimage,
+		CltvExpiry:      144,
+		SenderKey:       senderKey,
+		ReceiverKey:     receiverKey,
+		InitiationTime:  time.Unix(0, testTime.UnixNano()),
+	}
+
+	require.NoError(t, store.CreateLoopOut(hash, &LoopOutContract{
+		SwapContract:      contract,
+		DestAddr:          test.GetDestAddr(t, 0),
+		SwapInvoice:       "swapinvoice",
+		PrepayInvoice:     "prepayinvoice",
+		HtlcConfirmations: 2,
+	}))
+
+	// We should not be able to create a loop in with the same hash as
+	// our loop out.
+	err = store.CreateLoopIn(hash, &LoopInContract{
+		SwapContract: contract,
+	})
+	require.True(t, errors.Is(err, ErrSwapExists))
+
+	inContract := contract
+	inContract.Preimage = inPreimage
+	require.NoError(t, store.CreateLoopIn(inHash, &LoopInContract{
+		SwapContract: inContract,
+	}))
+
+	// Resolving a swap that does not have conflicting records fails.
+	err = store.ResolveSwapConflict(hash, swap.TypeOut)
+	require.Equal(t, ErrNoSwapConflict, err)
+
+	// Move our loop in's record under the hash of our loop out, as would
+	// happen if two databases were merged.
+	err = store.db.Update(func(tx *bbolt.Tx) error {
+		rootBucket := tx.Bucket(loopInBucketKey)
+
+		conflicted, err := rootBucket.CreateBucket(hash[:])
+		if err != nil {
+			return err
+		}
+
+		err = copyBucket(rootBucket.Bucket(inHash[:]), conflicted)
+		if err != nil {
+			return err
+		}
+
+		return rootBucket.DeleteBucket(inHash[:])
+	})
+	require.NoError(t, err)
+
+	loopOuts, err := store.FetchLoopOutSwaps()
+	require.NoError(t, err)
+
+	loopIns, err := store.FetchLoopInSwaps()
+	require.NoError(t, err)
+
+	conflicts := FindSwapConflicts(loopOuts, loopIns)
+	require.Len(t, conflicts, 1)
+	require.Equal(t, hash, conflicts[0].Hash)
+	require.Equal(t, loopOuts[0], conflicts[0].LoopOut)
+	require.Equal(t, loopIns[0], conflicts[0].LoopIn)
+
+	// Keep our loop out, and assert that the loop in is set aside.
+	require.NoError(t, store.ResolveSwapConflict(hash, swap.TypeOut))
+
+	loopOuts, err = store.FetchLoopOutSwaps()
+	require.NoError(t, err)
+	require.Len(t, loopOuts, 1)
+
+	loopIns, err = store.FetchLoopInSwaps()
+	require.NoError(t, err)
+	require.Len(t, loopIns, 0)
+
+	require.Len(t, FindSwapConflicts(loopOuts, loopIns), 0)
+
+	err = store.db.View(func(tx *bbolt.Tx) error {
+		archived := tx.Bucket(conflictedSwapBucketKey).Bucket(
+			loopInBucketKey,
+		).Bucket(hash[:])
+		require.NotNil(t, archived)
+		require.NotNil(t, archived.Get(contractKey))
+
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -64,6 +64,13 @@ const (
 	// StateFailIncorrectHtlcAmt indicates that the amount of an externally
 	// published loop in htlc didn't match the swap amount.
 	StateFailIncorrectHtlcAmt SwapState = 10
+
+	// StateFailMaxTotalCost indicates that the swap was aborted before we
+	// paid the server or published the htlc, because its costs would have
+	// exceeded the swap's total cost ceiling. Loop outs are only aborted
+	// on their first run, and loop ins before their htlc is published, so
+	// no funds were spent.
+	StateFailMaxTotalCost SwapState = 11
 )
 
 // SwapStateType defines the types of swap states that exist. Every swap state
@@ -134,6 +141,9 @@ func (s SwapState) String() string {
 	case StateFailIncorrectHtlcAmt:
 		return "IncorrectHtlcAmt"
 
+	case StateFailMaxTotalCost:
+		return "FailMaxTotalCost"
+
 	default:
 		return "Unknown"
 	}
@@ -0,0 +1,84 @@
+package loopdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// WatchedSwap is a swap that was initiated by another loop client using the
+// same lnd node. We do not hold the swap's contract, so we only monitor the
+// swap's payments and transactions in lnd for accounting purposes.
+type WatchedSwap struct {
+	// Hash is the hash of the swap.
+	Hash lntypes.Hash
+
+	// Type is the type of the swap.
+	Type swap.Type
+
+	// Label is an optional label for the swap.
+	Label string
+
+	// AddedTime is the time at which the swap was added to our watch
+	// list.
+	AddedTime time.Time
+}
+
+// serializeWatchedSwap serializes a watched swap. The swap's label is
+// appended after its fixed length fields.
+func serializeWatchedSwap(watched *WatchedSwap) ([]byte, error) {
+	var b bytes.Buffer
+
+	fields := []interface{}{
+		watched.Type,
+		watched.AddedTime.UnixNano(),
+	}
+
+	for _, field := range fields {
+		if err := binary.Write(&b, byteOrder, field); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := b.WriteString(watched.Label); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// deserializeWatchedSwap deserializes a watched swap that is stored under the
+// hash provided.
+func deserializeWatchedSwap(hash lntypes.Hash, value []byte) (*WatchedSwap,
+	error) {
+
+	var (
+		r       = bytes.NewReader(value)
+		watched = &WatchedSwap{Hash: hash}
+		added   int64
+	)
+
+	fields := []interface{}{
+		&watched.Type,
+		&added,
+	}
+
+	for _, field := range fields {
+		if err := binary.Read(r, byteOrder, field); err != nil {
+			return nil, err
+		}
+	}
+
+	label := make([]byte, r.Len())
+	if _, err := r.Read(label); err != nil && len(label) > 0 {
+		return nil, err
+	}
+
+	watched.Label = string(label)
+	watched.AddedTime = time.Unix(0, added)
+
+	return watched, nil
+}
@@ -67,6 +67,16 @@ type loopInSwap struct {
 
 	timeoutAddr btcutil.Address
 
+	// holdAlarm is set when the server has not paid our swap invoice by
+	// the time our htlc approaches expiry.
+	holdAlarm bool
+
+	// fundingFeeRate is the fee rate that the swap amount was adjusted for
+	// to fund the htlc without change, if the swap has a change tolerance.
+	// It is not persisted, so we fall back to a fresh fee estimate if we
+	// restart before publishing our htlc.
+	fundingFeeRate chainfee.SatPerKWeight
+
 	wg sync.WaitGroup
 }
 
@@ -81,7 +91,10 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 	currentHeight int32, request *LoopInRequest) (*loopInInitResult,
 	error) {
 
-	var err error
+	var (
+		err            error
+		fundingFeeRate chainfee.SatPerKWeight
+	)
 
 	// Private and routehints are mutually exclusive as setting private
 	// means we retrieve our own routehints from the connected node.
@@ -89,6 +102,17 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 		return nil, fmt.Errorf("private and route_hints both set")
 	}
 
+	// If the swap should be funded without change, we adjust its amount
+	// before we use it for anything else.
+	if request.ChangeTolerance != 0 {
+		fundingFeeRate, err = applyChangeTolerance(
+			globalCtx, cfg.lnd, request,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// If Private is set, we generate route hints
 	if request.Private {
 		// If last_hop is set, we'll only add channels with peers
@@ -132,6 +156,10 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 		return nil, ErrSwapFeeTooHigh
 	}
 
+	if err := checkTotalCost(request.MaxTotalCost, swapFee); err != nil {
+		return nil, err
+	}
+
 	// Calculate the swap invoice amount. The prepay is added which
 	// effectively forces the server to pay us back our prepayment on a
 	// successful swap.
@@ -243,6 +271,7 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 			CltvExpiry:       swapResp.expiry,
 			MaxMinerFee:      request.MaxMinerFee,
 			MaxSwapFee:       request.MaxSwapFee,
+			MaxTotalCost:     request.MaxTotalCost,
 			Label:            request.Label,
 			ProtocolVersion:  loopdb.CurrentInternalProtocolVersion,
 		},
@@ -258,6 +287,7 @@ func newLoopInSwap(globalCtx context.Context, cfg *swapConfig,
 	swap := &loopInSwap{
 		LoopInContract: contract,
 		swapKit:        *swapKit,
+		fundingFeeRate: fundingFeeRate,
 	}
 
 	if err := swap.initHtlcs(); err != nil {
@@ -430,6 +460,7 @@ func (s *loopInSwap) sendUpdate(ctx context.Context) error {
 	info.HtlcAddressP2WSH = s.htlcP2WSH.Address
 	info.HtlcAddressNP2WSH = s.htlcNP2WSH.Address
 	info.ExternalHtlc = s.ExternalHtlc
+	info.InvoiceHoldAlarm = s.holdAlarm
 
 	select {
 	case s.statusChan <- *info:
@@ -560,7 +591,11 @@ func (s *loopInSwap) executeSwap(globalCtx context.Context) error {
 	// invoice, receive the preimage and sweep the htlc. We are waiting for
 	// this to happen and simultaneously watch the htlc expiry height. When
 	// the htlc expires, we will publish a timeout tx to reclaim the funds.
-	err = s.waitForSwapComplete(globalCtx, htlcOutpoint, htlcValue)
+	hold := newInvoiceHold(
+		int32(conf.BlockHeight), time.Now(), s.loopInAlarmDelta,
+		s.loopInCancelDelta,
+	)
+	err = s.waitForSwapComplete(globalCtx, htlcOutpoint, htlcValue, hold)
 	if err != nil {
 		return err
 	}
@@ -679,6 +714,23 @@ func (s *loopInSwap) publishOnChainHtlc(ctx context.Context) (bool, error) {
 		return false, fmt.Errorf("estimate fee: %v", err)
 	}
 
+	// If our swap amount was adjusted to avoid change, we publish at the
+	// fee rate that it was adjusted for.
+	if s.fundingFeeRate != 0 {
+		feeRate = s.fundingFeeRate
+	}
+
+	// If publishing our htlc would exceed the swap's total cost ceiling,
+	// we abort the swap before we commit any funds.
+	err = s.checkHtlcCost(ctx)
+	if errors.Is(err, ErrTotalCostTooHigh) {
+		s.setState(loopdb.StateFailMaxTotalCost)
+		return false, s.persistAndAnnounceState(ctx)
+	}
+	if err != nil {
+		return false, err
+	}
+
 	// Transition to state HtlcPublished before calling SendOutputs to
 	// prevent us from ever paying multiple times after a crash.
 	s.setState(loopdb.StateHtlcPublished)
@@ -694,7 +746,7 @@ func (s *loopInSwap) publishOnChainHtlc(ctx context.Context) (bool, error) {
 		ctx, []*wire.TxOut{{
 			PkScript: s.htlcP2WSH.PkScript,
 			Value:    int64(s.LoopInContract.AmountRequested),
-		}}, feeRate, labels.LoopInHtlcLabel(swap.ShortHash(&s.hash)),
+		}}, feeRate, labels.LoopInHtlcLabel(s.hash),
 	)
 	if err != nil {
 		return false, fmt.Errorf("send outputs: %v", err)
@@ -727,6 +779,32 @@ func (s *loopInSwap) publishOnChainHtlc(ctx context.Context) (bool, error) {
 
 }
 
+// checkHtlcCost returns ErrTotalCostTooHigh if the swap has a total cost
+// ceiling and the swap fee plus the estimated fee for publishing our htlc
+// exceed it. The swap fee is the part of the swap amount that our swap invoice
+// does not cover.
+func (s *loopInSwap) checkHtlcCost(ctx context.Context) error {
+	if s.MaxTotalCost == 0 {
+		return nil
+	}
+
+	invoice, err := s.lnd.Client.LookupInvoice(ctx, s.hash)
+	if err != nil {
+		return fmt.Errorf("lookup swap invoice: %v", err)
+	}
+
+	htlcFee, err := s.lnd.Client.EstimateFeeToP2WSH(
+		ctx, s.AmountRequested, s.HtlcConfTarget,
+	)
+	if err != nil {
+		return fmt.Errorf("estimate htlc fee: %v", err)
+	}
+
+	swapFee := s.AmountRequested - invoice.Amount.ToSatoshis()
+
+	return checkTotalCost(s.MaxTotalCost, swapFee+htlcFee)
+}
+
 // getTxFee calculates our fee for a transaction that we have broadcast. We use
 // sat per kvbyte because this is what lnd uses, and we will run into rounding
 // issues if we do not use the same fee rate as lnd.
@@ -740,8 +818,13 @@ func getTxFee(tx *wire.MsgTx, fee chainfee.SatPerKVByte) btcutil.Amount {
 // waitForSwapComplete waits until a spending tx of the htlc gets confirmed and
 // the swap invoice is either settled or canceled. If the htlc times out, the
 // timeout tx will be published.
+//
+// While our swap invoice is unpaid, we track how long the server holds off on
+// paying it, raising an alarm and canceling the invoice as our htlc
+// approaches expiry.
 func (s *loopInSwap) waitForSwapComplete(ctx context.Context,
-	htlcOutpoint *wire.OutPoint, htlcValue btcutil.Amount) error {
+	htlcOutpoint *wire.OutPoint, htlcValue btcutil.Amount,
+	hold *invoiceHold) error {
 
 	// Register the htlc spend notification.
 	rpcCtx, cancel := context.WithCancel(ctx)
@@ -799,6 +882,13 @@ func (s *loopInSwap) waitForSwapComplete(ctx context.Context,
 				return err
 			}
 
+			if !invoiceFinalized {
+				err := s.checkInvoiceHold(ctx, hold)
+				if err != nil {
+					return err
+				}
+			}
+
 		// The htlc spend is confirmed. Inspect the spending tx to
 		// determine the final swap state.
 		case spendDetails := <-spendChan:
@@ -848,6 +938,11 @@ func (s *loopInSwap) waitForSwapComplete(ctx context.Context,
 			case channeldb.ContractSettled:
 				s.cost.Server -= update.AmtPaid
 
+				s.log.Infof("Swap invoice paid %v blocks (%v) "+
+					"after htlc confirmation",
+					hold.blocks(s.height),
+					hold.duration(time.Now()))
+
 				// If invoice settlement and htlc spend happen
 				// in the expected order, move the swap to an
 				// intermediate state that indicates that the
@@ -878,6 +973,44 @@ func (s *loopInSwap) waitForSwapComplete(ctx context.Context,
 	return nil
 }
 
+// checkInvoiceHold raises an alarm if our htlc is approaching expiry while the
+// server still has not paid our swap invoice, and cancels the invoice once our
+// htlc reaches our cancel deadline. Canceling the invoice prevents the server
+// from settling it while we are reclaiming our funds with the timeout path.
+func (s *loopInSwap) checkInvoiceHold(ctx context.Context,
+	hold *invoiceHold) error {
+
+	alarm, cancel := hold.check(s.height, s.LoopInContract.CltvExpiry)
+
+	if alarm {
+		s.log.Warnf("Swap invoice still unpaid %v blocks (%v) after "+
+			"htlc confirmation, htlc expires in %v blocks",
+			hold.blocks(s.height), hold.duration(time.Now()),
+			s.LoopInContract.CltvExpiry-s.height)
+
+		s.holdAlarm = true
+		if err := s.sendUpdate(ctx); err != nil {
+			return err
+		}
+	}
+
+	if !cancel {
+		return nil
+	}
+
+	s.log.Warnf("Canceling unpaid swap invoice, htlc expires in %v "+
+		"blocks", s.LoopInContract.CltvExpiry-s.height)
+
+	// The server may have paid the invoice before we receive the update,
+	// in which case there is nothing to cancel.
+	err := s.lnd.Invoices.CancelInvoice(ctx, s.hash)
+	if err != nil && err != channeldb.ErrInvoiceAlreadySettled {
+		return err
+	}
+
+	return nil
+}
+
 func (s *loopInSwap) processHtlcSpend(ctx context.Context,
 	spend *chainntnfs.SpendDetail, htlcValue,
 	sweepFee btcutil.Amount) error {
@@ -956,7 +1089,7 @@ func (s *loopInSwap) publishTimeoutTx(ctx context.Context,
 
 	err = s.lnd.WalletKit.PublishTransaction(
 		ctx, timeoutTx,
-		labels.LoopInSweepTimeout(swap.ShortHash(&s.hash)),
+		labels.LoopInSweepTimeout(s.hash),
 	)
 	if err != nil {
 		s.log.Warnf("publish timeout: %v", err)
@@ -0,0 +1,146 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrChangeUnavoidable is returned when a loop in requests that its
+	// htlc is funded without change, but no swap amount within its change
+	// tolerance can be funded by our wallet without a change output.
+	ErrChangeUnavoidable = errors.New("no swap amount within change " +
+		"tolerance avoids change")
+
+	// ErrChangeToleranceExternal is returned when a change tolerance is
+	// set for a loop in that is funded externally.
+	ErrChangeToleranceExternal = errors.New("change tolerance cannot be " +
+		"set for external htlcs")
+)
+
+// applyChangeTolerance adjusts the amount of a loop in that has a change
+// tolerance set, so that our wallet funds its htlc without a change output.
+// It returns the fee rate that the adjusted amount was calculated for, which
+// must be used to publish the htlc for the funding transaction to be
+// changeless. Avoiding change is the only change handling that we offer,
+// because the SendOutputs call that funds our htlc does not allow the change
+// address type or account to be selected in lnd v0.14.
+func applyChangeTolerance(ctx context.Context, lnd *lndclient.LndServices,
+	request *LoopInRequest) (chainfee.SatPerKWeight, error) {
+
+	if request.ExternalHtlc {
+		return 0, ErrChangeToleranceExternal
+	}
+
+	feeRate, err := lnd.WalletKit.EstimateFee(ctx, request.HtlcConfTarget)
+	if err != nil {
+		return 0, fmt.Errorf("estimate fee: %v", err)
+	}
+
+	// Our htlc is funded with confirmed outputs only.
+	utxos, err := lnd.WalletKit.ListUnspent(ctx, 1, math.MaxInt32)
+	if err != nil {
+		return 0, err
+	}
+
+	amount, err := changelessAmount(
+		utxos, request.Amount, request.ChangeTolerance, feeRate,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Infof("Loop in amount adjusted from %v to %v to avoid change at "+
+		"fee rate %v", request.Amount, amount, feeRate)
+
+	request.Amount = amount
+
+	return feeRate, nil
+}
+
+// changelessAmount returns the htlc amount closest to the amount provided,
+// and no more than the tolerance away from it, that our wallet can fund
+// without a change output. lnd's wallet selects its largest outputs first,
+// adding outputs until they cover the htlc and the fee for a transaction with
+// a change output, and only adds change if the remainder is not dust. An htlc
+// that spends the full value of the largest outputs, less this fee, is
+// therefore funded without change.
+func changelessAmount(utxos []*lnwallet.Utxo, amount,
+	tolerance btcutil.Amount, feeRate chainfee.SatPerKWeight) (
+	btcutil.Amount, error) {
+
+	sorted := make([]*lnwallet.Utxo, len(utxos))
+	copy(sorted, utxos)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Value > sorted[j].Value
+	})
+
+	var (
+		weightEstimate input.TxWeightEstimator
+		total          btcutil.Amount
+		best           btcutil.Amount
+		bestDiff       = btcutil.Amount(-1)
+	)
+
+	// Our htlc is always a P2WSH output, and lnd's wallet estimates its
+	// fee with a P2WKH change output.
+	weightEstimate.AddP2WSHOutput()
+	weightEstimate.AddP2WKHOutput()
+
+	for _, utxo := range sorted {
+		switch utxo.AddressType {
+		case lnwallet.WitnessPubKey:
+			weightEstimate.AddP2WKHInput()
+
+		case lnwallet.NestedWitnessPubKey:
+			weightEstimate.AddNestedP2WKHInput()
+
+		default:
+			return 0, fmt.Errorf("unsupported utxo type: %v",
+				utxo.AddressType)
+		}
+
+		total += utxo.Value
+
+		vsize := int64(weightEstimate.Weight()+3) / 4
+		fee := feeRate.FeePerKVByte().FeeForVSize(vsize)
+
+		candidate := total - fee
+		if candidate <= 0 {
+			continue
+		}
+
+		// Once our inputs exceed our amount by more than our
+		// tolerance, adding more inputs will only increase the
+		// difference.
+		diff := candidate - amount
+		if diff > tolerance {
+			break
+		}
+
+		if diff < 0 {
+			diff = -diff
+		}
+
+		if diff <= tolerance && (bestDiff < 0 || diff < bestDiff) {
+			best = candidate
+			bestDiff = diff
+		}
+	}
+
+	if bestDiff < 0 {
+		return 0, ErrChangeUnavoidable
+	}
+
+	return best, nil
+}
@@ -0,0 +1,100 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChangelessAmount tests selection of a loop in amount that our wallet
+// can fund without a change output.
+func TestChangelessAmount(t *testing.T) {
+	// A fee rate of 250 sat/kw is 1 sat/vbyte, so our fee is equal to the
+	// virtual size of the funding transaction.
+	feeRate := chainfee.SatPerKWeight(250)
+
+	// Our utxos are unsorted to test that we select the largest first. A
+	// transaction spending the first utxo is 153 vbytes, spending the
+	// first two is 221 vbytes and spending all three is 313 vbytes.
+	utxos := []*lnwallet.Utxo{
+		{
+			AddressType: lnwallet.WitnessPubKey,
+			Value:       50_000,
+		},
+		{
+			AddressType: lnwallet.NestedWitnessPubKey,
+			Value:       20_000,
+		},
+		{
+			AddressType: lnwallet.WitnessPubKey,
+			Value:       100_000,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		utxos     []*lnwallet.Utxo
+		amount    btcutil.Amount
+		tolerance btcutil.Amount
+		expected  btcutil.Amount
+		err       error
+	}{
+		{
+			name:      "exact amount",
+			utxos:     utxos,
+			amount:    99_847,
+			tolerance: 1,
+			expected:  99_847,
+		},
+		{
+			name:      "adjusted down",
+			utxos:     utxos,
+			amount:    99_900,
+			tolerance: 100,
+			expected:  99_847,
+		},
+		{
+			name:      "adjusted up",
+			utxos:     utxos,
+			amount:    149_500,
+			tolerance: 500,
+			expected:  149_779,
+		},
+		{
+			name:      "all utxos",
+			utxos:     utxos,
+			amount:    170_000,
+			tolerance: 500,
+			expected:  169_687,
+		},
+		{
+			name:      "outside tolerance",
+			utxos:     utxos,
+			amount:    120_000,
+			tolerance: 1000,
+			err:       ErrChangeUnavoidable,
+		},
+		{
+			name:      "no utxos",
+			amount:    100_000,
+			tolerance: 1000,
+			err:       ErrChangeUnavoidable,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			amount, err := changelessAmount(
+				testCase.utxos, testCase.amount,
+				testCase.tolerance, feeRate,
+			)
+			require.Equal(t, testCase.err, err)
+			require.Equal(t, testCase.expected, amount)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package loop
+
+import (
+	"time"
+)
+
+// invoiceHold tracks how long the server has held off on paying our loop in
+// swap invoice since our htlc confirmed. The server is expected to pay the
+// invoice promptly once it sees our htlc, because it can only sweep the htlc
+// with the preimage that it receives from us. A server that waits until our
+// htlc is close to expiry may attempt to settle the invoice while we are
+// already reclaiming our funds, so we raise an alarm when the htlc approaches
+// expiry and cancel the invoice at our cancel deadline.
+type invoiceHold struct {
+	// confHeight is the height at which our htlc confirmed.
+	confHeight int32
+
+	// confTime is the time at which we observed our htlc's confirmation.
+	confTime time.Time
+
+	// alarmDelta is the number of blocks before our htlc's expiry at
+	// which we raise an alarm if the invoice is still unpaid. A zero
+	// value disables the alarm.
+	alarmDelta int32
+
+	// cancelDelta is the number of blocks before our htlc's expiry at
+	// which we cancel the invoice if it is still unpaid. A zero value
+	// disables cancellation.
+	cancelDelta int32
+
+	// alarmed is true if we have already raised our alarm.
+	alarmed bool
+
+	// canceled is true if we have already canceled the invoice.
+	canceled bool
+}
+
+// newInvoiceHold creates a hold tracker for an htlc that confirmed at the
+// height and time provided.
+func newInvoiceHold(confHeight int32, confTime time.Time, alarmDelta,
+	cancelDelta int32) *invoiceHold {
+
+	return &invoiceHold{
+		confHeight:  confHeight,
+		confTime:    confTime,
+		alarmDelta:  alarmDelta,
+		cancelDelta: cancelDelta,
+	}
+}
+
+// check returns whether we need to raise an alarm and whether we need to
+// cancel the invoice at the current height, for an htlc that expires at the
+// height provided. Each action is only returned once.
+func (h *invoiceHold) check(height, expiry int32) (bool, bool) {
+	remaining := expiry - height
+
+	var alarm, cancel bool
+	if h.alarmDelta > 0 && !h.alarmed && remaining <= h.alarmDelta {
+		h.alarmed = true
+		alarm = true
+	}
+
+	if h.cancelDelta > 0 && !h.canceled && remaining <= h.cancelDelta {
+		h.canceled = true
+		cancel = true
+	}
+
+	return alarm, cancel
+}
+
+// blocks returns the number of blocks that the invoice has been held for at
+// the height provided.
+func (h *invoiceHold) blocks(height int32) int32 {
+	return height - h.confHeight
+}
+
+// duration returns the amount of time that the invoice has been held for at
+// the time provided.
+func (h *invoiceHold) duration(now time.Time) time.Duration {
+	return now.Sub(h.confTime)
+}
@@ -0,0 +1,75 @@
+package loop
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestInvoiceHoldCheck tests the actions that we take as an unpaid loop in
+// htlc approaches expiry.
+func TestInvoiceHoldCheck(t *testing.T) {
+	const expiry = int32(700)
+
+	type step struct {
+		height int32
+		alarm  bool
+		cancel bool
+	}
+
+	tests := []struct {
+		name        string
+		alarmDelta  int32
+		cancelDelta int32
+		steps       []step
+	}{
+		{
+			name:        "alarm then cancel",
+			alarmDelta:  10,
+			cancelDelta: 3,
+			steps: []step{
+				{height: 689},
+				{height: 690, alarm: true},
+				{height: 691},
+				{height: 697, cancel: true},
+				{height: 698},
+			},
+		},
+		{
+			name:        "both at once",
+			alarmDelta:  10,
+			cancelDelta: 3,
+			steps: []step{
+				{height: 698, alarm: true, cancel: true},
+				{height: 699},
+			},
+		},
+		{
+			name: "disabled",
+			steps: []step{
+				{height: 690},
+				{height: 700},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			hold := newInvoiceHold(
+				600, time.Now(), testCase.alarmDelta,
+				testCase.cancelDelta,
+			)
+
+			for _, step := range testCase.steps {
+				alarm, cancel := hold.check(step.height, expiry)
+				require.Equal(t, step.alarm, alarm)
+				require.Equal(t, step.cancel, cancel)
+			}
+
+			require.Equal(t, int32(100), hold.blocks(expiry))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package loop
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
@@ -521,3 +522,139 @@ func testLoopInResume(t *testing.T, state loopdb.SwapState, expired bool,
 	cost.Server = btcutil.Amount(htlcTx.TxOut[0].Value) - amtPaid
 	require.Equal(t, cost, finalState.Cost)
 }
+
+// TestLoopInMaxTotalCost tests that a loop in is aborted before its htlc is
+// published if its total cost ceiling cannot be met.
+func TestLoopInMaxTotalCost(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := newLoopInTestContext(t)
+
+	height := int32(600)
+
+	cfg := newSwapConfig(&ctx.lnd.LndServices, ctx.store, ctx.server)
+
+	// Set a ceiling that covers our swap fee, but not the fee for
+	// publishing our htlc.
+	req := testLoopInRequest
+	req.MaxTotalCost = testSwapFee + 1
+
+	initResult, err := newLoopInSwap(
+		context.Background(), cfg, height, &req,
+	)
+	require.NoError(t, err)
+	swap := initResult.swap
+
+	ctx.store.assertLoopInStored()
+
+	errChan := make(chan error)
+	go func() {
+		errChan <- swap.execute(context.Background(), ctx.cfg, height)
+	}()
+
+	ctx.assertState(loopdb.StateInitiated)
+
+	ctx.assertState(loopdb.StateFailMaxTotalCost)
+	ctx.store.assertLoopInState(loopdb.StateFailMaxTotalCost)
+
+	require.NoError(t, <-errChan)
+
+	// Our htlc should not have been published.
+	select {
+	case <-ctx.lnd.SendOutputsChannel:
+		t.Fatal("unexpected htlc publication")
+
+	default:
+	}
+
+	// A ceiling below our swap fee fails the swap when it is created.
+	req.MaxTotalCost = testSwapFee - 1
+	_, err = newLoopInSwap(context.Background(), cfg, height, &req)
+	require.Equal(t, ErrTotalCostTooHigh, err)
+}
+
+// TestLoopInInvoiceHold tests that we raise an alarm and cancel our swap
+// invoice when the server does not pay it before our htlc approaches expiry.
+func TestLoopInInvoiceHold(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := newLoopInTestContext(t)
+	ctx.cfg.loopInAlarmDelta = 10
+	ctx.cfg.loopInCancelDelta = 3
+
+	height := int32(600)
+
+	cfg := newSwapConfig(&ctx.lnd.LndServices, ctx.store, ctx.server)
+
+	initResult, err := newLoopInSwap(
+		context.Background(), cfg, height, &testLoopInRequest,
+	)
+	require.NoError(t, err)
+	s := initResult.swap
+
+	ctx.store.assertLoopInStored()
+
+	swapCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errChan := make(chan error)
+	go func() {
+		errChan <- s.execute(swapCtx, ctx.cfg, height)
+	}()
+
+	ctx.assertState(loopdb.StateInitiated)
+	ctx.assertState(loopdb.StateHtlcPublished)
+	ctx.store.assertLoopInState(loopdb.StateHtlcPublished)
+
+	htlcTx := <-ctx.lnd.SendOutputsChannel
+	ctx.store.assertLoopInState(loopdb.StateHtlcPublished)
+
+	<-ctx.lnd.RegisterConfChannel
+	<-ctx.lnd.RegisterConfChannel
+
+	ctx.lnd.ConfChannel <- &chainntnfs.TxConfirmation{
+		Tx:          &htlcTx,
+		BlockHeight: uint32(height),
+	}
+
+	<-ctx.lnd.RegisterSpendChannel
+	ctx.assertSubscribeInvoice(ctx.server.swapHash)
+
+	expiry := s.LoopInContract.CltvExpiry
+
+	// A block that leaves more blocks than our alarm delta before expiry
+	// does not raise an alarm, so we can't expect an update for it. We
+	// send a block at our alarm delta, and expect an update with our
+	// alarm set.
+	ctx.blockEpochChan <- expiry - 11
+	ctx.blockEpochChan <- expiry - 10
+
+	update := <-ctx.statusChan
+	require.Equal(t, loopdb.StateHtlcPublished, update.State)
+	require.True(t, update.InvoiceHoldAlarm)
+
+	// Once we reach our cancel deadline, we expect our invoice to be
+	// canceled.
+	ctx.blockEpochChan <- expiry - 3
+
+	select {
+	case hash := <-ctx.lnd.FailInvoiceChannel:
+		require.Equal(t, ctx.server.swapHash, hash)
+
+	case <-time.After(test.Timeout):
+		t.Fatal("invoice not canceled")
+	}
+
+	// Further blocks should not cancel the invoice again.
+	ctx.blockEpochChan <- expiry - 2
+
+	select {
+	case <-ctx.lnd.FailInvoiceChannel:
+		t.Fatal("invoice canceled twice")
+
+	default:
+	}
+
+	cancel()
+	require.ErrorIs(t, <-errChan, context.Canceled)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
 )
 
@@ -73,6 +74,16 @@ type loopOutSwap struct {
 	swapPaymentChan chan paymentResult
 	prePaymentChan  chan paymentResult
 
+	// feeLimits are the fee limits that the swap is executed with. They
+	// are set from the contract's limits when execution starts, and
+	// lowered if required to stay within the swap's total cost ceiling.
+	feeLimits loopOutFeeLimits
+
+	// resumed indicates that the swap was restored from the database. We
+	// may already have paid the invoices of a resumed swap in a previous
+	// run, even if it is still in the initiated state.
+	resumed bool
+
 	wg sync.WaitGroup
 }
 
@@ -86,12 +97,20 @@ type executeConfig struct {
 	totalPaymentTimout time.Duration
 	maxPaymentRetries  int
 	cancelSwap         func(context.Context, *outCancelDetails) error
+	loopInAlarmDelta   int32
+	loopInCancelDelta  int32
 }
 
 // loopOutInitResult contains information about a just-initiated loop out swap.
 type loopOutInitResult struct {
 	swap          *loopOutSwap
 	serverMessage string
+
+	// swapInvoiceAmt is the amount of the swap invoice.
+	swapInvoiceAmt btcutil.Amount
+
+	// prepayInvoiceAmt is the amount of the prepay invoice.
+	prepayInvoiceAmt btcutil.Amount
 }
 
 // newLoopOutSwap initiates a new swap with the server and returns a
@@ -131,8 +150,8 @@ func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 		return nil, wrapGrpcError("cannot initiate swap", err)
 	}
 
-	err = validateLoopOutContract(
-		cfg.lnd, currentHeight, request, swapHash, swapResp,
+	swapInvoiceAmt, prepayInvoiceAmt, err := validateLoopOutContract(
+		cfg.lnd, currentHeight, time.Now(), request, swapHash, swapResp,
 	)
 	if err != nil {
 		return nil, err
@@ -166,6 +185,8 @@ func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 		PrepayInvoice:           swapResp.prepayInvoice,
 		MaxPrepayRoutingFee:     request.MaxPrepayRoutingFee,
 		SwapPublicationDeadline: request.SwapPublicationDeadline,
+		CustomRecords:           request.CustomRecords,
+		DestVerification:        request.DestVerification,
 		SwapContract: loopdb.SwapContract{
 			InitiationHeight: currentHeight,
 			InitiationTime:   initiationTime,
@@ -176,6 +197,7 @@ func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 			CltvExpiry:       request.Expiry,
 			MaxMinerFee:      request.MaxMinerFee,
 			MaxSwapFee:       request.MaxSwapFee,
+			MaxTotalCost:     request.MaxTotalCost,
 			Label:            request.Label,
 			ProtocolVersion:  loopdb.CurrentInternalProtocolVersion,
 		},
@@ -224,8 +246,10 @@ func newLoopOutSwap(globalCtx context.Context, cfg *swapConfig,
 	}
 
 	return &loopOutInitResult{
-		swap:          swap,
-		serverMessage: swapResp.serverMessage,
+		swap:             swap,
+		serverMessage:    swapResp.serverMessage,
+		swapInvoiceAmt:   swapInvoiceAmt,
+		prepayInvoiceAmt: prepayInvoiceAmt,
 	}, nil
 }
 
@@ -267,6 +291,7 @@ func resumeLoopOutSwap(reqContext context.Context, cfg *swapConfig,
 		swapKit:                *swapKit,
 		htlc:                   htlc,
 		swapInvoicePaymentAddr: *paymentAddr,
+		resumed:                true,
 	}
 
 	lastUpdate := pend.LastUpdate()
@@ -305,6 +330,7 @@ func (s *loopOutSwap) sendUpdate(ctx context.Context) error {
 	s.log.Infof("Loop out swap state: %v", info.State)
 
 	info.HtlcAddressP2WSH = s.htlc.Address
+	info.DestVerification = s.DestVerification
 
 	select {
 	case s.statusChan <- *info:
@@ -464,6 +490,27 @@ func (s *loopOutSwap) executeSwap(globalCtx context.Context) error {
 	// TODO: We shouldn't pay the invoices if it is already too late to
 	// start the swap. But because we don't know if we already fired the
 	// payments in a previous run, we cannot just abandon here.
+	//
+	// Before we pay, we set the fee limits that we execute the swap with.
+	// If the swap's total cost ceiling cannot be met, we abort the swap on
+	// its first run, because we have not paid the server yet. A resumed
+	// swap may have been paid in a previous run, and the server may have
+	// published the htlc, so we have to complete it and fall back to the
+	// contract's limits.
+	err := s.setFeeLimits(globalCtx)
+	switch {
+	case errors.Is(err, ErrTotalCostTooHigh) && !s.resumed:
+		s.state = loopdb.StateFailMaxTotalCost
+		return nil
+
+	case errors.Is(err, ErrTotalCostTooHigh):
+		s.log.Warnf("Total cost ceiling cannot be met for resumed " +
+			"swap, using contract fee limits")
+
+	case err != nil:
+		return err
+	}
+
 	s.payInvoices(globalCtx)
 
 	// Wait for confirmation of the on-chain htlc by watching for a tx
@@ -568,6 +615,70 @@ func (s *loopOutSwap) persistState(ctx context.Context) error {
 	return s.sendUpdate(ctx)
 }
 
+// setFeeLimits sets the fee limits that the swap is executed with. If the swap
+// has a total cost ceiling, the contract's limits are lowered so that the swap
+// fee, the routing fees of our payments and our sweep fee at current fee rates
+// stay within the ceiling. ErrTotalCostTooHigh is returned if the ceiling
+// cannot be met. If we cannot estimate our sweep fee, we keep the contract's
+// limits, because a fee estimator hiccup should not fail the swap.
+func (s *loopOutSwap) setFeeLimits(ctx context.Context) error {
+	s.feeLimits = loopOutFeeLimits{
+		swapRoutingFee:   s.MaxSwapRoutingFee,
+		prepayRoutingFee: s.MaxPrepayRoutingFee,
+		minerFee:         s.MaxMinerFee,
+	}
+
+	if s.MaxTotalCost == 0 {
+		return nil
+	}
+
+	chainParams := s.lnd.ChainParams
+	_, _, _, swapInvoiceAmt, err := swap.DecodeInvoice(
+		chainParams, s.SwapInvoice,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, _, _, prepayInvoiceAmt, err := swap.DecodeInvoice(
+		chainParams, s.PrepayInvoice,
+	)
+	if err != nil {
+		return err
+	}
+
+	sweepFee, err := s.sweeper.GetSweepFee(
+		ctx, s.htlc.AddSuccessToEstimator, s.DestAddr,
+		s.SweepConfTarget,
+	)
+	if err != nil {
+		s.log.Warnf("Could not estimate sweep fee, using contract fee "+
+			"limits: %v", err)
+
+		return nil
+	}
+
+	swapFee := swapInvoiceAmt + prepayInvoiceAmt - s.AmountRequested
+	limits, err := limitLoopOutFees(
+		s.feeLimits, s.MaxTotalCost, swapFee, sweepFee,
+	)
+	if err != nil {
+		s.log.Warnf("Swap fee %v and sweep fee %v exceed total cost "+
+			"ceiling of %v", swapFee, sweepFee, s.MaxTotalCost)
+
+		return err
+	}
+
+	s.log.Infof("Fee limits for total cost ceiling %v: swap routing "+
+		"fee %v, prepay routing fee %v, miner fee %v", s.MaxTotalCost,
+		limits.swapRoutingFee, limits.prepayRoutingFee,
+		limits.minerFee)
+
+	s.feeLimits = limits
+
+	return nil
+}
+
 // payInvoices pays both swap invoices.
 func (s *loopOutSwap) payInvoices(ctx context.Context) {
 	// Pay the swap invoice.
@@ -586,7 +697,7 @@ func (s *loopOutSwap) payInvoices(ctx context.Context) {
 
 	// Use the recommended routing plugin.
 	s.swapPaymentChan = s.payInvoice(
-		ctx, s.SwapInvoice, s.MaxSwapRoutingFee,
+		ctx, s.SwapInvoice, s.feeLimits.swapRoutingFee,
 		s.LoopOutContract.OutgoingChanSet, pluginType, true,
 	)
 
@@ -594,7 +705,7 @@ func (s *loopOutSwap) payInvoices(ctx context.Context) {
 	// prepay is trivially small and shouldn't normally need any help.
 	s.log.Infof("Sending prepayment %v", s.PrepayInvoice)
 	s.prePaymentChan = s.payInvoice(
-		ctx, s.PrepayInvoice, s.MaxPrepayRoutingFee,
+		ctx, s.PrepayInvoice, s.feeLimits.prepayRoutingFee,
 		nil, RoutingPluginNone, false,
 	)
 }
@@ -706,6 +817,7 @@ func (s *loopOutSwap) payInvoiceAsync(ctx context.Context,
 		OutgoingChanIds: outgoingChanIds,
 		Timeout:         paymentTimeout,
 		MaxParts:        s.executeConfig.loopOutMaxParts,
+		CustomRecords:   s.CustomRecords,
 	}
 
 	// Lookup state of the swap payment.
@@ -1269,15 +1381,15 @@ func (s *loopOutSwap) sweep(ctx context.Context,
 	}
 
 	// Ensure it doesn't exceed our maximum fee allowed.
-	if fee > s.MaxMinerFee {
+	if fee > s.feeLimits.minerFee {
 		s.log.Warnf("Required fee %v exceeds max miner fee of %v",
-			fee, s.MaxMinerFee)
+			fee, s.feeLimits.minerFee)
 
 		if preimageRevealed {
 			// The currently required fee exceeds the max, but we
 			// already revealed the preimage. The best we can do now
 			// is to republish with the max fee.
-			fee = s.MaxMinerFee
+			fee = s.feeLimits.minerFee
 		} else {
 			s.log.Warnf("Not revealing preimage")
 			return nil
@@ -1311,7 +1423,7 @@ func (s *loopOutSwap) sweep(ctx context.Context,
 
 	err = s.lnd.WalletKit.PublishTransaction(
 		ctx, sweepTx,
-		labels.LoopOutSweepSuccess(swap.ShortHash(&s.hash)),
+		labels.LoopOutSweepSuccess(s.hash),
 	)
 	if err != nil {
 		s.log.Warnf("Publish sweep: %v", err)
@@ -1321,48 +1433,171 @@ func (s *loopOutSwap) sweep(ctx context.Context,
 }
 
 // validateLoopOutContract validates the contract parameters against our
-// request.
+// request. It returns the amounts of the swap and prepay invoices. The
+// invoices are validated against the time provided, and we refuse to pay
+// them with an InvoiceMismatchError if they diverge from our request.
 func validateLoopOutContract(lnd *lndclient.LndServices,
-	height int32, request *OutRequest, swapHash lntypes.Hash,
-	response *newLoopOutResponse) error {
+	height int32, now time.Time, request *OutRequest,
+	swapHash lntypes.Hash,
+	response *newLoopOutResponse) (btcutil.Amount, btcutil.Amount, error) {
 
 	// Check invoice amounts.
 	chainParams := lnd.ChainParams
 
-	_, _, swapInvoiceHash, swapInvoiceAmt, err := swap.DecodeInvoice(
+	swapInvoice, err := decodeServerInvoice(
 		chainParams, response.swapInvoice,
 	)
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 
-	if swapInvoiceHash != swapHash {
-		return fmt.Errorf(
-			"cannot initiate swap, swap invoice hash %v not equal generated swap hash %v",
-			swapInvoiceHash, swapHash)
+	if swapInvoice.hash != swapHash {
+		return 0, 0, &InvoiceMismatchError{
+			Invoice:  invoiceSwap,
+			Field:    "payment hash",
+			Expected: swapHash.String(),
+			Actual:   swapInvoice.hash.String(),
+		}
 	}
 
-	_, _, _, prepayInvoiceAmt, err := swap.DecodeInvoice(
+	prepayInvoice, err := decodeServerInvoice(
 		chainParams, response.prepayInvoice,
 	)
 	if err != nil {
-		return err
+		return 0, 0, err
+	}
+
+	// Both invoices must pay to the server's node. If our request has
+	// the destination that the server advertised in its quote, we check
+	// against it, otherwise we require the invoices to share a
+	// destination.
+	expectedDest := swapInvoice.destination
+	if request.SwapPaymentDest != (route.Vertex{}) {
+		expectedDest = request.SwapPaymentDest
+	}
+
+	for _, invoice := range []struct {
+		name    string
+		invoice *serverInvoice
+	}{
+		{invoiceSwap, swapInvoice},
+		{invoicePrepay, prepayInvoice},
+	} {
+		if invoice.invoice.destination == expectedDest {
+			continue
+		}
+
+		return 0, 0, &InvoiceMismatchError{
+			Invoice:  invoice.name,
+			Field:    "destination",
+			Expected: expectedDest.String(),
+			Actual:   invoice.invoice.destination.String(),
+		}
 	}
 
+	swapInvoiceAmt := swapInvoice.amount
+	prepayInvoiceAmt := prepayInvoice.amount
+
 	swapFee := swapInvoiceAmt + prepayInvoiceAmt - request.Amount
 	if swapFee > request.MaxSwapFee {
 		log.Warnf("Swap fee %v exceeding maximum of %v",
 			swapFee, request.MaxSwapFee)
 
-		return ErrSwapFeeTooHigh
+		return 0, 0, ErrSwapFeeTooHigh
 	}
 
 	if prepayInvoiceAmt > request.MaxPrepayAmount {
 		log.Warnf("Prepay amount %v exceeding maximum of %v",
 			prepayInvoiceAmt, request.MaxPrepayAmount)
 
-		return ErrPrepayAmountTooHigh
+		return 0, 0, ErrPrepayAmountTooHigh
 	}
 
-	return nil
+	// Both invoices must be payable without locking our funds for longer
+	// than our on-chain htlc.
+	expiryDelta := request.Expiry - height
+
+	err = validateServerInvoice(invoiceSwap, swapInvoice, now, expiryDelta)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	err = validateServerInvoice(
+		invoicePrepay, prepayInvoice, now, expiryDelta,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// The prepay is forfeited if the swap fails, so both the swap fee and
+	// the prepay need to fit within our total cost ceiling.
+	if err := checkTotalCost(request.MaxTotalCost, swapFee); err != nil {
+		return 0, 0, err
+	}
+
+	err = checkTotalCost(request.MaxTotalCost, prepayInvoiceAmt)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return swapInvoiceAmt, prepayInvoiceAmt, nil
+}
+
+// checkTotalCost returns ErrTotalCostTooHigh if a swap has a total cost
+// ceiling and the cost provided exceeds it.
+func checkTotalCost(maxTotalCost, cost btcutil.Amount) error {
+	if maxTotalCost == 0 || cost <= maxTotalCost {
+		return nil
+	}
+
+	log.Warnf("Swap cost %v exceeding total cost ceiling of %v", cost,
+		maxTotalCost)
+
+	return ErrTotalCostTooHigh
+}
+
+// loopOutFeeLimits contains the fee limits that a loop out is executed with.
+type loopOutFeeLimits struct {
+	// swapRoutingFee is the maximum routing fee for the swap payment.
+	swapRoutingFee btcutil.Amount
+
+	// prepayRoutingFee is the maximum routing fee for the prepayment.
+	prepayRoutingFee btcutil.Amount
+
+	// minerFee is the maximum fee for our sweep.
+	minerFee btcutil.Amount
+}
+
+// limitLoopOutFees lowers a loop out's fee limits so that the swap fee, the
+// routing fees of our payments and our sweep fee stay within the total cost
+// ceiling provided. The budget that remains after the swap fee and our current
+// sweep fee estimate is assigned to the prepayment's routing fee first, then to
+// the swap payment's routing fee, and any remainder allows our sweep fee to
+// rise above the estimate. ErrTotalCostTooHigh is returned if the swap fee and
+// sweep fee estimate alone exceed the ceiling.
+func limitLoopOutFees(limits loopOutFeeLimits, maxTotalCost, swapFee,
+	sweepFee btcutil.Amount) (loopOutFeeLimits, error) {
+
+	budget := maxTotalCost - swapFee - sweepFee
+	if budget < 0 {
+		return loopOutFeeLimits{}, ErrTotalCostTooHigh
+	}
+
+	minAmount := func(a, b btcutil.Amount) btcutil.Amount {
+		if a < b {
+			return a
+		}
+
+		return b
+	}
+
+	limits.prepayRoutingFee = minAmount(limits.prepayRoutingFee, budget)
+	budget -= limits.prepayRoutingFee
+
+	limits.swapRoutingFee = minAmount(limits.swapRoutingFee, budget)
+	budget -= limits.swapRoutingFee
+
+	limits.minerFee = minAmount(limits.minerFee, sweepFee+budget)
+
+	return limits, nil
 }
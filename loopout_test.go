@@ -843,3 +843,230 @@ func TestFailedOffChainCancelation(t *testing.T) {
 	require.Equal(t, state.State, loopdb.StateFailOffchainPayments)
 	require.NoError(t, <-errChan)
 }
+
+// TestLimitLoopOutFees tests lowering of loop out fee limits to stay within a
+// total cost ceiling.
+func TestLimitLoopOutFees(t *testing.T) {
+	limits := loopOutFeeLimits{
+		swapRoutingFee:   100,
+		prepayRoutingFee: 10,
+		minerFee:         500,
+	}
+
+	tests := []struct {
+		name         string
+		maxTotalCost btcutil.Amount
+		swapFee      btcutil.Amount
+		sweepFee     btcutil.Amount
+		expected     loopOutFeeLimits
+		err          error
+	}{
+		{
+			name:         "ceiling above limits",
+			maxTotalCost: 10000,
+			swapFee:      1000,
+			sweepFee:     200,
+			expected:     limits,
+		},
+		{
+			name:         "swap routing fee lowered",
+			maxTotalCost: 1260,
+			swapFee:      1000,
+			sweepFee:     200,
+			expected: loopOutFeeLimits{
+				swapRoutingFee:   50,
+				prepayRoutingFee: 10,
+				minerFee:         200,
+			},
+		},
+		{
+			name:         "all limits lowered",
+			maxTotalCost: 1205,
+			swapFee:      1000,
+			sweepFee:     200,
+			expected: loopOutFeeLimits{
+				swapRoutingFee:   0,
+				prepayRoutingFee: 5,
+				minerFee:         200,
+			},
+		},
+		{
+			name:         "sweep fee raised within ceiling",
+			maxTotalCost: 1400,
+			swapFee:      1000,
+			sweepFee:     200,
+			expected: loopOutFeeLimits{
+				swapRoutingFee:   100,
+				prepayRoutingFee: 10,
+				minerFee:         290,
+			},
+		},
+		{
+			name:         "ceiling exceeded",
+			maxTotalCost: 1199,
+			swapFee:      1000,
+			sweepFee:     200,
+			err:          ErrTotalCostTooHigh,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			actual, err := limitLoopOutFees(
+				limits, testCase.maxTotalCost, testCase.swapFee,
+				testCase.sweepFee,
+			)
+			require.Equal(t, testCase.err, err)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+// TestLoopOutMaxTotalCost tests that a loop out is aborted before the server
+// is paid if its total cost ceiling cannot be met.
+func TestLoopOutMaxTotalCost(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	ctx := test.NewContext(t, lnd)
+	server := newServerMock(lnd)
+	store := newStoreMock(t)
+
+	height := int32(600)
+
+	cfg := &swapConfig{
+		lnd:    &lnd.LndServices,
+		store:  store,
+		server: server,
+	}
+
+	// Set a ceiling that covers our swap fee, but leaves nothing for our
+	// sweep.
+	req := *testRequest
+	req.MaxTotalCost = server.swapInvoiceAmt + server.prepayInvoiceAmt -
+		req.Amount
+
+	initResult, err := newLoopOutSwap(
+		context.Background(), cfg, height, &req,
+	)
+	require.NoError(t, err)
+	swap := initResult.swap
+
+	statusChan := make(chan SwapInfo)
+	errChan := make(chan error)
+
+	go func() {
+		err := swap.execute(context.Background(), &executeConfig{
+			statusChan:     statusChan,
+			sweeper:        &sweep.Sweeper{Lnd: &lnd.LndServices},
+			blockEpochChan: make(chan interface{}),
+			timerFactory:   time.After,
+			cancelSwap:     server.CancelLoopOutSwap,
+		}, height)
+		errChan <- err
+	}()
+
+	store.assertLoopOutStored()
+
+	state := <-statusChan
+	require.Equal(t, loopdb.StateInitiated, state.State)
+
+	store.assertLoopOutState(loopdb.StateFailMaxTotalCost)
+
+	state = <-statusChan
+	require.Equal(t, loopdb.StateFailMaxTotalCost, state.State)
+
+	require.NoError(t, <-errChan)
+
+	// We should not have paid any of the swap's invoices.
+	select {
+	case <-ctx.Lnd.RouterSendPaymentChannel:
+		t.Fatal("unexpected payment")
+
+	default:
+	}
+}
+
+// TestLoopOutMaxTotalCostResumed tests that a resumed loop out whose total
+// cost ceiling cannot be met is not aborted, because we may already have paid
+// the server in a previous run.
+func TestLoopOutMaxTotalCostResumed(t *testing.T) {
+	defer test.Guard(t)()
+
+	lnd := test.NewMockLnd()
+	ctx := test.NewContext(t, lnd)
+	server := newServerMock(lnd)
+	store := newStoreMock(t)
+
+	expiryChan := make(chan time.Time)
+	timerFactory := func(expiry time.Duration) <-chan time.Time {
+		return expiryChan
+	}
+
+	height := int32(600)
+
+	cfg := newSwapConfig(&lnd.LndServices, store, server)
+
+	// Set a ceiling that covers our swap fee, but leaves nothing for our
+	// sweep.
+	req := *testRequest
+	req.Expiry = height + testLoopOutMinOnChainCltvDelta
+	req.MaxTotalCost = server.swapInvoiceAmt + server.prepayInvoiceAmt -
+		req.Amount
+
+	initResult, err := newLoopOutSwap(
+		context.Background(), cfg, height, &req,
+	)
+	require.NoError(t, err)
+	swap := initResult.swap
+
+	// Mark our swap as resumed, as it would be if it was restored from the
+	// database after a restart.
+	swap.resumed = true
+
+	blockEpochChan := make(chan interface{})
+	statusChan := make(chan SwapInfo)
+	errChan := make(chan error)
+
+	go func() {
+		err := swap.execute(context.Background(), &executeConfig{
+			statusChan:     statusChan,
+			sweeper:        &sweep.Sweeper{Lnd: &lnd.LndServices},
+			blockEpochChan: blockEpochChan,
+			timerFactory:   timerFactory,
+			cancelSwap:     server.CancelLoopOutSwap,
+		}, height)
+		errChan <- err
+	}()
+
+	store.assertLoopOutStored()
+
+	state := <-statusChan
+	require.Equal(t, loopdb.StateInitiated, state.State)
+
+	// Rather than aborting, we should pay both of the swap's invoices
+	// with the contract's fee limits.
+	signalSwapPaymentResult := ctx.AssertPaid(swapInvoiceDesc)
+	signalPrepaymentResult := ctx.AssertPaid(prepayInvoiceDesc)
+
+	ctx.AssertRegisterConf(false, defaultConfirmations)
+
+	// Let the swap time out so that it completes.
+	blockEpochChan <- int32(swap.CltvExpiry - 10)
+
+	signalSwapPaymentResult(
+		errors.New(lndclient.PaymentResultUnknownPaymentHash),
+	)
+	signalPrepaymentResult(
+		errors.New(lndclient.PaymentResultUnknownPaymentHash),
+	)
+
+	store.assertStoreFinished(loopdb.StateFailTimeout)
+
+	state = <-statusChan
+	require.Equal(t, loopdb.StateFailTimeout, state.State)
+
+	require.NoError(t, <-errChan)
+}
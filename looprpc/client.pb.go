@@ -21,6 +21,121 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DestVerificationMethod int32
+
+const (
+	//
+	//The destination was not verified.
+	DestVerificationMethod_DEST_VERIFICATION_NONE DestVerificationMethod = 0
+	//
+	//The destination was verified with a message signed by its key.
+	DestVerificationMethod_DEST_VERIFICATION_SIGNATURE DestVerificationMethod = 1
+	//
+	//The destination was derived from a known extended public key.
+	DestVerificationMethod_DEST_VERIFICATION_XPUB DestVerificationMethod = 2
+)
+
+// Enum value maps for DestVerificationMethod.
+var (
+	DestVerificationMethod_name = map[int32]string{
+		0: "DEST_VERIFICATION_NONE",
+		1: "DEST_VERIFICATION_SIGNATURE",
+		2: "DEST_VERIFICATION_XPUB",
+	}
+	DestVerificationMethod_value = map[string]int32{
+		"DEST_VERIFICATION_NONE":      0,
+		"DEST_VERIFICATION_SIGNATURE": 1,
+		"DEST_VERIFICATION_XPUB":      2,
+	}
+)
+
+func (x DestVerificationMethod) Enum() *DestVerificationMethod {
+	p := new(DestVerificationMethod)
+	*p = x
+	return p
+}
+
+func (x DestVerificationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DestVerificationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[0].Descriptor()
+}
+
+func (DestVerificationMethod) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[0]
+}
+
+func (x DestVerificationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DestVerificationMethod.Descriptor instead.
+func (DestVerificationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{0}
+}
+
+type DisplayUnit int32
+
+const (
+	//
+	//Amounts are not formatted for display.
+	DisplayUnit_DISPLAY_UNIT_NONE DisplayUnit = 0
+	//
+	//Amounts are formatted in satoshis.
+	DisplayUnit_DISPLAY_UNIT_SAT DisplayUnit = 1
+	//
+	//Amounts are formatted in bitcoin, with all eight decimal places.
+	DisplayUnit_DISPLAY_UNIT_BTC DisplayUnit = 2
+	//
+	//Amounts are formatted in millisatoshis.
+	DisplayUnit_DISPLAY_UNIT_MSAT DisplayUnit = 3
+)
+
+// Enum value maps for DisplayUnit.
+var (
+	DisplayUnit_name = map[int32]string{
+		0: "DISPLAY_UNIT_NONE",
+		1: "DISPLAY_UNIT_SAT",
+		2: "DISPLAY_UNIT_BTC",
+		3: "DISPLAY_UNIT_MSAT",
+	}
+	DisplayUnit_value = map[string]int32{
+		"DISPLAY_UNIT_NONE": 0,
+		"DISPLAY_UNIT_SAT":  1,
+		"DISPLAY_UNIT_BTC":  2,
+		"DISPLAY_UNIT_MSAT": 3,
+	}
+)
+
+func (x DisplayUnit) Enum() *DisplayUnit {
+	p := new(DisplayUnit)
+	*p = x
+	return p
+}
+
+func (x DisplayUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DisplayUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[1].Descriptor()
+}
+
+func (DisplayUnit) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[1]
+}
+
+func (x DisplayUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DisplayUnit.Descriptor instead.
+func (DisplayUnit) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{1}
+}
+
 type SwapType int32
 
 const (
@@ -53,11 +168,11 @@ func (x SwapType) String() string {
 }
 
 func (SwapType) Descriptor() protoreflect.EnumDescriptor {
-	return file_client_proto_enumTypes[0].Descriptor()
+	return file_client_proto_enumTypes[2].Descriptor()
 }
 
 func (SwapType) Type() protoreflect.EnumType {
-	return &file_client_proto_enumTypes[0]
+	return &file_client_proto_enumTypes[2]
 }
 
 func (x SwapType) Number() protoreflect.EnumNumber {
@@ -66,7 +181,7 @@ func (x SwapType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SwapType.Descriptor instead.
 func (SwapType) EnumDescriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{0}
+	return file_client_proto_rawDescGZIP(), []int{2}
 }
 
 type SwapState int32
@@ -133,11 +248,11 @@ func (x SwapState) String() string {
 }
 
 func (SwapState) Descriptor() protoreflect.EnumDescriptor {
-	return file_client_proto_enumTypes[1].Descriptor()
+	return file_client_proto_enumTypes[3].Descriptor()
 }
 
 func (SwapState) Type() protoreflect.EnumType {
-	return &file_client_proto_enumTypes[1]
+	return &file_client_proto_enumTypes[3]
 }
 
 func (x SwapState) Number() protoreflect.EnumNumber {
@@ -146,7 +261,7 @@ func (x SwapState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SwapState.Descriptor instead.
 func (SwapState) EnumDescriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{1}
+	return file_client_proto_rawDescGZIP(), []int{3}
 }
 
 type FailureReason int32
@@ -183,6 +298,11 @@ const (
 	//FAILURE_REASON_INCORRECT_AMOUNT indicates that a loop in permanently failed
 	//because the amount extended by an external loop in htlc is insufficient.
 	FailureReason_FAILURE_REASON_INCORRECT_AMOUNT FailureReason = 6
+	//
+	//FAILURE_REASON_MAX_TOTAL_COST indicates that a swap was aborted before any
+	//funds were committed, because its costs would have exceeded the swap's
+	//total cost ceiling.
+	FailureReason_FAILURE_REASON_MAX_TOTAL_COST FailureReason = 7
 )
 
 // Enum value maps for FailureReason.
@@ -195,6 +315,7 @@ var (
 		4: "FAILURE_REASON_INSUFFICIENT_VALUE",
 		5: "FAILURE_REASON_TEMPORARY",
 		6: "FAILURE_REASON_INCORRECT_AMOUNT",
+		7: "FAILURE_REASON_MAX_TOTAL_COST",
 	}
 	FailureReason_value = map[string]int32{
 		"FAILURE_REASON_NONE":               0,
@@ -204,6 +325,7 @@ var (
 		"FAILURE_REASON_INSUFFICIENT_VALUE": 4,
 		"FAILURE_REASON_TEMPORARY":          5,
 		"FAILURE_REASON_INCORRECT_AMOUNT":   6,
+		"FAILURE_REASON_MAX_TOTAL_COST":     7,
 	}
 )
 
@@ -218,11 +340,11 @@ func (x FailureReason) String() string {
 }
 
 func (FailureReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_client_proto_enumTypes[2].Descriptor()
+	return file_client_proto_enumTypes[4].Descriptor()
 }
 
 func (FailureReason) Type() protoreflect.EnumType {
-	return &file_client_proto_enumTypes[2]
+	return &file_client_proto_enumTypes[4]
 }
 
 func (x FailureReason) Number() protoreflect.EnumNumber {
@@ -231,7 +353,125 @@ func (x FailureReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FailureReason.Descriptor instead.
 func (FailureReason) EnumDescriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{2}
+	return file_client_proto_rawDescGZIP(), []int{4}
+}
+
+type AccountingCheck int32
+
+const (
+	//
+	//The server cost of a loop out, compared to the value of its payments less
+	//the amount of its htlc if it succeeded.
+	AccountingCheck_ACCOUNTING_CHECK_SERVER_COST AccountingCheck = 0
+	//
+	//The off-chain fees of a loop out, compared to the routing fees of its
+	//payments.
+	AccountingCheck_ACCOUNTING_CHECK_OFFCHAIN_FEES AccountingCheck = 1
+	//
+	//The on-chain fees of a swap, compared to the fees of its transactions in
+	//lnd's wallet.
+	AccountingCheck_ACCOUNTING_CHECK_ONCHAIN_FEES AccountingCheck = 2
+)
+
+// Enum value maps for AccountingCheck.
+var (
+	AccountingCheck_name = map[int32]string{
+		0: "ACCOUNTING_CHECK_SERVER_COST",
+		1: "ACCOUNTING_CHECK_OFFCHAIN_FEES",
+		2: "ACCOUNTING_CHECK_ONCHAIN_FEES",
+	}
+	AccountingCheck_value = map[string]int32{
+		"ACCOUNTING_CHECK_SERVER_COST":   0,
+		"ACCOUNTING_CHECK_OFFCHAIN_FEES": 1,
+		"ACCOUNTING_CHECK_ONCHAIN_FEES":  2,
+	}
+)
+
+func (x AccountingCheck) Enum() *AccountingCheck {
+	p := new(AccountingCheck)
+	*p = x
+	return p
+}
+
+func (x AccountingCheck) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountingCheck) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[5].Descriptor()
+}
+
+func (AccountingCheck) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[5]
+}
+
+func (x AccountingCheck) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountingCheck.Descriptor instead.
+func (AccountingCheck) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{5}
+}
+
+type WatchedSwapState int32
+
+const (
+	//
+	//lnd does not have any records for the swap yet.
+	WatchedSwapState_WATCHED_SWAP_UNKNOWN WatchedSwapState = 0
+	//
+	//The swap's off-chain payment is still in flight.
+	WatchedSwapState_WATCHED_SWAP_PENDING WatchedSwapState = 1
+	//
+	//The swap's off-chain payment settled.
+	WatchedSwapState_WATCHED_SWAP_SUCCESS WatchedSwapState = 2
+	//
+	//The swap's off-chain payment failed.
+	WatchedSwapState_WATCHED_SWAP_FAILED WatchedSwapState = 3
+)
+
+// Enum value maps for WatchedSwapState.
+var (
+	WatchedSwapState_name = map[int32]string{
+		0: "WATCHED_SWAP_UNKNOWN",
+		1: "WATCHED_SWAP_PENDING",
+		2: "WATCHED_SWAP_SUCCESS",
+		3: "WATCHED_SWAP_FAILED",
+	}
+	WatchedSwapState_value = map[string]int32{
+		"WATCHED_SWAP_UNKNOWN": 0,
+		"WATCHED_SWAP_PENDING": 1,
+		"WATCHED_SWAP_SUCCESS": 2,
+		"WATCHED_SWAP_FAILED":  3,
+	}
+)
+
+func (x WatchedSwapState) Enum() *WatchedSwapState {
+	p := new(WatchedSwapState)
+	*p = x
+	return p
+}
+
+func (x WatchedSwapState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchedSwapState) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[6].Descriptor()
+}
+
+func (WatchedSwapState) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[6]
+}
+
+func (x WatchedSwapState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchedSwapState.Descriptor instead.
+func (WatchedSwapState) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{6}
 }
 
 type LiquidityRuleType int32
@@ -264,11 +504,11 @@ func (x LiquidityRuleType) String() string {
 }
 
 func (LiquidityRuleType) Descriptor() protoreflect.EnumDescriptor {
-	return file_client_proto_enumTypes[3].Descriptor()
+	return file_client_proto_enumTypes[7].Descriptor()
 }
 
 func (LiquidityRuleType) Type() protoreflect.EnumType {
-	return &file_client_proto_enumTypes[3]
+	return &file_client_proto_enumTypes[7]
 }
 
 func (x LiquidityRuleType) Number() protoreflect.EnumNumber {
@@ -277,1018 +517,1407 @@ func (x LiquidityRuleType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LiquidityRuleType.Descriptor instead.
 func (LiquidityRuleType) EnumDescriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{3}
+	return file_client_proto_rawDescGZIP(), []int{7}
 }
 
-type AutoReason int32
+// ShrinkPolicy determines how autoloop handles a swap suggested for a rule when
+// the server lowers its maximum swap amount below the swap's amount before the
+// swap is dispatched.
+type ShrinkPolicy int32
 
 const (
-	AutoReason_AUTO_REASON_UNKNOWN AutoReason = 0
-	//
-	//Budget not started indicates that we do not recommend any swaps because
-	//the start time for our budget has not arrived yet.
-	AutoReason_AUTO_REASON_BUDGET_NOT_STARTED AutoReason = 1
-	//
-	//Sweep fees indicates that the estimated fees to sweep swaps are too high
-	//right now.
-	AutoReason_AUTO_REASON_SWEEP_FEES AutoReason = 2
-	//
-	//Budget elapsed indicates that the autoloop budget for the period has been
-	//elapsed.
-	AutoReason_AUTO_REASON_BUDGET_ELAPSED AutoReason = 3
-	//
-	//In flight indicates that the limit on in-flight automatically dispatched
-	//swaps has already been reached.
-	AutoReason_AUTO_REASON_IN_FLIGHT AutoReason = 4
-	//
-	//Swap fee indicates that the server fee for a specific swap is too high.
-	AutoReason_AUTO_REASON_SWAP_FEE AutoReason = 5
-	//
-	//Miner fee indicates that the miner fee for a specific swap is to high.
-	AutoReason_AUTO_REASON_MINER_FEE AutoReason = 6
-	//
-	//Prepay indicates that the prepay fee for a specific swap is too high.
-	AutoReason_AUTO_REASON_PREPAY AutoReason = 7
-	//
-	//Failure backoff indicates that a swap has recently failed for this target,
-	//and the backoff period has not yet passed.
-	AutoReason_AUTO_REASON_FAILURE_BACKOFF AutoReason = 8
 	//
-	//Loop out indicates that a loop out swap is currently utilizing the channel,
-	//so it is not eligible.
-	AutoReason_AUTO_REASON_LOOP_OUT AutoReason = 9
+	//Do not dispatch the swap. It will be suggested again within the server's
+	//new limits on the next autoloop tick.
+	ShrinkPolicy_SHRINK_POLICY_SKIP ShrinkPolicy = 0
+	// Lower the amount of the swap to the server's new maximum.
+	ShrinkPolicy_SHRINK_POLICY_RESIZE ShrinkPolicy = 1
 	//
-	//Loop In indicates that a loop in swap is currently in flight for the peer,
-	//so it is not eligible.
-	AutoReason_AUTO_REASON_LOOP_IN AutoReason = 10
+	//Split the swap into multiple swaps that each fall within the server's new
+	//maximum, as far as the autoloop in flight limit allows.
+	ShrinkPolicy_SHRINK_POLICY_SPLIT ShrinkPolicy = 2
+)
+
+// Enum value maps for ShrinkPolicy.
+var (
+	ShrinkPolicy_name = map[int32]string{
+		0: "SHRINK_POLICY_SKIP",
+		1: "SHRINK_POLICY_RESIZE",
+		2: "SHRINK_POLICY_SPLIT",
+	}
+	ShrinkPolicy_value = map[string]int32{
+		"SHRINK_POLICY_SKIP":   0,
+		"SHRINK_POLICY_RESIZE": 1,
+		"SHRINK_POLICY_SPLIT":  2,
+	}
+)
+
+func (x ShrinkPolicy) Enum() *ShrinkPolicy {
+	p := new(ShrinkPolicy)
+	*p = x
+	return p
+}
+
+func (x ShrinkPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShrinkPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[8].Descriptor()
+}
+
+func (ShrinkPolicy) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[8]
+}
+
+func (x ShrinkPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShrinkPolicy.Descriptor instead.
+func (ShrinkPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{8}
+}
+
+// RuleConflict determines how autoloop resolves the rules of a channel that has
+// a rule of its own, and whose peer also has a rule.
+type RuleConflict int32
+
+const (
 	//
-	//Liquidity ok indicates that a target meets the liquidity balance expressed
-	//in its rule, so no swap is needed.
-	AutoReason_AUTO_REASON_LIQUIDITY_OK AutoReason = 11
+	//Do not allow a channel and its peer to both have a rule. Channels that
+	//are opened with a peer after both rules were set are not managed by
+	//either rule.
+	RuleConflict_RULE_CONFLICT_REJECT RuleConflict = 0
 	//
-	//Budget insufficient indicates that we cannot perform a swap because we do
-	//not have enough pending budget available. This differs from budget elapsed,
-	//because we still have some budget available, but we have allocated it to
-	//other swaps.
-	AutoReason_AUTO_REASON_BUDGET_INSUFFICIENT AutoReason = 12
+	//Apply the channel's rule to the channel, and the peer's rule to the
+	//peer's remaining channels.
+	RuleConflict_RULE_CONFLICT_CHANNEL RuleConflict = 1
 	//
-	//Fee insufficient indicates that the fee estimate for a swap is higher than
-	//the portion of total swap amount that we allow fees to consume.
-	AutoReason_AUTO_REASON_FEE_INSUFFICIENT AutoReason = 13
+	//Apply the stricter of the two rules to the channel. A rule is stricter
+	//if it has the same swap type and no higher thresholds than the other
+	//rule. Rules that have no stricter rule are rejected.
+	RuleConflict_RULE_CONFLICT_STRICTEST RuleConflict = 2
 )
 
-// Enum value maps for AutoReason.
+// Enum value maps for RuleConflict.
 var (
-	AutoReason_name = map[int32]string{
-		0:  "AUTO_REASON_UNKNOWN",
-		1:  "AUTO_REASON_BUDGET_NOT_STARTED",
-		2:  "AUTO_REASON_SWEEP_FEES",
-		3:  "AUTO_REASON_BUDGET_ELAPSED",
-		4:  "AUTO_REASON_IN_FLIGHT",
-		5:  "AUTO_REASON_SWAP_FEE",
-		6:  "AUTO_REASON_MINER_FEE",
-		7:  "AUTO_REASON_PREPAY",
-		8:  "AUTO_REASON_FAILURE_BACKOFF",
-		9:  "AUTO_REASON_LOOP_OUT",
-		10: "AUTO_REASON_LOOP_IN",
-		11: "AUTO_REASON_LIQUIDITY_OK",
-		12: "AUTO_REASON_BUDGET_INSUFFICIENT",
-		13: "AUTO_REASON_FEE_INSUFFICIENT",
+	RuleConflict_name = map[int32]string{
+		0: "RULE_CONFLICT_REJECT",
+		1: "RULE_CONFLICT_CHANNEL",
+		2: "RULE_CONFLICT_STRICTEST",
 	}
-	AutoReason_value = map[string]int32{
-		"AUTO_REASON_UNKNOWN":             0,
-		"AUTO_REASON_BUDGET_NOT_STARTED":  1,
-		"AUTO_REASON_SWEEP_FEES":          2,
-		"AUTO_REASON_BUDGET_ELAPSED":      3,
-		"AUTO_REASON_IN_FLIGHT":           4,
-		"AUTO_REASON_SWAP_FEE":            5,
-		"AUTO_REASON_MINER_FEE":           6,
-		"AUTO_REASON_PREPAY":              7,
-		"AUTO_REASON_FAILURE_BACKOFF":     8,
-		"AUTO_REASON_LOOP_OUT":            9,
-		"AUTO_REASON_LOOP_IN":             10,
-		"AUTO_REASON_LIQUIDITY_OK":        11,
-		"AUTO_REASON_BUDGET_INSUFFICIENT": 12,
-		"AUTO_REASON_FEE_INSUFFICIENT":    13,
+	RuleConflict_value = map[string]int32{
+		"RULE_CONFLICT_REJECT":    0,
+		"RULE_CONFLICT_CHANNEL":   1,
+		"RULE_CONFLICT_STRICTEST": 2,
 	}
 )
 
-func (x AutoReason) Enum() *AutoReason {
-	p := new(AutoReason)
+func (x RuleConflict) Enum() *RuleConflict {
+	p := new(RuleConflict)
 	*p = x
 	return p
 }
 
-func (x AutoReason) String() string {
+func (x RuleConflict) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (AutoReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_client_proto_enumTypes[4].Descriptor()
+func (RuleConflict) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[9].Descriptor()
 }
 
-func (AutoReason) Type() protoreflect.EnumType {
-	return &file_client_proto_enumTypes[4]
+func (RuleConflict) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[9]
 }
 
-func (x AutoReason) Number() protoreflect.EnumNumber {
+func (x RuleConflict) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use AutoReason.Descriptor instead.
-func (AutoReason) EnumDescriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use RuleConflict.Descriptor instead.
+func (RuleConflict) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{9}
 }
 
-type LoopOutRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// LoopInSpread determines how autoloop spreads its loop in swaps across the last
+// hops that qualify for them.
+type LoopInSpread int32
 
+const (
 	//
-	//Requested swap amount in sat. This does not include the swap and miner fee.
-	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//Order loop in swaps by amount, so that the peers that need the most
+	//inbound liquidity are always swapped first.
+	LoopInSpread_LOOP_IN_SPREAD_NONE LoopInSpread = 0
 	//
-	//Base58 encoded destination address for the swap.
-	Dest string `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	//Prioritize the last hops that autoloop has gone the longest without
+	//looping in through, so that it cycles through peers over time.
+	LoopInSpread_LOOP_IN_SPREAD_ROUND_ROBIN LoopInSpread = 1
 	//
-	//Maximum off-chain fee in sat that may be paid for swap payment to the
-	//server. This limit is applied during path finding. Typically this value is
-	//taken from the response of the GetQuote call.
-	MaxSwapRoutingFee int64 `protobuf:"varint,3,opt,name=max_swap_routing_fee,json=maxSwapRoutingFee,proto3" json:"max_swap_routing_fee,omitempty"`
-	//
-	//Maximum off-chain fee in sat that may be paid for the prepay to the server.
-	//This limit is applied during path finding. Typically this value is taken
-	//from the response of the GetQuote call.
-	MaxPrepayRoutingFee int64 `protobuf:"varint,4,opt,name=max_prepay_routing_fee,json=maxPrepayRoutingFee,proto3" json:"max_prepay_routing_fee,omitempty"`
-	//
-	//Maximum we are willing to pay the server for the swap. This value is not
-	//disclosed in the swap initiation call, but if the server asks for a
-	//higher fee, we abort the swap. Typically this value is taken from the
-	//response of the GetQuote call. It includes the prepay amount.
-	MaxSwapFee int64 `protobuf:"varint,5,opt,name=max_swap_fee,json=maxSwapFee,proto3" json:"max_swap_fee,omitempty"`
-	//
-	//Maximum amount of the swap fee that may be charged as a prepayment.
-	MaxPrepayAmt int64 `protobuf:"varint,6,opt,name=max_prepay_amt,json=maxPrepayAmt,proto3" json:"max_prepay_amt,omitempty"`
-	//
-	//Maximum in on-chain fees that we are willing to spend. If we want to
-	//sweep the on-chain htlc and the fee estimate turns out higher than this
-	//value, we cancel the swap. If the fee estimate is lower, we publish the
-	//sweep tx.
-	//
-	//If the sweep tx is not confirmed, we are forced to ratchet up fees until it
-	//is swept. Possibly even exceeding max_miner_fee if we get close to the htlc
-	//timeout. Because the initial publication revealed the preimage, we have no
-	//other choice. The server may already have pulled the off-chain htlc. Only
-	//when the fee becomes higher than the swap amount, we can only wait for fees
-	//to come down and hope - if we are past the timeout - that the server is not
-	//publishing the revocation.
-	//
-	//max_miner_fee is typically taken from the response of the GetQuote call.
-	MaxMinerFee int64 `protobuf:"varint,7,opt,name=max_miner_fee,json=maxMinerFee,proto3" json:"max_miner_fee,omitempty"`
-	//
-	//Deprecated, use outgoing_chan_set. The channel to loop out, the channel
-	//to loop out is selected based on the lowest routing fee for the swap
-	//payment to the server.
-	//
-	// Deprecated: Do not use.
-	LoopOutChannel uint64 `protobuf:"varint,8,opt,name=loop_out_channel,json=loopOutChannel,proto3" json:"loop_out_channel,omitempty"`
-	//
-	//A restriction on the channel set that may be used to loop out. The actual
-	//channel(s) that will be used are selected based on the lowest routing fee
-	//for the swap payment to the server.
-	OutgoingChanSet []uint64 `protobuf:"varint,11,rep,packed,name=outgoing_chan_set,json=outgoingChanSet,proto3" json:"outgoing_chan_set,omitempty"`
-	//
-	//The number of blocks from the on-chain HTLC's confirmation height that it
-	//should be swept within.
-	SweepConfTarget int32 `protobuf:"varint,9,opt,name=sweep_conf_target,json=sweepConfTarget,proto3" json:"sweep_conf_target,omitempty"`
+	//Prioritize the last hops whose recent loop ins are the smallest portion
+	//of the node's capacity with the peer, so that inbound liquidity is spread
+	//across peers in proportion to the size of the node's channels with them.
+	LoopInSpread_LOOP_IN_SPREAD_CAPACITY LoopInSpread = 2
+)
+
+// Enum value maps for LoopInSpread.
+var (
+	LoopInSpread_name = map[int32]string{
+		0: "LOOP_IN_SPREAD_NONE",
+		1: "LOOP_IN_SPREAD_ROUND_ROBIN",
+		2: "LOOP_IN_SPREAD_CAPACITY",
+	}
+	LoopInSpread_value = map[string]int32{
+		"LOOP_IN_SPREAD_NONE":        0,
+		"LOOP_IN_SPREAD_ROUND_ROBIN": 1,
+		"LOOP_IN_SPREAD_CAPACITY":    2,
+	}
+)
+
+func (x LoopInSpread) Enum() *LoopInSpread {
+	p := new(LoopInSpread)
+	*p = x
+	return p
+}
+
+func (x LoopInSpread) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoopInSpread) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[10].Descriptor()
+}
+
+func (LoopInSpread) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[10]
+}
+
+func (x LoopInSpread) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoopInSpread.Descriptor instead.
+func (LoopInSpread) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{10}
+}
+
+// ConfigSource describes where the effective value of a setting was set.
+type ConfigSource int32
+
+const (
 	//
-	//The number of confirmations that we require for the on chain htlc that will
-	//be published by the server before we reveal the preimage.
-	HtlcConfirmations int32 `protobuf:"varint,13,opt,name=htlc_confirmations,json=htlcConfirmations,proto3" json:"htlc_confirmations,omitempty"`
+	//The setting has its default value.
+	ConfigSource_CONFIG_SOURCE_DEFAULT ConfigSource = 0
 	//
-	//The latest time (in unix seconds) we allow the server to wait before
-	//publishing the HTLC on chain. Setting this to a larger value will give the
-	//server the opportunity to batch multiple swaps together, and wait for
-	//low-fee periods before publishing the HTLC, potentially resulting in a
-	//lower total swap fee.
-	SwapPublicationDeadline uint64 `protobuf:"varint,10,opt,name=swap_publication_deadline,json=swapPublicationDeadline,proto3" json:"swap_publication_deadline,omitempty"`
+	//The setting was set in loopd's configuration file.
+	ConfigSource_CONFIG_SOURCE_CONFIG_FILE ConfigSource = 1
 	//
-	//An optional label for this swap. This field is limited to 500 characters
-	//and may not start with the prefix [reserved], which is used to tag labels
-	//produced by the daemon.
-	Label string `protobuf:"bytes,12,opt,name=label,proto3" json:"label,omitempty"`
+	//The setting was set on loopd's command line, which overrides the
+	//configuration file.
+	ConfigSource_CONFIG_SOURCE_COMMAND_LINE ConfigSource = 2
 	//
-	//An optional identification string that will be appended to the user agent
-	//string sent to the server to give information about the usage of loop. This
-	//initiator part is meant for user interfaces to add their name to give the
-	//full picture of the binary used (loopd, LiT) and the method used for
-	//triggering the swap (loop CLI, autolooper, LiT UI, other 3rd party UI).
-	Initiator string `protobuf:"bytes,14,opt,name=initiator,proto3" json:"initiator,omitempty"`
-}
+	//The liquidity parameter was changed from its default value over rpc.
+	ConfigSource_CONFIG_SOURCE_RPC ConfigSource = 3
+)
 
-func (x *LoopOutRequest) Reset() {
-	*x = LoopOutRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for ConfigSource.
+var (
+	ConfigSource_name = map[int32]string{
+		0: "CONFIG_SOURCE_DEFAULT",
+		1: "CONFIG_SOURCE_CONFIG_FILE",
+		2: "CONFIG_SOURCE_COMMAND_LINE",
+		3: "CONFIG_SOURCE_RPC",
+	}
+	ConfigSource_value = map[string]int32{
+		"CONFIG_SOURCE_DEFAULT":      0,
+		"CONFIG_SOURCE_CONFIG_FILE":  1,
+		"CONFIG_SOURCE_COMMAND_LINE": 2,
+		"CONFIG_SOURCE_RPC":          3,
 	}
-}
+)
 
-func (x *LoopOutRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ConfigSource) Enum() *ConfigSource {
+	p := new(ConfigSource)
+	*p = x
+	return p
 }
 
-func (*LoopOutRequest) ProtoMessage() {}
+func (x ConfigSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *LoopOutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ConfigSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[11].Descriptor()
 }
 
-// Deprecated: Use LoopOutRequest.ProtoReflect.Descriptor instead.
-func (*LoopOutRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{0}
+func (ConfigSource) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[11]
 }
 
-func (x *LoopOutRequest) GetAmt() int64 {
-	if x != nil {
-		return x.Amt
-	}
-	return 0
+func (x ConfigSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LoopOutRequest) GetDest() string {
-	if x != nil {
-		return x.Dest
-	}
-	return ""
+// Deprecated: Use ConfigSource.Descriptor instead.
+func (ConfigSource) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *LoopOutRequest) GetMaxSwapRoutingFee() int64 {
-	if x != nil {
-		return x.MaxSwapRoutingFee
+type RuleChangeType int32
+
+const (
+	//
+	//The channel or peer gains a rule.
+	RuleChangeType_RULE_CHANGE_ADDED RuleChangeType = 0
+	//
+	//The channel or peer's rule is replaced.
+	RuleChangeType_RULE_CHANGE_CHANGED RuleChangeType = 1
+	//
+	//The channel or peer loses its rule.
+	RuleChangeType_RULE_CHANGE_REMOVED RuleChangeType = 2
+)
+
+// Enum value maps for RuleChangeType.
+var (
+	RuleChangeType_name = map[int32]string{
+		0: "RULE_CHANGE_ADDED",
+		1: "RULE_CHANGE_CHANGED",
+		2: "RULE_CHANGE_REMOVED",
 	}
-	return 0
+	RuleChangeType_value = map[string]int32{
+		"RULE_CHANGE_ADDED":   0,
+		"RULE_CHANGE_CHANGED": 1,
+		"RULE_CHANGE_REMOVED": 2,
+	}
+)
+
+func (x RuleChangeType) Enum() *RuleChangeType {
+	p := new(RuleChangeType)
+	*p = x
+	return p
 }
 
-func (x *LoopOutRequest) GetMaxPrepayRoutingFee() int64 {
-	if x != nil {
-		return x.MaxPrepayRoutingFee
-	}
-	return 0
+func (x RuleChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *LoopOutRequest) GetMaxSwapFee() int64 {
-	if x != nil {
-		return x.MaxSwapFee
-	}
-	return 0
+func (RuleChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[12].Descriptor()
 }
 
-func (x *LoopOutRequest) GetMaxPrepayAmt() int64 {
-	if x != nil {
-		return x.MaxPrepayAmt
-	}
-	return 0
+func (RuleChangeType) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[12]
 }
 
-func (x *LoopOutRequest) GetMaxMinerFee() int64 {
-	if x != nil {
-		return x.MaxMinerFee
-	}
-	return 0
+func (x RuleChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *LoopOutRequest) GetLoopOutChannel() uint64 {
-	if x != nil {
-		return x.LoopOutChannel
-	}
-	return 0
+// Deprecated: Use RuleChangeType.Descriptor instead.
+func (RuleChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *LoopOutRequest) GetOutgoingChanSet() []uint64 {
-	if x != nil {
-		return x.OutgoingChanSet
+type ParamsChangeSource int32
+
+const (
+	//
+	//The parameters were set over rpc.
+	ParamsChangeSource_PARAMS_CHANGE_RPC ParamsChangeSource = 0
+	//
+	//The parameters were set by a scheduled profile switch.
+	ParamsChangeSource_PARAMS_CHANGE_PROFILE ParamsChangeSource = 1
+	//
+	//A delayed increase to the autoloop fee budget or fee limit took effect.
+	ParamsChangeSource_PARAMS_CHANGE_DELAYED_INCREASE ParamsChangeSource = 2
+)
+
+// Enum value maps for ParamsChangeSource.
+var (
+	ParamsChangeSource_name = map[int32]string{
+		0: "PARAMS_CHANGE_RPC",
+		1: "PARAMS_CHANGE_PROFILE",
+		2: "PARAMS_CHANGE_DELAYED_INCREASE",
 	}
-	return nil
+	ParamsChangeSource_value = map[string]int32{
+		"PARAMS_CHANGE_RPC":              0,
+		"PARAMS_CHANGE_PROFILE":          1,
+		"PARAMS_CHANGE_DELAYED_INCREASE": 2,
+	}
+)
+
+func (x ParamsChangeSource) Enum() *ParamsChangeSource {
+	p := new(ParamsChangeSource)
+	*p = x
+	return p
 }
 
-func (x *LoopOutRequest) GetSweepConfTarget() int32 {
-	if x != nil {
-		return x.SweepConfTarget
-	}
-	return 0
+func (x ParamsChangeSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *LoopOutRequest) GetHtlcConfirmations() int32 {
-	if x != nil {
-		return x.HtlcConfirmations
-	}
-	return 0
+func (ParamsChangeSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[13].Descriptor()
 }
 
-func (x *LoopOutRequest) GetSwapPublicationDeadline() uint64 {
-	if x != nil {
-		return x.SwapPublicationDeadline
-	}
-	return 0
+func (ParamsChangeSource) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[13]
 }
 
-func (x *LoopOutRequest) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
+func (x ParamsChangeSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LoopOutRequest) GetInitiator() string {
-	if x != nil {
-		return x.Initiator
-	}
-	return ""
+// Deprecated: Use ParamsChangeSource.Descriptor instead.
+func (ParamsChangeSource) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{13}
 }
 
-type LoopInRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type AutoReason int32
 
+const (
+	AutoReason_AUTO_REASON_UNKNOWN AutoReason = 0
 	//
-	//Requested swap amount in sat. This does not include the swap and miner
-	//fee.
-	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//Budget not started indicates that we do not recommend any swaps because
+	//the start time for our budget has not arrived yet.
+	AutoReason_AUTO_REASON_BUDGET_NOT_STARTED AutoReason = 1
 	//
-	//Maximum we are willing to pay the server for the swap. This value is not
-	//disclosed in the swap initiation call, but if the server asks for a
-	//higher fee, we abort the swap. Typically this value is taken from the
-	//response of the GetQuote call.
-	MaxSwapFee int64 `protobuf:"varint,2,opt,name=max_swap_fee,json=maxSwapFee,proto3" json:"max_swap_fee,omitempty"`
+	//Sweep fees indicates that the estimated fees to sweep swaps are too high
+	//right now.
+	AutoReason_AUTO_REASON_SWEEP_FEES AutoReason = 2
 	//
-	//Maximum in on-chain fees that we are willing to spend. If we want to
-	//publish the on-chain htlc and the fee estimate turns out higher than this
-	//value, we cancel the swap.
+	//Budget elapsed indicates that the autoloop budget for the period has been
+	//elapsed.
+	AutoReason_AUTO_REASON_BUDGET_ELAPSED AutoReason = 3
 	//
-	//max_miner_fee is typically taken from the response of the GetQuote call.
-	MaxMinerFee int64 `protobuf:"varint,3,opt,name=max_miner_fee,json=maxMinerFee,proto3" json:"max_miner_fee,omitempty"`
+	//In flight indicates that the limit on in-flight automatically dispatched
+	//swaps has already been reached.
+	AutoReason_AUTO_REASON_IN_FLIGHT AutoReason = 4
 	//
-	//The last hop to use for the loop in swap. If empty, the last hop is selected
-	//based on the lowest routing fee for the swap payment from the server.
-	LastHop []byte `protobuf:"bytes,4,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
+	//Swap fee indicates that the server fee for a specific swap is too high.
+	AutoReason_AUTO_REASON_SWAP_FEE AutoReason = 5
 	//
-	//If external_htlc is true, we expect the htlc to be published by an external
-	//actor.
-	ExternalHtlc bool `protobuf:"varint,5,opt,name=external_htlc,json=externalHtlc,proto3" json:"external_htlc,omitempty"`
+	//Miner fee indicates that the miner fee for a specific swap is to high.
+	AutoReason_AUTO_REASON_MINER_FEE AutoReason = 6
 	//
-	//The number of blocks that the on chain htlc should confirm within.
-	HtlcConfTarget int32 `protobuf:"varint,6,opt,name=htlc_conf_target,json=htlcConfTarget,proto3" json:"htlc_conf_target,omitempty"`
+	//Prepay indicates that the prepay fee for a specific swap is too high.
+	AutoReason_AUTO_REASON_PREPAY AutoReason = 7
 	//
-	//An optional label for this swap. This field is limited to 500 characters
-	//and may not be one of the reserved values in loop/labels Reserved list.
-	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	//Failure backoff indicates that a swap has recently failed for this target,
+	//and the backoff period has not yet passed.
+	AutoReason_AUTO_REASON_FAILURE_BACKOFF AutoReason = 8
 	//
-	//An optional identification string that will be appended to the user agent
-	//string sent to the server to give information about the usage of loop. This
-	//initiator part is meant for user interfaces to add their name to give the
-	//full picture of the binary used (loopd, LiT) and the method used for
-	//triggering the swap (loop CLI, autolooper, LiT UI, other 3rd party UI).
-	Initiator string `protobuf:"bytes,8,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	//Loop out indicates that a loop out swap is currently utilizing the channel,
+	//so it is not eligible.
+	AutoReason_AUTO_REASON_LOOP_OUT AutoReason = 9
 	//
-	//Optional route hints to reach the destination through private channels.
-	RouteHints []*swapserverrpc.RouteHint `protobuf:"bytes,9,rep,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
+	//Loop In indicates that a loop in swap is currently in flight for the peer,
+	//so it is not eligible.
+	AutoReason_AUTO_REASON_LOOP_IN AutoReason = 10
 	//
-	//Private indicates whether the destination node should be considered
-	//private. In which case, loop will generate hophints to assist with
-	//probing and payment.
-	Private bool `protobuf:"varint,10,opt,name=private,proto3" json:"private,omitempty"`
-}
+	//Liquidity ok indicates that a target meets the liquidity balance expressed
+	//in its rule, so no swap is needed.
+	AutoReason_AUTO_REASON_LIQUIDITY_OK AutoReason = 11
+	//
+	//Budget insufficient indicates that we cannot perform a swap because we do
+	//not have enough pending budget available. This differs from budget elapsed,
+	//because we still have some budget available, but we have allocated it to
+	//other swaps.
+	AutoReason_AUTO_REASON_BUDGET_INSUFFICIENT AutoReason = 12
+	//
+	//Fee insufficient indicates that the fee estimate for a swap is higher than
+	//the portion of total swap amount that we allow fees to consume.
+	AutoReason_AUTO_REASON_FEE_INSUFFICIENT AutoReason = 13
+	//
+	//Vbyte budget indicates that a swap would exceed the on-chain vbyte budget
+	//that is available for the current period.
+	AutoReason_AUTO_REASON_VBYTE_BUDGET AutoReason = 14
+	//
+	//Quote timeout indicates that the server did not provide a quote for a swap
+	//within the time that autoloop allows for each quote.
+	AutoReason_AUTO_REASON_QUOTE_TIMEOUT AutoReason = 15
+	//
+	//Rule budget indicates that the fee budget of the channel or peer rule that
+	//a swap is suggested for does not have enough remaining to cover the swap's
+	//fees.
+	AutoReason_AUTO_REASON_RULE_BUDGET AutoReason = 16
+	//
+	//Rule expired indicates that the channel or peer's rule has passed its
+	//expiry, so no swaps are suggested for it.
+	AutoReason_AUTO_REASON_RULE_EXPIRED AutoReason = 17
+	//
+	//Direction cooldown indicates that a swap in the opposite direction
+	//recently completed for the channel or peer, and its rule's cooldown has
+	//not yet passed.
+	AutoReason_AUTO_REASON_DIRECTION_COOLDOWN AutoReason = 18
+	//
+	//Excluded indicates that the channel is managed externally, so autoloop
+	//does not perform swaps for it.
+	AutoReason_AUTO_REASON_EXCLUDED AutoReason = 19
+	//
+	//No channels indicates that none of the channels that a rule applies to are
+	//currently open.
+	AutoReason_AUTO_REASON_NO_CHANNELS AutoReason = 20
+	//
+	//Reserve insufficient indicates that a swap is required to meet a rule, but
+	//there is not enough balance available to swap without dropping below the
+	//rule's reserve threshold, for example because of pending htlcs.
+	AutoReason_AUTO_REASON_RESERVE_INSUFFICIENT AutoReason = 21
+	//
+	//Below minimum indicates that a swap is required to meet a rule, but the
+	//amount required is less than the minimum swap amount.
+	AutoReason_AUTO_REASON_BELOW_MINIMUM AutoReason = 22
+	//
+	//Success cooldown indicates that a swap recently completed for the channel
+	//or peer, and the success cooldown has not yet passed.
+	AutoReason_AUTO_REASON_SUCCESS_COOLDOWN AutoReason = 23
+	//
+	//Swap rate limit indicates that the limit on the number of automatically
+	//dispatched swaps in the swap rate period has been reached, in total or for
+	//the channel or peer.
+	AutoReason_AUTO_REASON_SWAP_RATE_LIMIT AutoReason = 24
+	//
+	//Server Maintenance indicates that the server has published a notice that
+	//it is in maintenance, so no swaps are suggested.
+	AutoReason_AUTO_REASON_SERVER_MAINTENANCE AutoReason = 25
+	//
+	//Peer Offline indicates that the peer that a swap would route through is
+	//not connected to our node, so the swap would fail off-chain.
+	AutoReason_AUTO_REASON_PEER_OFFLINE AutoReason = 26
+	//
+	//Htlc Limits indicates that the htlc slots or in-flight limits of the
+	//channels that a swap would route through cannot carry the minimum swap
+	//amount, so the swap would fail off-chain.
+	AutoReason_AUTO_REASON_HTLC_LIMITS AutoReason = 27
+	//
+	//Channel Age indicates that a swap was not suggested because its channels
+	//are younger than the minimum channel age.
+	AutoReason_AUTO_REASON_CHANNEL_AGE AutoReason = 28
+	//
+	//Flow Refill indicates that a swap was not suggested because the forwarding
+	//flow of its channels is expected to restore the liquidity that it would
+	//add.
+	AutoReason_AUTO_REASON_FLOW_REFILL AutoReason = 29
+	//
+	//Insufficient Revenue indicates that a swap was not suggested because its
+	//channels have not earned the minimum routing revenue that their rule
+	//requires for the swap's fees.
+	AutoReason_AUTO_REASON_INSUFFICIENT_REVENUE AutoReason = 30
+	//
+	//Private Channel indicates that a loop in was not suggested because the
+	//node only has private channels with its peer, and the autolooper is not
+	//allowed to include route hints for them.
+	AutoReason_AUTO_REASON_PRIVATE_CHANNEL AutoReason = 31
+	//
+	//Rule Conflict indicates that a swap was not suggested for a channel
+	//because it has a rule of its own, its peer also has a rule, and the
+	//autolooper could not resolve which of the rules applies to it.
+	AutoReason_AUTO_REASON_RULE_CONFLICT AutoReason = 32
+)
 
-func (x *LoopInRequest) Reset() {
-	*x = LoopInRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for AutoReason.
+var (
+	AutoReason_name = map[int32]string{
+		0:  "AUTO_REASON_UNKNOWN",
+		1:  "AUTO_REASON_BUDGET_NOT_STARTED",
+		2:  "AUTO_REASON_SWEEP_FEES",
+		3:  "AUTO_REASON_BUDGET_ELAPSED",
+		4:  "AUTO_REASON_IN_FLIGHT",
+		5:  "AUTO_REASON_SWAP_FEE",
+		6:  "AUTO_REASON_MINER_FEE",
+		7:  "AUTO_REASON_PREPAY",
+		8:  "AUTO_REASON_FAILURE_BACKOFF",
+		9:  "AUTO_REASON_LOOP_OUT",
+		10: "AUTO_REASON_LOOP_IN",
+		11: "AUTO_REASON_LIQUIDITY_OK",
+		12: "AUTO_REASON_BUDGET_INSUFFICIENT",
+		13: "AUTO_REASON_FEE_INSUFFICIENT",
+		14: "AUTO_REASON_VBYTE_BUDGET",
+		15: "AUTO_REASON_QUOTE_TIMEOUT",
+		16: "AUTO_REASON_RULE_BUDGET",
+		17: "AUTO_REASON_RULE_EXPIRED",
+		18: "AUTO_REASON_DIRECTION_COOLDOWN",
+		19: "AUTO_REASON_EXCLUDED",
+		20: "AUTO_REASON_NO_CHANNELS",
+		21: "AUTO_REASON_RESERVE_INSUFFICIENT",
+		22: "AUTO_REASON_BELOW_MINIMUM",
+		23: "AUTO_REASON_SUCCESS_COOLDOWN",
+		24: "AUTO_REASON_SWAP_RATE_LIMIT",
+		25: "AUTO_REASON_SERVER_MAINTENANCE",
+		26: "AUTO_REASON_PEER_OFFLINE",
+		27: "AUTO_REASON_HTLC_LIMITS",
+		28: "AUTO_REASON_CHANNEL_AGE",
+		29: "AUTO_REASON_FLOW_REFILL",
+		30: "AUTO_REASON_INSUFFICIENT_REVENUE",
+		31: "AUTO_REASON_PRIVATE_CHANNEL",
+		32: "AUTO_REASON_RULE_CONFLICT",
+	}
+	AutoReason_value = map[string]int32{
+		"AUTO_REASON_UNKNOWN":              0,
+		"AUTO_REASON_BUDGET_NOT_STARTED":   1,
+		"AUTO_REASON_SWEEP_FEES":           2,
+		"AUTO_REASON_BUDGET_ELAPSED":       3,
+		"AUTO_REASON_IN_FLIGHT":            4,
+		"AUTO_REASON_SWAP_FEE":             5,
+		"AUTO_REASON_MINER_FEE":            6,
+		"AUTO_REASON_PREPAY":               7,
+		"AUTO_REASON_FAILURE_BACKOFF":      8,
+		"AUTO_REASON_LOOP_OUT":             9,
+		"AUTO_REASON_LOOP_IN":              10,
+		"AUTO_REASON_LIQUIDITY_OK":         11,
+		"AUTO_REASON_BUDGET_INSUFFICIENT":  12,
+		"AUTO_REASON_FEE_INSUFFICIENT":     13,
+		"AUTO_REASON_VBYTE_BUDGET":         14,
+		"AUTO_REASON_QUOTE_TIMEOUT":        15,
+		"AUTO_REASON_RULE_BUDGET":          16,
+		"AUTO_REASON_RULE_EXPIRED":         17,
+		"AUTO_REASON_DIRECTION_COOLDOWN":   18,
+		"AUTO_REASON_EXCLUDED":             19,
+		"AUTO_REASON_NO_CHANNELS":          20,
+		"AUTO_REASON_RESERVE_INSUFFICIENT": 21,
+		"AUTO_REASON_BELOW_MINIMUM":        22,
+		"AUTO_REASON_SUCCESS_COOLDOWN":     23,
+		"AUTO_REASON_SWAP_RATE_LIMIT":      24,
+		"AUTO_REASON_SERVER_MAINTENANCE":   25,
+		"AUTO_REASON_PEER_OFFLINE":         26,
+		"AUTO_REASON_HTLC_LIMITS":          27,
+		"AUTO_REASON_CHANNEL_AGE":          28,
+		"AUTO_REASON_FLOW_REFILL":          29,
+		"AUTO_REASON_INSUFFICIENT_REVENUE": 30,
+		"AUTO_REASON_PRIVATE_CHANNEL":      31,
+		"AUTO_REASON_RULE_CONFLICT":        32,
 	}
+)
+
+func (x AutoReason) Enum() *AutoReason {
+	p := new(AutoReason)
+	*p = x
+	return p
 }
 
-func (x *LoopInRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x AutoReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*LoopInRequest) ProtoMessage() {}
+func (AutoReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[14].Descriptor()
+}
 
-func (x *LoopInRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (AutoReason) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[14]
 }
 
-// Deprecated: Use LoopInRequest.ProtoReflect.Descriptor instead.
-func (*LoopInRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{1}
+func (x AutoReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LoopInRequest) GetAmt() int64 {
-	if x != nil {
-		return x.Amt
-	}
-	return 0
+// Deprecated: Use AutoReason.Descriptor instead.
+func (AutoReason) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *LoopInRequest) GetMaxSwapFee() int64 {
-	if x != nil {
-		return x.MaxSwapFee
+type RebalanceMethod int32
+
+const (
+	//
+	//Neither a circular rebalance nor a swap is possible.
+	RebalanceMethod_REBALANCE_METHOD_NONE RebalanceMethod = 0
+	//
+	//An off-chain circular rebalance from the outgoing channel back to our node
+	//through the incoming channel.
+	RebalanceMethod_REBALANCE_METHOD_CIRCULAR RebalanceMethod = 1
+	//
+	//A loop out swap over the outgoing channel.
+	RebalanceMethod_REBALANCE_METHOD_SWAP RebalanceMethod = 2
+)
+
+// Enum value maps for RebalanceMethod.
+var (
+	RebalanceMethod_name = map[int32]string{
+		0: "REBALANCE_METHOD_NONE",
+		1: "REBALANCE_METHOD_CIRCULAR",
+		2: "REBALANCE_METHOD_SWAP",
 	}
-	return 0
+	RebalanceMethod_value = map[string]int32{
+		"REBALANCE_METHOD_NONE":     0,
+		"REBALANCE_METHOD_CIRCULAR": 1,
+		"REBALANCE_METHOD_SWAP":     2,
+	}
+)
+
+func (x RebalanceMethod) Enum() *RebalanceMethod {
+	p := new(RebalanceMethod)
+	*p = x
+	return p
 }
 
-func (x *LoopInRequest) GetMaxMinerFee() int64 {
-	if x != nil {
-		return x.MaxMinerFee
-	}
-	return 0
+func (x RebalanceMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *LoopInRequest) GetLastHop() []byte {
-	if x != nil {
-		return x.LastHop
-	}
-	return nil
+func (RebalanceMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[15].Descriptor()
 }
 
-func (x *LoopInRequest) GetExternalHtlc() bool {
-	if x != nil {
-		return x.ExternalHtlc
-	}
-	return false
+func (RebalanceMethod) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[15]
 }
 
-func (x *LoopInRequest) GetHtlcConfTarget() int32 {
-	if x != nil {
-		return x.HtlcConfTarget
-	}
-	return 0
+func (x RebalanceMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LoopInRequest) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
+// Deprecated: Use RebalanceMethod.Descriptor instead.
+func (RebalanceMethod) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *LoopInRequest) GetInitiator() string {
-	if x != nil {
-		return x.Initiator
+type SimulatedSwapType int32
+
+const (
+	SimulatedSwapType_SIMULATED_LOOP_OUT  SimulatedSwapType = 0
+	SimulatedSwapType_SIMULATED_LOOP_IN   SimulatedSwapType = 1
+	SimulatedSwapType_SIMULATED_REBALANCE SimulatedSwapType = 2
+)
+
+// Enum value maps for SimulatedSwapType.
+var (
+	SimulatedSwapType_name = map[int32]string{
+		0: "SIMULATED_LOOP_OUT",
+		1: "SIMULATED_LOOP_IN",
+		2: "SIMULATED_REBALANCE",
 	}
-	return ""
+	SimulatedSwapType_value = map[string]int32{
+		"SIMULATED_LOOP_OUT":  0,
+		"SIMULATED_LOOP_IN":   1,
+		"SIMULATED_REBALANCE": 2,
+	}
+)
+
+func (x SimulatedSwapType) Enum() *SimulatedSwapType {
+	p := new(SimulatedSwapType)
+	*p = x
+	return p
 }
 
-func (x *LoopInRequest) GetRouteHints() []*swapserverrpc.RouteHint {
-	if x != nil {
-		return x.RouteHints
-	}
-	return nil
+func (x SimulatedSwapType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *LoopInRequest) GetPrivate() bool {
-	if x != nil {
-		return x.Private
-	}
-	return false
+func (SimulatedSwapType) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[16].Descriptor()
 }
 
-type SwapResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (SimulatedSwapType) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[16]
+}
 
+func (x SimulatedSwapType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SimulatedSwapType.Descriptor instead.
+func (SimulatedSwapType) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{16}
+}
+
+type CalendarEventType int32
+
+const (
 	//
-	//Swap identifier to track status in the update stream that is returned from
-	//the Start() call. Currently this is the hash that locks the htlcs.
-	//DEPRECATED: To make the API more consistent, this field is deprecated in
-	//favor of id_bytes and will be removed in a future release.
-	//
-	// Deprecated: Do not use.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	//
-	//Swap identifier to track status in the update stream that is returned from
-	//the Start() call. Currently this is the hash that locks the htlcs.
-	IdBytes []byte `protobuf:"bytes,3,opt,name=id_bytes,json=idBytes,proto3" json:"id_bytes,omitempty"`
+	//A period in which the autoloop schedule allows swaps to be dispatched.
+	CalendarEventType_DISPATCH_WINDOW CalendarEventType = 0
 	//
-	//DEPRECATED. This field stores the address of the onchain htlc, but
-	//depending on the request, the semantics are different.
-	//- For internal loop-in htlc_address contains the address of the
-	//native segwit (P2WSH) htlc.
-	//- For external loop-in htlc_address contains the address of the
-	//nested segwit (NP2WSH) htlc.
-	//- For loop-out htlc_address always contains the native segwit (P2WSH)
-	//htlc address.
+	//A swap that autoloop suggested but did not dispatch because it was outside
+	//of the autoloop schedule or the chain fee estimate was above the chain fee
+	//ceiling. The event is placed at the time that the swap is next expected to
+	//be dispatched.
+	CalendarEventType_DEFERRED_SWAP CalendarEventType = 1
 	//
-	// Deprecated: Do not use.
-	HtlcAddress string `protobuf:"bytes,2,opt,name=htlc_address,json=htlcAddress,proto3" json:"htlc_address,omitempty"`
+	//The time at which a swap that is waiting for approval expires.
+	CalendarEventType_APPROVAL_EXPIRY CalendarEventType = 2
 	//
-	//The nested segwit address of the on-chain htlc.
-	//This field remains empty for loop-out.
-	HtlcAddressNp2Wsh string `protobuf:"bytes,4,opt,name=htlc_address_np2wsh,json=htlcAddressNp2wsh,proto3" json:"htlc_address_np2wsh,omitempty"`
+	//The time at which the autoloop budget refreshes.
+	CalendarEventType_BUDGET_REFRESH CalendarEventType = 3
 	//
-	//The native segwit address of the on-chain htlc.
-	//Used for both loop-in and loop-out.
-	HtlcAddressP2Wsh string `protobuf:"bytes,5,opt,name=htlc_address_p2wsh,json=htlcAddressP2wsh,proto3" json:"htlc_address_p2wsh,omitempty"`
-	// A human-readable message received from the loop server.
-	ServerMessage string `protobuf:"bytes,6,opt,name=server_message,json=serverMessage,proto3" json:"server_message,omitempty"`
-}
+	//The time at which a temporary liquidity rule expires.
+	CalendarEventType_RULE_EXPIRY CalendarEventType = 4
+)
 
-func (x *SwapResponse) Reset() {
-	*x = SwapResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for CalendarEventType.
+var (
+	CalendarEventType_name = map[int32]string{
+		0: "DISPATCH_WINDOW",
+		1: "DEFERRED_SWAP",
+		2: "APPROVAL_EXPIRY",
+		3: "BUDGET_REFRESH",
+		4: "RULE_EXPIRY",
+	}
+	CalendarEventType_value = map[string]int32{
+		"DISPATCH_WINDOW": 0,
+		"DEFERRED_SWAP":   1,
+		"APPROVAL_EXPIRY": 2,
+		"BUDGET_REFRESH":  3,
+		"RULE_EXPIRY":     4,
 	}
+)
+
+func (x CalendarEventType) Enum() *CalendarEventType {
+	p := new(CalendarEventType)
+	*p = x
+	return p
 }
 
-func (x *SwapResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x CalendarEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*SwapResponse) ProtoMessage() {}
+func (CalendarEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[17].Descriptor()
+}
 
-func (x *SwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (CalendarEventType) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[17]
 }
 
-// Deprecated: Use SwapResponse.ProtoReflect.Descriptor instead.
-func (*SwapResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{2}
+func (x CalendarEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *SwapResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use CalendarEventType.Descriptor instead.
+func (CalendarEventType) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SwapResponse) GetIdBytes() []byte {
-	if x != nil {
-		return x.IdBytes
+// ErrorCode is a stable classification of the failures that loopd's rpc calls
+// return. It is attached to gRPC errors as an ErrorDetail status detail, so that
+// clients can branch on the type of failure without matching error strings.
+type ErrorCode int32
+
+const (
+	//
+	//The failure could not be classified.
+	ErrorCode_ERROR_CODE_UNKNOWN ErrorCode = 0
+	//
+	//The parameters provided in the request are invalid.
+	ErrorCode_ERROR_CODE_INVALID_PARAMETERS ErrorCode = 1
+	//
+	//The requested swap amount is outside of the bounds that the server
+	//permits, or cannot be routed with the balance that is available.
+	ErrorCode_ERROR_CODE_AMOUNT_OUT_OF_BOUNDS ErrorCode = 2
+	//
+	//The fees required for the swap exceed the fee budget that was set for it.
+	ErrorCode_ERROR_CODE_BUDGET_EXHAUSTED ErrorCode = 3
+	//
+	//The swap server could not be reached, or did not respond in time.
+	ErrorCode_ERROR_CODE_SERVER_UNAVAILABLE ErrorCode = 4
+	//
+	//The swap or resource that the request refers to does not exist.
+	ErrorCode_ERROR_CODE_NOT_FOUND ErrorCode = 5
+	//
+	//The request cannot be served in loopd's current state, for example
+	//because autoloop has no rules set.
+	ErrorCode_ERROR_CODE_FAILED_PRECONDITION ErrorCode = 6
+	//
+	//The server provided an invoice that does not match the swap that was
+	//negotiated, for example because its amount, expiry or destination
+	//diverges from the quote. The invoice was not paid.
+	ErrorCode_ERROR_CODE_SERVER_INVOICE_MISMATCH ErrorCode = 7
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0: "ERROR_CODE_UNKNOWN",
+		1: "ERROR_CODE_INVALID_PARAMETERS",
+		2: "ERROR_CODE_AMOUNT_OUT_OF_BOUNDS",
+		3: "ERROR_CODE_BUDGET_EXHAUSTED",
+		4: "ERROR_CODE_SERVER_UNAVAILABLE",
+		5: "ERROR_CODE_NOT_FOUND",
+		6: "ERROR_CODE_FAILED_PRECONDITION",
+		7: "ERROR_CODE_SERVER_INVOICE_MISMATCH",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_CODE_UNKNOWN":                 0,
+		"ERROR_CODE_INVALID_PARAMETERS":      1,
+		"ERROR_CODE_AMOUNT_OUT_OF_BOUNDS":    2,
+		"ERROR_CODE_BUDGET_EXHAUSTED":        3,
+		"ERROR_CODE_SERVER_UNAVAILABLE":      4,
+		"ERROR_CODE_NOT_FOUND":               5,
+		"ERROR_CODE_FAILED_PRECONDITION":     6,
+		"ERROR_CODE_SERVER_INVOICE_MISMATCH": 7,
 	}
-	return nil
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
 }
 
-// Deprecated: Do not use.
-func (x *SwapResponse) GetHtlcAddress() string {
-	if x != nil {
-		return x.HtlcAddress
-	}
-	return ""
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *SwapResponse) GetHtlcAddressNp2Wsh() string {
-	if x != nil {
-		return x.HtlcAddressNp2Wsh
-	}
-	return ""
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[18].Descriptor()
 }
 
-func (x *SwapResponse) GetHtlcAddressP2Wsh() string {
-	if x != nil {
-		return x.HtlcAddressP2Wsh
-	}
-	return ""
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[18]
 }
 
-func (x *SwapResponse) GetServerMessage() string {
-	if x != nil {
-		return x.ServerMessage
-	}
-	return ""
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-type MonitorRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MonitorRequest) Reset() {
-	*x = MonitorRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type ReplayEventSource int32
+
+const (
+	//
+	//The event was recorded in the daemon's swap store.
+	ReplayEventSource_REPLAY_SOURCE_STORE ReplayEventSource = 0
+	//
+	//The event was reconstructed from a payment that lnd made for the swap.
+	ReplayEventSource_REPLAY_SOURCE_PAYMENT ReplayEventSource = 1
+	//
+	//The event was reconstructed from the swap's invoice in lnd.
+	ReplayEventSource_REPLAY_SOURCE_INVOICE ReplayEventSource = 2
+	//
+	//The event was reconstructed from an on-chain transaction or block height.
+	ReplayEventSource_REPLAY_SOURCE_CHAIN ReplayEventSource = 3
+)
+
+// Enum value maps for ReplayEventSource.
+var (
+	ReplayEventSource_name = map[int32]string{
+		0: "REPLAY_SOURCE_STORE",
+		1: "REPLAY_SOURCE_PAYMENT",
+		2: "REPLAY_SOURCE_INVOICE",
+		3: "REPLAY_SOURCE_CHAIN",
+	}
+	ReplayEventSource_value = map[string]int32{
+		"REPLAY_SOURCE_STORE":   0,
+		"REPLAY_SOURCE_PAYMENT": 1,
+		"REPLAY_SOURCE_INVOICE": 2,
+		"REPLAY_SOURCE_CHAIN":   3,
 	}
+)
+
+func (x ReplayEventSource) Enum() *ReplayEventSource {
+	p := new(ReplayEventSource)
+	*p = x
+	return p
 }
 
-func (x *MonitorRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ReplayEventSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*MonitorRequest) ProtoMessage() {}
+func (ReplayEventSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[19].Descriptor()
+}
 
-func (x *MonitorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ReplayEventSource) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[19]
 }
 
-// Deprecated: Use MonitorRequest.ProtoReflect.Descriptor instead.
-func (*MonitorRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{3}
+func (x ReplayEventSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-type SwapStatus struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Deprecated: Use ReplayEventSource.Descriptor instead.
+func (ReplayEventSource) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{19}
+}
 
+type StateGraphFormat int32
+
+const (
 	//
-	//Requested swap amount in sat. This does not include the swap and miner
-	//fee.
-	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
-	//
-	//Swap identifier to track status in the update stream that is returned from
-	//the Start() call. Currently this is the hash that locks the htlcs.
-	//DEPRECATED: To make the API more consistent, this field is deprecated in
-	//favor of id_bytes and will be removed in a future release.
-	//
-	// Deprecated: Do not use.
-	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	//
-	//Swap identifier to track status in the update stream that is returned from
-	//the Start() call. Currently this is the hash that locks the htlcs.
-	IdBytes []byte `protobuf:"bytes,11,opt,name=id_bytes,json=idBytes,proto3" json:"id_bytes,omitempty"`
-	//
-	//The type of the swap.
-	Type SwapType `protobuf:"varint,3,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
-	//
-	//State the swap is currently in, see State enum.
-	State SwapState `protobuf:"varint,4,opt,name=state,proto3,enum=looprpc.SwapState" json:"state,omitempty"`
-	//
-	//A failure reason for the swap, only set if the swap has failed.
-	FailureReason FailureReason `protobuf:"varint,14,opt,name=failure_reason,json=failureReason,proto3,enum=looprpc.FailureReason" json:"failure_reason,omitempty"`
-	//
-	//Initiation time of the swap.
-	InitiationTime int64 `protobuf:"varint,5,opt,name=initiation_time,json=initiationTime,proto3" json:"initiation_time,omitempty"`
-	//
-	//Initiation time of the swap.
-	LastUpdateTime int64 `protobuf:"varint,6,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`
-	//
-	//DEPRECATED:  This field stores the address of the onchain htlc.
-	//- For internal loop-in htlc_address contains the address of the
-	//native segwit (P2WSH) htlc.
-	//- For external loop-in htlc_address contains the nested segwit (NP2WSH)
-	//address.
-	//- For loop-out htlc_address always contains the native segwit (P2WSH)
-	//htlc address.
+	//The graphviz DOT language.
+	StateGraphFormat_STATE_GRAPH_FORMAT_DOT StateGraphFormat = 0
 	//
-	// Deprecated: Do not use.
-	HtlcAddress string `protobuf:"bytes,7,opt,name=htlc_address,json=htlcAddress,proto3" json:"htlc_address,omitempty"`
-	// HTLC address (native segwit), used in loop-in and loop-out swaps.
-	HtlcAddressP2Wsh string `protobuf:"bytes,12,opt,name=htlc_address_p2wsh,json=htlcAddressP2wsh,proto3" json:"htlc_address_p2wsh,omitempty"`
-	// HTLC address (nested segwit), used in loop-in swaps only.
-	HtlcAddressNp2Wsh string `protobuf:"bytes,13,opt,name=htlc_address_np2wsh,json=htlcAddressNp2wsh,proto3" json:"htlc_address_np2wsh,omitempty"`
-	// Swap server cost
-	CostServer int64 `protobuf:"varint,8,opt,name=cost_server,json=costServer,proto3" json:"cost_server,omitempty"`
-	// On-chain transaction cost
-	CostOnchain int64 `protobuf:"varint,9,opt,name=cost_onchain,json=costOnchain,proto3" json:"cost_onchain,omitempty"`
-	// Off-chain routing fees
-	CostOffchain int64 `protobuf:"varint,10,opt,name=cost_offchain,json=costOffchain,proto3" json:"cost_offchain,omitempty"`
-	// An optional label given to the swap on creation.
-	Label string `protobuf:"bytes,15,opt,name=label,proto3" json:"label,omitempty"`
-}
+	//A mermaid state diagram.
+	StateGraphFormat_STATE_GRAPH_FORMAT_MERMAID StateGraphFormat = 1
+)
 
-func (x *SwapStatus) Reset() {
-	*x = SwapStatus{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for StateGraphFormat.
+var (
+	StateGraphFormat_name = map[int32]string{
+		0: "STATE_GRAPH_FORMAT_DOT",
+		1: "STATE_GRAPH_FORMAT_MERMAID",
 	}
-}
+	StateGraphFormat_value = map[string]int32{
+		"STATE_GRAPH_FORMAT_DOT":     0,
+		"STATE_GRAPH_FORMAT_MERMAID": 1,
+	}
+)
 
-func (x *SwapStatus) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x StateGraphFormat) Enum() *StateGraphFormat {
+	p := new(StateGraphFormat)
+	*p = x
+	return p
 }
 
-func (*SwapStatus) ProtoMessage() {}
+func (x StateGraphFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *SwapStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (StateGraphFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[20].Descriptor()
 }
 
-// Deprecated: Use SwapStatus.ProtoReflect.Descriptor instead.
-func (*SwapStatus) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{4}
+func (StateGraphFormat) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[20]
 }
 
-func (x *SwapStatus) GetAmt() int64 {
-	if x != nil {
-		return x.Amt
-	}
-	return 0
+func (x StateGraphFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *SwapStatus) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use StateGraphFormat.Descriptor instead.
+func (StateGraphFormat) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *SwapStatus) GetIdBytes() []byte {
-	if x != nil {
-		return x.IdBytes
+type NoticeCategory int32
+
+const (
+	//
+	//A notice that does not fit any other category.
+	NoticeCategory_NOTICE_GENERAL NoticeCategory = 0
+	//
+	//The server is in maintenance and may not accept new swaps.
+	NoticeCategory_NOTICE_MAINTENANCE NoticeCategory = 1
+	//
+	//The server is changing the fees that it charges for swaps.
+	NoticeCategory_NOTICE_FEE_CHANGE NoticeCategory = 2
+)
+
+// Enum value maps for NoticeCategory.
+var (
+	NoticeCategory_name = map[int32]string{
+		0: "NOTICE_GENERAL",
+		1: "NOTICE_MAINTENANCE",
+		2: "NOTICE_FEE_CHANGE",
 	}
-	return nil
+	NoticeCategory_value = map[string]int32{
+		"NOTICE_GENERAL":     0,
+		"NOTICE_MAINTENANCE": 1,
+		"NOTICE_FEE_CHANGE":  2,
+	}
+)
+
+func (x NoticeCategory) Enum() *NoticeCategory {
+	p := new(NoticeCategory)
+	*p = x
+	return p
 }
 
-func (x *SwapStatus) GetType() SwapType {
-	if x != nil {
-		return x.Type
-	}
-	return SwapType_LOOP_OUT
+func (x NoticeCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *SwapStatus) GetState() SwapState {
-	if x != nil {
-		return x.State
-	}
-	return SwapState_INITIATED
+func (NoticeCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_client_proto_enumTypes[21].Descriptor()
 }
 
-func (x *SwapStatus) GetFailureReason() FailureReason {
-	if x != nil {
-		return x.FailureReason
-	}
-	return FailureReason_FAILURE_REASON_NONE
+func (NoticeCategory) Type() protoreflect.EnumType {
+	return &file_client_proto_enumTypes[21]
 }
 
-func (x *SwapStatus) GetInitiationTime() int64 {
-	if x != nil {
-		return x.InitiationTime
-	}
-	return 0
+func (x NoticeCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *SwapStatus) GetLastUpdateTime() int64 {
-	if x != nil {
-		return x.LastUpdateTime
-	}
-	return 0
+// Deprecated: Use NoticeCategory.Descriptor instead.
+func (NoticeCategory) EnumDescriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{21}
 }
 
-// Deprecated: Do not use.
-func (x *SwapStatus) GetHtlcAddress() string {
-	if x != nil {
-		return x.HtlcAddress
+type LoopOutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Requested swap amount in sat. This does not include the swap and miner fee.
+	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//Base58 encoded destination address for the swap.
+	Dest string `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	//
+	//Maximum off-chain fee in sat that may be paid for swap payment to the
+	//server. This limit is applied during path finding. Typically this value is
+	//taken from the response of the GetQuote call.
+	MaxSwapRoutingFee int64 `protobuf:"varint,3,opt,name=max_swap_routing_fee,json=maxSwapRoutingFee,proto3" json:"max_swap_routing_fee,omitempty"`
+	//
+	//Maximum off-chain fee in sat that may be paid for the prepay to the server.
+	//This limit is applied during path finding. Typically this value is taken
+	//from the response of the GetQuote call.
+	MaxPrepayRoutingFee int64 `protobuf:"varint,4,opt,name=max_prepay_routing_fee,json=maxPrepayRoutingFee,proto3" json:"max_prepay_routing_fee,omitempty"`
+	//
+	//Maximum we are willing to pay the server for the swap. This value is not
+	//disclosed in the swap initiation call, but if the server asks for a
+	//higher fee, we abort the swap. Typically this value is taken from the
+	//response of the GetQuote call. It includes the prepay amount.
+	MaxSwapFee int64 `protobuf:"varint,5,opt,name=max_swap_fee,json=maxSwapFee,proto3" json:"max_swap_fee,omitempty"`
+	//
+	//Maximum amount of the swap fee that may be charged as a prepayment.
+	MaxPrepayAmt int64 `protobuf:"varint,6,opt,name=max_prepay_amt,json=maxPrepayAmt,proto3" json:"max_prepay_amt,omitempty"`
+	//
+	//Maximum in on-chain fees that we are willing to spend. If we want to
+	//sweep the on-chain htlc and the fee estimate turns out higher than this
+	//value, we cancel the swap. If the fee estimate is lower, we publish the
+	//sweep tx.
+	//
+	//If the sweep tx is not confirmed, we are forced to ratchet up fees until it
+	//is swept. Possibly even exceeding max_miner_fee if we get close to the htlc
+	//timeout. Because the initial publication revealed the preimage, we have no
+	//other choice. The server may already have pulled the off-chain htlc. Only
+	//when the fee becomes higher than the swap amount, we can only wait for fees
+	//to come down and hope - if we are past the timeout - that the server is not
+	//publishing the revocation.
+	//
+	//max_miner_fee is typically taken from the response of the GetQuote call.
+	MaxMinerFee int64 `protobuf:"varint,7,opt,name=max_miner_fee,json=maxMinerFee,proto3" json:"max_miner_fee,omitempty"`
+	//
+	//Deprecated, use outgoing_chan_set. The channel to loop out, the channel
+	//to loop out is selected based on the lowest routing fee for the swap
+	//payment to the server.
+	//
+	// Deprecated: Do not use.
+	LoopOutChannel uint64 `protobuf:"varint,8,opt,name=loop_out_channel,json=loopOutChannel,proto3" json:"loop_out_channel,omitempty"`
+	//
+	//A restriction on the channel set that may be used to loop out. The actual
+	//channel(s) that will be used are selected based on the lowest routing fee
+	//for the swap payment to the server.
+	OutgoingChanSet []uint64 `protobuf:"varint,11,rep,packed,name=outgoing_chan_set,json=outgoingChanSet,proto3" json:"outgoing_chan_set,omitempty"`
+	//
+	//The number of blocks from the on-chain HTLC's confirmation height that it
+	//should be swept within.
+	SweepConfTarget int32 `protobuf:"varint,9,opt,name=sweep_conf_target,json=sweepConfTarget,proto3" json:"sweep_conf_target,omitempty"`
+	//
+	//The number of confirmations that we require for the on chain htlc that will
+	//be published by the server before we reveal the preimage.
+	HtlcConfirmations int32 `protobuf:"varint,13,opt,name=htlc_confirmations,json=htlcConfirmations,proto3" json:"htlc_confirmations,omitempty"`
+	//
+	//The latest time (in unix seconds) we allow the server to wait before
+	//publishing the HTLC on chain. Setting this to a larger value will give the
+	//server the opportunity to batch multiple swaps together, and wait for
+	//low-fee periods before publishing the HTLC, potentially resulting in a
+	//lower total swap fee.
+	SwapPublicationDeadline uint64 `protobuf:"varint,10,opt,name=swap_publication_deadline,json=swapPublicationDeadline,proto3" json:"swap_publication_deadline,omitempty"`
+	//
+	//An optional label for this swap. This field is limited to 500 characters
+	//and may not start with the prefix [reserved], which is used to tag labels
+	//produced by the daemon.
+	Label string `protobuf:"bytes,12,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//An optional identification string that will be appended to the user agent
+	//string sent to the server to give information about the usage of loop. This
+	//initiator part is meant for user interfaces to add their name to give the
+	//full picture of the binary used (loopd, LiT) and the method used for
+	//triggering the swap (loop CLI, autolooper, LiT UI, other 3rd party UI).
+	Initiator string `protobuf:"bytes,14,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	//
+	//If set, the request is validated and quoted, and the fees that the swap
+	//would pay are checked against its fee limits, but no swap is created.
+	//The response contains the validation result instead of a swap.
+	ValidateOnly bool `protobuf:"varint,15,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	//
+	//An optional ceiling on the total cost of the swap in satoshis, which covers
+	//the swap fee (including the prepay), the off-chain routing fees and the
+	//on-chain sweep fee. The routing and sweep fee limits are lowered if
+	//required to stay within the ceiling, and the swap is aborted before the
+	//server is paid if it cannot be met. This field is mutually exclusive with
+	//max_total_cost_ppm.
+	MaxTotalCostSat int64 `protobuf:"varint,16,opt,name=max_total_cost_sat,json=maxTotalCostSat,proto3" json:"max_total_cost_sat,omitempty"`
+	//
+	//An optional ceiling on the total cost of the swap, expressed in parts per
+	//million of the swap amount. This field is mutually exclusive with
+	//max_total_cost_sat.
+	MaxTotalCostPpm uint64 `protobuf:"varint,17,opt,name=max_total_cost_ppm,json=maxTotalCostPpm,proto3" json:"max_total_cost_ppm,omitempty"`
+	//
+	//An optional set of custom tlv records, keyed by record type, that are
+	//attached to the swap and prepay payments. Record types must be >= 65536,
+	//and the total size of the record values may not exceed 512 bytes.
+	CustomRecords map[uint64][]byte `protobuf:"bytes,18,rep,name=custom_records,json=customRecords,proto3" json:"custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//
+	//An optional signature of the dest address, made by the address's key in
+	//the bitcoin signmessage format and base64 encoded, which verifies that the
+	//address is owned by the user. The signed message is the address itself. If
+	//not set, loopd checks whether the address was derived from one of its
+	//configured destxpubs.
+	DestSignature string `protobuf:"bytes,19,opt,name=dest_signature,json=destSignature,proto3" json:"dest_signature,omitempty"`
+}
+
+func (x *LoopOutRequest) Reset() {
+	*x = LoopOutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SwapStatus) GetHtlcAddressP2Wsh() string {
+func (x *LoopOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoopOutRequest) ProtoMessage() {}
+
+func (x *LoopOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoopOutRequest.ProtoReflect.Descriptor instead.
+func (*LoopOutRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LoopOutRequest) GetAmt() int64 {
 	if x != nil {
-		return x.HtlcAddressP2Wsh
+		return x.Amt
 	}
-	return ""
+	return 0
 }
 
-func (x *SwapStatus) GetHtlcAddressNp2Wsh() string {
+func (x *LoopOutRequest) GetDest() string {
 	if x != nil {
-		return x.HtlcAddressNp2Wsh
+		return x.Dest
 	}
 	return ""
 }
 
-func (x *SwapStatus) GetCostServer() int64 {
+func (x *LoopOutRequest) GetMaxSwapRoutingFee() int64 {
 	if x != nil {
-		return x.CostServer
+		return x.MaxSwapRoutingFee
 	}
 	return 0
 }
 
-func (x *SwapStatus) GetCostOnchain() int64 {
+func (x *LoopOutRequest) GetMaxPrepayRoutingFee() int64 {
 	if x != nil {
-		return x.CostOnchain
+		return x.MaxPrepayRoutingFee
 	}
 	return 0
 }
 
-func (x *SwapStatus) GetCostOffchain() int64 {
+func (x *LoopOutRequest) GetMaxSwapFee() int64 {
 	if x != nil {
-		return x.CostOffchain
+		return x.MaxSwapFee
 	}
 	return 0
 }
 
-func (x *SwapStatus) GetLabel() string {
+func (x *LoopOutRequest) GetMaxPrepayAmt() int64 {
 	if x != nil {
-		return x.Label
+		return x.MaxPrepayAmt
 	}
-	return ""
+	return 0
 }
 
-type ListSwapsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *LoopOutRequest) GetMaxMinerFee() int64 {
+	if x != nil {
+		return x.MaxMinerFee
+	}
+	return 0
 }
 
-func (x *ListSwapsRequest) Reset() {
-	*x = ListSwapsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Deprecated: Do not use.
+func (x *LoopOutRequest) GetLoopOutChannel() uint64 {
+	if x != nil {
+		return x.LoopOutChannel
 	}
+	return 0
 }
 
-func (x *ListSwapsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LoopOutRequest) GetOutgoingChanSet() []uint64 {
+	if x != nil {
+		return x.OutgoingChanSet
+	}
+	return nil
 }
 
-func (*ListSwapsRequest) ProtoMessage() {}
-
-func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LoopOutRequest) GetSweepConfTarget() int32 {
+	if x != nil {
+		return x.SweepConfTarget
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListSwapsRequest.ProtoReflect.Descriptor instead.
-func (*ListSwapsRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{5}
+func (x *LoopOutRequest) GetHtlcConfirmations() int32 {
+	if x != nil {
+		return x.HtlcConfirmations
+	}
+	return 0
 }
 
-type ListSwapsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//
-	//The list of all currently known swaps and their status.
-	Swaps []*SwapStatus `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+func (x *LoopOutRequest) GetSwapPublicationDeadline() uint64 {
+	if x != nil {
+		return x.SwapPublicationDeadline
+	}
+	return 0
 }
 
-func (x *ListSwapsResponse) Reset() {
-	*x = ListSwapsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LoopOutRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
 	}
+	return ""
 }
 
-func (x *ListSwapsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LoopOutRequest) GetInitiator() string {
+	if x != nil {
+		return x.Initiator
+	}
+	return ""
 }
 
-func (*ListSwapsResponse) ProtoMessage() {}
+func (x *LoopOutRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
 
-func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LoopOutRequest) GetMaxTotalCostSat() int64 {
+	if x != nil {
+		return x.MaxTotalCostSat
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListSwapsResponse.ProtoReflect.Descriptor instead.
-func (*ListSwapsResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{6}
+func (x *LoopOutRequest) GetMaxTotalCostPpm() uint64 {
+	if x != nil {
+		return x.MaxTotalCostPpm
+	}
+	return 0
 }
 
-func (x *ListSwapsResponse) GetSwaps() []*SwapStatus {
+func (x *LoopOutRequest) GetCustomRecords() map[uint64][]byte {
 	if x != nil {
-		return x.Swaps
+		return x.CustomRecords
 	}
 	return nil
 }
 
-type SwapInfoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//
-	//The swap identifier which currently is the hash that locks the HTLCs. When
-	//using REST, this field must be encoded as URL safe base64.
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *SwapInfoRequest) Reset() {
-	*x = SwapInfoRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SwapInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SwapInfoRequest) ProtoMessage() {}
-
-func (x *SwapInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SwapInfoRequest.ProtoReflect.Descriptor instead.
-func (*SwapInfoRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *SwapInfoRequest) GetId() []byte {
+func (x *LoopOutRequest) GetDestSignature() string {
 	if x != nil {
-		return x.Id
+		return x.DestSignature
 	}
-	return nil
+	return ""
 }
 
-type TermsRequest struct {
+type LoopInRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//Requested swap amount in sat. This does not include the swap and miner
+	//fee.
+	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//Maximum we are willing to pay the server for the swap. This value is not
+	//disclosed in the swap initiation call, but if the server asks for a
+	//higher fee, we abort the swap. Typically this value is taken from the
+	//response of the GetQuote call.
+	MaxSwapFee int64 `protobuf:"varint,2,opt,name=max_swap_fee,json=maxSwapFee,proto3" json:"max_swap_fee,omitempty"`
+	//
+	//Maximum in on-chain fees that we are willing to spend. If we want to
+	//publish the on-chain htlc and the fee estimate turns out higher than this
+	//value, we cancel the swap.
+	//
+	//max_miner_fee is typically taken from the response of the GetQuote call.
+	MaxMinerFee int64 `protobuf:"varint,3,opt,name=max_miner_fee,json=maxMinerFee,proto3" json:"max_miner_fee,omitempty"`
+	//
+	//The last hop to use for the loop in swap. If empty, the last hop is selected
+	//based on the lowest routing fee for the swap payment from the server.
+	LastHop []byte `protobuf:"bytes,4,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
+	//
+	//If external_htlc is true, we expect the htlc to be published by an external
+	//actor.
+	ExternalHtlc bool `protobuf:"varint,5,opt,name=external_htlc,json=externalHtlc,proto3" json:"external_htlc,omitempty"`
+	//
+	//The number of blocks that the on chain htlc should confirm within.
+	HtlcConfTarget int32 `protobuf:"varint,6,opt,name=htlc_conf_target,json=htlcConfTarget,proto3" json:"htlc_conf_target,omitempty"`
+	//
+	//An optional label for this swap. This field is limited to 500 characters
+	//and may not be one of the reserved values in loop/labels Reserved list.
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//An optional identification string that will be appended to the user agent
+	//string sent to the server to give information about the usage of loop. This
+	//initiator part is meant for user interfaces to add their name to give the
+	//full picture of the binary used (loopd, LiT) and the method used for
+	//triggering the swap (loop CLI, autolooper, LiT UI, other 3rd party UI).
+	Initiator string `protobuf:"bytes,8,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	//
+	//Optional route hints to reach the destination through private channels.
+	RouteHints []*swapserverrpc.RouteHint `protobuf:"bytes,9,rep,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
+	//
+	//Private indicates whether the destination node should be considered
+	//private. In which case, loop will generate hophints to assist with
+	//probing and payment.
+	Private bool `protobuf:"varint,10,opt,name=private,proto3" json:"private,omitempty"`
+	//
+	//If set, the request is validated and quoted, and the swap fee is checked
+	//against its fee limit, but no swap is created. The response contains the
+	//validation result instead of a swap.
+	ValidateOnly bool `protobuf:"varint,11,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	//
+	//An optional ceiling on the total cost of the swap in satoshis, which covers
+	//the swap fee and the on-chain fee for publishing the htlc. The swap is
+	//aborted before the htlc is published if the ceiling cannot be met. This
+	//field is mutually exclusive with max_total_cost_ppm.
+	MaxTotalCostSat int64 `protobuf:"varint,12,opt,name=max_total_cost_sat,json=maxTotalCostSat,proto3" json:"max_total_cost_sat,omitempty"`
+	//
+	//An optional ceiling on the total cost of the swap, expressed in parts per
+	//million of the swap amount. This field is mutually exclusive with
+	//max_total_cost_sat.
+	MaxTotalCostPpm uint64 `protobuf:"varint,13,opt,name=max_total_cost_ppm,json=maxTotalCostPpm,proto3" json:"max_total_cost_ppm,omitempty"`
+	//
+	//An optional tolerance in satoshis by which the swap amount may be
+	//adjusted so that the transaction that funds the htlc does not have a
+	//change output. The swap fails if no amount within the tolerance avoids
+	//change. This field cannot be set for external htlcs.
+	ChangeToleranceSat uint64 `protobuf:"varint,14,opt,name=change_tolerance_sat,json=changeToleranceSat,proto3" json:"change_tolerance_sat,omitempty"`
 }
 
-func (x *TermsRequest) Reset() {
-	*x = TermsRequest{}
+func (x *LoopInRequest) Reset() {
+	*x = LoopInRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[8]
+		mi := &file_client_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TermsRequest) String() string {
+func (x *LoopInRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TermsRequest) ProtoMessage() {}
+func (*LoopInRequest) ProtoMessage() {}
 
-func (x *TermsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[8]
+func (x *LoopInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1299,204 +1928,188 @@ func (x *TermsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TermsRequest.ProtoReflect.Descriptor instead.
-func (*TermsRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{8}
-}
-
-type InTermsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//
-	//Minimum swap amount (sat)
-	MinSwapAmount int64 `protobuf:"varint,5,opt,name=min_swap_amount,json=minSwapAmount,proto3" json:"min_swap_amount,omitempty"`
-	//
-	//Maximum swap amount (sat)
-	MaxSwapAmount int64 `protobuf:"varint,6,opt,name=max_swap_amount,json=maxSwapAmount,proto3" json:"max_swap_amount,omitempty"`
+// Deprecated: Use LoopInRequest.ProtoReflect.Descriptor instead.
+func (*LoopInRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *InTermsResponse) Reset() {
-	*x = InTermsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LoopInRequest) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
 	}
+	return 0
 }
 
-func (x *InTermsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LoopInRequest) GetMaxSwapFee() int64 {
+	if x != nil {
+		return x.MaxSwapFee
+	}
+	return 0
 }
 
-func (*InTermsResponse) ProtoMessage() {}
-
-func (x *InTermsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LoopInRequest) GetMaxMinerFee() int64 {
+	if x != nil {
+		return x.MaxMinerFee
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use InTermsResponse.ProtoReflect.Descriptor instead.
-func (*InTermsResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{9}
+func (x *LoopInRequest) GetLastHop() []byte {
+	if x != nil {
+		return x.LastHop
+	}
+	return nil
 }
 
-func (x *InTermsResponse) GetMinSwapAmount() int64 {
+func (x *LoopInRequest) GetExternalHtlc() bool {
 	if x != nil {
-		return x.MinSwapAmount
+		return x.ExternalHtlc
 	}
-	return 0
+	return false
 }
 
-func (x *InTermsResponse) GetMaxSwapAmount() int64 {
+func (x *LoopInRequest) GetHtlcConfTarget() int32 {
 	if x != nil {
-		return x.MaxSwapAmount
+		return x.HtlcConfTarget
 	}
 	return 0
 }
 
-type OutTermsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//
-	//Minimum swap amount (sat)
-	MinSwapAmount int64 `protobuf:"varint,5,opt,name=min_swap_amount,json=minSwapAmount,proto3" json:"min_swap_amount,omitempty"`
-	//
-	//Maximum swap amount (sat)
-	MaxSwapAmount int64 `protobuf:"varint,6,opt,name=max_swap_amount,json=maxSwapAmount,proto3" json:"max_swap_amount,omitempty"`
-	// The minimally accepted cltv delta of the on-chain htlc.
-	MinCltvDelta int32 `protobuf:"varint,8,opt,name=min_cltv_delta,json=minCltvDelta,proto3" json:"min_cltv_delta,omitempty"`
-	// The maximally accepted cltv delta of the on-chain htlc.
-	MaxCltvDelta int32 `protobuf:"varint,9,opt,name=max_cltv_delta,json=maxCltvDelta,proto3" json:"max_cltv_delta,omitempty"`
-}
-
-func (x *OutTermsResponse) Reset() {
-	*x = OutTermsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LoopInRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
 	}
+	return ""
 }
 
-func (x *OutTermsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LoopInRequest) GetInitiator() string {
+	if x != nil {
+		return x.Initiator
+	}
+	return ""
 }
 
-func (*OutTermsResponse) ProtoMessage() {}
-
-func (x *OutTermsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LoopInRequest) GetRouteHints() []*swapserverrpc.RouteHint {
+	if x != nil {
+		return x.RouteHints
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use OutTermsResponse.ProtoReflect.Descriptor instead.
-func (*OutTermsResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{10}
+func (x *LoopInRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
 }
 
-func (x *OutTermsResponse) GetMinSwapAmount() int64 {
+func (x *LoopInRequest) GetValidateOnly() bool {
 	if x != nil {
-		return x.MinSwapAmount
+		return x.ValidateOnly
 	}
-	return 0
+	return false
 }
 
-func (x *OutTermsResponse) GetMaxSwapAmount() int64 {
+func (x *LoopInRequest) GetMaxTotalCostSat() int64 {
 	if x != nil {
-		return x.MaxSwapAmount
+		return x.MaxTotalCostSat
 	}
 	return 0
 }
 
-func (x *OutTermsResponse) GetMinCltvDelta() int32 {
+func (x *LoopInRequest) GetMaxTotalCostPpm() uint64 {
 	if x != nil {
-		return x.MinCltvDelta
+		return x.MaxTotalCostPpm
 	}
 	return 0
 }
 
-func (x *OutTermsResponse) GetMaxCltvDelta() int32 {
+func (x *LoopInRequest) GetChangeToleranceSat() uint64 {
 	if x != nil {
-		return x.MaxCltvDelta
+		return x.ChangeToleranceSat
 	}
 	return 0
 }
 
-type QuoteRequest struct {
+type SwapResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The amount to swap in satoshis.
-	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//Swap identifier to track status in the update stream that is returned from
+	//the Start() call. Currently this is the hash that locks the htlcs.
+	//DEPRECATED: To make the API more consistent, this field is deprecated in
+	//favor of id_bytes and will be removed in a future release.
 	//
-	//The confirmation target that should be used either for the sweep of the
-	//on-chain HTLC broadcast by the swap server in the case of a Loop Out, or for
-	//the confirmation of the on-chain HTLC broadcast by the swap client in the
-	//case of a Loop In.
-	ConfTarget int32 `protobuf:"varint,2,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	// Deprecated: Do not use.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	//
-	//If external_htlc is true, we expect the htlc to be published by an external
-	//actor.
-	ExternalHtlc bool `protobuf:"varint,3,opt,name=external_htlc,json=externalHtlc,proto3" json:"external_htlc,omitempty"`
+	//Swap identifier to track status in the update stream that is returned from
+	//the Start() call. Currently this is the hash that locks the htlcs.
+	IdBytes []byte `protobuf:"bytes,3,opt,name=id_bytes,json=idBytes,proto3" json:"id_bytes,omitempty"`
 	//
-	//The latest time (in unix seconds) we allow the server to wait before
-	//publishing the HTLC on chain. Setting this to a larger value will give the
-	//server the opportunity to batch multiple swaps together, and wait for
-	//low-fee periods before publishing the HTLC, potentially resulting in a
-	//lower total swap fee. This only has an effect on loop out quotes.
-	SwapPublicationDeadline uint64 `protobuf:"varint,4,opt,name=swap_publication_deadline,json=swapPublicationDeadline,proto3" json:"swap_publication_deadline,omitempty"`
+	//DEPRECATED. This field stores the address of the onchain htlc, but
+	//depending on the request, the semantics are different.
+	//- For internal loop-in htlc_address contains the address of the
+	//native segwit (P2WSH) htlc.
+	//- For external loop-in htlc_address contains the address of the
+	//nested segwit (NP2WSH) htlc.
+	//- For loop-out htlc_address always contains the native segwit (P2WSH)
+	//htlc address.
 	//
-	//Optionally the client can specify the last hop pubkey when requesting a
-	//loop-in quote. This is useful to get better off-chain routing fee from the
-	//server.
-	LoopInLastHop []byte `protobuf:"bytes,5,opt,name=loop_in_last_hop,json=loopInLastHop,proto3" json:"loop_in_last_hop,omitempty"`
+	// Deprecated: Do not use.
+	HtlcAddress string `protobuf:"bytes,2,opt,name=htlc_address,json=htlcAddress,proto3" json:"htlc_address,omitempty"`
 	//
-	//Optional route hints to reach the destination through private channels.
-	LoopInRouteHints []*swapserverrpc.RouteHint `protobuf:"bytes,6,rep,name=loop_in_route_hints,json=loopInRouteHints,proto3" json:"loop_in_route_hints,omitempty"`
+	//The nested segwit address of the on-chain htlc.
+	//This field remains empty for loop-out.
+	HtlcAddressNp2Wsh string `protobuf:"bytes,4,opt,name=htlc_address_np2wsh,json=htlcAddressNp2wsh,proto3" json:"htlc_address_np2wsh,omitempty"`
 	//
-	//Private indicates whether the destination node should be considered
-	//private. In which case, loop will generate hophints to assist with
-	//probing and payment.
-	Private bool `protobuf:"varint,7,opt,name=private,proto3" json:"private,omitempty"`
+	//The native segwit address of the on-chain htlc.
+	//Used for both loop-in and loop-out.
+	HtlcAddressP2Wsh string `protobuf:"bytes,5,opt,name=htlc_address_p2wsh,json=htlcAddressP2wsh,proto3" json:"htlc_address_p2wsh,omitempty"`
+	// A human-readable message received from the loop server.
+	ServerMessage string `protobuf:"bytes,6,opt,name=server_message,json=serverMessage,proto3" json:"server_message,omitempty"`
+	//
+	//The value of the on-chain htlc output that the server will publish. This
+	//field is only set for loop-out.
+	HtlcValueSat int64 `protobuf:"varint,7,opt,name=htlc_value_sat,json=htlcValueSat,proto3" json:"htlc_value_sat,omitempty"`
+	//
+	//The amount of the swap invoice that is paid to the server. This field is
+	//only set for loop-out.
+	SwapInvoiceAmtSat int64 `protobuf:"varint,8,opt,name=swap_invoice_amt_sat,json=swapInvoiceAmtSat,proto3" json:"swap_invoice_amt_sat,omitempty"`
+	//
+	//The amount of the prepay invoice that is paid to the server. This field is
+	//only set for loop-out.
+	PrepayAmtSat int64 `protobuf:"varint,9,opt,name=prepay_amt_sat,json=prepayAmtSat,proto3" json:"prepay_amt_sat,omitempty"`
+	//
+	//The value we expect to sweep from the htlc, based on the fee estimate at
+	//the time the swap was initiated. This field is only set for loop-out.
+	ExpectedSweepValueSat int64 `protobuf:"varint,10,opt,name=expected_sweep_value_sat,json=expectedSweepValueSat,proto3" json:"expected_sweep_value_sat,omitempty"`
+	//
+	//The result of validating the request, only set if validate_only was set
+	//on the request. No swap is created in this case, so the other fields are
+	//not set.
+	Validation *SwapValidation `protobuf:"bytes,11,opt,name=validation,proto3" json:"validation,omitempty"`
 }
 
-func (x *QuoteRequest) Reset() {
-	*x = QuoteRequest{}
+func (x *SwapResponse) Reset() {
+	*x = SwapResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[11]
+		mi := &file_client_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuoteRequest) String() string {
+func (x *SwapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuoteRequest) ProtoMessage() {}
+func (*SwapResponse) ProtoMessage() {}
 
-func (x *QuoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[11]
+func (x *SwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1507,188 +2120,132 @@ func (x *QuoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuoteRequest.ProtoReflect.Descriptor instead.
-func (*QuoteRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SwapResponse.ProtoReflect.Descriptor instead.
+func (*SwapResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *QuoteRequest) GetAmt() int64 {
+// Deprecated: Do not use.
+func (x *SwapResponse) GetId() string {
 	if x != nil {
-		return x.Amt
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *QuoteRequest) GetConfTarget() int32 {
+func (x *SwapResponse) GetIdBytes() []byte {
 	if x != nil {
-		return x.ConfTarget
+		return x.IdBytes
 	}
-	return 0
+	return nil
 }
 
-func (x *QuoteRequest) GetExternalHtlc() bool {
+// Deprecated: Do not use.
+func (x *SwapResponse) GetHtlcAddress() string {
 	if x != nil {
-		return x.ExternalHtlc
+		return x.HtlcAddress
 	}
-	return false
+	return ""
 }
 
-func (x *QuoteRequest) GetSwapPublicationDeadline() uint64 {
+func (x *SwapResponse) GetHtlcAddressNp2Wsh() string {
 	if x != nil {
-		return x.SwapPublicationDeadline
+		return x.HtlcAddressNp2Wsh
 	}
-	return 0
+	return ""
 }
 
-func (x *QuoteRequest) GetLoopInLastHop() []byte {
+func (x *SwapResponse) GetHtlcAddressP2Wsh() string {
 	if x != nil {
-		return x.LoopInLastHop
+		return x.HtlcAddressP2Wsh
 	}
-	return nil
+	return ""
 }
 
-func (x *QuoteRequest) GetLoopInRouteHints() []*swapserverrpc.RouteHint {
+func (x *SwapResponse) GetServerMessage() string {
 	if x != nil {
-		return x.LoopInRouteHints
+		return x.ServerMessage
 	}
-	return nil
+	return ""
 }
 
-func (x *QuoteRequest) GetPrivate() bool {
+func (x *SwapResponse) GetHtlcValueSat() int64 {
 	if x != nil {
-		return x.Private
-	}
-	return false
-}
-
-type InQuoteResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//
-	//The fee that the swap server is charging for the swap.
-	SwapFeeSat int64 `protobuf:"varint,1,opt,name=swap_fee_sat,json=swapFeeSat,proto3" json:"swap_fee_sat,omitempty"`
-	//
-	//An estimate of the on-chain fee that needs to be paid to publish the HTLC
-	//If a miner fee of 0 is returned, it means the external_htlc flag was set for
-	//a loop in and the fee estimation was skipped. If a miner fee of -1 is
-	//returned, it means lnd's wallet tried to estimate the fee but was unable to
-	//create a sample estimation transaction because not enough funds are
-	//available. An information message should be shown to the user in this case.
-	HtlcPublishFeeSat int64 `protobuf:"varint,3,opt,name=htlc_publish_fee_sat,json=htlcPublishFeeSat,proto3" json:"htlc_publish_fee_sat,omitempty"`
-	//
-	//On-chain cltv expiry delta
-	CltvDelta int32 `protobuf:"varint,5,opt,name=cltv_delta,json=cltvDelta,proto3" json:"cltv_delta,omitempty"`
-	//
-	//The confirmation target to be used to publish the on-chain HTLC.
-	ConfTarget int32 `protobuf:"varint,6,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
-}
-
-func (x *InQuoteResponse) Reset() {
-	*x = InQuoteResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *InQuoteResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*InQuoteResponse) ProtoMessage() {}
-
-func (x *InQuoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.HtlcValueSat
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use InQuoteResponse.ProtoReflect.Descriptor instead.
-func (*InQuoteResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{12}
+	return 0
 }
 
-func (x *InQuoteResponse) GetSwapFeeSat() int64 {
+func (x *SwapResponse) GetSwapInvoiceAmtSat() int64 {
 	if x != nil {
-		return x.SwapFeeSat
+		return x.SwapInvoiceAmtSat
 	}
 	return 0
 }
 
-func (x *InQuoteResponse) GetHtlcPublishFeeSat() int64 {
+func (x *SwapResponse) GetPrepayAmtSat() int64 {
 	if x != nil {
-		return x.HtlcPublishFeeSat
+		return x.PrepayAmtSat
 	}
 	return 0
 }
 
-func (x *InQuoteResponse) GetCltvDelta() int32 {
+func (x *SwapResponse) GetExpectedSweepValueSat() int64 {
 	if x != nil {
-		return x.CltvDelta
+		return x.ExpectedSweepValueSat
 	}
 	return 0
 }
 
-func (x *InQuoteResponse) GetConfTarget() int32 {
+func (x *SwapResponse) GetValidation() *SwapValidation {
 	if x != nil {
-		return x.ConfTarget
+		return x.Validation
 	}
-	return 0
+	return nil
 }
 
-type OutQuoteResponse struct {
+type SwapValidation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The fee that the swap server is charging for the swap.
-	SwapFeeSat int64 `protobuf:"varint,1,opt,name=swap_fee_sat,json=swapFeeSat,proto3" json:"swap_fee_sat,omitempty"`
-	//
-	//The part of the swap fee that is requested as a prepayment.
-	PrepayAmtSat int64 `protobuf:"varint,2,opt,name=prepay_amt_sat,json=prepayAmtSat,proto3" json:"prepay_amt_sat,omitempty"`
+	//The loop out request as it would be executed, with its defaults filled
+	//in. Only set for loop out.
+	LoopOutRequest *LoopOutRequest `protobuf:"bytes,1,opt,name=loop_out_request,json=loopOutRequest,proto3" json:"loop_out_request,omitempty"`
 	//
-	//An estimate of the on-chain fee that needs to be paid to sweep the HTLC for
-	//a loop out.
-	HtlcSweepFeeSat int64 `protobuf:"varint,3,opt,name=htlc_sweep_fee_sat,json=htlcSweepFeeSat,proto3" json:"htlc_sweep_fee_sat,omitempty"`
+	//The loop in request as it would be executed, with its defaults filled
+	//in. Only set for loop in.
+	LoopInRequest *LoopInRequest `protobuf:"bytes,2,opt,name=loop_in_request,json=loopInRequest,proto3" json:"loop_in_request,omitempty"`
 	//
-	//The node pubkey where the swap payment needs to be paid
-	//to. This can be used to test connectivity before initiating the swap.
-	SwapPaymentDest []byte `protobuf:"bytes,4,opt,name=swap_payment_dest,json=swapPaymentDest,proto3" json:"swap_payment_dest,omitempty"`
+	//The swap fee quoted by the server.
+	SwapFeeSat int64 `protobuf:"varint,3,opt,name=swap_fee_sat,json=swapFeeSat,proto3" json:"swap_fee_sat,omitempty"`
 	//
-	//On-chain cltv expiry delta
-	CltvDelta int32 `protobuf:"varint,5,opt,name=cltv_delta,json=cltvDelta,proto3" json:"cltv_delta,omitempty"`
+	//The no-show fee quoted by the server, only set for loop out.
+	PrepayAmtSat int64 `protobuf:"varint,4,opt,name=prepay_amt_sat,json=prepayAmtSat,proto3" json:"prepay_amt_sat,omitempty"`
 	//
-	//The confirmation target to be used for the sweep of the on-chain HTLC.
-	ConfTarget int32 `protobuf:"varint,6,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	//The estimated on-chain fee that the swap would pay: the htlc sweep fee for
+	//loop out and the htlc publication fee for loop in.
+	MinerFeeSat int64 `protobuf:"varint,5,opt,name=miner_fee_sat,json=minerFeeSat,proto3" json:"miner_fee_sat,omitempty"`
 }
 
-func (x *OutQuoteResponse) Reset() {
-	*x = OutQuoteResponse{}
+func (x *SwapValidation) Reset() {
+	*x = SwapValidation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[13]
+		mi := &file_client_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OutQuoteResponse) String() string {
+func (x *SwapValidation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OutQuoteResponse) ProtoMessage() {}
+func (*SwapValidation) ProtoMessage() {}
 
-func (x *OutQuoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[13]
+func (x *SwapValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,86 +2256,74 @@ func (x *OutQuoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OutQuoteResponse.ProtoReflect.Descriptor instead.
-func (*OutQuoteResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SwapValidation.ProtoReflect.Descriptor instead.
+func (*SwapValidation) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *OutQuoteResponse) GetSwapFeeSat() int64 {
+func (x *SwapValidation) GetLoopOutRequest() *LoopOutRequest {
 	if x != nil {
-		return x.SwapFeeSat
+		return x.LoopOutRequest
 	}
-	return 0
+	return nil
 }
 
-func (x *OutQuoteResponse) GetPrepayAmtSat() int64 {
+func (x *SwapValidation) GetLoopInRequest() *LoopInRequest {
 	if x != nil {
-		return x.PrepayAmtSat
+		return x.LoopInRequest
 	}
-	return 0
+	return nil
 }
 
-func (x *OutQuoteResponse) GetHtlcSweepFeeSat() int64 {
+func (x *SwapValidation) GetSwapFeeSat() int64 {
 	if x != nil {
-		return x.HtlcSweepFeeSat
+		return x.SwapFeeSat
 	}
 	return 0
 }
 
-func (x *OutQuoteResponse) GetSwapPaymentDest() []byte {
-	if x != nil {
-		return x.SwapPaymentDest
-	}
-	return nil
-}
-
-func (x *OutQuoteResponse) GetCltvDelta() int32 {
+func (x *SwapValidation) GetPrepayAmtSat() int64 {
 	if x != nil {
-		return x.CltvDelta
+		return x.PrepayAmtSat
 	}
 	return 0
 }
 
-func (x *OutQuoteResponse) GetConfTarget() int32 {
+func (x *SwapValidation) GetMinerFeeSat() int64 {
 	if x != nil {
-		return x.ConfTarget
+		return x.MinerFeeSat
 	}
 	return 0
 }
 
-type ProbeRequest struct {
+type MonitorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The amount to probe.
-	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
-	//
-	//Optional last hop of the route to probe.
-	LastHop []byte `protobuf:"bytes,2,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
-	//
-	//Optional route hints to reach the destination through private channels.
-	RouteHints []*swapserverrpc.RouteHint `protobuf:"bytes,3,rep,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
+	//The unit that the response's display_amounts are formatted in. If unset,
+	//display_amounts are not returned.
+	DisplayUnit DisplayUnit `protobuf:"varint,1,opt,name=display_unit,json=displayUnit,proto3,enum=looprpc.DisplayUnit" json:"display_unit,omitempty"`
 }
 
-func (x *ProbeRequest) Reset() {
-	*x = ProbeRequest{}
+func (x *MonitorRequest) Reset() {
+	*x = MonitorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[14]
+		mi := &file_client_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProbeRequest) String() string {
+func (x *MonitorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProbeRequest) ProtoMessage() {}
+func (*MonitorRequest) ProtoMessage() {}
 
-func (x *ProbeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[14]
+func (x *MonitorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1789,55 +2334,118 @@ func (x *ProbeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProbeRequest.ProtoReflect.Descriptor instead.
-func (*ProbeRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *ProbeRequest) GetAmt() int64 {
-	if x != nil {
-		return x.Amt
-	}
-	return 0
-}
-
-func (x *ProbeRequest) GetLastHop() []byte {
-	if x != nil {
-		return x.LastHop
-	}
-	return nil
+// Deprecated: Use MonitorRequest.ProtoReflect.Descriptor instead.
+func (*MonitorRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ProbeRequest) GetRouteHints() []*swapserverrpc.RouteHint {
+func (x *MonitorRequest) GetDisplayUnit() DisplayUnit {
 	if x != nil {
-		return x.RouteHints
+		return x.DisplayUnit
 	}
-	return nil
+	return DisplayUnit_DISPLAY_UNIT_NONE
 }
 
-type ProbeResponse struct {
+type SwapStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//Requested swap amount in sat. This does not include the swap and miner
+	//fee.
+	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//Swap identifier to track status in the update stream that is returned from
+	//the Start() call. Currently this is the hash that locks the htlcs.
+	//DEPRECATED: To make the API more consistent, this field is deprecated in
+	//favor of id_bytes and will be removed in a future release.
+	//
+	// Deprecated: Do not use.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//Swap identifier to track status in the update stream that is returned from
+	//the Start() call. Currently this is the hash that locks the htlcs.
+	IdBytes []byte `protobuf:"bytes,11,opt,name=id_bytes,json=idBytes,proto3" json:"id_bytes,omitempty"`
+	//
+	//The type of the swap.
+	Type SwapType `protobuf:"varint,3,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
+	//
+	//State the swap is currently in, see State enum.
+	State SwapState `protobuf:"varint,4,opt,name=state,proto3,enum=looprpc.SwapState" json:"state,omitempty"`
+	//
+	//A failure reason for the swap, only set if the swap has failed.
+	FailureReason FailureReason `protobuf:"varint,14,opt,name=failure_reason,json=failureReason,proto3,enum=looprpc.FailureReason" json:"failure_reason,omitempty"`
+	//
+	//Initiation time of the swap.
+	InitiationTime int64 `protobuf:"varint,5,opt,name=initiation_time,json=initiationTime,proto3" json:"initiation_time,omitempty"`
+	//
+	//Initiation time of the swap.
+	LastUpdateTime int64 `protobuf:"varint,6,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`
+	//
+	//DEPRECATED:  This field stores the address of the onchain htlc.
+	//- For internal loop-in htlc_address contains the address of the
+	//native segwit (P2WSH) htlc.
+	//- For external loop-in htlc_address contains the nested segwit (NP2WSH)
+	//address.
+	//- For loop-out htlc_address always contains the native segwit (P2WSH)
+	//htlc address.
+	//
+	// Deprecated: Do not use.
+	HtlcAddress string `protobuf:"bytes,7,opt,name=htlc_address,json=htlcAddress,proto3" json:"htlc_address,omitempty"`
+	// HTLC address (native segwit), used in loop-in and loop-out swaps.
+	HtlcAddressP2Wsh string `protobuf:"bytes,12,opt,name=htlc_address_p2wsh,json=htlcAddressP2wsh,proto3" json:"htlc_address_p2wsh,omitempty"`
+	// HTLC address (nested segwit), used in loop-in swaps only.
+	HtlcAddressNp2Wsh string `protobuf:"bytes,13,opt,name=htlc_address_np2wsh,json=htlcAddressNp2wsh,proto3" json:"htlc_address_np2wsh,omitempty"`
+	// Swap server cost
+	CostServer int64 `protobuf:"varint,8,opt,name=cost_server,json=costServer,proto3" json:"cost_server,omitempty"`
+	// On-chain transaction cost
+	CostOnchain int64 `protobuf:"varint,9,opt,name=cost_onchain,json=costOnchain,proto3" json:"cost_onchain,omitempty"`
+	// Off-chain routing fees
+	CostOffchain int64 `protobuf:"varint,10,opt,name=cost_offchain,json=costOffchain,proto3" json:"cost_offchain,omitempty"`
+	// An optional label given to the swap on creation.
+	Label string `protobuf:"bytes,15,opt,name=label,proto3" json:"label,omitempty"`
+	// Optional free-text notes that the operator attached to the swap.
+	Notes string `protobuf:"bytes,16,opt,name=notes,proto3" json:"notes,omitempty"`
+	//
+	//Set for loop in swaps when the server has not paid the swap invoice by the
+	//time the htlc approaches expiry, as configured by loopd's
+	//loopinalarmdelta option.
+	InvoiceHoldAlarm bool `protobuf:"varint,17,opt,name=invoice_hold_alarm,json=invoiceHoldAlarm,proto3" json:"invoice_hold_alarm,omitempty"`
+	//
+	//The response's amounts formatted in the requested display unit, keyed by
+	//the name of the field that holds each amount. The amount fields themselves
+	//are always expressed as exact integers in their own units.
+	DisplayAmounts map[string]string `protobuf:"bytes,18,rep,name=display_amounts,json=displayAmounts,proto3" json:"display_amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//
+	//How the custom destination of a loop out was verified to be owned by the
+	//user. It is not set for swaps that sweep to the lnd wallet, or whose
+	//destination was not verified.
+	DestVerification *DestVerification `protobuf:"bytes,19,opt,name=dest_verification,json=destVerification,proto3" json:"dest_verification,omitempty"`
+	//
+	//Set when the swap has been pending for longer than the maximum lifetime
+	//for its type. A post-mortem report for the swap is available with the
+	//GetPostMortems rpc.
+	LifetimeExceeded bool `protobuf:"varint,20,opt,name=lifetime_exceeded,json=lifetimeExceeded,proto3" json:"lifetime_exceeded,omitempty"`
 }
 
-func (x *ProbeResponse) Reset() {
-	*x = ProbeResponse{}
+func (x *SwapStatus) Reset() {
+	*x = SwapStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[15]
+		mi := &file_client_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProbeResponse) String() string {
+func (x *SwapStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProbeResponse) ProtoMessage() {}
+func (*SwapStatus) ProtoMessage() {}
 
-func (x *ProbeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[15]
+func (x *SwapStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1848,149 +2456,185 @@ func (x *ProbeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProbeResponse.ProtoReflect.Descriptor instead.
-func (*ProbeResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SwapStatus.ProtoReflect.Descriptor instead.
+func (*SwapStatus) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{5}
 }
 
-type TokensRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SwapStatus) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
 }
 
-func (x *TokensRequest) Reset() {
-	*x = TokensRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Deprecated: Do not use.
+func (x *SwapStatus) GetId() string {
+	if x != nil {
+		return x.Id
 	}
+	return ""
 }
 
-func (x *TokensRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SwapStatus) GetIdBytes() []byte {
+	if x != nil {
+		return x.IdBytes
+	}
+	return nil
 }
 
-func (*TokensRequest) ProtoMessage() {}
+func (x *SwapStatus) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_LOOP_OUT
+}
 
-func (x *TokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SwapStatus) GetState() SwapState {
+	if x != nil {
+		return x.State
 	}
-	return mi.MessageOf(x)
+	return SwapState_INITIATED
 }
 
-// Deprecated: Use TokensRequest.ProtoReflect.Descriptor instead.
-func (*TokensRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{16}
+func (x *SwapStatus) GetFailureReason() FailureReason {
+	if x != nil {
+		return x.FailureReason
+	}
+	return FailureReason_FAILURE_REASON_NONE
 }
 
-type TokensResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SwapStatus) GetInitiationTime() int64 {
+	if x != nil {
+		return x.InitiationTime
+	}
+	return 0
+}
 
-	//
-	//List of all tokens the daemon knows of, including old/expired tokens.
-	Tokens []*LsatToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+func (x *SwapStatus) GetLastUpdateTime() int64 {
+	if x != nil {
+		return x.LastUpdateTime
+	}
+	return 0
 }
 
-func (x *TokensResponse) Reset() {
-	*x = TokensResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Deprecated: Do not use.
+func (x *SwapStatus) GetHtlcAddress() string {
+	if x != nil {
+		return x.HtlcAddress
 	}
+	return ""
 }
 
-func (x *TokensResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SwapStatus) GetHtlcAddressP2Wsh() string {
+	if x != nil {
+		return x.HtlcAddressP2Wsh
+	}
+	return ""
 }
 
-func (*TokensResponse) ProtoMessage() {}
+func (x *SwapStatus) GetHtlcAddressNp2Wsh() string {
+	if x != nil {
+		return x.HtlcAddressNp2Wsh
+	}
+	return ""
+}
 
-func (x *TokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SwapStatus) GetCostServer() int64 {
+	if x != nil {
+		return x.CostServer
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use TokensResponse.ProtoReflect.Descriptor instead.
-func (*TokensResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{17}
+func (x *SwapStatus) GetCostOnchain() int64 {
+	if x != nil {
+		return x.CostOnchain
+	}
+	return 0
 }
 
-func (x *TokensResponse) GetTokens() []*LsatToken {
+func (x *SwapStatus) GetCostOffchain() int64 {
 	if x != nil {
-		return x.Tokens
+		return x.CostOffchain
+	}
+	return 0
+}
+
+func (x *SwapStatus) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SwapStatus) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *SwapStatus) GetInvoiceHoldAlarm() bool {
+	if x != nil {
+		return x.InvoiceHoldAlarm
+	}
+	return false
+}
+
+func (x *SwapStatus) GetDisplayAmounts() map[string]string {
+	if x != nil {
+		return x.DisplayAmounts
 	}
 	return nil
 }
 
-type LsatToken struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SwapStatus) GetDestVerification() *DestVerification {
+	if x != nil {
+		return x.DestVerification
+	}
+	return nil
+}
+
+func (x *SwapStatus) GetLifetimeExceeded() bool {
+	if x != nil {
+		return x.LifetimeExceeded
+	}
+	return false
+}
+
+type DestVerification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
 	//
-	//The base macaroon that was baked by the auth server.
-	BaseMacaroon []byte `protobuf:"bytes,1,opt,name=base_macaroon,json=baseMacaroon,proto3" json:"base_macaroon,omitempty"`
-	//
-	//The payment hash of the payment that was paid to obtain the token.
-	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
-	//
-	//The preimage of the payment hash, knowledge of this is proof that the
-	//payment has been paid. If the preimage is set to all zeros, this means the
-	//payment is still pending and the token is not yet fully valid.
-	PaymentPreimage []byte `protobuf:"bytes,3,opt,name=payment_preimage,json=paymentPreimage,proto3" json:"payment_preimage,omitempty"`
-	//
-	//The amount of millisatoshis that was paid to get the token.
-	AmountPaidMsat int64 `protobuf:"varint,4,opt,name=amount_paid_msat,json=amountPaidMsat,proto3" json:"amount_paid_msat,omitempty"`
-	//
-	//The amount of millisatoshis paid in routing fee to pay for the token.
-	RoutingFeePaidMsat int64 `protobuf:"varint,5,opt,name=routing_fee_paid_msat,json=routingFeePaidMsat,proto3" json:"routing_fee_paid_msat,omitempty"`
-	//
-	//The creation time of the token as UNIX timestamp in seconds.
-	TimeCreated int64 `protobuf:"varint,6,opt,name=time_created,json=timeCreated,proto3" json:"time_created,omitempty"`
-	//
-	//Indicates whether the token is expired or still valid.
-	Expired bool `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
+	//The method that the destination was verified with.
+	Method DestVerificationMethod `protobuf:"varint,1,opt,name=method,proto3,enum=looprpc.DestVerificationMethod" json:"method,omitempty"`
 	//
-	//Identifying attribute of this token in the store. Currently represents the
-	//file name of the token where it's stored on the file system.
-	StorageName string `protobuf:"bytes,8,opt,name=storage_name,json=storageName,proto3" json:"storage_name,omitempty"`
+	//The evidence that the destination was verified with: the base64 encoded
+	//signature for signature verification, or the xpub and derivation path of
+	//the address for xpub verification.
+	Proof string `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (x *LsatToken) Reset() {
-	*x = LsatToken{}
+func (x *DestVerification) Reset() {
+	*x = DestVerification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[18]
+		mi := &file_client_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsatToken) String() string {
+func (x *DestVerification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsatToken) ProtoMessage() {}
+func (*DestVerification) ProtoMessage() {}
 
-func (x *LsatToken) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[18]
+func (x *DestVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2001,90 +2645,102 @@ func (x *LsatToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsatToken.ProtoReflect.Descriptor instead.
-func (*LsatToken) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DestVerification.ProtoReflect.Descriptor instead.
+func (*DestVerification) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *LsatToken) GetBaseMacaroon() []byte {
+func (x *DestVerification) GetMethod() DestVerificationMethod {
 	if x != nil {
-		return x.BaseMacaroon
+		return x.Method
 	}
-	return nil
+	return DestVerificationMethod_DEST_VERIFICATION_NONE
 }
 
-func (x *LsatToken) GetPaymentHash() []byte {
+func (x *DestVerification) GetProof() string {
 	if x != nil {
-		return x.PaymentHash
+		return x.Proof
 	}
-	return nil
+	return ""
 }
 
-func (x *LsatToken) GetPaymentPreimage() []byte {
-	if x != nil {
-		return x.PaymentPreimage
-	}
-	return nil
+type ListSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unit that the response's display_amounts are formatted in. If unset,
+	//display_amounts are not returned.
+	DisplayUnit DisplayUnit `protobuf:"varint,1,opt,name=display_unit,json=displayUnit,proto3,enum=looprpc.DisplayUnit" json:"display_unit,omitempty"`
 }
 
-func (x *LsatToken) GetAmountPaidMsat() int64 {
-	if x != nil {
-		return x.AmountPaidMsat
+func (x *ListSwapsRequest) Reset() {
+	*x = ListSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *LsatToken) GetRoutingFeePaidMsat() int64 {
-	if x != nil {
-		return x.RoutingFeePaidMsat
-	}
-	return 0
+func (x *ListSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *LsatToken) GetTimeCreated() int64 {
-	if x != nil {
-		return x.TimeCreated
+func (*ListSwapsRequest) ProtoMessage() {}
+
+func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *LsatToken) GetExpired() bool {
-	if x != nil {
-		return x.Expired
-	}
-	return false
+// Deprecated: Use ListSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ListSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *LsatToken) GetStorageName() string {
+func (x *ListSwapsRequest) GetDisplayUnit() DisplayUnit {
 	if x != nil {
-		return x.StorageName
+		return x.DisplayUnit
 	}
-	return ""
+	return DisplayUnit_DISPLAY_UNIT_NONE
 }
 
-type GetLiquidityParamsRequest struct {
+type ListSwapsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//The list of all currently known swaps and their status.
+	Swaps []*SwapStatus `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
 }
 
-func (x *GetLiquidityParamsRequest) Reset() {
-	*x = GetLiquidityParamsRequest{}
+func (x *ListSwapsResponse) Reset() {
+	*x = ListSwapsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[19]
+		mi := &file_client_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLiquidityParamsRequest) String() string {
+func (x *ListSwapsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLiquidityParamsRequest) ProtoMessage() {}
+func (*ListSwapsResponse) ProtoMessage() {}
 
-func (x *GetLiquidityParamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[19]
+func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2095,112 +2751,50 @@ func (x *GetLiquidityParamsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLiquidityParamsRequest.ProtoReflect.Descriptor instead.
-func (*GetLiquidityParamsRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ListSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{8}
 }
 
-type LiquidityParameters struct {
+func (x *ListSwapsResponse) GetSwaps() []*SwapStatus {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+type SwapInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//A set of liquidity rules that describe the desired liquidity balance.
-	Rules []*LiquidityRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
-	//
-	//The parts per million of swap amount that is allowed to be allocated to swap
-	//fees. This value is applied across swap categories and may not be set in
-	//conjunction with sweep fee rate, swap fee ppm, routing fee ppm, prepay
-	//routing, max prepay and max miner fee.
-	FeePpm uint64 `protobuf:"varint,16,opt,name=fee_ppm,json=feePpm,proto3" json:"fee_ppm,omitempty"`
-	//
-	//The limit we place on our estimated sweep cost for a swap in sat/vByte. If
-	//the estimated fee for our sweep transaction within the specified
-	//confirmation target is above this value, we will not suggest any swaps.
-	SweepFeeRateSatPerVbyte uint64 `protobuf:"varint,2,opt,name=sweep_fee_rate_sat_per_vbyte,json=sweepFeeRateSatPerVbyte,proto3" json:"sweep_fee_rate_sat_per_vbyte,omitempty"`
-	//
-	//The maximum fee paid to the server for facilitating the swap, expressed
-	//as parts per million of the swap volume.
-	MaxSwapFeePpm uint64 `protobuf:"varint,3,opt,name=max_swap_fee_ppm,json=maxSwapFeePpm,proto3" json:"max_swap_fee_ppm,omitempty"`
-	//
-	//The maximum fee paid to route the swap invoice off chain, expressed as
-	//parts per million of the volume being routed.
-	MaxRoutingFeePpm uint64 `protobuf:"varint,4,opt,name=max_routing_fee_ppm,json=maxRoutingFeePpm,proto3" json:"max_routing_fee_ppm,omitempty"`
-	//
-	//The maximum fee paid to route the prepay invoice off chain, expressed as
-	//parts per million of the volume being routed.
-	MaxPrepayRoutingFeePpm uint64 `protobuf:"varint,5,opt,name=max_prepay_routing_fee_ppm,json=maxPrepayRoutingFeePpm,proto3" json:"max_prepay_routing_fee_ppm,omitempty"`
-	//
-	//The maximum no-show penalty in satoshis paid for a swap.
-	MaxPrepaySat uint64 `protobuf:"varint,6,opt,name=max_prepay_sat,json=maxPrepaySat,proto3" json:"max_prepay_sat,omitempty"`
-	//
-	//The maximum miner fee we will pay to sweep the swap on chain. Note that we
-	//will not suggest a swap if the estimate is above the sweep limit set by
-	//these parameters, and we use the current fee estimate to sweep on chain so
-	//this value is only a cap placed on the amount we spend on fees in the case
-	//where the swap needs to be claimed on chain, but fees have suddenly spiked.
-	MaxMinerFeeSat uint64 `protobuf:"varint,7,opt,name=max_miner_fee_sat,json=maxMinerFeeSat,proto3" json:"max_miner_fee_sat,omitempty"`
-	//
-	//The number of blocks from the on-chain HTLC's confirmation height that it
-	//should be swept within.
-	SweepConfTarget int32 `protobuf:"varint,8,opt,name=sweep_conf_target,json=sweepConfTarget,proto3" json:"sweep_conf_target,omitempty"`
-	//
-	//The amount of time we require pass since a channel was part of a failed
-	//swap due to off chain payment failure until it will be considered for swap
-	//suggestions again, expressed in seconds.
-	FailureBackoffSec uint64 `protobuf:"varint,9,opt,name=failure_backoff_sec,json=failureBackoffSec,proto3" json:"failure_backoff_sec,omitempty"`
-	//
-	//Set to true to enable automatic dispatch of swaps. All swaps will be limited
-	//to the fee categories set by these parameters, and total expenditure will
-	//be limited to the autoloop budget.
-	Autoloop bool `protobuf:"varint,10,opt,name=autoloop,proto3" json:"autoloop,omitempty"`
-	//
-	//The total budget for automatically dispatched swaps since the budget start
-	//time, expressed in satoshis.
-	AutoloopBudgetSat uint64 `protobuf:"varint,11,opt,name=autoloop_budget_sat,json=autoloopBudgetSat,proto3" json:"autoloop_budget_sat,omitempty"`
-	//
-	//The start time for autoloop budget, expressed as a unix timestamp in
-	//seconds. If this value is 0, the budget will be applied for all
-	//automatically dispatched swaps. Swaps that were completed before this date
-	//will not be included in budget calculations.
-	AutoloopBudgetStartSec uint64 `protobuf:"varint,12,opt,name=autoloop_budget_start_sec,json=autoloopBudgetStartSec,proto3" json:"autoloop_budget_start_sec,omitempty"`
-	//
-	//The maximum number of automatically dispatched swaps that we allow to be in
-	//flight at any point in time.
-	AutoMaxInFlight uint64 `protobuf:"varint,13,opt,name=auto_max_in_flight,json=autoMaxInFlight,proto3" json:"auto_max_in_flight,omitempty"`
-	//
-	//The minimum amount, expressed in satoshis, that the autoloop client will
-	//dispatch a swap for. This value is subject to the server-side limits
-	//specified by the LoopOutTerms endpoint.
-	MinSwapAmount uint64 `protobuf:"varint,14,opt,name=min_swap_amount,json=minSwapAmount,proto3" json:"min_swap_amount,omitempty"`
-	//
-	//The maximum amount, expressed in satoshis, that the autoloop client will
-	//dispatch a swap for. This value is subject to the server-side limits
-	//specified by the LoopOutTerms endpoint.
-	MaxSwapAmount uint64 `protobuf:"varint,15,opt,name=max_swap_amount,json=maxSwapAmount,proto3" json:"max_swap_amount,omitempty"`
+	//The swap identifier which currently is the hash that locks the HTLCs. When
+	//using REST, this field must be encoded as URL safe base64.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	//
-	//The confirmation target for loop in on-chain htlcs.
-	HtlcConfTarget int32 `protobuf:"varint,17,opt,name=htlc_conf_target,json=htlcConfTarget,proto3" json:"htlc_conf_target,omitempty"`
+	//The unit that the response's display_amounts are formatted in. If unset,
+	//display_amounts are not returned.
+	DisplayUnit DisplayUnit `protobuf:"varint,2,opt,name=display_unit,json=displayUnit,proto3,enum=looprpc.DisplayUnit" json:"display_unit,omitempty"`
 }
 
-func (x *LiquidityParameters) Reset() {
-	*x = LiquidityParameters{}
+func (x *SwapInfoRequest) Reset() {
+	*x = SwapInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[20]
+		mi := &file_client_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LiquidityParameters) String() string {
+func (x *SwapInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LiquidityParameters) ProtoMessage() {}
+func (*SwapInfoRequest) ProtoMessage() {}
 
-func (x *LiquidityParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[20]
+func (x *SwapInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2211,178 +2805,152 @@ func (x *LiquidityParameters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LiquidityParameters.ProtoReflect.Descriptor instead.
-func (*LiquidityParameters) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SwapInfoRequest.ProtoReflect.Descriptor instead.
+func (*SwapInfoRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *LiquidityParameters) GetRules() []*LiquidityRule {
+func (x *SwapInfoRequest) GetId() []byte {
 	if x != nil {
-		return x.Rules
+		return x.Id
 	}
 	return nil
 }
 
-func (x *LiquidityParameters) GetFeePpm() uint64 {
+func (x *SwapInfoRequest) GetDisplayUnit() DisplayUnit {
 	if x != nil {
-		return x.FeePpm
+		return x.DisplayUnit
 	}
-	return 0
+	return DisplayUnit_DISPLAY_UNIT_NONE
 }
 
-func (x *LiquidityParameters) GetSweepFeeRateSatPerVbyte() uint64 {
-	if x != nil {
-		return x.SweepFeeRateSatPerVbyte
-	}
-	return 0
-}
+type SetSwapNotesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *LiquidityParameters) GetMaxSwapFeePpm() uint64 {
-	if x != nil {
-		return x.MaxSwapFeePpm
-	}
-	return 0
+	//
+	//The swap identifier which currently is the hash that locks the HTLCs. When
+	//using REST, this field must be encoded as URL safe base64.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The notes to attach to the swap. Any existing notes are replaced, an empty
+	//string clears the notes.
+	Notes string `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
 }
 
-func (x *LiquidityParameters) GetMaxRoutingFeePpm() uint64 {
-	if x != nil {
-		return x.MaxRoutingFeePpm
+func (x *SetSwapNotesRequest) Reset() {
+	*x = SetSwapNotesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *LiquidityParameters) GetMaxPrepayRoutingFeePpm() uint64 {
-	if x != nil {
-		return x.MaxPrepayRoutingFeePpm
-	}
-	return 0
+func (x *SetSwapNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *LiquidityParameters) GetMaxPrepaySat() uint64 {
-	if x != nil {
-		return x.MaxPrepaySat
-	}
-	return 0
-}
+func (*SetSwapNotesRequest) ProtoMessage() {}
 
-func (x *LiquidityParameters) GetMaxMinerFeeSat() uint64 {
-	if x != nil {
-		return x.MaxMinerFeeSat
+func (x *SetSwapNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *LiquidityParameters) GetSweepConfTarget() int32 {
-	if x != nil {
-		return x.SweepConfTarget
-	}
-	return 0
+// Deprecated: Use SetSwapNotesRequest.ProtoReflect.Descriptor instead.
+func (*SetSwapNotesRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *LiquidityParameters) GetFailureBackoffSec() uint64 {
+func (x *SetSwapNotesRequest) GetId() []byte {
 	if x != nil {
-		return x.FailureBackoffSec
+		return x.Id
 	}
-	return 0
+	return nil
 }
 
-func (x *LiquidityParameters) GetAutoloop() bool {
+func (x *SetSwapNotesRequest) GetNotes() string {
 	if x != nil {
-		return x.Autoloop
+		return x.Notes
 	}
-	return false
+	return ""
 }
 
-func (x *LiquidityParameters) GetAutoloopBudgetSat() uint64 {
-	if x != nil {
-		return x.AutoloopBudgetSat
-	}
-	return 0
+type SetSwapNotesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *LiquidityParameters) GetAutoloopBudgetStartSec() uint64 {
-	if x != nil {
-		return x.AutoloopBudgetStartSec
+func (x *SetSwapNotesResponse) Reset() {
+	*x = SetSwapNotesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *LiquidityParameters) GetAutoMaxInFlight() uint64 {
-	if x != nil {
-		return x.AutoMaxInFlight
-	}
-	return 0
+func (x *SetSwapNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *LiquidityParameters) GetMinSwapAmount() uint64 {
-	if x != nil {
-		return x.MinSwapAmount
-	}
-	return 0
-}
+func (*SetSwapNotesResponse) ProtoMessage() {}
 
-func (x *LiquidityParameters) GetMaxSwapAmount() uint64 {
-	if x != nil {
-		return x.MaxSwapAmount
+func (x *SetSwapNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *LiquidityParameters) GetHtlcConfTarget() int32 {
-	if x != nil {
-		return x.HtlcConfTarget
-	}
-	return 0
+// Deprecated: Use SetSwapNotesResponse.ProtoReflect.Descriptor instead.
+func (*SetSwapNotesResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{11}
 }
 
-type LiquidityRule struct {
+type SearchSwapsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The short channel ID of the channel that this rule should be applied to.
-	//This field may not be set when the pubkey field is set.
-	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	// The type of swap that will be dispatched for this rule.
-	SwapType SwapType `protobuf:"varint,6,opt,name=swap_type,json=swapType,proto3,enum=looprpc.SwapType" json:"swap_type,omitempty"`
-	//
-	//The public key of the peer that this rule should be applied to. This field
-	//may not be set when the channel id field is set.
-	Pubkey []byte `protobuf:"bytes,5,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	//
-	//Type indicates the type of rule that this message rule represents. Setting
-	//this value will determine which fields are used in the message. The comments
-	//on each field in this message will be prefixed with the LiquidityRuleType
-	//they belong to.
-	Type LiquidityRuleType `protobuf:"varint,2,opt,name=type,proto3,enum=looprpc.LiquidityRuleType" json:"type,omitempty"`
-	//
-	//THRESHOLD: The percentage of total capacity that incoming capacity should
-	//not drop beneath.
-	IncomingThreshold uint32 `protobuf:"varint,3,opt,name=incoming_threshold,json=incomingThreshold,proto3" json:"incoming_threshold,omitempty"`
-	//
-	//THRESHOLD: The percentage of total capacity that outgoing capacity should
-	//not drop beneath.
-	OutgoingThreshold uint32 `protobuf:"varint,4,opt,name=outgoing_threshold,json=outgoingThreshold,proto3" json:"outgoing_threshold,omitempty"`
+	//The string to search for. Any swap that has a field which contains this
+	//string is returned, so prefixes of hashes, txids and pubkeys may be used.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *LiquidityRule) Reset() {
-	*x = LiquidityRule{}
+func (x *SearchSwapsRequest) Reset() {
+	*x = SearchSwapsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[21]
+		mi := &file_client_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LiquidityRule) String() string {
+func (x *SearchSwapsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LiquidityRule) ProtoMessage() {}
+func (*SearchSwapsRequest) ProtoMessage() {}
 
-func (x *LiquidityRule) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[21]
+func (x *SearchSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2393,83 +2961,45 @@ func (x *LiquidityRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LiquidityRule.ProtoReflect.Descriptor instead.
-func (*LiquidityRule) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *LiquidityRule) GetChannelId() uint64 {
-	if x != nil {
-		return x.ChannelId
-	}
-	return 0
-}
-
-func (x *LiquidityRule) GetSwapType() SwapType {
-	if x != nil {
-		return x.SwapType
-	}
-	return SwapType_LOOP_OUT
-}
-
-func (x *LiquidityRule) GetPubkey() []byte {
-	if x != nil {
-		return x.Pubkey
-	}
-	return nil
-}
-
-func (x *LiquidityRule) GetType() LiquidityRuleType {
-	if x != nil {
-		return x.Type
-	}
-	return LiquidityRuleType_UNKNOWN
-}
-
-func (x *LiquidityRule) GetIncomingThreshold() uint32 {
-	if x != nil {
-		return x.IncomingThreshold
-	}
-	return 0
+// Deprecated: Use SearchSwapsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *LiquidityRule) GetOutgoingThreshold() uint32 {
+func (x *SearchSwapsRequest) GetQuery() string {
 	if x != nil {
-		return x.OutgoingThreshold
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-type SetLiquidityParamsRequest struct {
+type SearchSwapsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//Parameters is the desired new set of parameters for the liquidity management
-	//subsystem. Note that the current set of parameters will be completely
-	//overwritten by the parameters provided (if they are valid), so the full set
-	//of parameters should be provided for each call.
-	Parameters *LiquidityParameters `protobuf:"bytes,1,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	//The list of swaps that matched the search query.
+	Swaps []*SwapStatus `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
 }
 
-func (x *SetLiquidityParamsRequest) Reset() {
-	*x = SetLiquidityParamsRequest{}
+func (x *SearchSwapsResponse) Reset() {
+	*x = SearchSwapsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[22]
+		mi := &file_client_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetLiquidityParamsRequest) String() string {
+func (x *SearchSwapsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLiquidityParamsRequest) ProtoMessage() {}
+func (*SearchSwapsResponse) ProtoMessage() {}
 
-func (x *SetLiquidityParamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[22]
+func (x *SearchSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2480,41 +3010,41 @@ func (x *SetLiquidityParamsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLiquidityParamsRequest.ProtoReflect.Descriptor instead.
-func (*SetLiquidityParamsRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use SearchSwapsResponse.ProtoReflect.Descriptor instead.
+func (*SearchSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SetLiquidityParamsRequest) GetParameters() *LiquidityParameters {
+func (x *SearchSwapsResponse) GetSwaps() []*SwapStatus {
 	if x != nil {
-		return x.Parameters
+		return x.Swaps
 	}
 	return nil
 }
 
-type SetLiquidityParamsResponse struct {
+type ListSwapConflictsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetLiquidityParamsResponse) Reset() {
-	*x = SetLiquidityParamsResponse{}
+func (x *ListSwapConflictsRequest) Reset() {
+	*x = ListSwapConflictsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[23]
+		mi := &file_client_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetLiquidityParamsResponse) String() string {
+func (x *ListSwapConflictsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLiquidityParamsResponse) ProtoMessage() {}
+func (*ListSwapConflictsRequest) ProtoMessage() {}
 
-func (x *SetLiquidityParamsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[23]
+func (x *ListSwapConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2525,34 +3055,44 @@ func (x *SetLiquidityParamsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLiquidityParamsResponse.ProtoReflect.Descriptor instead.
-func (*SetLiquidityParamsResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ListSwapConflictsRequest.ProtoReflect.Descriptor instead.
+func (*ListSwapConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{14}
 }
 
-type SuggestSwapsRequest struct {
+type SwapConflict struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swap hash that is shared by both swaps.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The loop out swap that uses the hash.
+	LoopOut *SwapStatus `protobuf:"bytes,2,opt,name=loop_out,json=loopOut,proto3" json:"loop_out,omitempty"`
+	//
+	//The loop in swap that uses the hash.
+	LoopIn *SwapStatus `protobuf:"bytes,3,opt,name=loop_in,json=loopIn,proto3" json:"loop_in,omitempty"`
 }
 
-func (x *SuggestSwapsRequest) Reset() {
-	*x = SuggestSwapsRequest{}
+func (x *SwapConflict) Reset() {
+	*x = SwapConflict{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[24]
+		mi := &file_client_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SuggestSwapsRequest) String() string {
+func (x *SwapConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SuggestSwapsRequest) ProtoMessage() {}
+func (*SwapConflict) ProtoMessage() {}
 
-func (x *SuggestSwapsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[24]
+func (x *SwapConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2563,696 +3103,12236 @@ func (x *SuggestSwapsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SuggestSwapsRequest.ProtoReflect.Descriptor instead.
-func (*SuggestSwapsRequest) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use SwapConflict.ProtoReflect.Descriptor instead.
+func (*SwapConflict) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{15}
 }
 
-type Disqualified struct {
+func (x *SwapConflict) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *SwapConflict) GetLoopOut() *SwapStatus {
+	if x != nil {
+		return x.LoopOut
+	}
+	return nil
+}
+
+func (x *SwapConflict) GetLoopIn() *SwapStatus {
+	if x != nil {
+		return x.LoopIn
+	}
+	return nil
+}
+
+type ListSwapConflictsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	//
-	//The short channel ID of the channel that was excluded from our suggestions.
-	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	//The swap hashes that are used by both a loop out and a loop in swap.
+	Conflicts []*SwapConflict `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (x *ListSwapConflictsResponse) Reset() {
+	*x = ListSwapConflictsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSwapConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwapConflictsResponse) ProtoMessage() {}
+
+func (x *ListSwapConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSwapConflictsResponse.ProtoReflect.Descriptor instead.
+func (*ListSwapConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListSwapConflictsResponse) GetConflicts() []*SwapConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type ResolveSwapConflictRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
 	//
-	//The public key of the peer that was excluded from our suggestions.
-	Pubkey []byte `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	//The conflicting swap hash. When using REST, this field must be encoded as
+	//URL safe base64.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	//
-	//The reason that we excluded the channel from the our suggestions.
-	Reason AutoReason `protobuf:"varint,2,opt,name=reason,proto3,enum=looprpc.AutoReason" json:"reason,omitempty"`
+	//The type of the swap that should be kept.
+	Keep SwapType `protobuf:"varint,2,opt,name=keep,proto3,enum=looprpc.SwapType" json:"keep,omitempty"`
 }
 
-func (x *Disqualified) Reset() {
-	*x = Disqualified{}
+func (x *ResolveSwapConflictRequest) Reset() {
+	*x = ResolveSwapConflictRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[25]
+		mi := &file_client_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Disqualified) String() string {
+func (x *ResolveSwapConflictRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Disqualified) ProtoMessage() {}
+func (*ResolveSwapConflictRequest) ProtoMessage() {}
 
-func (x *Disqualified) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[25]
+func (x *ResolveSwapConflictRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveSwapConflictRequest.ProtoReflect.Descriptor instead.
+func (*ResolveSwapConflictRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResolveSwapConflictRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ResolveSwapConflictRequest) GetKeep() SwapType {
+	if x != nil {
+		return x.Keep
+	}
+	return SwapType_LOOP_OUT
+}
+
+type ResolveSwapConflictResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swap that was kept.
+	Swap *SwapStatus `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
+}
+
+func (x *ResolveSwapConflictResponse) Reset() {
+	*x = ResolveSwapConflictResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveSwapConflictResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveSwapConflictResponse) ProtoMessage() {}
+
+func (x *ResolveSwapConflictResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveSwapConflictResponse.ProtoReflect.Descriptor instead.
+func (*ResolveSwapConflictResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ResolveSwapConflictResponse) GetSwap() *SwapStatus {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+type FeeReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unit that the response's display_amounts are formatted in. If unset,
+	//display_amounts are not returned.
+	DisplayUnit DisplayUnit `protobuf:"varint,1,opt,name=display_unit,json=displayUnit,proto3,enum=looprpc.DisplayUnit" json:"display_unit,omitempty"`
+}
+
+func (x *FeeReportRequest) Reset() {
+	*x = FeeReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeReportRequest) ProtoMessage() {}
+
+func (x *FeeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeReportRequest.ProtoReflect.Descriptor instead.
+func (*FeeReportRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FeeReportRequest) GetDisplayUnit() DisplayUnit {
+	if x != nil {
+		return x.DisplayUnit
+	}
+	return DisplayUnit_DISPLAY_UNIT_NONE
+}
+
+type FeeReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//A comparison for each successful loop out, ordered by completion time.
+	Swaps []*LoopOutFeeComparison `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+	//
+	//The total cost of the loop outs in the report.
+	TotalLoopCostSat int64 `protobuf:"varint,2,opt,name=total_loop_cost_sat,json=totalLoopCostSat,proto3" json:"total_loop_cost_sat,omitempty"`
+	//
+	//The total estimated cost of plain on-chain sends for the loop outs in the
+	//report.
+	TotalOnchainCostSat int64 `protobuf:"varint,3,opt,name=total_onchain_cost_sat,json=totalOnchainCostSat,proto3" json:"total_onchain_cost_sat,omitempty"`
+	//
+	//The total estimated cost of closing and reopening a channel for the loop
+	//outs in the report.
+	TotalCloseReopenCostSat int64 `protobuf:"varint,4,opt,name=total_close_reopen_cost_sat,json=totalCloseReopenCostSat,proto3" json:"total_close_reopen_cost_sat,omitempty"`
+	//
+	//The number of successful loop outs that are not included in the report
+	//because their on-chain cost was not recorded.
+	Skipped uint32 `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	//
+	//The response's amounts formatted in the requested display unit, keyed by
+	//the name of the field that holds each amount. The amount fields themselves
+	//are always expressed as exact integers in their own units.
+	DisplayAmounts map[string]string `protobuf:"bytes,6,rep,name=display_amounts,json=displayAmounts,proto3" json:"display_amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *FeeReportResponse) Reset() {
+	*x = FeeReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeReportResponse) ProtoMessage() {}
+
+func (x *FeeReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeReportResponse.ProtoReflect.Descriptor instead.
+func (*FeeReportResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FeeReportResponse) GetSwaps() []*LoopOutFeeComparison {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+func (x *FeeReportResponse) GetTotalLoopCostSat() int64 {
+	if x != nil {
+		return x.TotalLoopCostSat
+	}
+	return 0
+}
+
+func (x *FeeReportResponse) GetTotalOnchainCostSat() int64 {
+	if x != nil {
+		return x.TotalOnchainCostSat
+	}
+	return 0
+}
+
+func (x *FeeReportResponse) GetTotalCloseReopenCostSat() int64 {
+	if x != nil {
+		return x.TotalCloseReopenCostSat
+	}
+	return 0
+}
+
+func (x *FeeReportResponse) GetSkipped() uint32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *FeeReportResponse) GetDisplayAmounts() map[string]string {
+	if x != nil {
+		return x.DisplayAmounts
+	}
+	return nil
+}
+
+type LoopOutFeeComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swap hash of the loop out.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The amount of the loop out.
+	Amt int64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The unix timestamp in nanoseconds at which the loop out completed.
+	CompletionTime int64 `protobuf:"varint,3,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+	//
+	//The fee rate that the loop out's sweep paid, expressed in sat/vbyte.
+	SatPerVbyte float64 `protobuf:"fixed64,4,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	//
+	//The total cost of the loop out, including its server, on-chain and
+	//off-chain fees.
+	LoopCostSat int64 `protobuf:"varint,5,opt,name=loop_cost_sat,json=loopCostSat,proto3" json:"loop_cost_sat,omitempty"`
+	//
+	//The estimated miner fee for a plain on-chain send of the loop out's
+	//amount, which spends a single p2wkh output with change, at the loop out's
+	//fee rate.
+	OnchainCostSat int64 `protobuf:"varint,6,opt,name=onchain_cost_sat,json=onchainCostSat,proto3" json:"onchain_cost_sat,omitempty"`
+	//
+	//The estimated miner fee for cooperatively closing a channel and funding a
+	//new one at the loop out's fee rate.
+	CloseReopenCostSat int64 `protobuf:"varint,7,opt,name=close_reopen_cost_sat,json=closeReopenCostSat,proto3" json:"close_reopen_cost_sat,omitempty"`
+	//
+	//The response's amounts formatted in the requested display unit, keyed by
+	//the name of the field that holds each amount. The amount fields themselves
+	//are always expressed as exact integers in their own units.
+	DisplayAmounts map[string]string `protobuf:"bytes,8,rep,name=display_amounts,json=displayAmounts,proto3" json:"display_amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *LoopOutFeeComparison) Reset() {
+	*x = LoopOutFeeComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoopOutFeeComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoopOutFeeComparison) ProtoMessage() {}
+
+func (x *LoopOutFeeComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Disqualified.ProtoReflect.Descriptor instead.
-func (*Disqualified) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *Disqualified) GetChannelId() uint64 {
-	if x != nil {
-		return x.ChannelId
-	}
-	return 0
-}
-
-func (x *Disqualified) GetPubkey() []byte {
-	if x != nil {
-		return x.Pubkey
-	}
-	return nil
-}
-
-func (x *Disqualified) GetReason() AutoReason {
-	if x != nil {
-		return x.Reason
-	}
-	return AutoReason_AUTO_REASON_UNKNOWN
-}
-
-type SuggestSwapsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//
-	//The set of recommended loop outs.
-	LoopOut []*LoopOutRequest `protobuf:"bytes,1,rep,name=loop_out,json=loopOut,proto3" json:"loop_out,omitempty"`
-	//
-	//The set of recommended loop in swaps
-	LoopIn []*LoopInRequest `protobuf:"bytes,3,rep,name=loop_in,json=loopIn,proto3" json:"loop_in,omitempty"`
-	//
-	//Disqualified contains the set of channels that swaps are not recommended
-	//for.
-	Disqualified []*Disqualified `protobuf:"bytes,2,rep,name=disqualified,proto3" json:"disqualified,omitempty"`
-}
-
-func (x *SuggestSwapsResponse) Reset() {
-	*x = SuggestSwapsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_client_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SuggestSwapsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SuggestSwapsResponse) ProtoMessage() {}
-
-func (x *SuggestSwapsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_client_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoopOutFeeComparison.ProtoReflect.Descriptor instead.
+func (*LoopOutFeeComparison) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LoopOutFeeComparison) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *LoopOutFeeComparison) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *LoopOutFeeComparison) GetCompletionTime() int64 {
+	if x != nil {
+		return x.CompletionTime
+	}
+	return 0
+}
+
+func (x *LoopOutFeeComparison) GetSatPerVbyte() float64 {
+	if x != nil {
+		return x.SatPerVbyte
+	}
+	return 0
+}
+
+func (x *LoopOutFeeComparison) GetLoopCostSat() int64 {
+	if x != nil {
+		return x.LoopCostSat
+	}
+	return 0
+}
+
+func (x *LoopOutFeeComparison) GetOnchainCostSat() int64 {
+	if x != nil {
+		return x.OnchainCostSat
+	}
+	return 0
+}
+
+func (x *LoopOutFeeComparison) GetCloseReopenCostSat() int64 {
+	if x != nil {
+		return x.CloseReopenCostSat
+	}
+	return 0
+}
+
+func (x *LoopOutFeeComparison) GetDisplayAmounts() map[string]string {
+	if x != nil {
+		return x.DisplayAmounts
+	}
+	return nil
+}
+
+type AccountingReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//If set, a new accounting check is performed rather than returning the
+	//outcome of the last periodic check.
+	Refresh bool `protobuf:"varint,1,opt,name=refresh,proto3" json:"refresh,omitempty"`
+}
+
+func (x *AccountingReportRequest) Reset() {
+	*x = AccountingReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountingReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountingReportRequest) ProtoMessage() {}
+
+func (x *AccountingReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountingReportRequest.ProtoReflect.Descriptor instead.
+func (*AccountingReportRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AccountingReportRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type AccountingReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unix timestamp in nanoseconds at which the check was performed.
+	CheckedAt int64 `protobuf:"varint,1,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	//
+	//The number of completed swaps that had at least one of their costs
+	//reconciled against lnd's records.
+	SwapsChecked uint32 `protobuf:"varint,2,opt,name=swaps_checked,json=swapsChecked,proto3" json:"swaps_checked,omitempty"`
+	//
+	//The number of completed swaps that could not be checked because lnd has
+	//no records for them.
+	Skipped uint32 `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	//
+	//The recorded swap costs that did not match lnd's records.
+	Discrepancies []*AccountingDiscrepancy `protobuf:"bytes,4,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+}
+
+func (x *AccountingReportResponse) Reset() {
+	*x = AccountingReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountingReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountingReportResponse) ProtoMessage() {}
+
+func (x *AccountingReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountingReportResponse.ProtoReflect.Descriptor instead.
+func (*AccountingReportResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AccountingReportResponse) GetCheckedAt() int64 {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return 0
+}
+
+func (x *AccountingReportResponse) GetSwapsChecked() uint32 {
+	if x != nil {
+		return x.SwapsChecked
+	}
+	return 0
+}
+
+func (x *AccountingReportResponse) GetSkipped() uint32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *AccountingReportResponse) GetDiscrepancies() []*AccountingDiscrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+type AccountingDiscrepancy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swap hash of the swap.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The type of the swap.
+	Type SwapType `protobuf:"varint,2,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
+	//
+	//The cost that the discrepancy was found in.
+	Check AccountingCheck `protobuf:"varint,3,opt,name=check,proto3,enum=looprpc.AccountingCheck" json:"check,omitempty"`
+	//
+	//The cost that loopd recorded for the swap.
+	RecordedSat int64 `protobuf:"varint,4,opt,name=recorded_sat,json=recordedSat,proto3" json:"recorded_sat,omitempty"`
+	//
+	//The cost that lnd's records imply.
+	ActualSat int64 `protobuf:"varint,5,opt,name=actual_sat,json=actualSat,proto3" json:"actual_sat,omitempty"`
+}
+
+func (x *AccountingDiscrepancy) Reset() {
+	*x = AccountingDiscrepancy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountingDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountingDiscrepancy) ProtoMessage() {}
+
+func (x *AccountingDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountingDiscrepancy.ProtoReflect.Descriptor instead.
+func (*AccountingDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AccountingDiscrepancy) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *AccountingDiscrepancy) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_LOOP_OUT
+}
+
+func (x *AccountingDiscrepancy) GetCheck() AccountingCheck {
+	if x != nil {
+		return x.Check
+	}
+	return AccountingCheck_ACCOUNTING_CHECK_SERVER_COST
+}
+
+func (x *AccountingDiscrepancy) GetRecordedSat() int64 {
+	if x != nil {
+		return x.RecordedSat
+	}
+	return 0
+}
+
+func (x *AccountingDiscrepancy) GetActualSat() int64 {
+	if x != nil {
+		return x.ActualSat
+	}
+	return 0
+}
+
+type WatchSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swap hash of the swap to watch.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The type of the swap.
+	Type SwapType `protobuf:"varint,2,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
+	//
+	//An optional label for the swap.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *WatchSwapRequest) Reset() {
+	*x = WatchSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSwapRequest) ProtoMessage() {}
+
+func (x *WatchSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSwapRequest.ProtoReflect.Descriptor instead.
+func (*WatchSwapRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WatchSwapRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *WatchSwapRequest) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_LOOP_OUT
+}
+
+func (x *WatchSwapRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type WatchSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WatchSwapResponse) Reset() {
+	*x = WatchSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSwapResponse) ProtoMessage() {}
+
+func (x *WatchSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSwapResponse.ProtoReflect.Descriptor instead.
+func (*WatchSwapResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{26}
+}
+
+type ListWatchedSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListWatchedSwapsRequest) Reset() {
+	*x = ListWatchedSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWatchedSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchedSwapsRequest) ProtoMessage() {}
+
+func (x *ListWatchedSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchedSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ListWatchedSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{27}
+}
+
+type ListWatchedSwapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swaps in the watch list.
+	Swaps []*WatchedSwap `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+}
+
+func (x *ListWatchedSwapsResponse) Reset() {
+	*x = ListWatchedSwapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWatchedSwapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchedSwapsResponse) ProtoMessage() {}
+
+func (x *ListWatchedSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchedSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ListWatchedSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListWatchedSwapsResponse) GetSwaps() []*WatchedSwap {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+type WatchedSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swap hash of the swap.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The type of the swap.
+	Type SwapType `protobuf:"varint,2,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
+	//
+	//The label of the swap.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	//
+	//The time at which the swap was added to the watch list, in unix nano
+	//seconds.
+	AddedAt int64 `protobuf:"varint,4,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	//
+	//The state of the swap, as implied by lnd's records.
+	State WatchedSwapState `protobuf:"varint,5,opt,name=state,proto3,enum=looprpc.WatchedSwapState" json:"state,omitempty"`
+	//
+	//The off-chain amount of the swap. For loop outs, this is the amount paid
+	//to the swap invoice. For loop ins, this is the amount that the server paid
+	//to the swap invoice.
+	AmtSat int64 `protobuf:"varint,6,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	//
+	//The routing fees paid for a loop out's swap payment. The prepayment of a
+	//loop out has a different hash, so it is not included.
+	OffchainFeeSat int64 `protobuf:"varint,7,opt,name=offchain_fee_sat,json=offchainFeeSat,proto3" json:"offchain_fee_sat,omitempty"`
+	//
+	//The fee that the wallet paid to publish a loop in's htlc.
+	OnchainFeeSat int64 `protobuf:"varint,8,opt,name=onchain_fee_sat,json=onchainFeeSat,proto3" json:"onchain_fee_sat,omitempty"`
+}
+
+func (x *WatchedSwap) Reset() {
+	*x = WatchedSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchedSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchedSwap) ProtoMessage() {}
+
+func (x *WatchedSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchedSwap.ProtoReflect.Descriptor instead.
+func (*WatchedSwap) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WatchedSwap) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *WatchedSwap) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_LOOP_OUT
+}
+
+func (x *WatchedSwap) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *WatchedSwap) GetAddedAt() int64 {
+	if x != nil {
+		return x.AddedAt
+	}
+	return 0
+}
+
+func (x *WatchedSwap) GetState() WatchedSwapState {
+	if x != nil {
+		return x.State
+	}
+	return WatchedSwapState_WATCHED_SWAP_UNKNOWN
+}
+
+func (x *WatchedSwap) GetAmtSat() int64 {
+	if x != nil {
+		return x.AmtSat
+	}
+	return 0
+}
+
+func (x *WatchedSwap) GetOffchainFeeSat() int64 {
+	if x != nil {
+		return x.OffchainFeeSat
+	}
+	return 0
+}
+
+func (x *WatchedSwap) GetOnchainFeeSat() int64 {
+	if x != nil {
+		return x.OnchainFeeSat
+	}
+	return 0
+}
+
+type TermsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unit that the response's display_amounts are formatted in. If unset,
+	//display_amounts are not returned.
+	DisplayUnit DisplayUnit `protobuf:"varint,1,opt,name=display_unit,json=displayUnit,proto3,enum=looprpc.DisplayUnit" json:"display_unit,omitempty"`
+}
+
+func (x *TermsRequest) Reset() {
+	*x = TermsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TermsRequest) ProtoMessage() {}
+
+func (x *TermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TermsRequest.ProtoReflect.Descriptor instead.
+func (*TermsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TermsRequest) GetDisplayUnit() DisplayUnit {
+	if x != nil {
+		return x.DisplayUnit
+	}
+	return DisplayUnit_DISPLAY_UNIT_NONE
+}
+
+type InTermsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Minimum swap amount (sat)
+	MinSwapAmount int64 `protobuf:"varint,5,opt,name=min_swap_amount,json=minSwapAmount,proto3" json:"min_swap_amount,omitempty"`
+	//
+	//Maximum swap amount (sat)
+	MaxSwapAmount int64 `protobuf:"varint,6,opt,name=max_swap_amount,json=maxSwapAmount,proto3" json:"max_swap_amount,omitempty"`
+	//
+	//The response's amounts formatted in the requested display unit, keyed by
+	//the name of the field that holds each amount. The amount fields themselves
+	//are always expressed as exact integers in their own units.
+	DisplayAmounts map[string]string `protobuf:"bytes,8,rep,name=display_amounts,json=displayAmounts,proto3" json:"display_amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *InTermsResponse) Reset() {
+	*x = InTermsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InTermsResponse) ProtoMessage() {}
+
+func (x *InTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InTermsResponse.ProtoReflect.Descriptor instead.
+func (*InTermsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InTermsResponse) GetMinSwapAmount() int64 {
+	if x != nil {
+		return x.MinSwapAmount
+	}
+	return 0
+}
+
+func (x *InTermsResponse) GetMaxSwapAmount() int64 {
+	if x != nil {
+		return x.MaxSwapAmount
+	}
+	return 0
+}
+
+func (x *InTermsResponse) GetDisplayAmounts() map[string]string {
+	if x != nil {
+		return x.DisplayAmounts
+	}
+	return nil
+}
+
+type OutTermsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Minimum swap amount (sat)
+	MinSwapAmount int64 `protobuf:"varint,5,opt,name=min_swap_amount,json=minSwapAmount,proto3" json:"min_swap_amount,omitempty"`
+	//
+	//Maximum swap amount (sat)
+	MaxSwapAmount int64 `protobuf:"varint,6,opt,name=max_swap_amount,json=maxSwapAmount,proto3" json:"max_swap_amount,omitempty"`
+	// The minimally accepted cltv delta of the on-chain htlc.
+	MinCltvDelta int32 `protobuf:"varint,8,opt,name=min_cltv_delta,json=minCltvDelta,proto3" json:"min_cltv_delta,omitempty"`
+	// The maximally accepted cltv delta of the on-chain htlc.
+	MaxCltvDelta int32 `protobuf:"varint,9,opt,name=max_cltv_delta,json=maxCltvDelta,proto3" json:"max_cltv_delta,omitempty"`
+	//
+	//The response's amounts formatted in the requested display unit, keyed by
+	//the name of the field that holds each amount. The amount fields themselves
+	//are always expressed as exact integers in their own units.
+	DisplayAmounts map[string]string `protobuf:"bytes,10,rep,name=display_amounts,json=displayAmounts,proto3" json:"display_amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *OutTermsResponse) Reset() {
+	*x = OutTermsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutTermsResponse) ProtoMessage() {}
+
+func (x *OutTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutTermsResponse.ProtoReflect.Descriptor instead.
+func (*OutTermsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *OutTermsResponse) GetMinSwapAmount() int64 {
+	if x != nil {
+		return x.MinSwapAmount
+	}
+	return 0
+}
+
+func (x *OutTermsResponse) GetMaxSwapAmount() int64 {
+	if x != nil {
+		return x.MaxSwapAmount
+	}
+	return 0
+}
+
+func (x *OutTermsResponse) GetMinCltvDelta() int32 {
+	if x != nil {
+		return x.MinCltvDelta
+	}
+	return 0
+}
+
+func (x *OutTermsResponse) GetMaxCltvDelta() int32 {
+	if x != nil {
+		return x.MaxCltvDelta
+	}
+	return 0
+}
+
+func (x *OutTermsResponse) GetDisplayAmounts() map[string]string {
+	if x != nil {
+		return x.DisplayAmounts
+	}
+	return nil
+}
+
+type QuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The amount to swap in satoshis.
+	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The confirmation target that should be used either for the sweep of the
+	//on-chain HTLC broadcast by the swap server in the case of a Loop Out, or for
+	//the confirmation of the on-chain HTLC broadcast by the swap client in the
+	//case of a Loop In.
+	ConfTarget int32 `protobuf:"varint,2,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	//
+	//If external_htlc is true, we expect the htlc to be published by an external
+	//actor.
+	ExternalHtlc bool `protobuf:"varint,3,opt,name=external_htlc,json=externalHtlc,proto3" json:"external_htlc,omitempty"`
+	//
+	//The latest time (in unix seconds) we allow the server to wait before
+	//publishing the HTLC on chain. Setting this to a larger value will give the
+	//server the opportunity to batch multiple swaps together, and wait for
+	//low-fee periods before publishing the HTLC, potentially resulting in a
+	//lower total swap fee. This only has an effect on loop out quotes.
+	SwapPublicationDeadline uint64 `protobuf:"varint,4,opt,name=swap_publication_deadline,json=swapPublicationDeadline,proto3" json:"swap_publication_deadline,omitempty"`
+	//
+	//Optionally the client can specify the last hop pubkey when requesting a
+	//loop-in quote. This is useful to get better off-chain routing fee from the
+	//server.
+	LoopInLastHop []byte `protobuf:"bytes,5,opt,name=loop_in_last_hop,json=loopInLastHop,proto3" json:"loop_in_last_hop,omitempty"`
+	//
+	//Optional route hints to reach the destination through private channels.
+	LoopInRouteHints []*swapserverrpc.RouteHint `protobuf:"bytes,6,rep,name=loop_in_route_hints,json=loopInRouteHints,proto3" json:"loop_in_route_hints,omitempty"`
+	//
+	//Private indicates whether the destination node should be considered
+	//private. In which case, loop will generate hophints to assist with
+	//probing and payment.
+	Private bool `protobuf:"varint,7,opt,name=private,proto3" json:"private,omitempty"`
+	//
+	//The unit that the response's display_amounts are formatted in. If unset,
+	//display_amounts are not returned.
+	DisplayUnit DisplayUnit `protobuf:"varint,8,opt,name=display_unit,json=displayUnit,proto3,enum=looprpc.DisplayUnit" json:"display_unit,omitempty"`
+}
+
+func (x *QuoteRequest) Reset() {
+	*x = QuoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteRequest) ProtoMessage() {}
+
+func (x *QuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteRequest.ProtoReflect.Descriptor instead.
+func (*QuoteRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *QuoteRequest) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *QuoteRequest) GetConfTarget() int32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *QuoteRequest) GetExternalHtlc() bool {
+	if x != nil {
+		return x.ExternalHtlc
+	}
+	return false
+}
+
+func (x *QuoteRequest) GetSwapPublicationDeadline() uint64 {
+	if x != nil {
+		return x.SwapPublicationDeadline
+	}
+	return 0
+}
+
+func (x *QuoteRequest) GetLoopInLastHop() []byte {
+	if x != nil {
+		return x.LoopInLastHop
+	}
+	return nil
+}
+
+func (x *QuoteRequest) GetLoopInRouteHints() []*swapserverrpc.RouteHint {
+	if x != nil {
+		return x.LoopInRouteHints
+	}
+	return nil
+}
+
+func (x *QuoteRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *QuoteRequest) GetDisplayUnit() DisplayUnit {
+	if x != nil {
+		return x.DisplayUnit
+	}
+	return DisplayUnit_DISPLAY_UNIT_NONE
+}
+
+type InQuoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The fee that the swap server is charging for the swap.
+	SwapFeeSat int64 `protobuf:"varint,1,opt,name=swap_fee_sat,json=swapFeeSat,proto3" json:"swap_fee_sat,omitempty"`
+	//
+	//An estimate of the on-chain fee that needs to be paid to publish the HTLC
+	//If a miner fee of 0 is returned, it means the external_htlc flag was set for
+	//a loop in and the fee estimation was skipped. If a miner fee of -1 is
+	//returned, it means lnd's wallet tried to estimate the fee but was unable to
+	//create a sample estimation transaction because not enough funds are
+	//available. An information message should be shown to the user in this case.
+	HtlcPublishFeeSat int64 `protobuf:"varint,3,opt,name=htlc_publish_fee_sat,json=htlcPublishFeeSat,proto3" json:"htlc_publish_fee_sat,omitempty"`
+	//
+	//On-chain cltv expiry delta
+	CltvDelta int32 `protobuf:"varint,5,opt,name=cltv_delta,json=cltvDelta,proto3" json:"cltv_delta,omitempty"`
+	//
+	//The confirmation target to be used to publish the on-chain HTLC.
+	ConfTarget int32 `protobuf:"varint,6,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	//
+	//The response's amounts formatted in the requested display unit, keyed by
+	//the name of the field that holds each amount. The amount fields themselves
+	//are always expressed as exact integers in their own units.
+	DisplayAmounts map[string]string `protobuf:"bytes,7,rep,name=display_amounts,json=displayAmounts,proto3" json:"display_amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *InQuoteResponse) Reset() {
+	*x = InQuoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InQuoteResponse) ProtoMessage() {}
+
+func (x *InQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InQuoteResponse.ProtoReflect.Descriptor instead.
+func (*InQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InQuoteResponse) GetSwapFeeSat() int64 {
+	if x != nil {
+		return x.SwapFeeSat
+	}
+	return 0
+}
+
+func (x *InQuoteResponse) GetHtlcPublishFeeSat() int64 {
+	if x != nil {
+		return x.HtlcPublishFeeSat
+	}
+	return 0
+}
+
+func (x *InQuoteResponse) GetCltvDelta() int32 {
+	if x != nil {
+		return x.CltvDelta
+	}
+	return 0
+}
+
+func (x *InQuoteResponse) GetConfTarget() int32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *InQuoteResponse) GetDisplayAmounts() map[string]string {
+	if x != nil {
+		return x.DisplayAmounts
+	}
+	return nil
+}
+
+type OutQuoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The fee that the swap server is charging for the swap.
+	SwapFeeSat int64 `protobuf:"varint,1,opt,name=swap_fee_sat,json=swapFeeSat,proto3" json:"swap_fee_sat,omitempty"`
+	//
+	//The part of the swap fee that is requested as a prepayment.
+	PrepayAmtSat int64 `protobuf:"varint,2,opt,name=prepay_amt_sat,json=prepayAmtSat,proto3" json:"prepay_amt_sat,omitempty"`
+	//
+	//An estimate of the on-chain fee that needs to be paid to sweep the HTLC for
+	//a loop out.
+	HtlcSweepFeeSat int64 `protobuf:"varint,3,opt,name=htlc_sweep_fee_sat,json=htlcSweepFeeSat,proto3" json:"htlc_sweep_fee_sat,omitempty"`
+	//
+	//The node pubkey where the swap payment needs to be paid
+	//to. This can be used to test connectivity before initiating the swap.
+	SwapPaymentDest []byte `protobuf:"bytes,4,opt,name=swap_payment_dest,json=swapPaymentDest,proto3" json:"swap_payment_dest,omitempty"`
+	//
+	//On-chain cltv expiry delta
+	CltvDelta int32 `protobuf:"varint,5,opt,name=cltv_delta,json=cltvDelta,proto3" json:"cltv_delta,omitempty"`
+	//
+	//The confirmation target to be used for the sweep of the on-chain HTLC.
+	ConfTarget int32 `protobuf:"varint,6,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	//
+	//The response's amounts formatted in the requested display unit, keyed by
+	//the name of the field that holds each amount. The amount fields themselves
+	//are always expressed as exact integers in their own units.
+	DisplayAmounts map[string]string `protobuf:"bytes,7,rep,name=display_amounts,json=displayAmounts,proto3" json:"display_amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *OutQuoteResponse) Reset() {
+	*x = OutQuoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutQuoteResponse) ProtoMessage() {}
+
+func (x *OutQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutQuoteResponse.ProtoReflect.Descriptor instead.
+func (*OutQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *OutQuoteResponse) GetSwapFeeSat() int64 {
+	if x != nil {
+		return x.SwapFeeSat
+	}
+	return 0
+}
+
+func (x *OutQuoteResponse) GetPrepayAmtSat() int64 {
+	if x != nil {
+		return x.PrepayAmtSat
+	}
+	return 0
+}
+
+func (x *OutQuoteResponse) GetHtlcSweepFeeSat() int64 {
+	if x != nil {
+		return x.HtlcSweepFeeSat
+	}
+	return 0
+}
+
+func (x *OutQuoteResponse) GetSwapPaymentDest() []byte {
+	if x != nil {
+		return x.SwapPaymentDest
+	}
+	return nil
+}
+
+func (x *OutQuoteResponse) GetCltvDelta() int32 {
+	if x != nil {
+		return x.CltvDelta
+	}
+	return 0
+}
+
+func (x *OutQuoteResponse) GetConfTarget() int32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *OutQuoteResponse) GetDisplayAmounts() map[string]string {
+	if x != nil {
+		return x.DisplayAmounts
+	}
+	return nil
+}
+
+type ProbeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The amount to probe.
+	Amt int64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//Optional last hop of the route to probe.
+	LastHop []byte `protobuf:"bytes,2,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
+	//
+	//Optional route hints to reach the destination through private channels.
+	RouteHints []*swapserverrpc.RouteHint `protobuf:"bytes,3,rep,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
+}
+
+func (x *ProbeRequest) Reset() {
+	*x = ProbeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeRequest) ProtoMessage() {}
+
+func (x *ProbeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeRequest.ProtoReflect.Descriptor instead.
+func (*ProbeRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ProbeRequest) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *ProbeRequest) GetLastHop() []byte {
+	if x != nil {
+		return x.LastHop
+	}
+	return nil
+}
+
+func (x *ProbeRequest) GetRouteHints() []*swapserverrpc.RouteHint {
+	if x != nil {
+		return x.RouteHints
+	}
+	return nil
+}
+
+type ProbeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProbeResponse) Reset() {
+	*x = ProbeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeResponse) ProtoMessage() {}
+
+func (x *ProbeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeResponse.ProtoReflect.Descriptor instead.
+func (*ProbeResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{37}
+}
+
+type TokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TokensRequest) Reset() {
+	*x = TokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokensRequest) ProtoMessage() {}
+
+func (x *TokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokensRequest.ProtoReflect.Descriptor instead.
+func (*TokensRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{38}
+}
+
+type TokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//List of all tokens the daemon knows of, including old/expired tokens.
+	Tokens []*LsatToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *TokensResponse) Reset() {
+	*x = TokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokensResponse) ProtoMessage() {}
+
+func (x *TokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokensResponse.ProtoReflect.Descriptor instead.
+func (*TokensResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TokensResponse) GetTokens() []*LsatToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type LndFeaturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LndFeaturesRequest) Reset() {
+	*x = LndFeaturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LndFeaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LndFeaturesRequest) ProtoMessage() {}
+
+func (x *LndFeaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LndFeaturesRequest.ProtoReflect.Descriptor instead.
+func (*LndFeaturesRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{40}
+}
+
+type LndFeaturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the lnd node that the daemon is connected to.
+	LndVersion string `protobuf:"bytes,1,opt,name=lnd_version,json=lndVersion,proto3" json:"lnd_version,omitempty"`
+	// The status of each of the version dependent lnd features.
+	Features []*LndFeatureStatus `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty"`
+}
+
+func (x *LndFeaturesResponse) Reset() {
+	*x = LndFeaturesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LndFeaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LndFeaturesResponse) ProtoMessage() {}
+
+func (x *LndFeaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LndFeaturesResponse.ProtoReflect.Descriptor instead.
+func (*LndFeaturesResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LndFeaturesResponse) GetLndVersion() string {
+	if x != nil {
+		return x.LndVersion
+	}
+	return ""
+}
+
+func (x *LndFeaturesResponse) GetFeatures() []*LndFeatureStatus {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type LndFeatureStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the feature.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the connected lnd supports the feature.
+	Supported bool `protobuf:"varint,2,opt,name=supported,proto3" json:"supported,omitempty"`
+	// The minimum lnd version that supports the feature.
+	MinVersion string `protobuf:"bytes,3,opt,name=min_version,json=minVersion,proto3" json:"min_version,omitempty"`
+	// The reason that the feature is disabled, if it is not supported.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *LndFeatureStatus) Reset() {
+	*x = LndFeatureStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LndFeatureStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LndFeatureStatus) ProtoMessage() {}
+
+func (x *LndFeatureStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LndFeatureStatus.ProtoReflect.Descriptor instead.
+func (*LndFeatureStatus) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LndFeatureStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LndFeatureStatus) GetSupported() bool {
+	if x != nil {
+		return x.Supported
+	}
+	return false
+}
+
+func (x *LndFeatureStatus) GetMinVersion() string {
+	if x != nil {
+		return x.MinVersion
+	}
+	return ""
+}
+
+func (x *LndFeatureStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetInfoRequest) Reset() {
+	*x = GetInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoRequest) ProtoMessage() {}
+
+func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{43}
+}
+
+type GetInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the daemon.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The bitcoin network that the daemon is running on.
+	Network string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	//
+	//Whether the connected lnd was synced to the chain when its sync state was
+	//last checked. If it is not, the daemon is degraded until lnd has caught up.
+	LndSyncedToChain bool `protobuf:"varint,3,opt,name=lnd_synced_to_chain,json=lndSyncedToChain,proto3" json:"lnd_synced_to_chain,omitempty"`
+	//
+	//The block height that the daemon monitors pending swaps at, which is the
+	//last block height that it was notified of.
+	BlockHeight uint32 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block height that lnd reported when its sync state was last checked.
+	LndBlockHeight uint32 `protobuf:"varint,5,opt,name=lnd_block_height,json=lndBlockHeight,proto3" json:"lnd_block_height,omitempty"`
+	//
+	//The unix timestamp in seconds at which lnd was found to not be synced to
+	//the chain, or zero if it is synced.
+	DegradedSince int64 `protobuf:"varint,6,opt,name=degraded_since,json=degradedSince,proto3" json:"degraded_since,omitempty"`
+	//
+	//The unix timestamp in seconds at which lnd's sync state was last checked,
+	//or zero if it has not been checked since the daemon started.
+	LastSyncCheck int64 `protobuf:"varint,7,opt,name=last_sync_check,json=lastSyncCheck,proto3" json:"last_sync_check,omitempty"`
+}
+
+func (x *GetInfoResponse) Reset() {
+	*x = GetInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoResponse) ProtoMessage() {}
+
+func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetLndSyncedToChain() bool {
+	if x != nil {
+		return x.LndSyncedToChain
+	}
+	return false
+}
+
+func (x *GetInfoResponse) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetLndBlockHeight() uint32 {
+	if x != nil {
+		return x.LndBlockHeight
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetDegradedSince() int64 {
+	if x != nil {
+		return x.DegradedSince
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetLastSyncCheck() int64 {
+	if x != nil {
+		return x.LastSyncCheck
+	}
+	return 0
+}
+
+type RecoveryTestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The amount in satoshis to lock in each of the drill's htlcs. If it is zero,
+	//a default of 20000 satoshis is used.
+	Amt uint64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//Whether to fund the drill's htlcs from the wallet and broadcast their
+	//sweeps. If this value is false, the sweeps are only signed and verified
+	//against their htlc scripts. Publishing drills wait for the htlcs to
+	//confirm, so a block must be mined on regtest for them to complete.
+	Publish bool `protobuf:"varint,2,opt,name=publish,proto3" json:"publish,omitempty"`
+}
+
+func (x *RecoveryTestRequest) Reset() {
+	*x = RecoveryTestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryTestRequest) ProtoMessage() {}
+
+func (x *RecoveryTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryTestRequest.ProtoReflect.Descriptor instead.
+func (*RecoveryTestRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RecoveryTestRequest) GetAmt() uint64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *RecoveryTestRequest) GetPublish() bool {
+	if x != nil {
+		return x.Publish
+	}
+	return false
+}
+
+type RecoveryTestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether all of the drill's checks passed.
+	Passed bool `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
+	//
+	//The outcome of each check that the drill ran, in order. The drill stops
+	//at the first check that fails.
+	Checks []*RecoveryCheck `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty"`
+	// The transaction that funded the drill's htlcs, if it was published.
+	FundingTxid string `protobuf:"bytes,3,opt,name=funding_txid,json=fundingTxid,proto3" json:"funding_txid,omitempty"`
+	// The transaction that swept the timeout htlc, if it was published.
+	TimeoutTxid string `protobuf:"bytes,4,opt,name=timeout_txid,json=timeoutTxid,proto3" json:"timeout_txid,omitempty"`
+	// The transaction that swept the success htlc, if it was published.
+	SuccessTxid string `protobuf:"bytes,5,opt,name=success_txid,json=successTxid,proto3" json:"success_txid,omitempty"`
+}
+
+func (x *RecoveryTestResponse) Reset() {
+	*x = RecoveryTestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryTestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryTestResponse) ProtoMessage() {}
+
+func (x *RecoveryTestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryTestResponse.ProtoReflect.Descriptor instead.
+func (*RecoveryTestResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RecoveryTestResponse) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *RecoveryTestResponse) GetChecks() []*RecoveryCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *RecoveryTestResponse) GetFundingTxid() string {
+	if x != nil {
+		return x.FundingTxid
+	}
+	return ""
+}
+
+func (x *RecoveryTestResponse) GetTimeoutTxid() string {
+	if x != nil {
+		return x.TimeoutTxid
+	}
+	return ""
+}
+
+func (x *RecoveryTestResponse) GetSuccessTxid() string {
+	if x != nil {
+		return x.SuccessTxid
+	}
+	return ""
+}
+
+type RecoveryCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the check.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the check passed.
+	Passed bool `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// The error that the check failed with, if it did not pass.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RecoveryCheck) Reset() {
+	*x = RecoveryCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryCheck) ProtoMessage() {}
+
+func (x *RecoveryCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryCheck.ProtoReflect.Descriptor instead.
+func (*RecoveryCheck) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RecoveryCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecoveryCheck) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *RecoveryCheck) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type LsatToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The base macaroon that was baked by the auth server.
+	BaseMacaroon []byte `protobuf:"bytes,1,opt,name=base_macaroon,json=baseMacaroon,proto3" json:"base_macaroon,omitempty"`
+	//
+	//The payment hash of the payment that was paid to obtain the token.
+	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	//
+	//The preimage of the payment hash, knowledge of this is proof that the
+	//payment has been paid. If the preimage is set to all zeros, this means the
+	//payment is still pending and the token is not yet fully valid.
+	PaymentPreimage []byte `protobuf:"bytes,3,opt,name=payment_preimage,json=paymentPreimage,proto3" json:"payment_preimage,omitempty"`
+	//
+	//The amount of millisatoshis that was paid to get the token.
+	AmountPaidMsat int64 `protobuf:"varint,4,opt,name=amount_paid_msat,json=amountPaidMsat,proto3" json:"amount_paid_msat,omitempty"`
+	//
+	//The amount of millisatoshis paid in routing fee to pay for the token.
+	RoutingFeePaidMsat int64 `protobuf:"varint,5,opt,name=routing_fee_paid_msat,json=routingFeePaidMsat,proto3" json:"routing_fee_paid_msat,omitempty"`
+	//
+	//The creation time of the token as UNIX timestamp in seconds.
+	TimeCreated int64 `protobuf:"varint,6,opt,name=time_created,json=timeCreated,proto3" json:"time_created,omitempty"`
+	//
+	//Indicates whether the token is expired or still valid.
+	Expired bool `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
+	//
+	//Identifying attribute of this token in the store. Currently represents the
+	//file name of the token where it's stored on the file system.
+	StorageName string `protobuf:"bytes,8,opt,name=storage_name,json=storageName,proto3" json:"storage_name,omitempty"`
+}
+
+func (x *LsatToken) Reset() {
+	*x = LsatToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsatToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsatToken) ProtoMessage() {}
+
+func (x *LsatToken) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsatToken.ProtoReflect.Descriptor instead.
+func (*LsatToken) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *LsatToken) GetBaseMacaroon() []byte {
+	if x != nil {
+		return x.BaseMacaroon
+	}
+	return nil
+}
+
+func (x *LsatToken) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+func (x *LsatToken) GetPaymentPreimage() []byte {
+	if x != nil {
+		return x.PaymentPreimage
+	}
+	return nil
+}
+
+func (x *LsatToken) GetAmountPaidMsat() int64 {
+	if x != nil {
+		return x.AmountPaidMsat
+	}
+	return 0
+}
+
+func (x *LsatToken) GetRoutingFeePaidMsat() int64 {
+	if x != nil {
+		return x.RoutingFeePaidMsat
+	}
+	return 0
+}
+
+func (x *LsatToken) GetTimeCreated() int64 {
+	if x != nil {
+		return x.TimeCreated
+	}
+	return 0
+}
+
+func (x *LsatToken) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *LsatToken) GetStorageName() string {
+	if x != nil {
+		return x.StorageName
+	}
+	return ""
+}
+
+type GetLiquidityParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetLiquidityParamsRequest) Reset() {
+	*x = GetLiquidityParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLiquidityParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLiquidityParamsRequest) ProtoMessage() {}
+
+func (x *GetLiquidityParamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLiquidityParamsRequest.ProtoReflect.Descriptor instead.
+func (*GetLiquidityParamsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{49}
+}
+
+type LiquidityParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//A set of liquidity rules that describe the desired liquidity balance.
+	Rules []*LiquidityRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	//
+	//The parts per million of swap amount that is allowed to be allocated to swap
+	//fees. This value is applied across swap categories and may not be set in
+	//conjunction with sweep fee rate, swap fee ppm, routing fee ppm, prepay
+	//routing, max prepay and max miner fee.
+	FeePpm uint64 `protobuf:"varint,16,opt,name=fee_ppm,json=feePpm,proto3" json:"fee_ppm,omitempty"`
+	//
+	//The limit we place on our estimated sweep cost for a swap in sat/vByte. If
+	//the estimated fee for our sweep transaction within the specified
+	//confirmation target is above this value, we will not suggest any swaps.
+	SweepFeeRateSatPerVbyte uint64 `protobuf:"varint,2,opt,name=sweep_fee_rate_sat_per_vbyte,json=sweepFeeRateSatPerVbyte,proto3" json:"sweep_fee_rate_sat_per_vbyte,omitempty"`
+	//
+	//The maximum fee paid to the server for facilitating the swap, expressed
+	//as parts per million of the swap volume.
+	MaxSwapFeePpm uint64 `protobuf:"varint,3,opt,name=max_swap_fee_ppm,json=maxSwapFeePpm,proto3" json:"max_swap_fee_ppm,omitempty"`
+	//
+	//The maximum fee paid to route the swap invoice off chain, expressed as
+	//parts per million of the volume being routed.
+	MaxRoutingFeePpm uint64 `protobuf:"varint,4,opt,name=max_routing_fee_ppm,json=maxRoutingFeePpm,proto3" json:"max_routing_fee_ppm,omitempty"`
+	//
+	//The maximum fee paid to route the prepay invoice off chain, expressed as
+	//parts per million of the volume being routed.
+	MaxPrepayRoutingFeePpm uint64 `protobuf:"varint,5,opt,name=max_prepay_routing_fee_ppm,json=maxPrepayRoutingFeePpm,proto3" json:"max_prepay_routing_fee_ppm,omitempty"`
+	//
+	//The maximum no-show penalty in satoshis paid for a swap.
+	MaxPrepaySat uint64 `protobuf:"varint,6,opt,name=max_prepay_sat,json=maxPrepaySat,proto3" json:"max_prepay_sat,omitempty"`
+	//
+	//The maximum miner fee we will pay to sweep the swap on chain. Note that we
+	//will not suggest a swap if the estimate is above the sweep limit set by
+	//these parameters, and we use the current fee estimate to sweep on chain so
+	//this value is only a cap placed on the amount we spend on fees in the case
+	//where the swap needs to be claimed on chain, but fees have suddenly spiked.
+	MaxMinerFeeSat uint64 `protobuf:"varint,7,opt,name=max_miner_fee_sat,json=maxMinerFeeSat,proto3" json:"max_miner_fee_sat,omitempty"`
+	//
+	//The number of blocks from the on-chain HTLC's confirmation height that it
+	//should be swept within.
+	SweepConfTarget int32 `protobuf:"varint,8,opt,name=sweep_conf_target,json=sweepConfTarget,proto3" json:"sweep_conf_target,omitempty"`
+	//
+	//The amount of time we require pass since a channel was part of a failed
+	//swap due to off chain payment failure until it will be considered for swap
+	//suggestions again, expressed in seconds.
+	FailureBackoffSec uint64 `protobuf:"varint,9,opt,name=failure_backoff_sec,json=failureBackoffSec,proto3" json:"failure_backoff_sec,omitempty"`
+	//
+	//Set to true to enable automatic dispatch of swaps. All swaps will be limited
+	//to the fee categories set by these parameters, and total expenditure will
+	//be limited to the autoloop budget.
+	Autoloop bool `protobuf:"varint,10,opt,name=autoloop,proto3" json:"autoloop,omitempty"`
+	//
+	//The total budget for automatically dispatched swaps since the budget start
+	//time, expressed in satoshis.
+	AutoloopBudgetSat uint64 `protobuf:"varint,11,opt,name=autoloop_budget_sat,json=autoloopBudgetSat,proto3" json:"autoloop_budget_sat,omitempty"`
+	//
+	//The start time for autoloop budget, expressed as a unix timestamp in
+	//seconds. If this value is 0, the budget will be applied for all
+	//automatically dispatched swaps. Swaps that were completed before this date
+	//will not be included in budget calculations.
+	AutoloopBudgetStartSec uint64 `protobuf:"varint,12,opt,name=autoloop_budget_start_sec,json=autoloopBudgetStartSec,proto3" json:"autoloop_budget_start_sec,omitempty"`
+	//
+	//The maximum number of automatically dispatched swaps that we allow to be in
+	//flight at any point in time.
+	AutoMaxInFlight uint64 `protobuf:"varint,13,opt,name=auto_max_in_flight,json=autoMaxInFlight,proto3" json:"auto_max_in_flight,omitempty"`
+	//
+	//The minimum amount, expressed in satoshis, that the autoloop client will
+	//dispatch a swap for. This value is subject to the server-side limits
+	//specified by the LoopOutTerms endpoint.
+	MinSwapAmount uint64 `protobuf:"varint,14,opt,name=min_swap_amount,json=minSwapAmount,proto3" json:"min_swap_amount,omitempty"`
+	//
+	//The maximum amount, expressed in satoshis, that the autoloop client will
+	//dispatch a swap for. This value is subject to the server-side limits
+	//specified by the LoopOutTerms endpoint.
+	MaxSwapAmount uint64 `protobuf:"varint,15,opt,name=max_swap_amount,json=maxSwapAmount,proto3" json:"max_swap_amount,omitempty"`
+	//
+	//The confirmation target for loop in on-chain htlcs.
+	HtlcConfTarget int32 `protobuf:"varint,17,opt,name=htlc_conf_target,json=htlcConfTarget,proto3" json:"htlc_conf_target,omitempty"`
+	//
+	//The maximum number of vbytes that loop transactions may occupy on chain
+	//per budget period. Swap suggestions that would exceed this budget are
+	//disqualified. A zero value disables the budget.
+	VbyteBudget uint64 `protobuf:"varint,18,opt,name=vbyte_budget,json=vbyteBudget,proto3" json:"vbyte_budget,omitempty"`
+	//
+	//The period, expressed in seconds, that the vbyte budget applies to. If
+	//this value is not set, a default of one day is used.
+	VbyteBudgetPeriodSec uint64 `protobuf:"varint,19,opt,name=vbyte_budget_period_sec,json=vbyteBudgetPeriodSec,proto3" json:"vbyte_budget_period_sec,omitempty"`
+	//
+	//The maximum routing fee for circular rebalances, expressed in parts per
+	//million of the rebalance amount. If this value is non-zero, autoloop
+	//replaces loop out suggestions for single channels with circular
+	//rebalances when a rebalance is cheaper than the swap and within this
+	//limit. A zero value disables rebalances.
+	RebalanceFeePpm uint64 `protobuf:"varint,20,opt,name=rebalance_fee_ppm,json=rebalanceFeePpm,proto3" json:"rebalance_fee_ppm,omitempty"`
+	//
+	//The total amount of routing fees that may be spent on circular rebalances
+	//that were dispatched since the budget start date. This budget is
+	//separate from the autoloop swap budget.
+	RebalanceBudgetSat uint64 `protobuf:"varint,21,opt,name=rebalance_budget_sat,json=rebalanceBudgetSat,proto3" json:"rebalance_budget_sat,omitempty"`
+	//
+	//The period, expressed in seconds, after which the autoloop budget resets.
+	//Each time this period elapses, the budget start time is moved forward by
+	//the period. A budget start time must be set to use this value. A zero
+	//value disables budget refreshes.
+	AutoloopBudgetRefreshPeriodSec uint64 `protobuf:"varint,22,opt,name=autoloop_budget_refresh_period_sec,json=autoloopBudgetRefreshPeriodSec,proto3" json:"autoloop_budget_refresh_period_sec,omitempty"`
+	//
+	//Set to true to carry budget that was not spent in a budget period over to
+	//the next period when the budget refreshes. The amount carried over is
+	//capped at the autoloop budget. Requires a budget refresh period.
+	AutoloopBudgetRollover bool `protobuf:"varint,23,opt,name=autoloop_budget_rollover,json=autoloopBudgetRollover,proto3" json:"autoloop_budget_rollover,omitempty"`
+	//
+	//The unused budget, expressed in satoshis, that was carried over from the
+	//previous budget period and is available in addition to the autoloop
+	//budget. This value is set by the liquidity manager when the budget
+	//refreshes.
+	AutoloopBudgetRolledOverSat uint64 `protobuf:"varint,24,opt,name=autoloop_budget_rolled_over_sat,json=autoloopBudgetRolledOverSat,proto3" json:"autoloop_budget_rolled_over_sat,omitempty"`
+	//
+	//The set of windows in which the autolooper may dispatch swaps. Outside of
+	//these windows, swap suggestions are still computed but not dispatched. If
+	//no windows are set, swaps may be dispatched at any time.
+	AutoloopSchedule []*AutoloopWindow `protobuf:"bytes,25,rep,name=autoloop_schedule,json=autoloopSchedule,proto3" json:"autoloop_schedule,omitempty"`
+	//
+	//The maximum on-chain fee rate, expressed in sat/vbyte, at which the
+	//autolooper dispatches swaps. Each tick, the fee rate for the htlc
+	//confirmation target is estimated, and swap dispatch is deferred while the
+	//estimate is above this ceiling. A zero value disables the ceiling.
+	ChainFeeCeilingSatPerVbyte uint64 `protobuf:"varint,26,opt,name=chain_fee_ceiling_sat_per_vbyte,json=chainFeeCeilingSatPerVbyte,proto3" json:"chain_fee_ceiling_sat_per_vbyte,omitempty"`
+	//
+	//The short channel IDs of channels that are managed externally, for example
+	//by another rebalancing tool. Autoloop never suggests swaps or rebalances
+	//that use these channels, and they are left out of the balances that peer
+	//and group rules are applied to.
+	ExcludedChannels []uint64 `protobuf:"varint,27,rep,packed,name=excluded_channels,json=excludedChannels,proto3" json:"excluded_channels,omitempty"`
+	//
+	//Set to true to queue the swaps that autoloop would dispatch as pending
+	//approvals, which must be approved with ApproveSwap before they are
+	//dispatched, instead of dispatching them automatically.
+	ApprovalMode bool `protobuf:"varint,28,opt,name=approval_mode,json=approvalMode,proto3" json:"approval_mode,omitempty"`
+	//
+	//The number of seconds that a pending approval may be approved for before
+	//it expires. A zero value means that pending approvals do not expire.
+	ApprovalTtlSec uint64 `protobuf:"varint,29,opt,name=approval_ttl_sec,json=approvalTtlSec,proto3" json:"approval_ttl_sec,omitempty"`
+	//
+	//Set to true to enable easy autoloop, which manages the node's liquidity as
+	//a whole instead of with channel, peer or group rules. Easy autoloop loops
+	//out the local balance that the node holds above its outbound target,
+	//choosing the peers with the cheapest routes to the swap server. It may
+	//not be enabled while rules are set.
+	EasyAutoloop bool `protobuf:"varint,30,opt,name=easy_autoloop,json=easyAutoloop,proto3" json:"easy_autoloop,omitempty"`
+	//
+	//The percentage of the node's total channel capacity that easy autoloop
+	//aims to hold as local balance, in [1, 99].
+	EasyOutboundTargetPercent uint64 `protobuf:"varint,31,opt,name=easy_outbound_target_percent,json=easyOutboundTargetPercent,proto3" json:"easy_outbound_target_percent,omitempty"`
+	//
+	//The multiple, in satoshis, that suggested swap amounts are rounded down
+	//to so that swaps do not reveal exact channel balances. Set to zero to
+	//disable rounding.
+	AmountBucketSat uint64 `protobuf:"varint,32,opt,name=amount_bucket_sat,json=amountBucketSat,proto3" json:"amount_bucket_sat,omitempty"`
+	//
+	//The largest percentage of a suggested swap amount, in [0, 50], that is
+	//randomly removed from it before it is rounded. Set to zero to disable
+	//randomization.
+	AmountJitterPercent uint64 `protobuf:"varint,33,opt,name=amount_jitter_percent,json=amountJitterPercent,proto3" json:"amount_jitter_percent,omitempty"`
+	//
+	//Set to true to scale the number of automatically dispatched swaps that
+	//may be in flight with the number of channels that autoloop manages.
+	//auto_max_in_flight remains the absolute ceiling.
+	AdaptiveInFlight bool `protobuf:"varint,34,opt,name=adaptive_in_flight,json=adaptiveInFlight,proto3" json:"adaptive_in_flight,omitempty"`
+	//
+	//The number of managed channels that one in flight swap is allowed for
+	//when adaptive_in_flight is set. The limit is rounded up, so at least one
+	//swap is always allowed.
+	ChannelsPerInFlight uint32 `protobuf:"varint,35,opt,name=channels_per_in_flight,json=channelsPerInFlight,proto3" json:"channels_per_in_flight,omitempty"`
+	//
+	//The amount of time, in seconds, that autoloop waits after a swap completes
+	//successfully for a channel or peer before it suggests another swap for
+	//it. Set to zero to disable the cooldown.
+	SuccessCooldownSec uint64 `protobuf:"varint,36,opt,name=success_cooldown_sec,json=successCooldownSec,proto3" json:"success_cooldown_sec,omitempty"`
+	//
+	//Set to true to dispatch the next-best swap that the in flight limit
+	//excluded when a swap that autoloop dispatches fails at initiation, for
+	//example because the server rejects it, rather than waiting for the next
+	//autoloop tick.
+	RetryDispatchFailures bool `protobuf:"varint,37,opt,name=retry_dispatch_failures,json=retryDispatchFailures,proto3" json:"retry_dispatch_failures,omitempty"`
+	//
+	//The maximum number of automatically dispatched swaps that may be initiated
+	//within the swap rate period. Set to zero to not limit the number of swaps.
+	MaxSwapsPerPeriod uint32 `protobuf:"varint,38,opt,name=max_swaps_per_period,json=maxSwapsPerPeriod,proto3" json:"max_swaps_per_period,omitempty"`
+	//
+	//The maximum number of automatically dispatched swaps that may be initiated
+	//for a single channel or peer within the swap rate period. Set to zero to
+	//not limit the number of swaps.
+	MaxChannelSwapsPerPeriod uint32 `protobuf:"varint,39,opt,name=max_channel_swaps_per_period,json=maxChannelSwapsPerPeriod,proto3" json:"max_channel_swaps_per_period,omitempty"`
+	//
+	//The rolling window, in seconds, that the number of automatically
+	//dispatched swaps is limited in. Must be set if either swap limit is set.
+	SwapRatePeriodSec uint64 `protobuf:"varint,40,opt,name=swap_rate_period_sec,json=swapRatePeriodSec,proto3" json:"swap_rate_period_sec,omitempty"`
+	//
+	//Set to true to grow the failure backoff of a channel or peer with its
+	//number of consecutive failures, starting at failure_backoff_sec.
+	ExponentialBackoff bool `protobuf:"varint,41,opt,name=exponential_backoff,json=exponentialBackoff,proto3" json:"exponential_backoff,omitempty"`
+	//
+	//The factor that the failure backoff grows by for each consecutive failure
+	//when exponential backoff is enabled. Must be at least 1.
+	BackoffMultiplier float64 `protobuf:"fixed64,42,opt,name=backoff_multiplier,json=backoffMultiplier,proto3" json:"backoff_multiplier,omitempty"`
+	//
+	//The maximum failure backoff, in seconds, when exponential backoff is
+	//enabled, before jitter is added. Must be at least failure_backoff_sec.
+	BackoffCapSec uint64 `protobuf:"varint,43,opt,name=backoff_cap_sec,json=backoffCapSec,proto3" json:"backoff_cap_sec,omitempty"`
+	//
+	//The largest percentage of the failure backoff, in [0, 100], that is added
+	//to it as jitter when exponential backoff is enabled.
+	BackoffJitterPercent uint32 `protobuf:"varint,44,opt,name=backoff_jitter_percent,json=backoffJitterPercent,proto3" json:"backoff_jitter_percent,omitempty"`
+	//
+	//The autoloop fee budget expressed as parts per million of the amount
+	//swapped by automatically dispatched swaps in the budget period. If set,
+	//the budget for the period is the larger of autoloop_budget_sat and this
+	//share of the swapped amount. A zero value disables the ppm budget.
+	AutoloopBudgetPpm uint64 `protobuf:"varint,45,opt,name=autoloop_budget_ppm,json=autoloopBudgetPpm,proto3" json:"autoloop_budget_ppm,omitempty"`
+	//
+	//An optional fee budget, expressed in satoshis, for automatically
+	//dispatched loop out swaps since the budget start date. It applies in
+	//addition to autoloop_budget_sat. A zero value sets no limit for loop out.
+	AutoloopBudgetOutSat uint64 `protobuf:"varint,46,opt,name=autoloop_budget_out_sat,json=autoloopBudgetOutSat,proto3" json:"autoloop_budget_out_sat,omitempty"`
+	//
+	//An optional fee budget, expressed in satoshis, for automatically
+	//dispatched loop in swaps since the budget start date. It applies in
+	//addition to autoloop_budget_sat. A zero value sets no limit for loop in.
+	AutoloopBudgetInSat uint64 `protobuf:"varint,47,opt,name=autoloop_budget_in_sat,json=autoloopBudgetInSat,proto3" json:"autoloop_budget_in_sat,omitempty"`
+	//
+	//An optional limit on the number of automatically dispatched loop out
+	//swaps that may be in flight. It applies in addition to auto_max_in_flight.
+	//A zero value sets no limit for loop out.
+	AutoMaxInFlightOut uint64 `protobuf:"varint,48,opt,name=auto_max_in_flight_out,json=autoMaxInFlightOut,proto3" json:"auto_max_in_flight_out,omitempty"`
+	//
+	//An optional limit on the number of automatically dispatched loop in swaps
+	//that may be in flight. It applies in addition to auto_max_in_flight. A
+	//zero value sets no limit for loop in.
+	AutoMaxInFlightIn uint64 `protobuf:"varint,49,opt,name=auto_max_in_flight_in,json=autoMaxInFlightIn,proto3" json:"auto_max_in_flight_in,omitempty"`
+	//
+	//The amount of time, in seconds, that the autolooper waits between
+	//dispatching the swaps that it decides on in a single tick. A zero value
+	//dispatches all swaps at once.
+	DispatchSpacingSec uint64 `protobuf:"varint,50,opt,name=dispatch_spacing_sec,json=dispatchSpacingSec,proto3" json:"dispatch_spacing_sec,omitempty"`
+	//
+	//The number of blocks that must have been mined since a channel confirmed
+	//before the autolooper suggests swaps for it. Younger channels are left out
+	//of the balances of peer and group rules. A zero value manages channels of
+	//any age.
+	MinChannelAgeBlocks uint32 `protobuf:"varint,51,opt,name=min_channel_age_blocks,json=minChannelAgeBlocks,proto3" json:"min_channel_age_blocks,omitempty"`
+	//
+	//Set to true to record the swaps that autoloop would dispatch, along with
+	//their projected costs, instead of dispatching them. Swaps are simulated
+	//whether or not autoloop is enabled, and simulation takes precedence over
+	//approval mode.
+	SimulationMode bool `protobuf:"varint,52,opt,name=simulation_mode,json=simulationMode,proto3" json:"simulation_mode,omitempty"`
+	//
+	//The period of forwarding history, in seconds, that the autolooper uses to
+	//forecast the flow of channels. Swaps are lowered by the liquidity that
+	//their channels regained from forwarding over the last period, so that
+	//channels which refill themselves are swapped less than stagnant channels.
+	//A zero value does not size swaps for forwarding flow.
+	FlowWindowSec uint64 `protobuf:"varint,53,opt,name=flow_window_sec,json=flowWindowSec,proto3" json:"flow_window_sec,omitempty"`
+	//
+	//The amount of time, in seconds, after a loop out is suggested that the
+	//autolooper allows the server to wait before publishing its htlc. Longer
+	//deadlines allow the server to batch htlcs and wait for lower chain fees,
+	//which lowers the swap fee. A zero value asks the server to publish
+	//immediately. Rules may override it with their own deadline.
+	PublicationDeadlineSec uint64 `protobuf:"varint,54,opt,name=publication_deadline_sec,json=publicationDeadlineSec,proto3" json:"publication_deadline_sec,omitempty"`
+	//
+	//Set to true to allow the autolooper to suggest loop ins for peers that the
+	//node only has private channels with. Loop ins dispatched by the autolooper
+	//then include route hints for the private channels of their last hop, so
+	//that the server can reach them.
+	LoopInPrivate bool `protobuf:"varint,55,opt,name=loop_in_private,json=loopInPrivate,proto3" json:"loop_in_private,omitempty"`
+	//
+	//Set to true to combine the loop outs that the autolooper suggests for
+	//different channels and peers into as few swaps as the server's maximum
+	//swap amount allows. Only loop outs for rules that use the global fee
+	//limit, publication deadline, payment metadata and sweep destination are
+	//combined.
+	BatchLoopOuts bool `protobuf:"varint,56,opt,name=batch_loop_outs,json=batchLoopOuts,proto3" json:"batch_loop_outs,omitempty"`
+	//
+	//The way that the autolooper resolves the rules of a channel that has a
+	//rule of its own, and whose peer also has a rule.
+	RuleConflict RuleConflict `protobuf:"varint,57,opt,name=rule_conflict,json=ruleConflict,proto3,enum=looprpc.RuleConflict" json:"rule_conflict,omitempty"`
+	//
+	//The largest portion of the swap amount, expressed as parts per million,
+	//that the current on-chain fee estimate for a swap may consume. If set,
+	//fee_ppm limits the server fees, off-chain routing fees and no-show penalty
+	//of a swap, and on-chain fees are limited relative to the current fee
+	//estimate rather than to the swap amount. It may only be set in conjunction
+	//with fee_ppm and miner_fee_multiplier.
+	MinerFeePpm uint64 `protobuf:"varint,58,opt,name=miner_fee_ppm,json=minerFeePpm,proto3" json:"miner_fee_ppm,omitempty"`
+	//
+	//The multiple of a swap's current on-chain fee estimate that we are willing
+	//to pay on chain if fees spike after the swap has been dispatched. It may
+	//only be set in conjunction with fee_ppm and miner_fee_ppm.
+	MinerFeeMultiplier uint64 `protobuf:"varint,59,opt,name=miner_fee_multiplier,json=minerFeeMultiplier,proto3" json:"miner_fee_multiplier,omitempty"`
+	//
+	//The way that the autolooper orders the loop in swaps that it suggests for
+	//different last hops, which decides which of them are dispatched when the
+	//in flight limits or budget do not allow all of them.
+	LoopInSpread LoopInSpread `protobuf:"varint,60,opt,name=loop_in_spread,json=loopInSpread,proto3,enum=looprpc.LoopInSpread" json:"loop_in_spread,omitempty"`
+}
+
+func (x *LiquidityParameters) Reset() {
+	*x = LiquidityParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityParameters) ProtoMessage() {}
+
+func (x *LiquidityParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityParameters.ProtoReflect.Descriptor instead.
+func (*LiquidityParameters) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *LiquidityParameters) GetRules() []*LiquidityRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *LiquidityParameters) GetFeePpm() uint64 {
+	if x != nil {
+		return x.FeePpm
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetSweepFeeRateSatPerVbyte() uint64 {
+	if x != nil {
+		return x.SweepFeeRateSatPerVbyte
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMaxSwapFeePpm() uint64 {
+	if x != nil {
+		return x.MaxSwapFeePpm
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMaxRoutingFeePpm() uint64 {
+	if x != nil {
+		return x.MaxRoutingFeePpm
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMaxPrepayRoutingFeePpm() uint64 {
+	if x != nil {
+		return x.MaxPrepayRoutingFeePpm
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMaxPrepaySat() uint64 {
+	if x != nil {
+		return x.MaxPrepaySat
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMaxMinerFeeSat() uint64 {
+	if x != nil {
+		return x.MaxMinerFeeSat
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetSweepConfTarget() int32 {
+	if x != nil {
+		return x.SweepConfTarget
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetFailureBackoffSec() uint64 {
+	if x != nil {
+		return x.FailureBackoffSec
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoloop() bool {
+	if x != nil {
+		return x.Autoloop
+	}
+	return false
+}
+
+func (x *LiquidityParameters) GetAutoloopBudgetSat() uint64 {
+	if x != nil {
+		return x.AutoloopBudgetSat
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoloopBudgetStartSec() uint64 {
+	if x != nil {
+		return x.AutoloopBudgetStartSec
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoMaxInFlight() uint64 {
+	if x != nil {
+		return x.AutoMaxInFlight
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMinSwapAmount() uint64 {
+	if x != nil {
+		return x.MinSwapAmount
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMaxSwapAmount() uint64 {
+	if x != nil {
+		return x.MaxSwapAmount
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetHtlcConfTarget() int32 {
+	if x != nil {
+		return x.HtlcConfTarget
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetVbyteBudget() uint64 {
+	if x != nil {
+		return x.VbyteBudget
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetVbyteBudgetPeriodSec() uint64 {
+	if x != nil {
+		return x.VbyteBudgetPeriodSec
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetRebalanceFeePpm() uint64 {
+	if x != nil {
+		return x.RebalanceFeePpm
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetRebalanceBudgetSat() uint64 {
+	if x != nil {
+		return x.RebalanceBudgetSat
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoloopBudgetRefreshPeriodSec() uint64 {
+	if x != nil {
+		return x.AutoloopBudgetRefreshPeriodSec
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoloopBudgetRollover() bool {
+	if x != nil {
+		return x.AutoloopBudgetRollover
+	}
+	return false
+}
+
+func (x *LiquidityParameters) GetAutoloopBudgetRolledOverSat() uint64 {
+	if x != nil {
+		return x.AutoloopBudgetRolledOverSat
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoloopSchedule() []*AutoloopWindow {
+	if x != nil {
+		return x.AutoloopSchedule
+	}
+	return nil
+}
+
+func (x *LiquidityParameters) GetChainFeeCeilingSatPerVbyte() uint64 {
+	if x != nil {
+		return x.ChainFeeCeilingSatPerVbyte
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetExcludedChannels() []uint64 {
+	if x != nil {
+		return x.ExcludedChannels
+	}
+	return nil
+}
+
+func (x *LiquidityParameters) GetApprovalMode() bool {
+	if x != nil {
+		return x.ApprovalMode
+	}
+	return false
+}
+
+func (x *LiquidityParameters) GetApprovalTtlSec() uint64 {
+	if x != nil {
+		return x.ApprovalTtlSec
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetEasyAutoloop() bool {
+	if x != nil {
+		return x.EasyAutoloop
+	}
+	return false
+}
+
+func (x *LiquidityParameters) GetEasyOutboundTargetPercent() uint64 {
+	if x != nil {
+		return x.EasyOutboundTargetPercent
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAmountBucketSat() uint64 {
+	if x != nil {
+		return x.AmountBucketSat
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAmountJitterPercent() uint64 {
+	if x != nil {
+		return x.AmountJitterPercent
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAdaptiveInFlight() bool {
+	if x != nil {
+		return x.AdaptiveInFlight
+	}
+	return false
+}
+
+func (x *LiquidityParameters) GetChannelsPerInFlight() uint32 {
+	if x != nil {
+		return x.ChannelsPerInFlight
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetSuccessCooldownSec() uint64 {
+	if x != nil {
+		return x.SuccessCooldownSec
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetRetryDispatchFailures() bool {
+	if x != nil {
+		return x.RetryDispatchFailures
+	}
+	return false
+}
+
+func (x *LiquidityParameters) GetMaxSwapsPerPeriod() uint32 {
+	if x != nil {
+		return x.MaxSwapsPerPeriod
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMaxChannelSwapsPerPeriod() uint32 {
+	if x != nil {
+		return x.MaxChannelSwapsPerPeriod
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetSwapRatePeriodSec() uint64 {
+	if x != nil {
+		return x.SwapRatePeriodSec
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetExponentialBackoff() bool {
+	if x != nil {
+		return x.ExponentialBackoff
+	}
+	return false
+}
+
+func (x *LiquidityParameters) GetBackoffMultiplier() float64 {
+	if x != nil {
+		return x.BackoffMultiplier
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetBackoffCapSec() uint64 {
+	if x != nil {
+		return x.BackoffCapSec
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetBackoffJitterPercent() uint32 {
+	if x != nil {
+		return x.BackoffJitterPercent
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoloopBudgetPpm() uint64 {
+	if x != nil {
+		return x.AutoloopBudgetPpm
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoloopBudgetOutSat() uint64 {
+	if x != nil {
+		return x.AutoloopBudgetOutSat
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoloopBudgetInSat() uint64 {
+	if x != nil {
+		return x.AutoloopBudgetInSat
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoMaxInFlightOut() uint64 {
+	if x != nil {
+		return x.AutoMaxInFlightOut
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetAutoMaxInFlightIn() uint64 {
+	if x != nil {
+		return x.AutoMaxInFlightIn
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetDispatchSpacingSec() uint64 {
+	if x != nil {
+		return x.DispatchSpacingSec
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMinChannelAgeBlocks() uint32 {
+	if x != nil {
+		return x.MinChannelAgeBlocks
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetSimulationMode() bool {
+	if x != nil {
+		return x.SimulationMode
+	}
+	return false
+}
+
+func (x *LiquidityParameters) GetFlowWindowSec() uint64 {
+	if x != nil {
+		return x.FlowWindowSec
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetPublicationDeadlineSec() uint64 {
+	if x != nil {
+		return x.PublicationDeadlineSec
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetLoopInPrivate() bool {
+	if x != nil {
+		return x.LoopInPrivate
+	}
+	return false
+}
+
+func (x *LiquidityParameters) GetBatchLoopOuts() bool {
+	if x != nil {
+		return x.BatchLoopOuts
+	}
+	return false
+}
+
+func (x *LiquidityParameters) GetRuleConflict() RuleConflict {
+	if x != nil {
+		return x.RuleConflict
+	}
+	return RuleConflict_RULE_CONFLICT_REJECT
+}
+
+func (x *LiquidityParameters) GetMinerFeePpm() uint64 {
+	if x != nil {
+		return x.MinerFeePpm
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetMinerFeeMultiplier() uint64 {
+	if x != nil {
+		return x.MinerFeeMultiplier
+	}
+	return 0
+}
+
+func (x *LiquidityParameters) GetLoopInSpread() LoopInSpread {
+	if x != nil {
+		return x.LoopInSpread
+	}
+	return LoopInSpread_LOOP_IN_SPREAD_NONE
+}
+
+// AutoloopWindow is a period of the day in which the autolooper may dispatch
+// swaps. Times are expressed in the local time of the machine running loopd. A
+// window that ends before it starts wraps past midnight, and applies to the day
+// that it starts on.
+type AutoloopWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The days of the week that the window applies to, where 0 is Sunday and 6
+	//is Saturday. If no days are set, the window applies to every day.
+	Days []uint32 `protobuf:"varint,1,rep,packed,name=days,proto3" json:"days,omitempty"`
+	//
+	//The time of day that the window starts, expressed in minutes since
+	//midnight, inclusive.
+	StartMinute uint32 `protobuf:"varint,2,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	//
+	//The time of day that the window ends, expressed in minutes since
+	//midnight, exclusive. A value of 1440 ends the window at midnight.
+	EndMinute uint32 `protobuf:"varint,3,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`
+}
+
+func (x *AutoloopWindow) Reset() {
+	*x = AutoloopWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoloopWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoloopWindow) ProtoMessage() {}
+
+func (x *AutoloopWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoloopWindow.ProtoReflect.Descriptor instead.
+func (*AutoloopWindow) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AutoloopWindow) GetDays() []uint32 {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *AutoloopWindow) GetStartMinute() uint32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *AutoloopWindow) GetEndMinute() uint32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+type LiquidityRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The short channel ID of the channel that this rule should be applied to.
+	//This field may not be set when the pubkey field is set.
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// The type of swap that will be dispatched for this rule.
+	SwapType SwapType `protobuf:"varint,6,opt,name=swap_type,json=swapType,proto3,enum=looprpc.SwapType" json:"swap_type,omitempty"`
+	//
+	//The public key of the peer that this rule should be applied to. This field
+	//may not be set when the channel id field is set.
+	Pubkey []byte `protobuf:"bytes,5,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	//
+	//Type indicates the type of rule that this message rule represents. Setting
+	//this value will determine which fields are used in the message. The comments
+	//on each field in this message will be prefixed with the LiquidityRuleType
+	//they belong to.
+	Type LiquidityRuleType `protobuf:"varint,2,opt,name=type,proto3,enum=looprpc.LiquidityRuleType" json:"type,omitempty"`
+	//
+	//THRESHOLD: The percentage of total capacity that incoming capacity should
+	//not drop beneath.
+	IncomingThreshold uint32 `protobuf:"varint,3,opt,name=incoming_threshold,json=incomingThreshold,proto3" json:"incoming_threshold,omitempty"`
+	//
+	//THRESHOLD: The percentage of total capacity that outgoing capacity should
+	//not drop beneath.
+	OutgoingThreshold uint32 `protobuf:"varint,4,opt,name=outgoing_threshold,json=outgoingThreshold,proto3" json:"outgoing_threshold,omitempty"`
+	//
+	//The policy that autoloop applies to swaps suggested for this rule when the
+	//server lowers its maximum swap amount before they are dispatched. The
+	//policy that was applied is recorded in the notes of the dispatched swaps.
+	ShrinkPolicy ShrinkPolicy `protobuf:"varint,7,opt,name=shrink_policy,json=shrinkPolicy,proto3,enum=looprpc.ShrinkPolicy" json:"shrink_policy,omitempty"`
+	//
+	//The total amount of fees that autoloop may spend on swaps for this rule
+	//since the budget start date. This budget applies in addition to the
+	//global autoloop budget. If it is zero, swaps for this rule are only
+	//limited by the global budget.
+	FeeBudgetSat uint64 `protobuf:"varint,8,opt,name=fee_budget_sat,json=feeBudgetSat,proto3" json:"fee_budget_sat,omitempty"`
+	//
+	//The unix timestamp in seconds at which the rule expires. Expired rules
+	//remain set until they are removed, but autoloop no longer suggests swaps
+	//for them. If it is zero, the rule does not expire.
+	ExpirySec uint64 `protobuf:"varint,9,opt,name=expiry_sec,json=expirySec,proto3" json:"expiry_sec,omitempty"`
+	//
+	//The name of the channel group that the rule applies to. Group rules apply
+	//to the total balance of all of the group's channels, which may be with
+	//different peers. If this field is set, group_channels must be set and
+	//channel_id and pubkey may not be set.
+	GroupName string `protobuf:"bytes,10,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	//
+	//The short channel IDs of the channels in the channel group that the rule
+	//applies to. A channel may only belong to one group, and may not be in a
+	//group if it has a channel rule or its peer has a peer rule.
+	GroupChannels []uint64 `protobuf:"varint,11,rep,packed,name=group_channels,json=groupChannels,proto3" json:"group_channels,omitempty"`
+	//
+	//The number of seconds after a swap in the opposite direction to the rule's
+	//swap type completes on its channels or peer that autoloop waits before
+	//suggesting swaps for the rule. If it is zero, no cooldown is applied.
+	CooldownSec uint64 `protobuf:"varint,12,opt,name=cooldown_sec,json=cooldownSec,proto3" json:"cooldown_sec,omitempty"`
+	//
+	//The fee limit for swaps suggested for this rule, expressed as parts per
+	//million of the swap amount, which overrides the global fee limit. It may
+	//not be set in conjunction with the rule's fee categories. If neither are
+	//set, the global fee limit is used.
+	FeePpm uint64 `protobuf:"varint,13,opt,name=fee_ppm,json=feePpm,proto3" json:"fee_ppm,omitempty"`
+	//
+	//The limit placed on our estimated sweep fee for swaps suggested for this
+	//rule, overriding the global sweep fee rate limit.
+	SweepFeeRateSatPerVbyte uint64 `protobuf:"varint,14,opt,name=sweep_fee_rate_sat_per_vbyte,json=sweepFeeRateSatPerVbyte,proto3" json:"sweep_fee_rate_sat_per_vbyte,omitempty"`
+	//
+	//The maximum server fee for swaps suggested for this rule, expressed as
+	//parts per million of the swap amount.
+	MaxSwapFeePpm uint64 `protobuf:"varint,15,opt,name=max_swap_fee_ppm,json=maxSwapFeePpm,proto3" json:"max_swap_fee_ppm,omitempty"`
+	//
+	//The maximum fee paid to route the swap invoice off chain for swaps
+	//suggested for this rule, expressed as parts per million of the swap
+	//amount.
+	MaxRoutingFeePpm uint64 `protobuf:"varint,16,opt,name=max_routing_fee_ppm,json=maxRoutingFeePpm,proto3" json:"max_routing_fee_ppm,omitempty"`
+	//
+	//The maximum fee paid to route the prepay invoice off chain for swaps
+	//suggested for this rule, expressed as parts per million of the swap
+	//amount.
+	MaxPrepayRoutingFeePpm uint64 `protobuf:"varint,17,opt,name=max_prepay_routing_fee_ppm,json=maxPrepayRoutingFeePpm,proto3" json:"max_prepay_routing_fee_ppm,omitempty"`
+	//
+	//The maximum no show penalty in satoshis paid for swaps suggested for this
+	//rule.
+	MaxPrepaySat uint64 `protobuf:"varint,18,opt,name=max_prepay_sat,json=maxPrepaySat,proto3" json:"max_prepay_sat,omitempty"`
+	//
+	//The maximum miner fee we will pay to sweep swaps suggested for this rule
+	//on chain.
+	MaxMinerFeeSat uint64 `protobuf:"varint,19,opt,name=max_miner_fee_sat,json=maxMinerFeeSat,proto3" json:"max_miner_fee_sat,omitempty"`
+	//
+	//THRESHOLD: The absolute amount of incoming capacity, in satoshis, that
+	//incoming capacity should not drop beneath. It may not be set in
+	//conjunction with incoming_threshold.
+	IncomingThresholdSat uint64 `protobuf:"varint,20,opt,name=incoming_threshold_sat,json=incomingThresholdSat,proto3" json:"incoming_threshold_sat,omitempty"`
+	//
+	//THRESHOLD: The absolute amount of outgoing capacity, in satoshis, that
+	//outgoing capacity should not drop beneath. It may not be set in
+	//conjunction with outgoing_threshold.
+	OutgoingThresholdSat uint64 `protobuf:"varint,21,opt,name=outgoing_threshold_sat,json=outgoingThresholdSat,proto3" json:"outgoing_threshold_sat,omitempty"`
+	//
+	//The maximum amount, in satoshis, of swaps suggested for this rule. Swaps
+	//are capped at this amount even if the rule's thresholds require a larger
+	//swap. If zero, swaps are only limited by our swap size restrictions.
+	MaxSwapAmountSat uint64 `protobuf:"varint,22,opt,name=max_swap_amount_sat,json=maxSwapAmountSat,proto3" json:"max_swap_amount_sat,omitempty"`
+	//
+	//An optional set of custom tlv records, keyed by record type, that are
+	//attached to the swap and prepay payments of loop outs suggested for this
+	//rule. Record types must be >= 65536, and the total size of the record
+	//values may not exceed 512 bytes. Custom records may only be set for loop
+	//out rules.
+	CustomRecords map[uint64][]byte `protobuf:"bytes,23,rep,name=custom_records,json=customRecords,proto3" json:"custom_records,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//
+	//The percentage of the fees that a loop out suggested for this rule could
+	//cost that its channels must have earned in routing fees over the rule's
+	//revenue window. Loop outs for channels that earn less are not suggested. A
+	//zero value does not require any revenue. It may only be set for loop out
+	//rules.
+	MinRevenuePercent uint64 `protobuf:"varint,24,opt,name=min_revenue_percent,json=minRevenuePercent,proto3" json:"min_revenue_percent,omitempty"`
+	//
+	//The period of forwarding history, in seconds, that the rule's minimum
+	//revenue is applied to. If zero, a default of 30 days is used.
+	RevenueWindowSec uint64 `protobuf:"varint,25,opt,name=revenue_window_sec,json=revenueWindowSec,proto3" json:"revenue_window_sec,omitempty"`
+	//
+	//An optional address that autoloop sweeps the loop outs suggested for this
+	//rule to, rather than the lnd wallet. It may only be set for loop out rules,
+	//and may not be set with sweep_xpub.
+	SweepAddress string `protobuf:"bytes,26,opt,name=sweep_address,json=sweepAddress,proto3" json:"sweep_address,omitempty"`
+	//
+	//An optional extended public key that autoloop derives a fresh native segwit
+	//sweep address from for each loop out suggested for this rule. Addresses are
+	//derived on the key's external branch (m/0/i), skipping addresses that
+	//previous swaps were swept to. It may only be set for loop out rules, and may
+	//not be set with sweep_address.
+	SweepXpub string `protobuf:"bytes,27,opt,name=sweep_xpub,json=sweepXpub,proto3" json:"sweep_xpub,omitempty"`
+	//
+	//An optional signature of sweep_address, made by the address's key in the
+	//bitcoin signmessage format and base64 encoded, which verifies that the
+	//address is owned by the user. The signed message is the address itself.
+	SweepAddressSignature string `protobuf:"bytes,28,opt,name=sweep_address_signature,json=sweepAddressSignature,proto3" json:"sweep_address_signature,omitempty"`
+	//
+	//An optional amount of time, in seconds, after a loop out is suggested for
+	//this rule that the server may wait before publishing its htlc, which
+	//overrides the global publication_deadline_sec. If zero, the global
+	//deadline is used. It may only be set for loop out rules.
+	PublicationDeadlineSec uint64 `protobuf:"varint,29,opt,name=publication_deadline_sec,json=publicationDeadlineSec,proto3" json:"publication_deadline_sec,omitempty"`
+}
+
+func (x *LiquidityRule) Reset() {
+	*x = LiquidityRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityRule) ProtoMessage() {}
+
+func (x *LiquidityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityRule.ProtoReflect.Descriptor instead.
+func (*LiquidityRule) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *LiquidityRule) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetSwapType() SwapType {
+	if x != nil {
+		return x.SwapType
+	}
+	return SwapType_LOOP_OUT
+}
+
+func (x *LiquidityRule) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *LiquidityRule) GetType() LiquidityRuleType {
+	if x != nil {
+		return x.Type
+	}
+	return LiquidityRuleType_UNKNOWN
+}
+
+func (x *LiquidityRule) GetIncomingThreshold() uint32 {
+	if x != nil {
+		return x.IncomingThreshold
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetOutgoingThreshold() uint32 {
+	if x != nil {
+		return x.OutgoingThreshold
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetShrinkPolicy() ShrinkPolicy {
+	if x != nil {
+		return x.ShrinkPolicy
+	}
+	return ShrinkPolicy_SHRINK_POLICY_SKIP
+}
+
+func (x *LiquidityRule) GetFeeBudgetSat() uint64 {
+	if x != nil {
+		return x.FeeBudgetSat
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetExpirySec() uint64 {
+	if x != nil {
+		return x.ExpirySec
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *LiquidityRule) GetGroupChannels() []uint64 {
+	if x != nil {
+		return x.GroupChannels
+	}
+	return nil
+}
+
+func (x *LiquidityRule) GetCooldownSec() uint64 {
+	if x != nil {
+		return x.CooldownSec
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetFeePpm() uint64 {
+	if x != nil {
+		return x.FeePpm
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetSweepFeeRateSatPerVbyte() uint64 {
+	if x != nil {
+		return x.SweepFeeRateSatPerVbyte
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetMaxSwapFeePpm() uint64 {
+	if x != nil {
+		return x.MaxSwapFeePpm
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetMaxRoutingFeePpm() uint64 {
+	if x != nil {
+		return x.MaxRoutingFeePpm
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetMaxPrepayRoutingFeePpm() uint64 {
+	if x != nil {
+		return x.MaxPrepayRoutingFeePpm
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetMaxPrepaySat() uint64 {
+	if x != nil {
+		return x.MaxPrepaySat
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetMaxMinerFeeSat() uint64 {
+	if x != nil {
+		return x.MaxMinerFeeSat
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetIncomingThresholdSat() uint64 {
+	if x != nil {
+		return x.IncomingThresholdSat
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetOutgoingThresholdSat() uint64 {
+	if x != nil {
+		return x.OutgoingThresholdSat
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetMaxSwapAmountSat() uint64 {
+	if x != nil {
+		return x.MaxSwapAmountSat
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetCustomRecords() map[uint64][]byte {
+	if x != nil {
+		return x.CustomRecords
+	}
+	return nil
+}
+
+func (x *LiquidityRule) GetMinRevenuePercent() uint64 {
+	if x != nil {
+		return x.MinRevenuePercent
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetRevenueWindowSec() uint64 {
+	if x != nil {
+		return x.RevenueWindowSec
+	}
+	return 0
+}
+
+func (x *LiquidityRule) GetSweepAddress() string {
+	if x != nil {
+		return x.SweepAddress
+	}
+	return ""
+}
+
+func (x *LiquidityRule) GetSweepXpub() string {
+	if x != nil {
+		return x.SweepXpub
+	}
+	return ""
+}
+
+func (x *LiquidityRule) GetSweepAddressSignature() string {
+	if x != nil {
+		return x.SweepAddressSignature
+	}
+	return ""
+}
+
+func (x *LiquidityRule) GetPublicationDeadlineSec() uint64 {
+	if x != nil {
+		return x.PublicationDeadlineSec
+	}
+	return 0
+}
+
+type SetLiquidityParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Parameters is the desired new set of parameters for the liquidity management
+	//subsystem. Note that the current set of parameters will be completely
+	//overwritten by the parameters provided (if they are valid), so the full set
+	//of parameters should be provided for each call.
+	Parameters *LiquidityParameters `protobuf:"bytes,1,opt,name=parameters,proto3" json:"parameters,omitempty"`
+}
+
+func (x *SetLiquidityParamsRequest) Reset() {
+	*x = SetLiquidityParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLiquidityParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLiquidityParamsRequest) ProtoMessage() {}
+
+func (x *SetLiquidityParamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLiquidityParamsRequest.ProtoReflect.Descriptor instead.
+func (*SetLiquidityParamsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SetLiquidityParamsRequest) GetParameters() *LiquidityParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type SetLiquidityParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The increase to the autoloop fee budget or fee limit that is waiting for
+	//its delay to pass after this update, if any. Increases that exceed the
+	//budget increase threshold configured in loopd only take effect once the
+	//delay has passed.
+	PendingIncrease *PendingIncrease `protobuf:"bytes,1,opt,name=pending_increase,json=pendingIncrease,proto3" json:"pending_increase,omitempty"`
+}
+
+func (x *SetLiquidityParamsResponse) Reset() {
+	*x = SetLiquidityParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLiquidityParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLiquidityParamsResponse) ProtoMessage() {}
+
+func (x *SetLiquidityParamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLiquidityParamsResponse.ProtoReflect.Descriptor instead.
+func (*SetLiquidityParamsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SetLiquidityParamsResponse) GetPendingIncrease() *PendingIncrease {
+	if x != nil {
+		return x.PendingIncrease
+	}
+	return nil
+}
+
+type GetEffectiveConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetEffectiveConfigRequest) Reset() {
+	*x = GetEffectiveConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEffectiveConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectiveConfigRequest) ProtoMessage() {}
+
+func (x *GetEffectiveConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectiveConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetEffectiveConfigRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{55}
+}
+
+type ConfigValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The name of the setting. Daemon settings use the name of their config
+	//option, and liquidity parameters use the name of the parameter.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//
+	//The effective value of the setting. Sensitive values are redacted.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	//
+	//Where the effective value of the setting was set.
+	Source ConfigSource `protobuf:"varint,3,opt,name=source,proto3,enum=looprpc.ConfigSource" json:"source,omitempty"`
+}
+
+func (x *ConfigValue) Reset() {
+	*x = ConfigValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigValue) ProtoMessage() {}
+
+func (x *ConfigValue) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigValue.ProtoReflect.Descriptor instead.
+func (*ConfigValue) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ConfigValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ConfigValue) GetSource() ConfigSource {
+	if x != nil {
+		return x.Source
+	}
+	return ConfigSource_CONFIG_SOURCE_DEFAULT
+}
+
+type GetEffectiveConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The effective settings of the daemon.
+	Daemon []*ConfigValue `protobuf:"bytes,1,rep,name=daemon,proto3" json:"daemon,omitempty"`
+	//
+	//The effective parameters of the liquidity manager.
+	Liquidity []*ConfigValue `protobuf:"bytes,2,rep,name=liquidity,proto3" json:"liquidity,omitempty"`
+}
+
+func (x *GetEffectiveConfigResponse) Reset() {
+	*x = GetEffectiveConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEffectiveConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectiveConfigResponse) ProtoMessage() {}
+
+func (x *GetEffectiveConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectiveConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetEffectiveConfigResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetEffectiveConfigResponse) GetDaemon() []*ConfigValue {
+	if x != nil {
+		return x.Daemon
+	}
+	return nil
+}
+
+func (x *GetEffectiveConfigResponse) GetLiquidity() []*ConfigValue {
+	if x != nil {
+		return x.Liquidity
+	}
+	return nil
+}
+
+type ApplyRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The rule template that the selected channels and peers are given. The
+	//channel id, pubkey and group fields of the template must not be set.
+	Rule *LiquidityRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	//
+	//Remove the rules of the selected channels and peers rather than setting
+	//them. The rule template must not be set if this field is set.
+	Remove bool `protobuf:"varint,2,opt,name=remove,proto3" json:"remove,omitempty"`
+	//
+	//The channels to select.
+	Channels []uint64 `protobuf:"varint,3,rep,packed,name=channels,proto3" json:"channels,omitempty"`
+	//
+	//The public keys of the peers to select.
+	Peers [][]byte `protobuf:"bytes,4,rep,name=peers,proto3" json:"peers,omitempty"`
+	//
+	//Select all of our open channels.
+	AllChannels bool `protobuf:"varint,5,opt,name=all_channels,json=allChannels,proto3" json:"all_channels,omitempty"`
+	//
+	//Select all of the peers that we have open channels with.
+	AllPeers bool `protobuf:"varint,6,opt,name=all_peers,json=allPeers,proto3" json:"all_peers,omitempty"`
+	//
+	//The id of the preview of this change that is being confirmed. If it is
+	//not set, the change is previewed and not applied.
+	PreviewId []byte `protobuf:"bytes,7,opt,name=preview_id,json=previewId,proto3" json:"preview_id,omitempty"`
+}
+
+func (x *ApplyRulesRequest) Reset() {
+	*x = ApplyRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRulesRequest) ProtoMessage() {}
+
+func (x *ApplyRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRulesRequest.ProtoReflect.Descriptor instead.
+func (*ApplyRulesRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ApplyRulesRequest) GetRule() *LiquidityRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *ApplyRulesRequest) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+func (x *ApplyRulesRequest) GetChannels() []uint64 {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *ApplyRulesRequest) GetPeers() [][]byte {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *ApplyRulesRequest) GetAllChannels() bool {
+	if x != nil {
+		return x.AllChannels
+	}
+	return false
+}
+
+func (x *ApplyRulesRequest) GetAllPeers() bool {
+	if x != nil {
+		return x.AllPeers
+	}
+	return false
+}
+
+func (x *ApplyRulesRequest) GetPreviewId() []byte {
+	if x != nil {
+		return x.PreviewId
+	}
+	return nil
+}
+
+type RuleChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The channel whose rule changes, set if this is a channel rule.
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	//
+	//The peer whose rule changes, set if this is a peer rule.
+	Pubkey []byte `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	//
+	//The type of change.
+	Type RuleChangeType `protobuf:"varint,3,opt,name=type,proto3,enum=looprpc.RuleChangeType" json:"type,omitempty"`
+	//
+	//The rule that is currently set, if any.
+	OldRule *LiquidityRule `protobuf:"bytes,4,opt,name=old_rule,json=oldRule,proto3" json:"old_rule,omitempty"`
+	//
+	//The rule that is set by the change, if any.
+	NewRule *LiquidityRule `protobuf:"bytes,5,opt,name=new_rule,json=newRule,proto3" json:"new_rule,omitempty"`
+}
+
+func (x *RuleChange) Reset() {
+	*x = RuleChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleChange) ProtoMessage() {}
+
+func (x *RuleChange) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleChange.ProtoReflect.Descriptor instead.
+func (*RuleChange) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RuleChange) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *RuleChange) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *RuleChange) GetType() RuleChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return RuleChangeType_RULE_CHANGE_ADDED
+}
+
+func (x *RuleChange) GetOldRule() *LiquidityRule {
+	if x != nil {
+		return x.OldRule
+	}
+	return nil
+}
+
+func (x *RuleChange) GetNewRule() *LiquidityRule {
+	if x != nil {
+		return x.NewRule
+	}
+	return nil
+}
+
+type ProjectedSwaps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The number of loop outs suggested.
+	LoopOutCount uint32 `protobuf:"varint,1,opt,name=loop_out_count,json=loopOutCount,proto3" json:"loop_out_count,omitempty"`
+	//
+	//The total amount of the loop outs suggested.
+	LoopOutAmtSat uint64 `protobuf:"varint,2,opt,name=loop_out_amt_sat,json=loopOutAmtSat,proto3" json:"loop_out_amt_sat,omitempty"`
+	//
+	//The number of loop ins suggested.
+	LoopInCount uint32 `protobuf:"varint,3,opt,name=loop_in_count,json=loopInCount,proto3" json:"loop_in_count,omitempty"`
+	//
+	//The total amount of the loop ins suggested.
+	LoopInAmtSat uint64 `protobuf:"varint,4,opt,name=loop_in_amt_sat,json=loopInAmtSat,proto3" json:"loop_in_amt_sat,omitempty"`
+}
+
+func (x *ProjectedSwaps) Reset() {
+	*x = ProjectedSwaps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectedSwaps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectedSwaps) ProtoMessage() {}
+
+func (x *ProjectedSwaps) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectedSwaps.ProtoReflect.Descriptor instead.
+func (*ProjectedSwaps) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ProjectedSwaps) GetLoopOutCount() uint32 {
+	if x != nil {
+		return x.LoopOutCount
+	}
+	return 0
+}
+
+func (x *ProjectedSwaps) GetLoopOutAmtSat() uint64 {
+	if x != nil {
+		return x.LoopOutAmtSat
+	}
+	return 0
+}
+
+func (x *ProjectedSwaps) GetLoopInCount() uint32 {
+	if x != nil {
+		return x.LoopInCount
+	}
+	return 0
+}
+
+func (x *ProjectedSwaps) GetLoopInAmtSat() uint64 {
+	if x != nil {
+		return x.LoopInAmtSat
+	}
+	return 0
+}
+
+type ApplyRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The channels and peers whose rules change, ordered by channel and then by
+	//peer. Channels and peers whose rules would not change are not included.
+	Changes []*RuleChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	//
+	//The swaps that are suggested with our current rules.
+	CurrentSwaps *ProjectedSwaps `protobuf:"bytes,2,opt,name=current_swaps,json=currentSwaps,proto3" json:"current_swaps,omitempty"`
+	//
+	//The swaps that would be suggested once the change has been applied.
+	ProjectedSwaps *ProjectedSwaps `protobuf:"bytes,3,opt,name=projected_swaps,json=projectedSwaps,proto3" json:"projected_swaps,omitempty"`
+	//
+	//The id of the preview, which must be provided to apply the change.
+	PreviewId []byte `protobuf:"bytes,4,opt,name=preview_id,json=previewId,proto3" json:"preview_id,omitempty"`
+	//
+	//Whether the change was applied.
+	Applied bool `protobuf:"varint,5,opt,name=applied,proto3" json:"applied,omitempty"`
+}
+
+func (x *ApplyRulesResponse) Reset() {
+	*x = ApplyRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRulesResponse) ProtoMessage() {}
+
+func (x *ApplyRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRulesResponse.ProtoReflect.Descriptor instead.
+func (*ApplyRulesResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ApplyRulesResponse) GetChanges() []*RuleChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ApplyRulesResponse) GetCurrentSwaps() *ProjectedSwaps {
+	if x != nil {
+		return x.CurrentSwaps
+	}
+	return nil
+}
+
+func (x *ApplyRulesResponse) GetProjectedSwaps() *ProjectedSwaps {
+	if x != nil {
+		return x.ProjectedSwaps
+	}
+	return nil
+}
+
+func (x *ApplyRulesResponse) GetPreviewId() []byte {
+	if x != nil {
+		return x.PreviewId
+	}
+	return nil
+}
+
+func (x *ApplyRulesResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type SaveProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The name of the profile.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//
+	//Remove the profile rather than saving it. Profiles that are used by the
+	//profile schedule cannot be removed.
+	Remove bool `protobuf:"varint,2,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (x *SaveProfileRequest) Reset() {
+	*x = SaveProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveProfileRequest) ProtoMessage() {}
+
+func (x *SaveProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveProfileRequest.ProtoReflect.Descriptor instead.
+func (*SaveProfileRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SaveProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveProfileRequest) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+type SaveProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SaveProfileResponse) Reset() {
+	*x = SaveProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveProfileResponse) ProtoMessage() {}
+
+func (x *SaveProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveProfileResponse.ProtoReflect.Descriptor instead.
+func (*SaveProfileResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{63}
+}
+
+type ListProfilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListProfilesRequest) Reset() {
+	*x = ListProfilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfilesRequest) ProtoMessage() {}
+
+func (x *ListProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfilesRequest.ProtoReflect.Descriptor instead.
+func (*ListProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{64}
+}
+
+type LiquidityProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The name of the profile.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//
+	//The liquidity parameters saved in the profile.
+	Parameters *LiquidityParameters `protobuf:"bytes,2,opt,name=parameters,proto3" json:"parameters,omitempty"`
+}
+
+func (x *LiquidityProfile) Reset() {
+	*x = LiquidityProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityProfile) ProtoMessage() {}
+
+func (x *LiquidityProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidityProfile.ProtoReflect.Descriptor instead.
+func (*LiquidityProfile) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *LiquidityProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LiquidityProfile) GetParameters() *LiquidityParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type ProfileSwitch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The name of the profile that is switched to.
+	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	//
+	//The window in which the profile is active. The profile is applied when
+	//the window starts.
+	Window *AutoloopWindow `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (x *ProfileSwitch) Reset() {
+	*x = ProfileSwitch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfileSwitch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileSwitch) ProtoMessage() {}
+
+func (x *ProfileSwitch) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileSwitch.ProtoReflect.Descriptor instead.
+func (*ProfileSwitch) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ProfileSwitch) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *ProfileSwitch) GetWindow() *AutoloopWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type ListProfilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The saved profiles.
+	Profiles []*LiquidityProfile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	//
+	//The scheduled profile switches. If the windows of switches overlap, the
+	//switch that is listed first takes precedence.
+	Schedule []*ProfileSwitch `protobuf:"bytes,2,rep,name=schedule,proto3" json:"schedule,omitempty"`
+	//
+	//The profile that the liquidity parameters were last set from. It is empty
+	//if the parameters have been changed since.
+	ActiveProfile string `protobuf:"bytes,3,opt,name=active_profile,json=activeProfile,proto3" json:"active_profile,omitempty"`
+}
+
+func (x *ListProfilesResponse) Reset() {
+	*x = ListProfilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfilesResponse) ProtoMessage() {}
+
+func (x *ListProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ListProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListProfilesResponse) GetProfiles() []*LiquidityProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *ListProfilesResponse) GetSchedule() []*ProfileSwitch {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *ListProfilesResponse) GetActiveProfile() string {
+	if x != nil {
+		return x.ActiveProfile
+	}
+	return ""
+}
+
+type SetProfileScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The scheduled profile switches, which replace the current schedule. If
+	//the windows of switches overlap, the switch that is listed first takes
+	//precedence. An empty schedule disables profile switching.
+	Schedule []*ProfileSwitch `protobuf:"bytes,1,rep,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *SetProfileScheduleRequest) Reset() {
+	*x = SetProfileScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetProfileScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProfileScheduleRequest) ProtoMessage() {}
+
+func (x *SetProfileScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProfileScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetProfileScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SetProfileScheduleRequest) GetSchedule() []*ProfileSwitch {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type SetProfileScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetProfileScheduleResponse) Reset() {
+	*x = SetProfileScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetProfileScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProfileScheduleResponse) ProtoMessage() {}
+
+func (x *SetProfileScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProfileScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SetProfileScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{69}
+}
+
+type GetParamsHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetParamsHistoryRequest) Reset() {
+	*x = GetParamsHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetParamsHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParamsHistoryRequest) ProtoMessage() {}
+
+func (x *GetParamsHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParamsHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetParamsHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{70}
+}
+
+type ParamsChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unix timestamp, in seconds, at which the parameters changed.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	//
+	//What changed the parameters.
+	Source ParamsChangeSource `protobuf:"varint,2,opt,name=source,proto3,enum=looprpc.ParamsChangeSource" json:"source,omitempty"`
+	//
+	//The profile that is active after the change, if any.
+	Profile string `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	//
+	//The profile that was active before the change, if any.
+	PreviousProfile string `protobuf:"bytes,4,opt,name=previous_profile,json=previousProfile,proto3" json:"previous_profile,omitempty"`
+}
+
+func (x *ParamsChange) Reset() {
+	*x = ParamsChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParamsChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamsChange) ProtoMessage() {}
+
+func (x *ParamsChange) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParamsChange.ProtoReflect.Descriptor instead.
+func (*ParamsChange) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ParamsChange) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ParamsChange) GetSource() ParamsChangeSource {
+	if x != nil {
+		return x.Source
+	}
+	return ParamsChangeSource_PARAMS_CHANGE_RPC
+}
+
+func (x *ParamsChange) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *ParamsChange) GetPreviousProfile() string {
+	if x != nil {
+		return x.PreviousProfile
+	}
+	return ""
+}
+
+type GetParamsHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The most recent changes to the liquidity parameters, oldest first.
+	Changes []*ParamsChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *GetParamsHistoryResponse) Reset() {
+	*x = GetParamsHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetParamsHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParamsHistoryResponse) ProtoMessage() {}
+
+func (x *GetParamsHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParamsHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetParamsHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetParamsHistoryResponse) GetChanges() []*ParamsChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type PendingIncrease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unix timestamp, in seconds, at which the increase was requested.
+	RequestedTime int64 `protobuf:"varint,1,opt,name=requested_time,json=requestedTime,proto3" json:"requested_time,omitempty"`
+	//
+	//The unix timestamp, in seconds, from which the increase takes effect. It
+	//is applied on the first autoloop tick at or after this time.
+	EffectiveTime int64 `protobuf:"varint,2,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+	//
+	//Whether the autoloop fee budget is increased.
+	BudgetIncrease bool `protobuf:"varint,3,opt,name=budget_increase,json=budgetIncrease,proto3" json:"budget_increase,omitempty"`
+	//
+	//Whether the fee limit is increased.
+	FeeLimitIncrease bool `protobuf:"varint,4,opt,name=fee_limit_increase,json=feeLimitIncrease,proto3" json:"fee_limit_increase,omitempty"`
+	//
+	//The liquidity parameters that will be in effect once the increase is
+	//applied, given the current parameters.
+	Parameters *LiquidityParameters `protobuf:"bytes,5,opt,name=parameters,proto3" json:"parameters,omitempty"`
+}
+
+func (x *PendingIncrease) Reset() {
+	*x = PendingIncrease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingIncrease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingIncrease) ProtoMessage() {}
+
+func (x *PendingIncrease) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingIncrease.ProtoReflect.Descriptor instead.
+func (*PendingIncrease) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PendingIncrease) GetRequestedTime() int64 {
+	if x != nil {
+		return x.RequestedTime
+	}
+	return 0
+}
+
+func (x *PendingIncrease) GetEffectiveTime() int64 {
+	if x != nil {
+		return x.EffectiveTime
+	}
+	return 0
+}
+
+func (x *PendingIncrease) GetBudgetIncrease() bool {
+	if x != nil {
+		return x.BudgetIncrease
+	}
+	return false
+}
+
+func (x *PendingIncrease) GetFeeLimitIncrease() bool {
+	if x != nil {
+		return x.FeeLimitIncrease
+	}
+	return false
+}
+
+func (x *PendingIncrease) GetParameters() *LiquidityParameters {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type GetPendingIncreaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPendingIncreaseRequest) Reset() {
+	*x = GetPendingIncreaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPendingIncreaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPendingIncreaseRequest) ProtoMessage() {}
+
+func (x *GetPendingIncreaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPendingIncreaseRequest.ProtoReflect.Descriptor instead.
+func (*GetPendingIncreaseRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{74}
+}
+
+type GetPendingIncreaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The increase that is waiting for its delay to pass, unset if there is
+	//none.
+	Increase *PendingIncrease `protobuf:"bytes,1,opt,name=increase,proto3" json:"increase,omitempty"`
+}
+
+func (x *GetPendingIncreaseResponse) Reset() {
+	*x = GetPendingIncreaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPendingIncreaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPendingIncreaseResponse) ProtoMessage() {}
+
+func (x *GetPendingIncreaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPendingIncreaseResponse.ProtoReflect.Descriptor instead.
+func (*GetPendingIncreaseResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetPendingIncreaseResponse) GetIncrease() *PendingIncrease {
+	if x != nil {
+		return x.Increase
+	}
+	return nil
+}
+
+type CancelPendingIncreaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelPendingIncreaseRequest) Reset() {
+	*x = CancelPendingIncreaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPendingIncreaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPendingIncreaseRequest) ProtoMessage() {}
+
+func (x *CancelPendingIncreaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPendingIncreaseRequest.ProtoReflect.Descriptor instead.
+func (*CancelPendingIncreaseRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{76}
+}
+
+type CancelPendingIncreaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The increase that was cancelled.
+	Cancelled *PendingIncrease `protobuf:"bytes,1,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (x *CancelPendingIncreaseResponse) Reset() {
+	*x = CancelPendingIncreaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPendingIncreaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPendingIncreaseResponse) ProtoMessage() {}
+
+func (x *CancelPendingIncreaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPendingIncreaseResponse.ProtoReflect.Descriptor instead.
+func (*CancelPendingIncreaseResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CancelPendingIncreaseResponse) GetCancelled() *PendingIncrease {
+	if x != nil {
+		return x.Cancelled
+	}
+	return nil
+}
+
+type SuggestSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SuggestSwapsRequest) Reset() {
+	*x = SuggestSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSwapsRequest) ProtoMessage() {}
+
+func (x *SuggestSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSwapsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{78}
+}
+
+type SubscribeSuggestionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The amount in satoshis that a channel's balance must shift by since the
+	//last set of suggestions was sent for a new set to be sent. If this value
+	//is zero, a default of 10000 satoshis is used.
+	BalanceDeltaSat uint64 `protobuf:"varint,1,opt,name=balance_delta_sat,json=balanceDeltaSat,proto3" json:"balance_delta_sat,omitempty"`
+}
+
+func (x *SubscribeSuggestionsRequest) Reset() {
+	*x = SubscribeSuggestionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSuggestionsRequest) ProtoMessage() {}
+
+func (x *SubscribeSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SubscribeSuggestionsRequest) GetBalanceDeltaSat() uint64 {
+	if x != nil {
+		return x.BalanceDeltaSat
+	}
+	return 0
+}
+
+type Disqualified struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The short channel ID of the channel that was excluded from our suggestions.
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	//
+	//The public key of the peer that was excluded from our suggestions.
+	Pubkey []byte `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	//
+	//The name of the channel group that was excluded from our suggestions.
+	GroupName string `protobuf:"bytes,4,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	//
+	//The reason that we excluded the channel from the our suggestions.
+	Reason AutoReason `protobuf:"varint,2,opt,name=reason,proto3,enum=looprpc.AutoReason" json:"reason,omitempty"`
+}
+
+func (x *Disqualified) Reset() {
+	*x = Disqualified{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Disqualified) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Disqualified) ProtoMessage() {}
+
+func (x *Disqualified) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Disqualified.ProtoReflect.Descriptor instead.
+func (*Disqualified) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Disqualified) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Disqualified) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *Disqualified) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *Disqualified) GetReason() AutoReason {
+	if x != nil {
+		return x.Reason
+	}
+	return AutoReason_AUTO_REASON_UNKNOWN
+}
+
+type SuggestSwapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The set of recommended loop outs.
+	LoopOut []*LoopOutRequest `protobuf:"bytes,1,rep,name=loop_out,json=loopOut,proto3" json:"loop_out,omitempty"`
+	//
+	//The set of recommended loop in swaps
+	LoopIn []*LoopInRequest `protobuf:"bytes,3,rep,name=loop_in,json=loopIn,proto3" json:"loop_in,omitempty"`
+	//
+	//Disqualified contains the set of channels that swaps are not recommended
+	//for.
+	Disqualified []*Disqualified `protobuf:"bytes,2,rep,name=disqualified,proto3" json:"disqualified,omitempty"`
+	//
+	//The set of recommended circular rebalances, which replace loop out
+	//suggestions that a rebalance is cheaper for.
+	Rebalances []*RebalanceSuggestion `protobuf:"bytes,4,rep,name=rebalances,proto3" json:"rebalances,omitempty"`
+	//
+	//The operational notices published by the server that are currently in
+	//effect, which may explain why swaps were not suggested.
+	ServerNotices []*ServerNotice `protobuf:"bytes,5,rep,name=server_notices,json=serverNotices,proto3" json:"server_notices,omitempty"`
+	//
+	//The priority scores of the suggested swaps, in the order that they were
+	//considered, if the autoloop fee budget could not cover all of them. Swaps
+	//are prioritized by score rather than amount in this case.
+	Scores []*SwapScore `protobuf:"bytes,6,rep,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (x *SuggestSwapsResponse) Reset() {
+	*x = SuggestSwapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestSwapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSwapsResponse) ProtoMessage() {}
+
+func (x *SuggestSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSwapsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SuggestSwapsResponse) GetLoopOut() []*LoopOutRequest {
+	if x != nil {
+		return x.LoopOut
+	}
+	return nil
+}
+
+func (x *SuggestSwapsResponse) GetLoopIn() []*LoopInRequest {
+	if x != nil {
+		return x.LoopIn
+	}
+	return nil
+}
+
+func (x *SuggestSwapsResponse) GetDisqualified() []*Disqualified {
+	if x != nil {
+		return x.Disqualified
+	}
+	return nil
+}
+
+func (x *SuggestSwapsResponse) GetRebalances() []*RebalanceSuggestion {
+	if x != nil {
+		return x.Rebalances
+	}
+	return nil
+}
+
+func (x *SuggestSwapsResponse) GetServerNotices() []*ServerNotice {
+	if x != nil {
+		return x.ServerNotices
+	}
+	return nil
+}
+
+func (x *SuggestSwapsResponse) GetScores() []*SwapScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type SwapScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The type of the swap.
+	Type SwapType `protobuf:"varint,1,opt,name=type,proto3,enum=looprpc.SwapType" json:"type,omitempty"`
+	//
+	//The amount of the swap, in satoshis.
+	Amt uint64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The short channel IDs of the channels that the swap was scored on. For
+	//swaps that are restricted to a peer, these are all of the channels with
+	//that peer.
+	Channels []uint64 `protobuf:"varint,3,rep,packed,name=channels,proto3" json:"channels,omitempty"`
+	//
+	//The total amount that has been sent and received over the swap's
+	//channels, in satoshis.
+	VolumeSat uint64 `protobuf:"varint,4,opt,name=volume_sat,json=volumeSat,proto3" json:"volume_sat,omitempty"`
+	//
+	//The total capacity of the swap's channels, in satoshis.
+	CapacitySat uint64 `protobuf:"varint,5,opt,name=capacity_sat,json=capacitySat,proto3" json:"capacity_sat,omitempty"`
+	//
+	//The amount that the swap shifts per satoshi of the maximum fees that it
+	//may pay.
+	Efficiency float64 `protobuf:"fixed64,6,opt,name=efficiency,proto3" json:"efficiency,omitempty"`
+	//
+	//The swap's priority, between 0 and 1. The swap's volume, capacity and
+	//efficiency are each scored relative to the highest value among the
+	//suggestions, and weighted equally.
+	Score float64 `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	//
+	//Whether the swap was suggested.
+	Selected bool `protobuf:"varint,8,opt,name=selected,proto3" json:"selected,omitempty"`
+}
+
+func (x *SwapScore) Reset() {
+	*x = SwapScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapScore) ProtoMessage() {}
+
+func (x *SwapScore) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapScore.ProtoReflect.Descriptor instead.
+func (*SwapScore) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SwapScore) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_LOOP_OUT
+}
+
+func (x *SwapScore) GetAmt() uint64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *SwapScore) GetChannels() []uint64 {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *SwapScore) GetVolumeSat() uint64 {
+	if x != nil {
+		return x.VolumeSat
+	}
+	return 0
+}
+
+func (x *SwapScore) GetCapacitySat() uint64 {
+	if x != nil {
+		return x.CapacitySat
+	}
+	return 0
+}
+
+func (x *SwapScore) GetEfficiency() float64 {
+	if x != nil {
+		return x.Efficiency
+	}
+	return 0
+}
+
+func (x *SwapScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SwapScore) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+type RebalanceSuggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The amount to shift between the channels, in satoshis.
+	Amt uint64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The short channel ID of the channel that the rebalance pays out of.
+	OutgoingChanId uint64 `protobuf:"varint,2,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	//
+	//The short channel ID of the channel that the rebalance pays into.
+	IncomingChanId uint64 `protobuf:"varint,3,opt,name=incoming_chan_id,json=incomingChanId,proto3" json:"incoming_chan_id,omitempty"`
+	//
+	//The maximum routing fee for the rebalance, in satoshis.
+	MaxFeeSat uint64 `protobuf:"varint,4,opt,name=max_fee_sat,json=maxFeeSat,proto3" json:"max_fee_sat,omitempty"`
+}
+
+func (x *RebalanceSuggestion) Reset() {
+	*x = RebalanceSuggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebalanceSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceSuggestion) ProtoMessage() {}
+
+func (x *RebalanceSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceSuggestion.ProtoReflect.Descriptor instead.
+func (*RebalanceSuggestion) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *RebalanceSuggestion) GetAmt() uint64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *RebalanceSuggestion) GetOutgoingChanId() uint64 {
+	if x != nil {
+		return x.OutgoingChanId
+	}
+	return 0
+}
+
+func (x *RebalanceSuggestion) GetIncomingChanId() uint64 {
+	if x != nil {
+		return x.IncomingChanId
+	}
+	return 0
+}
+
+func (x *RebalanceSuggestion) GetMaxFeeSat() uint64 {
+	if x != nil {
+		return x.MaxFeeSat
+	}
+	return 0
+}
+
+type PreviewFeesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The loop out swap amount to preview fees for, in satoshis.
+	Amt uint64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+}
+
+func (x *PreviewFeesRequest) Reset() {
+	*x = PreviewFeesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewFeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewFeesRequest) ProtoMessage() {}
+
+func (x *PreviewFeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewFeesRequest.ProtoReflect.Descriptor instead.
+func (*PreviewFeesRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *PreviewFeesRequest) GetAmt() uint64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+type PreviewFeesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The server fee quoted for the swap.
+	SwapFeeSat uint64 `protobuf:"varint,1,opt,name=swap_fee_sat,json=swapFeeSat,proto3" json:"swap_fee_sat,omitempty"`
+	//
+	//The no-show fee quoted for the swap.
+	PrepayAmtSat uint64 `protobuf:"varint,2,opt,name=prepay_amt_sat,json=prepayAmtSat,proto3" json:"prepay_amt_sat,omitempty"`
+	//
+	//The maximum miner fee that would be allowed for the swap's sweep.
+	MaxMinerFeeSat uint64 `protobuf:"varint,3,opt,name=max_miner_fee_sat,json=maxMinerFeeSat,proto3" json:"max_miner_fee_sat,omitempty"`
+	//
+	//The maximum off-chain routing fee for the swap invoice.
+	MaxSwapRoutingFeeSat uint64 `protobuf:"varint,4,opt,name=max_swap_routing_fee_sat,json=maxSwapRoutingFeeSat,proto3" json:"max_swap_routing_fee_sat,omitempty"`
+	//
+	//The maximum off-chain routing fee for the prepay invoice.
+	MaxPrepayRoutingFeeSat uint64 `protobuf:"varint,5,opt,name=max_prepay_routing_fee_sat,json=maxPrepayRoutingFeeSat,proto3" json:"max_prepay_routing_fee_sat,omitempty"`
+	//
+	//The largest amount of fees that the swap could cost, which is the amount
+	//that would be reserved from the autoloop budget.
+	WorstCaseFeeSat uint64 `protobuf:"varint,6,opt,name=worst_case_fee_sat,json=worstCaseFeeSat,proto3" json:"worst_case_fee_sat,omitempty"`
+	//
+	//Whether the quote is within the current fee limits. If it is not, only
+	//the quoted fees are set and reason describes the limit that the quote
+	//exceeds.
+	WithinLimits bool `protobuf:"varint,7,opt,name=within_limits,json=withinLimits,proto3" json:"within_limits,omitempty"`
+	//
+	//The reason that the quote is not within the current fee limits.
+	Reason AutoReason `protobuf:"varint,8,opt,name=reason,proto3,enum=looprpc.AutoReason" json:"reason,omitempty"`
+}
+
+func (x *PreviewFeesResponse) Reset() {
+	*x = PreviewFeesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewFeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewFeesResponse) ProtoMessage() {}
+
+func (x *PreviewFeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewFeesResponse.ProtoReflect.Descriptor instead.
+func (*PreviewFeesResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PreviewFeesResponse) GetSwapFeeSat() uint64 {
+	if x != nil {
+		return x.SwapFeeSat
+	}
+	return 0
+}
+
+func (x *PreviewFeesResponse) GetPrepayAmtSat() uint64 {
+	if x != nil {
+		return x.PrepayAmtSat
+	}
+	return 0
+}
+
+func (x *PreviewFeesResponse) GetMaxMinerFeeSat() uint64 {
+	if x != nil {
+		return x.MaxMinerFeeSat
+	}
+	return 0
+}
+
+func (x *PreviewFeesResponse) GetMaxSwapRoutingFeeSat() uint64 {
+	if x != nil {
+		return x.MaxSwapRoutingFeeSat
+	}
+	return 0
+}
+
+func (x *PreviewFeesResponse) GetMaxPrepayRoutingFeeSat() uint64 {
+	if x != nil {
+		return x.MaxPrepayRoutingFeeSat
+	}
+	return 0
+}
+
+func (x *PreviewFeesResponse) GetWorstCaseFeeSat() uint64 {
+	if x != nil {
+		return x.WorstCaseFeeSat
+	}
+	return 0
+}
+
+func (x *PreviewFeesResponse) GetWithinLimits() bool {
+	if x != nil {
+		return x.WithinLimits
+	}
+	return false
+}
+
+func (x *PreviewFeesResponse) GetReason() AutoReason {
+	if x != nil {
+		return x.Reason
+	}
+	return AutoReason_AUTO_REASON_UNKNOWN
+}
+
+type CompareRebalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The amount of local balance to shift out of the outgoing channel, in
+	//satoshis.
+	Amt uint64 `protobuf:"varint,1,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The short channel ID of the channel that has excess local balance.
+	OutgoingChanId uint64 `protobuf:"varint,2,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	//
+	//The short channel ID of the channel that a circular rebalance would shift
+	//the local balance into.
+	IncomingChanId uint64 `protobuf:"varint,3,opt,name=incoming_chan_id,json=incomingChanId,proto3" json:"incoming_chan_id,omitempty"`
+}
+
+func (x *CompareRebalanceRequest) Reset() {
+	*x = CompareRebalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareRebalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareRebalanceRequest) ProtoMessage() {}
+
+func (x *CompareRebalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareRebalanceRequest.ProtoReflect.Descriptor instead.
+func (*CompareRebalanceRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CompareRebalanceRequest) GetAmt() uint64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *CompareRebalanceRequest) GetOutgoingChanId() uint64 {
+	if x != nil {
+		return x.OutgoingChanId
+	}
+	return 0
+}
+
+func (x *CompareRebalanceRequest) GetIncomingChanId() uint64 {
+	if x != nil {
+		return x.IncomingChanId
+	}
+	return 0
+}
+
+type CompareRebalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Whether a route was found for a circular rebalance.
+	RebalanceAvailable bool `protobuf:"varint,1,opt,name=rebalance_available,json=rebalanceAvailable,proto3" json:"rebalance_available,omitempty"`
+	//
+	//The estimated routing fee of the circular rebalance.
+	RebalanceFeeSat uint64 `protobuf:"varint,2,opt,name=rebalance_fee_sat,json=rebalanceFeeSat,proto3" json:"rebalance_fee_sat,omitempty"`
+	//
+	//The number of hops in the route found for the circular rebalance,
+	//including the outgoing and incoming channels.
+	RebalanceHops uint32 `protobuf:"varint,3,opt,name=rebalance_hops,json=rebalanceHops,proto3" json:"rebalance_hops,omitempty"`
+	//
+	//Whether the server would accept a swap of the amount, and a route was
+	//found for its payment.
+	SwapAvailable bool `protobuf:"varint,4,opt,name=swap_available,json=swapAvailable,proto3" json:"swap_available,omitempty"`
+	//
+	//The server fee quoted for the swap.
+	SwapFeeSat uint64 `protobuf:"varint,5,opt,name=swap_fee_sat,json=swapFeeSat,proto3" json:"swap_fee_sat,omitempty"`
+	//
+	//The estimated on-chain fee for the swap's sweep.
+	SwapMinerFeeSat uint64 `protobuf:"varint,6,opt,name=swap_miner_fee_sat,json=swapMinerFeeSat,proto3" json:"swap_miner_fee_sat,omitempty"`
+	//
+	//The estimated off-chain routing fee for the swap payment.
+	SwapRoutingFeeSat uint64 `protobuf:"varint,7,opt,name=swap_routing_fee_sat,json=swapRoutingFeeSat,proto3" json:"swap_routing_fee_sat,omitempty"`
+	//
+	//The total estimated cost of the swap.
+	SwapCostSat uint64 `protobuf:"varint,8,opt,name=swap_cost_sat,json=swapCostSat,proto3" json:"swap_cost_sat,omitempty"`
+	//
+	//The cheaper of the methods that are available.
+	Preferred RebalanceMethod `protobuf:"varint,9,opt,name=preferred,proto3,enum=looprpc.RebalanceMethod" json:"preferred,omitempty"`
+}
+
+func (x *CompareRebalanceResponse) Reset() {
+	*x = CompareRebalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareRebalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareRebalanceResponse) ProtoMessage() {}
+
+func (x *CompareRebalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareRebalanceResponse.ProtoReflect.Descriptor instead.
+func (*CompareRebalanceResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CompareRebalanceResponse) GetRebalanceAvailable() bool {
+	if x != nil {
+		return x.RebalanceAvailable
+	}
+	return false
+}
+
+func (x *CompareRebalanceResponse) GetRebalanceFeeSat() uint64 {
+	if x != nil {
+		return x.RebalanceFeeSat
+	}
+	return 0
+}
+
+func (x *CompareRebalanceResponse) GetRebalanceHops() uint32 {
+	if x != nil {
+		return x.RebalanceHops
+	}
+	return 0
+}
+
+func (x *CompareRebalanceResponse) GetSwapAvailable() bool {
+	if x != nil {
+		return x.SwapAvailable
+	}
+	return false
+}
+
+func (x *CompareRebalanceResponse) GetSwapFeeSat() uint64 {
+	if x != nil {
+		return x.SwapFeeSat
+	}
+	return 0
+}
+
+func (x *CompareRebalanceResponse) GetSwapMinerFeeSat() uint64 {
+	if x != nil {
+		return x.SwapMinerFeeSat
+	}
+	return 0
+}
+
+func (x *CompareRebalanceResponse) GetSwapRoutingFeeSat() uint64 {
+	if x != nil {
+		return x.SwapRoutingFeeSat
+	}
+	return 0
+}
+
+func (x *CompareRebalanceResponse) GetSwapCostSat() uint64 {
+	if x != nil {
+		return x.SwapCostSat
+	}
+	return 0
+}
+
+func (x *CompareRebalanceResponse) GetPreferred() RebalanceMethod {
+	if x != nil {
+		return x.Preferred
+	}
+	return RebalanceMethod_REBALANCE_METHOD_NONE
+}
+
+type CloseAdviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The short channel ID of the channel that is to be closed.
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *CloseAdviceRequest) Reset() {
+	*x = CloseAdviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseAdviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAdviceRequest) ProtoMessage() {}
+
+func (x *CloseAdviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAdviceRequest.ProtoReflect.Descriptor instead.
+func (*CloseAdviceRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CloseAdviceRequest) GetChannelId() uint64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+type CloseAdviceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Whether we recommend waiting for the swaps in flight that involve the
+	//channel to complete before closing it. Closing the channel while a swap
+	//depends on it may cause the swap to fail after its fees have been paid.
+	Wait bool `protobuf:"varint,1,opt,name=wait,proto3" json:"wait,omitempty"`
+	//
+	//The pending swaps that involve the channel. Loop outs are included if they
+	//may use the channel, and loop ins if their last hop is the channel's peer.
+	InFlight []*SwapStatus `protobuf:"bytes,2,rep,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	//
+	//The estimated fees that have already been committed to the swaps in
+	//flight, including loop out prepays, which may be lost if the channel is
+	//closed before the swaps complete.
+	SunkCostSat uint64 `protobuf:"varint,3,opt,name=sunk_cost_sat,json=sunkCostSat,proto3" json:"sunk_cost_sat,omitempty"`
+	//
+	//The loop outs that the liquidity manager's current rules suggest for the
+	//channel.
+	SuggestedLoopOut []*LoopOutRequest `protobuf:"bytes,4,rep,name=suggested_loop_out,json=suggestedLoopOut,proto3" json:"suggested_loop_out,omitempty"`
+	//
+	//The loop ins that the liquidity manager's current rules suggest for the
+	//channel's peer.
+	SuggestedLoopIn []*LoopInRequest `protobuf:"bytes,5,rep,name=suggested_loop_in,json=suggestedLoopIn,proto3" json:"suggested_loop_in,omitempty"`
+}
+
+func (x *CloseAdviceResponse) Reset() {
+	*x = CloseAdviceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseAdviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAdviceResponse) ProtoMessage() {}
+
+func (x *CloseAdviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAdviceResponse.ProtoReflect.Descriptor instead.
+func (*CloseAdviceResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CloseAdviceResponse) GetWait() bool {
+	if x != nil {
+		return x.Wait
+	}
+	return false
+}
+
+func (x *CloseAdviceResponse) GetInFlight() []*SwapStatus {
+	if x != nil {
+		return x.InFlight
+	}
+	return nil
+}
+
+func (x *CloseAdviceResponse) GetSunkCostSat() uint64 {
+	if x != nil {
+		return x.SunkCostSat
+	}
+	return 0
+}
+
+func (x *CloseAdviceResponse) GetSuggestedLoopOut() []*LoopOutRequest {
+	if x != nil {
+		return x.SuggestedLoopOut
+	}
+	return nil
+}
+
+func (x *CloseAdviceResponse) GetSuggestedLoopIn() []*LoopInRequest {
+	if x != nil {
+		return x.SuggestedLoopIn
+	}
+	return nil
+}
+
+type AutoloopStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The maximum number of ticks to return, starting with the most recent. If
+	//this value is zero, all stored ticks are returned.
+	MaxTicks uint32 `protobuf:"varint,1,opt,name=max_ticks,json=maxTicks,proto3" json:"max_ticks,omitempty"`
+}
+
+func (x *AutoloopStatsRequest) Reset() {
+	*x = AutoloopStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoloopStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoloopStatsRequest) ProtoMessage() {}
+
+func (x *AutoloopStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoloopStatsRequest.ProtoReflect.Descriptor instead.
+func (*AutoloopStatsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AutoloopStatsRequest) GetMaxTicks() uint32 {
+	if x != nil {
+		return x.MaxTicks
+	}
+	return 0
+}
+
+type AutoloopStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The stored autoloop ticks, most recent first.
+	Ticks []*AutoloopTick `protobuf:"bytes,1,rep,name=ticks,proto3" json:"ticks,omitempty"`
+	// The total number of stored ticks that our aggregates cover.
+	TickCount uint32 `protobuf:"varint,2,opt,name=tick_count,json=tickCount,proto3" json:"tick_count,omitempty"`
+	// The number of stored ticks that failed.
+	FailedTicks uint32 `protobuf:"varint,3,opt,name=failed_ticks,json=failedTicks,proto3" json:"failed_ticks,omitempty"`
+	// The mean duration of the stored ticks in milliseconds.
+	MeanDurationMs uint64 `protobuf:"varint,4,opt,name=mean_duration_ms,json=meanDurationMs,proto3" json:"mean_duration_ms,omitempty"`
+	// The longest duration of the stored ticks in milliseconds.
+	MaxDurationMs uint64 `protobuf:"varint,5,opt,name=max_duration_ms,json=maxDurationMs,proto3" json:"max_duration_ms,omitempty"`
+}
+
+func (x *AutoloopStatsResponse) Reset() {
+	*x = AutoloopStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoloopStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoloopStatsResponse) ProtoMessage() {}
+
+func (x *AutoloopStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoloopStatsResponse.ProtoReflect.Descriptor instead.
+func (*AutoloopStatsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AutoloopStatsResponse) GetTicks() []*AutoloopTick {
+	if x != nil {
+		return x.Ticks
+	}
+	return nil
+}
+
+func (x *AutoloopStatsResponse) GetTickCount() uint32 {
+	if x != nil {
+		return x.TickCount
+	}
+	return 0
+}
+
+func (x *AutoloopStatsResponse) GetFailedTicks() uint32 {
+	if x != nil {
+		return x.FailedTicks
+	}
+	return 0
+}
+
+func (x *AutoloopStatsResponse) GetMeanDurationMs() uint64 {
+	if x != nil {
+		return x.MeanDurationMs
+	}
+	return 0
+}
+
+func (x *AutoloopStatsResponse) GetMaxDurationMs() uint64 {
+	if x != nil {
+		return x.MaxDurationMs
+	}
+	return 0
+}
+
+type AutoloopTick struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix timestamp in seconds at which the tick started.
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The duration of the tick in milliseconds.
+	DurationMs uint64 `protobuf:"varint,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// The number of channels that the tick evaluated.
+	ChannelsEvaluated uint32 `protobuf:"varint,3,opt,name=channels_evaluated,json=channelsEvaluated,proto3" json:"channels_evaluated,omitempty"`
+	// The number of swap quotes that the tick fetched from the server.
+	QuotesFetched uint32 `protobuf:"varint,4,opt,name=quotes_fetched,json=quotesFetched,proto3" json:"quotes_fetched,omitempty"`
+	// The number of swaps and circular rebalances that the tick dispatched.
+	SwapsDispatched uint32 `protobuf:"varint,5,opt,name=swaps_dispatched,json=swapsDispatched,proto3" json:"swaps_dispatched,omitempty"`
+	// The error that the tick failed with, empty if it succeeded.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *AutoloopTick) Reset() {
+	*x = AutoloopTick{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoloopTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoloopTick) ProtoMessage() {}
+
+func (x *AutoloopTick) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoloopTick.ProtoReflect.Descriptor instead.
+func (*AutoloopTick) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *AutoloopTick) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *AutoloopTick) GetDurationMs() uint64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *AutoloopTick) GetChannelsEvaluated() uint32 {
+	if x != nil {
+		return x.ChannelsEvaluated
+	}
+	return 0
+}
+
+func (x *AutoloopTick) GetQuotesFetched() uint32 {
+	if x != nil {
+		return x.QuotesFetched
+	}
+	return 0
+}
+
+func (x *AutoloopTick) GetSwapsDispatched() uint32 {
+	if x != nil {
+		return x.SwapsDispatched
+	}
+	return 0
+}
+
+func (x *AutoloopTick) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SuggestionHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unix timestamp in seconds from which to return suggestion rounds,
+	//inclusive. If this value is zero, rounds are returned from the oldest
+	//stored round.
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	//
+	//The unix timestamp in seconds until which to return suggestion rounds,
+	//exclusive. If this value is zero, rounds are returned up to the most
+	//recent stored round.
+	EndTime int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	//
+	//The maximum number of rounds to return, starting with the most recent. If
+	//this value is zero, all rounds in the time range are returned.
+	MaxRounds uint32 `protobuf:"varint,3,opt,name=max_rounds,json=maxRounds,proto3" json:"max_rounds,omitempty"`
+}
+
+func (x *SuggestionHistoryRequest) Reset() {
+	*x = SuggestionHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestionHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestionHistoryRequest) ProtoMessage() {}
+
+func (x *SuggestionHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*SuggestionHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *SuggestionHistoryRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SuggestionHistoryRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *SuggestionHistoryRequest) GetMaxRounds() uint32 {
+	if x != nil {
+		return x.MaxRounds
+	}
+	return 0
+}
+
+type SuggestionHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The suggestion rounds that match the request, most recent first.
+	Rounds []*SuggestionRound `protobuf:"bytes,1,rep,name=rounds,proto3" json:"rounds,omitempty"`
+}
+
+func (x *SuggestionHistoryResponse) Reset() {
+	*x = SuggestionHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestionHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestionHistoryResponse) ProtoMessage() {}
+
+func (x *SuggestionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*SuggestionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SuggestionHistoryResponse) GetRounds() []*SuggestionRound {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+type SuggestionRound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix timestamp in seconds at which the round's tick started.
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	//
+	//Whether autoloop was allowed to dispatch swaps in this round. If false,
+	//the round's suggestions were only logged, because autoloop is disabled or
+	//dispatch was deferred by the autoloop schedule or chain fee ceiling.
+	Dispatch bool `protobuf:"varint,2,opt,name=dispatch,proto3" json:"dispatch,omitempty"`
+	// The loop outs that were suggested.
+	LoopOut []*SuggestedSwap `protobuf:"bytes,3,rep,name=loop_out,json=loopOut,proto3" json:"loop_out,omitempty"`
+	// The loop ins that were suggested.
+	LoopIn []*SuggestedSwap `protobuf:"bytes,4,rep,name=loop_in,json=loopIn,proto3" json:"loop_in,omitempty"`
+	// The circular rebalances that were suggested.
+	Rebalances []*SuggestedSwap `protobuf:"bytes,5,rep,name=rebalances,proto3" json:"rebalances,omitempty"`
+	// The channels, peers and groups that swaps were not suggested for.
+	Disqualified []*Disqualified `protobuf:"bytes,6,rep,name=disqualified,proto3" json:"disqualified,omitempty"`
+	// The error that the round failed with, empty if it succeeded.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SuggestionRound) Reset() {
+	*x = SuggestionRound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestionRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestionRound) ProtoMessage() {}
+
+func (x *SuggestionRound) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestionRound.ProtoReflect.Descriptor instead.
+func (*SuggestionRound) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SuggestionRound) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SuggestionRound) GetDispatch() bool {
+	if x != nil {
+		return x.Dispatch
+	}
+	return false
+}
+
+func (x *SuggestionRound) GetLoopOut() []*SuggestedSwap {
+	if x != nil {
+		return x.LoopOut
+	}
+	return nil
+}
+
+func (x *SuggestionRound) GetLoopIn() []*SuggestedSwap {
+	if x != nil {
+		return x.LoopIn
+	}
+	return nil
+}
+
+func (x *SuggestionRound) GetRebalances() []*SuggestedSwap {
+	if x != nil {
+		return x.Rebalances
+	}
+	return nil
+}
+
+func (x *SuggestionRound) GetDisqualified() []*Disqualified {
+	if x != nil {
+		return x.Disqualified
+	}
+	return nil
+}
+
+func (x *SuggestionRound) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SuggestedSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The short channel IDs of the channels that the swap was restricted to.
+	//For rebalances, this contains the outgoing channel followed by the
+	//incoming channel.
+	Channels []uint64 `protobuf:"varint,1,rep,packed,name=channels,proto3" json:"channels,omitempty"`
+	// The peer that a loop in or rebalance was restricted to, if any.
+	LastHop []byte `protobuf:"bytes,2,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
+	// The amount of the swap in satoshis.
+	Amt int64 `protobuf:"varint,3,opt,name=amt,proto3" json:"amt,omitempty"`
+	//
+	//The maximum fees that the swap could pay in satoshis, based on the quote
+	//that was received from the server.
+	MaxFees int64 `protobuf:"varint,4,opt,name=max_fees,json=maxFees,proto3" json:"max_fees,omitempty"`
+	// Whether autoloop dispatched the swap.
+	Dispatched bool `protobuf:"varint,5,opt,name=dispatched,proto3" json:"dispatched,omitempty"`
+}
+
+func (x *SuggestedSwap) Reset() {
+	*x = SuggestedSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestedSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestedSwap) ProtoMessage() {}
+
+func (x *SuggestedSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestedSwap.ProtoReflect.Descriptor instead.
+func (*SuggestedSwap) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SuggestedSwap) GetChannels() []uint64 {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *SuggestedSwap) GetLastHop() []byte {
+	if x != nil {
+		return x.LastHop
+	}
+	return nil
+}
+
+func (x *SuggestedSwap) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *SuggestedSwap) GetMaxFees() int64 {
+	if x != nil {
+		return x.MaxFees
+	}
+	return 0
+}
+
+func (x *SuggestedSwap) GetDispatched() bool {
+	if x != nil {
+		return x.Dispatched
+	}
+	return false
+}
+
+type SimulatedSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unix timestamp in seconds from which to return simulated swaps,
+	//inclusive. If this value is zero, swaps are returned from the oldest
+	//stored swap.
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	//
+	//The unix timestamp in seconds until which to return simulated swaps,
+	//exclusive. If this value is zero, swaps are returned up to the most recent
+	//stored swap.
+	EndTime int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *SimulatedSwapsRequest) Reset() {
+	*x = SimulatedSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulatedSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedSwapsRequest) ProtoMessage() {}
+
+func (x *SimulatedSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedSwapsRequest.ProtoReflect.Descriptor instead.
+func (*SimulatedSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SimulatedSwapsRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SimulatedSwapsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type SimulatedSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unix timestamp in seconds at which the swap was simulated.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The type of swap that was simulated.
+	Type SimulatedSwapType `protobuf:"varint,2,opt,name=type,proto3,enum=looprpc.SimulatedSwapType" json:"type,omitempty"`
+	//
+	//The short channel IDs of the channels that the swap was restricted to.
+	//For rebalances, this contains the outgoing channel followed by the
+	//incoming channel.
+	Channels []uint64 `protobuf:"varint,3,rep,packed,name=channels,proto3" json:"channels,omitempty"`
+	// The peer that a loop in or rebalance was restricted to, if any.
+	LastHop []byte `protobuf:"bytes,4,opt,name=last_hop,json=lastHop,proto3" json:"last_hop,omitempty"`
+	// The amount of the swap in satoshis.
+	Amt int64 `protobuf:"varint,5,opt,name=amt,proto3" json:"amt,omitempty"`
+	// The swap fee that the server quoted in satoshis.
+	SwapFee int64 `protobuf:"varint,6,opt,name=swap_fee,json=swapFee,proto3" json:"swap_fee,omitempty"`
+	// The maximum on-chain fee that the swap would have paid in satoshis.
+	MinerFee int64 `protobuf:"varint,7,opt,name=miner_fee,json=minerFee,proto3" json:"miner_fee,omitempty"`
+	//
+	//The maximum off-chain routing fee that the swap would have paid in
+	//satoshis.
+	RoutingFee int64 `protobuf:"varint,8,opt,name=routing_fee,json=routingFee,proto3" json:"routing_fee,omitempty"`
+}
+
+func (x *SimulatedSwap) Reset() {
+	*x = SimulatedSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulatedSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedSwap) ProtoMessage() {}
+
+func (x *SimulatedSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedSwap.ProtoReflect.Descriptor instead.
+func (*SimulatedSwap) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SimulatedSwap) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *SimulatedSwap) GetType() SimulatedSwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SimulatedSwapType_SIMULATED_LOOP_OUT
+}
+
+func (x *SimulatedSwap) GetChannels() []uint64 {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *SimulatedSwap) GetLastHop() []byte {
+	if x != nil {
+		return x.LastHop
+	}
+	return nil
+}
+
+func (x *SimulatedSwap) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *SimulatedSwap) GetSwapFee() int64 {
+	if x != nil {
+		return x.SwapFee
+	}
+	return 0
+}
+
+func (x *SimulatedSwap) GetMinerFee() int64 {
+	if x != nil {
+		return x.MinerFee
+	}
+	return 0
+}
+
+func (x *SimulatedSwap) GetRoutingFee() int64 {
+	if x != nil {
+		return x.RoutingFee
+	}
+	return 0
+}
+
+type SimulationTotals struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of swaps that were simulated.
+	Count uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// The total amount of the swaps in satoshis.
+	Amt int64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
+	// The total projected cost of the swaps in satoshis.
+	Cost int64 `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (x *SimulationTotals) Reset() {
+	*x = SimulationTotals{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulationTotals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationTotals) ProtoMessage() {}
+
+func (x *SimulationTotals) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationTotals.ProtoReflect.Descriptor instead.
+func (*SimulationTotals) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SimulationTotals) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SimulationTotals) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+func (x *SimulationTotals) GetCost() int64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type SimulatedSwapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The simulated swaps that match the request, most recent first.
+	Swaps []*SimulatedSwap `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+	// The totals of the loop outs that were simulated.
+	LoopOut *SimulationTotals `protobuf:"bytes,2,opt,name=loop_out,json=loopOut,proto3" json:"loop_out,omitempty"`
+	// The totals of the loop ins that were simulated.
+	LoopIn *SimulationTotals `protobuf:"bytes,3,opt,name=loop_in,json=loopIn,proto3" json:"loop_in,omitempty"`
+	// The totals of the circular rebalances that were simulated.
+	Rebalance *SimulationTotals `protobuf:"bytes,4,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+}
+
+func (x *SimulatedSwapsResponse) Reset() {
+	*x = SimulatedSwapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulatedSwapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedSwapsResponse) ProtoMessage() {}
+
+func (x *SimulatedSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedSwapsResponse.ProtoReflect.Descriptor instead.
+func (*SimulatedSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SimulatedSwapsResponse) GetSwaps() []*SimulatedSwap {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+func (x *SimulatedSwapsResponse) GetLoopOut() *SimulationTotals {
+	if x != nil {
+		return x.LoopOut
+	}
+	return nil
+}
+
+func (x *SimulatedSwapsResponse) GetLoopIn() *SimulationTotals {
+	if x != nil {
+		return x.LoopIn
+	}
+	return nil
+}
+
+func (x *SimulatedSwapsResponse) GetRebalance() *SimulationTotals {
+	if x != nil {
+		return x.Rebalance
+	}
+	return nil
+}
+
+type ClearSimulatedSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearSimulatedSwapsRequest) Reset() {
+	*x = ClearSimulatedSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearSimulatedSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearSimulatedSwapsRequest) ProtoMessage() {}
+
+func (x *ClearSimulatedSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearSimulatedSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ClearSimulatedSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{101}
+}
+
+type ClearSimulatedSwapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClearSimulatedSwapsResponse) Reset() {
+	*x = ClearSimulatedSwapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearSimulatedSwapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearSimulatedSwapsResponse) ProtoMessage() {}
+
+func (x *ClearSimulatedSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearSimulatedSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ClearSimulatedSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{102}
+}
+
+type AutoloopCalendarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The number of seconds from now that the calendar should cover. If this
+	//value is zero, the calendar covers the next seven days. The calendar may
+	//cover at most 31 days.
+	HorizonSec uint64 `protobuf:"varint,1,opt,name=horizon_sec,json=horizonSec,proto3" json:"horizon_sec,omitempty"`
+	// Whether to also return the calendar in iCalendar format.
+	Ical bool `protobuf:"varint,2,opt,name=ical,proto3" json:"ical,omitempty"`
+}
+
+func (x *AutoloopCalendarRequest) Reset() {
+	*x = AutoloopCalendarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoloopCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoloopCalendarRequest) ProtoMessage() {}
+
+func (x *AutoloopCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoloopCalendarRequest.ProtoReflect.Descriptor instead.
+func (*AutoloopCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *AutoloopCalendarRequest) GetHorizonSec() uint64 {
+	if x != nil {
+		return x.HorizonSec
+	}
+	return 0
+}
+
+func (x *AutoloopCalendarRequest) GetIcal() bool {
+	if x != nil {
+		return x.Ical
+	}
+	return false
+}
+
+type CalendarEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of the event.
+	Type CalendarEventType `protobuf:"varint,1,opt,name=type,proto3,enum=looprpc.CalendarEventType" json:"type,omitempty"`
+	// The unix timestamp in seconds at which the event starts.
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	//
+	//The unix timestamp in seconds at which the event ends, which is equal to
+	//its start time for events that happen at a single point in time.
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// A human readable description of the event.
+	Summary string `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	// The amount of the swap that the event is for in satoshis, if any.
+	Amt int64 `protobuf:"varint,5,opt,name=amt,proto3" json:"amt,omitempty"`
+}
+
+func (x *CalendarEvent) Reset() {
+	*x = CalendarEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarEvent) ProtoMessage() {}
+
+func (x *CalendarEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarEvent.ProtoReflect.Descriptor instead.
+func (*CalendarEvent) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *CalendarEvent) GetType() CalendarEventType {
+	if x != nil {
+		return x.Type
+	}
+	return CalendarEventType_DISPATCH_WINDOW
+}
+
+func (x *CalendarEvent) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CalendarEvent) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *CalendarEvent) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *CalendarEvent) GetAmt() int64 {
+	if x != nil {
+		return x.Amt
+	}
+	return 0
+}
+
+type AutoloopCalendarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The events in the calendar, ordered by start time.
+	Events []*CalendarEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	//
+	//The calendar in iCalendar format, which is only set if it was requested.
+	Ical string `protobuf:"bytes,2,opt,name=ical,proto3" json:"ical,omitempty"`
+}
+
+func (x *AutoloopCalendarResponse) Reset() {
+	*x = AutoloopCalendarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoloopCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoloopCalendarResponse) ProtoMessage() {}
+
+func (x *AutoloopCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoloopCalendarResponse.ProtoReflect.Descriptor instead.
+func (*AutoloopCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AutoloopCalendarResponse) GetEvents() []*CalendarEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *AutoloopCalendarResponse) GetIcal() string {
+	if x != nil {
+		return x.Ical
+	}
+	return ""
+}
+
+type ListApprovalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListApprovalsRequest) Reset() {
+	*x = ListApprovalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalsRequest) ProtoMessage() {}
+
+func (x *ListApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{106}
+}
+
+type ListApprovalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The swaps that are waiting for approval, oldest first.
+	Approvals []*PendingApproval `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+}
+
+func (x *ListApprovalsResponse) Reset() {
+	*x = ListApprovalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalsResponse) ProtoMessage() {}
+
+func (x *ListApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListApprovalsResponse) GetApprovals() []*PendingApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+type PendingApproval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the pending approval.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The unix timestamp in seconds at which the swap was queued.
+	CreatedTime int64 `protobuf:"varint,2,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	//
+	//The unix timestamp in seconds after which the swap can no longer be
+	//approved. This value is zero if the approval does not expire.
+	ExpiryTime int64 `protobuf:"varint,3,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	// The loop out that is waiting for approval, if any.
+	LoopOut *LoopOutRequest `protobuf:"bytes,4,opt,name=loop_out,json=loopOut,proto3" json:"loop_out,omitempty"`
+	// The loop in that is waiting for approval, if any.
+	LoopIn *LoopInRequest `protobuf:"bytes,5,opt,name=loop_in,json=loopIn,proto3" json:"loop_in,omitempty"`
+	// The circular rebalance that is waiting for approval, if any.
+	Rebalance *RebalanceSuggestion `protobuf:"bytes,6,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+}
+
+func (x *PendingApproval) Reset() {
+	*x = PendingApproval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingApproval) ProtoMessage() {}
+
+func (x *PendingApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingApproval.ProtoReflect.Descriptor instead.
+func (*PendingApproval) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *PendingApproval) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PendingApproval) GetCreatedTime() int64 {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return 0
+}
+
+func (x *PendingApproval) GetExpiryTime() int64 {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return 0
+}
+
+func (x *PendingApproval) GetLoopOut() *LoopOutRequest {
+	if x != nil {
+		return x.LoopOut
+	}
+	return nil
+}
+
+func (x *PendingApproval) GetLoopIn() *LoopInRequest {
+	if x != nil {
+		return x.LoopIn
+	}
+	return nil
+}
+
+func (x *PendingApproval) GetRebalance() *RebalanceSuggestion {
+	if x != nil {
+		return x.Rebalance
+	}
+	return nil
+}
+
+type ApproveSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the pending approval to dispatch.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ApproveSwapRequest) Reset() {
+	*x = ApproveSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveSwapRequest) ProtoMessage() {}
+
+func (x *ApproveSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveSwapRequest.ProtoReflect.Descriptor instead.
+func (*ApproveSwapRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ApproveSwapRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ApproveSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hash of the swap that was dispatched.
+	IdBytes []byte `protobuf:"bytes,1,opt,name=id_bytes,json=idBytes,proto3" json:"id_bytes,omitempty"`
+}
+
+func (x *ApproveSwapResponse) Reset() {
+	*x = ApproveSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveSwapResponse) ProtoMessage() {}
+
+func (x *ApproveSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveSwapResponse.ProtoReflect.Descriptor instead.
+func (*ApproveSwapResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ApproveSwapResponse) GetIdBytes() []byte {
+	if x != nil {
+		return x.IdBytes
+	}
+	return nil
+}
+
+type RejectSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the pending approval to reject.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RejectSwapRequest) Reset() {
+	*x = RejectSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectSwapRequest) ProtoMessage() {}
+
+func (x *RejectSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectSwapRequest.ProtoReflect.Descriptor instead.
+func (*RejectSwapRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *RejectSwapRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RejectSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RejectSwapResponse) Reset() {
+	*x = RejectSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectSwapResponse) ProtoMessage() {}
+
+func (x *RejectSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectSwapResponse.ProtoReflect.Descriptor instead.
+func (*RejectSwapResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{112}
+}
+
+type ErrorDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The code classifying the failure.
+	Code ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=looprpc.ErrorCode" json:"code,omitempty"`
+	//
+	//A human readable description of the failure, equal to the gRPC status
+	//message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ErrorDetail) Reset() {
+	*x = ErrorDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetail) ProtoMessage() {}
+
+func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorDetail.ProtoReflect.Descriptor instead.
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ErrorDetail) GetCode() ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ErrorCode_ERROR_CODE_UNKNOWN
+}
+
+func (x *ErrorDetail) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DebugLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//If set, the subsystems that log levels can be set for are returned and
+	//no log levels are changed.
+	Show bool `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
+	//
+	//The log levels to set, formatted as a level for all subsystems, or
+	//<subsystem>=<level> pairs separated by commas, for example
+	//"info,LOOP=debug".
+	LevelSpec string `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
+}
+
+func (x *DebugLevelRequest) Reset() {
+	*x = DebugLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugLevelRequest) ProtoMessage() {}
+
+func (x *DebugLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugLevelRequest.ProtoReflect.Descriptor instead.
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *DebugLevelRequest) GetShow() bool {
+	if x != nil {
+		return x.Show
+	}
+	return false
+}
+
+func (x *DebugLevelRequest) GetLevelSpec() string {
+	if x != nil {
+		return x.LevelSpec
+	}
+	return ""
+}
+
+type DebugLevelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The subsystems that log levels can be set for, only set if show was set
+	//on the request.
+	SubSystems []string `protobuf:"bytes,1,rep,name=sub_systems,json=subSystems,proto3" json:"sub_systems,omitempty"`
+}
+
+func (x *DebugLevelResponse) Reset() {
+	*x = DebugLevelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugLevelResponse) ProtoMessage() {}
+
+func (x *DebugLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugLevelResponse.ProtoReflect.Descriptor instead.
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *DebugLevelResponse) GetSubSystems() []string {
+	if x != nil {
+		return x.SubSystems
+	}
+	return nil
+}
+
+type ReplaySwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swap hash of the swap to replay.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReplaySwapRequest) Reset() {
+	*x = ReplaySwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaySwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaySwapRequest) ProtoMessage() {}
+
+func (x *ReplaySwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaySwapRequest.ProtoReflect.Descriptor instead.
+func (*ReplaySwapRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ReplaySwapRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type ReplayEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The unix timestamp in nanoseconds at which the event occurred, or zero if
+	//it is not known.
+	TimestampNs int64 `protobuf:"varint,1,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+	//
+	//The block height at which the event occurred, or zero if it is not known.
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	//
+	//The record that the event was reconstructed from.
+	Source ReplayEventSource `protobuf:"varint,3,opt,name=source,proto3,enum=looprpc.ReplayEventSource" json:"source,omitempty"`
+	//
+	//A human readable description of the event.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	//
+	//The txid of the transaction that the event relates to, if any.
+	Txid string `protobuf:"bytes,5,opt,name=txid,proto3" json:"txid,omitempty"`
+	//
+	//Set if the event is a failure, such as a failed payment attempt or the
+	//swap reaching a failure state.
+	Failure bool `protobuf:"varint,6,opt,name=failure,proto3" json:"failure,omitempty"`
+}
+
+func (x *ReplayEvent) Reset() {
+	*x = ReplayEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayEvent) ProtoMessage() {}
+
+func (x *ReplayEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayEvent.ProtoReflect.Descriptor instead.
+func (*ReplayEvent) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ReplayEvent) GetTimestampNs() int64 {
+	if x != nil {
+		return x.TimestampNs
+	}
+	return 0
+}
+
+func (x *ReplayEvent) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ReplayEvent) GetSource() ReplayEventSource {
+	if x != nil {
+		return x.Source
+	}
+	return ReplayEventSource_REPLAY_SOURCE_STORE
+}
+
+func (x *ReplayEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ReplayEvent) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *ReplayEvent) GetFailure() bool {
+	if x != nil {
+		return x.Failure
+	}
+	return false
+}
+
+type ReplaySwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swap's contract and final state, as recorded in the swap store.
+	Swap *SwapStatus `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
+	//
+	//The block height that lnd was at when the swap was replayed.
+	CurrentHeight uint32 `protobuf:"varint,2,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	//
+	//The events in the swap's timeline, ordered by the time at which they
+	//occurred. Events for which only a block height is known are ordered by
+	//their height.
+	Events []*ReplayEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ReplaySwapResponse) Reset() {
+	*x = ReplaySwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaySwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaySwapResponse) ProtoMessage() {}
+
+func (x *ReplaySwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaySwapResponse.ProtoReflect.Descriptor instead.
+func (*ReplaySwapResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ReplaySwapResponse) GetSwap() *SwapStatus {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+func (x *ReplaySwapResponse) GetCurrentHeight() uint32 {
+	if x != nil {
+		return x.CurrentHeight
+	}
+	return 0
+}
+
+func (x *ReplaySwapResponse) GetEvents() []*ReplayEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type SwapStateGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swap hash of the swap to export the state graph of. If it is not set,
+	//the state machine of swap_type is exported.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The type of swap to export the state machine of. It is ignored if a swap
+	//is specified.
+	SwapType SwapType `protobuf:"varint,2,opt,name=swap_type,json=swapType,proto3,enum=looprpc.SwapType" json:"swap_type,omitempty"`
+	//
+	//The format to render the state graph in.
+	Format StateGraphFormat `protobuf:"varint,3,opt,name=format,proto3,enum=looprpc.StateGraphFormat" json:"format,omitempty"`
+}
+
+func (x *SwapStateGraphRequest) Reset() {
+	*x = SwapStateGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapStateGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapStateGraphRequest) ProtoMessage() {}
+
+func (x *SwapStateGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapStateGraphRequest.ProtoReflect.Descriptor instead.
+func (*SwapStateGraphRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SwapStateGraphRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *SwapStateGraphRequest) GetSwapType() SwapType {
+	if x != nil {
+		return x.SwapType
+	}
+	return SwapType_LOOP_OUT
+}
+
+func (x *SwapStateGraphRequest) GetFormat() StateGraphFormat {
+	if x != nil {
+		return x.Format
+	}
+	return StateGraphFormat_STATE_GRAPH_FORMAT_DOT
+}
+
+type StateTransition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The state that the transition starts at.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	//
+	//The state that the transition ends at.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *StateTransition) Reset() {
+	*x = StateTransition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateTransition) ProtoMessage() {}
+
+func (x *StateTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateTransition.ProtoReflect.Descriptor instead.
+func (*StateTransition) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *StateTransition) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *StateTransition) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type SwapStateGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The type of swap that the state graph describes.
+	SwapType SwapType `protobuf:"varint,1,opt,name=swap_type,json=swapType,proto3,enum=looprpc.SwapType" json:"swap_type,omitempty"`
+	//
+	//The rendered state graph.
+	Graph string `protobuf:"bytes,2,opt,name=graph,proto3" json:"graph,omitempty"`
+	//
+	//The transitions of the swap type's state machine, along with any
+	//transitions that the swap took that are not part of it.
+	Transitions []*StateTransition `protobuf:"bytes,3,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	//
+	//The states that the swap went through, in order, ending with its current
+	//state. It is empty if no swap was specified.
+	Path []string `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
+	//
+	//The states that the swap can still reach from its current state. It is
+	//empty if no swap was specified, or the swap is in a final state.
+	Reachable []string `protobuf:"bytes,5,rep,name=reachable,proto3" json:"reachable,omitempty"`
+}
+
+func (x *SwapStateGraphResponse) Reset() {
+	*x = SwapStateGraphResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapStateGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapStateGraphResponse) ProtoMessage() {}
+
+func (x *SwapStateGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapStateGraphResponse.ProtoReflect.Descriptor instead.
+func (*SwapStateGraphResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *SwapStateGraphResponse) GetSwapType() SwapType {
+	if x != nil {
+		return x.SwapType
+	}
+	return SwapType_LOOP_OUT
+}
+
+func (x *SwapStateGraphResponse) GetGraph() string {
+	if x != nil {
+		return x.Graph
+	}
+	return ""
+}
+
+func (x *SwapStateGraphResponse) GetTransitions() []*StateTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+func (x *SwapStateGraphResponse) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *SwapStateGraphResponse) GetReachable() []string {
+	if x != nil {
+		return x.Reachable
+	}
+	return nil
+}
+
+type PostMortemsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PostMortemsRequest) Reset() {
+	*x = PostMortemsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostMortemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostMortemsRequest) ProtoMessage() {}
+
+func (x *PostMortemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostMortemsRequest.ProtoReflect.Descriptor instead.
+func (*PostMortemsRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{122}
+}
+
+type PostMortem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The swap's contract and state at the time it was flagged.
+	Swap *SwapStatus `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
+	//
+	//The unix timestamp in nanoseconds at which the swap was flagged.
+	CreatedAt int64 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	//
+	//The number of seconds that the swap had been pending for when it was
+	//flagged.
+	AgeSec uint64 `protobuf:"varint,3,opt,name=age_sec,json=ageSec,proto3" json:"age_sec,omitempty"`
+	//
+	//The maximum lifetime for the swap's type, in seconds, that it exceeded.
+	MaxLifetimeSec uint64 `protobuf:"varint,4,opt,name=max_lifetime_sec,json=maxLifetimeSec,proto3" json:"max_lifetime_sec,omitempty"`
+	//
+	//The block height that lnd was at when the swap was flagged.
+	CurrentHeight uint32 `protobuf:"varint,5,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	//
+	//The number of blocks until the swap's htlc expires, which is negative if
+	//it has already expired.
+	BlocksUntilExpiry int32 `protobuf:"varint,6,opt,name=blocks_until_expiry,json=blocksUntilExpiry,proto3" json:"blocks_until_expiry,omitempty"`
+	//
+	//The events in the swap's timeline, as returned by the ReplaySwap rpc.
+	Events []*ReplayEvent `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
+	//
+	//The most recent failures in the swap's timeline, ordered by the time at
+	//which they occurred.
+	LastErrors []*ReplayEvent `protobuf:"bytes,8,rep,name=last_errors,json=lastErrors,proto3" json:"last_errors,omitempty"`
+	//
+	//The error that the swap's timeline could not be reconstructed with. If
+	//set, the height, expiry and event fields are not populated.
+	ReplayError string `protobuf:"bytes,9,opt,name=replay_error,json=replayError,proto3" json:"replay_error,omitempty"`
+}
+
+func (x *PostMortem) Reset() {
+	*x = PostMortem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostMortem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostMortem) ProtoMessage() {}
+
+func (x *PostMortem) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostMortem.ProtoReflect.Descriptor instead.
+func (*PostMortem) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *PostMortem) GetSwap() *SwapStatus {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+func (x *PostMortem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PostMortem) GetAgeSec() uint64 {
+	if x != nil {
+		return x.AgeSec
+	}
+	return 0
+}
+
+func (x *PostMortem) GetMaxLifetimeSec() uint64 {
+	if x != nil {
+		return x.MaxLifetimeSec
+	}
+	return 0
+}
+
+func (x *PostMortem) GetCurrentHeight() uint32 {
+	if x != nil {
+		return x.CurrentHeight
+	}
+	return 0
+}
+
+func (x *PostMortem) GetBlocksUntilExpiry() int32 {
+	if x != nil {
+		return x.BlocksUntilExpiry
+	}
+	return 0
+}
+
+func (x *PostMortem) GetEvents() []*ReplayEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *PostMortem) GetLastErrors() []*ReplayEvent {
+	if x != nil {
+		return x.LastErrors
+	}
+	return nil
+}
+
+func (x *PostMortem) GetReplayError() string {
+	if x != nil {
+		return x.ReplayError
+	}
+	return ""
+}
+
+type PostMortemsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The post-mortems of the swaps that have exceeded their maximum lifetime
+	//since the daemon started, ordered by the time at which they were flagged.
+	PostMortems []*PostMortem `protobuf:"bytes,1,rep,name=post_mortems,json=postMortems,proto3" json:"post_mortems,omitempty"`
+}
+
+func (x *PostMortemsResponse) Reset() {
+	*x = PostMortemsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostMortemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostMortemsResponse) ProtoMessage() {}
+
+func (x *PostMortemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostMortemsResponse.ProtoReflect.Descriptor instead.
+func (*PostMortemsResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *PostMortemsResponse) GetPostMortems() []*PostMortem {
+	if x != nil {
+		return x.PostMortems
+	}
+	return nil
+}
+
+type ServerNoticesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//If set, notices that are scheduled for the future or that have already
+	//ended are also returned.
+	IncludeInactive bool `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+}
+
+func (x *ServerNoticesRequest) Reset() {
+	*x = ServerNoticesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerNoticesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerNoticesRequest) ProtoMessage() {}
+
+func (x *ServerNoticesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerNoticesRequest.ProtoReflect.Descriptor instead.
+func (*ServerNoticesRequest) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ServerNoticesRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type ServerNotice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The server's unique identifier for the notice.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	//
+	//The category of the notice.
+	Category NoticeCategory `protobuf:"varint,2,opt,name=category,proto3,enum=looprpc.NoticeCategory" json:"category,omitempty"`
+	//
+	//A human readable description of the notice.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	//
+	//The unix timestamp in seconds that the notice takes effect, zero if it is
+	//already in effect.
+	StartUnix int64 `protobuf:"varint,4,opt,name=start_unix,json=startUnix,proto3" json:"start_unix,omitempty"`
+	//
+	//The unix timestamp in seconds that the notice ends, zero if it has no
+	//scheduled end.
+	EndUnix int64 `protobuf:"varint,5,opt,name=end_unix,json=endUnix,proto3" json:"end_unix,omitempty"`
+	//
+	//Whether the notice is currently in effect.
+	Active bool `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *ServerNotice) Reset() {
+	*x = ServerNotice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerNotice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerNotice) ProtoMessage() {}
+
+func (x *ServerNotice) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerNotice.ProtoReflect.Descriptor instead.
+func (*ServerNotice) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ServerNotice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServerNotice) GetCategory() NoticeCategory {
+	if x != nil {
+		return x.Category
+	}
+	return NoticeCategory_NOTICE_GENERAL
+}
+
+func (x *ServerNotice) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ServerNotice) GetStartUnix() int64 {
+	if x != nil {
+		return x.StartUnix
+	}
+	return 0
+}
+
+func (x *ServerNotice) GetEndUnix() int64 {
+	if x != nil {
+		return x.EndUnix
+	}
+	return 0
+}
+
+func (x *ServerNotice) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ServerNoticesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//The notices published by the server.
+	Notices []*ServerNotice `protobuf:"bytes,1,rep,name=notices,proto3" json:"notices,omitempty"`
+}
+
+func (x *ServerNoticesResponse) Reset() {
+	*x = ServerNoticesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_client_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerNoticesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerNoticesResponse) ProtoMessage() {}
+
+func (x *ServerNoticesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_client_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerNoticesResponse.ProtoReflect.Descriptor instead.
+func (*ServerNoticesResponse) Descriptor() ([]byte, []int) {
+	return file_client_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ServerNoticesResponse) GetNotices() []*ServerNotice {
+	if x != nil {
+		return x.Notices
+	}
+	return nil
+}
+
+var File_client_proto protoreflect.FileDescriptor
+
+var file_client_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x1a, 0x1a, 0x73, 0x77, 0x61, 0x70, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x06, 0x0a, 0x0e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x14,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x12, 0x33, 0x0a,
+	0x16, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d,
+	0x61, 0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46,
+	0x65, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61,
+	0x70, 0x46, 0x65, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70,
+	0x61, 0x79, 0x5f, 0x61, 0x6d, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x41, 0x6d, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61,
+	0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x2c,
+	0x0a, 0x10, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x4f, 0x75, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x2a, 0x0a, 0x11,
+	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x73, 0x65,
+	0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x43, 0x68, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x73, 0x77, 0x61, 0x70, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x73, 0x74, 0x53, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x50,
+	0x70, 0x6d, 0x12, 0x51, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64,
+	0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x40, 0x0a, 0x12,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x85,
+	0x04, 0x0a, 0x0d, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61,
+	0x6d, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61,
+	0x70, 0x46, 0x65, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x68, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74,
+	0x48, 0x6f, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x68, 0x74, 0x6c, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x48, 0x74, 0x6c, 0x63, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x74, 0x6c, 0x63,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x68, 0x74, 0x6c, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x52,
+	0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61,
+	0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x73, 0x74, 0x53, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73,
+	0x74, 0x50, 0x70, 0x6d, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x22, 0xd8, 0x03, 0x0a, 0x0c, 0x53, 0x77, 0x61, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69,
+	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x69,
+	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0c, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x0b, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x70,
+	0x32, 0x77, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x74, 0x6c, 0x63,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x70, 0x32, 0x77, 0x73, 0x68, 0x12, 0x2c, 0x0a,
+	0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x32,
+	0x77, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x74, 0x6c, 0x63, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x32, 0x77, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x68, 0x74, 0x6c, 0x63,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x73, 0x77, 0x61, 0x70,
+	0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x72, 0x65,
+	0x70, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12,
+	0x37, 0x0a, 0x18, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x77, 0x65, 0x65, 0x70,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x61, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xff, 0x01, 0x0a, 0x0e, 0x53, 0x77, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x10, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f,
+	0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x49,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0d, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73,
+	0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x72, 0x65,
+	0x70, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12,
+	0x22, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65,
+	0x53, 0x61, 0x74, 0x22, 0x49, 0x0a, 0x0e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e, 0x69,
+	0x74, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x22, 0x82,
+	0x07, 0x0a, 0x0a, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12,
+	0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x69, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x3d, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x25, 0x0a, 0x0c, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x68, 0x74, 0x6c,
+	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x50, 0x32, 0x77, 0x73, 0x68, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x4e, 0x70, 0x32, 0x77, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x73, 0x74, 0x5f,
+	0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63,
+	0x6f, 0x73, 0x74, 0x4f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x73, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x63, 0x6f, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x61, 0x6c, 0x61, 0x72,
+	0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x48, 0x6f, 0x6c, 0x64, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x12, 0x50, 0x0a, 0x0f, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x11, 0x64,
+	0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x10, 0x64, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x1a, 0x41, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x61, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x4b, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55,
+	0x6e, 0x69, 0x74, 0x22, 0x3e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x22, 0x5a, 0x0a, 0x0f, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e,
+	0x69, 0x74, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x22,
+	0x3b, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x16, 0x0a, 0x14,
+	0x53, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x22, 0x40, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x73, 0x77, 0x61,
+	0x70, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7c,
+	0x0a, 0x0c, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e,
+	0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x07, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x2c,
+	0x0a, 0x07, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x22, 0x50, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0x53,
+	0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04,
+	0x6b, 0x65, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x6b,
+	0x65, 0x65, 0x70, 0x22, 0x46, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x77,
+	0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x22, 0x4b, 0x0a, 0x10, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x37, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x22, 0xa0, 0x03, 0x0a, 0x11, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x46,
+	0x65, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x05, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x6f,
+	0x70, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x6f, 0x70, 0x43, 0x6f, 0x73, 0x74, 0x53,
+	0x61, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x6e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x43, 0x6f, 0x73, 0x74, 0x53, 0x61, 0x74, 0x12, 0x3c, 0x0a, 0x1b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x6f, 0x70, 0x65, 0x6e, 0x43, 0x6f,
+	0x73, 0x74, 0x53, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12,
+	0x57, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa5, 0x03, 0x0a, 0x14,
+	0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62,
+	0x79, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x6f, 0x70,
+	0x43, 0x6f, 0x73, 0x74, 0x53, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6e, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x61,
+	0x74, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x6f, 0x70, 0x65,
+	0x6e, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x6f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x73,
+	0x74, 0x53, 0x61, 0x74, 0x12, 0x5a, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x46,
+	0x65, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x1a, 0x41, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x33, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0xbe, 0x01, 0x0a, 0x18, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x77, 0x61,
+	0x70, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x44,
+	0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63,
+	0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x15, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61,
+	0x6e, 0x63, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x53, 0x61, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x61, 0x74, 0x22, 0x5f, 0x0a, 0x10,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x13, 0x0a,
+	0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x46, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x77, 0x61,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52, 0x05,
+	0x73, 0x77, 0x61, 0x70, 0x73, 0x22, 0x91, 0x02, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x53, 0x77, 0x61, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2f, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x53, 0x77,
+	0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x61, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x66, 0x66, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61,
+	0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x6e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x22, 0x47, 0x0a, 0x0c, 0x54, 0x65, 0x72,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e,
+	0x69, 0x74, 0x22, 0x99, 0x02, 0x0a, 0x0f, 0x49, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x54, 0x65, 0x72, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x41, 0x0a,
+	0x13, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03,
+	0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0xe7,
+	0x02, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x69,
+	0x6e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6c, 0x74, 0x76, 0x5f,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x69, 0x6e,
+	0x43, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12,
+	0x56, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
+	0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04,
+	0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0xe1, 0x02, 0x0a, 0x0c, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x74, 0x6c,
+	0x63, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x73, 0x77, 0x61, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x27, 0x0a,
+	0x10, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x4c,
+	0x61, 0x73, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x41, 0x0a, 0x13, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69,
+	0x6e, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x10, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x22, 0xca, 0x02, 0x0a,
+	0x0f, 0x49, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x53,
+	0x61, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x46, 0x65, 0x65,
+	0x53, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x55, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x44, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08,
+	0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x8e, 0x03, 0x0a, 0x10, 0x4f, 0x75,
+	0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
+	0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74,
+	0x12, 0x24, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79,
+	0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x73,
+	0x77, 0x65, 0x65, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x68, 0x74, 0x6c, 0x63, 0x53, 0x77, 0x65, 0x65, 0x70, 0x46, 0x65, 0x65,
+	0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
+	0x73, 0x77, 0x61, 0x70, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x56, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x70, 0x0a, 0x0c, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x6c, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x33, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74,
+	0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x0f, 0x0a, 0x0d,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x0a,
+	0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c,
+	0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2a, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x73, 0x61, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x14, 0x0a, 0x12,
+	0x4c, 0x6e, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x6d, 0x0a, 0x13, 0x4c, 0x6e, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6e, 0x64,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6c, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x66, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6e, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x22, 0x7d, 0x0a, 0x10, 0x4c, 0x6e, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69,
+	0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x90, 0x02, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2d, 0x0a, 0x13, 0x6c, 0x6e,
+	0x64, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6c, 0x6e, 0x64, 0x53, 0x79, 0x6e, 0x63,
+	0x65, 0x64, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x10,
+	0x6c, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6c, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x41, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x22, 0xc7, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x54, 0x78, 0x69, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x78,
+	0x69, 0x64, 0x22, 0x51, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xbb, 0x02, 0x0a, 0x09, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x61, 0x63, 0x61,
+	0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65,
+	0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x31, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x50, 0x61, 0x69, 0x64, 0x4d,
+	0x73, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x9b, 0x17, 0x0a, 0x13, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70,
+	0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12,
+	0x3d, 0x0a, 0x1c, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x73, 0x77, 0x65, 0x65, 0x70, 0x46, 0x65, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x12, 0x27,
+	0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70,
+	0x70, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61,
+	0x70, 0x46, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x46, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x70, 0x70, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x50,
+	0x72, 0x65, 0x70, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x50,
+	0x70, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50,
+	0x72, 0x65, 0x70, 0x61, 0x79, 0x53, 0x61, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65,
+	0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x73, 0x77, 0x65, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x2e, 0x0a, 0x13, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f,
+	0x66, 0x66, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x12, 0x2e, 0x0a, 0x13, 0x61,
+	0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f,
+	0x6f, 0x70, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x61, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x61,
+	0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
+	0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x53, 0x65, 0x63, 0x12, 0x2b, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x61, 0x78, 0x49, 0x6e, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69,
+	0x6e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x68,
+	0x74, 0x6c, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x76, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x76, 0x62, 0x79, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x12, 0x35, 0x0a, 0x17, 0x76, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x14, 0x76, 0x62, 0x79, 0x74, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65,
+	0x50, 0x70, 0x6d, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x12, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x53, 0x61, 0x74, 0x12, 0x4a, 0x0a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f,
+	0x70, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x1e, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65,
+	0x63, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x1f, 0x61,
+	0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x1b, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x53, 0x61,
+	0x74, 0x12, 0x44, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x10, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x1f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x61, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x1a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e,
+	0x67, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x04, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x28,
+	0x0a, 0x10, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x73,
+	0x65, 0x63, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x54, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x61, 0x73, 0x79,
+	0x5f, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x65, 0x61, 0x73, 0x79, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x12, 0x3f, 0x0a,
+	0x1c, 0x65, 0x61, 0x73, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x19, 0x65, 0x61, 0x73, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2a,
+	0x0a, 0x11, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2c,
+	0x0a, 0x12, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x64, 0x61, 0x70,
+	0x74, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x33, 0x0a, 0x16,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x5f,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6f,
+	0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x24, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x12, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e,
+	0x53, 0x65, 0x63, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x69, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x25,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x74, 0x72, 0x79, 0x44, 0x69, 0x73, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x50, 0x65, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x3e, 0x0a, 0x1c,
+	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x27, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x50, 0x65, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2f, 0x0a, 0x14,
+	0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x5f, 0x73, 0x65, 0x63, 0x18, 0x28, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x77, 0x61, 0x70,
+	0x52, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x12, 0x2f, 0x0a,
+	0x13, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x18, 0x29, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x78, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x2d,
+	0x0a, 0x12, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x62, 0x61, 0x63, 0x6b,
+	0x6f, 0x66, 0x66, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x26, 0x0a,
+	0x0f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x63, 0x61, 0x70, 0x5f, 0x73, 0x65, 0x63,
+	0x18, 0x2b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x43,
+	0x61, 0x70, 0x53, 0x65, 0x63, 0x12, 0x34, 0x0a, 0x16, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x5f, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x2c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4a, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x61,
+	0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x70,
+	0x70, 0x6d, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f,
+	0x6f, 0x70, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x50, 0x70, 0x6d, 0x12, 0x35, 0x0a, 0x17, 0x61,
+	0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x6f,
+	0x75, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x61, 0x75,
+	0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x53,
+	0x61, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x2f, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x13, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x49, 0x6e, 0x53, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6f, 0x75,
+	0x74, 0x18, 0x30, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x61, 0x78,
+	0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x15, 0x61,
+	0x75, 0x74, 0x6f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x5f, 0x69, 0x6e, 0x18, 0x31, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x75, 0x74, 0x6f,
+	0x4d, 0x61, 0x78, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6e, 0x12, 0x30, 0x0a,
+	0x14, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x32, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x64, 0x69, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x53, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x12,
+	0x33, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x61,
+	0x67, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x13, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x67, 0x65, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x34, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63,
+	0x18, 0x35, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x6c, 0x6f, 0x77, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x53, 0x65, 0x63, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x65,
+	0x63, 0x18, 0x36, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x63, 0x12,
+	0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x18, 0x37, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x38, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x73, 0x12,
+	0x3a, 0x0a, 0x0d, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x18, 0x39, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x0c, 0x72,
+	0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x3a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12,
+	0x30, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x12, 0x3b, 0x0a, 0x0e, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x70, 0x72,
+	0x65, 0x61, 0x64, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64,
+	0x52, 0x0c, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x22, 0x66,
+	0x0a, 0x0e, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x04,
+	0x64, 0x61, 0x79, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x6d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x6e, 0x64,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x22, 0xee, 0x0a, 0x0a, 0x0d, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x73,
+	0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
+	0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2d,
+	0x0a, 0x12, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3a, 0x0a,
+	0x0d, 0x73, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x68, 0x72, 0x69, 0x6e, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0c, 0x73, 0x68, 0x72,
+	0x69, 0x6e, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x65, 0x65,
+	0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x61, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x53, 0x65, 0x63, 0x12, 0x1d,
+	0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e,
+	0x5f, 0x73, 0x65, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x6c,
+	0x64, 0x6f, 0x77, 0x6e, 0x53, 0x65, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x5f, 0x70,
+	0x70, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x65, 0x65, 0x50, 0x70, 0x6d,
+	0x12, 0x3d, 0x0a, 0x1c, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x73, 0x77, 0x65, 0x65, 0x70, 0x46, 0x65, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x12,
+	0x27, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x70, 0x70, 0x6d, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x77,
+	0x61, 0x70, 0x46, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x46, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78,
+	0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65,
+	0x50, 0x70, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61,
+	0x79, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x53, 0x61, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78,
+	0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65,
+	0x65, 0x53, 0x61, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x61, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x6f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x61, 0x74,
+	0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d,
+	0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x12,
+	0x50, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x2e,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x19, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72,
+	0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x77, 0x65, 0x65, 0x70, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x78, 0x70,
+	0x75, 0x62, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x77, 0x65, 0x65, 0x70, 0x58,
+	0x70, 0x75, 0x62, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x1c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x73, 0x77, 0x65, 0x65, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x53, 0x65, 0x63, 0x1a, 0x40, 0x0a, 0x12, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x59, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x4c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x22, 0x61, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x43, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x63, 0x72,
+	0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x72,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63,
+	0x72, 0x65, 0x61, 0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x45, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x66, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x7e, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x09, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x22, 0xe8, 0x01, 0x0a, 0x11, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2a, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c,
+	0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6c, 0x6c,
+	0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x6c,
+	0x6c, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x49, 0x64, 0x22, 0xd6, 0x01, 0x0a, 0x0a, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f,
+	0x72, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x6e,
+	0x65, 0x77, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x52, 0x75, 0x6c, 0x65, 0x22, 0xaa,
+	0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x6f, 0x6f, 0x70, 0x4f,
+	0x75, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x10, 0x6c, 0x6f, 0x6f, 0x70, 0x5f,
+	0x6f, 0x75, 0x74, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74,
+	0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x5f,
+	0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c,
+	0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x22, 0xfc, 0x01, 0x0a, 0x12,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x77, 0x61,
+	0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70,
+	0x73, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12,
+	0x40, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x77, 0x61,
+	0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70,
+	0x73, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0x40, 0x0a, 0x12, 0x53, 0x61,
+	0x76, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x15, 0x0a, 0x13,
+	0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x64, 0x0a, 0x10, 0x4c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x22, 0x5a, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xa8, 0x01, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x4f, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xa6, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x33, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x4b, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xf4, 0x01, 0x0a, 0x0f, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f,
+	0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x1b,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x72,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x69, 0x6e, 0x63,
+	0x72, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63,
+	0x72, 0x65, 0x61, 0x73, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x22,
+	0x1e, 0x0a, 0x1c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x57, 0x0a, 0x1d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x52, 0x09, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x49, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a,
+	0x0a, 0x11, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x61, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x0c, 0x44,
+	0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xde,
+	0x02, 0x0a, 0x14, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x70, 0x5f,
+	0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x6c,
+	0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x12, 0x39, 0x0a, 0x0c,
+	0x64, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73,
+	0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x71, 0x75,
+	0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22,
+	0xf4, 0x01, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x73, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x61,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x53, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x6d, 0x74,
+	0x12, 0x28, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x68,
+	0x61, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x46, 0x65,
+	0x65, 0x53, 0x61, 0x74, 0x22, 0x26, 0x0a, 0x12, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x46,
+	0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x22, 0xfb, 0x02, 0x0a,
+	0x13, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70,
+	0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79,
+	0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x29, 0x0a, 0x11,
+	0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x77, 0x61, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x53, 0x77,
+	0x61, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12,
+	0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x52, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x77,
+	0x6f, 0x72, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68,
+	0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2b, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x7f, 0x0a, 0x17, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x22, 0xa1, 0x03, 0x0a, 0x18,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46,
+	0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72,
+	0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x6f, 0x70, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x46,
+	0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x53,
+	0x61, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x73, 0x77, 0x61, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65,
+	0x53, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x77, 0x61, 0x70,
+	0x43, 0x6f, 0x73, 0x74, 0x53, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x22,
+	0x33, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x22, 0x8a, 0x02, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x77, 0x61, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74,
+	0x12, 0x30, 0x0a, 0x09, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x69, 0x6e, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x75, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x75, 0x6e, 0x6b, 0x43,
+	0x6f, 0x73, 0x74, 0x53, 0x61, 0x74, 0x12, 0x45, 0x0a, 0x12, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x10, 0x73, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x42, 0x0a,
+	0x11, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f,
+	0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x6f, 0x70, 0x49,
+	0x6e, 0x22, 0x33, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x22, 0xd8, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x6f, 0x6c,
+	0x6f, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f,
+	0x6f, 0x70, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x22, 0xe5, 0x01, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x54, 0x69,
+	0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x5f, 0x65,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65,
+	0x73, 0x46, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x73, 0x0a, 0x18, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x4d,
+	0x0a, 0x19, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0xb9, 0x02,
+	0x0a, 0x0f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x31, 0x0a, 0x08,
+	0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52, 0x07, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12,
+	0x2f, 0x0a, 0x07, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52, 0x06, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e,
+	0x12, 0x36, 0x0a, 0x0a, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52, 0x0a, 0x72, 0x65,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x71,
+	0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x93, 0x01, 0x0a, 0x0d, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x68, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x48,
+	0x6f, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x61, 0x6d, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x22,
+	0x51, 0x0a, 0x15, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0xff, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x53, 0x77, 0x61, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73,
+	0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x46, 0x65, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x46, 0x65, 0x65, 0x22, 0x4e, 0x0a, 0x10, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x16, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x34, 0x0a,
+	0x08, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x07, 0x6c, 0x6f, 0x6f, 0x70,
+	0x4f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52,
+	0x06, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x09, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x22, 0x1c, 0x0a, 0x1a, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1d,
+	0x0a, 0x1b, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a,
+	0x17, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x63, 0x61, 0x6c, 0x22, 0xa5, 0x01,
+	0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x61, 0x6d, 0x74, 0x22, 0x5e, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f,
+	0x70, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x22, 0x86,
+	0x02, 0x0a, 0x0f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f,
+	0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x6c, 0x6f,
+	0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x06, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x12, 0x3a, 0x0a, 0x09, 0x72,
+	0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a,
+	0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x69, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22,
+	0x23, 0x0a, 0x11, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x0b, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x26, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x11, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x73, 0x68, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x53,
+	0x70, 0x65, 0x63, 0x22, 0x35, 0x0a, 0x12, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62,
+	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x75, 0x62, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0xcc, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x4e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x78, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0x92,
+	0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x15, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a,
+	0x09, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x22, 0x35, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xcc, 0x01, 0x0a, 0x16, 0x53, 0x77, 0x61, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x70, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63,
+	0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x61,
+	0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x6f,
+	0x72, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf6, 0x02, 0x0a,
+	0x0a, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x6f, 0x72, 0x74, 0x65, 0x6d, 0x12, 0x27, 0x0a, 0x04, 0x73,
+	0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04,
+	0x73, 0x77, 0x61, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x67, 0x65, 0x53, 0x65, 0x63, 0x12, 0x28, 0x0a, 0x10,
+	0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x66, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2e, 0x0a,
+	0x13, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x2c, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4d, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x6f, 0x72,
+	0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0c,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x72, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x4d, 0x6f, 0x72, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x4d, 0x6f, 0x72,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x41, 0x0a, 0x14, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49,
+	0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x6e,
+	0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x69,
+	0x78, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x48, 0x0a, 0x15, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x69,
+	0x63, 0x65, 0x73, 0x2a, 0x71, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a,
+	0x16, 0x44, 0x45, 0x53, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x53,
+	0x54, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x45,
+	0x53, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x58, 0x50, 0x55, 0x42, 0x10, 0x02, 0x2a, 0x67, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59,
+	0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
+	0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x53, 0x41, 0x54,
+	0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x55, 0x4e,
+	0x49, 0x54, 0x5f, 0x42, 0x54, 0x43, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x49, 0x53, 0x50,
+	0x4c, 0x41, 0x59, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x4d, 0x53, 0x41, 0x54, 0x10, 0x03, 0x2a,
+	0x25, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4c,
+	0x4f, 0x4f, 0x50, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x4f, 0x4f,
+	0x50, 0x5f, 0x49, 0x4e, 0x10, 0x01, 0x2a, 0x73, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x45, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x52,
+	0x45, 0x56, 0x45, 0x41, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x54, 0x4c,
+	0x43, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a,
+	0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43,
+	0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x90, 0x02, 0x0a, 0x0d,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a,
+	0x13, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52,
+	0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x46, 0x46, 0x43, 0x48, 0x41, 0x49,
+	0x4e, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x02, 0x12,
+	0x20, 0x0a, 0x1c, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x53, 0x57, 0x45, 0x45, 0x50, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10,
+	0x03, 0x12, 0x25, 0x0a, 0x21, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54,
+	0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x41, 0x49, 0x4c,
+	0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4f,
+	0x52, 0x41, 0x52, 0x59, 0x10, 0x05, 0x12, 0x23, 0x0a, 0x1f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52,
+	0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45,
+	0x43, 0x54, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x06, 0x12, 0x21, 0x0a, 0x1d, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x41,
+	0x58, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x53, 0x54, 0x10, 0x07, 0x2a, 0x7a,
+	0x0a, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x53,
+	0x54, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4f, 0x46, 0x46, 0x43, 0x48, 0x41, 0x49, 0x4e,
+	0x5f, 0x46, 0x45, 0x45, 0x53, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x43, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4f, 0x4e, 0x43, 0x48,
+	0x41, 0x49, 0x4e, 0x5f, 0x46, 0x45, 0x45, 0x53, 0x10, 0x02, 0x2a, 0x79, 0x0a, 0x10, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18,
+	0x0a, 0x14, 0x57, 0x41, 0x54, 0x43, 0x48, 0x45, 0x44, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x57, 0x41, 0x54, 0x43,
+	0x48, 0x45, 0x44, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x57, 0x41, 0x54, 0x43, 0x48, 0x45, 0x44, 0x5f, 0x53, 0x57,
+	0x41, 0x50, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13,
+	0x57, 0x41, 0x54, 0x43, 0x48, 0x45, 0x44, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x2f, 0x0a, 0x11, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x48, 0x52, 0x45, 0x53,
+	0x48, 0x4f, 0x4c, 0x44, 0x10, 0x01, 0x2a, 0x59, 0x0a, 0x0c, 0x53, 0x68, 0x72, 0x69, 0x6e, 0x6b,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x48, 0x52, 0x49, 0x4e, 0x4b,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x53, 0x48, 0x52, 0x49, 0x4e, 0x4b, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f,
+	0x52, 0x45, 0x53, 0x49, 0x5a, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x48, 0x52, 0x49,
+	0x4e, 0x4b, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10,
+	0x02, 0x2a, 0x60, 0x0a, 0x0c, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49,
+	0x43, 0x54, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52,
+	0x55, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x43, 0x48, 0x41,
+	0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x53,
+	0x54, 0x10, 0x02, 0x2a, 0x64, 0x0a, 0x0c, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x53, 0x70, 0x72,
+	0x65, 0x61, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x5f, 0x53,
+	0x50, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a,
+	0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x5f, 0x53, 0x50, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x52,
+	0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17,
+	0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x5f, 0x53, 0x50, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x43,
+	0x41, 0x50, 0x41, 0x43, 0x49, 0x54, 0x59, 0x10, 0x02, 0x2a, 0x7f, 0x0a, 0x0c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55,
+	0x4c, 0x54, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x4c,
+	0x45, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x4c, 0x49, 0x4e,
+	0x45, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x50, 0x43, 0x10, 0x03, 0x2a, 0x59, 0x0a, 0x0e, 0x52, 0x75,
+	0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11,
+	0x52, 0x55, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
+	0x52, 0x55, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f,
+	0x56, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x6a, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x50,
+	0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x50, 0x43,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x22, 0x0a,
+	0x1e, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44,
+	0x45, 0x4c, 0x41, 0x59, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x10,
+	0x02, 0x2a, 0x80, 0x08, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x17, 0x0a, 0x13, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x55, 0x54,
+	0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a,
+	0x16, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x57, 0x45,
+	0x45, 0x50, 0x5f, 0x46, 0x45, 0x45, 0x53, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x55, 0x54,
+	0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f,
+	0x45, 0x4c, 0x41, 0x50, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x55, 0x54,
+	0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4c, 0x49, 0x47,
+	0x48, 0x54, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x05, 0x12, 0x19,
+	0x0a, 0x15, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x49,
+	0x4e, 0x45, 0x52, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x55, 0x54,
+	0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x50, 0x41, 0x59, 0x10,
+	0x07, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x4f, 0x46, 0x46,
+	0x10, 0x08, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x09, 0x12, 0x17, 0x0a, 0x13,
+	0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x4f, 0x50,
+	0x5f, 0x49, 0x4e, 0x10, 0x0a, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x51, 0x55, 0x49, 0x44, 0x49, 0x54, 0x59, 0x5f, 0x4f,
+	0x4b, 0x10, 0x0b, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46,
+	0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x0c, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x55, 0x54, 0x4f,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x45, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x55,
+	0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x0d, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55,
+	0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x56, 0x42, 0x59, 0x54, 0x45, 0x5f,
+	0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x10, 0x0e, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x55, 0x54, 0x4f,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x5f, 0x54, 0x49,
+	0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x0f, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x54, 0x4f, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x42, 0x55, 0x44, 0x47,
+	0x45, 0x54, 0x10, 0x10, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x11, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4f, 0x4c,
+	0x44, 0x4f, 0x57, 0x4e, 0x10, 0x12, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x44, 0x10, 0x13,
+	0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x4e, 0x4f, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x53, 0x10, 0x14, 0x12, 0x24, 0x0a,
+	0x20, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53,
+	0x45, 0x52, 0x56, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e,
+	0x54, 0x10, 0x15, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x57, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4d, 0x55, 0x4d,
+	0x10, 0x16, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x4f, 0x4f, 0x4c, 0x44, 0x4f,
+	0x57, 0x4e, 0x10, 0x17, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49,
+	0x4d, 0x49, 0x54, 0x10, 0x18, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e,
+	0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x19, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55, 0x54,
+	0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x4f, 0x46,
+	0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x1a, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x54, 0x4f, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x4c, 0x49, 0x4d, 0x49,
+	0x54, 0x53, 0x10, 0x1b, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x41, 0x47, 0x45, 0x10,
+	0x1c, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x52, 0x45, 0x46, 0x49, 0x4c, 0x4c, 0x10, 0x1d, 0x12, 0x24,
+	0x0a, 0x20, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e,
+	0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x4e,
+	0x55, 0x45, 0x10, 0x1e, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x4e, 0x45, 0x4c, 0x10, 0x1f, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49,
+	0x43, 0x54, 0x10, 0x20, 0x2a, 0x66, 0x0a, 0x0f, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x42, 0x41, 0x4c,
+	0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x43, 0x49, 0x52, 0x43, 0x55, 0x4c, 0x41, 0x52, 0x10,
+	0x01, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4d,
+	0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x10, 0x02, 0x2a, 0x5b, 0x0a, 0x11,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x49, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x4c,
+	0x4f, 0x4f, 0x50, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x49, 0x4d,
+	0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x53, 0x49, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x52, 0x45,
+	0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x02, 0x2a, 0x75, 0x0a, 0x11, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13,
+	0x0a, 0x0f, 0x44, 0x49, 0x53, 0x50, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f,
+	0x57, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f,
+	0x53, 0x57, 0x41, 0x50, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56,
+	0x41, 0x4c, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x59, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x42,
+	0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x10, 0x03, 0x12,
+	0x0f, 0x0a, 0x0b, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x59, 0x10, 0x04,
+	0x2a, 0x95, 0x02, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16,
+	0x0a, 0x12, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x41, 0x52,
+	0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x53, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4f,
+	0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x53, 0x10, 0x02, 0x12, 0x1f,
+	0x0a, 0x1b, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x55, 0x44,
+	0x47, 0x45, 0x54, 0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x21, 0x0a, 0x1d, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45,
+	0x52, 0x56, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x22, 0x0a, 0x1e,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06,
+	0x12, 0x26, 0x0a, 0x22, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53,
+	0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x4d, 0x49,
+	0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x07, 0x2a, 0x7b, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a,
+	0x13, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53,
+	0x54, 0x4f, 0x52, 0x45, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59,
+	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10,
+	0x01, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13,
+	0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x48,
+	0x41, 0x49, 0x4e, 0x10, 0x03, 0x2a, 0x4e, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x44, 0x4f, 0x54, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x47,
+	0x52, 0x41, 0x50, 0x48, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4d, 0x45, 0x52, 0x4d,
+	0x41, 0x49, 0x44, 0x10, 0x01, 0x2a, 0x53, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x54, 0x49, 0x43,
+	0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4e,
+	0x4f, 0x54, 0x49, 0x43, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43,
+	0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x4f, 0x54, 0x49, 0x43, 0x45, 0x5f, 0x46, 0x45,
+	0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x32, 0xe2, 0x1e, 0x0a, 0x0a, 0x53,
+	0x77, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x4c, 0x6f, 0x6f,
+	0x70, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x12, 0x16,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x07, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08,
+	0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x12, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x12, 0x23, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x42, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x77, 0x61, 0x70, 0x12, 0x19, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x77, 0x61, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x20, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x53, 0x77, 0x61,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x53,
+	0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c,
+	0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x15, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75,
+	0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x0c, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x15,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x4f, 0x75, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x54, 0x65, 0x72,
+	0x6d, 0x73, 0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x72,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12,
+	0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12,
+	0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6e, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6e, 0x64,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6e, 0x64, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x07, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x5d, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
+	0x0a, 0x0a, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x61, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x61, 0x76, 0x65,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12,
+	0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x20, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x12, 0x25, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x72, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1c, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x24, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x57, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x6c,
+	0x6f, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x21, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x20, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x6f,
+	0x6f, 0x70, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x54, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1e, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12,
+	0x23, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x53,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x12, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
+	0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
+	0x0a, 0x0a, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x53,
+	0x77, 0x61, 0x70, 0x12, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x12, 0x1e, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x6f, 0x72,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x4d, 0x6f, 0x72, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x4d, 0x6f, 0x72, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6c, 0x6f, 0x6f, 0x70,
+	0x2f, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_client_proto_rawDescOnce sync.Once
+	file_client_proto_rawDescData = file_client_proto_rawDesc
+)
+
+func file_client_proto_rawDescGZIP() []byte {
+	file_client_proto_rawDescOnce.Do(func() {
+		file_client_proto_rawDescData = protoimpl.X.CompressGZIP(file_client_proto_rawDescData)
+	})
+	return file_client_proto_rawDescData
+}
+
+var file_client_proto_enumTypes = make([]protoimpl.EnumInfo, 22)
+var file_client_proto_msgTypes = make([]protoimpl.MessageInfo, 137)
+var file_client_proto_goTypes = []interface{}{
+	(DestVerificationMethod)(0),           // 0: looprpc.DestVerificationMethod
+	(DisplayUnit)(0),                      // 1: looprpc.DisplayUnit
+	(SwapType)(0),                         // 2: looprpc.SwapType
+	(SwapState)(0),                        // 3: looprpc.SwapState
+	(FailureReason)(0),                    // 4: looprpc.FailureReason
+	(AccountingCheck)(0),                  // 5: looprpc.AccountingCheck
+	(WatchedSwapState)(0),                 // 6: looprpc.WatchedSwapState
+	(LiquidityRuleType)(0),                // 7: looprpc.LiquidityRuleType
+	(ShrinkPolicy)(0),                     // 8: looprpc.ShrinkPolicy
+	(RuleConflict)(0),                     // 9: looprpc.RuleConflict
+	(LoopInSpread)(0),                     // 10: looprpc.LoopInSpread
+	(ConfigSource)(0),                     // 11: looprpc.ConfigSource
+	(RuleChangeType)(0),                   // 12: looprpc.RuleChangeType
+	(ParamsChangeSource)(0),               // 13: looprpc.ParamsChangeSource
+	(AutoReason)(0),                       // 14: looprpc.AutoReason
+	(RebalanceMethod)(0),                  // 15: looprpc.RebalanceMethod
+	(SimulatedSwapType)(0),                // 16: looprpc.SimulatedSwapType
+	(CalendarEventType)(0),                // 17: looprpc.CalendarEventType
+	(ErrorCode)(0),                        // 18: looprpc.ErrorCode
+	(ReplayEventSource)(0),                // 19: looprpc.ReplayEventSource
+	(StateGraphFormat)(0),                 // 20: looprpc.StateGraphFormat
+	(NoticeCategory)(0),                   // 21: looprpc.NoticeCategory
+	(*LoopOutRequest)(nil),                // 22: looprpc.LoopOutRequest
+	(*LoopInRequest)(nil),                 // 23: looprpc.LoopInRequest
+	(*SwapResponse)(nil),                  // 24: looprpc.SwapResponse
+	(*SwapValidation)(nil),                // 25: looprpc.SwapValidation
+	(*MonitorRequest)(nil),                // 26: looprpc.MonitorRequest
+	(*SwapStatus)(nil),                    // 27: looprpc.SwapStatus
+	(*DestVerification)(nil),              // 28: looprpc.DestVerification
+	(*ListSwapsRequest)(nil),              // 29: looprpc.ListSwapsRequest
+	(*ListSwapsResponse)(nil),             // 30: looprpc.ListSwapsResponse
+	(*SwapInfoRequest)(nil),               // 31: looprpc.SwapInfoRequest
+	(*SetSwapNotesRequest)(nil),           // 32: looprpc.SetSwapNotesRequest
+	(*SetSwapNotesResponse)(nil),          // 33: looprpc.SetSwapNotesResponse
+	(*SearchSwapsRequest)(nil),            // 34: looprpc.SearchSwapsRequest
+	(*SearchSwapsResponse)(nil),           // 35: looprpc.SearchSwapsResponse
+	(*ListSwapConflictsRequest)(nil),      // 36: looprpc.ListSwapConflictsRequest
+	(*SwapConflict)(nil),                  // 37: looprpc.SwapConflict
+	(*ListSwapConflictsResponse)(nil),     // 38: looprpc.ListSwapConflictsResponse
+	(*ResolveSwapConflictRequest)(nil),    // 39: looprpc.ResolveSwapConflictRequest
+	(*ResolveSwapConflictResponse)(nil),   // 40: looprpc.ResolveSwapConflictResponse
+	(*FeeReportRequest)(nil),              // 41: looprpc.FeeReportRequest
+	(*FeeReportResponse)(nil),             // 42: looprpc.FeeReportResponse
+	(*LoopOutFeeComparison)(nil),          // 43: looprpc.LoopOutFeeComparison
+	(*AccountingReportRequest)(nil),       // 44: looprpc.AccountingReportRequest
+	(*AccountingReportResponse)(nil),      // 45: looprpc.AccountingReportResponse
+	(*AccountingDiscrepancy)(nil),         // 46: looprpc.AccountingDiscrepancy
+	(*WatchSwapRequest)(nil),              // 47: looprpc.WatchSwapRequest
+	(*WatchSwapResponse)(nil),             // 48: looprpc.WatchSwapResponse
+	(*ListWatchedSwapsRequest)(nil),       // 49: looprpc.ListWatchedSwapsRequest
+	(*ListWatchedSwapsResponse)(nil),      // 50: looprpc.ListWatchedSwapsResponse
+	(*WatchedSwap)(nil),                   // 51: looprpc.WatchedSwap
+	(*TermsRequest)(nil),                  // 52: looprpc.TermsRequest
+	(*InTermsResponse)(nil),               // 53: looprpc.InTermsResponse
+	(*OutTermsResponse)(nil),              // 54: looprpc.OutTermsResponse
+	(*QuoteRequest)(nil),                  // 55: looprpc.QuoteRequest
+	(*InQuoteResponse)(nil),               // 56: looprpc.InQuoteResponse
+	(*OutQuoteResponse)(nil),              // 57: looprpc.OutQuoteResponse
+	(*ProbeRequest)(nil),                  // 58: looprpc.ProbeRequest
+	(*ProbeResponse)(nil),                 // 59: looprpc.ProbeResponse
+	(*TokensRequest)(nil),                 // 60: looprpc.TokensRequest
+	(*TokensResponse)(nil),                // 61: looprpc.TokensResponse
+	(*LndFeaturesRequest)(nil),            // 62: looprpc.LndFeaturesRequest
+	(*LndFeaturesResponse)(nil),           // 63: looprpc.LndFeaturesResponse
+	(*LndFeatureStatus)(nil),              // 64: looprpc.LndFeatureStatus
+	(*GetInfoRequest)(nil),                // 65: looprpc.GetInfoRequest
+	(*GetInfoResponse)(nil),               // 66: looprpc.GetInfoResponse
+	(*RecoveryTestRequest)(nil),           // 67: looprpc.RecoveryTestRequest
+	(*RecoveryTestResponse)(nil),          // 68: looprpc.RecoveryTestResponse
+	(*RecoveryCheck)(nil),                 // 69: looprpc.RecoveryCheck
+	(*LsatToken)(nil),                     // 70: looprpc.LsatToken
+	(*GetLiquidityParamsRequest)(nil),     // 71: looprpc.GetLiquidityParamsRequest
+	(*LiquidityParameters)(nil),           // 72: looprpc.LiquidityParameters
+	(*AutoloopWindow)(nil),                // 73: looprpc.AutoloopWindow
+	(*LiquidityRule)(nil),                 // 74: looprpc.LiquidityRule
+	(*SetLiquidityParamsRequest)(nil),     // 75: looprpc.SetLiquidityParamsRequest
+	(*SetLiquidityParamsResponse)(nil),    // 76: looprpc.SetLiquidityParamsResponse
+	(*GetEffectiveConfigRequest)(nil),     // 77: looprpc.GetEffectiveConfigRequest
+	(*ConfigValue)(nil),                   // 78: looprpc.ConfigValue
+	(*GetEffectiveConfigResponse)(nil),    // 79: looprpc.GetEffectiveConfigResponse
+	(*ApplyRulesRequest)(nil),             // 80: looprpc.ApplyRulesRequest
+	(*RuleChange)(nil),                    // 81: looprpc.RuleChange
+	(*ProjectedSwaps)(nil),                // 82: looprpc.ProjectedSwaps
+	(*ApplyRulesResponse)(nil),            // 83: looprpc.ApplyRulesResponse
+	(*SaveProfileRequest)(nil),            // 84: looprpc.SaveProfileRequest
+	(*SaveProfileResponse)(nil),           // 85: looprpc.SaveProfileResponse
+	(*ListProfilesRequest)(nil),           // 86: looprpc.ListProfilesRequest
+	(*LiquidityProfile)(nil),              // 87: looprpc.LiquidityProfile
+	(*ProfileSwitch)(nil),                 // 88: looprpc.ProfileSwitch
+	(*ListProfilesResponse)(nil),          // 89: looprpc.ListProfilesResponse
+	(*SetProfileScheduleRequest)(nil),     // 90: looprpc.SetProfileScheduleRequest
+	(*SetProfileScheduleResponse)(nil),    // 91: looprpc.SetProfileScheduleResponse
+	(*GetParamsHistoryRequest)(nil),       // 92: looprpc.GetParamsHistoryRequest
+	(*ParamsChange)(nil),                  // 93: looprpc.ParamsChange
+	(*GetParamsHistoryResponse)(nil),      // 94: looprpc.GetParamsHistoryResponse
+	(*PendingIncrease)(nil),               // 95: looprpc.PendingIncrease
+	(*GetPendingIncreaseRequest)(nil),     // 96: looprpc.GetPendingIncreaseRequest
+	(*GetPendingIncreaseResponse)(nil),    // 97: looprpc.GetPendingIncreaseResponse
+	(*CancelPendingIncreaseRequest)(nil),  // 98: looprpc.CancelPendingIncreaseRequest
+	(*CancelPendingIncreaseResponse)(nil), // 99: looprpc.CancelPendingIncreaseResponse
+	(*SuggestSwapsRequest)(nil),           // 100: looprpc.SuggestSwapsRequest
+	(*SubscribeSuggestionsRequest)(nil),   // 101: looprpc.SubscribeSuggestionsRequest
+	(*Disqualified)(nil),                  // 102: looprpc.Disqualified
+	(*SuggestSwapsResponse)(nil),          // 103: looprpc.SuggestSwapsResponse
+	(*SwapScore)(nil),                     // 104: looprpc.SwapScore
+	(*RebalanceSuggestion)(nil),           // 105: looprpc.RebalanceSuggestion
+	(*PreviewFeesRequest)(nil),            // 106: looprpc.PreviewFeesRequest
+	(*PreviewFeesResponse)(nil),           // 107: looprpc.PreviewFeesResponse
+	(*CompareRebalanceRequest)(nil),       // 108: looprpc.CompareRebalanceRequest
+	(*CompareRebalanceResponse)(nil),      // 109: looprpc.CompareRebalanceResponse
+	(*CloseAdviceRequest)(nil),            // 110: looprpc.CloseAdviceRequest
+	(*CloseAdviceResponse)(nil),           // 111: looprpc.CloseAdviceResponse
+	(*AutoloopStatsRequest)(nil),          // 112: looprpc.AutoloopStatsRequest
+	(*AutoloopStatsResponse)(nil),         // 113: looprpc.AutoloopStatsResponse
+	(*AutoloopTick)(nil),                  // 114: looprpc.AutoloopTick
+	(*SuggestionHistoryRequest)(nil),      // 115: looprpc.SuggestionHistoryRequest
+	(*SuggestionHistoryResponse)(nil),     // 116: looprpc.SuggestionHistoryResponse
+	(*SuggestionRound)(nil),               // 117: looprpc.SuggestionRound
+	(*SuggestedSwap)(nil),                 // 118: looprpc.SuggestedSwap
+	(*SimulatedSwapsRequest)(nil),         // 119: looprpc.SimulatedSwapsRequest
+	(*SimulatedSwap)(nil),                 // 120: looprpc.SimulatedSwap
+	(*SimulationTotals)(nil),              // 121: looprpc.SimulationTotals
+	(*SimulatedSwapsResponse)(nil),        // 122: looprpc.SimulatedSwapsResponse
+	(*ClearSimulatedSwapsRequest)(nil),    // 123: looprpc.ClearSimulatedSwapsRequest
+	(*ClearSimulatedSwapsResponse)(nil),   // 124: looprpc.ClearSimulatedSwapsResponse
+	(*AutoloopCalendarRequest)(nil),       // 125: looprpc.AutoloopCalendarRequest
+	(*CalendarEvent)(nil),                 // 126: looprpc.CalendarEvent
+	(*AutoloopCalendarResponse)(nil),      // 127: looprpc.AutoloopCalendarResponse
+	(*ListApprovalsRequest)(nil),          // 128: looprpc.ListApprovalsRequest
+	(*ListApprovalsResponse)(nil),         // 129: looprpc.ListApprovalsResponse
+	(*PendingApproval)(nil),               // 130: looprpc.PendingApproval
+	(*ApproveSwapRequest)(nil),            // 131: looprpc.ApproveSwapRequest
+	(*ApproveSwapResponse)(nil),           // 132: looprpc.ApproveSwapResponse
+	(*RejectSwapRequest)(nil),             // 133: looprpc.RejectSwapRequest
+	(*RejectSwapResponse)(nil),            // 134: looprpc.RejectSwapResponse
+	(*ErrorDetail)(nil),                   // 135: looprpc.ErrorDetail
+	(*DebugLevelRequest)(nil),             // 136: looprpc.DebugLevelRequest
+	(*DebugLevelResponse)(nil),            // 137: looprpc.DebugLevelResponse
+	(*ReplaySwapRequest)(nil),             // 138: looprpc.ReplaySwapRequest
+	(*ReplayEvent)(nil),                   // 139: looprpc.ReplayEvent
+	(*ReplaySwapResponse)(nil),            // 140: looprpc.ReplaySwapResponse
+	(*SwapStateGraphRequest)(nil),         // 141: looprpc.SwapStateGraphRequest
+	(*StateTransition)(nil),               // 142: looprpc.StateTransition
+	(*SwapStateGraphResponse)(nil),        // 143: looprpc.SwapStateGraphResponse
+	(*PostMortemsRequest)(nil),            // 144: looprpc.PostMortemsRequest
+	(*PostMortem)(nil),                    // 145: looprpc.PostMortem
+	(*PostMortemsResponse)(nil),           // 146: looprpc.PostMortemsResponse
+	(*ServerNoticesRequest)(nil),          // 147: looprpc.ServerNoticesRequest
+	(*ServerNotice)(nil),                  // 148: looprpc.ServerNotice
+	(*ServerNoticesResponse)(nil),         // 149: looprpc.ServerNoticesResponse
+	nil,                                   // 150: looprpc.LoopOutRequest.CustomRecordsEntry
+	nil,                                   // 151: looprpc.SwapStatus.DisplayAmountsEntry
+	nil,                                   // 152: looprpc.FeeReportResponse.DisplayAmountsEntry
+	nil,                                   // 153: looprpc.LoopOutFeeComparison.DisplayAmountsEntry
+	nil,                                   // 154: looprpc.InTermsResponse.DisplayAmountsEntry
+	nil,                                   // 155: looprpc.OutTermsResponse.DisplayAmountsEntry
+	nil,                                   // 156: looprpc.InQuoteResponse.DisplayAmountsEntry
+	nil,                                   // 157: looprpc.OutQuoteResponse.DisplayAmountsEntry
+	nil,                                   // 158: looprpc.LiquidityRule.CustomRecordsEntry
+	(*swapserverrpc.RouteHint)(nil),       // 159: looprpc.RouteHint
+}
+var file_client_proto_depIdxs = []int32{
+	150, // 0: looprpc.LoopOutRequest.custom_records:type_name -> looprpc.LoopOutRequest.CustomRecordsEntry
+	159, // 1: looprpc.LoopInRequest.route_hints:type_name -> looprpc.RouteHint
+	25,  // 2: looprpc.SwapResponse.validation:type_name -> looprpc.SwapValidation
+	22,  // 3: looprpc.SwapValidation.loop_out_request:type_name -> looprpc.LoopOutRequest
+	23,  // 4: looprpc.SwapValidation.loop_in_request:type_name -> looprpc.LoopInRequest
+	1,   // 5: looprpc.MonitorRequest.display_unit:type_name -> looprpc.DisplayUnit
+	2,   // 6: looprpc.SwapStatus.type:type_name -> looprpc.SwapType
+	3,   // 7: looprpc.SwapStatus.state:type_name -> looprpc.SwapState
+	4,   // 8: looprpc.SwapStatus.failure_reason:type_name -> looprpc.FailureReason
+	151, // 9: looprpc.SwapStatus.display_amounts:type_name -> looprpc.SwapStatus.DisplayAmountsEntry
+	28,  // 10: looprpc.SwapStatus.dest_verification:type_name -> looprpc.DestVerification
+	0,   // 11: looprpc.DestVerification.method:type_name -> looprpc.DestVerificationMethod
+	1,   // 12: looprpc.ListSwapsRequest.display_unit:type_name -> looprpc.DisplayUnit
+	27,  // 13: looprpc.ListSwapsResponse.swaps:type_name -> looprpc.SwapStatus
+	1,   // 14: looprpc.SwapInfoRequest.display_unit:type_name -> looprpc.DisplayUnit
+	27,  // 15: looprpc.SearchSwapsResponse.swaps:type_name -> looprpc.SwapStatus
+	27,  // 16: looprpc.SwapConflict.loop_out:type_name -> looprpc.SwapStatus
+	27,  // 17: looprpc.SwapConflict.loop_in:type_name -> looprpc.SwapStatus
+	37,  // 18: looprpc.ListSwapConflictsResponse.conflicts:type_name -> looprpc.SwapConflict
+	2,   // 19: looprpc.ResolveSwapConflictRequest.keep:type_name -> looprpc.SwapType
+	27,  // 20: looprpc.ResolveSwapConflictResponse.swap:type_name -> looprpc.SwapStatus
+	1,   // 21: looprpc.FeeReportRequest.display_unit:type_name -> looprpc.DisplayUnit
+	43,  // 22: looprpc.FeeReportResponse.swaps:type_name -> looprpc.LoopOutFeeComparison
+	152, // 23: looprpc.FeeReportResponse.display_amounts:type_name -> looprpc.FeeReportResponse.DisplayAmountsEntry
+	153, // 24: looprpc.LoopOutFeeComparison.display_amounts:type_name -> looprpc.LoopOutFeeComparison.DisplayAmountsEntry
+	46,  // 25: looprpc.AccountingReportResponse.discrepancies:type_name -> looprpc.AccountingDiscrepancy
+	2,   // 26: looprpc.AccountingDiscrepancy.type:type_name -> looprpc.SwapType
+	5,   // 27: looprpc.AccountingDiscrepancy.check:type_name -> looprpc.AccountingCheck
+	2,   // 28: looprpc.WatchSwapRequest.type:type_name -> looprpc.SwapType
+	51,  // 29: looprpc.ListWatchedSwapsResponse.swaps:type_name -> looprpc.WatchedSwap
+	2,   // 30: looprpc.WatchedSwap.type:type_name -> looprpc.SwapType
+	6,   // 31: looprpc.WatchedSwap.state:type_name -> looprpc.WatchedSwapState
+	1,   // 32: looprpc.TermsRequest.display_unit:type_name -> looprpc.DisplayUnit
+	154, // 33: looprpc.InTermsResponse.display_amounts:type_name -> looprpc.InTermsResponse.DisplayAmountsEntry
+	155, // 34: looprpc.OutTermsResponse.display_amounts:type_name -> looprpc.OutTermsResponse.DisplayAmountsEntry
+	159, // 35: looprpc.QuoteRequest.loop_in_route_hints:type_name -> looprpc.RouteHint
+	1,   // 36: looprpc.QuoteRequest.display_unit:type_name -> looprpc.DisplayUnit
+	156, // 37: looprpc.InQuoteResponse.display_amounts:type_name -> looprpc.InQuoteResponse.DisplayAmountsEntry
+	157, // 38: looprpc.OutQuoteResponse.display_amounts:type_name -> looprpc.OutQuoteResponse.DisplayAmountsEntry
+	159, // 39: looprpc.ProbeRequest.route_hints:type_name -> looprpc.RouteHint
+	70,  // 40: looprpc.TokensResponse.tokens:type_name -> looprpc.LsatToken
+	64,  // 41: looprpc.LndFeaturesResponse.features:type_name -> looprpc.LndFeatureStatus
+	69,  // 42: looprpc.RecoveryTestResponse.checks:type_name -> looprpc.RecoveryCheck
+	74,  // 43: looprpc.LiquidityParameters.rules:type_name -> looprpc.LiquidityRule
+	73,  // 44: looprpc.LiquidityParameters.autoloop_schedule:type_name -> looprpc.AutoloopWindow
+	9,   // 45: looprpc.LiquidityParameters.rule_conflict:type_name -> looprpc.RuleConflict
+	10,  // 46: looprpc.LiquidityParameters.loop_in_spread:type_name -> looprpc.LoopInSpread
+	2,   // 47: looprpc.LiquidityRule.swap_type:type_name -> looprpc.SwapType
+	7,   // 48: looprpc.LiquidityRule.type:type_name -> looprpc.LiquidityRuleType
+	8,   // 49: looprpc.LiquidityRule.shrink_policy:type_name -> looprpc.ShrinkPolicy
+	158, // 50: looprpc.LiquidityRule.custom_records:type_name -> looprpc.LiquidityRule.CustomRecordsEntry
+	72,  // 51: looprpc.SetLiquidityParamsRequest.parameters:type_name -> looprpc.LiquidityParameters
+	95,  // 52: looprpc.SetLiquidityParamsResponse.pending_increase:type_name -> looprpc.PendingIncrease
+	11,  // 53: looprpc.ConfigValue.source:type_name -> looprpc.ConfigSource
+	78,  // 54: looprpc.GetEffectiveConfigResponse.daemon:type_name -> looprpc.ConfigValue
+	78,  // 55: looprpc.GetEffectiveConfigResponse.liquidity:type_name -> looprpc.ConfigValue
+	74,  // 56: looprpc.ApplyRulesRequest.rule:type_name -> looprpc.LiquidityRule
+	12,  // 57: looprpc.RuleChange.type:type_name -> looprpc.RuleChangeType
+	74,  // 58: looprpc.RuleChange.old_rule:type_name -> looprpc.LiquidityRule
+	74,  // 59: looprpc.RuleChange.new_rule:type_name -> looprpc.LiquidityRule
+	81,  // 60: looprpc.ApplyRulesResponse.changes:type_name -> looprpc.RuleChange
+	82,  // 61: looprpc.ApplyRulesResponse.current_swaps:type_name -> looprpc.ProjectedSwaps
+	82,  // 62: looprpc.ApplyRulesResponse.projected_swaps:type_name -> looprpc.ProjectedSwaps
+	72,  // 63: looprpc.LiquidityProfile.parameters:type_name -> looprpc.LiquidityParameters
+	73,  // 64: looprpc.ProfileSwitch.window:type_name -> looprpc.AutoloopWindow
+	87,  // 65: looprpc.ListProfilesResponse.profiles:type_name -> looprpc.LiquidityProfile
+	88,  // 66: looprpc.ListProfilesResponse.schedule:type_name -> looprpc.ProfileSwitch
+	88,  // 67: looprpc.SetProfileScheduleRequest.schedule:type_name -> looprpc.ProfileSwitch
+	13,  // 68: looprpc.ParamsChange.source:type_name -> looprpc.ParamsChangeSource
+	93,  // 69: looprpc.GetParamsHistoryResponse.changes:type_name -> looprpc.ParamsChange
+	72,  // 70: looprpc.PendingIncrease.parameters:type_name -> looprpc.LiquidityParameters
+	95,  // 71: looprpc.GetPendingIncreaseResponse.increase:type_name -> looprpc.PendingIncrease
+	95,  // 72: looprpc.CancelPendingIncreaseResponse.cancelled:type_name -> looprpc.PendingIncrease
+	14,  // 73: looprpc.Disqualified.reason:type_name -> looprpc.AutoReason
+	22,  // 74: looprpc.SuggestSwapsResponse.loop_out:type_name -> looprpc.LoopOutRequest
+	23,  // 75: looprpc.SuggestSwapsResponse.loop_in:type_name -> looprpc.LoopInRequest
+	102, // 76: looprpc.SuggestSwapsResponse.disqualified:type_name -> looprpc.Disqualified
+	105, // 77: looprpc.SuggestSwapsResponse.rebalances:type_name -> looprpc.RebalanceSuggestion
+	148, // 78: looprpc.SuggestSwapsResponse.server_notices:type_name -> looprpc.ServerNotice
+	104, // 79: looprpc.SuggestSwapsResponse.scores:type_name -> looprpc.SwapScore
+	2,   // 80: looprpc.SwapScore.type:type_name -> looprpc.SwapType
+	14,  // 81: looprpc.PreviewFeesResponse.reason:type_name -> looprpc.AutoReason
+	15,  // 82: looprpc.CompareRebalanceResponse.preferred:type_name -> looprpc.RebalanceMethod
+	27,  // 83: looprpc.CloseAdviceResponse.in_flight:type_name -> looprpc.SwapStatus
+	22,  // 84: looprpc.CloseAdviceResponse.suggested_loop_out:type_name -> looprpc.LoopOutRequest
+	23,  // 85: looprpc.CloseAdviceResponse.suggested_loop_in:type_name -> looprpc.LoopInRequest
+	114, // 86: looprpc.AutoloopStatsResponse.ticks:type_name -> looprpc.AutoloopTick
+	117, // 87: looprpc.SuggestionHistoryResponse.rounds:type_name -> looprpc.SuggestionRound
+	118, // 88: looprpc.SuggestionRound.loop_out:type_name -> looprpc.SuggestedSwap
+	118, // 89: looprpc.SuggestionRound.loop_in:type_name -> looprpc.SuggestedSwap
+	118, // 90: looprpc.SuggestionRound.rebalances:type_name -> looprpc.SuggestedSwap
+	102, // 91: looprpc.SuggestionRound.disqualified:type_name -> looprpc.Disqualified
+	16,  // 92: looprpc.SimulatedSwap.type:type_name -> looprpc.SimulatedSwapType
+	120, // 93: looprpc.SimulatedSwapsResponse.swaps:type_name -> looprpc.SimulatedSwap
+	121, // 94: looprpc.SimulatedSwapsResponse.loop_out:type_name -> looprpc.SimulationTotals
+	121, // 95: looprpc.SimulatedSwapsResponse.loop_in:type_name -> looprpc.SimulationTotals
+	121, // 96: looprpc.SimulatedSwapsResponse.rebalance:type_name -> looprpc.SimulationTotals
+	17,  // 97: looprpc.CalendarEvent.type:type_name -> looprpc.CalendarEventType
+	126, // 98: looprpc.AutoloopCalendarResponse.events:type_name -> looprpc.CalendarEvent
+	130, // 99: looprpc.ListApprovalsResponse.approvals:type_name -> looprpc.PendingApproval
+	22,  // 100: looprpc.PendingApproval.loop_out:type_name -> looprpc.LoopOutRequest
+	23,  // 101: looprpc.PendingApproval.loop_in:type_name -> looprpc.LoopInRequest
+	105, // 102: looprpc.PendingApproval.rebalance:type_name -> looprpc.RebalanceSuggestion
+	18,  // 103: looprpc.ErrorDetail.code:type_name -> looprpc.ErrorCode
+	19,  // 104: looprpc.ReplayEvent.source:type_name -> looprpc.ReplayEventSource
+	27,  // 105: looprpc.ReplaySwapResponse.swap:type_name -> looprpc.SwapStatus
+	139, // 106: looprpc.ReplaySwapResponse.events:type_name -> looprpc.ReplayEvent
+	2,   // 107: looprpc.SwapStateGraphRequest.swap_type:type_name -> looprpc.SwapType
+	20,  // 108: looprpc.SwapStateGraphRequest.format:type_name -> looprpc.StateGraphFormat
+	2,   // 109: looprpc.SwapStateGraphResponse.swap_type:type_name -> looprpc.SwapType
+	142, // 110: looprpc.SwapStateGraphResponse.transitions:type_name -> looprpc.StateTransition
+	27,  // 111: looprpc.PostMortem.swap:type_name -> looprpc.SwapStatus
+	139, // 112: looprpc.PostMortem.events:type_name -> looprpc.ReplayEvent
+	139, // 113: looprpc.PostMortem.last_errors:type_name -> looprpc.ReplayEvent
+	145, // 114: looprpc.PostMortemsResponse.post_mortems:type_name -> looprpc.PostMortem
+	21,  // 115: looprpc.ServerNotice.category:type_name -> looprpc.NoticeCategory
+	148, // 116: looprpc.ServerNoticesResponse.notices:type_name -> looprpc.ServerNotice
+	22,  // 117: looprpc.SwapClient.LoopOut:input_type -> looprpc.LoopOutRequest
+	23,  // 118: looprpc.SwapClient.LoopIn:input_type -> looprpc.LoopInRequest
+	26,  // 119: looprpc.SwapClient.Monitor:input_type -> looprpc.MonitorRequest
+	29,  // 120: looprpc.SwapClient.ListSwaps:input_type -> looprpc.ListSwapsRequest
+	31,  // 121: looprpc.SwapClient.SwapInfo:input_type -> looprpc.SwapInfoRequest
+	32,  // 122: looprpc.SwapClient.SetSwapNotes:input_type -> looprpc.SetSwapNotesRequest
+	34,  // 123: looprpc.SwapClient.SearchSwaps:input_type -> looprpc.SearchSwapsRequest
+	36,  // 124: looprpc.SwapClient.ListSwapConflicts:input_type -> looprpc.ListSwapConflictsRequest
+	39,  // 125: looprpc.SwapClient.ResolveSwapConflict:input_type -> looprpc.ResolveSwapConflictRequest
+	41,  // 126: looprpc.SwapClient.GetFeeReport:input_type -> looprpc.FeeReportRequest
+	44,  // 127: looprpc.SwapClient.GetAccountingReport:input_type -> looprpc.AccountingReportRequest
+	47,  // 128: looprpc.SwapClient.WatchSwap:input_type -> looprpc.WatchSwapRequest
+	49,  // 129: looprpc.SwapClient.ListWatchedSwaps:input_type -> looprpc.ListWatchedSwapsRequest
+	52,  // 130: looprpc.SwapClient.LoopOutTerms:input_type -> looprpc.TermsRequest
+	55,  // 131: looprpc.SwapClient.LoopOutQuote:input_type -> looprpc.QuoteRequest
+	52,  // 132: looprpc.SwapClient.GetLoopInTerms:input_type -> looprpc.TermsRequest
+	55,  // 133: looprpc.SwapClient.GetLoopInQuote:input_type -> looprpc.QuoteRequest
+	58,  // 134: looprpc.SwapClient.Probe:input_type -> looprpc.ProbeRequest
+	60,  // 135: looprpc.SwapClient.GetLsatTokens:input_type -> looprpc.TokensRequest
+	62,  // 136: looprpc.SwapClient.GetLndFeatures:input_type -> looprpc.LndFeaturesRequest
+	65,  // 137: looprpc.SwapClient.GetInfo:input_type -> looprpc.GetInfoRequest
+	67,  // 138: looprpc.SwapClient.RecoveryTest:input_type -> looprpc.RecoveryTestRequest
+	71,  // 139: looprpc.SwapClient.GetLiquidityParams:input_type -> looprpc.GetLiquidityParamsRequest
+	75,  // 140: looprpc.SwapClient.SetLiquidityParams:input_type -> looprpc.SetLiquidityParamsRequest
+	77,  // 141: looprpc.SwapClient.GetEffectiveConfig:input_type -> looprpc.GetEffectiveConfigRequest
+	80,  // 142: looprpc.SwapClient.ApplyRules:input_type -> looprpc.ApplyRulesRequest
+	84,  // 143: looprpc.SwapClient.SaveProfile:input_type -> looprpc.SaveProfileRequest
+	86,  // 144: looprpc.SwapClient.ListProfiles:input_type -> looprpc.ListProfilesRequest
+	90,  // 145: looprpc.SwapClient.SetProfileSchedule:input_type -> looprpc.SetProfileScheduleRequest
+	92,  // 146: looprpc.SwapClient.GetParamsHistory:input_type -> looprpc.GetParamsHistoryRequest
+	96,  // 147: looprpc.SwapClient.GetPendingIncrease:input_type -> looprpc.GetPendingIncreaseRequest
+	98,  // 148: looprpc.SwapClient.CancelPendingIncrease:input_type -> looprpc.CancelPendingIncreaseRequest
+	100, // 149: looprpc.SwapClient.SuggestSwaps:input_type -> looprpc.SuggestSwapsRequest
+	101, // 150: looprpc.SwapClient.SubscribeSuggestions:input_type -> looprpc.SubscribeSuggestionsRequest
+	106, // 151: looprpc.SwapClient.PreviewFees:input_type -> looprpc.PreviewFeesRequest
+	108, // 152: looprpc.SwapClient.CompareRebalance:input_type -> looprpc.CompareRebalanceRequest
+	110, // 153: looprpc.SwapClient.CloseAdvice:input_type -> looprpc.CloseAdviceRequest
+	112, // 154: looprpc.SwapClient.GetAutoloopStats:input_type -> looprpc.AutoloopStatsRequest
+	115, // 155: looprpc.SwapClient.GetSuggestionHistory:input_type -> looprpc.SuggestionHistoryRequest
+	125, // 156: looprpc.SwapClient.GetAutoloopCalendar:input_type -> looprpc.AutoloopCalendarRequest
+	119, // 157: looprpc.SwapClient.GetSimulatedSwaps:input_type -> looprpc.SimulatedSwapsRequest
+	123, // 158: looprpc.SwapClient.ClearSimulatedSwaps:input_type -> looprpc.ClearSimulatedSwapsRequest
+	128, // 159: looprpc.SwapClient.ListApprovals:input_type -> looprpc.ListApprovalsRequest
+	131, // 160: looprpc.SwapClient.ApproveSwap:input_type -> looprpc.ApproveSwapRequest
+	133, // 161: looprpc.SwapClient.RejectSwap:input_type -> looprpc.RejectSwapRequest
+	147, // 162: looprpc.SwapClient.GetServerNotices:input_type -> looprpc.ServerNoticesRequest
+	136, // 163: looprpc.SwapClient.DebugLevel:input_type -> looprpc.DebugLevelRequest
+	138, // 164: looprpc.SwapClient.ReplaySwap:input_type -> looprpc.ReplaySwapRequest
+	141, // 165: looprpc.SwapClient.GetSwapStateGraph:input_type -> looprpc.SwapStateGraphRequest
+	144, // 166: looprpc.SwapClient.GetPostMortems:input_type -> looprpc.PostMortemsRequest
+	24,  // 167: looprpc.SwapClient.LoopOut:output_type -> looprpc.SwapResponse
+	24,  // 168: looprpc.SwapClient.LoopIn:output_type -> looprpc.SwapResponse
+	27,  // 169: looprpc.SwapClient.Monitor:output_type -> looprpc.SwapStatus
+	30,  // 170: looprpc.SwapClient.ListSwaps:output_type -> looprpc.ListSwapsResponse
+	27,  // 171: looprpc.SwapClient.SwapInfo:output_type -> looprpc.SwapStatus
+	33,  // 172: looprpc.SwapClient.SetSwapNotes:output_type -> looprpc.SetSwapNotesResponse
+	35,  // 173: looprpc.SwapClient.SearchSwaps:output_type -> looprpc.SearchSwapsResponse
+	38,  // 174: looprpc.SwapClient.ListSwapConflicts:output_type -> looprpc.ListSwapConflictsResponse
+	40,  // 175: looprpc.SwapClient.ResolveSwapConflict:output_type -> looprpc.ResolveSwapConflictResponse
+	42,  // 176: looprpc.SwapClient.GetFeeReport:output_type -> looprpc.FeeReportResponse
+	45,  // 177: looprpc.SwapClient.GetAccountingReport:output_type -> looprpc.AccountingReportResponse
+	48,  // 178: looprpc.SwapClient.WatchSwap:output_type -> looprpc.WatchSwapResponse
+	50,  // 179: looprpc.SwapClient.ListWatchedSwaps:output_type -> looprpc.ListWatchedSwapsResponse
+	54,  // 180: looprpc.SwapClient.LoopOutTerms:output_type -> looprpc.OutTermsResponse
+	57,  // 181: looprpc.SwapClient.LoopOutQuote:output_type -> looprpc.OutQuoteResponse
+	53,  // 182: looprpc.SwapClient.GetLoopInTerms:output_type -> looprpc.InTermsResponse
+	56,  // 183: looprpc.SwapClient.GetLoopInQuote:output_type -> looprpc.InQuoteResponse
+	59,  // 184: looprpc.SwapClient.Probe:output_type -> looprpc.ProbeResponse
+	61,  // 185: looprpc.SwapClient.GetLsatTokens:output_type -> looprpc.TokensResponse
+	63,  // 186: looprpc.SwapClient.GetLndFeatures:output_type -> looprpc.LndFeaturesResponse
+	66,  // 187: looprpc.SwapClient.GetInfo:output_type -> looprpc.GetInfoResponse
+	68,  // 188: looprpc.SwapClient.RecoveryTest:output_type -> looprpc.RecoveryTestResponse
+	72,  // 189: looprpc.SwapClient.GetLiquidityParams:output_type -> looprpc.LiquidityParameters
+	76,  // 190: looprpc.SwapClient.SetLiquidityParams:output_type -> looprpc.SetLiquidityParamsResponse
+	79,  // 191: looprpc.SwapClient.GetEffectiveConfig:output_type -> looprpc.GetEffectiveConfigResponse
+	83,  // 192: looprpc.SwapClient.ApplyRules:output_type -> looprpc.ApplyRulesResponse
+	85,  // 193: looprpc.SwapClient.SaveProfile:output_type -> looprpc.SaveProfileResponse
+	89,  // 194: looprpc.SwapClient.ListProfiles:output_type -> looprpc.ListProfilesResponse
+	91,  // 195: looprpc.SwapClient.SetProfileSchedule:output_type -> looprpc.SetProfileScheduleResponse
+	94,  // 196: looprpc.SwapClient.GetParamsHistory:output_type -> looprpc.GetParamsHistoryResponse
+	97,  // 197: looprpc.SwapClient.GetPendingIncrease:output_type -> looprpc.GetPendingIncreaseResponse
+	99,  // 198: looprpc.SwapClient.CancelPendingIncrease:output_type -> looprpc.CancelPendingIncreaseResponse
+	103, // 199: looprpc.SwapClient.SuggestSwaps:output_type -> looprpc.SuggestSwapsResponse
+	103, // 200: looprpc.SwapClient.SubscribeSuggestions:output_type -> looprpc.SuggestSwapsResponse
+	107, // 201: looprpc.SwapClient.PreviewFees:output_type -> looprpc.PreviewFeesResponse
+	109, // 202: looprpc.SwapClient.CompareRebalance:output_type -> looprpc.CompareRebalanceResponse
+	111, // 203: looprpc.SwapClient.CloseAdvice:output_type -> looprpc.CloseAdviceResponse
+	113, // 204: looprpc.SwapClient.GetAutoloopStats:output_type -> looprpc.AutoloopStatsResponse
+	116, // 205: looprpc.SwapClient.GetSuggestionHistory:output_type -> looprpc.SuggestionHistoryResponse
+	127, // 206: looprpc.SwapClient.GetAutoloopCalendar:output_type -> looprpc.AutoloopCalendarResponse
+	122, // 207: looprpc.SwapClient.GetSimulatedSwaps:output_type -> looprpc.SimulatedSwapsResponse
+	124, // 208: looprpc.SwapClient.ClearSimulatedSwaps:output_type -> looprpc.ClearSimulatedSwapsResponse
+	129, // 209: looprpc.SwapClient.ListApprovals:output_type -> looprpc.ListApprovalsResponse
+	132, // 210: looprpc.SwapClient.ApproveSwap:output_type -> looprpc.ApproveSwapResponse
+	134, // 211: looprpc.SwapClient.RejectSwap:output_type -> looprpc.RejectSwapResponse
+	149, // 212: looprpc.SwapClient.GetServerNotices:output_type -> looprpc.ServerNoticesResponse
+	137, // 213: looprpc.SwapClient.DebugLevel:output_type -> looprpc.DebugLevelResponse
+	140, // 214: looprpc.SwapClient.ReplaySwap:output_type -> looprpc.ReplaySwapResponse
+	143, // 215: looprpc.SwapClient.GetSwapStateGraph:output_type -> looprpc.SwapStateGraphResponse
+	146, // 216: looprpc.SwapClient.GetPostMortems:output_type -> looprpc.PostMortemsResponse
+	167, // [167:217] is the sub-list for method output_type
+	117, // [117:167] is the sub-list for method input_type
+	117, // [117:117] is the sub-list for extension type_name
+	117, // [117:117] is the sub-list for extension extendee
+	0,   // [0:117] is the sub-list for field type_name
+}
+
+func init() { file_client_proto_init() }
+func file_client_proto_init() {
+	if File_client_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_client_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoopOutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoopInRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapValidation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DestVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSwapNotesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSwapNotesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSwapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSwapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapConflictsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapConflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapConflictsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveSwapConflictRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveSwapConflictResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoopOutFeeComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountingReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountingReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountingDiscrepancy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWatchedSwapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWatchedSwapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchedSwap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TermsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InTermsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutTermsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InQuoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutQuoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokensResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LndFeaturesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LndFeaturesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LndFeatureStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryTestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryTestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsatToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLiquidityParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidityParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoloopWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidityRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLiquidityParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLiquidityParamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEffectiveConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEffectiveConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectedSwaps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProfilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidityProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileSwitch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProfilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProfileScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProfileScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetParamsHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParamsChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetParamsHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingIncrease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPendingIncreaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPendingIncreaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPendingIncreaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPendingIncreaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestSwapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSuggestionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Disqualified); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestSwapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RebalanceSuggestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewFeesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewFeesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareRebalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareRebalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseAdviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseAdviceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoloopStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoloopStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoloopTick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestionHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestionHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestionRound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestedSwap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulatedSwapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulatedSwap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulationTotals); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_client_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulatedSwapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SuggestSwapsResponse.ProtoReflect.Descriptor instead.
-func (*SuggestSwapsResponse) Descriptor() ([]byte, []int) {
-	return file_client_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *SuggestSwapsResponse) GetLoopOut() []*LoopOutRequest {
-	if x != nil {
-		return x.LoopOut
-	}
-	return nil
-}
-
-func (x *SuggestSwapsResponse) GetLoopIn() []*LoopInRequest {
-	if x != nil {
-		return x.LoopIn
-	}
-	return nil
-}
-
-func (x *SuggestSwapsResponse) GetDisqualified() []*Disqualified {
-	if x != nil {
-		return x.Disqualified
-	}
-	return nil
-}
-
-var File_client_proto protoreflect.FileDescriptor
-
-var file_client_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
-	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x1a, 0x1a, 0x73, 0x77, 0x61, 0x70, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xad, 0x04, 0x0a, 0x0e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x14,
-	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
-	0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x53,
-	0x77, 0x61, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x12, 0x33, 0x0a,
-	0x16, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x6f, 0x75, 0x74,
-	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d,
-	0x61, 0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46,
-	0x65, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66,
-	0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61,
-	0x70, 0x46, 0x65, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70,
-	0x61, 0x79, 0x5f, 0x61, 0x6d, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61,
-	0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x41, 0x6d, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61,
-	0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x2c,
-	0x0a, 0x10, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x6c, 0x6f,
-	0x6f, 0x70, 0x4f, 0x75, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x2a, 0x0a, 0x11,
-	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x73, 0x65,
-	0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
-	0x67, 0x43, 0x68, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x77, 0x65, 0x65,
-	0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x73, 0x77, 0x61, 0x70, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x74, 0x6f, 0x72, 0x22, 0xd4, 0x02, 0x0a, 0x0d, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73,
-	0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d,
-	0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
-	0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x74, 0x6c, 0x63, 0x12, 0x28, 0x0a,
-	0x10, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x68, 0x74, 0x6c, 0x63, 0x43, 0x6f, 0x6e,
-	0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a,
-	0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x0b, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x48, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x0c, 0x53,
-	0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x69, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0c, 0x68, 0x74,
-	0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x5f, 0x6e, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x70, 0x32, 0x77, 0x73,
-	0x68, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x5f, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68,
-	0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x32, 0x77, 0x73, 0x68, 0x12,
-	0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb4, 0x04, 0x0a, 0x0a, 0x53, 0x77, 0x61,
-	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a,
-	0x08, 0x69, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x69, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
-	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x66, 0x61, 0x69,
-	0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x61, 0x69, 0x6c,
-	0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75,
-	0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0c, 0x68,
-	0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x5f, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x68, 0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x32, 0x77, 0x73, 0x68,
-	0x12, 0x2e, 0x0a, 0x13, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x5f, 0x6e, 0x70, 0x32, 0x77, 0x73, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68,
-	0x74, 0x6c, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x70, 0x32, 0x77, 0x73, 0x68,
-	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x73, 0x74, 0x4f, 0x6e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x66,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x73,
-	0x74, 0x4f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22,
-	0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70,
-	0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x73, 0x77,
-	0x61, 0x70, 0x73, 0x22, 0x21, 0x0a, 0x0f, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7f, 0x0a, 0x0f, 0x49, 0x6e, 0x54, 0x65, 0x72, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e,
-	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53,
-	0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a,
-	0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10,
-	0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0xcc, 0x01, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x54,
-	0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f,
-	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70,
-	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d,
-	0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e,
-	0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x43, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c,
-	0x74, 0x61, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64,
-	0x65, 0x6c, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x43,
-	0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04,
-	0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05,
-	0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0xa8, 0x02, 0x0a, 0x0c, 0x51, 0x75, 0x6f, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
-	0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x74, 0x6c, 0x63, 0x12,
-	0x3a, 0x0a, 0x19, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x17, 0x73, 0x77, 0x61, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x10, 0x6c,
-	0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x4c, 0x61, 0x73,
-	0x74, 0x48, 0x6f, 0x70, 0x12, 0x41, 0x0a, 0x13, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x5f,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x10, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x22, 0xb0, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65,
-	0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x77, 0x61,
-	0x70, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x68, 0x74, 0x6c, 0x63, 0x5f,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x68, 0x74, 0x6c, 0x63, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x74, 0x76,
-	0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6c,
-	0x74, 0x76, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f,
-	0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04,
-	0x08, 0x04, 0x10, 0x05, 0x22, 0xf3, 0x01, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x51, 0x75, 0x6f, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x77, 0x61,
-	0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x73, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x70,
-	0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x41, 0x6d, 0x74, 0x53, 0x61,
-	0x74, 0x12, 0x2b, 0x0a, 0x12, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f,
-	0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x68,
-	0x74, 0x6c, 0x63, 0x53, 0x77, 0x65, 0x65, 0x70, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2a,
-	0x0a, 0x11, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64,
-	0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x50,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c,
-	0x74, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x63, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
-	0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x70, 0x0a, 0x0c, 0x50, 0x72,
-	0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61, 0x6d, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x6c, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x33, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c,
-	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74,
-	0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x0f, 0x0a, 0x0d,
-	0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x0a,
-	0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c,
-	0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2a, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x73, 0x61, 0x74, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xbb, 0x02, 0x0a,
-	0x09, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61,
-	0x73, 0x65, 0x5f, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12,
-	0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72,
-	0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a,
-	0x10, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50,
-	0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x69,
-	0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46,
-	0x65, 0x65, 0x50, 0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65,
-	0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8a, 0x06, 0x0a, 0x13, 0x4c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12,
-	0x2c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
-	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x0a,
-	0x07, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x66, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x3d, 0x0a, 0x1c, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f,
-	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x73, 0x77,
-	0x65, 0x65, 0x70, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72,
-	0x56, 0x62, 0x79, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61,
-	0x70, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0d, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x2d,
-	0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65,
-	0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78,
-	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x3a, 0x0a,
-	0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x6f, 0x75, 0x74,
-	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74,
-	0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
-	0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x53, 0x61, 0x74, 0x12,
-	0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65,
-	0x5f, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4d,
-	0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x77,
-	0x65, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66,
-	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
-	0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x42, 0x61, 0x63, 0x6b,
-	0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f,
-	0x6f, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f,
-	0x6f, 0x70, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x62,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x11, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53,
-	0x61, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x62,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x6f, 0x6f, 0x70, 0x42,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x63, 0x12, 0x2b, 0x0a,
-	0x12, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x4d,
-	0x61, 0x78, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69,
-	0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78,
-	0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x74,
-	0x6c, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x68, 0x74, 0x6c, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x22, 0x84, 0x02, 0x0a, 0x0d, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
-	0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
-	0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x73, 0x77, 0x61,
-	0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x6f,
-	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52,
-	0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a,
-	0x12, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6f, 0x6d,
-	0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2d, 0x0a, 0x12,
-	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69,
-	0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x59, 0x0a, 0x19, 0x53,
-	0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c,
-	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53,
-	0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x72, 0x0a, 0x0c, 0x44,
-	0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b,
-	0x65, 0x79, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x74,
-	0x6f, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0xb6, 0x01, 0x0a, 0x14, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x70,
-	0x5f, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f,
-	0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x07, 0x6c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x07,
-	0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x6c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x12, 0x39, 0x0a,
-	0x0c, 0x64, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69,
-	0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x71,
-	0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2a, 0x25, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x4f, 0x55, 0x54,
-	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x10, 0x01, 0x2a,
-	0x73, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0d, 0x0a, 0x09,
-	0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50,
-	0x52, 0x45, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x41, 0x4c, 0x45, 0x44,
-	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49,
-	0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
-	0x53, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12,
-	0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c,
-	0x45, 0x44, 0x10, 0x05, 0x2a, 0xed, 0x01, 0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
-	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52,
-	0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
-	0x1b, 0x0a, 0x17, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
-	0x4e, 0x5f, 0x4f, 0x46, 0x46, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16,
-	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54,
-	0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x41, 0x49, 0x4c,
-	0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x57, 0x45, 0x45, 0x50,
-	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x46, 0x41,
-	0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x53,
-	0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10,
-	0x04, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41,
-	0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4f, 0x52, 0x41, 0x52, 0x59, 0x10, 0x05, 0x12,
-	0x23, 0x0a, 0x1f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
-	0x4e, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x4d, 0x4f, 0x55,
-	0x4e, 0x54, 0x10, 0x06, 0x2a, 0x2f, 0x0a, 0x11, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
-	0x79, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x48, 0x52, 0x45, 0x53, 0x48,
-	0x4f, 0x4c, 0x44, 0x10, 0x01, 0x2a, 0xa6, 0x03, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41,
-	0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x22, 0x0a,
-	0x1e, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x44,
-	0x47, 0x45, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10,
-	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
-	0x5f, 0x53, 0x57, 0x45, 0x45, 0x50, 0x5f, 0x46, 0x45, 0x45, 0x53, 0x10, 0x02, 0x12, 0x1e, 0x0a,
-	0x1a, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x44,
-	0x47, 0x45, 0x54, 0x5f, 0x45, 0x4c, 0x41, 0x50, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a,
-	0x15, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x5f,
-	0x46, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x4f,
-	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x46, 0x45, 0x45,
-	0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
-	0x4e, 0x5f, 0x4d, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x06, 0x12, 0x16, 0x0a,
-	0x12, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x45,
-	0x50, 0x41, 0x59, 0x10, 0x07, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45,
-	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x42, 0x41, 0x43,
-	0x4b, 0x4f, 0x46, 0x46, 0x10, 0x08, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52,
-	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x09,
-	0x12, 0x17, 0x0a, 0x13, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
-	0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x10, 0x0a, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55, 0x54,
-	0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x51, 0x55, 0x49, 0x44, 0x49,
-	0x54, 0x59, 0x5f, 0x4f, 0x4b, 0x10, 0x0b, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x55, 0x54, 0x4f, 0x5f,
-	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x49, 0x4e,
-	0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x0c, 0x12, 0x20, 0x0a, 0x1c,
-	0x41, 0x55, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x45, 0x45, 0x5f,
-	0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x0d, 0x32, 0xc2,
-	0x07, 0x0a, 0x0a, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a,
-	0x07, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
-	0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x70,
-	0x49, 0x6e, 0x12, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x6f,
-	0x70, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x6f,
-	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x39, 0x0a, 0x07, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x2e, 0x6c,
-	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
-	0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x09,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x39, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x6c,
-	0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x4c,
-	0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x15, 0x2e, 0x6c, 0x6f,
-	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74,
-	0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
-	0x0c, 0x4c, 0x6f, 0x6f, 0x70, 0x4f, 0x75, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x15, 0x2e,
-	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4f,
-	0x75, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x54, 0x65, 0x72, 0x6d,
-	0x73, 0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x72, 0x6d,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72,
-	0x70, 0x63, 0x2e, 0x49, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x6f, 0x70, 0x49, 0x6e, 0x51,
-	0x75, 0x6f, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x51,
-	0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6c, 0x6f,
-	0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x15,
-	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
-	0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x4c, 0x73, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x16,
-	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x56, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x5d, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e,
-	0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69,
-	0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x4c,
-	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
-	0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f,
-	0x6c, 0x6f, 0x6f, 0x70, 0x2f, 0x6c, 0x6f, 0x6f, 0x70, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_client_proto_rawDescOnce sync.Once
-	file_client_proto_rawDescData = file_client_proto_rawDesc
-)
-
-func file_client_proto_rawDescGZIP() []byte {
-	file_client_proto_rawDescOnce.Do(func() {
-		file_client_proto_rawDescData = protoimpl.X.CompressGZIP(file_client_proto_rawDescData)
-	})
-	return file_client_proto_rawDescData
-}
-
-var file_client_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_client_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_client_proto_goTypes = []interface{}{
-	(SwapType)(0),                      // 0: looprpc.SwapType
-	(SwapState)(0),                     // 1: looprpc.SwapState
-	(FailureReason)(0),                 // 2: looprpc.FailureReason
-	(LiquidityRuleType)(0),             // 3: looprpc.LiquidityRuleType
-	(AutoReason)(0),                    // 4: looprpc.AutoReason
-	(*LoopOutRequest)(nil),             // 5: looprpc.LoopOutRequest
-	(*LoopInRequest)(nil),              // 6: looprpc.LoopInRequest
-	(*SwapResponse)(nil),               // 7: looprpc.SwapResponse
-	(*MonitorRequest)(nil),             // 8: looprpc.MonitorRequest
-	(*SwapStatus)(nil),                 // 9: looprpc.SwapStatus
-	(*ListSwapsRequest)(nil),           // 10: looprpc.ListSwapsRequest
-	(*ListSwapsResponse)(nil),          // 11: looprpc.ListSwapsResponse
-	(*SwapInfoRequest)(nil),            // 12: looprpc.SwapInfoRequest
-	(*TermsRequest)(nil),               // 13: looprpc.TermsRequest
-	(*InTermsResponse)(nil),            // 14: looprpc.InTermsResponse
-	(*OutTermsResponse)(nil),           // 15: looprpc.OutTermsResponse
-	(*QuoteRequest)(nil),               // 16: looprpc.QuoteRequest
-	(*InQuoteResponse)(nil),            // 17: looprpc.InQuoteResponse
-	(*OutQuoteResponse)(nil),           // 18: looprpc.OutQuoteResponse
-	(*ProbeRequest)(nil),               // 19: looprpc.ProbeRequest
-	(*ProbeResponse)(nil),              // 20: looprpc.ProbeResponse
-	(*TokensRequest)(nil),              // 21: looprpc.TokensRequest
-	(*TokensResponse)(nil),             // 22: looprpc.TokensResponse
-	(*LsatToken)(nil),                  // 23: looprpc.LsatToken
-	(*GetLiquidityParamsRequest)(nil),  // 24: looprpc.GetLiquidityParamsRequest
-	(*LiquidityParameters)(nil),        // 25: looprpc.LiquidityParameters
-	(*LiquidityRule)(nil),              // 26: looprpc.LiquidityRule
-	(*SetLiquidityParamsRequest)(nil),  // 27: looprpc.SetLiquidityParamsRequest
-	(*SetLiquidityParamsResponse)(nil), // 28: looprpc.SetLiquidityParamsResponse
-	(*SuggestSwapsRequest)(nil),        // 29: looprpc.SuggestSwapsRequest
-	(*Disqualified)(nil),               // 30: looprpc.Disqualified
-	(*SuggestSwapsResponse)(nil),       // 31: looprpc.SuggestSwapsResponse
-	(*swapserverrpc.RouteHint)(nil),    // 32: looprpc.RouteHint
-}
-var file_client_proto_depIdxs = []int32{
-	32, // 0: looprpc.LoopInRequest.route_hints:type_name -> looprpc.RouteHint
-	0,  // 1: looprpc.SwapStatus.type:type_name -> looprpc.SwapType
-	1,  // 2: looprpc.SwapStatus.state:type_name -> looprpc.SwapState
-	2,  // 3: looprpc.SwapStatus.failure_reason:type_name -> looprpc.FailureReason
-	9,  // 4: looprpc.ListSwapsResponse.swaps:type_name -> looprpc.SwapStatus
-	32, // 5: looprpc.QuoteRequest.loop_in_route_hints:type_name -> looprpc.RouteHint
-	32, // 6: looprpc.ProbeRequest.route_hints:type_name -> looprpc.RouteHint
-	23, // 7: looprpc.TokensResponse.tokens:type_name -> looprpc.LsatToken
-	26, // 8: looprpc.LiquidityParameters.rules:type_name -> looprpc.LiquidityRule
-	0,  // 9: looprpc.LiquidityRule.swap_type:type_name -> looprpc.SwapType
-	3,  // 10: looprpc.LiquidityRule.type:type_name -> looprpc.LiquidityRuleType
-	25, // 11: looprpc.SetLiquidityParamsRequest.parameters:type_name -> looprpc.LiquidityParameters
-	4,  // 12: looprpc.Disqualified.reason:type_name -> looprpc.AutoReason
-	5,  // 13: looprpc.SuggestSwapsResponse.loop_out:type_name -> looprpc.LoopOutRequest
-	6,  // 14: looprpc.SuggestSwapsResponse.loop_in:type_name -> looprpc.LoopInRequest
-	30, // 15: looprpc.SuggestSwapsResponse.disqualified:type_name -> looprpc.Disqualified
-	5,  // 16: looprpc.SwapClient.LoopOut:input_type -> looprpc.LoopOutRequest
-	6,  // 17: looprpc.SwapClient.LoopIn:input_type -> looprpc.LoopInRequest
-	8,  // 18: looprpc.SwapClient.Monitor:input_type -> looprpc.MonitorRequest
-	10, // 19: looprpc.SwapClient.ListSwaps:input_type -> looprpc.ListSwapsRequest
-	12, // 20: looprpc.SwapClient.SwapInfo:input_type -> looprpc.SwapInfoRequest
-	13, // 21: looprpc.SwapClient.LoopOutTerms:input_type -> looprpc.TermsRequest
-	16, // 22: looprpc.SwapClient.LoopOutQuote:input_type -> looprpc.QuoteRequest
-	13, // 23: looprpc.SwapClient.GetLoopInTerms:input_type -> looprpc.TermsRequest
-	16, // 24: looprpc.SwapClient.GetLoopInQuote:input_type -> looprpc.QuoteRequest
-	19, // 25: looprpc.SwapClient.Probe:input_type -> looprpc.ProbeRequest
-	21, // 26: looprpc.SwapClient.GetLsatTokens:input_type -> looprpc.TokensRequest
-	24, // 27: looprpc.SwapClient.GetLiquidityParams:input_type -> looprpc.GetLiquidityParamsRequest
-	27, // 28: looprpc.SwapClient.SetLiquidityParams:input_type -> looprpc.SetLiquidityParamsRequest
-	29, // 29: looprpc.SwapClient.SuggestSwaps:input_type -> looprpc.SuggestSwapsRequest
-	7,  // 30: looprpc.SwapClient.LoopOut:output_type -> looprpc.SwapResponse
-	7,  // 31: looprpc.SwapClient.LoopIn:output_type -> looprpc.SwapResponse
-	9,  // 32: looprpc.SwapClient.Monitor:output_type -> looprpc.SwapStatus
-	11, // 33: looprpc.SwapClient.ListSwaps:output_type -> looprpc.ListSwapsResponse
-	9,  // 34: looprpc.SwapClient.SwapInfo:output_type -> looprpc.SwapStatus
-	15, // 35: looprpc.SwapClient.LoopOutTerms:output_type -> looprpc.OutTermsResponse
-	18, // 36: looprpc.SwapClient.LoopOutQuote:output_type -> looprpc.OutQuoteResponse
-	14, // 37: looprpc.SwapClient.GetLoopInTerms:output_type -> looprpc.InTermsResponse
-	17, // 38: looprpc.SwapClient.GetLoopInQuote:output_type -> looprpc.InQuoteResponse
-	20, // 39: looprpc.SwapClient.Probe:output_type -> looprpc.ProbeResponse
-	22, // 40: looprpc.SwapClient.GetLsatTokens:output_type -> looprpc.TokensResponse
-	25, // 41: looprpc.SwapClient.GetLiquidityParams:output_type -> looprpc.LiquidityParameters
-	28, // 42: looprpc.SwapClient.SetLiquidityParams:output_type -> looprpc.SetLiquidityParamsResponse
-	31, // 43: looprpc.SwapClient.SuggestSwaps:output_type -> looprpc.SuggestSwapsResponse
-	30, // [30:44] is the sub-list for method output_type
-	16, // [16:30] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
-}
-
-func init() { file_client_proto_init() }
-func file_client_proto_init() {
-	if File_client_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_client_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoopOutRequest); i {
+		file_client_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearSimulatedSwapsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3263,8 +15343,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoopInRequest); i {
+		file_client_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearSimulatedSwapsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3275,8 +15355,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwapResponse); i {
+		file_client_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoloopCalendarRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3287,8 +15367,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MonitorRequest); i {
+		file_client_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalendarEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3299,8 +15379,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwapStatus); i {
+		file_client_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoloopCalendarResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3311,8 +15391,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSwapsRequest); i {
+		file_client_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListApprovalsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3323,8 +15403,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSwapsResponse); i {
+		file_client_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListApprovalsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3335,8 +15415,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwapInfoRequest); i {
+		file_client_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingApproval); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3347,8 +15427,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TermsRequest); i {
+		file_client_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveSwapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3359,8 +15439,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InTermsResponse); i {
+		file_client_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveSwapResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3371,8 +15451,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutTermsResponse); i {
+		file_client_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectSwapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3383,8 +15463,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuoteRequest); i {
+		file_client_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectSwapResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3395,8 +15475,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InQuoteResponse); i {
+		file_client_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorDetail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3407,8 +15487,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutQuoteResponse); i {
+		file_client_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugLevelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3419,8 +15499,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProbeRequest); i {
+		file_client_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugLevelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3431,8 +15511,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProbeResponse); i {
+		file_client_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaySwapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3443,8 +15523,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokensRequest); i {
+		file_client_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3455,8 +15535,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokensResponse); i {
+		file_client_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaySwapResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3467,8 +15547,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsatToken); i {
+		file_client_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapStateGraphRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3479,8 +15559,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLiquidityParamsRequest); i {
+		file_client_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateTransition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3491,8 +15571,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LiquidityParameters); i {
+		file_client_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapStateGraphResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3503,8 +15583,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LiquidityRule); i {
+		file_client_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostMortemsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3515,8 +15595,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLiquidityParamsRequest); i {
+		file_client_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostMortem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3527,8 +15607,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLiquidityParamsResponse); i {
+		file_client_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostMortemsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3539,8 +15619,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestSwapsRequest); i {
+		file_client_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerNoticesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3551,8 +15631,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Disqualified); i {
+		file_client_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerNotice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3563,8 +15643,8 @@ func file_client_proto_init() {
 				return nil
 			}
 		}
-		file_client_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SuggestSwapsResponse); i {
+		file_client_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerNoticesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3581,8 +15661,8 @@ func file_client_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_client_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   27,
+			NumEnums:      22,
+			NumMessages:   137,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
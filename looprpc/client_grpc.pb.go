@@ -40,6 +40,55 @@ type SwapClientClient interface {
 	// loop: `swapinfo`
 	//SwapInfo returns all known details about a single swap.
 	SwapInfo(ctx context.Context, in *SwapInfoRequest, opts ...grpc.CallOption) (*SwapStatus, error)
+	// loop: `setnotes`
+	//SetSwapNotes attaches free-text notes to an existing swap, replacing any
+	//notes that were previously set. Notes can be edited at any point in the
+	//swap's lifetime and are cleared by setting an empty string.
+	SetSwapNotes(ctx context.Context, in *SetSwapNotesRequest, opts ...grpc.CallOption) (*SetSwapNotesResponse, error)
+	// loop: `searchswaps`
+	//SearchSwaps returns all swaps that have a hash, label, notes, htlc txid,
+	//invoice, destination address or peer pubkey that contains the query
+	//provided. Matching is case-insensitive.
+	SearchSwaps(ctx context.Context, in *SearchSwapsRequest, opts ...grpc.CallOption) (*SearchSwapsResponse, error)
+	// loop: `swapconflicts`
+	//ListSwapConflicts returns the swap hashes that are used by both a loop out
+	//and a loop in swap in the daemon's swap store. This can happen when a
+	//restored backup is merged with a live database. Pending swaps with a
+	//conflicting hash are not resumed until the conflict is resolved.
+	ListSwapConflicts(ctx context.Context, in *ListSwapConflictsRequest, opts ...grpc.CallOption) (*ListSwapConflictsResponse, error)
+	// loop: `resolveconflict`
+	//ResolveSwapConflict resolves a conflict between a loop out and a loop in
+	//swap that share a hash by keeping the swap of the type provided. The
+	//record of the other swap is set aside in the swap store rather than being
+	//deleted. If the swap that is kept is pending, it is resumed the next time
+	//the daemon is started.
+	ResolveSwapConflict(ctx context.Context, in *ResolveSwapConflictRequest, opts ...grpc.CallOption) (*ResolveSwapConflictResponse, error)
+	// loop: `feereport`
+	//GetFeeReport compares the cost of each successful loop out to the
+	//estimated cost of a plain on-chain send of the same amount, and of closing
+	//and reopening a channel instead, at the fee rate that the swap's sweep
+	//paid.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetFeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error)
+	// loop: `accounting`
+	//GetAccountingReport returns the outcome of the daemon's last accounting
+	//check, which reconciles the costs recorded for completed swaps against
+	//lnd's payment and transaction records. Accounting checks must be enabled
+	//with the accountingcheckinterval option.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetAccountingReport(ctx context.Context, in *AccountingReportRequest, opts ...grpc.CallOption) (*AccountingReportResponse, error)
+	// loop: `watchswap`
+	//WatchSwap adds a swap that was initiated by another loop client using the
+	//same lnd node to the daemon's watch list. The daemon does not hold the
+	//contracts of watched swaps, so it only monitors their payments, invoices
+	//and transactions in lnd for accounting purposes.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	WatchSwap(ctx context.Context, in *WatchSwapRequest, opts ...grpc.CallOption) (*WatchSwapResponse, error)
+	// loop: `watchedswaps`
+	//ListWatchedSwaps returns the swaps in the daemon's watch list, along with
+	//the state and costs that lnd's records imply for them.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ListWatchedSwaps(ctx context.Context, in *ListWatchedSwapsRequest, opts ...grpc.CallOption) (*ListWatchedSwapsResponse, error)
 	// loop: `terms`
 	//LoopOutTerms returns the terms that the server enforces for a loop out swap.
 	LoopOutTerms(ctx context.Context, in *TermsRequest, opts ...grpc.CallOption) (*OutTermsResponse, error)
@@ -60,6 +109,22 @@ type SwapClientClient interface {
 	// loop: `listauth`
 	//GetLsatTokens returns all LSAT tokens the daemon ever paid for.
 	GetLsatTokens(ctx context.Context, in *TokensRequest, opts ...grpc.CallOption) (*TokensResponse, error)
+	// loop: `lndfeatures`
+	//GetLndFeatures returns the version dependent lnd features that the daemon
+	//detected on startup, and whether they are enabled for the connected lnd.
+	GetLndFeatures(ctx context.Context, in *LndFeaturesRequest, opts ...grpc.CallOption) (*LndFeaturesResponse, error)
+	// loop: `getinfo`
+	//GetInfo returns basic information about the daemon, including whether the
+	//connected lnd is synced to the chain. While lnd is not synced, the daemon
+	//runs in a degraded state in which new swaps are refused and autoloop ticks
+	//are skipped, but pending swaps are still monitored.
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// loop: `recoverytest`
+	//RecoveryTest runs a drill that exercises the timeout and uncooperative
+	//sweep paths of a synthetic swap, to verify that the daemon is able to
+	//recover its funds with the keys, scripts and wallet of this node. It is
+	//only available on regtest and signet.
+	RecoveryTest(ctx context.Context, in *RecoveryTestRequest, opts ...grpc.CallOption) (*RecoveryTestResponse, error)
 	// loop: `getparams`
 	//GetLiquidityParams gets the parameters that the daemon's liquidity manager
 	//is currently configured with. This may be nil if nothing is configured.
@@ -71,12 +136,165 @@ type SwapClientClient interface {
 	//this call fully overwrites our existing parameters.
 	//[EXPERIMENTAL]: endpoint is subject to change.
 	SetLiquidityParams(ctx context.Context, in *SetLiquidityParamsRequest, opts ...grpc.CallOption) (*SetLiquidityParamsResponse, error)
+	// loop: `getparams --effective`
+	//GetEffectiveConfig returns the fully resolved settings of the daemon and
+	//its liquidity manager, along with where each value was set, so that
+	//operators can see exactly what the daemon is acting on.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetEffectiveConfig(ctx context.Context, in *GetEffectiveConfigRequest, opts ...grpc.CallOption) (*GetEffectiveConfigResponse, error)
+	// loop: `applyrules`
+	//ApplyRules sets or removes the rule of a selection of channels or peers
+	//from a single rule template. If no preview id is provided, the change is
+	//previewed: the channels and peers that would gain, change or lose a rule
+	//are returned along with the swaps that would be suggested before and after
+	//the change, and no parameters are changed. To apply the change, the same
+	//request must be made again with the preview id returned. The change is
+	//applied atomically, and is rejected if it no longer matches its preview.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ApplyRules(ctx context.Context, in *ApplyRulesRequest, opts ...grpc.CallOption) (*ApplyRulesResponse, error)
+	// loop: `profile save`
+	//SaveProfile saves the current liquidity parameters as a named profile,
+	//replacing any profile with the same name, or removes a saved profile.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	SaveProfile(ctx context.Context, in *SaveProfileRequest, opts ...grpc.CallOption) (*SaveProfileResponse, error)
+	// loop: `profile list`
+	//ListProfiles returns the saved liquidity parameter profiles, the schedule
+	//that the liquidity manager switches between them on and the profile that
+	//is currently active.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ListProfiles(ctx context.Context, in *ListProfilesRequest, opts ...grpc.CallOption) (*ListProfilesResponse, error)
+	// loop: `profile schedule`
+	//SetProfileSchedule replaces the schedule of automatic switches between
+	//saved liquidity parameter profiles. When the window of a scheduled switch
+	//starts, its profile replaces the liquidity parameters on the next autoloop
+	//tick.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	SetProfileSchedule(ctx context.Context, in *SetProfileScheduleRequest, opts ...grpc.CallOption) (*SetProfileScheduleResponse, error)
+	// loop: `paramshistory`
+	//GetParamsHistory returns the audit history of changes to the liquidity
+	//parameters, including scheduled profile switches.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetParamsHistory(ctx context.Context, in *GetParamsHistoryRequest, opts ...grpc.CallOption) (*GetParamsHistoryResponse, error)
+	// loop: `pendingincrease`
+	//GetPendingIncrease returns the increase to the autoloop fee budget or fee
+	//limit that is waiting for the budget increase delay configured in loopd to
+	//pass before it takes effect, if any.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetPendingIncrease(ctx context.Context, in *GetPendingIncreaseRequest, opts ...grpc.CallOption) (*GetPendingIncreaseResponse, error)
+	// loop: `cancelincrease`
+	//CancelPendingIncrease cancels the increase to the autoloop fee budget or
+	//fee limit that is waiting for its delay to pass, so that it never takes
+	//effect.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	CancelPendingIncrease(ctx context.Context, in *CancelPendingIncreaseRequest, opts ...grpc.CallOption) (*CancelPendingIncreaseResponse, error)
 	// loop: `suggestswaps`
 	//SuggestSwaps returns a list of recommended swaps based on the current
 	//state of your node's channels and it's liquidity manager parameters.
 	//Note that only loop out suggestions are currently supported.
 	//[EXPERIMENTAL]: endpoint is subject to change.
 	SuggestSwaps(ctx context.Context, in *SuggestSwapsRequest, opts ...grpc.CallOption) (*SuggestSwapsResponse, error)
+	// loop: `suggestswaps --subscribe`
+	//SubscribeSuggestions returns a stream of swap suggestions. The current
+	//suggestions are sent immediately, and a new set is sent each time the
+	//inputs to the suggestions change materially: a channel is opened or
+	//closed, a channel's balance shifts by more than the delta requested, the
+	//server's swap restrictions change or the liquidity parameters are updated.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	SubscribeSuggestions(ctx context.Context, in *SubscribeSuggestionsRequest, opts ...grpc.CallOption) (SwapClient_SubscribeSuggestionsClient, error)
+	// loop: `previewfees`
+	//PreviewFees returns the breakdown of fees that the liquidity manager's
+	//current fee limits would set for a loop out swap of the amount provided,
+	//based on a quote from the server. This can be used to review the fees
+	//that an automatically dispatched swap would pay before it is executed.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	PreviewFees(ctx context.Context, in *PreviewFeesRequest, opts ...grpc.CallOption) (*PreviewFeesResponse, error)
+	// loop: `comparerebalance`
+	//CompareRebalance estimates the cost of shifting local balance out of a
+	//channel with an off-chain circular rebalance into another channel, and the
+	//cost of doing so with a loop out swap, and returns the comparison.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	CompareRebalance(ctx context.Context, in *CompareRebalanceRequest, opts ...grpc.CallOption) (*CompareRebalanceResponse, error)
+	// loop: `closeadvice`
+	//CloseAdvice reports the swaps in flight and the swaps suggested by the
+	//liquidity manager that involve a channel, along with the fees that have
+	//already been committed to the swaps in flight, and recommends whether to
+	//wait before closing the channel.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	CloseAdvice(ctx context.Context, in *CloseAdviceRequest, opts ...grpc.CallOption) (*CloseAdviceResponse, error)
+	// loop: `autoloopstats`
+	//GetAutoloopStats returns the duration and work done by the most recent
+	//autoloop ticks, so that the performance of the liquidity manager's
+	//suggestions can be monitored.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetAutoloopStats(ctx context.Context, in *AutoloopStatsRequest, opts ...grpc.CallOption) (*AutoloopStatsResponse, error)
+	// loop: `suggestionhistory`
+	//GetSuggestionHistory returns the stored autoloop suggestion rounds, which
+	//record the swaps that each autoloop tick suggested, whether they were
+	//dispatched, and the reasons that swaps were not suggested for the rest of
+	//the liquidity manager's rules.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetSuggestionHistory(ctx context.Context, in *SuggestionHistoryRequest, opts ...grpc.CallOption) (*SuggestionHistoryResponse, error)
+	// loop: `calendar`
+	//GetAutoloopCalendar returns the actions that autoloop plans to take over
+	//the coming days, including swaps that were deferred by the autoloop
+	//schedule or chain fee ceiling, so that they can be reviewed in advance. The
+	//calendar can also be exported in iCalendar format.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetAutoloopCalendar(ctx context.Context, in *AutoloopCalendarRequest, opts ...grpc.CallOption) (*AutoloopCalendarResponse, error)
+	// loop: `simulation`
+	//GetSimulatedSwaps returns the swaps that autoloop recorded rather than
+	//dispatched while it was in simulation mode, along with their projected
+	//costs.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetSimulatedSwaps(ctx context.Context, in *SimulatedSwapsRequest, opts ...grpc.CallOption) (*SimulatedSwapsResponse, error)
+	// loop: `simulation --clear`
+	//ClearSimulatedSwaps removes all recorded simulated swaps, so that a new
+	//simulation can be started.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ClearSimulatedSwaps(ctx context.Context, in *ClearSimulatedSwapsRequest, opts ...grpc.CallOption) (*ClearSimulatedSwapsResponse, error)
+	// loop: `listapprovals`
+	//ListApprovals returns the swaps that autoloop has queued for approval
+	//while it is in approval mode.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ListApprovals(ctx context.Context, in *ListApprovalsRequest, opts ...grpc.CallOption) (*ListApprovalsResponse, error)
+	// loop: `approveswap`
+	//ApproveSwap dispatches a swap that autoloop has queued for approval, and
+	//removes it from the queue.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ApproveSwap(ctx context.Context, in *ApproveSwapRequest, opts ...grpc.CallOption) (*ApproveSwapResponse, error)
+	// loop: `rejectswap`
+	//RejectSwap removes a swap that autoloop has queued for approval from the
+	//queue without dispatching it. Autoloop does not queue another swap for
+	//the same channels or peer until its failure backoff period has passed.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	RejectSwap(ctx context.Context, in *RejectSwapRequest, opts ...grpc.CallOption) (*RejectSwapResponse, error)
+	// loop: `notices`
+	//GetServerNotices returns the operational notices, such as maintenance
+	//windows and fee changes, that the swap server has published.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetServerNotices(ctx context.Context, in *ServerNoticesRequest, opts ...grpc.CallOption) (*ServerNoticesResponse, error)
+	// loop: `debuglevel`
+	//DebugLevel sets the log level of all or individual subsystems at runtime,
+	//or lists the subsystems that log levels can be set for.
+	DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error)
+	// loop: `replayswap`
+	//ReplaySwap reconstructs the full timeline of a swap from the daemon's swap
+	//store, lnd's payments, invoices and wallet transactions, and the chain.
+	//It is intended for debugging swaps that did not behave as expected.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ReplaySwap(ctx context.Context, in *ReplaySwapRequest, opts ...grpc.CallOption) (*ReplaySwapResponse, error)
+	// loop: `stategraph`
+	//GetSwapStateGraph exports the state machine of a swap type as a DOT or
+	//mermaid graph. If a swap is specified, the graph highlights the states
+	//that the swap went through and its current state, and the states that
+	//the swap can still reach are returned.
+	GetSwapStateGraph(ctx context.Context, in *SwapStateGraphRequest, opts ...grpc.CallOption) (*SwapStateGraphResponse, error)
+	// loop: `postmortems`
+	//GetPostMortems returns the post-mortem reports of swaps that have been
+	//pending for longer than the maximum lifetime for their type, as configured
+	//by loopd's maxloopoutlifetime and maxloopinlifetime options.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetPostMortems(ctx context.Context, in *PostMortemsRequest, opts ...grpc.CallOption) (*PostMortemsResponse, error)
 }
 
 type swapClientClient struct {
@@ -155,6 +373,78 @@ func (c *swapClientClient) SwapInfo(ctx context.Context, in *SwapInfoRequest, op
 	return out, nil
 }
 
+func (c *swapClientClient) SetSwapNotes(ctx context.Context, in *SetSwapNotesRequest, opts ...grpc.CallOption) (*SetSwapNotesResponse, error) {
+	out := new(SetSwapNotesResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/SetSwapNotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) SearchSwaps(ctx context.Context, in *SearchSwapsRequest, opts ...grpc.CallOption) (*SearchSwapsResponse, error) {
+	out := new(SearchSwapsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/SearchSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ListSwapConflicts(ctx context.Context, in *ListSwapConflictsRequest, opts ...grpc.CallOption) (*ListSwapConflictsResponse, error) {
+	out := new(ListSwapConflictsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListSwapConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ResolveSwapConflict(ctx context.Context, in *ResolveSwapConflictRequest, opts ...grpc.CallOption) (*ResolveSwapConflictResponse, error) {
+	out := new(ResolveSwapConflictResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ResolveSwapConflict", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetFeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error) {
+	out := new(FeeReportResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetFeeReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetAccountingReport(ctx context.Context, in *AccountingReportRequest, opts ...grpc.CallOption) (*AccountingReportResponse, error) {
+	out := new(AccountingReportResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetAccountingReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) WatchSwap(ctx context.Context, in *WatchSwapRequest, opts ...grpc.CallOption) (*WatchSwapResponse, error) {
+	out := new(WatchSwapResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/WatchSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ListWatchedSwaps(ctx context.Context, in *ListWatchedSwapsRequest, opts ...grpc.CallOption) (*ListWatchedSwapsResponse, error) {
+	out := new(ListWatchedSwapsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListWatchedSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *swapClientClient) LoopOutTerms(ctx context.Context, in *TermsRequest, opts ...grpc.CallOption) (*OutTermsResponse, error) {
 	out := new(OutTermsResponse)
 	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/LoopOutTerms", in, out, opts...)
@@ -209,6 +499,33 @@ func (c *swapClientClient) GetLsatTokens(ctx context.Context, in *TokensRequest,
 	return out, nil
 }
 
+func (c *swapClientClient) GetLndFeatures(ctx context.Context, in *LndFeaturesRequest, opts ...grpc.CallOption) (*LndFeaturesResponse, error) {
+	out := new(LndFeaturesResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetLndFeatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) RecoveryTest(ctx context.Context, in *RecoveryTestRequest, opts ...grpc.CallOption) (*RecoveryTestResponse, error) {
+	out := new(RecoveryTestResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/RecoveryTest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *swapClientClient) GetLiquidityParams(ctx context.Context, in *GetLiquidityParamsRequest, opts ...grpc.CallOption) (*LiquidityParameters, error) {
 	out := new(LiquidityParameters)
 	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetLiquidityParams", in, out, opts...)
@@ -227,391 +544,1616 @@ func (c *swapClientClient) SetLiquidityParams(ctx context.Context, in *SetLiquid
 	return out, nil
 }
 
-func (c *swapClientClient) SuggestSwaps(ctx context.Context, in *SuggestSwapsRequest, opts ...grpc.CallOption) (*SuggestSwapsResponse, error) {
-	out := new(SuggestSwapsResponse)
-	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/SuggestSwaps", in, out, opts...)
+func (c *swapClientClient) GetEffectiveConfig(ctx context.Context, in *GetEffectiveConfigRequest, opts ...grpc.CallOption) (*GetEffectiveConfigResponse, error) {
+	out := new(GetEffectiveConfigResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetEffectiveConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// SwapClientServer is the server API for SwapClient service.
-// All implementations must embed UnimplementedSwapClientServer
-// for forward compatibility
-type SwapClientServer interface {
-	// loop: `out`
-	//LoopOut initiates an loop out swap with the given parameters. The call
-	//returns after the swap has been set up with the swap server. From that
-	//point onwards, progress can be tracked via the SwapStatus stream that is
-	//returned from Monitor().
-	LoopOut(context.Context, *LoopOutRequest) (*SwapResponse, error)
-	// loop: `in`
-	//LoopIn initiates a loop in swap with the given parameters. The call
-	//returns after the swap has been set up with the swap server. From that
-	//point onwards, progress can be tracked via the SwapStatus stream
-	//that is returned from Monitor().
-	LoopIn(context.Context, *LoopInRequest) (*SwapResponse, error)
-	// loop: `monitor`
-	//Monitor will return a stream of swap updates for currently active swaps.
-	Monitor(*MonitorRequest, SwapClient_MonitorServer) error
-	// loop: `listswaps`
-	//ListSwaps returns a list of all currently known swaps and their current
-	//status.
-	ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error)
-	// loop: `swapinfo`
-	//SwapInfo returns all known details about a single swap.
-	SwapInfo(context.Context, *SwapInfoRequest) (*SwapStatus, error)
-	// loop: `terms`
-	//LoopOutTerms returns the terms that the server enforces for a loop out swap.
-	LoopOutTerms(context.Context, *TermsRequest) (*OutTermsResponse, error)
-	// loop: `quote`
-	//LoopOutQuote returns a quote for a loop out swap with the provided
-	//parameters.
-	LoopOutQuote(context.Context, *QuoteRequest) (*OutQuoteResponse, error)
-	// loop: `terms`
-	//GetTerms returns the terms that the server enforces for swaps.
-	GetLoopInTerms(context.Context, *TermsRequest) (*InTermsResponse, error)
-	// loop: `quote`
-	//GetQuote returns a quote for a swap with the provided parameters.
-	GetLoopInQuote(context.Context, *QuoteRequest) (*InQuoteResponse, error)
-	//
-	//Probe asks he sever to probe the route to us to have a better upfront
-	//estimate about routing fees when loopin-in.
-	Probe(context.Context, *ProbeRequest) (*ProbeResponse, error)
-	// loop: `listauth`
-	//GetLsatTokens returns all LSAT tokens the daemon ever paid for.
-	GetLsatTokens(context.Context, *TokensRequest) (*TokensResponse, error)
-	// loop: `getparams`
-	//GetLiquidityParams gets the parameters that the daemon's liquidity manager
-	//is currently configured with. This may be nil if nothing is configured.
-	//[EXPERIMENTAL]: endpoint is subject to change.
-	GetLiquidityParams(context.Context, *GetLiquidityParamsRequest) (*LiquidityParameters, error)
-	// loop: `setparams`
-	//SetLiquidityParams sets a new set of parameters for the daemon's liquidity
-	//manager. Note that the full set of parameters must be provided, because
-	//this call fully overwrites our existing parameters.
-	//[EXPERIMENTAL]: endpoint is subject to change.
-	SetLiquidityParams(context.Context, *SetLiquidityParamsRequest) (*SetLiquidityParamsResponse, error)
-	// loop: `suggestswaps`
-	//SuggestSwaps returns a list of recommended swaps based on the current
-	//state of your node's channels and it's liquidity manager parameters.
-	//Note that only loop out suggestions are currently supported.
-	//[EXPERIMENTAL]: endpoint is subject to change.
-	SuggestSwaps(context.Context, *SuggestSwapsRequest) (*SuggestSwapsResponse, error)
-	mustEmbedUnimplementedSwapClientServer()
+func (c *swapClientClient) ApplyRules(ctx context.Context, in *ApplyRulesRequest, opts ...grpc.CallOption) (*ApplyRulesResponse, error) {
+	out := new(ApplyRulesResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ApplyRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedSwapClientServer must be embedded to have forward compatible implementations.
-type UnimplementedSwapClientServer struct {
+func (c *swapClientClient) SaveProfile(ctx context.Context, in *SaveProfileRequest, opts ...grpc.CallOption) (*SaveProfileResponse, error) {
+	out := new(SaveProfileResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/SaveProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedSwapClientServer) LoopOut(context.Context, *LoopOutRequest) (*SwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoopOut not implemented")
+func (c *swapClientClient) ListProfiles(ctx context.Context, in *ListProfilesRequest, opts ...grpc.CallOption) (*ListProfilesResponse, error) {
+	out := new(ListProfilesResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) LoopIn(context.Context, *LoopInRequest) (*SwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoopIn not implemented")
+
+func (c *swapClientClient) SetProfileSchedule(ctx context.Context, in *SetProfileScheduleRequest, opts ...grpc.CallOption) (*SetProfileScheduleResponse, error) {
+	out := new(SetProfileScheduleResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/SetProfileSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) Monitor(*MonitorRequest, SwapClient_MonitorServer) error {
-	return status.Errorf(codes.Unimplemented, "method Monitor not implemented")
+
+func (c *swapClientClient) GetParamsHistory(ctx context.Context, in *GetParamsHistoryRequest, opts ...grpc.CallOption) (*GetParamsHistoryResponse, error) {
+	out := new(GetParamsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetParamsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSwaps not implemented")
+
+func (c *swapClientClient) GetPendingIncrease(ctx context.Context, in *GetPendingIncreaseRequest, opts ...grpc.CallOption) (*GetPendingIncreaseResponse, error) {
+	out := new(GetPendingIncreaseResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetPendingIncrease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) SwapInfo(context.Context, *SwapInfoRequest) (*SwapStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SwapInfo not implemented")
+
+func (c *swapClientClient) CancelPendingIncrease(ctx context.Context, in *CancelPendingIncreaseRequest, opts ...grpc.CallOption) (*CancelPendingIncreaseResponse, error) {
+	out := new(CancelPendingIncreaseResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/CancelPendingIncrease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) LoopOutTerms(context.Context, *TermsRequest) (*OutTermsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoopOutTerms not implemented")
+
+func (c *swapClientClient) SuggestSwaps(ctx context.Context, in *SuggestSwapsRequest, opts ...grpc.CallOption) (*SuggestSwapsResponse, error) {
+	out := new(SuggestSwapsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/SuggestSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) LoopOutQuote(context.Context, *QuoteRequest) (*OutQuoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoopOutQuote not implemented")
+
+func (c *swapClientClient) SubscribeSuggestions(ctx context.Context, in *SubscribeSuggestionsRequest, opts ...grpc.CallOption) (SwapClient_SubscribeSuggestionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SwapClient_ServiceDesc.Streams[1], "/looprpc.SwapClient/SubscribeSuggestions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &swapClientSubscribeSuggestionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedSwapClientServer) GetLoopInTerms(context.Context, *TermsRequest) (*InTermsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLoopInTerms not implemented")
+
+type SwapClient_SubscribeSuggestionsClient interface {
+	Recv() (*SuggestSwapsResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedSwapClientServer) GetLoopInQuote(context.Context, *QuoteRequest) (*InQuoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLoopInQuote not implemented")
+
+type swapClientSubscribeSuggestionsClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedSwapClientServer) Probe(context.Context, *ProbeRequest) (*ProbeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Probe not implemented")
+
+func (x *swapClientSubscribeSuggestionsClient) Recv() (*SuggestSwapsResponse, error) {
+	m := new(SuggestSwapsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedSwapClientServer) GetLsatTokens(context.Context, *TokensRequest) (*TokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLsatTokens not implemented")
+
+func (c *swapClientClient) PreviewFees(ctx context.Context, in *PreviewFeesRequest, opts ...grpc.CallOption) (*PreviewFeesResponse, error) {
+	out := new(PreviewFeesResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/PreviewFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSwapClientServer) GetLiquidityParams(context.Context, *GetLiquidityParamsRequest) (*LiquidityParameters, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityParams not implemented")
+
+func (c *swapClientClient) CompareRebalance(ctx context.Context, in *CompareRebalanceRequest, opts ...grpc.CallOption) (*CompareRebalanceResponse, error) {
+	out := new(CompareRebalanceResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/CompareRebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) CloseAdvice(ctx context.Context, in *CloseAdviceRequest, opts ...grpc.CallOption) (*CloseAdviceResponse, error) {
+	out := new(CloseAdviceResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/CloseAdvice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetAutoloopStats(ctx context.Context, in *AutoloopStatsRequest, opts ...grpc.CallOption) (*AutoloopStatsResponse, error) {
+	out := new(AutoloopStatsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetAutoloopStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetSuggestionHistory(ctx context.Context, in *SuggestionHistoryRequest, opts ...grpc.CallOption) (*SuggestionHistoryResponse, error) {
+	out := new(SuggestionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetSuggestionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetAutoloopCalendar(ctx context.Context, in *AutoloopCalendarRequest, opts ...grpc.CallOption) (*AutoloopCalendarResponse, error) {
+	out := new(AutoloopCalendarResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetAutoloopCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetSimulatedSwaps(ctx context.Context, in *SimulatedSwapsRequest, opts ...grpc.CallOption) (*SimulatedSwapsResponse, error) {
+	out := new(SimulatedSwapsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetSimulatedSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ClearSimulatedSwaps(ctx context.Context, in *ClearSimulatedSwapsRequest, opts ...grpc.CallOption) (*ClearSimulatedSwapsResponse, error) {
+	out := new(ClearSimulatedSwapsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ClearSimulatedSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ListApprovals(ctx context.Context, in *ListApprovalsRequest, opts ...grpc.CallOption) (*ListApprovalsResponse, error) {
+	out := new(ListApprovalsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ListApprovals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ApproveSwap(ctx context.Context, in *ApproveSwapRequest, opts ...grpc.CallOption) (*ApproveSwapResponse, error) {
+	out := new(ApproveSwapResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ApproveSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) RejectSwap(ctx context.Context, in *RejectSwapRequest, opts ...grpc.CallOption) (*RejectSwapResponse, error) {
+	out := new(RejectSwapResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/RejectSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetServerNotices(ctx context.Context, in *ServerNoticesRequest, opts ...grpc.CallOption) (*ServerNoticesResponse, error) {
+	out := new(ServerNoticesResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetServerNotices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error) {
+	out := new(DebugLevelResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/DebugLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) ReplaySwap(ctx context.Context, in *ReplaySwapRequest, opts ...grpc.CallOption) (*ReplaySwapResponse, error) {
+	out := new(ReplaySwapResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/ReplaySwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetSwapStateGraph(ctx context.Context, in *SwapStateGraphRequest, opts ...grpc.CallOption) (*SwapStateGraphResponse, error) {
+	out := new(SwapStateGraphResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetSwapStateGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swapClientClient) GetPostMortems(ctx context.Context, in *PostMortemsRequest, opts ...grpc.CallOption) (*PostMortemsResponse, error) {
+	out := new(PostMortemsResponse)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapClient/GetPostMortems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SwapClientServer is the server API for SwapClient service.
+// All implementations must embed UnimplementedSwapClientServer
+// for forward compatibility
+type SwapClientServer interface {
+	// loop: `out`
+	//LoopOut initiates an loop out swap with the given parameters. The call
+	//returns after the swap has been set up with the swap server. From that
+	//point onwards, progress can be tracked via the SwapStatus stream that is
+	//returned from Monitor().
+	LoopOut(context.Context, *LoopOutRequest) (*SwapResponse, error)
+	// loop: `in`
+	//LoopIn initiates a loop in swap with the given parameters. The call
+	//returns after the swap has been set up with the swap server. From that
+	//point onwards, progress can be tracked via the SwapStatus stream
+	//that is returned from Monitor().
+	LoopIn(context.Context, *LoopInRequest) (*SwapResponse, error)
+	// loop: `monitor`
+	//Monitor will return a stream of swap updates for currently active swaps.
+	Monitor(*MonitorRequest, SwapClient_MonitorServer) error
+	// loop: `listswaps`
+	//ListSwaps returns a list of all currently known swaps and their current
+	//status.
+	ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error)
+	// loop: `swapinfo`
+	//SwapInfo returns all known details about a single swap.
+	SwapInfo(context.Context, *SwapInfoRequest) (*SwapStatus, error)
+	// loop: `setnotes`
+	//SetSwapNotes attaches free-text notes to an existing swap, replacing any
+	//notes that were previously set. Notes can be edited at any point in the
+	//swap's lifetime and are cleared by setting an empty string.
+	SetSwapNotes(context.Context, *SetSwapNotesRequest) (*SetSwapNotesResponse, error)
+	// loop: `searchswaps`
+	//SearchSwaps returns all swaps that have a hash, label, notes, htlc txid,
+	//invoice, destination address or peer pubkey that contains the query
+	//provided. Matching is case-insensitive.
+	SearchSwaps(context.Context, *SearchSwapsRequest) (*SearchSwapsResponse, error)
+	// loop: `swapconflicts`
+	//ListSwapConflicts returns the swap hashes that are used by both a loop out
+	//and a loop in swap in the daemon's swap store. This can happen when a
+	//restored backup is merged with a live database. Pending swaps with a
+	//conflicting hash are not resumed until the conflict is resolved.
+	ListSwapConflicts(context.Context, *ListSwapConflictsRequest) (*ListSwapConflictsResponse, error)
+	// loop: `resolveconflict`
+	//ResolveSwapConflict resolves a conflict between a loop out and a loop in
+	//swap that share a hash by keeping the swap of the type provided. The
+	//record of the other swap is set aside in the swap store rather than being
+	//deleted. If the swap that is kept is pending, it is resumed the next time
+	//the daemon is started.
+	ResolveSwapConflict(context.Context, *ResolveSwapConflictRequest) (*ResolveSwapConflictResponse, error)
+	// loop: `feereport`
+	//GetFeeReport compares the cost of each successful loop out to the
+	//estimated cost of a plain on-chain send of the same amount, and of closing
+	//and reopening a channel instead, at the fee rate that the swap's sweep
+	//paid.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetFeeReport(context.Context, *FeeReportRequest) (*FeeReportResponse, error)
+	// loop: `accounting`
+	//GetAccountingReport returns the outcome of the daemon's last accounting
+	//check, which reconciles the costs recorded for completed swaps against
+	//lnd's payment and transaction records. Accounting checks must be enabled
+	//with the accountingcheckinterval option.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetAccountingReport(context.Context, *AccountingReportRequest) (*AccountingReportResponse, error)
+	// loop: `watchswap`
+	//WatchSwap adds a swap that was initiated by another loop client using the
+	//same lnd node to the daemon's watch list. The daemon does not hold the
+	//contracts of watched swaps, so it only monitors their payments, invoices
+	//and transactions in lnd for accounting purposes.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	WatchSwap(context.Context, *WatchSwapRequest) (*WatchSwapResponse, error)
+	// loop: `watchedswaps`
+	//ListWatchedSwaps returns the swaps in the daemon's watch list, along with
+	//the state and costs that lnd's records imply for them.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ListWatchedSwaps(context.Context, *ListWatchedSwapsRequest) (*ListWatchedSwapsResponse, error)
+	// loop: `terms`
+	//LoopOutTerms returns the terms that the server enforces for a loop out swap.
+	LoopOutTerms(context.Context, *TermsRequest) (*OutTermsResponse, error)
+	// loop: `quote`
+	//LoopOutQuote returns a quote for a loop out swap with the provided
+	//parameters.
+	LoopOutQuote(context.Context, *QuoteRequest) (*OutQuoteResponse, error)
+	// loop: `terms`
+	//GetTerms returns the terms that the server enforces for swaps.
+	GetLoopInTerms(context.Context, *TermsRequest) (*InTermsResponse, error)
+	// loop: `quote`
+	//GetQuote returns a quote for a swap with the provided parameters.
+	GetLoopInQuote(context.Context, *QuoteRequest) (*InQuoteResponse, error)
+	//
+	//Probe asks he sever to probe the route to us to have a better upfront
+	//estimate about routing fees when loopin-in.
+	Probe(context.Context, *ProbeRequest) (*ProbeResponse, error)
+	// loop: `listauth`
+	//GetLsatTokens returns all LSAT tokens the daemon ever paid for.
+	GetLsatTokens(context.Context, *TokensRequest) (*TokensResponse, error)
+	// loop: `lndfeatures`
+	//GetLndFeatures returns the version dependent lnd features that the daemon
+	//detected on startup, and whether they are enabled for the connected lnd.
+	GetLndFeatures(context.Context, *LndFeaturesRequest) (*LndFeaturesResponse, error)
+	// loop: `getinfo`
+	//GetInfo returns basic information about the daemon, including whether the
+	//connected lnd is synced to the chain. While lnd is not synced, the daemon
+	//runs in a degraded state in which new swaps are refused and autoloop ticks
+	//are skipped, but pending swaps are still monitored.
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// loop: `recoverytest`
+	//RecoveryTest runs a drill that exercises the timeout and uncooperative
+	//sweep paths of a synthetic swap, to verify that the daemon is able to
+	//recover its funds with the keys, scripts and wallet of this node. It is
+	//only available on regtest and signet.
+	RecoveryTest(context.Context, *RecoveryTestRequest) (*RecoveryTestResponse, error)
+	// loop: `getparams`
+	//GetLiquidityParams gets the parameters that the daemon's liquidity manager
+	//is currently configured with. This may be nil if nothing is configured.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetLiquidityParams(context.Context, *GetLiquidityParamsRequest) (*LiquidityParameters, error)
+	// loop: `setparams`
+	//SetLiquidityParams sets a new set of parameters for the daemon's liquidity
+	//manager. Note that the full set of parameters must be provided, because
+	//this call fully overwrites our existing parameters.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	SetLiquidityParams(context.Context, *SetLiquidityParamsRequest) (*SetLiquidityParamsResponse, error)
+	// loop: `getparams --effective`
+	//GetEffectiveConfig returns the fully resolved settings of the daemon and
+	//its liquidity manager, along with where each value was set, so that
+	//operators can see exactly what the daemon is acting on.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetEffectiveConfig(context.Context, *GetEffectiveConfigRequest) (*GetEffectiveConfigResponse, error)
+	// loop: `applyrules`
+	//ApplyRules sets or removes the rule of a selection of channels or peers
+	//from a single rule template. If no preview id is provided, the change is
+	//previewed: the channels and peers that would gain, change or lose a rule
+	//are returned along with the swaps that would be suggested before and after
+	//the change, and no parameters are changed. To apply the change, the same
+	//request must be made again with the preview id returned. The change is
+	//applied atomically, and is rejected if it no longer matches its preview.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ApplyRules(context.Context, *ApplyRulesRequest) (*ApplyRulesResponse, error)
+	// loop: `profile save`
+	//SaveProfile saves the current liquidity parameters as a named profile,
+	//replacing any profile with the same name, or removes a saved profile.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	SaveProfile(context.Context, *SaveProfileRequest) (*SaveProfileResponse, error)
+	// loop: `profile list`
+	//ListProfiles returns the saved liquidity parameter profiles, the schedule
+	//that the liquidity manager switches between them on and the profile that
+	//is currently active.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ListProfiles(context.Context, *ListProfilesRequest) (*ListProfilesResponse, error)
+	// loop: `profile schedule`
+	//SetProfileSchedule replaces the schedule of automatic switches between
+	//saved liquidity parameter profiles. When the window of a scheduled switch
+	//starts, its profile replaces the liquidity parameters on the next autoloop
+	//tick.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	SetProfileSchedule(context.Context, *SetProfileScheduleRequest) (*SetProfileScheduleResponse, error)
+	// loop: `paramshistory`
+	//GetParamsHistory returns the audit history of changes to the liquidity
+	//parameters, including scheduled profile switches.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetParamsHistory(context.Context, *GetParamsHistoryRequest) (*GetParamsHistoryResponse, error)
+	// loop: `pendingincrease`
+	//GetPendingIncrease returns the increase to the autoloop fee budget or fee
+	//limit that is waiting for the budget increase delay configured in loopd to
+	//pass before it takes effect, if any.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetPendingIncrease(context.Context, *GetPendingIncreaseRequest) (*GetPendingIncreaseResponse, error)
+	// loop: `cancelincrease`
+	//CancelPendingIncrease cancels the increase to the autoloop fee budget or
+	//fee limit that is waiting for its delay to pass, so that it never takes
+	//effect.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	CancelPendingIncrease(context.Context, *CancelPendingIncreaseRequest) (*CancelPendingIncreaseResponse, error)
+	// loop: `suggestswaps`
+	//SuggestSwaps returns a list of recommended swaps based on the current
+	//state of your node's channels and it's liquidity manager parameters.
+	//Note that only loop out suggestions are currently supported.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	SuggestSwaps(context.Context, *SuggestSwapsRequest) (*SuggestSwapsResponse, error)
+	// loop: `suggestswaps --subscribe`
+	//SubscribeSuggestions returns a stream of swap suggestions. The current
+	//suggestions are sent immediately, and a new set is sent each time the
+	//inputs to the suggestions change materially: a channel is opened or
+	//closed, a channel's balance shifts by more than the delta requested, the
+	//server's swap restrictions change or the liquidity parameters are updated.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	SubscribeSuggestions(*SubscribeSuggestionsRequest, SwapClient_SubscribeSuggestionsServer) error
+	// loop: `previewfees`
+	//PreviewFees returns the breakdown of fees that the liquidity manager's
+	//current fee limits would set for a loop out swap of the amount provided,
+	//based on a quote from the server. This can be used to review the fees
+	//that an automatically dispatched swap would pay before it is executed.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	PreviewFees(context.Context, *PreviewFeesRequest) (*PreviewFeesResponse, error)
+	// loop: `comparerebalance`
+	//CompareRebalance estimates the cost of shifting local balance out of a
+	//channel with an off-chain circular rebalance into another channel, and the
+	//cost of doing so with a loop out swap, and returns the comparison.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	CompareRebalance(context.Context, *CompareRebalanceRequest) (*CompareRebalanceResponse, error)
+	// loop: `closeadvice`
+	//CloseAdvice reports the swaps in flight and the swaps suggested by the
+	//liquidity manager that involve a channel, along with the fees that have
+	//already been committed to the swaps in flight, and recommends whether to
+	//wait before closing the channel.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	CloseAdvice(context.Context, *CloseAdviceRequest) (*CloseAdviceResponse, error)
+	// loop: `autoloopstats`
+	//GetAutoloopStats returns the duration and work done by the most recent
+	//autoloop ticks, so that the performance of the liquidity manager's
+	//suggestions can be monitored.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetAutoloopStats(context.Context, *AutoloopStatsRequest) (*AutoloopStatsResponse, error)
+	// loop: `suggestionhistory`
+	//GetSuggestionHistory returns the stored autoloop suggestion rounds, which
+	//record the swaps that each autoloop tick suggested, whether they were
+	//dispatched, and the reasons that swaps were not suggested for the rest of
+	//the liquidity manager's rules.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetSuggestionHistory(context.Context, *SuggestionHistoryRequest) (*SuggestionHistoryResponse, error)
+	// loop: `calendar`
+	//GetAutoloopCalendar returns the actions that autoloop plans to take over
+	//the coming days, including swaps that were deferred by the autoloop
+	//schedule or chain fee ceiling, so that they can be reviewed in advance. The
+	//calendar can also be exported in iCalendar format.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetAutoloopCalendar(context.Context, *AutoloopCalendarRequest) (*AutoloopCalendarResponse, error)
+	// loop: `simulation`
+	//GetSimulatedSwaps returns the swaps that autoloop recorded rather than
+	//dispatched while it was in simulation mode, along with their projected
+	//costs.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetSimulatedSwaps(context.Context, *SimulatedSwapsRequest) (*SimulatedSwapsResponse, error)
+	// loop: `simulation --clear`
+	//ClearSimulatedSwaps removes all recorded simulated swaps, so that a new
+	//simulation can be started.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ClearSimulatedSwaps(context.Context, *ClearSimulatedSwapsRequest) (*ClearSimulatedSwapsResponse, error)
+	// loop: `listapprovals`
+	//ListApprovals returns the swaps that autoloop has queued for approval
+	//while it is in approval mode.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ListApprovals(context.Context, *ListApprovalsRequest) (*ListApprovalsResponse, error)
+	// loop: `approveswap`
+	//ApproveSwap dispatches a swap that autoloop has queued for approval, and
+	//removes it from the queue.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ApproveSwap(context.Context, *ApproveSwapRequest) (*ApproveSwapResponse, error)
+	// loop: `rejectswap`
+	//RejectSwap removes a swap that autoloop has queued for approval from the
+	//queue without dispatching it. Autoloop does not queue another swap for
+	//the same channels or peer until its failure backoff period has passed.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	RejectSwap(context.Context, *RejectSwapRequest) (*RejectSwapResponse, error)
+	// loop: `notices`
+	//GetServerNotices returns the operational notices, such as maintenance
+	//windows and fee changes, that the swap server has published.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetServerNotices(context.Context, *ServerNoticesRequest) (*ServerNoticesResponse, error)
+	// loop: `debuglevel`
+	//DebugLevel sets the log level of all or individual subsystems at runtime,
+	//or lists the subsystems that log levels can be set for.
+	DebugLevel(context.Context, *DebugLevelRequest) (*DebugLevelResponse, error)
+	// loop: `replayswap`
+	//ReplaySwap reconstructs the full timeline of a swap from the daemon's swap
+	//store, lnd's payments, invoices and wallet transactions, and the chain.
+	//It is intended for debugging swaps that did not behave as expected.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	ReplaySwap(context.Context, *ReplaySwapRequest) (*ReplaySwapResponse, error)
+	// loop: `stategraph`
+	//GetSwapStateGraph exports the state machine of a swap type as a DOT or
+	//mermaid graph. If a swap is specified, the graph highlights the states
+	//that the swap went through and its current state, and the states that
+	//the swap can still reach are returned.
+	GetSwapStateGraph(context.Context, *SwapStateGraphRequest) (*SwapStateGraphResponse, error)
+	// loop: `postmortems`
+	//GetPostMortems returns the post-mortem reports of swaps that have been
+	//pending for longer than the maximum lifetime for their type, as configured
+	//by loopd's maxloopoutlifetime and maxloopinlifetime options.
+	//[EXPERIMENTAL]: endpoint is subject to change.
+	GetPostMortems(context.Context, *PostMortemsRequest) (*PostMortemsResponse, error)
+	mustEmbedUnimplementedSwapClientServer()
+}
+
+// UnimplementedSwapClientServer must be embedded to have forward compatible implementations.
+type UnimplementedSwapClientServer struct {
+}
+
+func (UnimplementedSwapClientServer) LoopOut(context.Context, *LoopOutRequest) (*SwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoopOut not implemented")
+}
+func (UnimplementedSwapClientServer) LoopIn(context.Context, *LoopInRequest) (*SwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoopIn not implemented")
+}
+func (UnimplementedSwapClientServer) Monitor(*MonitorRequest, SwapClient_MonitorServer) error {
+	return status.Errorf(codes.Unimplemented, "method Monitor not implemented")
+}
+func (UnimplementedSwapClientServer) ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwaps not implemented")
+}
+func (UnimplementedSwapClientServer) SwapInfo(context.Context, *SwapInfoRequest) (*SwapStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapInfo not implemented")
+}
+func (UnimplementedSwapClientServer) SetSwapNotes(context.Context, *SetSwapNotesRequest) (*SetSwapNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSwapNotes not implemented")
+}
+func (UnimplementedSwapClientServer) SearchSwaps(context.Context, *SearchSwapsRequest) (*SearchSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSwaps not implemented")
+}
+func (UnimplementedSwapClientServer) ListSwapConflicts(context.Context, *ListSwapConflictsRequest) (*ListSwapConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwapConflicts not implemented")
+}
+func (UnimplementedSwapClientServer) ResolveSwapConflict(context.Context, *ResolveSwapConflictRequest) (*ResolveSwapConflictResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveSwapConflict not implemented")
+}
+func (UnimplementedSwapClientServer) GetFeeReport(context.Context, *FeeReportRequest) (*FeeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeeReport not implemented")
+}
+func (UnimplementedSwapClientServer) GetAccountingReport(context.Context, *AccountingReportRequest) (*AccountingReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountingReport not implemented")
+}
+func (UnimplementedSwapClientServer) WatchSwap(context.Context, *WatchSwapRequest) (*WatchSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchSwap not implemented")
+}
+func (UnimplementedSwapClientServer) ListWatchedSwaps(context.Context, *ListWatchedSwapsRequest) (*ListWatchedSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatchedSwaps not implemented")
+}
+func (UnimplementedSwapClientServer) LoopOutTerms(context.Context, *TermsRequest) (*OutTermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoopOutTerms not implemented")
+}
+func (UnimplementedSwapClientServer) LoopOutQuote(context.Context, *QuoteRequest) (*OutQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoopOutQuote not implemented")
+}
+func (UnimplementedSwapClientServer) GetLoopInTerms(context.Context, *TermsRequest) (*InTermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoopInTerms not implemented")
+}
+func (UnimplementedSwapClientServer) GetLoopInQuote(context.Context, *QuoteRequest) (*InQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoopInQuote not implemented")
+}
+func (UnimplementedSwapClientServer) Probe(context.Context, *ProbeRequest) (*ProbeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Probe not implemented")
+}
+func (UnimplementedSwapClientServer) GetLsatTokens(context.Context, *TokensRequest) (*TokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLsatTokens not implemented")
+}
+func (UnimplementedSwapClientServer) GetLndFeatures(context.Context, *LndFeaturesRequest) (*LndFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLndFeatures not implemented")
+}
+func (UnimplementedSwapClientServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
+func (UnimplementedSwapClientServer) RecoveryTest(context.Context, *RecoveryTestRequest) (*RecoveryTestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoveryTest not implemented")
+}
+func (UnimplementedSwapClientServer) GetLiquidityParams(context.Context, *GetLiquidityParamsRequest) (*LiquidityParameters, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityParams not implemented")
 }
 func (UnimplementedSwapClientServer) SetLiquidityParams(context.Context, *SetLiquidityParamsRequest) (*SetLiquidityParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetLiquidityParams not implemented")
 }
+func (UnimplementedSwapClientServer) GetEffectiveConfig(context.Context, *GetEffectiveConfigRequest) (*GetEffectiveConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveConfig not implemented")
+}
+func (UnimplementedSwapClientServer) ApplyRules(context.Context, *ApplyRulesRequest) (*ApplyRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyRules not implemented")
+}
+func (UnimplementedSwapClientServer) SaveProfile(context.Context, *SaveProfileRequest) (*SaveProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveProfile not implemented")
+}
+func (UnimplementedSwapClientServer) ListProfiles(context.Context, *ListProfilesRequest) (*ListProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfiles not implemented")
+}
+func (UnimplementedSwapClientServer) SetProfileSchedule(context.Context, *SetProfileScheduleRequest) (*SetProfileScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProfileSchedule not implemented")
+}
+func (UnimplementedSwapClientServer) GetParamsHistory(context.Context, *GetParamsHistoryRequest) (*GetParamsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParamsHistory not implemented")
+}
+func (UnimplementedSwapClientServer) GetPendingIncrease(context.Context, *GetPendingIncreaseRequest) (*GetPendingIncreaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingIncrease not implemented")
+}
+func (UnimplementedSwapClientServer) CancelPendingIncrease(context.Context, *CancelPendingIncreaseRequest) (*CancelPendingIncreaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPendingIncrease not implemented")
+}
 func (UnimplementedSwapClientServer) SuggestSwaps(context.Context, *SuggestSwapsRequest) (*SuggestSwapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuggestSwaps not implemented")
 }
-func (UnimplementedSwapClientServer) mustEmbedUnimplementedSwapClientServer() {}
+func (UnimplementedSwapClientServer) SubscribeSuggestions(*SubscribeSuggestionsRequest, SwapClient_SubscribeSuggestionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSuggestions not implemented")
+}
+func (UnimplementedSwapClientServer) PreviewFees(context.Context, *PreviewFeesRequest) (*PreviewFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewFees not implemented")
+}
+func (UnimplementedSwapClientServer) CompareRebalance(context.Context, *CompareRebalanceRequest) (*CompareRebalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareRebalance not implemented")
+}
+func (UnimplementedSwapClientServer) CloseAdvice(context.Context, *CloseAdviceRequest) (*CloseAdviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAdvice not implemented")
+}
+func (UnimplementedSwapClientServer) GetAutoloopStats(context.Context, *AutoloopStatsRequest) (*AutoloopStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAutoloopStats not implemented")
+}
+func (UnimplementedSwapClientServer) GetSuggestionHistory(context.Context, *SuggestionHistoryRequest) (*SuggestionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSuggestionHistory not implemented")
+}
+func (UnimplementedSwapClientServer) GetAutoloopCalendar(context.Context, *AutoloopCalendarRequest) (*AutoloopCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAutoloopCalendar not implemented")
+}
+func (UnimplementedSwapClientServer) GetSimulatedSwaps(context.Context, *SimulatedSwapsRequest) (*SimulatedSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimulatedSwaps not implemented")
+}
+func (UnimplementedSwapClientServer) ClearSimulatedSwaps(context.Context, *ClearSimulatedSwapsRequest) (*ClearSimulatedSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearSimulatedSwaps not implemented")
+}
+func (UnimplementedSwapClientServer) ListApprovals(context.Context, *ListApprovalsRequest) (*ListApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApprovals not implemented")
+}
+func (UnimplementedSwapClientServer) ApproveSwap(context.Context, *ApproveSwapRequest) (*ApproveSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSwap not implemented")
+}
+func (UnimplementedSwapClientServer) RejectSwap(context.Context, *RejectSwapRequest) (*RejectSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectSwap not implemented")
+}
+func (UnimplementedSwapClientServer) GetServerNotices(context.Context, *ServerNoticesRequest) (*ServerNoticesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerNotices not implemented")
+}
+func (UnimplementedSwapClientServer) DebugLevel(context.Context, *DebugLevelRequest) (*DebugLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugLevel not implemented")
+}
+func (UnimplementedSwapClientServer) ReplaySwap(context.Context, *ReplaySwapRequest) (*ReplaySwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaySwap not implemented")
+}
+func (UnimplementedSwapClientServer) GetSwapStateGraph(context.Context, *SwapStateGraphRequest) (*SwapStateGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSwapStateGraph not implemented")
+}
+func (UnimplementedSwapClientServer) GetPostMortems(context.Context, *PostMortemsRequest) (*PostMortemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostMortems not implemented")
+}
+func (UnimplementedSwapClientServer) mustEmbedUnimplementedSwapClientServer() {}
+
+// UnsafeSwapClientServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SwapClientServer will
+// result in compilation errors.
+type UnsafeSwapClientServer interface {
+	mustEmbedUnimplementedSwapClientServer()
+}
+
+func RegisterSwapClientServer(s grpc.ServiceRegistrar, srv SwapClientServer) {
+	s.RegisterService(&SwapClient_ServiceDesc, srv)
+}
+
+func _SwapClient_LoopOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoopOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).LoopOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/LoopOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).LoopOut(ctx, req.(*LoopOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_LoopIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoopInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).LoopIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/LoopIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).LoopIn(ctx, req.(*LoopInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_Monitor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MonitorRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SwapClientServer).Monitor(m, &swapClientMonitorServer{stream})
+}
+
+type SwapClient_MonitorServer interface {
+	Send(*SwapStatus) error
+	grpc.ServerStream
+}
+
+type swapClientMonitorServer struct {
+	grpc.ServerStream
+}
+
+func (x *swapClientMonitorServer) Send(m *SwapStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SwapClient_ListSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListSwaps(ctx, req.(*ListSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_SwapInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).SwapInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/SwapInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).SwapInfo(ctx, req.(*SwapInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_SetSwapNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSwapNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).SetSwapNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/SetSwapNotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).SetSwapNotes(ctx, req.(*SetSwapNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_SearchSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).SearchSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/SearchSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).SearchSwaps(ctx, req.(*SearchSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ListSwapConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwapConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListSwapConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListSwapConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListSwapConflicts(ctx, req.(*ListSwapConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ResolveSwapConflict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveSwapConflictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ResolveSwapConflict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ResolveSwapConflict",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ResolveSwapConflict(ctx, req.(*ResolveSwapConflictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetFeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetFeeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetFeeReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetFeeReport(ctx, req.(*FeeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetAccountingReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountingReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetAccountingReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetAccountingReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetAccountingReport(ctx, req.(*AccountingReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_WatchSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).WatchSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/WatchSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).WatchSwap(ctx, req.(*WatchSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ListWatchedSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWatchedSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListWatchedSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListWatchedSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListWatchedSwaps(ctx, req.(*ListWatchedSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_LoopOutTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).LoopOutTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/LoopOutTerms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).LoopOutTerms(ctx, req.(*TermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_LoopOutQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).LoopOutQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/LoopOutQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).LoopOutQuote(ctx, req.(*QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetLoopInTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetLoopInTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetLoopInTerms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetLoopInTerms(ctx, req.(*TermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetLoopInQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetLoopInQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetLoopInQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetLoopInQuote(ctx, req.(*QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_Probe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).Probe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/Probe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).Probe(ctx, req.(*ProbeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetLsatTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetLsatTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetLsatTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetLsatTokens(ctx, req.(*TokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetLndFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LndFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetLndFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetLndFeatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetLndFeatures(ctx, req.(*LndFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_RecoveryTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoveryTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).RecoveryTest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/RecoveryTest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).RecoveryTest(ctx, req.(*RecoveryTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetLiquidityParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiquidityParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetLiquidityParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetLiquidityParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetLiquidityParams(ctx, req.(*GetLiquidityParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_SetLiquidityParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLiquidityParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).SetLiquidityParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/SetLiquidityParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).SetLiquidityParams(ctx, req.(*SetLiquidityParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetEffectiveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectiveConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetEffectiveConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetEffectiveConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetEffectiveConfig(ctx, req.(*GetEffectiveConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ApplyRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ApplyRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ApplyRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ApplyRules(ctx, req.(*ApplyRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_SaveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).SaveProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/SaveProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).SaveProfile(ctx, req.(*SaveProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ListProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ListProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ListProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ListProfiles(ctx, req.(*ListProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_SetProfileSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProfileScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).SetProfileSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/SetProfileSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).SetProfileSchedule(ctx, req.(*SetProfileScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// UnsafeSwapClientServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SwapClientServer will
-// result in compilation errors.
-type UnsafeSwapClientServer interface {
-	mustEmbedUnimplementedSwapClientServer()
+func _SwapClient_GetParamsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetParamsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetParamsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetParamsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetParamsHistory(ctx, req.(*GetParamsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSwapClientServer(s grpc.ServiceRegistrar, srv SwapClientServer) {
-	s.RegisterService(&SwapClient_ServiceDesc, srv)
+func _SwapClient_GetPendingIncrease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingIncreaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetPendingIncrease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetPendingIncrease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetPendingIncrease(ctx, req.(*GetPendingIncreaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_LoopOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoopOutRequest)
+func _SwapClient_CancelPendingIncrease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPendingIncreaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).LoopOut(ctx, in)
+		return srv.(SwapClientServer).CancelPendingIncrease(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/LoopOut",
+		FullMethod: "/looprpc.SwapClient/CancelPendingIncrease",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).LoopOut(ctx, req.(*LoopOutRequest))
+		return srv.(SwapClientServer).CancelPendingIncrease(ctx, req.(*CancelPendingIncreaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_LoopIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoopInRequest)
+func _SwapClient_SuggestSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestSwapsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).LoopIn(ctx, in)
+		return srv.(SwapClientServer).SuggestSwaps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/LoopIn",
+		FullMethod: "/looprpc.SwapClient/SuggestSwaps",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).LoopIn(ctx, req.(*LoopInRequest))
+		return srv.(SwapClientServer).SuggestSwaps(ctx, req.(*SuggestSwapsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_Monitor_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(MonitorRequest)
+func _SwapClient_SubscribeSuggestions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeSuggestionsRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(SwapClientServer).Monitor(m, &swapClientMonitorServer{stream})
+	return srv.(SwapClientServer).SubscribeSuggestions(m, &swapClientSubscribeSuggestionsServer{stream})
 }
 
-type SwapClient_MonitorServer interface {
-	Send(*SwapStatus) error
+type SwapClient_SubscribeSuggestionsServer interface {
+	Send(*SuggestSwapsResponse) error
 	grpc.ServerStream
 }
 
-type swapClientMonitorServer struct {
+type swapClientSubscribeSuggestionsServer struct {
 	grpc.ServerStream
 }
 
-func (x *swapClientMonitorServer) Send(m *SwapStatus) error {
+func (x *swapClientSubscribeSuggestionsServer) Send(m *SuggestSwapsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _SwapClient_ListSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListSwapsRequest)
+func _SwapClient_PreviewFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewFeesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).ListSwaps(ctx, in)
+		return srv.(SwapClientServer).PreviewFees(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/ListSwaps",
+		FullMethod: "/looprpc.SwapClient/PreviewFees",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).ListSwaps(ctx, req.(*ListSwapsRequest))
+		return srv.(SwapClientServer).PreviewFees(ctx, req.(*PreviewFeesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_SwapInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SwapInfoRequest)
+func _SwapClient_CompareRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRebalanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).SwapInfo(ctx, in)
+		return srv.(SwapClientServer).CompareRebalance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/SwapInfo",
+		FullMethod: "/looprpc.SwapClient/CompareRebalance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).SwapInfo(ctx, req.(*SwapInfoRequest))
+		return srv.(SwapClientServer).CompareRebalance(ctx, req.(*CompareRebalanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_LoopOutTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TermsRequest)
+func _SwapClient_CloseAdvice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAdviceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).LoopOutTerms(ctx, in)
+		return srv.(SwapClientServer).CloseAdvice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/LoopOutTerms",
+		FullMethod: "/looprpc.SwapClient/CloseAdvice",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).LoopOutTerms(ctx, req.(*TermsRequest))
+		return srv.(SwapClientServer).CloseAdvice(ctx, req.(*CloseAdviceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_LoopOutQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuoteRequest)
+func _SwapClient_GetAutoloopStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoloopStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).LoopOutQuote(ctx, in)
+		return srv.(SwapClientServer).GetAutoloopStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/LoopOutQuote",
+		FullMethod: "/looprpc.SwapClient/GetAutoloopStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).LoopOutQuote(ctx, req.(*QuoteRequest))
+		return srv.(SwapClientServer).GetAutoloopStats(ctx, req.(*AutoloopStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_GetLoopInTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TermsRequest)
+func _SwapClient_GetSuggestionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestionHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).GetLoopInTerms(ctx, in)
+		return srv.(SwapClientServer).GetSuggestionHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/GetLoopInTerms",
+		FullMethod: "/looprpc.SwapClient/GetSuggestionHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).GetLoopInTerms(ctx, req.(*TermsRequest))
+		return srv.(SwapClientServer).GetSuggestionHistory(ctx, req.(*SuggestionHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_GetLoopInQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuoteRequest)
+func _SwapClient_GetAutoloopCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoloopCalendarRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).GetLoopInQuote(ctx, in)
+		return srv.(SwapClientServer).GetAutoloopCalendar(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/GetLoopInQuote",
+		FullMethod: "/looprpc.SwapClient/GetAutoloopCalendar",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).GetLoopInQuote(ctx, req.(*QuoteRequest))
+		return srv.(SwapClientServer).GetAutoloopCalendar(ctx, req.(*AutoloopCalendarRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_Probe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProbeRequest)
+func _SwapClient_GetSimulatedSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulatedSwapsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).Probe(ctx, in)
+		return srv.(SwapClientServer).GetSimulatedSwaps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/Probe",
+		FullMethod: "/looprpc.SwapClient/GetSimulatedSwaps",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).Probe(ctx, req.(*ProbeRequest))
+		return srv.(SwapClientServer).GetSimulatedSwaps(ctx, req.(*SimulatedSwapsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_GetLsatTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TokensRequest)
+func _SwapClient_ClearSimulatedSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearSimulatedSwapsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).GetLsatTokens(ctx, in)
+		return srv.(SwapClientServer).ClearSimulatedSwaps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/GetLsatTokens",
+		FullMethod: "/looprpc.SwapClient/ClearSimulatedSwaps",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).GetLsatTokens(ctx, req.(*TokensRequest))
+		return srv.(SwapClientServer).ClearSimulatedSwaps(ctx, req.(*ClearSimulatedSwapsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_GetLiquidityParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLiquidityParamsRequest)
+func _SwapClient_ListApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApprovalsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).GetLiquidityParams(ctx, in)
+		return srv.(SwapClientServer).ListApprovals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/GetLiquidityParams",
+		FullMethod: "/looprpc.SwapClient/ListApprovals",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).GetLiquidityParams(ctx, req.(*GetLiquidityParamsRequest))
+		return srv.(SwapClientServer).ListApprovals(ctx, req.(*ListApprovalsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_SetLiquidityParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLiquidityParamsRequest)
+func _SwapClient_ApproveSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSwapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).SetLiquidityParams(ctx, in)
+		return srv.(SwapClientServer).ApproveSwap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/SetLiquidityParams",
+		FullMethod: "/looprpc.SwapClient/ApproveSwap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).SetLiquidityParams(ctx, req.(*SetLiquidityParamsRequest))
+		return srv.(SwapClientServer).ApproveSwap(ctx, req.(*ApproveSwapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SwapClient_SuggestSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuggestSwapsRequest)
+func _SwapClient_RejectSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectSwapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SwapClientServer).SuggestSwaps(ctx, in)
+		return srv.(SwapClientServer).RejectSwap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/looprpc.SwapClient/SuggestSwaps",
+		FullMethod: "/looprpc.SwapClient/RejectSwap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SwapClientServer).SuggestSwaps(ctx, req.(*SuggestSwapsRequest))
+		return srv.(SwapClientServer).RejectSwap(ctx, req.(*RejectSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetServerNotices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerNoticesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetServerNotices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetServerNotices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetServerNotices(ctx, req.(*ServerNoticesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_DebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).DebugLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/DebugLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).DebugLevel(ctx, req.(*DebugLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_ReplaySwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaySwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).ReplaySwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/ReplaySwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).ReplaySwap(ctx, req.(*ReplaySwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetSwapStateGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapStateGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetSwapStateGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetSwapStateGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetSwapStateGraph(ctx, req.(*SwapStateGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwapClient_GetPostMortems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostMortemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapClientServer).GetPostMortems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapClient/GetPostMortems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapClientServer).GetPostMortems(ctx, req.(*PostMortemsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -639,6 +2181,38 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SwapInfo",
 			Handler:    _SwapClient_SwapInfo_Handler,
 		},
+		{
+			MethodName: "SetSwapNotes",
+			Handler:    _SwapClient_SetSwapNotes_Handler,
+		},
+		{
+			MethodName: "SearchSwaps",
+			Handler:    _SwapClient_SearchSwaps_Handler,
+		},
+		{
+			MethodName: "ListSwapConflicts",
+			Handler:    _SwapClient_ListSwapConflicts_Handler,
+		},
+		{
+			MethodName: "ResolveSwapConflict",
+			Handler:    _SwapClient_ResolveSwapConflict_Handler,
+		},
+		{
+			MethodName: "GetFeeReport",
+			Handler:    _SwapClient_GetFeeReport_Handler,
+		},
+		{
+			MethodName: "GetAccountingReport",
+			Handler:    _SwapClient_GetAccountingReport_Handler,
+		},
+		{
+			MethodName: "WatchSwap",
+			Handler:    _SwapClient_WatchSwap_Handler,
+		},
+		{
+			MethodName: "ListWatchedSwaps",
+			Handler:    _SwapClient_ListWatchedSwaps_Handler,
+		},
 		{
 			MethodName: "LoopOutTerms",
 			Handler:    _SwapClient_LoopOutTerms_Handler,
@@ -663,6 +2237,18 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLsatTokens",
 			Handler:    _SwapClient_GetLsatTokens_Handler,
 		},
+		{
+			MethodName: "GetLndFeatures",
+			Handler:    _SwapClient_GetLndFeatures_Handler,
+		},
+		{
+			MethodName: "GetInfo",
+			Handler:    _SwapClient_GetInfo_Handler,
+		},
+		{
+			MethodName: "RecoveryTest",
+			Handler:    _SwapClient_RecoveryTest_Handler,
+		},
 		{
 			MethodName: "GetLiquidityParams",
 			Handler:    _SwapClient_GetLiquidityParams_Handler,
@@ -671,10 +2257,106 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetLiquidityParams",
 			Handler:    _SwapClient_SetLiquidityParams_Handler,
 		},
+		{
+			MethodName: "GetEffectiveConfig",
+			Handler:    _SwapClient_GetEffectiveConfig_Handler,
+		},
+		{
+			MethodName: "ApplyRules",
+			Handler:    _SwapClient_ApplyRules_Handler,
+		},
+		{
+			MethodName: "SaveProfile",
+			Handler:    _SwapClient_SaveProfile_Handler,
+		},
+		{
+			MethodName: "ListProfiles",
+			Handler:    _SwapClient_ListProfiles_Handler,
+		},
+		{
+			MethodName: "SetProfileSchedule",
+			Handler:    _SwapClient_SetProfileSchedule_Handler,
+		},
+		{
+			MethodName: "GetParamsHistory",
+			Handler:    _SwapClient_GetParamsHistory_Handler,
+		},
+		{
+			MethodName: "GetPendingIncrease",
+			Handler:    _SwapClient_GetPendingIncrease_Handler,
+		},
+		{
+			MethodName: "CancelPendingIncrease",
+			Handler:    _SwapClient_CancelPendingIncrease_Handler,
+		},
 		{
 			MethodName: "SuggestSwaps",
 			Handler:    _SwapClient_SuggestSwaps_Handler,
 		},
+		{
+			MethodName: "PreviewFees",
+			Handler:    _SwapClient_PreviewFees_Handler,
+		},
+		{
+			MethodName: "CompareRebalance",
+			Handler:    _SwapClient_CompareRebalance_Handler,
+		},
+		{
+			MethodName: "CloseAdvice",
+			Handler:    _SwapClient_CloseAdvice_Handler,
+		},
+		{
+			MethodName: "GetAutoloopStats",
+			Handler:    _SwapClient_GetAutoloopStats_Handler,
+		},
+		{
+			MethodName: "GetSuggestionHistory",
+			Handler:    _SwapClient_GetSuggestionHistory_Handler,
+		},
+		{
+			MethodName: "GetAutoloopCalendar",
+			Handler:    _SwapClient_GetAutoloopCalendar_Handler,
+		},
+		{
+			MethodName: "GetSimulatedSwaps",
+			Handler:    _SwapClient_GetSimulatedSwaps_Handler,
+		},
+		{
+			MethodName: "ClearSimulatedSwaps",
+			Handler:    _SwapClient_ClearSimulatedSwaps_Handler,
+		},
+		{
+			MethodName: "ListApprovals",
+			Handler:    _SwapClient_ListApprovals_Handler,
+		},
+		{
+			MethodName: "ApproveSwap",
+			Handler:    _SwapClient_ApproveSwap_Handler,
+		},
+		{
+			MethodName: "RejectSwap",
+			Handler:    _SwapClient_RejectSwap_Handler,
+		},
+		{
+			MethodName: "GetServerNotices",
+			Handler:    _SwapClient_GetServerNotices_Handler,
+		},
+		{
+			MethodName: "DebugLevel",
+			Handler:    _SwapClient_DebugLevel_Handler,
+		},
+		{
+			MethodName: "ReplaySwap",
+			Handler:    _SwapClient_ReplaySwap_Handler,
+		},
+		{
+			MethodName: "GetSwapStateGraph",
+			Handler:    _SwapClient_GetSwapStateGraph_Handler,
+		},
+		{
+			MethodName: "GetPostMortems",
+			Handler:    _SwapClient_GetPostMortems_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -682,6 +2364,11 @@ var SwapClient_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _SwapClient_Monitor_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeSuggestions",
+			Handler:       _SwapClient_SubscribeSuggestions_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "client.proto",
 }
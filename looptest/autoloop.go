@@ -0,0 +1,326 @@
+package looptest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/ticker"
+	"github.com/stretchr/testify/require"
+)
+
+// StartTime is the time that the test clock of an AutoloopContext starts at.
+var StartTime = time.Date(2020, 02, 13, 0, 0, 0, 0, time.UTC)
+
+// AutoloopContext runs a liquidity manager against mocked lnd services and a
+// mocked server that is driven step by step by the test, so that tests can
+// assert on every call the autolooper makes.
+type AutoloopContext struct {
+	t *testing.T
+
+	// Manager is the liquidity manager under test.
+	Manager *liquidity.Manager
+
+	// Lnd is the set of mocked lnd services that the manager uses.
+	Lnd *MockLnd
+
+	// Clock is the test clock that the manager uses.
+	Clock *clock.TestClock
+
+	ticker *ticker.Force
+
+	quoteRequest        chan *loop.LoopOutQuoteRequest
+	quotes              chan *loop.LoopOutQuote
+	quoteRequestIn      chan *loop.LoopInQuoteRequest
+	quotesIn            chan *loop.LoopInQuote
+	loopOutRestrictions chan *liquidity.Restrictions
+	loopInRestrictions  chan *liquidity.Restrictions
+	loopOuts            chan []*loopdb.LoopOut
+	loopIns             chan []*loopdb.LoopIn
+	outRequest          chan *loop.OutRequest
+	loopOut             chan *loop.LoopOutSwapInfo
+	inRequest           chan *loop.LoopInRequest
+	loopIn              chan *loop.LoopInSwapInfo
+	errChan             chan error
+
+	cancelCtx func()
+}
+
+// NewAutoloopContext creates a test context for a liquidity manager with the
+// parameters, lnd channels and server loop out restrictions provided.
+func NewAutoloopContext(t *testing.T, parameters liquidity.Parameters,
+	channels []lndclient.ChannelInfo,
+	server *liquidity.Restrictions) *AutoloopContext {
+
+	// Create a mock lnd and set our expected fee rate for sweeps to our
+	// sweep fee rate limit value.
+	lnd := NewMockLnd()
+
+	categories, ok := parameters.FeeLimit.(*liquidity.FeeCategoryLimit)
+	if ok {
+		lnd.SetFeeEstimate(
+			parameters.SweepConfTarget, categories.SweepFeeRateLimit,
+		)
+	}
+
+	lnd.SetChannels(channels)
+
+	c := &AutoloopContext{
+		t:      t,
+		Lnd:    lnd,
+		Clock:  clock.NewTestClock(StartTime),
+		ticker: ticker.NewForce(liquidity.DefaultAutoloopTicker),
+
+		quoteRequest:        make(chan *loop.LoopOutQuoteRequest),
+		quotes:              make(chan *loop.LoopOutQuote),
+		quoteRequestIn:      make(chan *loop.LoopInQuoteRequest),
+		quotesIn:            make(chan *loop.LoopInQuote),
+		loopOutRestrictions: make(chan *liquidity.Restrictions),
+		loopInRestrictions:  make(chan *liquidity.Restrictions),
+		loopOuts:            make(chan []*loopdb.LoopOut),
+		loopIns:             make(chan []*loopdb.LoopIn),
+		outRequest:          make(chan *loop.OutRequest),
+		loopOut:             make(chan *loop.LoopOutSwapInfo),
+		inRequest:           make(chan *loop.LoopInRequest),
+		loopIn:              make(chan *loop.LoopInSwapInfo),
+		errChan:             make(chan error, 1),
+	}
+
+	// The manager's inputs are driven by our test steps.
+	sources := &autoloopSources{c}
+
+	services := lnd.LndServices()
+	source := liquidity.NewLndChannelSource(services.Client)
+	cfg := &liquidity.Config{
+		AutoloopTicker:       c.ticker,
+		Channels:             source,
+		Quotes:               sources,
+		Dispatcher:           sources,
+		Swaps:                sources,
+		MinimumConfirmations: loop.DefaultSweepConfTarget,
+		Wallet:               services.WalletKit,
+		Graph:                services.Client,
+		Node:                 services.Client,
+		ChainParams:          services.ChainParams,
+		NodePubkey:           services.NodePubkey,
+		Clock:                c.Clock,
+	}
+
+	// SetParameters needs to make a call to our mocked restrictions call,
+	// which will block, so we push our test values in a goroutine.
+	done := make(chan struct{})
+	go func() {
+		c.loopOutRestrictions <- server
+		close(done)
+	}()
+
+	c.Manager = liquidity.NewManager(cfg)
+	err := c.Manager.SetParameters(context.Background(), parameters)
+	require.NoError(t, err)
+	<-done
+
+	return c
+}
+
+// autoloopSources provides a liquidity manager with restrictions, quotes,
+// swaps and dispatch results that are pushed by an AutoloopContext's test
+// steps.
+type autoloopSources struct {
+	*AutoloopContext
+}
+
+// Restrictions returns the restrictions that our test pushes for the swap
+// type provided.
+func (c *autoloopSources) Restrictions(_ context.Context,
+	swapType swap.Type) (*liquidity.Restrictions, error) {
+
+	if swapType == swap.TypeOut {
+		return <-c.loopOutRestrictions, nil
+	}
+
+	return <-c.loopInRestrictions, nil
+}
+
+// ListLoopOut returns the loop outs that our test pushes.
+func (c *autoloopSources) ListLoopOut() ([]*loopdb.LoopOut, error) {
+	return <-c.loopOuts, nil
+}
+
+// ListLoopIn returns the loop ins that our test pushes.
+func (c *autoloopSources) ListLoopIn() ([]*loopdb.LoopIn, error) {
+	return <-c.loopIns, nil
+}
+
+// LoopOutQuote sends the quote request to our test and returns the quote that
+// it pushes.
+func (c *autoloopSources) LoopOutQuote(_ context.Context,
+	req *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote, error) {
+
+	c.quoteRequest <- req
+
+	return <-c.quotes, nil
+}
+
+// LoopInQuote sends the quote request to our test and returns the quote that
+// it pushes.
+func (c *autoloopSources) LoopInQuote(_ context.Context,
+	req *loop.LoopInQuoteRequest) (*loop.LoopInQuote, error) {
+
+	c.quoteRequestIn <- req
+
+	return <-c.quotesIn, nil
+}
+
+// LoopOut sends the loop out request to our test and returns the swap info
+// that it pushes.
+func (c *autoloopSources) LoopOut(_ context.Context,
+	req *loop.OutRequest) (*loop.LoopOutSwapInfo, error) {
+
+	c.outRequest <- req
+
+	return <-c.loopOut, nil
+}
+
+// LoopIn sends the loop in request to our test and returns the swap info that
+// it pushes.
+func (c *autoloopSources) LoopIn(_ context.Context,
+	req *loop.LoopInRequest) (*loop.LoopInSwapInfo, error) {
+
+	c.inRequest <- req
+
+	return <-c.loopIn, nil
+}
+
+// Start runs the liquidity manager in a goroutine. Tests must call Stop to
+// shut it down.
+func (c *AutoloopContext) Start() {
+	ctx := context.Background()
+	ctx, c.cancelCtx = context.WithCancel(ctx)
+
+	go func() {
+		c.errChan <- c.Manager.Run(ctx)
+	}()
+}
+
+// Stop shuts down the liquidity manager and asserts that it exited with a
+// context canceled error.
+func (c *AutoloopContext) Stop() {
+	c.cancelCtx()
+	require.Equal(c.t, context.Canceled, <-c.errChan)
+}
+
+// LoopOutQuote pairs an expected loop out quote request with the quote that
+// the mocked server responds with.
+type LoopOutQuote struct {
+	Request *loop.LoopOutQuoteRequest
+	Quote   *loop.LoopOutQuote
+}
+
+// LoopInQuote pairs an expected loop in quote request with the quote that the
+// mocked server responds with.
+type LoopInQuote struct {
+	Request *loop.LoopInQuoteRequest
+	Quote   *loop.LoopInQuote
+}
+
+// LoopOut pairs an expected loop out request with the response that the
+// mocked server responds with.
+type LoopOut struct {
+	Request  *loop.OutRequest
+	Response *loop.LoopOutSwapInfo
+}
+
+// LoopIn pairs an expected loop in request with the response that the mocked
+// server responds with.
+type LoopIn struct {
+	Request  *loop.LoopInRequest
+	Response *loop.LoopInSwapInfo
+}
+
+// AutoloopStep contains the mocked values and expectations for a single
+// autoloop tick.
+type AutoloopStep struct {
+	// MinAmt and MaxAmt are the server's swap size restrictions.
+	MinAmt btcutil.Amount
+	MaxAmt btcutil.Amount
+
+	// ExistingOut and ExistingIn are the swaps that already exist.
+	ExistingOut []*loopdb.LoopOut
+	ExistingIn  []*loopdb.LoopIn
+
+	// QuotesOut and QuotesIn are the quotes we expect to be requested, one
+	// for each swap that is suggested.
+	QuotesOut []LoopOutQuote
+	QuotesIn  []LoopInQuote
+
+	// ExpectedOut and ExpectedIn are the swaps we expect to be dispatched.
+	ExpectedOut []LoopOut
+	ExpectedIn  []LoopIn
+}
+
+// Autoloop forces an autoloop tick and walks the manager through it,
+// providing the mocked values in the step and asserting that the manager
+// makes the expected quote and swap requests.
+func (c *AutoloopContext) Autoloop(step *AutoloopStep) {
+	c.ticker.Force <- c.Clock.Now()
+
+	c.loopOutRestrictions <- liquidity.NewRestrictions(
+		step.MinAmt, step.MaxAmt,
+	)
+	c.loopInRestrictions <- liquidity.NewRestrictions(
+		step.MinAmt, step.MaxAmt,
+	)
+
+	c.loopOuts <- step.ExistingOut
+	c.loopIns <- step.ExistingIn
+
+	// Note that the quotes we are asked for may differ from the set of
+	// swaps that are dispatched, because suggestions may still be limited
+	// by our budget after they are quoted.
+	for _, expected := range step.QuotesIn {
+		request := <-c.quoteRequestIn
+		require.Equal(c.t, expected.Request.Amount, request.Amount)
+		require.Equal(
+			c.t, expected.Request.HtlcConfTarget,
+			request.HtlcConfTarget,
+		)
+
+		c.quotesIn <- expected.Quote
+	}
+
+	for _, expected := range step.QuotesOut {
+		request := <-c.quoteRequest
+		require.Equal(c.t, expected.Request.Amount, request.Amount)
+		require.Equal(
+			c.t, expected.Request.SweepConfTarget,
+			request.SweepConfTarget,
+		)
+
+		c.quotes <- expected.Quote
+	}
+
+	for _, expected := range step.ExpectedOut {
+		actual := <-c.outRequest
+
+		// Set our destination address to nil so that tests do not need
+		// to provide the address that is obtained by the mock wallet.
+		actual.DestAddr = nil
+
+		require.Equal(c.t, expected.Request, actual)
+		c.loopOut <- expected.Response
+	}
+
+	for _, expected := range step.ExpectedIn {
+		actual := <-c.inRequest
+		require.Equal(c.t, expected.Request, actual)
+
+		c.loopIn <- expected.Response
+	}
+}
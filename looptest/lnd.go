@@ -0,0 +1,50 @@
+// Package looptest provides mocks and test contexts that applications which
+// integrate with loop can use in their unit tests. The exported types and
+// functions in this package are part of loop's public API, and are kept
+// stable across releases. They wrap loop's internal test mocks, which may
+// change at any time, so only the behavior exposed here may be relied on.
+package looptest
+
+import (
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// MockLnd provides a mocked set of lnd services, which can be used wherever
+// loop requires lnd. The mock's channels and fee estimates should be set
+// before it is used.
+type MockLnd struct {
+	mock *test.LndMockServices
+}
+
+// NewMockLnd returns a new set of mocked lnd services.
+func NewMockLnd() *MockLnd {
+	return &MockLnd{
+		mock: test.NewMockLnd(),
+	}
+}
+
+// LndServices returns the lnd services that are backed by the mock.
+func (m *MockLnd) LndServices() *lndclient.LndServices {
+	return &m.mock.LndServices
+}
+
+// SetChannels sets the open channels that the mock lists.
+func (m *MockLnd) SetChannels(channels []lndclient.ChannelInfo) {
+	m.mock.Channels = channels
+}
+
+// SetFeeEstimate sets the fee rate that the mock estimates for the
+// confirmation target provided.
+func (m *MockLnd) SetFeeEstimate(confTarget int32,
+	feeRate chainfee.SatPerKWeight) {
+
+	m.mock.SetFeeEstimate(confTarget, feeRate)
+}
+
+// NotifyHeight notifies subscribers of the mock's block epochs of a new block
+// height.
+func (m *MockLnd) NotifyHeight(height int32) error {
+	return m.mock.NotifyHeight(height)
+}
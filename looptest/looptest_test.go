@@ -0,0 +1,113 @@
+package looptest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testChanID = lnwire.NewShortChanIDFromInt(1)
+
+	// testChannel is a channel that has all of its balance on our side, so
+	// it will require a loop out with testRule.
+	testChannel = lndclient.ChannelInfo{
+		ChannelID:     testChanID.ToUint64(),
+		PubKeyBytes:   route.Vertex{1},
+		LocalBalance:  100000,
+		RemoteBalance: 0,
+		Capacity:      100000,
+	}
+
+	testRule = &liquidity.SwapRule{
+		ThresholdRule: liquidity.NewThresholdRule(50, 0),
+		Type:          swap.TypeOut,
+	}
+)
+
+// TestMockServer tests getting swap suggestions from a liquidity manager that
+// is backed by our mock server.
+func TestMockServer(t *testing.T) {
+	lnd := NewMockLnd()
+	lnd.SetChannels([]lndclient.ChannelInfo{testChannel})
+
+	server := NewMockServer()
+	manager := liquidity.NewManager(
+		server.LiquidityConfig(lnd, clock.NewTestClock(StartTime)),
+	)
+
+	params := manager.GetParameters()
+	params.ChannelRules = map[lnwire.ShortChannelID]*liquidity.SwapRule{
+		testChanID: testRule,
+	}
+
+	ctx := context.Background()
+	require.NoError(t, manager.SetParameters(ctx, params))
+
+	suggestions, err := manager.SuggestSwaps(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, suggestions.OutSwaps, 1)
+	require.Equal(
+		t, []uint64{testChanID.ToUint64()},
+		[]uint64(suggestions.OutSwaps[0].OutgoingChanSet),
+	)
+
+	// Dispatch the suggested swap and assert that the server records it
+	// with a stable hash.
+	info, err := server.LoopOut(ctx, &suggestions.OutSwaps[0])
+	require.NoError(t, err)
+	require.Equal(t, server.nextHash(), info.SwapHash)
+	require.Len(t, server.LoopOutRequests(), 1)
+}
+
+// TestAutoloopContext tests stepping a liquidity manager through an autoloop
+// tick with our test context.
+func TestAutoloopContext(t *testing.T) {
+	defer test.Guard(t)()
+
+	manager := liquidity.NewManager(
+		NewMockServer().LiquidityConfig(
+			NewMockLnd(), clock.NewTestClock(StartTime),
+		),
+	)
+
+	params := manager.GetParameters()
+	params.ChannelRules = map[lnwire.ShortChannelID]*liquidity.SwapRule{
+		testChanID: testRule,
+	}
+
+	c := NewAutoloopContext(
+		t, params, []lndclient.ChannelInfo{testChannel},
+		liquidity.NewRestrictions(
+			DefaultMinSwapAmount, DefaultMaxSwapAmount,
+		),
+	)
+	c.Start()
+
+	// Autoloop is disabled by default, so we expect our swap to be quoted
+	// but not dispatched.
+	c.Autoloop(&AutoloopStep{
+		MinAmt: DefaultMinSwapAmount,
+		MaxAmt: DefaultMaxSwapAmount,
+		QuotesOut: []LoopOutQuote{
+			{
+				Request: &loop.LoopOutQuoteRequest{
+					Amount:          75000,
+					SweepConfTarget: params.SweepConfTarget,
+				},
+				Quote: &DefaultLoopOutQuote,
+			},
+		},
+	})
+
+	c.Stop()
+}
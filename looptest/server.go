@@ -0,0 +1,247 @@
+package looptest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/liquidity"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+var (
+	// DefaultMinSwapAmount is the minimum swap amount that our mock server
+	// allows by default.
+	DefaultMinSwapAmount = btcutil.Amount(10000)
+
+	// DefaultMaxSwapAmount is the maximum swap amount that our mock server
+	// allows by default.
+	DefaultMaxSwapAmount = btcutil.Amount(1000000)
+
+	// DefaultLoopOutQuote is the quote that our mock server provides for
+	// loop out swaps by default. The miner fee is low because autoloop
+	// scales it up to account for fee spikes before checking it against
+	// its fee limits.
+	DefaultLoopOutQuote = loop.LoopOutQuote{
+		SwapFee:      btcutil.Amount(210),
+		PrepayAmount: btcutil.Amount(100),
+		MinerFee:     btcutil.Amount(2),
+	}
+
+	// DefaultLoopInQuote is the quote that our mock server provides for
+	// loop in swaps by default.
+	DefaultLoopInQuote = loop.LoopInQuote{
+		SwapFee:   btcutil.Amount(210),
+		MinerFee:  btcutil.Amount(50),
+		CltvDelta: 100,
+	}
+)
+
+// MockServer is a mocked swap server which responds to quote and swap
+// requests with fixed values, and records the swaps that it is asked to
+// dispatch. Its methods have the signatures that the liquidity manager's config
+// requires, so that it can be used to test autoloop without a live server.
+// The exported fields should be set before the mock is used; existing swaps
+// can be updated afterwards with SetExistingSwaps.
+type MockServer struct {
+	// OutRestrictions are the swap size restrictions that the server
+	// places on loop out swaps.
+	OutRestrictions *liquidity.Restrictions
+
+	// InRestrictions are the swap size restrictions that the server places
+	// on loop in swaps.
+	InRestrictions *liquidity.Restrictions
+
+	// OutQuote is the quote that the server provides for loop out swaps.
+	OutQuote *loop.LoopOutQuote
+
+	// InQuote is the quote that the server provides for loop in swaps.
+	InQuote *loop.LoopInQuote
+
+	// ExistingOut is the set of loop out swaps that we report as existing.
+	ExistingOut []*loopdb.LoopOut
+
+	// ExistingIn is the set of loop in swaps that we report as existing.
+	ExistingIn []*loopdb.LoopIn
+
+	outRequests []*loop.OutRequest
+	inRequests  []*loop.LoopInRequest
+
+	mu sync.Mutex
+}
+
+// NewMockServer returns a mock server which uses our default restrictions
+// and quotes.
+func NewMockServer() *MockServer {
+	outQuote := DefaultLoopOutQuote
+	inQuote := DefaultLoopInQuote
+
+	return &MockServer{
+		OutRestrictions: liquidity.NewRestrictions(
+			DefaultMinSwapAmount, DefaultMaxSwapAmount,
+		),
+		InRestrictions: liquidity.NewRestrictions(
+			DefaultMinSwapAmount, DefaultMaxSwapAmount,
+		),
+		OutQuote: &outQuote,
+		InQuote:  &inQuote,
+	}
+}
+
+// Restrictions returns the server's restrictions for the swap type provided.
+func (s *MockServer) Restrictions(_ context.Context, swapType swap.Type) (
+	*liquidity.Restrictions, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if swapType == swap.TypeOut {
+		return s.OutRestrictions, nil
+	}
+
+	return s.InRestrictions, nil
+}
+
+// ListLoopOut returns our set of existing loop out swaps.
+func (s *MockServer) ListLoopOut() ([]*loopdb.LoopOut, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.ExistingOut, nil
+}
+
+// ListLoopIn returns our set of existing loop in swaps.
+func (s *MockServer) ListLoopIn() ([]*loopdb.LoopIn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.ExistingIn, nil
+}
+
+// SetExistingSwaps replaces the set of existing swaps that we report.
+func (s *MockServer) SetExistingSwaps(loopOuts []*loopdb.LoopOut,
+	loopIns []*loopdb.LoopIn) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ExistingOut = loopOuts
+	s.ExistingIn = loopIns
+}
+
+// LoopOutQuote returns the server's loop out quote.
+func (s *MockServer) LoopOutQuote(_ context.Context,
+	_ *loop.LoopOutQuoteRequest) (*loop.LoopOutQuote, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.OutQuote, nil
+}
+
+// LoopInQuote returns the server's loop in quote.
+func (s *MockServer) LoopInQuote(_ context.Context,
+	_ *loop.LoopInQuoteRequest) (*loop.LoopInQuote, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.InQuote, nil
+}
+
+// LoopOut records a loop out request and returns a swap with a deterministic
+// hash.
+func (s *MockServer) LoopOut(_ context.Context, request *loop.OutRequest) (
+	*loop.LoopOutSwapInfo, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.outRequests = append(s.outRequests, request)
+
+	return &loop.LoopOutSwapInfo{
+		SwapHash: s.nextHash(),
+	}, nil
+}
+
+// LoopIn records a loop in request and returns a swap with a deterministic
+// hash.
+func (s *MockServer) LoopIn(_ context.Context, request *loop.LoopInRequest) (
+	*loop.LoopInSwapInfo, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inRequests = append(s.inRequests, request)
+
+	return &loop.LoopInSwapInfo{
+		SwapHash: s.nextHash(),
+	}, nil
+}
+
+// LoopOutRequests returns the loop out swaps that the server has been asked
+// to dispatch.
+func (s *MockServer) LoopOutRequests() []*loop.OutRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*loop.OutRequest(nil), s.outRequests...)
+}
+
+// LoopInRequests returns the loop in swaps that the server has been asked to
+// dispatch.
+func (s *MockServer) LoopInRequests() []*loop.LoopInRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*loop.LoopInRequest(nil), s.inRequests...)
+}
+
+// nextHash returns a hash derived from the number of swaps that we have
+// dispatched so far, so that tests can rely on stable swap hashes. It must be
+// called with the mutex held, after the current request has been recorded.
+func (s *MockServer) nextHash() lntypes.Hash {
+	var count [8]byte
+	binary.BigEndian.PutUint64(
+		count[:], uint64(len(s.outRequests)+len(s.inRequests)),
+	)
+
+	return sha256.Sum256(count[:])
+}
+
+// A mock server can provide all of the swap related inputs of a liquidity
+// manager.
+var (
+	_ liquidity.QuoteSource    = (*MockServer)(nil)
+	_ liquidity.SwapDispatcher = (*MockServer)(nil)
+	_ liquidity.SwapStore      = (*MockServer)(nil)
+)
+
+// LiquidityConfig returns a liquidity manager config which is backed by the
+// mock server and lnd services provided.
+func (s *MockServer) LiquidityConfig(lnd *MockLnd,
+	testClock clock.Clock) *liquidity.Config {
+
+	services := lnd.LndServices()
+	channels := liquidity.NewLndChannelSource(services.Client)
+
+	return &liquidity.Config{
+		Channels:             channels,
+		Quotes:               s,
+		Dispatcher:           s,
+		Swaps:                s,
+		Wallet:               services.WalletKit,
+		Graph:                services.Client,
+		Node:                 services.Client,
+		ChainParams:          services.ChainParams,
+		NodePubkey:           services.NodePubkey,
+		Clock:                testClock,
+		MinimumConfirmations: loop.DefaultSweepConfTarget,
+	}
+}
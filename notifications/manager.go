@@ -0,0 +1,481 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// sendTimeout is the maximum amount of time we allow a transport to
+	// take to deliver a notification.
+	sendTimeout = time.Second * 30
+)
+
+// ErrShuttingDown is returned when an update is provided to the manager after
+// it has shut down.
+var ErrShuttingDown = errors.New("notification manager shutting down")
+
+// Config contains the external functionality required by the notification
+// manager.
+type Config struct {
+	// Rules is the set of rules that we send notifications for.
+	Rules []*Rule
+
+	// Transports maps transport names to the transports that rules may
+	// route notifications to.
+	Transports map[string]Transport
+
+	// RegisterBlockEpochNtfn subscribes to new blocks.
+	RegisterBlockEpochNtfn func(ctx context.Context) (chan int32,
+		chan error, error)
+
+	// BudgetStatus returns the amount of fees that autoloop has spent in
+	// its current budget period and its total budget.
+	BudgetStatus func(ctx context.Context) (btcutil.Amount,
+		btcutil.Amount, error)
+
+	// AutoloopTransport is an optional transport that autoloop's swap
+	// dispatch, completion and failure events, and the exhaustion of its
+	// budget, are sent to.
+	AutoloopTransport Transport
+
+	// Clock allows easy mocking of time in unit tests.
+	Clock clock.Clock
+}
+
+// sweepWatch tracks a published loop out sweep that has not confirmed yet.
+type sweepWatch struct {
+	// publishHeight is the height at which we first saw the sweep.
+	publishHeight int32
+
+	// notified is the set of rules that we have already sent a
+	// notification for, so that each tier only fires once per sweep.
+	notified map[*Rule]bool
+}
+
+// Manager evaluates notification rules against swap updates, new blocks and
+// autoloop's budget and routes notifications to the configured transports.
+type Manager struct {
+	cfg *Config
+
+	// updates is used to deliver swap updates to our main loop.
+	updates chan loop.SwapInfo
+
+	// overdue is used to deliver the post-mortems of swaps that have
+	// exceeded their maximum lifetime to our main loop.
+	overdue chan *loop.PostMortem
+
+	// height is the current block height, only accessed by our main loop.
+	height int32
+
+	// initiated is the set of swaps that we have already evaluated our
+	// swap initiation rules for.
+	initiated map[lntypes.Hash]struct{}
+
+	// sweeps tracks the loop out swaps that have published sweeps that
+	// are not confirmed yet.
+	sweeps map[lntypes.Hash]*sweepWatch
+
+	// budgetNotified indicates, per budget rule, whether we have already
+	// notified about the budget threshold being reached. It is reset when
+	// our consumption drops below the threshold, which happens when a new
+	// budget period starts.
+	budgetNotified map[*Rule]bool
+
+	// autoloopSwaps is the set of swaps dispatched by autoloop that have
+	// not reached a final state yet.
+	autoloopSwaps map[lntypes.Hash]struct{}
+
+	// budgetExhausted indicates whether we have already sent an event for
+	// autoloop's budget being exhausted. It is reset when our spending
+	// drops below our budget.
+	budgetExhausted bool
+
+	wg sync.WaitGroup
+}
+
+// NewManager validates the rules provided and returns a notification manager.
+func NewManager(cfg *Config) (*Manager, error) {
+	for _, rule := range cfg.Rules {
+		if err := rule.validate(cfg.Transports); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Manager{
+		cfg:            cfg,
+		updates:        make(chan loop.SwapInfo),
+		overdue:        make(chan *loop.PostMortem),
+		initiated:      make(map[lntypes.Hash]struct{}),
+		sweeps:         make(map[lntypes.Hash]*sweepWatch),
+		budgetNotified: make(map[*Rule]bool),
+		autoloopSwaps:  make(map[lntypes.Hash]struct{}),
+	}, nil
+}
+
+// Run evaluates our rules until the context provided is canceled. Note that
+// any notifications that are still being sent are given the chance to
+// complete before this function returns.
+func (m *Manager) Run(ctx context.Context) error {
+	defer m.wg.Wait()
+
+	blockChan, errChan, err := m.cfg.RegisterBlockEpochNtfn(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case height := <-blockChan:
+			m.height = height
+			m.checkSweeps(ctx)
+			m.checkBudget(ctx)
+
+		case info := <-m.updates:
+			m.swapUpdate(ctx, info)
+
+		case postMortem := <-m.overdue:
+			m.checkOverdue(ctx, postMortem)
+
+		case err := <-errChan:
+			return err
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// SwapUpdate delivers a swap update to the manager. This call blocks until
+// the update is received or the context is canceled.
+func (m *Manager) SwapUpdate(ctx context.Context, info loop.SwapInfo) error {
+	select {
+	case m.updates <- info:
+		return nil
+
+	case <-ctx.Done():
+		return ErrShuttingDown
+	}
+}
+
+// SwapOverdue delivers the post-mortem of a swap that has exceeded its maximum
+// lifetime to the manager. This call blocks until the post-mortem is received
+// or the context is canceled.
+func (m *Manager) SwapOverdue(ctx context.Context,
+	postMortem *loop.PostMortem) error {
+
+	select {
+	case m.overdue <- postMortem:
+		return nil
+
+	case <-ctx.Done():
+		return ErrShuttingDown
+	}
+}
+
+// swapUpdate evaluates our swap-level rules for a swap update.
+func (m *Manager) swapUpdate(ctx context.Context, info loop.SwapInfo) {
+	hash := info.SwapHash
+
+	// We only evaluate initiation rules once per swap, the first time we
+	// see it. Swaps that are resumed on startup are also initiated, so we
+	// check that the swap is in its initial state.
+	if _, ok := m.initiated[hash]; !ok {
+		m.initiated[hash] = struct{}{}
+
+		if info.State == loopdb.StateInitiated {
+			m.checkInitiated(ctx, info)
+		}
+	}
+
+	m.autoloopUpdate(ctx, info)
+
+	if info.SwapType != swap.TypeOut {
+		return
+	}
+
+	// Start watching a loop out's sweep once the preimage is revealed,
+	// which happens when we first publish it, and stop watching it once
+	// the swap has moved on to any other state.
+	_, watched := m.sweeps[hash]
+	switch {
+	case info.State == loopdb.StatePreimageRevealed && !watched:
+		m.sweeps[hash] = &sweepWatch{
+			publishHeight: m.height,
+			notified:      make(map[*Rule]bool),
+		}
+
+	case info.State != loopdb.StatePreimageRevealed && watched:
+		delete(m.sweeps, hash)
+	}
+}
+
+// autoloopUpdate sends events to our autoloop transport when a swap that was
+// dispatched by autoloop is initiated, succeeds or fails. We only send
+// completion events for swaps that we have seen while they were pending, so
+// that updates for swaps that are already final do not produce duplicates.
+func (m *Manager) autoloopUpdate(ctx context.Context, info loop.SwapInfo) {
+	if m.cfg.AutoloopTransport == nil {
+		return
+	}
+
+	if info.Label != labels.AutoloopLabel(info.SwapType) {
+		return
+	}
+
+	hash := info.SwapHash
+	_, tracked := m.autoloopSwaps[hash]
+
+	switch info.State.Type() {
+	case loopdb.StateTypePending:
+		if tracked {
+			return
+		}
+		m.autoloopSwaps[hash] = struct{}{}
+
+		if info.State == loopdb.StateInitiated {
+			m.notifyAutoloop(ctx, TriggerAutoloopDispatched, &hash,
+				fmt.Sprintf("autoloop dispatched %v swap of %v",
+					info.SwapType, info.AmountRequested))
+		}
+
+	case loopdb.StateTypeSuccess:
+		if !tracked {
+			return
+		}
+		delete(m.autoloopSwaps, hash)
+
+		m.notifyAutoloop(ctx, TriggerAutoloopSucceeded, &hash,
+			fmt.Sprintf("autoloop %v swap of %v succeeded, cost: %v",
+				info.SwapType, info.AmountRequested,
+				info.Cost.Total()))
+
+		// The fees of a completed swap count towards our budget, so we
+		// check whether it has been exhausted.
+		m.checkBudget(ctx)
+
+	case loopdb.StateTypeFail:
+		if !tracked {
+			return
+		}
+		delete(m.autoloopSwaps, hash)
+
+		m.notifyAutoloop(ctx, TriggerAutoloopFailed, &hash,
+			fmt.Sprintf("autoloop %v swap of %v failed: %v",
+				info.SwapType, info.AmountRequested,
+				info.State))
+
+		m.checkBudget(ctx)
+	}
+}
+
+// checkInitiated sends notifications for all of our swap initiation rules
+// that have a threshold below the swap's amount.
+func (m *Manager) checkInitiated(ctx context.Context, info loop.SwapInfo) {
+	for _, rule := range m.cfg.Rules {
+		if rule.Trigger != TriggerSwapInitiated {
+			continue
+		}
+
+		if uint64(info.AmountRequested) <= rule.Threshold {
+			continue
+		}
+
+		hash := info.SwapHash
+		m.notify(ctx, rule, &hash, fmt.Sprintf("%v swap of %v "+
+			"initiated", info.SwapType, info.AmountRequested))
+	}
+}
+
+// checkOverdue sends notifications for all of our overdue swap rules that have
+// a threshold below the overdue swap's amount.
+func (m *Manager) checkOverdue(ctx context.Context,
+	postMortem *loop.PostMortem) {
+
+	for _, rule := range m.cfg.Rules {
+		if rule.Trigger != TriggerSwapOverdue {
+			continue
+		}
+
+		if uint64(postMortem.Swap.AmountRequested) <= rule.Threshold {
+			continue
+		}
+
+		hash := postMortem.Swap.SwapHash
+		m.notify(ctx, rule, &hash, postMortem.String())
+	}
+}
+
+// checkSweeps sends notifications for sweeps that have been unconfirmed for
+// longer than the threshold of our sweep rules. Each rule fires once per sweep,
+// so that operators can escalate to different transports as a sweep remains
+// unconfirmed.
+func (m *Manager) checkSweeps(ctx context.Context) {
+	for hash, watch := range m.sweeps {
+		// If we started watching the sweep before we knew our height,
+		// we start counting from the first block we receive.
+		if watch.publishHeight == 0 {
+			watch.publishHeight = m.height
+			continue
+		}
+
+		pending := uint64(m.height - watch.publishHeight)
+
+		for _, rule := range m.cfg.Rules {
+			if rule.Trigger != TriggerSweepUnconfirmed {
+				continue
+			}
+
+			if pending <= rule.Threshold || watch.notified[rule] {
+				continue
+			}
+
+			hash := hash
+			m.notify(ctx, rule, &hash, fmt.Sprintf("sweep "+
+				"unconfirmed for %v blocks", pending))
+
+			watch.notified[rule] = true
+		}
+	}
+}
+
+// checkBudget sends notifications when autoloop's consumed budget reaches the
+// threshold of our budget rules, and an event to our autoloop transport when
+// the budget is exhausted.
+func (m *Manager) checkBudget(ctx context.Context) {
+	haveRules := m.cfg.AutoloopTransport != nil
+	for _, rule := range m.cfg.Rules {
+		if rule.Trigger == TriggerBudgetConsumed {
+			haveRules = true
+			break
+		}
+	}
+
+	if !haveRules || m.cfg.BudgetStatus == nil {
+		return
+	}
+
+	spent, budget, err := m.cfg.BudgetStatus(ctx)
+	if err != nil {
+		log.Errorf("Could not get budget status: %v", err)
+		return
+	}
+
+	// If there is no budget set, there's nothing to consume.
+	if budget == 0 {
+		return
+	}
+
+	m.checkExhausted(ctx, spent, budget)
+
+	consumed := uint64(spent * 100 / budget)
+
+	for _, rule := range m.cfg.Rules {
+		if rule.Trigger != TriggerBudgetConsumed {
+			continue
+		}
+
+		if consumed < rule.Threshold {
+			m.budgetNotified[rule] = false
+			continue
+		}
+
+		if m.budgetNotified[rule] {
+			continue
+		}
+
+		m.budgetNotified[rule] = true
+		m.notify(ctx, rule, nil, fmt.Sprintf("%v%% of autoloop "+
+			"budget consumed (%v of %v)", consumed, spent, budget))
+	}
+}
+
+// checkExhausted sends an event to our autoloop transport once autoloop has
+// spent its full budget. We send the event again if our spending drops below
+// the budget and reaches it again, which happens in a new budget period.
+func (m *Manager) checkExhausted(ctx context.Context, spent,
+	budget btcutil.Amount) {
+
+	if m.cfg.AutoloopTransport == nil {
+		return
+	}
+
+	if spent < budget {
+		m.budgetExhausted = false
+		return
+	}
+
+	if m.budgetExhausted {
+		return
+	}
+
+	m.budgetExhausted = true
+	m.notifyAutoloop(ctx, TriggerBudgetExhausted, nil, fmt.Sprintf(
+		"autoloop budget exhausted (%v of %v spent)", spent, budget,
+	))
+}
+
+// notify sends a notification to all of the transports of a rule.
+func (m *Manager) notify(ctx context.Context, rule *Rule,
+	hash *lntypes.Hash, msg string) {
+
+	log.Infof("Rule %v fired: %v", rule, msg)
+
+	transports := make([]Transport, len(rule.Transports))
+	for i, name := range rule.Transports {
+		transports[i] = m.cfg.Transports[name]
+	}
+
+	m.send(ctx, rule.Trigger, hash, msg, transports)
+}
+
+// notifyAutoloop sends an autoloop event to our autoloop transport.
+func (m *Manager) notifyAutoloop(ctx context.Context, trigger Trigger,
+	hash *lntypes.Hash, msg string) {
+
+	log.Infof("Autoloop event %v: %v", trigger, msg)
+
+	m.send(ctx, trigger, hash, msg, []Transport{m.cfg.AutoloopTransport})
+}
+
+// send delivers a notification to the set of transports provided. Sending is
+// done in goroutines so that slow transports don't hold up our main loop.
+func (m *Manager) send(ctx context.Context, trigger Trigger,
+	hash *lntypes.Hash, msg string, transports []Transport) {
+
+	notification := &Notification{
+		Trigger:   trigger,
+		SwapHash:  hash,
+		Message:   msg,
+		Timestamp: m.cfg.Clock.Now(),
+	}
+
+	for _, transport := range transports {
+		transport := transport
+
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+			defer cancel()
+
+			err := transport.Send(ctx, notification)
+			if err != nil {
+				log.Errorf("Could not send %v notification: "+
+					"%v", transport.Name(), err)
+			}
+		}()
+	}
+}
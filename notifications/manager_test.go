@@ -0,0 +1,412 @@
+package notifications
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTransport is a transport that delivers notifications on a channel.
+type mockTransport struct {
+	name          string
+	notifications chan *Notification
+}
+
+// Name returns the name of the mock transport.
+func (m *mockTransport) Name() string {
+	return m.name
+}
+
+// Send delivers a notification on the transport's channel.
+func (m *mockTransport) Send(_ context.Context,
+	notification *Notification) error {
+
+	m.notifications <- notification
+	return nil
+}
+
+// managerTestContext contains a notification manager with mocked transports
+// and block notifications.
+type managerTestContext struct {
+	t *testing.T
+
+	manager *Manager
+
+	webhook  *mockTransport
+	email    *mockTransport
+	autoloop *mockTransport
+
+	blocks chan int32
+
+	spent      btcutil.Amount
+	budget     btcutil.Amount
+	budgetLock sync.Mutex
+
+	cancel func()
+	done   chan error
+}
+
+// newManagerTestContext creates and runs a notification manager for the set
+// of rules provided.
+func newManagerTestContext(t *testing.T, rules []string) *managerTestContext {
+	ctx := &managerTestContext{
+		t: t,
+		webhook: &mockTransport{
+			name:          "webhook",
+			notifications: make(chan *Notification),
+		},
+		email: &mockTransport{
+			name:          "email",
+			notifications: make(chan *Notification),
+		},
+		autoloop: &mockTransport{
+			name:          "autoloop",
+			notifications: make(chan *Notification),
+		},
+		blocks: make(chan int32),
+		done:   make(chan error),
+	}
+
+	cfg := &Config{
+		Transports: map[string]Transport{
+			ctx.webhook.name: ctx.webhook,
+			ctx.email.name:   ctx.email,
+		},
+		RegisterBlockEpochNtfn: func(context.Context) (chan int32,
+			chan error, error) {
+
+			return ctx.blocks, make(chan error), nil
+		},
+		BudgetStatus: func(context.Context) (btcutil.Amount,
+			btcutil.Amount, error) {
+
+			ctx.budgetLock.Lock()
+			defer ctx.budgetLock.Unlock()
+
+			return ctx.spent, ctx.budget, nil
+		},
+		AutoloopTransport: ctx.autoloop,
+		Clock:             clock.NewTestClock(time.Unix(123, 0)),
+	}
+
+	for _, rule := range rules {
+		parsed, err := ParseRule(rule)
+		require.NoError(t, err)
+
+		cfg.Rules = append(cfg.Rules, parsed)
+	}
+
+	var err error
+	ctx.manager, err = NewManager(cfg)
+	require.NoError(t, err)
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	ctx.cancel = cancel
+
+	go func() {
+		ctx.done <- ctx.manager.Run(runCtx)
+	}()
+
+	return ctx
+}
+
+// stop shuts down the manager and asserts that no unexpected notifications
+// were sent.
+func (c *managerTestContext) stop() {
+	c.cancel()
+
+	select {
+	case err := <-c.done:
+		require.Equal(c.t, context.Canceled, err)
+
+	case <-time.After(test.Timeout):
+		c.t.Fatal("manager did not exit")
+	}
+}
+
+// setBudget updates the budget status that is returned to the manager.
+func (c *managerTestContext) setBudget(spent, budget btcutil.Amount) {
+	c.budgetLock.Lock()
+	defer c.budgetLock.Unlock()
+
+	c.spent = spent
+	c.budget = budget
+}
+
+// block notifies the manager of a new block.
+func (c *managerTestContext) block(height int32) {
+	select {
+	case c.blocks <- height:
+	case <-time.After(test.Timeout):
+		c.t.Fatal("block not consumed")
+	}
+}
+
+// swapUpdate delivers a swap update to the manager.
+func (c *managerTestContext) swapUpdate(hash lntypes.Hash, swapType swap.Type,
+	amt btcutil.Amount, state loopdb.SwapState) {
+
+	c.labeledSwapUpdate(hash, swapType, amt, state, "")
+}
+
+// labeledSwapUpdate delivers a swap update for a swap with a label to the
+// manager.
+func (c *managerTestContext) labeledSwapUpdate(hash lntypes.Hash,
+	swapType swap.Type, amt btcutil.Amount, state loopdb.SwapState,
+	label string) {
+
+	info := loop.SwapInfo{
+		SwapHash: hash,
+		SwapType: swapType,
+		SwapContract: loopdb.SwapContract{
+			AmountRequested: amt,
+			Label:           label,
+		},
+		SwapStateData: loopdb.SwapStateData{
+			State: state,
+		},
+	}
+
+	require.NoError(c.t, c.manager.SwapUpdate(context.Background(), info))
+}
+
+// assertNotification asserts that a transport received a notification for
+// the trigger provided.
+func (c *managerTestContext) assertNotification(transport *mockTransport,
+	trigger Trigger, hash *lntypes.Hash) {
+
+	select {
+	case notification := <-transport.notifications:
+		require.Equal(c.t, trigger, notification.Trigger)
+		require.Equal(c.t, hash, notification.SwapHash)
+
+	case <-time.After(test.Timeout):
+		c.t.Fatalf("expected %v notification", trigger)
+	}
+}
+
+// assertNoNotification asserts that a transport has not received any
+// notifications. Since notifications are sent asynchronously, this is only
+// reliable after a subsequent update has been consumed by the manager.
+func (c *managerTestContext) assertNoNotification(transport *mockTransport) {
+	select {
+	case notification := <-transport.notifications:
+		c.t.Fatalf("unexpected notification: %v", notification)
+
+	default:
+	}
+}
+
+// TestSwapInitiatedRule tests notifications for large swaps being initiated.
+func TestSwapInitiatedRule(t *testing.T) {
+	c := newManagerTestContext(t, []string{
+		"swapinitiated:1000000:webhook,email",
+	})
+	defer c.stop()
+
+	hash := lntypes.Hash{1}
+
+	// A swap below our threshold should not trigger a notification.
+	c.swapUpdate(lntypes.Hash{2}, swap.TypeIn, 1000000,
+		loopdb.StateInitiated)
+
+	// A swap above our threshold should notify both transports.
+	c.swapUpdate(hash, swap.TypeOut, 1000001, loopdb.StateInitiated)
+	c.assertNotification(c.webhook, TriggerSwapInitiated, &hash)
+	c.assertNotification(c.email, TriggerSwapInitiated, &hash)
+
+	// Further updates for the swap should not notify again.
+	c.swapUpdate(hash, swap.TypeOut, 1000001, loopdb.StateInitiated)
+	c.swapUpdate(lntypes.Hash{3}, swap.TypeOut, 1000001,
+		loopdb.StateSuccess)
+	c.assertNoNotification(c.webhook)
+	c.assertNoNotification(c.email)
+}
+
+// TestSwapOverdueRule tests notifications for swaps that exceed their maximum
+// lifetime.
+func TestSwapOverdueRule(t *testing.T) {
+	c := newManagerTestContext(t, []string{
+		"swapoverdue:100000:webhook",
+	})
+	defer c.stop()
+
+	overdue := func(hash lntypes.Hash, amt btcutil.Amount) {
+		postMortem := &loop.PostMortem{
+			Swap: &loop.SwapInfo{
+				SwapHash: hash,
+				SwapType: swap.TypeOut,
+				SwapContract: loopdb.SwapContract{
+					AmountRequested: amt,
+				},
+				SwapStateData: loopdb.SwapStateData{
+					State: loopdb.StatePreimageRevealed,
+				},
+			},
+			Age:         time.Hour * 25,
+			MaxLifetime: time.Hour * 24,
+		}
+
+		require.NoError(t, c.manager.SwapOverdue(
+			context.Background(), postMortem,
+		))
+	}
+
+	// A swap below our threshold should not trigger a notification.
+	overdue(lntypes.Hash{1}, 100000)
+
+	hash := lntypes.Hash{2}
+	overdue(hash, 100001)
+	c.assertNotification(c.webhook, TriggerSwapOverdue, &hash)
+	c.assertNoNotification(c.webhook)
+}
+
+// TestSweepUnconfirmedRule tests tiered notifications for sweeps that remain
+// unconfirmed.
+func TestSweepUnconfirmedRule(t *testing.T) {
+	c := newManagerTestContext(t, []string{
+		"sweepunconfirmed:2:webhook",
+		"sweepunconfirmed:4:email",
+	})
+	defer c.stop()
+
+	hash := lntypes.Hash{1}
+	confirmed := lntypes.Hash{2}
+
+	c.block(100)
+	c.swapUpdate(hash, swap.TypeOut, 100, loopdb.StatePreimageRevealed)
+	c.swapUpdate(confirmed, swap.TypeOut, 100, loopdb.StatePreimageRevealed)
+
+	// Our second sweep confirms, so we should never notify for it.
+	c.swapUpdate(confirmed, swap.TypeOut, 100, loopdb.StateSuccess)
+
+	// Our first tier fires once the sweep has been pending for more than
+	// two blocks.
+	c.block(102)
+	c.block(103)
+	c.assertNotification(c.webhook, TriggerSweepUnconfirmed, &hash)
+
+	// Our second tier fires once we exceed four blocks, and our first tier
+	// does not fire again.
+	c.block(104)
+	c.block(105)
+	c.assertNotification(c.email, TriggerSweepUnconfirmed, &hash)
+
+	c.block(106)
+	c.assertNoNotification(c.webhook)
+	c.assertNoNotification(c.email)
+}
+
+// TestBudgetConsumedRule tests notifications for autoloop's budget being
+// consumed.
+func TestBudgetConsumedRule(t *testing.T) {
+	c := newManagerTestContext(t, []string{
+		"budgetconsumed:90:webhook",
+	})
+	defer c.stop()
+
+	c.setBudget(899, 1000)
+	c.block(100)
+
+	// Once we reach our threshold, we should notify once.
+	c.setBudget(900, 1000)
+	c.block(101)
+	c.assertNotification(c.webhook, TriggerBudgetConsumed, nil)
+
+	c.block(102)
+	c.assertNoNotification(c.webhook)
+
+	// When our consumption drops, for example due to a new budget period,
+	// we notify again when we reach the threshold.
+	c.setBudget(0, 1000)
+	c.block(103)
+
+	c.setBudget(950, 1000)
+	c.block(104)
+	c.assertNotification(c.webhook, TriggerBudgetConsumed, nil)
+}
+
+// TestAutoloopEvents tests sending of autoloop events to our autoloop
+// transport.
+func TestAutoloopEvents(t *testing.T) {
+	c := newManagerTestContext(t, nil)
+	defer c.stop()
+
+	var (
+		outHash   = lntypes.Hash{1}
+		inHash    = lntypes.Hash{2}
+		otherHash = lntypes.Hash{3}
+
+		outLabel = labels.AutoloopLabel(swap.TypeOut)
+		inLabel  = labels.AutoloopLabel(swap.TypeIn)
+	)
+
+	c.setBudget(0, 1000)
+
+	// Swaps that were not dispatched by autoloop should not produce any
+	// events.
+	c.swapUpdate(otherHash, swap.TypeOut, 1000, loopdb.StateInitiated)
+	c.swapUpdate(otherHash, swap.TypeOut, 1000, loopdb.StateSuccess)
+
+	// When autoloop dispatches a swap we send an event, but not for its
+	// further pending updates.
+	c.labeledSwapUpdate(
+		outHash, swap.TypeOut, 1000, loopdb.StateInitiated, outLabel,
+	)
+	c.assertNotification(c.autoloop, TriggerAutoloopDispatched, &outHash)
+
+	c.labeledSwapUpdate(
+		outHash, swap.TypeOut, 1000, loopdb.StatePreimageRevealed,
+		outLabel,
+	)
+	c.assertNoNotification(c.autoloop)
+
+	c.labeledSwapUpdate(
+		outHash, swap.TypeOut, 1000, loopdb.StateSuccess, outLabel,
+	)
+	c.assertNotification(c.autoloop, TriggerAutoloopSucceeded, &outHash)
+
+	// Final updates for a swap that we did not see while it was pending
+	// should not produce events.
+	c.labeledSwapUpdate(
+		otherHash, swap.TypeIn, 1000, loopdb.StateFailTimeout, inLabel,
+	)
+	c.assertNoNotification(c.autoloop)
+
+	c.labeledSwapUpdate(
+		inHash, swap.TypeIn, 1000, loopdb.StateInitiated, inLabel,
+	)
+	c.assertNotification(c.autoloop, TriggerAutoloopDispatched, &inHash)
+
+	c.labeledSwapUpdate(
+		inHash, swap.TypeIn, 1000, loopdb.StateFailTimeout, inLabel,
+	)
+	c.assertNotification(c.autoloop, TriggerAutoloopFailed, &inHash)
+
+	// Once our budget is exhausted we send an event once, and send it
+	// again when it is exhausted in a new budget period.
+	c.setBudget(1000, 1000)
+	c.block(100)
+	c.assertNotification(c.autoloop, TriggerBudgetExhausted, nil)
+
+	c.block(101)
+	c.assertNoNotification(c.autoloop)
+
+	c.setBudget(0, 1000)
+	c.block(102)
+
+	c.setBudget(1000, 1000)
+	c.block(103)
+	c.assertNotification(c.autoloop, TriggerBudgetExhausted, nil)
+}
@@ -0,0 +1,195 @@
+package notifications
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Trigger describes the event that a notification rule fires on.
+type Trigger uint8
+
+const (
+	// TriggerSwapInitiated fires when a swap with an amount above the
+	// rule's threshold (in satoshis) is initiated.
+	TriggerSwapInitiated Trigger = iota
+
+	// TriggerSweepUnconfirmed fires when a loop out sweep has been
+	// published but has not confirmed for more than the rule's threshold
+	// (in blocks).
+	TriggerSweepUnconfirmed
+
+	// TriggerBudgetConsumed fires when the fees spent by autoloop reach
+	// the rule's threshold (in percent) of the autoloop fee budget.
+	TriggerBudgetConsumed
+
+	// TriggerAutoloopDispatched fires when autoloop dispatches a swap.
+	// Autoloop triggers are not configured with rules, their events are
+	// sent to the manager's autoloop transport.
+	TriggerAutoloopDispatched
+
+	// TriggerAutoloopSucceeded fires when a swap dispatched by autoloop
+	// completes successfully.
+	TriggerAutoloopSucceeded
+
+	// TriggerAutoloopFailed fires when a swap dispatched by autoloop
+	// fails.
+	TriggerAutoloopFailed
+
+	// TriggerBudgetExhausted fires when the fees spent by autoloop reach
+	// its fee budget, so no further swaps are dispatched until the budget
+	// is refreshed.
+	TriggerBudgetExhausted
+
+	// TriggerSwapOverdue fires when a swap with an amount above the rule's
+	// threshold (in satoshis) is still pending after the maximum lifetime
+	// for its type.
+	TriggerSwapOverdue
+)
+
+// String returns the string representation of a trigger, which is also the
+// name that is used to configure rules for it.
+func (t Trigger) String() string {
+	switch t {
+	case TriggerSwapInitiated:
+		return "swapinitiated"
+
+	case TriggerSweepUnconfirmed:
+		return "sweepunconfirmed"
+
+	case TriggerBudgetConsumed:
+		return "budgetconsumed"
+
+	case TriggerAutoloopDispatched:
+		return "autoloopdispatched"
+
+	case TriggerAutoloopSucceeded:
+		return "autoloopsucceeded"
+
+	case TriggerAutoloopFailed:
+		return "autoloopfailed"
+
+	case TriggerBudgetExhausted:
+		return "budgetexhausted"
+
+	case TriggerSwapOverdue:
+		return "swapoverdue"
+
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	// ErrInvalidRuleFormat is returned when a rule string is not in the
+	// format trigger:threshold:transport[,transport].
+	ErrInvalidRuleFormat = errors.New("rule must be formatted as " +
+		"trigger:threshold:transport[,transport]")
+
+	// ErrNoTransports is returned when a rule does not route to any
+	// transport.
+	ErrNoTransports = errors.New("rule requires at least one transport")
+
+	// ErrZeroThreshold is returned when a rule has a zero threshold.
+	ErrZeroThreshold = errors.New("rule threshold must be non-zero")
+
+	// ErrInvalidPercent is returned when a budget rule has a threshold
+	// above 100 percent.
+	ErrInvalidPercent = errors.New("budget threshold must be a " +
+		"percentage in [1, 100]")
+)
+
+// Rule describes a condition that we notify external systems about, and the
+// transports that notifications for the condition should be routed to.
+type Rule struct {
+	// Trigger is the event that this rule fires on.
+	Trigger Trigger
+
+	// Threshold is the value at which the rule fires. The unit depends on
+	// the rule's trigger.
+	Threshold uint64
+
+	// Transports is the set of transport names that notifications for
+	// this rule are sent to.
+	Transports []string
+}
+
+// String returns the string representation of a rule.
+func (r *Rule) String() string {
+	return fmt.Sprintf("%v:%v:%v", r.Trigger, r.Threshold,
+		strings.Join(r.Transports, ","))
+}
+
+// validate checks that a rule is sane, and that all of the transports it
+// routes to are known.
+func (r *Rule) validate(transports map[string]Transport) error {
+	if r.Threshold == 0 {
+		return ErrZeroThreshold
+	}
+
+	if r.Trigger == TriggerBudgetConsumed && r.Threshold > 100 {
+		return ErrInvalidPercent
+	}
+
+	if len(r.Transports) == 0 {
+		return ErrNoTransports
+	}
+
+	for _, name := range r.Transports {
+		if _, ok := transports[name]; !ok {
+			return fmt.Errorf("rule %v: unknown transport %v", r,
+				name)
+		}
+	}
+
+	return nil
+}
+
+// ParseRule parses a rule from a string formatted as
+// trigger:threshold:transport[,transport], for example
+// swapinitiated:1000000:webhook,email.
+func ParseRule(rule string) (*Rule, error) {
+	parts := strings.Split(rule, ":")
+	if len(parts) != 3 {
+		return nil, ErrInvalidRuleFormat
+	}
+
+	var trigger Trigger
+	switch parts[0] {
+	case TriggerSwapInitiated.String():
+		trigger = TriggerSwapInitiated
+
+	case TriggerSweepUnconfirmed.String():
+		trigger = TriggerSweepUnconfirmed
+
+	case TriggerBudgetConsumed.String():
+		trigger = TriggerBudgetConsumed
+
+	case TriggerSwapOverdue.String():
+		trigger = TriggerSwapOverdue
+
+	default:
+		return nil, fmt.Errorf("unknown trigger: %v", parts[0])
+	}
+
+	threshold, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid threshold %v: %v", parts[1],
+			err)
+	}
+
+	var transports []string
+	for _, name := range strings.Split(parts[2], ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			transports = append(transports, name)
+		}
+	}
+
+	return &Rule{
+		Trigger:    trigger,
+		Threshold:  threshold,
+		Transports: transports,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseRule tests parsing and validation of notification rules.
+func TestParseRule(t *testing.T) {
+	transports := map[string]Transport{
+		"webhook": NewWebhookTransport("http://localhost"),
+		"email":   &EmailTransport{},
+	}
+
+	tests := []struct {
+		name     string
+		rule     string
+		expected *Rule
+		parseErr bool
+		err      error
+	}{
+		{
+			name: "swap initiated",
+			rule: "swapinitiated:1000000:webhook",
+			expected: &Rule{
+				Trigger:    TriggerSwapInitiated,
+				Threshold:  1000000,
+				Transports: []string{"webhook"},
+			},
+		},
+		{
+			name: "multiple transports",
+			rule: "sweepunconfirmed:6:webhook, email",
+			expected: &Rule{
+				Trigger:    TriggerSweepUnconfirmed,
+				Threshold:  6,
+				Transports: []string{"webhook", "email"},
+			},
+		},
+		{
+			name: "swap overdue",
+			rule: "swapoverdue:1:email",
+			expected: &Rule{
+				Trigger:    TriggerSwapOverdue,
+				Threshold:  1,
+				Transports: []string{"email"},
+			},
+		},
+		{
+			name:     "too few parts",
+			rule:     "budgetconsumed:90",
+			parseErr: true,
+		},
+		{
+			name:     "unknown trigger",
+			rule:     "swapfailed:1:webhook",
+			parseErr: true,
+		},
+		{
+			name:     "invalid threshold",
+			rule:     "budgetconsumed:ninety:webhook",
+			parseErr: true,
+		},
+		{
+			name: "zero threshold",
+			rule: "swapinitiated:0:webhook",
+			expected: &Rule{
+				Trigger:    TriggerSwapInitiated,
+				Transports: []string{"webhook"},
+			},
+			err: ErrZeroThreshold,
+		},
+		{
+			name: "invalid percent",
+			rule: "budgetconsumed:101:email",
+			expected: &Rule{
+				Trigger:    TriggerBudgetConsumed,
+				Threshold:  101,
+				Transports: []string{"email"},
+			},
+			err: ErrInvalidPercent,
+		},
+		{
+			name: "no transports",
+			rule: "budgetconsumed:90:",
+			expected: &Rule{
+				Trigger:   TriggerBudgetConsumed,
+				Threshold: 90,
+			},
+			err: ErrNoTransports,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			rule, err := ParseRule(testCase.rule)
+			if testCase.parseErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, rule)
+
+			err = rule.validate(transports)
+			require.Equal(t, testCase.err, err)
+		})
+	}
+
+	// Finally, check that we fail on rules that route to transports that
+	// are not configured.
+	rule, err := ParseRule("swapinitiated:1:nostr")
+	require.NoError(t, err)
+	require.Error(t, rule.validate(transports))
+}
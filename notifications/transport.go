@@ -0,0 +1,158 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// Notification is a message that is delivered to external systems when one
+// of our rules fires.
+type Notification struct {
+	// Trigger is the trigger of the rule that fired.
+	Trigger Trigger
+
+	// SwapHash is the hash of the swap that the notification relates to,
+	// if any.
+	SwapHash *lntypes.Hash
+
+	// Message is a human-readable description of the event.
+	Message string
+
+	// Timestamp is the time at which the rule fired.
+	Timestamp time.Time
+}
+
+// Transport is an interface implemented by the systems that notifications
+// can be delivered through, for example e-mail, webhooks or nostr direct
+// messages.
+type Transport interface {
+	// Name returns the name that rules use to route to the transport.
+	Name() string
+
+	// Send delivers a notification.
+	Send(ctx context.Context, notification *Notification) error
+}
+
+// WebhookTransport delivers notifications by posting them as JSON to a url.
+type WebhookTransport struct {
+	// URL is the endpoint that notifications are posted to.
+	URL string
+
+	// Client is the http client used to post notifications.
+	Client *http.Client
+}
+
+// NewWebhookTransport returns a transport that posts notifications to the url
+// provided.
+func NewWebhookTransport(url string) *WebhookTransport {
+	return &WebhookTransport{
+		URL:    url,
+		Client: http.DefaultClient,
+	}
+}
+
+// Name returns the name of the webhook transport.
+//
+// NOTE: Part of the Transport interface.
+func (w *WebhookTransport) Name() string {
+	return "webhook"
+}
+
+// webhookPayload is the json body that is posted to webhooks.
+type webhookPayload struct {
+	Trigger   string `json:"trigger"`
+	SwapHash  string `json:"swap_hash,omitempty"`
+	Message   string `json:"message"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// Send posts a notification to our webhook url.
+//
+// NOTE: Part of the Transport interface.
+func (w *WebhookTransport) Send(ctx context.Context,
+	notification *Notification) error {
+
+	payload := webhookPayload{
+		Trigger:   notification.Trigger.String(),
+		Message:   notification.Message,
+		Timestamp: notification.Timestamp.Unix(),
+	}
+
+	if notification.SwapHash != nil {
+		payload.SwapHash = notification.SwapHash.String()
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, w.URL, bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status: %v", resp.Status)
+	}
+
+	return nil
+}
+
+// EmailTransport delivers notifications as e-mails through an smtp server.
+type EmailTransport struct {
+	// Host is the host:port of the smtp server.
+	Host string
+
+	// Auth is an optional authentication mechanism for the smtp server.
+	Auth smtp.Auth
+
+	// From is the sender address for our e-mails.
+	From string
+
+	// To is the list of recipient addresses.
+	To []string
+}
+
+// Name returns the name of the e-mail transport.
+//
+// NOTE: Part of the Transport interface.
+func (e *EmailTransport) Name() string {
+	return "email"
+}
+
+// Send e-mails a notification to our set of recipients. Note that the smtp
+// client does not support contexts, so the context is only checked before the
+// e-mail is sent.
+//
+// NOTE: Part of the Transport interface.
+func (e *EmailTransport) Send(ctx context.Context,
+	notification *Notification) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	msg := fmt.Sprintf("From: %v\r\nTo: %v\r\nSubject: loop: %v\r\n"+
+		"\r\n%v\r\n", e.From, strings.Join(e.To, ", "),
+		notification.Trigger, notification.Message)
+
+	return smtp.SendMail(e.Host, e.Auth, e.From, e.To, []byte(msg))
+}
@@ -0,0 +1,280 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// rebalanceMemo is the memo that we set on the invoices that we pay
+	// to ourselves for circular rebalances.
+	rebalanceMemo = "loop rebalance"
+
+	// rebalanceInvoiceExpiry is the expiry of our rebalance invoices in
+	// seconds.
+	rebalanceInvoiceExpiry = 600
+
+	// rebalancePaymentTimeout is the amount of time that we allow lnd to
+	// find a route for a rebalance payment.
+	rebalancePaymentTimeout = time.Minute
+)
+
+var (
+	// ErrRebalanceSameChannel is returned when a rebalance is requested
+	// from a channel to itself.
+	ErrRebalanceSameChannel = errors.New("rebalance outgoing and " +
+		"incoming channel must differ")
+)
+
+// RebalanceRequest contains the parameters of a circular rebalance, which
+// pays from one of our channels back to ourselves through another one of our
+// channels.
+type RebalanceRequest struct {
+	// Amount is the amount to shift between our channels.
+	Amount btcutil.Amount
+
+	// MaxFee is the maximum routing fee that we pay for the rebalance.
+	MaxFee btcutil.Amount
+
+	// OutgoingChannel is the short channel ID of the channel that the
+	// payment must leave over.
+	OutgoingChannel uint64
+
+	// IncomingChannel is the short channel ID of the channel that the
+	// payment should arrive over.
+	IncomingChannel uint64
+
+	// LastHop is the peer of the incoming channel, which the payment
+	// must arrive from.
+	LastHop route.Vertex
+
+	// Autoloop indicates that the rebalance is dispatched by autoloop, so
+	// that it counts towards autoloop's rebalance budget.
+	Autoloop bool
+}
+
+// RebalanceInfo contains the outcome of a circular rebalance.
+type RebalanceInfo struct {
+	// Hash is the payment hash of the rebalance.
+	Hash lntypes.Hash
+
+	// State is the final state of the rebalance.
+	State loopdb.RebalanceState
+
+	// Fee is the routing fee that the rebalance paid.
+	Fee btcutil.Amount
+
+	// FailureReason is set if the rebalance payment failed.
+	FailureReason lnrpc.PaymentFailureReason
+}
+
+// Rebalance performs a circular rebalance by paying an invoice to ourselves
+// over the outgoing channel, with the incoming channel's peer as the last hop.
+// The rebalance is persisted before its payment is dispatched, and the call
+// blocks until the payment reaches a final state.
+func (s *Client) Rebalance(ctx context.Context, req *RebalanceRequest) (
+	*RebalanceInfo, error) {
+
+	if req.Amount <= 0 {
+		return nil, errors.New("rebalance amount must be > 0")
+	}
+
+	if req.OutgoingChannel == req.IncomingChannel {
+		return nil, ErrRebalanceSameChannel
+	}
+
+	hash, invoice, err := s.lndServices.Client.AddInvoice(
+		ctx, &invoicesrpc.AddInvoiceData{
+			Memo:   rebalanceMemo,
+			Value:  lnwire.NewMSatFromSatoshis(req.Amount),
+			Expiry: rebalanceInvoiceExpiry,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Store.CreateRebalance(hash, &loopdb.RebalanceContract{
+		InitiationTime:  time.Now(),
+		Amount:          req.Amount,
+		MaxFee:          req.MaxFee,
+		OutgoingChannel: req.OutgoingChannel,
+		IncomingChannel: req.IncomingChannel,
+		Autoloop:        req.Autoloop,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Rebalance %v: paying %v from channel %v to channel %v, "+
+		"max fee %v", hash, req.Amount, req.OutgoingChannel,
+		req.IncomingChannel, req.MaxFee)
+
+	lastHop := req.LastHop
+	payStatusChan, payErrChan, err := s.lndServices.Router.SendPayment(
+		ctx, lndclient.SendPaymentRequest{
+			Invoice:          invoice,
+			MaxFee:           req.MaxFee,
+			OutgoingChanIds:  []uint64{req.OutgoingChannel},
+			LastHopPubkey:    &lastHop,
+			AllowSelfPayment: true,
+			Timeout:          rebalancePaymentTimeout,
+		},
+	)
+	if err != nil {
+		return nil, s.failRebalance(hash, err)
+	}
+
+	payStatus, err := s.awaitRebalance(
+		ctx, hash, payStatusChan, payErrChan,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.completeRebalance(hash, payStatus)
+}
+
+// awaitRebalance waits for a rebalance payment to reach a final state.
+func (s *Client) awaitRebalance(ctx context.Context, hash lntypes.Hash,
+	payStatusChan chan lndclient.PaymentStatus, payErrChan chan error) (
+	*lndclient.PaymentStatus, error) {
+
+	for {
+		select {
+		case payState := <-payStatusChan:
+			log.Debugf("Rebalance %v: %v", hash, payState)
+
+			switch payState.State {
+			case lnrpc.Payment_SUCCEEDED, lnrpc.Payment_FAILED:
+				return &payState, nil
+
+			case lnrpc.Payment_IN_FLIGHT:
+				// Continue waiting for final state.
+
+			default:
+				return nil, errors.New("unknown payment state")
+			}
+
+		// If our payment was already dispatched, we track it instead.
+		case err := <-payErrChan:
+			if err != channeldb.ErrAlreadyPaid {
+				return nil, err
+			}
+
+			payStatusChan, payErrChan, err =
+				s.lndServices.Router.TrackPayment(ctx, hash)
+			if err != nil {
+				return nil, err
+			}
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// completeRebalance records the final state of a rebalance payment.
+func (s *Client) completeRebalance(hash lntypes.Hash,
+	payStatus *lndclient.PaymentStatus) (*RebalanceInfo, error) {
+
+	info := &RebalanceInfo{
+		Hash:  hash,
+		State: loopdb.RebalanceStateFailed,
+	}
+
+	if payStatus.State == lnrpc.Payment_SUCCEEDED {
+		info.State = loopdb.RebalanceStateSuccess
+		info.Fee = payStatus.Fee.ToSatoshis()
+
+		log.Infof("Rebalance %v succeeded, fee: %v", hash, info.Fee)
+	} else {
+		info.FailureReason = payStatus.FailureReason
+
+		log.Infof("Rebalance %v failed: %v", hash, info.FailureReason)
+	}
+
+	err := s.Store.UpdateRebalance(hash, time.Now(), info.State, info.Fee)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// failRebalance marks a rebalance whose payment could not be dispatched as
+// failed and returns the error that it failed with.
+func (s *Client) failRebalance(hash lntypes.Hash, err error) error {
+	updateErr := s.Store.UpdateRebalance(
+		hash, time.Now(), loopdb.RebalanceStateFailed, 0,
+	)
+	if updateErr != nil {
+		log.Errorf("Could not fail rebalance %v: %v", hash, updateErr)
+	}
+
+	return err
+}
+
+// resumeRebalances tracks the payments of rebalances that were still pending
+// when we last shut down, so that their final state is recorded.
+func (s *Client) resumeRebalances(ctx context.Context,
+	rebalances []*loopdb.Rebalance) {
+
+	for _, rebalance := range rebalances {
+		if !rebalance.State.IsPending() {
+			continue
+		}
+
+		hash := rebalance.Hash
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+
+			err := s.resumeRebalance(ctx, hash)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				log.Errorf("Resuming rebalance %v: %v", hash,
+					err)
+			}
+		}()
+	}
+}
+
+// resumeRebalance tracks the payment of a pending rebalance until it reaches a
+// final state. If lnd does not know the payment, it was never dispatched and
+// the rebalance is failed.
+func (s *Client) resumeRebalance(ctx context.Context,
+	hash lntypes.Hash) error {
+
+	payStatusChan, payErrChan, err := s.lndServices.Router.TrackPayment(
+		ctx, hash,
+	)
+	if err != nil {
+		return err
+	}
+
+	payStatus, err := s.awaitRebalance(ctx, hash, payStatusChan, payErrChan)
+	if status.Code(err) == codes.NotFound {
+		log.Infof("Rebalance %v was not dispatched", hash)
+		return s.failRebalance(hash, nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = s.completeRebalance(hash, payStatus)
+	return err
+}
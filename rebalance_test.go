@@ -0,0 +1,88 @@
+package loop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRebalance tests dispatching circular rebalances and recording their
+// outcome.
+func TestRebalance(t *testing.T) {
+	defer test.Guard(t)()
+
+	ctx := createClientTestContext(t, nil)
+
+	req := &RebalanceRequest{
+		Amount:          10000,
+		MaxFee:          10,
+		OutgoingChannel: 1,
+		IncomingChannel: 2,
+		LastHop:         route.Vertex{2},
+	}
+
+	// rebalance dispatches our rebalance, completes its payment with the
+	// status provided and returns the outcome.
+	rebalance := func(payStatus lnrpc.Payment_PaymentStatus) *RebalanceInfo {
+		type result struct {
+			info *RebalanceInfo
+			err  error
+		}
+
+		resultChan := make(chan result, 1)
+		go func() {
+			info, err := ctx.swapClient.Rebalance(
+				context.Background(), req,
+			)
+			resultChan <- result{info, err}
+		}()
+
+		payment := <-ctx.Lnd.RouterSendPaymentChannel
+		require.Equal(t, []uint64{1}, payment.OutgoingChanIds)
+		require.Equal(t, route.Vertex{2}, *payment.LastHopPubkey)
+		require.True(t, payment.AllowSelfPayment)
+		require.Equal(t, req.MaxFee, payment.MaxFee)
+
+		payment.Updates <- lndclient.PaymentStatus{
+			State: payStatus,
+			Fee:   lnwire.NewMSatFromSatoshis(5),
+		}
+
+		res := <-resultChan
+		require.NoError(t, res.err)
+
+		return res.info
+	}
+
+	info := rebalance(lnrpc.Payment_SUCCEEDED)
+	require.Equal(t, loopdb.RebalanceStateSuccess, info.State)
+	require.EqualValues(t, 5, info.Fee)
+
+	stored := ctx.store.rebalances[info.Hash]
+	require.Equal(t, loopdb.RebalanceStateSuccess, stored.State)
+	require.EqualValues(t, 5, stored.Fee)
+	require.Equal(t, req.Amount, stored.Amount)
+
+	info = rebalance(lnrpc.Payment_FAILED)
+	require.Equal(t, loopdb.RebalanceStateFailed, info.State)
+	require.Zero(t, info.Fee)
+
+	stored = ctx.store.rebalances[info.Hash]
+	require.Equal(t, loopdb.RebalanceStateFailed, stored.State)
+	require.Zero(t, stored.Fee)
+
+	// Rebalancing into the outgoing channel is not allowed.
+	sameChannel := *req
+	sameChannel.IncomingChannel = sameChannel.OutgoingChannel
+	_, err := ctx.swapClient.Rebalance(context.Background(), &sameChannel)
+	require.Equal(t, ErrRebalanceSameChannel, err)
+
+	ctx.finish()
+}
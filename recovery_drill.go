@@ -0,0 +1,496 @@
+package loop
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// DefaultRecoveryDrillAmount is the amount that we lock in each of the
+	// htlcs of a recovery drill if no amount is provided.
+	DefaultRecoveryDrillAmount = btcutil.Amount(20000)
+
+	// recoveryDrillSuccessDelta is the number of blocks that the htlc that
+	// we sweep with the success path of a recovery drill expires in. This
+	// htlc is never timed out, so the value is arbitrary.
+	recoveryDrillSuccessDelta = 144
+)
+
+var (
+	// ErrRecoveryDrillNetwork is returned when a recovery drill is
+	// requested on a network where it would lock up real funds.
+	ErrRecoveryDrillNetwork = errors.New("recovery drills can only be " +
+		"run on regtest or signet")
+)
+
+// The names of the checks that a recovery drill performs.
+const (
+	recoveryCheckKeys         = "key derivation"
+	recoveryCheckAddress      = "sweep address"
+	recoveryCheckHeight       = "block height"
+	recoveryCheckHtlc         = "htlc scripts"
+	recoveryCheckFee          = "sweep fee estimation"
+	recoveryCheckFunding      = "htlc funding"
+	recoveryCheckTimeout      = "timeout sweep"
+	recoveryCheckSuccess      = "uncooperative success sweep"
+	recoveryCheckConfirmation = "htlc confirmation"
+	recoveryCheckTimeoutPub   = "timeout sweep broadcast"
+	recoveryCheckSuccessPub   = "success sweep broadcast"
+)
+
+// RecoveryDrillRequest contains the parameters of a recovery drill.
+type RecoveryDrillRequest struct {
+	// Amount is the amount to lock in each of the drill's htlcs. If it is
+	// zero, DefaultRecoveryDrillAmount is used.
+	Amount btcutil.Amount
+
+	// Publish indicates that the drill's htlcs should be funded on chain
+	// and their sweeps broadcast. If it is false, the sweeps are only
+	// signed and verified against a synthetic funding transaction.
+	Publish bool
+}
+
+// RecoveryCheck is the outcome of a single step of a recovery drill.
+type RecoveryCheck struct {
+	// Name describes the step of the drill.
+	Name string
+
+	// Err is set if the step failed.
+	Err error
+}
+
+// RecoveryDrillResult contains the outcome of a recovery drill.
+type RecoveryDrillResult struct {
+	// Checks contains the outcome of each step that the drill ran, in the
+	// order that they were run. The drill stops at the first step that
+	// fails.
+	Checks []*RecoveryCheck
+
+	// FundingTx is the transaction that funded the drill's htlcs. It is
+	// only set for drills that publish.
+	FundingTx *chainhash.Hash
+
+	// TimeoutTx is the transaction that swept the drill's timeout htlc.
+	TimeoutTx *chainhash.Hash
+
+	// SuccessTx is the transaction that swept the drill's success htlc.
+	SuccessTx *chainhash.Hash
+}
+
+// Passed returns true if all of the drill's checks passed.
+func (r *RecoveryDrillResult) Passed() bool {
+	for _, check := range r.Checks {
+		if check.Err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// check records the outcome of a step of the drill, returning true if the
+// step passed.
+func (r *RecoveryDrillResult) check(name string, err error) bool {
+	r.Checks = append(r.Checks, &RecoveryCheck{
+		Name: name,
+		Err:  err,
+	})
+
+	if err != nil {
+		log.Warnf("Recovery drill %v failed: %v", name, err)
+		return false
+	}
+
+	log.Infof("Recovery drill %v passed", name)
+
+	return true
+}
+
+// RecoveryDrill exercises the paths that we rely on to recover our funds
+// when a swap does not complete cooperatively, using a synthetic swap with
+// a counterparty key that we generate ourselves. It derives keys and a sweep
+// address from lnd, and signs a loop in timeout sweep and a loop out success
+// sweep, verifying both against their htlc scripts. If publish is set, the
+// htlcs are funded from our wallet and both sweeps are broadcast, which
+// returns the funds to our wallet less fees.
+//
+// A drill that fails to run at all returns an error. A drill that runs but
+// fails a check reports it in the result.
+func (s *Client) RecoveryDrill(ctx context.Context,
+	req *RecoveryDrillRequest) (*RecoveryDrillResult, error) {
+
+	err := checkRecoveryDrillNetwork(s.lndServices.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := req.Amount
+	if amount == 0 {
+		amount = DefaultRecoveryDrillAmount
+	}
+
+	log.Infof("Starting recovery drill with amount %v, publish: %v",
+		amount, req.Publish)
+
+	var (
+		lnd    = s.lndServices
+		result = &RecoveryDrillResult{}
+	)
+
+	// We derive a sender key for the htlc that we time out, as we would
+	// for a loop in, and a receiver key for the htlc that we sweep with
+	// our preimage, as we would for a loop out.
+	senderKey, receiverKey, err := s.deriveRecoveryDrillKeys(ctx)
+	if !result.check(recoveryCheckKeys, err) {
+		return result, nil
+	}
+
+	sweepAddr, err := lnd.WalletKit.NextAddr(ctx)
+	if !result.check(recoveryCheckAddress, err) {
+		return result, nil
+	}
+
+	info, err := lnd.Client.GetInfo(ctx)
+	if !result.check(recoveryCheckHeight, err) {
+		return result, nil
+	}
+	height := int32(info.BlockHeight)
+
+	// Our timeout htlc expires at the current height, so that we can
+	// sweep it right away.
+	timeoutHtlc, successHtlc, preimage, err := newRecoveryDrillHtlcs(
+		height, senderKey, receiverKey, lnd.ChainParams,
+	)
+	if !result.check(recoveryCheckHtlc, err) {
+		return result, nil
+	}
+
+	timeoutFee, successFee, err := s.recoveryDrillFees(
+		ctx, timeoutHtlc, successHtlc, sweepAddr, amount,
+	)
+	if !result.check(recoveryCheckFee, err) {
+		return result, nil
+	}
+
+	// If we are not publishing, we sign our sweeps against a funding
+	// transaction that never makes it onto the chain, which is enough to
+	// validate them against their scripts.
+	var fundingTx *wire.MsgTx
+	if req.Publish {
+		fundingTx, err = s.fundRecoveryDrill(
+			ctx, timeoutHtlc, successHtlc, amount,
+			preimage.Hash(),
+		)
+		if !result.check(recoveryCheckFunding, err) {
+			return result, nil
+		}
+
+		fundingHash := fundingTx.TxHash()
+		result.FundingTx = &fundingHash
+	} else {
+		fundingTx = wire.NewMsgTx(2)
+		fundingTx.AddTxOut(&wire.TxOut{
+			PkScript: timeoutHtlc.PkScript,
+			Value:    int64(amount),
+		})
+		fundingTx.AddTxOut(&wire.TxOut{
+			PkScript: successHtlc.PkScript,
+			Value:    int64(amount),
+		})
+	}
+
+	timeoutTx, err := s.recoveryDrillSweep(
+		ctx, fundingTx, height, 0, timeoutHtlc, senderKey,
+		func(sig []byte) (wire.TxWitness, error) {
+			return timeoutHtlc.GenTimeoutWitness(sig), nil
+		}, amount, timeoutFee, sweepAddr,
+	)
+	if !result.check(recoveryCheckTimeout, err) {
+		return result, nil
+	}
+
+	successTx, err := s.recoveryDrillSweep(
+		ctx, fundingTx, height, successHtlc.SuccessSequence(),
+		successHtlc, receiverKey,
+		func(sig []byte) (wire.TxWitness, error) {
+			return successHtlc.GenSuccessWitness(sig, preimage)
+		}, amount, successFee, sweepAddr,
+	)
+	if !result.check(recoveryCheckSuccess, err) {
+		return result, nil
+	}
+
+	if !req.Publish {
+		return result, nil
+	}
+
+	// Our success path has a relative timelock, so we have to wait for
+	// our htlcs to confirm before we can broadcast our sweeps.
+	err = s.awaitRecoveryDrillFunding(ctx, fundingTx, timeoutHtlc, height)
+	if !result.check(recoveryCheckConfirmation, err) {
+		return result, nil
+	}
+
+	hash := preimage.Hash()
+
+	err = lnd.WalletKit.PublishTransaction(
+		ctx, timeoutTx, labels.RecoveryDrillSweepTimeout(hash),
+	)
+	if !result.check(recoveryCheckTimeoutPub, err) {
+		return result, nil
+	}
+	timeoutHash := timeoutTx.TxHash()
+	result.TimeoutTx = &timeoutHash
+
+	err = lnd.WalletKit.PublishTransaction(
+		ctx, successTx, labels.RecoveryDrillSweepSuccess(hash),
+	)
+	if !result.check(recoveryCheckSuccessPub, err) {
+		return result, nil
+	}
+	successHash := successTx.TxHash()
+	result.SuccessTx = &successHash
+
+	return result, nil
+}
+
+// checkRecoveryDrillNetwork returns an error if recovery drills should not be
+// run on the network provided.
+func checkRecoveryDrillNetwork(params *chaincfg.Params) error {
+	switch params.Name {
+	case chaincfg.RegressionNetParams.Name, chaincfg.SigNetParams.Name:
+		return nil
+
+	default:
+		return fmt.Errorf("%w, connected to %v",
+			ErrRecoveryDrillNetwork, params.Name)
+	}
+}
+
+// deriveRecoveryDrillKeys derives the sender and receiver keys that we use
+// for a recovery drill from lnd's swap key family.
+func (s *Client) deriveRecoveryDrillKeys(ctx context.Context) ([33]byte,
+	[33]byte, error) {
+
+	var senderKey, receiverKey [33]byte
+
+	keyDesc, err := s.lndServices.WalletKit.DeriveNextKey(
+		ctx, swap.KeyFamily,
+	)
+	if err != nil {
+		return senderKey, receiverKey, err
+	}
+	copy(senderKey[:], keyDesc.PubKey.SerializeCompressed())
+
+	keyDesc, err = s.lndServices.WalletKit.DeriveNextKey(
+		ctx, swap.KeyFamily,
+	)
+	if err != nil {
+		return senderKey, receiverKey, err
+	}
+	copy(receiverKey[:], keyDesc.PubKey.SerializeCompressed())
+
+	return senderKey, receiverKey, nil
+}
+
+// newRecoveryDrillHtlcs creates the two htlcs of a recovery drill with a
+// randomly generated preimage and counterparty key. The first htlc has our
+// sender key and expires at the height provided, and the second has our
+// receiver key.
+func newRecoveryDrillHtlcs(height int32, senderKey, receiverKey [33]byte,
+	chainParams *chaincfg.Params) (*swap.Htlc, *swap.Htlc, lntypes.Preimage,
+	error) {
+
+	var preimage lntypes.Preimage
+	if _, err := rand.Read(preimage[:]); err != nil {
+		return nil, nil, preimage, err
+	}
+	hash := lntypes.Hash(sha256.Sum256(preimage[:]))
+
+	// We generate a key for our synthetic counterparty. It is never used
+	// to sign, because we only exercise the paths that we sign for.
+	counterparty, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, nil, preimage, err
+	}
+
+	var counterpartyKey [33]byte
+	copy(counterpartyKey[:], counterparty.PubKey().SerializeCompressed())
+
+	scriptVersion := GetHtlcScriptVersion(
+		loopdb.CurrentInternalProtocolVersion,
+	)
+
+	timeoutHtlc, err := swap.NewHtlc(
+		scriptVersion, height, senderKey, counterpartyKey, hash,
+		swap.HtlcP2WSH, chainParams,
+	)
+	if err != nil {
+		return nil, nil, preimage, err
+	}
+
+	successHtlc, err := swap.NewHtlc(
+		scriptVersion, height+recoveryDrillSuccessDelta,
+		counterpartyKey, receiverKey, hash, swap.HtlcP2WSH,
+		chainParams,
+	)
+	if err != nil {
+		return nil, nil, preimage, err
+	}
+
+	return timeoutHtlc, successHtlc, preimage, nil
+}
+
+// recoveryDrillFees estimates the fees for the sweeps of a recovery drill,
+// failing if they would consume the amount in our htlcs.
+func (s *Client) recoveryDrillFees(ctx context.Context, timeoutHtlc,
+	successHtlc *swap.Htlc, sweepAddr btcutil.Address,
+	amount btcutil.Amount) (btcutil.Amount, btcutil.Amount, error) {
+
+	timeoutFee, err := s.sweeper.GetSweepFee(
+		ctx, timeoutHtlc.AddTimeoutToEstimator, sweepAddr,
+		TimeoutTxConfTarget,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	successFee, err := s.sweeper.GetSweepFee(
+		ctx, successHtlc.AddSuccessToEstimator, sweepAddr,
+		DefaultSweepConfTarget,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if timeoutFee >= amount || successFee >= amount {
+		return 0, 0, fmt.Errorf("sweep fees of %v and %v exceed drill "+
+			"amount %v", timeoutFee, successFee, amount)
+	}
+
+	return timeoutFee, successFee, nil
+}
+
+// fundRecoveryDrill sends the amount provided to each of our drill's htlcs
+// in a single transaction from our wallet.
+func (s *Client) fundRecoveryDrill(ctx context.Context, timeoutHtlc,
+	successHtlc *swap.Htlc, amount btcutil.Amount,
+	hash lntypes.Hash) (*wire.MsgTx, error) {
+
+	feeRate, err := s.lndServices.WalletKit.EstimateFee(
+		ctx, DefaultHtlcConfTarget,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("estimate fee: %v", err)
+	}
+
+	return s.lndServices.WalletKit.SendOutputs(
+		ctx, []*wire.TxOut{
+			{
+				PkScript: timeoutHtlc.PkScript,
+				Value:    int64(amount),
+			},
+			{
+				PkScript: successHtlc.PkScript,
+				Value:    int64(amount),
+			},
+		}, feeRate,
+		labels.RecoveryDrillHtlc(hash),
+	)
+}
+
+// recoveryDrillSweep signs a sweep of an htlc in our funding transaction and
+// verifies it against the htlc's script.
+func (s *Client) recoveryDrillSweep(ctx context.Context, fundingTx *wire.MsgTx,
+	height int32, sequence uint32, htlc *swap.Htlc, key [33]byte,
+	witnessFunc func(sig []byte) (wire.TxWitness, error),
+	amount, fee btcutil.Amount, sweepAddr btcutil.Address) (*wire.MsgTx,
+	error) {
+
+	outpoint, value, err := swap.GetScriptOutput(fundingTx, htlc.PkScript)
+	if err != nil {
+		return nil, err
+	}
+
+	if value != amount {
+		return nil, fmt.Errorf("htlc value %v does not match drill "+
+			"amount %v", value, amount)
+	}
+
+	sweepTx, err := s.sweeper.CreateSweepTx(
+		ctx, height, sequence, htlc, *outpoint, key, witnessFunc,
+		value, fee, sweepAddr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifySweep(htlc, sweepTx, value); err != nil {
+		return nil, fmt.Errorf("script verification: %w", err)
+	}
+
+	return sweepTx, nil
+}
+
+// verifySweep executes the script of the htlc that a sweep transaction spends
+// in its first input.
+func verifySweep(htlc *swap.Htlc, sweepTx *wire.MsgTx,
+	value btcutil.Amount) error {
+
+	engine, err := txscript.NewEngine(
+		htlc.PkScript, sweepTx, 0, txscript.StandardVerifyFlags, nil,
+		nil, int64(value),
+	)
+	if err != nil {
+		return err
+	}
+
+	return engine.Execute()
+}
+
+// awaitRecoveryDrillFunding waits for our drill's funding transaction to
+// confirm.
+func (s *Client) awaitRecoveryDrillFunding(ctx context.Context,
+	fundingTx *wire.MsgTx, htlc *swap.Htlc, heightHint int32) error {
+
+	fundingHash := fundingTx.TxHash()
+
+	log.Infof("Recovery drill waiting for funding tx %v to confirm",
+		fundingHash)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	notifier := s.lndServices.ChainNotifier
+	confChan, errChan, err := notifier.RegisterConfirmationsNtfn(
+		ctx, &fundingHash, htlc.PkScript, 1, heightHint,
+	)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-confChan:
+		return nil
+
+	case err := <-errChan:
+		return err
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,146 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckRecoveryDrillNetwork tests that recovery drills are only allowed on
+// networks where they do not lock up real funds.
+func TestCheckRecoveryDrillNetwork(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  *chaincfg.Params
+		allowed bool
+	}{
+		{
+			name:    "regtest",
+			params:  &chaincfg.RegressionNetParams,
+			allowed: true,
+		},
+		{
+			name:    "signet",
+			params:  &chaincfg.SigNetParams,
+			allowed: true,
+		},
+		{
+			name:    "testnet",
+			params:  &chaincfg.TestNet3Params,
+			allowed: false,
+		},
+		{
+			name:    "mainnet",
+			params:  &chaincfg.MainNetParams,
+			allowed: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			err := checkRecoveryDrillNetwork(testCase.params)
+			if testCase.allowed {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, ErrRecoveryDrillNetwork)
+		})
+	}
+}
+
+// TestRecoveryDrillSweeps tests that the sweeps of our recovery drill's htlcs
+// verify against their scripts when signed with our keys, and fail to verify
+// otherwise.
+func TestRecoveryDrillSweeps(t *testing.T) {
+	const (
+		height = int32(600)
+		amount = btcutil.Amount(20000)
+	)
+
+	senderPriv, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	receiverPriv, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	var senderKey, receiverKey [33]byte
+	copy(senderKey[:], senderPriv.PubKey().SerializeCompressed())
+	copy(receiverKey[:], receiverPriv.PubKey().SerializeCompressed())
+
+	timeoutHtlc, successHtlc, preimage, err := newRecoveryDrillHtlcs(
+		height, senderKey, receiverKey, &chaincfg.RegressionNetParams,
+	)
+	require.NoError(t, err)
+
+	// sign creates a sweep of the htlc provided, signed with the key
+	// provided. Like lnd's signer, it returns the signature without a
+	// sighash flag, because our witness functions add it.
+	sign := func(t *testing.T, htlcScript []byte, sequence uint32,
+		key *btcec.PrivateKey) (*wire.MsgTx, []byte) {
+
+		sweepTx := wire.NewMsgTx(2)
+		sweepTx.LockTime = uint32(height)
+		sweepTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: wire.OutPoint{Index: 0},
+			Sequence:         sequence,
+		})
+		sweepTx.AddTxOut(&wire.TxOut{
+			PkScript: []byte{txscript.OP_TRUE},
+			Value:    int64(amount - 1000),
+		})
+
+		sig, err := txscript.RawTxInWitnessSignature(
+			sweepTx, txscript.NewTxSigHashes(sweepTx), 0,
+			int64(amount), htlcScript, txscript.SigHashAll, key,
+		)
+		require.NoError(t, err)
+
+		return sweepTx, sig[:len(sig)-1]
+	}
+
+	t.Run("timeout sweep", func(t *testing.T) {
+		sweepTx, sig := sign(t, timeoutHtlc.Script(), 0, senderPriv)
+		sweepTx.TxIn[0].Witness = timeoutHtlc.GenTimeoutWitness(sig)
+
+		require.NoError(t, verifySweep(timeoutHtlc, sweepTx, amount))
+	})
+
+	t.Run("timeout sweep wrong key", func(t *testing.T) {
+		sweepTx, sig := sign(t, timeoutHtlc.Script(), 0, receiverPriv)
+		sweepTx.TxIn[0].Witness = timeoutHtlc.GenTimeoutWitness(sig)
+
+		require.Error(t, verifySweep(timeoutHtlc, sweepTx, amount))
+	})
+
+	t.Run("success sweep", func(t *testing.T) {
+		sweepTx, sig := sign(
+			t, successHtlc.Script(), successHtlc.SuccessSequence(),
+			receiverPriv,
+		)
+		witness, err := successHtlc.GenSuccessWitness(sig, preimage)
+		require.NoError(t, err)
+		sweepTx.TxIn[0].Witness = witness
+
+		require.NoError(t, verifySweep(successHtlc, sweepTx, amount))
+	})
+
+	t.Run("success sweep wrong amount", func(t *testing.T) {
+		sweepTx, sig := sign(
+			t, successHtlc.Script(), successHtlc.SuccessSequence(),
+			receiverPriv,
+		)
+		witness, err := successHtlc.GenSuccessWitness(sig, preimage)
+		require.NoError(t, err)
+		sweepTx.TxIn[0].Witness = witness
+
+		require.Error(t, verifySweep(successHtlc, sweepTx, amount+1))
+	})
+}
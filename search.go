@@ -0,0 +1,172 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// ErrEmptySearchQuery is returned when a swap search is performed without a
+// query.
+var ErrEmptySearchQuery = errors.New("search query must be set")
+
+// SearchSwaps returns the hashes of all swaps in our store that have a hash,
+// label, notes, htlc txid, invoice, destination address or peer pubkey which
+// contains the query provided. Matching is case-insensitive, and matches
+// prefixes or any other substring of the searched fields.
+func (s *Client) SearchSwaps(ctx context.Context, query string) (
+	[]lntypes.Hash, error) {
+
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil, ErrEmptySearchQuery
+	}
+
+	// Our database reads can't be interrupted once they have started, so
+	// we check whether our request has been canceled between them.
+	loopOutSwaps, err := s.Store.FetchLoopOutSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	loopInSwaps, err := s.Store.FetchLoopInSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Loop out swaps only store the channels they were restricted to, so
+	// we lookup the peers of our open and closed channels to be able to
+	// match them by pubkey.
+	chanPeers, err := s.getChannelPeers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []lntypes.Hash
+	for _, swp := range loopOutSwaps {
+		fields := loopOutSearchFields(swp, chanPeers)
+		if searchFieldsMatch(fields, query) {
+			matches = append(matches, swp.Hash)
+		}
+	}
+
+	for _, swp := range loopInSwaps {
+		if searchFieldsMatch(loopInSearchFields(swp), query) {
+			matches = append(matches, swp.Hash)
+		}
+	}
+
+	return matches, nil
+}
+
+// getChannelPeers returns a map of channel ID to peer for all of our open and
+// closed channels.
+func (s *Client) getChannelPeers(ctx context.Context) (
+	map[uint64]route.Vertex, error) {
+
+	channels, err := s.lndServices.Client.ListChannels(ctx, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	closedChannels, err := s.lndServices.Client.ClosedChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make(
+		map[uint64]route.Vertex, len(channels)+len(closedChannels),
+	)
+	for _, channel := range channels {
+		peers[channel.ChannelID] = channel.PubKeyBytes
+	}
+
+	for _, channel := range closedChannels {
+		peers[channel.ChannelID] = channel.PubKeyBytes
+	}
+
+	return peers, nil
+}
+
+// commonSearchFields returns the searchable fields that are shared between
+// loop in and loop out swaps.
+func commonSearchFields(hash lntypes.Hash, contract *loopdb.SwapContract,
+	events []*loopdb.LoopEvent) []string {
+
+	fields := []string{
+		hash.String(), contract.Label, contract.Notes,
+	}
+
+	for _, event := range events {
+		if event.HtlcTxHash != nil {
+			fields = append(fields, event.HtlcTxHash.String())
+		}
+	}
+
+	return fields
+}
+
+// loopOutSearchFields returns all the fields of a loop out swap that we match
+// search queries against.
+func loopOutSearchFields(swp *loopdb.LoopOut,
+	chanPeers map[uint64]route.Vertex) []string {
+
+	fields := commonSearchFields(
+		swp.Hash, &swp.Contract.SwapContract, swp.Events,
+	)
+
+	fields = append(
+		fields, swp.Contract.SwapInvoice, swp.Contract.PrepayInvoice,
+	)
+
+	if swp.Contract.DestAddr != nil {
+		fields = append(fields, swp.Contract.DestAddr.String())
+	}
+
+	for _, chanID := range swp.Contract.OutgoingChanSet {
+		peer, ok := chanPeers[chanID]
+		if ok {
+			fields = append(fields, peer.String())
+		}
+	}
+
+	return fields
+}
+
+// loopInSearchFields returns all the fields of a loop in swap that we match
+// search queries against.
+func loopInSearchFields(swp *loopdb.LoopIn) []string {
+	fields := commonSearchFields(
+		swp.Hash, &swp.Contract.SwapContract, swp.Events,
+	)
+
+	if swp.Contract.LastHop != nil {
+		fields = append(fields, swp.Contract.LastHop.String())
+	}
+
+	return fields
+}
+
+// searchFieldsMatch returns a boolean indicating whether any of the fields
+// provided contains our (lower case) query.
+func searchFieldsMatch(fields []string, query string) bool {
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), query) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,143 @@
+package loop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSearchSwaps tests matching of search queries against the fields of our
+// stored swaps.
+func TestSearchSwaps(t *testing.T) {
+	var (
+		outHash = lntypes.Hash{1, 2, 3}
+		inHash  = lntypes.Hash{4, 5, 6}
+
+		outPeer = route.Vertex{7, 7, 7}
+		inPeer  = route.Vertex{8, 8, 8}
+
+		htlcTx = chainhash.Hash{9, 9, 9}
+	)
+
+	lnd := test.NewMockLnd()
+	lnd.Channels = []lndclient.ChannelInfo{
+		{
+			ChannelID:   1,
+			PubKeyBytes: outPeer,
+		},
+	}
+
+	store := newStoreMock(t)
+	store.loopOutSwaps[outHash] = &loopdb.LoopOutContract{
+		SwapContract: loopdb.SwapContract{
+			Label: "payout",
+			Notes: "Customer #123",
+		},
+		SwapInvoice:     "lnbcswapinvoice",
+		PrepayInvoice:   "lnbcprepayinvoice",
+		OutgoingChanSet: loopdb.ChannelSet{1},
+	}
+	store.loopOutUpdates[outHash] = []loopdb.SwapStateData{
+		{
+			State:      loopdb.StatePreimageRevealed,
+			HtlcTxHash: &htlcTx,
+		},
+	}
+
+	store.loopInSwaps[inHash] = &loopdb.LoopInContract{
+		SwapContract: loopdb.SwapContract{
+			Label: "inbound",
+		},
+		LastHop: &inPeer,
+	}
+
+	client := &Client{
+		clientConfig: clientConfig{
+			Store: store,
+		},
+		lndServices: &lnd.LndServices,
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		canceled bool
+		expected []lntypes.Hash
+		err      error
+	}{
+		{
+			name:  "empty query",
+			query: " ",
+			err:   ErrEmptySearchQuery,
+		},
+		{
+			name:     "request canceled",
+			query:    "payout",
+			canceled: true,
+			err:      context.Canceled,
+		},
+		{
+			name:     "hash prefix",
+			query:    outHash.String()[:6],
+			expected: []lntypes.Hash{outHash},
+		},
+		{
+			name:     "case insensitive notes",
+			query:    "customer",
+			expected: []lntypes.Hash{outHash},
+		},
+		{
+			name:     "invoice substring",
+			query:    "prepayinv",
+			expected: []lntypes.Hash{outHash},
+		},
+		{
+			name:     "htlc txid",
+			query:    htlcTx.String(),
+			expected: []lntypes.Hash{outHash},
+		},
+		{
+			name:     "loop out peer",
+			query:    outPeer.String(),
+			expected: []lntypes.Hash{outHash},
+		},
+		{
+			name:     "loop in last hop",
+			query:    inPeer.String(),
+			expected: []lntypes.Hash{inHash},
+		},
+		{
+			name:     "label on both swaps",
+			query:    "ou",
+			expected: []lntypes.Hash{outHash, inHash},
+		},
+		{
+			name:  "no match",
+			query: "nothing",
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			if testCase.canceled {
+				cancel()
+			}
+
+			matches, err := client.SearchSwaps(ctx, testCase.query)
+			require.Equal(t, testCase.err, err)
+			require.ElementsMatch(t, testCase.expected, matches)
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package secrets
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// DefaultKeychainService is the service name that preimages are
+	// stored under in the OS keychain.
+	DefaultKeychainService = "loop"
+)
+
+var (
+	// ErrKeychainUnsupported is returned when we do not support the
+	// keychain of the operating system that we are running on.
+	ErrKeychainUnsupported = errors.New("os keychain is only supported " +
+		"on macOS and linux")
+)
+
+// commandError is returned when a command exits with a non-zero exit code.
+type commandError struct {
+	// name is the name of the command.
+	name string
+
+	// code is the command's exit code.
+	code int
+
+	// stderr is the output that the command wrote to stderr.
+	stderr string
+}
+
+// Error returns the error string for a failed command.
+func (c *commandError) Error() string {
+	return fmt.Sprintf("%v exited with code %v: %v", c.name, c.code,
+		c.stderr)
+}
+
+// commandRunner runs a command with the input provided on stdin, and returns
+// its stdout. A *commandError is returned if the command exits with a
+// non-zero exit code.
+type commandRunner func(stdin []byte, name string, args ...string) ([]byte,
+	error)
+
+// runCommand runs a command on our operating system.
+func runCommand(stdin []byte, name string, args ...string) ([]byte, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return nil, &commandError{
+			name:   name,
+			code:   exitErr.ExitCode(),
+			stderr: strings.TrimSpace(stderr.String()),
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// KeychainStore holds preimages in the OS keychain. On macOS, preimages are
+// stored in the user's login keychain with the security tool. On linux, they
+// are stored with secret-tool, which requires a secret service such as
+// gnome-keyring. Preimages are passed to these tools on stdin so that they do
+// not show up in our process list.
+type KeychainStore struct {
+	// Service is the service name that preimages are stored under.
+	Service string
+
+	goos string
+	run  commandRunner
+}
+
+// A compile time assertion that KeychainStore satisfies the
+// loopdb.SecretStore interface.
+var _ loopdb.SecretStore = (*KeychainStore)(nil)
+
+// NewKeychainStore returns a secret store that holds preimages in the OS
+// keychain under the service name provided.
+func NewKeychainStore(service string) (*KeychainStore, error) {
+	switch runtime.GOOS {
+	case "darwin", "linux":
+
+	default:
+		return nil, ErrKeychainUnsupported
+	}
+
+	return &KeychainStore{
+		Service: service,
+		goos:    runtime.GOOS,
+		run:     runCommand,
+	}, nil
+}
+
+// Name returns the name of the keychain store.
+//
+// NOTE: Part of the loopdb.SecretStore interface.
+func (k *KeychainStore) Name() string {
+	return "keychain"
+}
+
+// StorePreimage stores the preimage for the swap hash provided in the OS
+// keychain.
+//
+// NOTE: Part of the loopdb.SecretStore interface.
+func (k *KeychainStore) StorePreimage(hash lntypes.Hash,
+	preimage lntypes.Preimage) error {
+
+	if k.goos == "darwin" {
+		// The security tool reads commands from stdin in interactive
+		// mode, which lets us add the preimage without providing it
+		// as an argument.
+		cmd := fmt.Sprintf("add-generic-password -U -s %v -a %v "+
+			"-w %v\n", k.Service, hash, preimage)
+
+		_, err := k.run([]byte(cmd), "security", "-i")
+		return err
+	}
+
+	_, err := k.run(
+		[]byte(preimage.String()), "secret-tool", "store",
+		"--label", fmt.Sprintf("%v swap %v", k.Service, hash),
+		"service", k.Service, "hash", hash.String(),
+	)
+
+	return err
+}
+
+// FetchPreimage returns the preimage for the swap hash provided from the OS
+// keychain.
+//
+// NOTE: Part of the loopdb.SecretStore interface.
+func (k *KeychainStore) FetchPreimage(hash lntypes.Hash) (lntypes.Preimage,
+	error) {
+
+	var (
+		out          []byte
+		err          error
+		notFoundCode int
+	)
+
+	// The security tool exits with code 44 if it does not hold an item,
+	// and secret-tool exits with code 1.
+	if k.goos == "darwin" {
+		notFoundCode = 44
+		out, err = k.run(
+			nil, "security", "find-generic-password", "-s",
+			k.Service, "-a", hash.String(), "-w",
+		)
+	} else {
+		notFoundCode = 1
+		out, err = k.run(
+			nil, "secret-tool", "lookup", "service", k.Service,
+			"hash", hash.String(),
+		)
+	}
+
+	var cmdErr *commandError
+	if errors.As(err, &cmdErr) && cmdErr.code == notFoundCode {
+		return lntypes.Preimage{}, loopdb.ErrSecretNotFound
+	}
+	if err != nil {
+		return lntypes.Preimage{}, err
+	}
+
+	return lntypes.MakePreimageFromStr(strings.TrimSpace(string(out)))
+}
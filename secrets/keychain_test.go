@@ -0,0 +1,111 @@
+package secrets
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// mockKeychain emulates the keychain tools that we use on each operating
+// system.
+type mockKeychain struct {
+	// secrets maps the hashes that secrets are stored under to the
+	// secrets.
+	secrets map[string]string
+
+	// args records the arguments of every command that we ran.
+	args [][]string
+}
+
+func (m *mockKeychain) run(stdin []byte, name string, args ...string) ([]byte,
+	error) {
+
+	m.args = append(m.args, append([]string{name}, args...))
+	last := args[len(args)-1]
+
+	switch {
+	// The security tool's add command is provided on stdin.
+	case name == "security" && args[0] == "-i":
+		fields := strings.Fields(string(stdin))
+		m.secrets[fields[5]] = fields[7]
+		return nil, nil
+
+	case name == "security":
+		secret, ok := m.secrets[args[4]]
+		if !ok {
+			return nil, &commandError{name: name, code: 44}
+		}
+
+		return []byte(secret + "\n"), nil
+
+	case args[0] == "store":
+		m.secrets[last] = string(stdin)
+		return nil, nil
+
+	default:
+		secret, ok := m.secrets[last]
+		if !ok {
+			return nil, &commandError{name: name, code: 1}
+		}
+
+		return []byte(secret), nil
+	}
+}
+
+// TestKeychainStore tests storing preimages in the keychains of the operating
+// systems that we support.
+func TestKeychainStore(t *testing.T) {
+	preimage := lntypes.Preimage{1, 2, 3}
+	hash := preimage.Hash()
+
+	for _, goos := range []string{"darwin", "linux"} {
+		goos := goos
+
+		t.Run(goos, func(t *testing.T) {
+			keychain := &mockKeychain{
+				secrets: make(map[string]string),
+			}
+
+			store := &KeychainStore{
+				Service: DefaultKeychainService,
+				goos:    goos,
+				run:     keychain.run,
+			}
+
+			_, err := store.FetchPreimage(hash)
+			require.ErrorIs(t, err, loopdb.ErrSecretNotFound)
+
+			require.NoError(t, store.StorePreimage(hash, preimage))
+
+			stored, err := store.FetchPreimage(hash)
+			require.NoError(t, err)
+			require.Equal(t, preimage, stored)
+
+			// We should never pass the preimage as an argument.
+			for _, args := range keychain.args {
+				require.NotContains(
+					t, args, preimage.String(),
+				)
+			}
+		})
+	}
+
+	// Errors other than a missing secret should be returned as is.
+	store := &KeychainStore{
+		goos: "linux",
+		run: func(_ []byte, name string, _ ...string) ([]byte,
+			error) {
+
+			return nil, &commandError{name: name, code: 2}
+		},
+	}
+
+	_, err := store.FetchPreimage(hash)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, loopdb.ErrSecretNotFound)
+	require.Equal(t, "secret-tool exited with code 2: ", fmt.Sprint(err))
+}
@@ -0,0 +1,168 @@
+package secrets
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// DefaultVaultMount is the default mount path of the kv secrets
+	// engine that preimages are stored in.
+	DefaultVaultMount = "secret"
+
+	// DefaultVaultPath is the default path under the mount that preimages
+	// are stored at.
+	DefaultVaultPath = "loop"
+
+	// vaultTimeout is the maximum time that a request to vault may take.
+	vaultTimeout = 30 * time.Second
+)
+
+// VaultStore holds preimages in a HashiCorp Vault kv version 2 secrets engine,
+// or any key management system that serves the same http api. Each preimage
+// is stored as its own secret, at {mount}/data/{path}/{hash}.
+type VaultStore struct {
+	// Addr is the address of the vault server, including its scheme.
+	Addr string
+
+	// Token is the token that we authenticate with.
+	Token string
+
+	// Mount is the mount path of the kv secrets engine.
+	Mount string
+
+	// Path is the path under the mount that preimages are stored at.
+	Path string
+
+	// Client is the http client used to make requests to vault.
+	Client *http.Client
+}
+
+// A compile time assertion that VaultStore satisfies the loopdb.SecretStore
+// interface.
+var _ loopdb.SecretStore = (*VaultStore)(nil)
+
+// NewVaultStore returns a secret store that holds preimages in the vault
+// server provided.
+func NewVaultStore(addr, token, mount, path string) *VaultStore {
+	return &VaultStore{
+		Addr:  strings.TrimSuffix(addr, "/"),
+		Token: token,
+		Mount: strings.Trim(mount, "/"),
+		Path:  strings.Trim(path, "/"),
+		Client: &http.Client{
+			Timeout: vaultTimeout,
+		},
+	}
+}
+
+// Name returns the name of the vault store.
+//
+// NOTE: Part of the loopdb.SecretStore interface.
+func (v *VaultStore) Name() string {
+	return "vault"
+}
+
+// vaultSecret is the secret that we store a preimage in.
+type vaultSecret struct {
+	Preimage string `json:"preimage"`
+}
+
+// vaultWriteRequest is the body of a request to write a secret.
+type vaultWriteRequest struct {
+	Data vaultSecret `json:"data"`
+}
+
+// vaultReadResponse is the body of a response to a request to read a secret.
+// Version 2 of the kv engine nests the secret in the response's data along
+// with its metadata.
+type vaultReadResponse struct {
+	Data struct {
+		Data vaultSecret `json:"data"`
+	} `json:"data"`
+}
+
+// secretURL returns the url of the secret for the swap hash provided.
+func (v *VaultStore) secretURL(hash lntypes.Hash) string {
+	return fmt.Sprintf("%v/v1/%v/data/%v/%v", v.Addr, v.Mount, v.Path,
+		hash)
+}
+
+// do makes a request to vault, returning the response if it succeeded.
+func (v *VaultStore) do(method, url string, body []byte) (*http.Response,
+	error) {
+
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", v.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := v.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, loopdb.ErrSecretNotFound
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("vault returned status: %v", resp.Status)
+	}
+
+	return resp, nil
+}
+
+// StorePreimage stores the preimage for the swap hash provided in vault.
+//
+// NOTE: Part of the loopdb.SecretStore interface.
+func (v *VaultStore) StorePreimage(hash lntypes.Hash,
+	preimage lntypes.Preimage) error {
+
+	body, err := json.Marshal(vaultWriteRequest{
+		Data: vaultSecret{
+			Preimage: preimage.String(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := v.do(http.MethodPost, v.secretURL(hash), body)
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+// FetchPreimage returns the preimage for the swap hash provided from vault.
+//
+// NOTE: Part of the loopdb.SecretStore interface.
+func (v *VaultStore) FetchPreimage(hash lntypes.Hash) (lntypes.Preimage,
+	error) {
+
+	resp, err := v.do(http.MethodGet, v.secretURL(hash), nil)
+	if err != nil {
+		return lntypes.Preimage{}, err
+	}
+	defer resp.Body.Close()
+
+	var secret vaultReadResponse
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return lntypes.Preimage{}, err
+	}
+
+	return lntypes.MakePreimageFromStr(secret.Data.Data.Preimage)
+}
@@ -0,0 +1,72 @@
+package secrets
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVaultStore tests storing preimages in a vault kv version 2 secrets
+// engine.
+func TestVaultStore(t *testing.T) {
+	const token = "token"
+
+	secrets := make(map[string]vaultSecret)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Vault-Token") != token {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+
+			switch r.Method {
+			case http.MethodPost:
+				var req vaultWriteRequest
+				err := json.NewDecoder(r.Body).Decode(&req)
+				require.NoError(t, err)
+
+				secrets[r.URL.Path] = req.Data
+
+			case http.MethodGet:
+				secret, ok := secrets[r.URL.Path]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				var resp vaultReadResponse
+				resp.Data.Data = secret
+				require.NoError(
+					t, json.NewEncoder(w).Encode(resp),
+				)
+			}
+		},
+	))
+	defer server.Close()
+
+	preimage := lntypes.Preimage{1, 2, 3}
+	hash := preimage.Hash()
+
+	store := NewVaultStore(server.URL+"/", token, "/kv/", "loop")
+
+	_, err := store.FetchPreimage(hash)
+	require.ErrorIs(t, err, loopdb.ErrSecretNotFound)
+
+	require.NoError(t, store.StorePreimage(hash, preimage))
+	require.Contains(t, secrets, "/v1/kv/data/loop/"+hash.String())
+
+	stored, err := store.FetchPreimage(hash)
+	require.NoError(t, err)
+	require.Equal(t, preimage, stored)
+
+	// Requests that vault rejects should fail.
+	store.Token = "wrong"
+	_, err = store.FetchPreimage(hash)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, loopdb.ErrSecretNotFound)
+}
@@ -0,0 +1,78 @@
+package loop
+
+import (
+	"context"
+	"time"
+)
+
+// NoticeCategory describes the kind of operational notice that the server
+// has published.
+type NoticeCategory uint8
+
+const (
+	// NoticeGeneral is a notice that does not fit any other category.
+	NoticeGeneral NoticeCategory = iota
+
+	// NoticeMaintenance indicates that the server is in maintenance, and
+	// may not accept new swaps.
+	NoticeMaintenance
+
+	// NoticeFeeChange indicates that the server is changing the fees that
+	// it charges for swaps.
+	NoticeFeeChange
+)
+
+// String returns a string representation of a notice category.
+func (n NoticeCategory) String() string {
+	switch n {
+	case NoticeGeneral:
+		return "general"
+
+	case NoticeMaintenance:
+		return "maintenance"
+
+	case NoticeFeeChange:
+		return "fee change"
+
+	default:
+		return "unknown"
+	}
+}
+
+// ServerNotice is an operational notice published by the swap server.
+type ServerNotice struct {
+	// ID is the server's unique identifier for the notice.
+	ID string
+
+	// Category is the kind of notice.
+	Category NoticeCategory
+
+	// Message is a human readable description of the notice.
+	Message string
+
+	// Start is the time that the notice takes effect. It is zero if the
+	// notice is already in effect.
+	Start time.Time
+
+	// End is the time that the notice ends. It is zero if the notice has
+	// no scheduled end.
+	End time.Time
+}
+
+// Active returns a boolean indicating whether the notice is in effect at the
+// time provided.
+func (n *ServerNotice) Active(now time.Time) bool {
+	if !n.Start.IsZero() && now.Before(n.Start) {
+		return false
+	}
+
+	return n.End.IsZero() || now.Before(n.End)
+}
+
+// ServerNotices returns the set of operational notices that the server has
+// published.
+func (s *Client) ServerNotices(ctx context.Context) ([]*ServerNotice,
+	error) {
+
+	return s.Server.FetchNotices(ctx)
+}
@@ -0,0 +1,240 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+const (
+	// maxServerCalls is the maximum number of quote and terms calls that
+	// we make to the swap server concurrently.
+	maxServerCalls = 4
+
+	// reservedInteractiveCalls is the number of concurrent server calls
+	// that background calls may not use, so that interactive calls do not
+	// need to wait for background calls to complete.
+	reservedInteractiveCalls = 1
+
+	// maxQueuedInteractive is the maximum number of interactive server
+	// calls that may wait for a free slot.
+	maxQueuedInteractive = 100
+
+	// maxQueuedBackground is the maximum number of background server calls
+	// that may wait for a free slot.
+	maxQueuedBackground = 20
+)
+
+// ErrServerQueueFull is returned when a server call cannot be queued because
+// too many calls of its priority class are already waiting.
+var ErrServerQueueFull = errors.New("too many swap server calls queued")
+
+// ServerPriority is the priority class of a call to the swap server.
+type ServerPriority uint8
+
+const (
+	// PriorityInteractive is the priority of calls that a user is waiting
+	// on, such as quotes requested over rpc. Calls are interactive unless
+	// their context is marked otherwise.
+	PriorityInteractive ServerPriority = iota
+
+	// PriorityBackground is the priority of calls made by automated
+	// processes such as the autolooper.
+	PriorityBackground
+
+	// numPriorities is the number of priority classes that we have.
+	numPriorities
+)
+
+// String returns the string representation of a server priority.
+func (p ServerPriority) String() string {
+	switch p {
+	case PriorityInteractive:
+		return "interactive"
+
+	case PriorityBackground:
+		return "background"
+
+	default:
+		return "unknown"
+	}
+}
+
+// serverPriorityKey is the context key that a call's server priority is
+// stored under.
+type serverPriorityKey struct{}
+
+// WithServerPriority returns a context that marks the swap server calls made
+// with it as having the priority provided.
+func WithServerPriority(ctx context.Context,
+	priority ServerPriority) context.Context {
+
+	return context.WithValue(ctx, serverPriorityKey{}, priority)
+}
+
+// serverPriority returns the priority of the server calls made with the
+// context provided.
+func serverPriority(ctx context.Context) ServerPriority {
+	priority, ok := ctx.Value(serverPriorityKey{}).(ServerPriority)
+	if !ok || priority >= numPriorities {
+		return PriorityInteractive
+	}
+
+	return priority
+}
+
+// serverQueue limits the number of concurrent calls that we make to the swap
+// server. Waiting calls are admitted in priority order, so interactive calls
+// preempt queued background calls, and a number of slots are reserved for
+// interactive calls.
+type serverQueue struct {
+	// maxActive is the maximum number of calls that may be in flight.
+	maxActive int
+
+	// reserved is the number of slots that only interactive calls may
+	// use.
+	reserved int
+
+	// maxQueued is the maximum number of calls of each class that may be
+	// waiting for a slot.
+	maxQueued [numPriorities]int
+
+	// active is the number of calls in flight.
+	active int
+
+	// waiting holds a channel for each call of each class that is waiting
+	// for a slot, in the order that they arrived. A call's channel is
+	// closed once it is admitted.
+	waiting [numPriorities][]chan struct{}
+
+	mu sync.Mutex
+}
+
+// newServerQueue creates a server queue with our default limits.
+func newServerQueue() *serverQueue {
+	return &serverQueue{
+		maxActive: maxServerCalls,
+		reserved:  reservedInteractiveCalls,
+		maxQueued: [numPriorities]int{
+			PriorityInteractive: maxQueuedInteractive,
+			PriorityBackground:  maxQueuedBackground,
+		},
+	}
+}
+
+// canAdmit returns a boolean indicating whether a call of the priority
+// provided may be started now. This function must be called with the mutex
+// held.
+func (q *serverQueue) canAdmit(priority ServerPriority) bool {
+	limit := q.maxActive
+	if priority != PriorityInteractive {
+		limit -= q.reserved
+	}
+
+	return q.active < limit
+}
+
+// acquire waits until a call with the context provided may be made to the
+// server. The closure returned must be called once the call has completed.
+func (q *serverQueue) acquire(ctx context.Context) (func(), error) {
+	priority := serverPriority(ctx)
+
+	q.mu.Lock()
+
+	// We only start right away if no calls of the same or a higher
+	// priority are waiting, so that we do not overtake them.
+	queued := false
+	for p := PriorityInteractive; p <= priority; p++ {
+		if len(q.waiting[p]) != 0 {
+			queued = true
+		}
+	}
+
+	if !queued && q.canAdmit(priority) {
+		q.active++
+		q.mu.Unlock()
+
+		return q.release, nil
+	}
+
+	if len(q.waiting[priority]) >= q.maxQueued[priority] {
+		q.mu.Unlock()
+
+		return nil, ErrServerQueueFull
+	}
+
+	admitted := make(chan struct{})
+	q.waiting[priority] = append(q.waiting[priority], admitted)
+	q.mu.Unlock()
+
+	log.Debugf("Queued %v server call", priority)
+
+	select {
+	case <-admitted:
+		return q.release, nil
+
+	case <-ctx.Done():
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	// If we were admitted while we were cancelled, we need to give our
+	// slot up. Otherwise, we remove ourselves from the queue.
+	select {
+	case <-admitted:
+		q.active--
+
+	default:
+		q.remove(priority, admitted)
+	}
+	q.admit()
+
+	return nil, ctx.Err()
+}
+
+// release frees up the slot of a call that has completed, and admits waiting
+// calls.
+func (q *serverQueue) release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.active--
+	q.admit()
+}
+
+// admit starts as many waiting calls as we have slots for, highest priority
+// first. This function must be called with the mutex held.
+func (q *serverQueue) admit() {
+	for p := PriorityInteractive; p < numPriorities; p++ {
+		for len(q.waiting[p]) != 0 && q.canAdmit(p) {
+			close(q.waiting[p][0])
+			q.waiting[p] = q.waiting[p][1:]
+			q.active++
+		}
+
+		// Lower priority calls may not overtake calls of this class
+		// that are still waiting.
+		if len(q.waiting[p]) != 0 {
+			return
+		}
+	}
+}
+
+// remove removes a waiting call from the queue. This function must be called
+// with the mutex held.
+func (q *serverQueue) remove(priority ServerPriority,
+	admitted chan struct{}) {
+
+	for i, waiting := range q.waiting[priority] {
+		if waiting != admitted {
+			continue
+		}
+
+		q.waiting[priority] = append(
+			q.waiting[priority][:i], q.waiting[priority][i+1:]...,
+		)
+
+		return
+	}
+}
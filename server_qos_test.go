@@ -0,0 +1,110 @@
+package loop
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestServerQueue tests that our server queue admits interactive calls ahead
+// of background calls, reserves slots for interactive calls and bounds the
+// number of queued calls of each class.
+func TestServerQueue(t *testing.T) {
+	defer test.Guard(t)()
+
+	q := &serverQueue{
+		maxActive: 2,
+		reserved:  1,
+		maxQueued: [numPriorities]int{
+			PriorityInteractive: 1,
+			PriorityBackground:  1,
+		},
+	}
+
+	var (
+		interactive = context.Background()
+		background  = WithServerPriority(
+			context.Background(), PriorityBackground,
+		)
+	)
+
+	// acquire starts acquiring a slot in the background, returning a
+	// channel that delivers the result.
+	acquire := func(ctx context.Context) chan error {
+		errChan := make(chan error, 1)
+		go func() {
+			_, err := q.acquire(ctx)
+			errChan <- err
+		}()
+
+		return errChan
+	}
+
+	// waitQueued waits until the number of calls of the priority provided
+	// that are queued reaches the count provided.
+	waitQueued := func(priority ServerPriority, count int) {
+		require.Eventually(t, func() bool {
+			q.mu.Lock()
+			defer q.mu.Unlock()
+
+			return len(q.waiting[priority]) == count
+		}, test.Timeout, time.Millisecond)
+	}
+
+	// Our first background call can start right away, but our second has
+	// to wait because the remaining slot is reserved for interactive calls.
+	release, err := q.acquire(background)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(background)
+	queuedBackground := acquire(ctx)
+	waitQueued(PriorityBackground, 1)
+
+	// We only queue one background call.
+	_, err = q.acquire(background)
+	require.Equal(t, ErrServerQueueFull, err)
+
+	// An interactive call can use the reserved slot right away, and the
+	// next one has to wait.
+	_, err = q.acquire(interactive)
+	require.NoError(t, err)
+
+	queuedInteractive := acquire(interactive)
+	waitQueued(PriorityInteractive, 1)
+
+	// When our first background call completes, the waiting interactive
+	// call should overtake the background call that was queued first.
+	release()
+
+	select {
+	case err := <-queuedInteractive:
+		require.NoError(t, err)
+
+	case <-time.After(test.Timeout):
+		t.Fatal("interactive call not admitted")
+	}
+
+	select {
+	case <-queuedBackground:
+		t.Fatal("background call admitted ahead of interactive")
+
+	default:
+	}
+
+	// When our queued background call is cancelled, it should be removed
+	// from the queue.
+	cancel()
+
+	select {
+	case err := <-queuedBackground:
+		require.Equal(t, context.Canceled, err)
+
+	case <-time.After(test.Timeout):
+		t.Fatal("cancelled call not returned")
+	}
+
+	waitQueued(PriorityBackground, 0)
+}
@@ -0,0 +1,378 @@
+package loop
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// StateGraphFormat is the format that a swap state graph is rendered in.
+type StateGraphFormat uint8
+
+const (
+	// StateGraphDOT renders a state graph in the graphviz DOT language.
+	StateGraphDOT StateGraphFormat = iota
+
+	// StateGraphMermaid renders a state graph as a mermaid state diagram.
+	StateGraphMermaid
+)
+
+// String returns the string representation of a state graph format.
+func (f StateGraphFormat) String() string {
+	switch f {
+	case StateGraphDOT:
+		return "dot"
+
+	case StateGraphMermaid:
+		return "mermaid"
+
+	default:
+		return "unknown"
+	}
+}
+
+// StateTransition is a transition between two swap states.
+type StateTransition struct {
+	// From is the state that the transition starts at.
+	From loopdb.SwapState
+
+	// To is the state that the transition ends at.
+	To loopdb.SwapState
+}
+
+var (
+	// loopOutTransitions are the transitions between the persisted states
+	// of a loop out swap.
+	loopOutTransitions = []StateTransition{
+		{loopdb.StateInitiated, loopdb.StatePreimageRevealed},
+		{loopdb.StateInitiated, loopdb.StateFailOffchainPayments},
+		{loopdb.StateInitiated, loopdb.StateFailTimeout},
+		{loopdb.StateInitiated, loopdb.StateFailInsufficientValue},
+		{loopdb.StateInitiated, loopdb.StateFailMaxTotalCost},
+		{loopdb.StateInitiated, loopdb.StateFailSweepTimeout},
+		{loopdb.StatePreimageRevealed, loopdb.StateSuccess},
+		{loopdb.StatePreimageRevealed, loopdb.StateFailSweepTimeout},
+	}
+
+	// loopInTransitions are the transitions between the persisted states
+	// of a loop in swap.
+	loopInTransitions = []StateTransition{
+		{loopdb.StateInitiated, loopdb.StateHtlcPublished},
+		{loopdb.StateInitiated, loopdb.StateFailTimeout},
+		{loopdb.StateInitiated, loopdb.StateFailMaxTotalCost},
+		{loopdb.StateHtlcPublished, loopdb.StateInvoiceSettled},
+		{loopdb.StateHtlcPublished, loopdb.StateSuccess},
+		{loopdb.StateHtlcPublished, loopdb.StateFailTimeout},
+		{loopdb.StateHtlcPublished, loopdb.StateFailIncorrectHtlcAmt},
+		{loopdb.StateInvoiceSettled, loopdb.StateSuccess},
+	}
+)
+
+// SwapTransitions returns the transitions between the persisted states of
+// the swap type provided. The temporary failure state is not included,
+// because it is never persisted and swaps resume from their last persisted
+// state on restart.
+func SwapTransitions(swapType swap.Type) []StateTransition {
+	if swapType == swap.TypeIn {
+		return loopInTransitions
+	}
+
+	return loopOutTransitions
+}
+
+// SwapStateGraph is the state machine of a swap type, along with the path
+// that a specific swap took through it, if any.
+type SwapStateGraph struct {
+	// SwapType is the type of swap that the graph describes.
+	SwapType swap.Type
+
+	// Transitions holds the transitions of the swap type's state machine.
+	// Transitions that a swap took which are not part of the state
+	// machine, for example because they were recorded by an older version,
+	// are included so that the swap's path is complete.
+	Transitions []StateTransition
+
+	// Path holds the states that a swap went through, in order. It is
+	// empty if the graph is not for a specific swap.
+	Path []loopdb.SwapState
+
+	// Reachable holds the states that are still reachable from the last
+	// state in the swap's path. It is empty if the graph is not for a
+	// specific swap, or if the swap is in a final state.
+	Reachable []loopdb.SwapState
+
+	// Graph is the rendered state graph.
+	Graph string
+}
+
+// Current returns the state that the swap is currently in, and false if the
+// graph is not for a specific swap.
+func (g *SwapStateGraph) Current() (loopdb.SwapState, bool) {
+	if len(g.Path) == 0 {
+		return 0, false
+	}
+
+	return g.Path[len(g.Path)-1], true
+}
+
+// newSwapStateGraph creates a state graph for the swap type and path provided
+// and renders it in the format provided.
+func newSwapStateGraph(swapType swap.Type, path []loopdb.SwapState,
+	format StateGraphFormat) (*SwapStateGraph, error) {
+
+	graph := &SwapStateGraph{
+		SwapType:    swapType,
+		Transitions: SwapTransitions(swapType),
+		Path:        path,
+	}
+
+	// Add any transitions that our swap took that are not part of our
+	// state machine.
+	known := make(map[StateTransition]bool)
+	for _, transition := range graph.Transitions {
+		known[transition] = true
+	}
+
+	for _, transition := range pathTransitions(path) {
+		if known[transition] {
+			continue
+		}
+
+		known[transition] = true
+		graph.Transitions = append(graph.Transitions, transition)
+	}
+
+	if current, ok := graph.Current(); ok {
+		graph.Reachable = reachableStates(graph.Transitions, current)
+	}
+
+	var err error
+	switch format {
+	case StateGraphDOT:
+		graph.Graph = graph.renderDOT()
+
+	case StateGraphMermaid:
+		graph.Graph = graph.renderMermaid()
+
+	default:
+		err = fmt.Errorf("unknown state graph format: %v", format)
+	}
+
+	return graph, err
+}
+
+// swapPath returns the states that a swap with the events provided went
+// through, in order. Swaps start in the initiated state, which is not
+// recorded as an event, and repeated updates of the same state are only
+// included once.
+func swapPath(events []*loopdb.LoopEvent) []loopdb.SwapState {
+	path := []loopdb.SwapState{loopdb.StateInitiated}
+
+	for _, event := range events {
+		if event.State == path[len(path)-1] {
+			continue
+		}
+
+		path = append(path, event.State)
+	}
+
+	return path
+}
+
+// pathTransitions returns the transitions that a path through our state
+// machine took.
+func pathTransitions(path []loopdb.SwapState) []StateTransition {
+	var transitions []StateTransition
+	for i := 1; i < len(path); i++ {
+		transitions = append(transitions, StateTransition{
+			From: path[i-1],
+			To:   path[i],
+		})
+	}
+
+	return transitions
+}
+
+// reachableStates returns the states that can be reached from the state
+// provided, in the order that they are found.
+func reachableStates(transitions []StateTransition,
+	from loopdb.SwapState) []loopdb.SwapState {
+
+	var (
+		reachable []loopdb.SwapState
+		visited   = map[loopdb.SwapState]bool{from: true}
+		queue     = []loopdb.SwapState{from}
+	)
+
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+
+		for _, transition := range transitions {
+			if transition.From != state || visited[transition.To] {
+				continue
+			}
+
+			visited[transition.To] = true
+			reachable = append(reachable, transition.To)
+			queue = append(queue, transition.To)
+		}
+	}
+
+	return reachable
+}
+
+// states returns all of the states in our graph, in the order that they
+// first appear in our transitions.
+func (g *SwapStateGraph) states() []loopdb.SwapState {
+	var (
+		states []loopdb.SwapState
+		seen   = make(map[loopdb.SwapState]bool)
+	)
+
+	add := func(state loopdb.SwapState) {
+		if seen[state] {
+			return
+		}
+
+		seen[state] = true
+		states = append(states, state)
+	}
+
+	add(loopdb.StateInitiated)
+	for _, transition := range g.Transitions {
+		add(transition.From)
+		add(transition.To)
+	}
+
+	return states
+}
+
+// renderDOT renders our graph in the graphviz DOT language. Final states are
+// drawn with a double border, and the states and transitions of our swap's
+// path are highlighted, with the current state filled.
+func (g *SwapStateGraph) renderDOT() string {
+	var (
+		b       strings.Builder
+		taken   = make(map[StateTransition]bool)
+		visited = make(map[loopdb.SwapState]bool)
+	)
+
+	for _, transition := range pathTransitions(g.Path) {
+		taken[transition] = true
+	}
+	for _, state := range g.Path {
+		visited[state] = true
+	}
+	current, hasCurrent := g.Current()
+
+	fmt.Fprintf(&b, "digraph loop_%v {\n",
+		strings.ToLower(g.SwapType.String()))
+	b.WriteString("\trankdir=LR;\n")
+
+	for _, state := range g.states() {
+		var attrs []string
+		if state.Type() != loopdb.StateTypePending {
+			attrs = append(attrs, "peripheries=2")
+		}
+
+		if visited[state] {
+			attrs = append(attrs, "color=blue")
+		}
+
+		if hasCurrent && state == current {
+			attrs = append(
+				attrs, "style=filled", "fillcolor=lightblue",
+			)
+		}
+
+		if len(attrs) == 0 {
+			fmt.Fprintf(&b, "\t%v;\n", state)
+			continue
+		}
+
+		fmt.Fprintf(&b, "\t%v [%v];\n", state,
+			strings.Join(attrs, ", "))
+	}
+
+	for _, transition := range g.Transitions {
+		if taken[transition] {
+			fmt.Fprintf(&b, "\t%v -> %v [color=blue, "+
+				"penwidth=2];\n", transition.From,
+				transition.To)
+
+			continue
+		}
+
+		fmt.Fprintf(&b, "\t%v -> %v;\n", transition.From,
+			transition.To)
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// renderMermaid renders our graph as a mermaid state diagram. Final states
+// transition to mermaid's end state, and the states of our swap's path are
+// highlighted, with the current state styled separately.
+func (g *SwapStateGraph) renderMermaid() string {
+	var b strings.Builder
+
+	b.WriteString("stateDiagram-v2\n")
+	fmt.Fprintf(&b, "\t[*] --> %v\n", loopdb.StateInitiated)
+
+	for _, transition := range g.Transitions {
+		fmt.Fprintf(&b, "\t%v --> %v\n", transition.From,
+			transition.To)
+	}
+
+	for _, state := range g.states() {
+		if state.Type() != loopdb.StateTypePending {
+			fmt.Fprintf(&b, "\t%v --> [*]\n", state)
+		}
+	}
+
+	current, ok := g.Current()
+	if !ok {
+		return b.String()
+	}
+
+	b.WriteString("\tclassDef visited stroke:blue\n")
+	b.WriteString("\tclassDef current fill:lightblue,stroke:blue\n")
+
+	for _, state := range g.states() {
+		for _, visited := range g.Path {
+			if state == visited && state != current {
+				fmt.Fprintf(&b, "\tclass %v visited\n", state)
+				break
+			}
+		}
+	}
+	fmt.Fprintf(&b, "\tclass %v current\n", current)
+
+	return b.String()
+}
+
+// SwapStateGraph returns the state machine of the swap type provided,
+// rendered in the format provided. If a swap hash is provided, the graph
+// is for the swap's type and highlights the path that the swap took.
+func (s *Client) SwapStateGraph(hash *lntypes.Hash, swapType swap.Type,
+	format StateGraphFormat) (*SwapStateGraph, error) {
+
+	if hash == nil {
+		return newSwapStateGraph(swapType, nil, format)
+	}
+
+	data, err := s.fetchReplaySwap(*hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSwapStateGraph(
+		data.swap.SwapType, swapPath(data.events), format,
+	)
+}
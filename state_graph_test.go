@@ -0,0 +1,145 @@
+package loop
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSwapPath tests reconstructing the path that a swap took through its
+// state machine from its events.
+func TestSwapPath(t *testing.T) {
+	event := func(state loopdb.SwapState) *loopdb.LoopEvent {
+		return &loopdb.LoopEvent{
+			SwapStateData: loopdb.SwapStateData{
+				State: state,
+			},
+		}
+	}
+
+	require.Equal(t, []loopdb.SwapState{
+		loopdb.StateInitiated,
+	}, swapPath(nil))
+
+	require.Equal(t, []loopdb.SwapState{
+		loopdb.StateInitiated,
+		loopdb.StateHtlcPublished,
+		loopdb.StateSuccess,
+	}, swapPath([]*loopdb.LoopEvent{
+		event(loopdb.StateHtlcPublished),
+		event(loopdb.StateHtlcPublished),
+		event(loopdb.StateSuccess),
+	}))
+}
+
+// TestSwapStateGraph tests the reachable states and transitions of swap state
+// graphs.
+func TestSwapStateGraph(t *testing.T) {
+	// A graph without a swap has no path or reachable states.
+	graph, err := newSwapStateGraph(swap.TypeOut, nil, StateGraphDOT)
+	require.NoError(t, err)
+	require.Equal(t, loopOutTransitions, graph.Transitions)
+	require.Empty(t, graph.Reachable)
+
+	_, ok := graph.Current()
+	require.False(t, ok)
+
+	// A loop in that has published its htlc can still reach all of the
+	// states that follow it.
+	graph, err = newSwapStateGraph(swap.TypeIn, []loopdb.SwapState{
+		loopdb.StateInitiated, loopdb.StateHtlcPublished,
+	}, StateGraphMermaid)
+	require.NoError(t, err)
+
+	current, ok := graph.Current()
+	require.True(t, ok)
+	require.Equal(t, loopdb.StateHtlcPublished, current)
+	require.Equal(t, []loopdb.SwapState{
+		loopdb.StateInvoiceSettled,
+		loopdb.StateSuccess,
+		loopdb.StateFailTimeout,
+		loopdb.StateFailIncorrectHtlcAmt,
+	}, graph.Reachable)
+
+	// A swap in a final state cannot reach any other states.
+	graph, err = newSwapStateGraph(swap.TypeOut, []loopdb.SwapState{
+		loopdb.StateInitiated, loopdb.StatePreimageRevealed,
+		loopdb.StateSuccess,
+	}, StateGraphDOT)
+	require.NoError(t, err)
+	require.Empty(t, graph.Reachable)
+
+	// Transitions that are not part of our state machine are added to the
+	// graph, so that the swap's path is complete.
+	graph, err = newSwapStateGraph(swap.TypeOut, []loopdb.SwapState{
+		loopdb.StateInitiated, loopdb.StateSuccess,
+	}, StateGraphDOT)
+	require.NoError(t, err)
+	require.Len(t, graph.Transitions, len(loopOutTransitions)+1)
+	require.Equal(t, StateTransition{
+		From: loopdb.StateInitiated,
+		To:   loopdb.StateSuccess,
+	}, graph.Transitions[len(graph.Transitions)-1])
+
+	_, err = newSwapStateGraph(swap.TypeOut, nil, StateGraphFormat(99))
+	require.Error(t, err)
+}
+
+// TestRenderStateGraph tests rendering a swap's state graph.
+func TestRenderStateGraph(t *testing.T) {
+	path := []loopdb.SwapState{
+		loopdb.StateInitiated, loopdb.StateHtlcPublished,
+		loopdb.StateInvoiceSettled,
+	}
+
+	graph, err := newSwapStateGraph(swap.TypeIn, path, StateGraphDOT)
+	require.NoError(t, err)
+
+	expected := "digraph loop_in {\n" +
+		"\trankdir=LR;\n" +
+		"\tInitiated [color=blue];\n" +
+		"\tHtlcPublished [color=blue];\n" +
+		"\tFailTimeout [peripheries=2];\n" +
+		"\tFailMaxTotalCost [peripheries=2];\n" +
+		"\tInvoiceSettled [color=blue, style=filled, " +
+		"fillcolor=lightblue];\n" +
+		"\tSuccess [peripheries=2];\n" +
+		"\tIncorrectHtlcAmt [peripheries=2];\n" +
+		"\tInitiated -> HtlcPublished [color=blue, penwidth=2];\n" +
+		"\tInitiated -> FailTimeout;\n" +
+		"\tInitiated -> FailMaxTotalCost;\n" +
+		"\tHtlcPublished -> InvoiceSettled [color=blue, " +
+		"penwidth=2];\n" +
+		"\tHtlcPublished -> Success;\n" +
+		"\tHtlcPublished -> FailTimeout;\n" +
+		"\tHtlcPublished -> IncorrectHtlcAmt;\n" +
+		"\tInvoiceSettled -> Success;\n" +
+		"}\n"
+	require.Equal(t, expected, graph.Graph)
+
+	graph, err = newSwapStateGraph(swap.TypeIn, path, StateGraphMermaid)
+	require.NoError(t, err)
+
+	expected = "stateDiagram-v2\n" +
+		"\t[*] --> Initiated\n" +
+		"\tInitiated --> HtlcPublished\n" +
+		"\tInitiated --> FailTimeout\n" +
+		"\tInitiated --> FailMaxTotalCost\n" +
+		"\tHtlcPublished --> InvoiceSettled\n" +
+		"\tHtlcPublished --> Success\n" +
+		"\tHtlcPublished --> FailTimeout\n" +
+		"\tHtlcPublished --> IncorrectHtlcAmt\n" +
+		"\tInvoiceSettled --> Success\n" +
+		"\tFailTimeout --> [*]\n" +
+		"\tFailMaxTotalCost --> [*]\n" +
+		"\tSuccess --> [*]\n" +
+		"\tIncorrectHtlcAmt --> [*]\n" +
+		"\tclassDef visited stroke:blue\n" +
+		"\tclassDef current fill:lightblue,stroke:blue\n" +
+		"\tclass Initiated visited\n" +
+		"\tclass HtlcPublished visited\n" +
+		"\tclass InvoiceSettled current\n"
+	require.Equal(t, expected, graph.Graph)
+}
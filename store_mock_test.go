@@ -5,7 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcutil"
 	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
 	"github.com/lightninglabs/loop/test"
 	"github.com/lightningnetwork/lnd/lntypes"
 )
@@ -22,6 +24,12 @@ type storeMock struct {
 	loopInStoreChan  chan loopdb.LoopInContract
 	loopInUpdateChan chan loopdb.SwapStateData
 
+	rebalances map[lntypes.Hash]*loopdb.Rebalance
+	ticks      []*loopdb.AutoloopTick
+	rounds     []*loopdb.SuggestionRound
+	watched    map[lntypes.Hash]*loopdb.WatchedSwap
+	simulated  []*loopdb.SimulatedSwap
+
 	t *testing.T
 }
 
@@ -37,6 +45,8 @@ func newStoreMock(t *testing.T) *storeMock {
 		loopInUpdateChan: make(chan loopdb.SwapStateData, 1),
 		loopInSwaps:      make(map[lntypes.Hash]*loopdb.LoopInContract),
 		loopInUpdates:    make(map[lntypes.Hash][]loopdb.SwapStateData),
+		rebalances:       make(map[lntypes.Hash]*loopdb.Rebalance),
+		watched:          make(map[lntypes.Hash]*loopdb.WatchedSwap),
 		t:                t,
 	}
 }
@@ -171,6 +181,192 @@ func (s *storeMock) UpdateLoopIn(hash lntypes.Hash, time time.Time,
 	return nil
 }
 
+// SetLoopOutNotes replaces the notes of a loop out swap.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) SetLoopOutNotes(hash lntypes.Hash, notes string) error {
+	swap, ok := s.loopOutSwaps[hash]
+	if !ok {
+		return loopdb.ErrSwapNotFound
+	}
+
+	swap.Notes = notes
+
+	return nil
+}
+
+// SetLoopInNotes replaces the notes of a loop in swap.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) SetLoopInNotes(hash lntypes.Hash, notes string) error {
+	swap, ok := s.loopInSwaps[hash]
+	if !ok {
+		return loopdb.ErrSwapNotFound
+	}
+
+	swap.Notes = notes
+
+	return nil
+}
+
+// ResolveSwapConflict drops the swap that is not of the type provided when
+// a loop out and loop in swap share a hash.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) ResolveSwapConflict(hash lntypes.Hash,
+	keep swap.Type) error {
+
+	_, loopOut := s.loopOutSwaps[hash]
+	_, loopIn := s.loopInSwaps[hash]
+	if !loopOut || !loopIn {
+		return loopdb.ErrNoSwapConflict
+	}
+
+	if keep == swap.TypeOut {
+		delete(s.loopInSwaps, hash)
+		delete(s.loopInUpdates, hash)
+	} else {
+		delete(s.loopOutSwaps, hash)
+		delete(s.loopOutUpdates, hash)
+	}
+
+	return nil
+}
+
+// FetchRebalances returns all rebalances currently in the store.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) FetchRebalances() ([]*loopdb.Rebalance, error) {
+	var result []*loopdb.Rebalance
+	for _, rebalance := range s.rebalances {
+		rebalanceCopy := *rebalance
+		result = append(result, &rebalanceCopy)
+	}
+
+	return result, nil
+}
+
+// CreateRebalance adds an initiated rebalance to the store.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) CreateRebalance(hash lntypes.Hash,
+	contract *loopdb.RebalanceContract) error {
+
+	if _, ok := s.rebalances[hash]; ok {
+		return loopdb.ErrRebalanceExists
+	}
+
+	s.rebalances[hash] = &loopdb.Rebalance{
+		RebalanceContract: *contract,
+		Hash:              hash,
+		LastUpdate:        contract.InitiationTime,
+	}
+
+	return nil
+}
+
+// UpdateRebalance updates the state of a rebalance.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) UpdateRebalance(hash lntypes.Hash, time time.Time,
+	state loopdb.RebalanceState, fee btcutil.Amount) error {
+
+	rebalance, ok := s.rebalances[hash]
+	if !ok {
+		return loopdb.ErrRebalanceNotFound
+	}
+
+	rebalance.State = state
+	rebalance.Fee = fee
+	rebalance.LastUpdate = time
+
+	return nil
+}
+
+// FetchWatchedSwaps returns all of the swaps in our watch list.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) FetchWatchedSwaps() ([]*loopdb.WatchedSwap, error) {
+	var result []*loopdb.WatchedSwap
+	for _, watched := range s.watched {
+		watchedCopy := *watched
+		result = append(result, &watchedCopy)
+	}
+
+	return result, nil
+}
+
+// CreateWatchedSwap adds a swap to our watch list.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) CreateWatchedSwap(watched *loopdb.WatchedSwap) error {
+	if _, ok := s.watched[watched.Hash]; ok {
+		return loopdb.ErrWatchedSwapExists
+	}
+
+	watchedCopy := *watched
+	s.watched[watched.Hash] = &watchedCopy
+
+	return nil
+}
+
+// RecordAutoloopTick stores the metrics of an autoloop tick.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) RecordAutoloopTick(tick *loopdb.AutoloopTick) error {
+	s.ticks = append(s.ticks, tick)
+	return nil
+}
+
+// FetchAutoloopTicks returns the autoloop ticks in the store.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) FetchAutoloopTicks() ([]*loopdb.AutoloopTick, error) {
+	return s.ticks, nil
+}
+
+// RecordSuggestionRound stores the suggestions made by an autoloop tick.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) RecordSuggestionRound(round *loopdb.SuggestionRound) error {
+	s.rounds = append(s.rounds, round)
+	return nil
+}
+
+// FetchSuggestionRounds returns the autoloop suggestion rounds in the store.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) FetchSuggestionRounds() ([]*loopdb.SuggestionRound,
+	error) {
+
+	return s.rounds, nil
+}
+
+// RecordSimulatedSwaps stores a set of swaps that autoloop simulated.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) RecordSimulatedSwaps(
+	swaps []*loopdb.SimulatedSwap) error {
+
+	s.simulated = append(s.simulated, swaps...)
+	return nil
+}
+
+// FetchSimulatedSwaps returns the simulated swaps in the store.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) FetchSimulatedSwaps() ([]*loopdb.SimulatedSwap, error) {
+	return s.simulated, nil
+}
+
+// ClearSimulatedSwaps removes all simulated swaps from the store.
+//
+// NOTE: Part of the loopdb.SwapStore interface.
+func (s *storeMock) ClearSimulatedSwaps() error {
+	s.simulated = nil
+	return nil
+}
+
 func (s *storeMock) Close() error {
 	return nil
 }
@@ -0,0 +1,505 @@
+package loop
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/sweep"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"github.com/stretchr/testify/require"
+)
+
+const benchHeight = int32(600)
+
+// benchConcurrency is the set of concurrent swap counts that we benchmark.
+var benchConcurrency = []int{1, 10, 100, 500}
+
+// BenchmarkLoopInSwaps measures the throughput of loop in swaps that run
+// concurrently through the swap state machine and a bolt swap store, with a
+// mocked server and mocked lnd services that respond immediately. Each op is a
+// single swap, from creation to success. Along with swaps per second, we report
+// the mean and maximum latency of our store writes, which grow with contention
+// on the database as we increase the number of concurrent swaps.
+func BenchmarkLoopInSwaps(b *testing.B) {
+	for _, concurrency := range benchConcurrency {
+		concurrency := concurrency
+
+		name := fmt.Sprintf("concurrency=%v", concurrency)
+		b.Run(name, func(b *testing.B) {
+			benchmarkLoopInSwaps(b, concurrency)
+		})
+	}
+}
+
+func benchmarkLoopInSwaps(b *testing.B, concurrency int) {
+	tempDirName, err := ioutil.TempDir("", "swapbench")
+	require.NoError(b, err)
+	defer os.RemoveAll(tempDirName)
+
+	boltStore, err := loopdb.NewBoltSwapStore(
+		tempDirName, &chaincfg.TestNet3Params,
+	)
+	require.NoError(b, err)
+	defer boltStore.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bench := newSwapBenchContext(ctx, boltStore)
+
+	var (
+		swaps   = make(chan struct{})
+		errChan = make(chan error, 1)
+		wg      sync.WaitGroup
+	)
+
+	b.ResetTimer()
+	start := time.Now()
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			// We keep consuming swaps after an error so that we
+			// do not block the loop below, and only report our
+			// first error.
+			for range swaps {
+				err := bench.runLoopIn(ctx)
+				if err == nil {
+					continue
+				}
+
+				select {
+				case errChan <- err:
+				default:
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < b.N; i++ {
+		swaps <- struct{}{}
+	}
+	close(swaps)
+	wg.Wait()
+
+	elapsed := time.Since(start)
+	b.StopTimer()
+
+	select {
+	case err := <-errChan:
+		b.Fatal(err)
+
+	default:
+	}
+
+	b.ReportMetric(float64(b.N)/elapsed.Seconds(), "swaps/s")
+	b.ReportMetric(bench.store.meanWrite(), "db-write-µs")
+	b.ReportMetric(bench.store.maxWrite(), "db-max-write-µs")
+}
+
+// swapBenchContext contains the mocks that our benchmarked swaps share.
+type swapBenchContext struct {
+	cfg     *swapConfig
+	execCfg *executeConfig
+	store   *benchStore
+}
+
+// newSwapBenchContext creates a benchmark context that runs swaps against
+// the store provided. The status updates of our swaps are consumed until the
+// context is canceled.
+func newSwapBenchContext(ctx context.Context,
+	store loopdb.SwapStore) *swapBenchContext {
+
+	mock := test.NewMockLnd()
+
+	lnd := mock.LndServices
+	notifier := &benchNotifier{
+		published: make(map[string]*wire.MsgTx),
+	}
+	invoices := &benchInvoices{
+		InvoicesClient: lnd.Invoices,
+		holdInvoices:   make(map[lntypes.Hash]struct{}),
+		canceled:       make(map[lntypes.Hash]chan struct{}),
+	}
+	lnd.ChainNotifier = notifier
+	lnd.Invoices = invoices
+	lnd.WalletKit = &benchWallet{
+		WalletKitClient: lnd.WalletKit,
+		notifier:        notifier,
+	}
+
+	server := &benchServer{
+		invoices: invoices,
+	}
+
+	timedStore := &benchStore{
+		SwapStore: store,
+	}
+
+	statusChan := make(chan SwapInfo)
+	go func() {
+		for {
+			select {
+			case <-statusChan:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return &swapBenchContext{
+		cfg: newSwapConfig(&lnd, timedStore, server),
+		execCfg: &executeConfig{
+			statusChan: statusChan,
+			sweeper:    &sweep.Sweeper{Lnd: &lnd},
+		},
+		store: timedStore,
+	}
+}
+
+// runLoopIn creates and executes a single loop in swap, and checks that it
+// succeeded.
+func (c *swapBenchContext) runLoopIn(ctx context.Context) error {
+	request := testLoopInRequest
+
+	initResult, err := newLoopInSwap(ctx, c.cfg, benchHeight, &request)
+	if err != nil {
+		return err
+	}
+
+	s := initResult.swap
+	if err := s.execute(ctx, c.execCfg, benchHeight); err != nil {
+		return err
+	}
+
+	if s.state != loopdb.StateSuccess {
+		return fmt.Errorf("swap %v completed in state %v", s.hash,
+			s.state)
+	}
+
+	return nil
+}
+
+// benchStore wraps a swap store and records the latency of the writes that
+// loop in swaps make.
+type benchStore struct {
+	loopdb.SwapStore
+
+	writes    int64
+	writeTime int64
+	maxTime   int64
+}
+
+// CreateLoopIn adds an initiated swap to the store, recording its latency.
+func (s *benchStore) CreateLoopIn(hash lntypes.Hash,
+	swap *loopdb.LoopInContract) error {
+
+	defer s.record(time.Now())
+
+	return s.SwapStore.CreateLoopIn(hash, swap)
+}
+
+// UpdateLoopIn stores a new event for a loop in swap, recording its latency.
+func (s *benchStore) UpdateLoopIn(hash lntypes.Hash, updateTime time.Time,
+	state loopdb.SwapStateData) error {
+
+	defer s.record(time.Now())
+
+	return s.SwapStore.UpdateLoopIn(hash, updateTime, state)
+}
+
+// record records a write that started at the time provided.
+func (s *benchStore) record(start time.Time) {
+	latency := int64(time.Since(start))
+
+	atomic.AddInt64(&s.writes, 1)
+	atomic.AddInt64(&s.writeTime, latency)
+
+	for {
+		max := atomic.LoadInt64(&s.maxTime)
+		if latency <= max {
+			return
+		}
+
+		if atomic.CompareAndSwapInt64(&s.maxTime, max, latency) {
+			return
+		}
+	}
+}
+
+// meanWrite returns the mean write latency in microseconds.
+func (s *benchStore) meanWrite() float64 {
+	writes := atomic.LoadInt64(&s.writes)
+	if writes == 0 {
+		return 0
+	}
+
+	mean := time.Duration(atomic.LoadInt64(&s.writeTime) / writes)
+
+	return float64(mean) / float64(time.Microsecond)
+}
+
+// maxWrite returns the maximum write latency in microseconds.
+func (s *benchStore) maxWrite() float64 {
+	max := time.Duration(atomic.LoadInt64(&s.maxTime))
+
+	return float64(max) / float64(time.Microsecond)
+}
+
+// benchServer is a mocked server that accepts every loop in swap. Unlike our
+// server mock, it does not expect to be stepped through swaps by a test, so
+// it can serve many swaps concurrently. Methods that loop in swaps do not use
+// are left unimplemented.
+type benchServer struct {
+	swapServerClient
+
+	invoices *benchInvoices
+}
+
+// GetLoopInQuote returns a fixed loop in quote.
+func (s *benchServer) GetLoopInQuote(context.Context, btcutil.Amount,
+	route.Vertex, *route.Vertex, [][]zpay32.HopHint) (*LoopInQuote, error) {
+
+	return &LoopInQuote{
+		SwapFee:   testSwapFee,
+		CltvDelta: testChargeOnChainCltvDelta,
+	}, nil
+}
+
+// NewLoopInSwap accepts a loop in swap once the client has canceled our
+// probe payment.
+func (s *benchServer) NewLoopInSwap(ctx context.Context,
+	swapHash lntypes.Hash, _ btcutil.Amount, _ [33]byte, _, _ string,
+	_ *route.Vertex, _ string) (*newLoopInResponse, error) {
+
+	probeHash := lntypes.Hash(sha256.Sum256(swapHash[:]))
+	probeHash[0] ^= 1
+
+	if err := s.invoices.waitForCancel(ctx, probeHash); err != nil {
+		return nil, err
+	}
+
+	_, receiverKey := test.CreateKey(101)
+
+	var receiverKeyArray [33]byte
+	copy(receiverKeyArray[:], receiverKey.SerializeCompressed())
+
+	return &newLoopInResponse{
+		expiry:      benchHeight + testChargeOnChainCltvDelta,
+		receiverKey: receiverKeyArray,
+	}, nil
+}
+
+// SubscribeLoopInUpdates does not provide any server updates.
+func (s *benchServer) SubscribeLoopInUpdates(context.Context,
+	lntypes.Hash) (<-chan *ServerUpdate, <-chan error, error) {
+
+	return nil, nil, nil
+}
+
+// benchInvoices wraps our mocked invoices client, accepting probe payments
+// to hold invoices and settling all other invoices as soon as they are
+// subscribed to.
+type benchInvoices struct {
+	lndclient.InvoicesClient
+
+	holdInvoices map[lntypes.Hash]struct{}
+	canceled     map[lntypes.Hash]chan struct{}
+	mu           sync.Mutex
+}
+
+// AddHoldInvoice adds a hold invoice, which we treat as a probe invoice.
+func (i *benchInvoices) AddHoldInvoice(ctx context.Context,
+	in *invoicesrpc.AddInvoiceData) (string, error) {
+
+	i.mu.Lock()
+	i.holdInvoices[*in.Hash] = struct{}{}
+	i.mu.Unlock()
+
+	return i.InvoicesClient.AddHoldInvoice(ctx, in)
+}
+
+// SubscribeSingleInvoice accepts a payment to probe invoices, and settles
+// swap invoices immediately.
+func (i *benchInvoices) SubscribeSingleInvoice(ctx context.Context,
+	hash lntypes.Hash) (<-chan lndclient.InvoiceUpdate, <-chan error,
+	error) {
+
+	i.mu.Lock()
+	_, probe := i.holdInvoices[hash]
+	i.mu.Unlock()
+
+	update := lndclient.InvoiceUpdate{
+		State: channeldb.ContractSettled,
+	}
+	if probe {
+		update.State = channeldb.ContractAccepted
+	}
+
+	updateChan := make(chan lndclient.InvoiceUpdate, 1)
+	updateChan <- update
+
+	return updateChan, make(chan error), nil
+}
+
+// CancelInvoice records the cancellation of an invoice.
+func (i *benchInvoices) CancelInvoice(_ context.Context,
+	hash lntypes.Hash) error {
+
+	close(i.cancelChan(hash))
+
+	return nil
+}
+
+// waitForCancel waits for the invoice provided to be canceled.
+func (i *benchInvoices) waitForCancel(ctx context.Context,
+	hash lntypes.Hash) error {
+
+	select {
+	case <-i.cancelChan(hash):
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// cancelChan returns the channel that is closed when the invoice provided is
+// canceled.
+func (i *benchInvoices) cancelChan(hash lntypes.Hash) chan struct{} {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	cancelChan, ok := i.canceled[hash]
+	if !ok {
+		cancelChan = make(chan struct{})
+		i.canceled[hash] = cancelChan
+	}
+
+	return cancelChan
+}
+
+// benchWallet wraps our mocked wallet, publishing the transactions that it
+// creates to our benchmark notifier.
+type benchWallet struct {
+	lndclient.WalletKitClient
+
+	notifier *benchNotifier
+	keyIndex int32
+}
+
+// DeriveNextKey derives a new key. Our mocked wallet does not derive keys
+// concurrently, so we track our own index.
+func (w *benchWallet) DeriveNextKey(_ context.Context, family int32) (
+	*keychain.KeyDescriptor, error) {
+
+	index := atomic.AddInt32(&w.keyIndex, 1)
+	_, pubKey := test.CreateKey(index)
+
+	return &keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamily(family),
+			Index:  uint32(index),
+		},
+		PubKey: pubKey,
+	}, nil
+}
+
+// SendOutputs creates a transaction with the outputs provided and publishes
+// it.
+func (w *benchWallet) SendOutputs(_ context.Context, outputs []*wire.TxOut,
+	_ chainfee.SatPerKWeight, _ string) (*wire.MsgTx, error) {
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{})
+	for _, out := range outputs {
+		tx.AddTxOut(out)
+	}
+
+	w.notifier.publish(tx)
+
+	return tx, nil
+}
+
+// benchNotifier is a chain notifier that confirms published transactions as
+// soon as we register for their confirmation, and reports a success sweep of
+// every output that we register a spend for.
+type benchNotifier struct {
+	lndclient.ChainNotifierClient
+
+	published map[string]*wire.MsgTx
+	mu        sync.Mutex
+}
+
+// publish records a published transaction by the script of its first output.
+func (n *benchNotifier) publish(tx *wire.MsgTx) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.published[string(tx.TxOut[0].PkScript)] = tx
+}
+
+// RegisterConfirmationsNtfn confirms a published transaction with the script
+// provided. If no such transaction was published, it never confirms.
+func (n *benchNotifier) RegisterConfirmationsNtfn(_ context.Context,
+	_ *chainhash.Hash, pkScript []byte, _, _ int32) (
+	chan *chainntnfs.TxConfirmation, chan error, error) {
+
+	n.mu.Lock()
+	tx, ok := n.published[string(pkScript)]
+	n.mu.Unlock()
+
+	confChan := make(chan *chainntnfs.TxConfirmation, 1)
+	if ok {
+		confChan <- &chainntnfs.TxConfirmation{
+			Tx:          tx,
+			BlockHeight: uint32(benchHeight),
+		}
+	}
+
+	return confChan, make(chan error), nil
+}
+
+// RegisterSpendNtfn reports a spend of the outpoint provided by a success
+// sweep.
+func (n *benchNotifier) RegisterSpendNtfn(_ context.Context,
+	outpoint *wire.OutPoint, _ []byte, _ int32) (
+	chan *chainntnfs.SpendDetail, chan error, error) {
+
+	successTx := wire.NewMsgTx(2)
+	successTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: *outpoint,
+		Witness:          [][]byte{{}, {}, {}},
+	})
+
+	spendChan := make(chan *chainntnfs.SpendDetail, 1)
+	spendChan <- &chainntnfs.SpendDetail{
+		SpentOutPoint: outpoint,
+		SpendingTx:    successTx,
+	}
+
+	return spendChan, make(chan error), nil
+}
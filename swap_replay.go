@@ -0,0 +1,611 @@
+package loop
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// replayConfTimeout is the amount of time that we wait for lnd to find the
+// confirmation of a swap's htlc when we replay a swap. If the htlc is not
+// found within this time, we report it as unconfirmed.
+const replayConfTimeout = time.Second * 10
+
+// ReplaySource identifies the record that an event in a swap's timeline was
+// reconstructed from.
+type ReplaySource uint8
+
+const (
+	// ReplaySourceStore indicates that an event was recorded in our swap
+	// store.
+	ReplaySourceStore ReplaySource = iota
+
+	// ReplaySourcePayment indicates that an event was reconstructed from
+	// a payment that lnd made for the swap.
+	ReplaySourcePayment
+
+	// ReplaySourceInvoice indicates that an event was reconstructed from
+	// the swap's invoice in lnd.
+	ReplaySourceInvoice
+
+	// ReplaySourceChain indicates that an event was reconstructed from an
+	// on-chain transaction.
+	ReplaySourceChain
+)
+
+// String returns the string representation of a replay source.
+func (r ReplaySource) String() string {
+	switch r {
+	case ReplaySourceStore:
+		return "store"
+
+	case ReplaySourcePayment:
+		return "payment"
+
+	case ReplaySourceInvoice:
+		return "invoice"
+
+	case ReplaySourceChain:
+		return "chain"
+
+	default:
+		return "unknown"
+	}
+}
+
+// ReplayEvent is a single event in the timeline of a swap.
+type ReplayEvent struct {
+	// Time is the time at which the event occurred, or zero if it is not
+	// known.
+	Time time.Time
+
+	// Height is the block height at which the event occurred, or zero if
+	// it is not known.
+	Height int32
+
+	// Source is the record that the event was reconstructed from.
+	Source ReplaySource
+
+	// Description is a human readable description of the event.
+	Description string
+
+	// TxHash is the hash of the transaction that the event relates to, if
+	// any.
+	TxHash *chainhash.Hash
+
+	// Failure indicates that the event is a failure, such as a failed
+	// payment attempt or a swap failure state.
+	Failure bool
+}
+
+// SwapReplay is the timeline of a swap, reconstructed from our swap store,
+// lnd's payments, invoices and wallet transactions and the chain.
+type SwapReplay struct {
+	// Swap is the swap's contract and final state, as recorded in our
+	// store.
+	Swap *SwapInfo
+
+	// CurrentHeight is the block height that lnd was at when the swap was
+	// replayed.
+	CurrentHeight int32
+
+	// Events holds the events in the swap's timeline, ordered by the time
+	// at which they occurred.
+	Events []*ReplayEvent
+}
+
+// replayData holds the records that we reconstruct a swap's timeline from.
+type replayData struct {
+	// swap is the swap that we are replaying.
+	swap *SwapInfo
+
+	// events holds the updates that were recorded for the swap in our
+	// store.
+	events []*loopdb.LoopEvent
+
+	// swapInvoice and prepayInvoice are the invoices that we paid for a
+	// loop out. They are empty for loop ins.
+	swapInvoice, prepayInvoice string
+
+	// payments holds the payments that lnd has made.
+	payments []lndclient.Payment
+
+	// invoice is the swap invoice of a loop in, or nil if it could not be
+	// found in lnd.
+	invoice *lndclient.Invoice
+
+	// txs holds the wallet transactions that lnd has recorded since the
+	// swap was initiated.
+	txs []lndclient.Transaction
+
+	// htlcConf is the confirmation of the swap's htlc, if it was looked
+	// up on chain and found.
+	htlcConf *chainntnfs.TxConfirmation
+
+	// currentHeight is lnd's current block height.
+	currentHeight int32
+}
+
+// ReplaySwap reconstructs the full timeline of the swap with the hash
+// provided from our swap store, lnd's records and the chain.
+func (s *Client) ReplaySwap(ctx context.Context, hash lntypes.Hash) (
+	*SwapReplay, error) {
+
+	data, err := s.fetchReplaySwap(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := s.lndServices.Client.GetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data.currentHeight = int32(info.BlockHeight)
+
+	if data.swap.SwapType == swap.TypeOut {
+		data.payments, err = s.listPayments(ctx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		data.invoice, err = s.lndServices.Client.LookupInvoice(
+			ctx, hash,
+		)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+	}
+
+	data.txs, err = s.lndServices.Client.ListTransactions(
+		ctx, data.swap.InitiationHeight, -1,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// If our htlc was not confirmed in our own wallet, we look it up on
+	// chain.
+	htlcTxHash := replayHtlcTxHash(data.events)
+	if htlcTxHash != nil && !confirmedInWallet(data.txs, *htlcTxHash) {
+		data.htlcConf, err = s.lookupHtlcConf(ctx, data, *htlcTxHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return newSwapReplay(data), nil
+}
+
+// fetchReplaySwap looks up the swap with the hash provided in our store.
+func (s *Client) fetchReplaySwap(hash lntypes.Hash) (*replayData, error) {
+	loopOutSwaps, err := s.Store.FetchLoopOutSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, swp := range loopOutSwaps {
+		if swp.Hash != hash {
+			continue
+		}
+
+		info, err := loopOutSwapInfo(swp, s.lndServices.ChainParams)
+		if err != nil {
+			return nil, err
+		}
+
+		return &replayData{
+			swap:          info,
+			events:        swp.Events,
+			swapInvoice:   swp.Contract.SwapInvoice,
+			prepayInvoice: swp.Contract.PrepayInvoice,
+		}, nil
+	}
+
+	loopInSwaps, err := s.Store.FetchLoopInSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, swp := range loopInSwaps {
+		if swp.Hash != hash {
+			continue
+		}
+
+		info, err := loopInSwapInfo(swp, s.lndServices.ChainParams)
+		if err != nil {
+			return nil, err
+		}
+
+		return &replayData{
+			swap:   info,
+			events: swp.Events,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("swap with hash %v: %w", hash,
+		loopdb.ErrSwapNotFound)
+}
+
+// lookupHtlcConf looks up the confirmation of a swap's htlc on chain. Loop in
+// htlcs may pay to either of our htlc addresses, so we look up both. If the
+// htlc is not found within our timeout, nil is returned.
+func (s *Client) lookupHtlcConf(ctx context.Context, data *replayData,
+	htlcTxHash chainhash.Hash) (*chainntnfs.TxConfirmation, error) {
+
+	outputTypes := []swap.HtlcOutputType{swap.HtlcP2WSH}
+	if data.swap.SwapType == swap.TypeIn {
+		outputTypes = append(outputTypes, swap.HtlcNP2WSH)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, replayConfTimeout)
+	defer cancel()
+
+	var (
+		notifier = s.lndServices.ChainNotifier
+		confChan = make(chan *chainntnfs.TxConfirmation, 1)
+		errChan  = make(chan error, 1)
+	)
+
+	for _, outputType := range outputTypes {
+		htlc, err := swap.NewHtlc(
+			GetHtlcScriptVersion(data.swap.ProtocolVersion),
+			data.swap.CltvExpiry, data.swap.SenderKey,
+			data.swap.ReceiverKey, data.swap.SwapHash, outputType,
+			s.lndServices.ChainParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		conf, confErr, err := notifier.RegisterConfirmationsNtfn(
+			ctx, &htlcTxHash, htlc.PkScript, 1,
+			data.swap.InitiationHeight,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		go func() {
+			select {
+			case c := <-conf:
+				select {
+				case confChan <- c:
+				default:
+				}
+
+			case err := <-confErr:
+				select {
+				case errChan <- err:
+				default:
+				}
+
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	select {
+	case conf := <-confChan:
+		return conf, nil
+
+	case err := <-errChan:
+		return nil, err
+
+	case <-ctx.Done():
+		log.Infof("Htlc %v of swap %v not found on chain within %v",
+			htlcTxHash, data.swap.SwapHash, replayConfTimeout)
+
+		return nil, nil
+	}
+}
+
+// replayHtlcTxHash returns the last htlc tx hash that was recorded for a swap,
+// or nil if none was recorded.
+func replayHtlcTxHash(events []*loopdb.LoopEvent) *chainhash.Hash {
+	var htlcTxHash *chainhash.Hash
+	for _, event := range events {
+		if event.HtlcTxHash != nil {
+			htlcTxHash = event.HtlcTxHash
+		}
+	}
+
+	return htlcTxHash
+}
+
+// confirmedInWallet returns a boolean indicating whether the transaction with
+// the hash provided is confirmed in the set of wallet transactions provided.
+func confirmedInWallet(txs []lndclient.Transaction,
+	hash chainhash.Hash) bool {
+
+	for _, tx := range txs {
+		if tx.TxHash == hash.String() && tx.Confirmations > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newSwapReplay reconstructs a swap's timeline from the records provided.
+func newSwapReplay(data *replayData) *SwapReplay {
+	var (
+		swp        = data.swap
+		events     []*ReplayEvent
+		htlcTxHash = replayHtlcTxHash(data.events)
+	)
+
+	addEvent := func(event *ReplayEvent) {
+		events = append(events, event)
+	}
+
+	addEvent(&ReplayEvent{
+		Time:   swp.InitiationTime,
+		Height: swp.InitiationHeight,
+		Source: ReplaySourceStore,
+		Description: fmt.Sprintf("swap initiated: amount %v, cltv "+
+			"expiry %v, max swap fee %v, max miner fee %v",
+			swp.AmountRequested, swp.CltvExpiry, swp.MaxSwapFee,
+			swp.MaxMinerFee),
+	})
+
+	for _, update := range data.events {
+		description := fmt.Sprintf("state %v", update.State)
+		if update.Cost != (loopdb.SwapCost{}) {
+			description += fmt.Sprintf(", cost: server %v, "+
+				"on-chain %v, off-chain %v", update.Cost.Server,
+				update.Cost.Onchain, update.Cost.Offchain)
+		}
+
+		addEvent(&ReplayEvent{
+			Time:        update.Time,
+			Source:      ReplaySourceStore,
+			Description: description,
+			TxHash:      update.HtlcTxHash,
+			Failure:     update.State.Type() == loopdb.StateTypeFail,
+		})
+	}
+
+	// We include the expiry of our htlc once it has been reached.
+	if swp.CltvExpiry <= data.currentHeight {
+		addEvent(&ReplayEvent{
+			Height:      swp.CltvExpiry,
+			Source:      ReplaySourceChain,
+			Description: "htlc cltv expiry reached",
+		})
+	}
+
+	for _, payment := range data.payments {
+		var name string
+		switch payment.PaymentRequest {
+		case data.swapInvoice:
+			name = "swap payment"
+
+		case data.prepayInvoice:
+			name = "prepay payment"
+
+		default:
+			continue
+		}
+
+		for i, attempt := range payment.Htlcs {
+			addEvent(htlcAttemptEvent(name, i, attempt))
+		}
+
+		if payment.Status == nil {
+			continue
+		}
+
+		addEvent(&ReplayEvent{
+			Time:   paymentResolveTime(payment),
+			Source: ReplaySourcePayment,
+			Description: fmt.Sprintf("%v %v: amount %v, routing "+
+				"fee %v", name, payment.Status.State,
+				payment.Amount.ToSatoshis(),
+				payment.Fee.ToSatoshis()),
+			Failure: payment.Status.State == lnrpc.Payment_FAILED,
+		})
+	}
+
+	if invoice := data.invoice; invoice != nil {
+		switch invoice.State {
+		case channeldb.ContractSettled:
+			addEvent(&ReplayEvent{
+				Time:   invoice.SettleDate,
+				Source: ReplaySourceInvoice,
+				Description: fmt.Sprintf("swap invoice "+
+					"settled: amount paid %v",
+					invoice.AmountPaid.ToSatoshis()),
+			})
+
+		default:
+			addEvent(&ReplayEvent{
+				Source: ReplaySourceInvoice,
+				Description: fmt.Sprintf("swap invoice %v",
+					invoice.State),
+			})
+		}
+	}
+
+	for _, tx := range data.txs {
+		name := replayTxName(swp.SwapHash, htlcTxHash, tx)
+		if name == "" {
+			continue
+		}
+
+		event := &ReplayEvent{
+			Time:   tx.Timestamp,
+			Source: ReplaySourceChain,
+		}
+
+		hash, err := chainhash.NewHashFromStr(tx.TxHash)
+		if err == nil {
+			event.TxHash = hash
+		}
+
+		if tx.Confirmations > 0 {
+			event.Height = data.currentHeight - tx.Confirmations + 1
+			event.Description = fmt.Sprintf("%v confirmed: fee %v",
+				name, tx.Fee)
+		} else {
+			event.Description = fmt.Sprintf("%v published, "+
+				"unconfirmed: fee %v", name, tx.Fee)
+		}
+
+		addEvent(event)
+	}
+
+	if conf := data.htlcConf; conf != nil {
+		addEvent(&ReplayEvent{
+			Height: int32(conf.BlockHeight),
+			Source: ReplaySourceChain,
+			Description: fmt.Sprintf("htlc confirmed in block %v",
+				conf.BlockHash),
+			TxHash: htlcTxHash,
+		})
+	}
+
+	return &SwapReplay{
+		Swap:          swp,
+		CurrentHeight: data.currentHeight,
+		Events:        orderReplayEvents(events),
+	}
+}
+
+// htlcAttemptEvent creates an event for the htlc attempt with the index
+// provided of a swap payment.
+func htlcAttemptEvent(name string, index int,
+	attempt *lnrpc.HTLCAttempt) *ReplayEvent {
+
+	description := fmt.Sprintf("%v attempt %v: %v", name, index+1,
+		attempt.Status)
+
+	if route := attempt.Route; route != nil {
+		fee := lnwire.MilliSatoshi(route.TotalFeesMsat)
+		description += fmt.Sprintf(", fee %v", fee.ToSatoshis())
+
+		if len(route.Hops) > 0 {
+			description += fmt.Sprintf(", channel %v",
+				route.Hops[0].ChanId)
+		}
+	}
+
+	if failure := attempt.Failure; failure != nil {
+		description += fmt.Sprintf(", failure %v at hop %v",
+			failure.Code, failure.FailureSourceIndex)
+	}
+
+	return &ReplayEvent{
+		Time:        time.Unix(0, attempt.AttemptTimeNs),
+		Source:      ReplaySourcePayment,
+		Description: description,
+		Failure:     attempt.Status == lnrpc.HTLCAttempt_FAILED,
+	}
+}
+
+// paymentResolveTime returns the time at which the last htlc attempt of a
+// payment was resolved, or zero if none were resolved.
+func paymentResolveTime(payment lndclient.Payment) time.Time {
+	var resolved int64
+	for _, attempt := range payment.Htlcs {
+		if attempt.ResolveTimeNs > resolved {
+			resolved = attempt.ResolveTimeNs
+		}
+	}
+
+	if resolved == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, resolved)
+}
+
+// replayTxName returns a name for a wallet transaction that relates to the
+// swap with the hash provided, or an empty string if it does not relate to
+// the swap. Transactions are matched by their label, or if they are or spend
+// the swap's htlc.
+func replayTxName(hash lntypes.Hash, htlcTxHash *chainhash.Hash,
+	tx lndclient.Transaction) string {
+
+	switch tx.Label {
+	case labels.LoopOutSweepSuccess(hash):
+		return "sweep"
+
+	case labels.LoopInHtlcLabel(hash):
+		return "htlc"
+
+	case labels.LoopInSweepTimeout(hash):
+		return "timeout sweep"
+	}
+
+	if htlcTxHash == nil {
+		return ""
+	}
+
+	if tx.TxHash == htlcTxHash.String() {
+		return "htlc"
+	}
+
+	if tx.Tx == nil {
+		return ""
+	}
+
+	for _, txIn := range tx.Tx.TxIn {
+		if txIn.PreviousOutPoint.Hash == *htlcTxHash {
+			return "htlc spend"
+		}
+	}
+
+	return ""
+}
+
+// orderReplayEvents orders the events provided by the time at which they
+// occurred. Events that only have a block height are placed before the first
+// event that occurred at the same height or later, and events that have
+// neither are placed at the end of the timeline.
+func orderReplayEvents(events []*ReplayEvent) []*ReplayEvent {
+	var ordered, untimed []*ReplayEvent
+	for _, event := range events {
+		if event.Time.IsZero() {
+			untimed = append(untimed, event)
+			continue
+		}
+
+		ordered = append(ordered, event)
+	}
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Time.Before(ordered[j].Time)
+	})
+
+	for _, event := range untimed {
+		index := len(ordered)
+		if event.Height != 0 {
+			for i, e := range ordered {
+				if e.Height != 0 && e.Height >= event.Height {
+					index = i
+					break
+				}
+			}
+		}
+
+		ordered = append(ordered, nil)
+		copy(ordered[index+1:], ordered[index:])
+		ordered[index] = event
+	}
+
+	return ordered
+}
@@ -0,0 +1,225 @@
+package loop
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSwapReplay tests reconstruction of a loop out's timeline from our store,
+// lnd's payments and wallet transactions and the chain.
+func TestSwapReplay(t *testing.T) {
+	var (
+		hash       = lntypes.Hash{1}
+		start      = time.Unix(100000, 0)
+		htlcTxHash = chainhash.Hash{2}
+		sweepHash  = chainhash.Hash{3}
+		blockHash  = chainhash.Hash{4}
+	)
+
+	swp := &SwapInfo{
+		SwapType: swap.TypeOut,
+		SwapHash: hash,
+		SwapContract: loopdb.SwapContract{
+			AmountRequested:  100_000,
+			CltvExpiry:       200,
+			MaxSwapFee:       1000,
+			MaxMinerFee:      500,
+			InitiationHeight: 100,
+			InitiationTime:   start,
+		},
+	}
+
+	newEvent := func(offset time.Duration, state loopdb.SwapState,
+		cost loopdb.SwapCost,
+		htlcTxHash *chainhash.Hash) *loopdb.LoopEvent {
+
+		return &loopdb.LoopEvent{
+			SwapStateData: loopdb.SwapStateData{
+				State:      state,
+				Cost:       cost,
+				HtlcTxHash: htlcTxHash,
+			},
+			Time: start.Add(offset),
+		}
+	}
+
+	newPayment := func(invoice string, offset time.Duration,
+		fee lnwire.MilliSatoshi) lndclient.Payment {
+
+		attempt := start.Add(offset)
+		return lndclient.Payment{
+			PaymentRequest: invoice,
+			Amount:         lnwire.NewMSatFromSatoshis(1000),
+			Fee:            fee,
+			Status: &lndclient.PaymentStatus{
+				State: lnrpc.Payment_SUCCEEDED,
+			},
+			Htlcs: []*lnrpc.HTLCAttempt{{
+				Status: lnrpc.HTLCAttempt_SUCCEEDED,
+				Route: &lnrpc.Route{
+					TotalFeesMsat: int64(fee),
+					Hops: []*lnrpc.Hop{{
+						ChanId: 123,
+					}},
+				},
+				AttemptTimeNs: attempt.UnixNano(),
+				ResolveTimeNs: attempt.Add(time.Second).
+					UnixNano(),
+			}},
+		}
+	}
+
+	data := &replayData{
+		swap: swp,
+		events: []*loopdb.LoopEvent{
+			newEvent(
+				time.Second, loopdb.StateInitiated,
+				loopdb.SwapCost{}, nil,
+			),
+			newEvent(
+				time.Minute*5, loopdb.StatePreimageRevealed,
+				loopdb.SwapCost{}, &htlcTxHash,
+			),
+			newEvent(
+				time.Minute*20, loopdb.StateSuccess,
+				loopdb.SwapCost{
+					Server:   1000,
+					Onchain:  300,
+					Offchain: 5,
+				}, &htlcTxHash,
+			),
+		},
+		swapInvoice:   "swap",
+		prepayInvoice: "prepay",
+		payments: []lndclient.Payment{
+			newPayment("prepay", time.Second*2, 1000),
+			newPayment("swap", time.Minute*6, 4000),
+
+			// An unrelated payment should not be included.
+			newPayment("other", time.Minute, 1000),
+		},
+		txs: []lndclient.Transaction{
+			{
+				Tx: &wire.MsgTx{
+					TxIn: []*wire.TxIn{{
+						PreviousOutPoint: wire.OutPoint{
+							Hash: htlcTxHash,
+						},
+					}},
+				},
+				TxHash:        sweepHash.String(),
+				Timestamp:     start.Add(time.Minute * 15),
+				Fee:           300,
+				Confirmations: 3,
+				Label:         labels.LoopOutSweepSuccess(hash),
+			},
+			// An unrelated transaction should not be included.
+			{
+				Tx:            &wire.MsgTx{},
+				TxHash:        chainhash.Hash{5}.String(),
+				Timestamp:     start.Add(time.Minute * 10),
+				Confirmations: 5,
+			},
+		},
+		htlcConf: &chainntnfs.TxConfirmation{
+			BlockHash:   &blockHash,
+			BlockHeight: 104,
+		},
+		currentHeight: 110,
+	}
+
+	replay := newSwapReplay(data)
+	require.Equal(t, swp, replay.Swap)
+	require.EqualValues(t, 110, replay.CurrentHeight)
+
+	expected := []*ReplayEvent{
+		{
+			Time:   start,
+			Height: 100,
+			Source: ReplaySourceStore,
+			Description: "swap initiated: amount 0.001 BTC, " +
+				"cltv expiry 200, max swap fee 0.00001 BTC, " +
+				"max miner fee 0.000005 BTC",
+		},
+		{
+			Time:        start.Add(time.Second),
+			Source:      ReplaySourceStore,
+			Description: "state Initiated",
+		},
+		{
+			Time:   start.Add(time.Second * 2),
+			Source: ReplaySourcePayment,
+			Description: "prepay payment attempt 1: SUCCEEDED, " +
+				"fee 0.00000001 BTC, channel 123",
+		},
+		{
+			Time:   start.Add(time.Second * 3),
+			Source: ReplaySourcePayment,
+			Description: "prepay payment SUCCEEDED: amount " +
+				"0.00001 BTC, routing fee 0.00000001 BTC",
+		},
+		{
+			Time:        start.Add(time.Minute * 5),
+			Source:      ReplaySourceStore,
+			Description: "state PreimageRevealed",
+			TxHash:      &htlcTxHash,
+		},
+		{
+			Time:   start.Add(time.Minute * 6),
+			Source: ReplaySourcePayment,
+			Description: "swap payment attempt 1: SUCCEEDED, " +
+				"fee 0.00000004 BTC, channel 123",
+		},
+		{
+			Time:   start.Add(time.Minute*6 + time.Second),
+			Source: ReplaySourcePayment,
+			Description: "swap payment SUCCEEDED: amount " +
+				"0.00001 BTC, routing fee 0.00000004 BTC",
+		},
+		{
+			Height: 104,
+			Source: ReplaySourceChain,
+			Description: "htlc confirmed in block " +
+				blockHash.String(),
+			TxHash: &htlcTxHash,
+		},
+		{
+			Time:        start.Add(time.Minute * 15),
+			Height:      108,
+			Source:      ReplaySourceChain,
+			Description: "sweep confirmed: fee 0.000003 BTC",
+			TxHash:      &sweepHash,
+		},
+		{
+			Time:   start.Add(time.Minute * 20),
+			Source: ReplaySourceStore,
+			Description: "state Success, cost: server 0.00001 " +
+				"BTC, on-chain 0.000003 BTC, off-chain " +
+				"0.00000005 BTC",
+			TxHash: &htlcTxHash,
+		},
+	}
+	require.Equal(t, expected, replay.Events)
+
+	// Once our htlc has expired, its expiry should be included in our
+	// timeline after all of our events.
+	data.currentHeight = 200
+	replay = newSwapReplay(data)
+	require.Equal(t, &ReplayEvent{
+		Height:      200,
+		Source:      ReplaySourceChain,
+		Description: "htlc cltv expiry reached",
+	}, replay.Events[len(replay.Events)-1])
+}
@@ -2,6 +2,7 @@ package loop
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/tls"
 	"encoding/hex"
 	"errors"
@@ -41,6 +42,26 @@ var (
 	// we will not resume our subscription once this error occurs.
 	errSubscriptionFailed = errors.New("failed, no further updates will " +
 		"be provided")
+
+	// ErrDuplicateSwap is returned when the server reports that a swap
+	// creation request conflicts with a swap that it previously created
+	// for the same idempotency token.
+	ErrDuplicateSwap = errors.New("server already created a swap for " +
+		"this request with different parameters")
+
+	// swapCreationRetryDelay is the amount of time we wait before retrying
+	// a swap creation request that failed with a transient error.
+	swapCreationRetryDelay = time.Second * 2
+)
+
+const (
+	// idempotencyTokenLength is the length of the tokens we attach to
+	// swap creation requests.
+	idempotencyTokenLength = 32
+
+	// maxSwapCreationAttempts is the number of times we attempt to create
+	// a swap with the server if our requests fail with transient errors.
+	maxSwapCreationAttempts = 3
 )
 
 // RoutingPluginType represents the routing plugin type directly.
@@ -121,12 +142,20 @@ type swapServerClient interface {
 		swapHash lntypes.Hash, paymentAddr [32]byte,
 		plugin RoutingPluginType, success bool, attempts int32,
 		totalTime int64) error
+
+	// FetchNotices returns the operational notices that the server has
+	// published.
+	FetchNotices(ctx context.Context) ([]*ServerNotice, error)
 }
 
 type grpcSwapServerClient struct {
 	server looprpc.SwapServerClient
 	conn   *grpc.ClientConn
 
+	// queue limits our concurrent quote and terms calls, admitting
+	// interactive calls ahead of background calls.
+	queue *serverQueue
+
 	wg sync.WaitGroup
 }
 
@@ -164,12 +193,19 @@ func newSwapServerClient(cfg *ClientConfig, lsatStore lsat.Store) (
 	return &grpcSwapServerClient{
 		conn:   serverConn,
 		server: server,
+		queue:  newServerQueue(),
 	}, nil
 }
 
 func (s *grpcSwapServerClient) GetLoopOutTerms(ctx context.Context) (
 	*LoopOutTerms, error) {
 
+	release, err := s.queue.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	rpcCtx, rpcCancel := context.WithTimeout(ctx, globalCallTimeout)
 	defer rpcCancel()
 	terms, err := s.server.LoopOutTerms(rpcCtx,
@@ -193,6 +229,12 @@ func (s *grpcSwapServerClient) GetLoopOutQuote(ctx context.Context,
 	amt btcutil.Amount, expiry int32, swapPublicationDeadline time.Time) (
 	*LoopOutQuote, error) {
 
+	release, err := s.queue.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	rpcCtx, rpcCancel := context.WithTimeout(ctx, globalCallTimeout)
 	defer rpcCancel()
 	quoteResp, err := s.server.LoopOutQuote(rpcCtx,
@@ -227,6 +269,12 @@ func (s *grpcSwapServerClient) GetLoopOutQuote(ctx context.Context,
 func (s *grpcSwapServerClient) GetLoopInTerms(ctx context.Context) (
 	*LoopInTerms, error) {
 
+	release, err := s.queue.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	rpcCtx, rpcCancel := context.WithTimeout(ctx, globalCallTimeout)
 	defer rpcCancel()
 	terms, err := s.server.LoopInTerms(rpcCtx,
@@ -248,7 +296,13 @@ func (s *grpcSwapServerClient) GetLoopInQuote(ctx context.Context,
 	amt btcutil.Amount, pubKey route.Vertex, lastHop *route.Vertex,
 	routeHints [][]zpay32.HopHint) (*LoopInQuote, error) {
 
-	err := s.Probe(ctx, amt, pubKey, lastHop, routeHints)
+	release, err := s.queue.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	err = s.Probe(ctx, amt, pubKey, lastHop, routeHints)
 	if err != nil && status.Code(err) != codes.Unavailable {
 		log.Warnf("Server probe error: %v", err)
 	}
@@ -360,23 +414,52 @@ func (s *grpcSwapServerClient) NewLoopOutSwap(ctx context.Context,
 	receiverKey [33]byte, swapPublicationDeadline time.Time,
 	initiator string) (*newLoopOutResponse, error) {
 
-	rpcCtx, rpcCancel := context.WithTimeout(ctx, globalCallTimeout)
-	defer rpcCancel()
-	swapResp, err := s.server.NewLoopOutSwap(rpcCtx,
-		&looprpc.ServerLoopOutRequest{
-			SwapHash:                swapHash[:],
-			Amt:                     uint64(amount),
-			ReceiverKey:             receiverKey[:],
-			SwapPublicationDeadline: swapPublicationDeadline.Unix(),
-			ProtocolVersion:         loopdb.CurrentRPCProtocolVersion,
-			Expiry:                  expiry,
-			UserAgent:               UserAgent(initiator),
-		},
-	)
+	// Generate a token for this request, which we reuse for retries so
+	// that the server can detect duplicate requests. This allows us to
+	// safely retry if our request times out after the server has already
+	// created the swap, rather than risking two swaps and two prepays.
+	token, err := newIdempotencyToken()
 	if err != nil {
 		return nil, err
 	}
 
+	req := &looprpc.ServerLoopOutRequest{
+		SwapHash:                swapHash[:],
+		Amt:                     uint64(amount),
+		ReceiverKey:             receiverKey[:],
+		SwapPublicationDeadline: swapPublicationDeadline.Unix(),
+		ProtocolVersion:         loopdb.CurrentRPCProtocolVersion,
+		Expiry:                  expiry,
+		UserAgent:               UserAgent(initiator),
+		IdempotencyToken:        token,
+	}
+
+	var swapResp *looprpc.ServerLoopOutResponse
+	for attempt := 1; ; attempt++ {
+		swapResp, err = s.newLoopOutSwap(ctx, req)
+		if err == nil {
+			break
+		}
+
+		if status.Code(err) == codes.AlreadyExists {
+			return nil, ErrDuplicateSwap
+		}
+
+		if !isTransientErr(err) || attempt == maxSwapCreationAttempts {
+			return nil, err
+		}
+
+		log.Warnf("Loop out creation attempt %v for %v failed: %v, "+
+			"retrying", attempt, swapHash, err)
+
+		select {
+		case <-time.After(swapCreationRetryDelay):
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
 	var senderKey [33]byte
 	copy(senderKey[:], swapResp.SenderKey)
 
@@ -394,6 +477,41 @@ func (s *grpcSwapServerClient) NewLoopOutSwap(ctx context.Context,
 	}, nil
 }
 
+// newLoopOutSwap makes a single request to the server to create a loop out
+// swap.
+func (s *grpcSwapServerClient) newLoopOutSwap(ctx context.Context,
+	req *looprpc.ServerLoopOutRequest) (*looprpc.ServerLoopOutResponse,
+	error) {
+
+	rpcCtx, rpcCancel := context.WithTimeout(ctx, globalCallTimeout)
+	defer rpcCancel()
+
+	return s.server.NewLoopOutSwap(rpcCtx, req)
+}
+
+// newIdempotencyToken generates a random token for a swap creation request.
+func newIdempotencyToken() ([]byte, error) {
+	token := make([]byte, idempotencyTokenLength)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// isTransientErr returns a boolean indicating whether an error returned by
+// the server is transient, so that a request with an idempotency token can
+// safely be retried.
+func isTransientErr(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+
+	default:
+		return false
+	}
+}
+
 // PushLoopOutPreimage pushes a preimage to the server.
 func (s *grpcSwapServerClient) PushLoopOutPreimage(ctx context.Context,
 	preimage lntypes.Preimage) error {
@@ -844,3 +962,56 @@ type newLoopInResponse struct {
 	expiry        int32
 	serverMessage string
 }
+
+// FetchNotices returns the operational notices that the server has
+// published. Servers that do not publish notices are treated as having no
+// notices.
+func (s *grpcSwapServerClient) FetchNotices(ctx context.Context) (
+	[]*ServerNotice, error) {
+
+	rpcCtx, rpcCancel := context.WithTimeout(ctx, globalCallTimeout)
+	defer rpcCancel()
+
+	resp, err := s.server.FetchNotices(rpcCtx, &looprpc.FetchNoticesReq{
+		ProtocolVersion: loopdb.CurrentRPCProtocolVersion,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	notices := make([]*ServerNotice, 0, len(resp.Notices))
+	for _, notice := range resp.Notices {
+		var category NoticeCategory
+		switch notice.Type {
+		case looprpc.NoticeType_NOTICE_TYPE_MAINTENANCE:
+			category = NoticeMaintenance
+
+		case looprpc.NoticeType_NOTICE_TYPE_FEE_CHANGE:
+			category = NoticeFeeChange
+
+		default:
+			category = NoticeGeneral
+		}
+
+		serverNotice := &ServerNotice{
+			ID:       notice.Id,
+			Category: category,
+			Message:  notice.Message,
+		}
+
+		if notice.StartUnix != 0 {
+			serverNotice.Start = time.Unix(notice.StartUnix, 0)
+		}
+
+		if notice.EndUnix != 0 {
+			serverNotice.End = time.Unix(notice.EndUnix, 0)
+		}
+
+		notices = append(notices, serverNotice)
+	}
+
+	return notices, nil
+}
@@ -0,0 +1,132 @@
+package loop
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/loop/swapserverrpc"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockSwapServerRPC is a mocked server rpc client that fails loop out
+// creation requests with a set of errors before succeeding, and records the
+// requests it receives.
+type mockSwapServerRPC struct {
+	swapserverrpc.SwapServerClient
+
+	errs     []error
+	requests []*swapserverrpc.ServerLoopOutRequest
+}
+
+// NewLoopOutSwap records the request provided and returns our next error, or
+// a successful response if we have no more errors.
+func (m *mockSwapServerRPC) NewLoopOutSwap(_ context.Context,
+	req *swapserverrpc.ServerLoopOutRequest, _ ...grpc.CallOption) (
+	*swapserverrpc.ServerLoopOutResponse, error) {
+
+	m.requests = append(m.requests, req)
+
+	if len(m.errs) > 0 {
+		err := m.errs[0]
+		m.errs = m.errs[1:]
+
+		return nil, err
+	}
+
+	_, senderKey := test.CreateKey(100)
+
+	return &swapserverrpc.ServerLoopOutResponse{
+		SenderKey: senderKey.SerializeCompressed(),
+	}, nil
+}
+
+// TestNewLoopOutSwapRetry tests that we retry loop out creation with the same
+// idempotency token when we hit transient errors, and that we handle duplicate
+// detection by the server.
+func TestNewLoopOutSwapRetry(t *testing.T) {
+	// Shorten our retry delay for the test, restoring it once we are done
+	// so that other tests are not affected.
+	retryDelay := swapCreationRetryDelay
+	swapCreationRetryDelay = time.Millisecond
+	defer func() {
+		swapCreationRetryDelay = retryDelay
+	}()
+
+	var (
+		unavailable = status.Error(codes.Unavailable, "unavailable")
+		timeout     = status.Error(codes.DeadlineExceeded, "timeout")
+		invalid     = status.Error(codes.InvalidArgument, "invalid")
+		duplicate   = status.Error(codes.AlreadyExists, "duplicate")
+	)
+
+	tests := []struct {
+		name     string
+		errs     []error
+		requests int
+		err      error
+	}{
+		{
+			name:     "success",
+			requests: 1,
+		},
+		{
+			name:     "transient errors",
+			errs:     []error{unavailable, timeout},
+			requests: 3,
+		},
+		{
+			name: "attempts exhausted",
+			errs: []error{
+				unavailable, unavailable, unavailable,
+			},
+			requests: maxSwapCreationAttempts,
+			err:      unavailable,
+		},
+		{
+			name:     "permanent error",
+			errs:     []error{invalid},
+			requests: 1,
+			err:      invalid,
+		},
+		{
+			name:     "duplicate",
+			errs:     []error{timeout, duplicate},
+			requests: 2,
+			err:      ErrDuplicateSwap,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			server := &mockSwapServerRPC{
+				errs: testCase.errs,
+			}
+			client := &grpcSwapServerClient{
+				server: server,
+			}
+
+			_, err := client.NewLoopOutSwap(
+				context.Background(), lntypes.Hash{1}, 1000,
+				100, [33]byte{}, time.Now(), "",
+			)
+			require.Equal(t, testCase.err, err)
+			require.Len(t, server.requests, testCase.requests)
+
+			// All of our attempts should use the same token.
+			token := server.requests[0].IdempotencyToken
+			require.Len(t, token, idempotencyTokenLength)
+
+			for _, req := range server.requests {
+				require.Equal(t, token, req.IdempotencyToken)
+			}
+		})
+	}
+}
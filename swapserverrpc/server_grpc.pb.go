@@ -31,6 +31,7 @@ type SwapServerClient interface {
 	Probe(ctx context.Context, in *ServerProbeRequest, opts ...grpc.CallOption) (*ServerProbeResponse, error)
 	RecommendRoutingPlugin(ctx context.Context, in *RecommendRoutingPluginReq, opts ...grpc.CallOption) (*RecommendRoutingPluginRes, error)
 	ReportRoutingResult(ctx context.Context, in *ReportRoutingResultReq, opts ...grpc.CallOption) (*ReportRoutingResultRes, error)
+	FetchNotices(ctx context.Context, in *FetchNoticesReq, opts ...grpc.CallOption) (*FetchNoticesRes, error)
 }
 
 type swapServerClient struct {
@@ -204,6 +205,15 @@ func (c *swapServerClient) ReportRoutingResult(ctx context.Context, in *ReportRo
 	return out, nil
 }
 
+func (c *swapServerClient) FetchNotices(ctx context.Context, in *FetchNoticesReq, opts ...grpc.CallOption) (*FetchNoticesRes, error) {
+	out := new(FetchNoticesRes)
+	err := c.cc.Invoke(ctx, "/looprpc.SwapServer/FetchNotices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SwapServerServer is the server API for SwapServer service.
 // All implementations must embed UnimplementedSwapServerServer
 // for forward compatibility
@@ -221,6 +231,7 @@ type SwapServerServer interface {
 	Probe(context.Context, *ServerProbeRequest) (*ServerProbeResponse, error)
 	RecommendRoutingPlugin(context.Context, *RecommendRoutingPluginReq) (*RecommendRoutingPluginRes, error)
 	ReportRoutingResult(context.Context, *ReportRoutingResultReq) (*ReportRoutingResultRes, error)
+	FetchNotices(context.Context, *FetchNoticesReq) (*FetchNoticesRes, error)
 	mustEmbedUnimplementedSwapServerServer()
 }
 
@@ -267,6 +278,9 @@ func (UnimplementedSwapServerServer) RecommendRoutingPlugin(context.Context, *Re
 func (UnimplementedSwapServerServer) ReportRoutingResult(context.Context, *ReportRoutingResultReq) (*ReportRoutingResultRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportRoutingResult not implemented")
 }
+func (UnimplementedSwapServerServer) FetchNotices(context.Context, *FetchNoticesReq) (*FetchNoticesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchNotices not implemented")
+}
 func (UnimplementedSwapServerServer) mustEmbedUnimplementedSwapServerServer() {}
 
 // UnsafeSwapServerServer may be embedded to opt out of forward compatibility for this service.
@@ -520,6 +534,24 @@ func _SwapServer_ReportRoutingResult_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SwapServer_FetchNotices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchNoticesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwapServerServer).FetchNotices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/looprpc.SwapServer/FetchNotices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwapServerServer).FetchNotices(ctx, req.(*FetchNoticesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SwapServer_ServiceDesc is the grpc.ServiceDesc for SwapServer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -571,6 +603,10 @@ var SwapServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportRoutingResult",
 			Handler:    _SwapServer_ReportRoutingResult_Handler,
 		},
+		{
+			MethodName: "FetchNotices",
+			Handler:    _SwapServer_FetchNotices_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
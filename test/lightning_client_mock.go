@@ -71,6 +71,7 @@ func (h *mockLightningClient) GetInfo(ctx context.Context) (*lndclient.Info,
 	copy(pubKey[:], pubKeyBytes)
 	return &lndclient.Info{
 		BlockHeight:    600,
+		SyncedToChain:  true,
 		IdentityPubkey: pubKey,
 		Uris:           []string{h.lnd.NodePubkey + "@127.0.0.1:9735"},
 	}, nil
@@ -239,6 +240,22 @@ func (h *mockLightningClient) ListChannels(ctx context.Context, _, _ bool) (
 	return h.lnd.Channels, nil
 }
 
+// QueryRoutes returns the mock's route for the destination of the query.
+func (h *mockLightningClient) QueryRoutes(_ context.Context,
+	req lndclient.QueryRoutesRequest) (*lndclient.QueryRoutesResponse,
+	error) {
+
+	h.lnd.lock.Lock()
+	defer h.lnd.lock.Unlock()
+
+	resp, ok := h.lnd.Routes[req.PubKey]
+	if !ok {
+		return nil, lndclient.ErrNoRouteFound
+	}
+
+	return resp, nil
+}
+
 // ClosedChannels returns a list of our closed channels.
 func (h *mockLightningClient) ClosedChannels(_ context.Context) ([]lndclient.ClosedChannel,
 	error) {
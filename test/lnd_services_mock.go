@@ -12,6 +12,7 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
 )
 
@@ -170,6 +171,11 @@ type LndMockServices struct {
 	Payments            []lndclient.Payment
 	MissionControlState []lndclient.MissionControlEntry
 
+	// Routes is the set of routes that the mock returns for queries,
+	// keyed by destination. If no route is set for a destination, queries
+	// fail with lndclient.ErrNoRouteFound.
+	Routes map[route.Vertex]*lndclient.QueryRoutesResponse
+
 	WaitForFinished func()
 
 	lock sync.Mutex
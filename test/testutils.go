@@ -10,6 +10,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -43,14 +44,15 @@ func EncodePayReq(payReq *zpay32.Invoice) (string, error) {
 	privKey, _ := CreateKey(5)
 	reqString, err := payReq.Encode(
 		zpay32.MessageSigner{
-			SignCompact: func(hash []byte) ([]byte, error) {
+			SignCompact: func(msg []byte) ([]byte, error) {
 				// btcec.SignCompact returns a
-				// pubkey-recoverable signature
+				// pubkey-recoverable signature over the
+				// hash of the invoice, so that the invoice
+				// decodes with our fixed key as its
+				// destination.
 				sig, err := btcec.SignCompact(
-					btcec.S256(),
-					privKey,
-					payReq.PaymentHash[:],
-					true,
+					btcec.S256(), privKey,
+					chainhash.HashB(msg), true,
 				)
 				if err != nil {
 					return nil, fmt.Errorf(
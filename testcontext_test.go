@@ -59,8 +59,10 @@ func newSwapClient(config *clientConfig) *Client {
 		errChan:      make(chan error),
 		clientConfig: *config,
 		lndServices:  lndServices,
+		lndFeatures:  NewLndFeatures(lndServices.Version),
 		sweeper:      sweeper,
 		executor:     executor,
+		syncMonitor:  newLndSyncMonitor(lndServices.Client),
 		resumeReady:  make(chan struct{}),
 	}
 }
@@ -0,0 +1,252 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrSwapNotExternal is returned when we try to watch a swap that was
+	// initiated by this client, and is therefore already monitored.
+	ErrSwapNotExternal = errors.New("swap was initiated by this client")
+)
+
+// WatchedSwapState is the state of a watched swap, as implied by lnd's
+// records of its payments and invoices.
+type WatchedSwapState uint8
+
+const (
+	// WatchedSwapUnknown indicates that lnd does not have any records for
+	// the swap yet.
+	WatchedSwapUnknown WatchedSwapState = iota
+
+	// WatchedSwapPending indicates that the swap's off-chain payment is
+	// still in flight.
+	WatchedSwapPending
+
+	// WatchedSwapSuccess indicates that the swap's off-chain payment
+	// settled.
+	WatchedSwapSuccess
+
+	// WatchedSwapFailed indicates that the swap's off-chain payment
+	// failed.
+	WatchedSwapFailed
+)
+
+// String returns a string representation of a watched swap state.
+func (w WatchedSwapState) String() string {
+	switch w {
+	case WatchedSwapUnknown:
+		return "unknown"
+
+	case WatchedSwapPending:
+		return "pending"
+
+	case WatchedSwapSuccess:
+		return "success"
+
+	case WatchedSwapFailed:
+		return "failed"
+
+	default:
+		return "invalid"
+	}
+}
+
+// WatchedSwapInfo contains the current state and costs of a swap that was
+// initiated by another loop client, as implied by lnd's records.
+type WatchedSwapInfo struct {
+	loopdb.WatchedSwap
+
+	// State is the current state of the swap.
+	State WatchedSwapState
+
+	// Amount is the off-chain amount of the swap. For loop outs, this is
+	// the amount that we paid to the swap invoice. For loop ins, this is
+	// the amount that the server paid to our invoice.
+	Amount btcutil.Amount
+
+	// OffchainFees is the routing fees that we paid for a loop out's swap
+	// payment. The prepayment of a loop out has a different hash, so it
+	// is not included.
+	OffchainFees btcutil.Amount
+
+	// OnchainFees is the fee that our wallet paid to publish a loop in's
+	// htlc. Sweeps do not spend wallet inputs, so lnd does not record
+	// their fees.
+	OnchainFees btcutil.Amount
+}
+
+// WatchSwap adds a swap that was initiated by another loop client using the
+// same lnd node to our watch list, so that we can monitor it.
+func (s *Client) WatchSwap(hash lntypes.Hash, swapType swap.Type,
+	label string) error {
+
+	if err := labels.Validate(label); err != nil {
+		return err
+	}
+
+	loopOutSwaps, err := s.Store.FetchLoopOutSwaps()
+	if err != nil {
+		return err
+	}
+
+	for _, loopOut := range loopOutSwaps {
+		if loopOut.Hash == hash {
+			return ErrSwapNotExternal
+		}
+	}
+
+	loopInSwaps, err := s.Store.FetchLoopInSwaps()
+	if err != nil {
+		return err
+	}
+
+	for _, loopIn := range loopInSwaps {
+		if loopIn.Hash == hash {
+			return ErrSwapNotExternal
+		}
+	}
+
+	return s.Store.CreateWatchedSwap(&loopdb.WatchedSwap{
+		Hash:      hash,
+		Type:      swapType,
+		Label:     label,
+		AddedTime: time.Now(),
+	})
+}
+
+// WatchedSwaps returns the current state and costs of each of the swaps in
+// our watch list.
+func (s *Client) WatchedSwaps(ctx context.Context) ([]*WatchedSwapInfo,
+	error) {
+
+	watched, err := s.Store.FetchWatchedSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(watched) == 0 {
+		return nil, nil
+	}
+
+	payments, err := s.listPayments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.lndServices.Client.ListTransactions(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*WatchedSwapInfo, len(watched))
+	for i, watchedSwap := range watched {
+		// Loop ins are paid to an invoice in our lnd node, which may
+		// not have been created yet.
+		var invoice *lndclient.Invoice
+		if watchedSwap.Type == swap.TypeIn {
+			invoice, err = s.lndServices.Client.LookupInvoice(
+				ctx, watchedSwap.Hash,
+			)
+			if status.Code(err) == codes.NotFound {
+				invoice, err = nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		infos[i] = newWatchedSwapInfo(
+			watchedSwap, payments, invoice, txs,
+		)
+	}
+
+	return infos, nil
+}
+
+// newWatchedSwapInfo derives the state and costs of a watched swap from the
+// payments, invoice and transactions provided. The invoice is only used for
+// loop ins, and is nil if lnd does not have an invoice for the swap.
+func newWatchedSwapInfo(watched *loopdb.WatchedSwap,
+	payments []lndclient.Payment, invoice *lndclient.Invoice,
+	txs []lndclient.Transaction) *WatchedSwapInfo {
+
+	info := &WatchedSwapInfo{
+		WatchedSwap: *watched,
+	}
+
+	if watched.Type == swap.TypeOut {
+		var attempts, failed int
+		for _, payment := range payments {
+			if payment.Hash != watched.Hash ||
+				payment.Status == nil {
+
+				continue
+			}
+			attempts++
+
+			switch payment.Status.State {
+			case lnrpc.Payment_SUCCEEDED:
+				info.State = WatchedSwapSuccess
+				info.Amount += payment.Amount.ToSatoshis()
+				info.OffchainFees += payment.Fee.ToSatoshis()
+
+			case lnrpc.Payment_FAILED:
+				failed++
+
+			default:
+				if info.State != WatchedSwapSuccess {
+					info.State = WatchedSwapPending
+				}
+			}
+		}
+
+		// We only consider a loop out to have failed if all of its
+		// payment attempts failed.
+		if attempts > 0 && failed == attempts {
+			info.State = WatchedSwapFailed
+		}
+
+		return info
+	}
+
+	label := labels.LoopInHtlcLabel(watched.Hash)
+	for _, tx := range txs {
+		if tx.Label == label {
+			info.OnchainFees = tx.Fee
+		}
+	}
+
+	if invoice == nil {
+		return info
+	}
+
+	switch invoice.State {
+	case channeldb.ContractSettled:
+		info.State = WatchedSwapSuccess
+		info.Amount = invoice.AmountPaid.ToSatoshis()
+
+	case channeldb.ContractCanceled:
+		info.State = WatchedSwapFailed
+		info.Amount = invoice.Amount.ToSatoshis()
+
+	default:
+		info.State = WatchedSwapPending
+		info.Amount = invoice.Amount.ToSatoshis()
+	}
+
+	return info
+}
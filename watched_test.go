@@ -0,0 +1,188 @@
+package loop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/labels"
+	"github.com/lightninglabs/loop/loopdb"
+	"github.com/lightninglabs/loop/swap"
+	"github.com/lightninglabs/loop/test"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWatchSwap tests adding swaps initiated by other loop clients to our
+// watch list, and deriving their state from lnd's records.
+func TestWatchSwap(t *testing.T) {
+	var (
+		ownHash = lntypes.Hash{1}
+		outHash = lntypes.Hash{2}
+		inHash  = lntypes.Hash{3}
+	)
+
+	lnd := test.NewMockLnd()
+	lnd.Payments = []lndclient.Payment{{
+		Hash:   outHash,
+		Amount: lnwire.NewMSatFromSatoshis(50000),
+		Fee:    lnwire.NewMSatFromSatoshis(10),
+		Status: &lndclient.PaymentStatus{
+			State: lnrpc.Payment_SUCCEEDED,
+		},
+	}}
+	lnd.Invoices[inHash] = &lndclient.Invoice{
+		Hash:       inHash,
+		Amount:     lnwire.NewMSatFromSatoshis(40000),
+		AmountPaid: lnwire.NewMSatFromSatoshis(39900),
+		State:      channeldb.ContractSettled,
+	}
+	lnd.Transactions = []lndclient.Transaction{{
+		Fee:   250,
+		Label: labels.LoopInHtlcLabel(inHash),
+	}}
+
+	store := newStoreMock(t)
+	store.loopOutSwaps[ownHash] = &loopdb.LoopOutContract{}
+
+	client := &Client{
+		clientConfig: clientConfig{
+			Store: store,
+		},
+		lndServices: &lnd.LndServices,
+	}
+
+	// Swaps that we initiated ourselves are already monitored.
+	err := client.WatchSwap(ownHash, swap.TypeOut, "")
+	require.ErrorIs(t, err, ErrSwapNotExternal)
+
+	// Labels must be valid.
+	err = client.WatchSwap(outHash, swap.TypeOut, labels.Reserved)
+	require.ErrorIs(t, err, labels.ErrReservedPrefix)
+
+	require.NoError(t, client.WatchSwap(outHash, swap.TypeOut, "other"))
+	require.NoError(t, client.WatchSwap(inHash, swap.TypeIn, ""))
+
+	// We can only watch a swap once.
+	err = client.WatchSwap(inHash, swap.TypeIn, "")
+	require.ErrorIs(t, err, loopdb.ErrWatchedSwapExists)
+
+	infos, err := client.WatchedSwaps(context.Background())
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+
+	byHash := make(map[lntypes.Hash]*WatchedSwapInfo)
+	for _, info := range infos {
+		byHash[info.Hash] = info
+	}
+
+	out := byHash[outHash]
+	require.Equal(t, "other", out.Label)
+	require.Equal(t, WatchedSwapSuccess, out.State)
+	require.Equal(t, btcutil.Amount(50000), out.Amount)
+	require.Equal(t, btcutil.Amount(10), out.OffchainFees)
+
+	in := byHash[inHash]
+	require.Equal(t, WatchedSwapSuccess, in.State)
+	require.Equal(t, btcutil.Amount(39900), in.Amount)
+	require.Equal(t, btcutil.Amount(250), in.OnchainFees)
+}
+
+// TestWatchedSwapInfo tests deriving the state of watched swaps from the
+// payments and invoices that lnd has for them.
+func TestWatchedSwapInfo(t *testing.T) {
+	hash := lntypes.Hash{1}
+
+	newPayment := func(
+		state lnrpc.Payment_PaymentStatus) lndclient.Payment {
+
+		return lndclient.Payment{
+			Hash:   hash,
+			Amount: lnwire.NewMSatFromSatoshis(1000),
+			Status: &lndclient.PaymentStatus{
+				State: state,
+			},
+		}
+	}
+
+	newInvoice := func(state channeldb.ContractState) *lndclient.Invoice {
+		return &lndclient.Invoice{
+			Hash:   hash,
+			Amount: lnwire.NewMSatFromSatoshis(1000),
+			State:  state,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		swapType swap.Type
+		payments []lndclient.Payment
+		invoice  *lndclient.Invoice
+		state    WatchedSwapState
+	}{
+		{
+			name:     "loop out, no payments",
+			swapType: swap.TypeOut,
+			payments: []lndclient.Payment{{
+				Hash: lntypes.Hash{2},
+				Status: &lndclient.PaymentStatus{
+					State: lnrpc.Payment_SUCCEEDED,
+				},
+			}},
+			state: WatchedSwapUnknown,
+		},
+		{
+			name:     "loop out, retried after failure",
+			swapType: swap.TypeOut,
+			payments: []lndclient.Payment{
+				newPayment(lnrpc.Payment_FAILED),
+				newPayment(lnrpc.Payment_IN_FLIGHT),
+			},
+			state: WatchedSwapPending,
+		},
+		{
+			name:     "loop out, all payments failed",
+			swapType: swap.TypeOut,
+			payments: []lndclient.Payment{
+				newPayment(lnrpc.Payment_FAILED),
+				newPayment(lnrpc.Payment_FAILED),
+			},
+			state: WatchedSwapFailed,
+		},
+		{
+			name:     "loop in, no invoice",
+			swapType: swap.TypeIn,
+			state:    WatchedSwapUnknown,
+		},
+		{
+			name:     "loop in, invoice accepted",
+			swapType: swap.TypeIn,
+			invoice:  newInvoice(channeldb.ContractAccepted),
+			state:    WatchedSwapPending,
+		},
+		{
+			name:     "loop in, invoice canceled",
+			swapType: swap.TypeIn,
+			invoice:  newInvoice(channeldb.ContractCanceled),
+			state:    WatchedSwapFailed,
+		},
+	}
+
+	for _, testCase := range tests {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			info := newWatchedSwapInfo(
+				&loopdb.WatchedSwap{
+					Hash: hash,
+					Type: testCase.swapType,
+				}, testCase.payments, testCase.invoice, nil,
+			)
+			require.Equal(t, testCase.state, info.State)
+		})
+	}
+}